@@ -0,0 +1,977 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.12.4
+// source: github.com/kubernetes-csi/csi-proxy/client/api/bitlocker/v1alpha1/api.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// KeyProtectorType is the type of a key protector that unlocks a volume.
+type KeyProtectorType int32
+
+const (
+	// A password chosen by the caller.
+	KeyProtectorType_PASSWORD KeyProtectorType = 0
+	// A 48 digit numerical recovery password.
+	KeyProtectorType_RECOVERY_PASSWORD KeyProtectorType = 1
+)
+
+// Enum value maps for KeyProtectorType.
+var (
+	KeyProtectorType_name = map[int32]string{
+		0: "PASSWORD",
+		1: "RECOVERY_PASSWORD",
+	}
+	KeyProtectorType_value = map[string]int32{
+		"PASSWORD":          0,
+		"RECOVERY_PASSWORD": 1,
+	}
+)
+
+func (x KeyProtectorType) Enum() *KeyProtectorType {
+	p := new(KeyProtectorType)
+	*p = x
+	return p
+}
+
+func (x KeyProtectorType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyProtectorType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (KeyProtectorType) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_enumTypes[0]
+}
+
+func (x KeyProtectorType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyProtectorType.Descriptor instead.
+func (KeyProtectorType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+// EncryptionMethod is the encryption algorithm and key size of a volume.
+type EncryptionMethod int32
+
+const (
+	EncryptionMethod_XTS_AES_128 EncryptionMethod = 0
+	EncryptionMethod_XTS_AES_256 EncryptionMethod = 1
+	EncryptionMethod_AES_128     EncryptionMethod = 2
+	EncryptionMethod_AES_256     EncryptionMethod = 3
+)
+
+// Enum value maps for EncryptionMethod.
+var (
+	EncryptionMethod_name = map[int32]string{
+		0: "XTS_AES_128",
+		1: "XTS_AES_256",
+		2: "AES_128",
+		3: "AES_256",
+	}
+	EncryptionMethod_value = map[string]int32{
+		"XTS_AES_128": 0,
+		"XTS_AES_256": 1,
+		"AES_128":     2,
+		"AES_256":     3,
+	}
+)
+
+func (x EncryptionMethod) Enum() *EncryptionMethod {
+	p := new(EncryptionMethod)
+	*p = x
+	return p
+}
+
+func (x EncryptionMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EncryptionMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (EncryptionMethod) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_enumTypes[1]
+}
+
+func (x EncryptionMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EncryptionMethod.Descriptor instead.
+func (EncryptionMethod) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+type KeyProtector struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type KeyProtectorType `protobuf:"varint,1,opt,name=type,proto3,enum=v1alpha1.KeyProtectorType" json:"type,omitempty"`
+	// Secret of the key protector i.e. the password or the recovery password.
+	// The recovery password of a new key protector is generated if it's empty.
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *KeyProtector) Reset() {
+	*x = KeyProtector{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyProtector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyProtector) ProtoMessage() {}
+
+func (x *KeyProtector) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyProtector.ProtoReflect.Descriptor instead.
+func (*KeyProtector) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyProtector) GetType() KeyProtectorType {
+	if x != nil {
+		return x.Type
+	}
+	return KeyProtectorType_PASSWORD
+}
+
+func (x *KeyProtector) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type EnableBitLockerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to encrypt.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Key protector added to the volume.
+	KeyProtector *KeyProtector `protobuf:"bytes,2,opt,name=key_protector,json=keyProtector,proto3" json:"key_protector,omitempty"`
+	// Encryption method of the volume, XTS-AES 128 by default.
+	EncryptionMethod EncryptionMethod `protobuf:"varint,3,opt,name=encryption_method,json=encryptionMethod,proto3,enum=v1alpha1.EncryptionMethod" json:"encryption_method,omitempty"`
+	// Encrypt only the used space of the volume, it's faster for new volumes
+	// as the free space doesn't have to be encrypted.
+	UsedSpaceOnly bool `protobuf:"varint,4,opt,name=used_space_only,json=usedSpaceOnly,proto3" json:"used_space_only,omitempty"`
+}
+
+func (x *EnableBitLockerRequest) Reset() {
+	*x = EnableBitLockerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableBitLockerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableBitLockerRequest) ProtoMessage() {}
+
+func (x *EnableBitLockerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableBitLockerRequest.ProtoReflect.Descriptor instead.
+func (*EnableBitLockerRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnableBitLockerRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *EnableBitLockerRequest) GetKeyProtector() *KeyProtector {
+	if x != nil {
+		return x.KeyProtector
+	}
+	return nil
+}
+
+func (x *EnableBitLockerRequest) GetEncryptionMethod() EncryptionMethod {
+	if x != nil {
+		return x.EncryptionMethod
+	}
+	return EncryptionMethod_XTS_AES_128
+}
+
+func (x *EnableBitLockerRequest) GetUsedSpaceOnly() bool {
+	if x != nil {
+		return x.UsedSpaceOnly
+	}
+	return false
+}
+
+type EnableBitLockerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the key protector added to the volume.
+	KeyProtectorId string `protobuf:"bytes,1,opt,name=key_protector_id,json=keyProtectorId,proto3" json:"key_protector_id,omitempty"`
+	// Recovery password of the key protector if it was generated, the caller
+	// must store it as it's the only way to unlock the volume.
+	RecoveryPassword string `protobuf:"bytes,2,opt,name=recovery_password,json=recoveryPassword,proto3" json:"recovery_password,omitempty"`
+}
+
+func (x *EnableBitLockerResponse) Reset() {
+	*x = EnableBitLockerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableBitLockerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableBitLockerResponse) ProtoMessage() {}
+
+func (x *EnableBitLockerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableBitLockerResponse.ProtoReflect.Descriptor instead.
+func (*EnableBitLockerResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnableBitLockerResponse) GetKeyProtectorId() string {
+	if x != nil {
+		return x.KeyProtectorId
+	}
+	return ""
+}
+
+func (x *EnableBitLockerResponse) GetRecoveryPassword() string {
+	if x != nil {
+		return x.RecoveryPassword
+	}
+	return ""
+}
+
+type UnlockVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to unlock.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Key protector of the volume used to unlock it.
+	KeyProtector *KeyProtector `protobuf:"bytes,2,opt,name=key_protector,json=keyProtector,proto3" json:"key_protector,omitempty"`
+}
+
+func (x *UnlockVolumeRequest) Reset() {
+	*x = UnlockVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockVolumeRequest) ProtoMessage() {}
+
+func (x *UnlockVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockVolumeRequest.ProtoReflect.Descriptor instead.
+func (*UnlockVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnlockVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *UnlockVolumeRequest) GetKeyProtector() *KeyProtector {
+	if x != nil {
+		return x.KeyProtector
+	}
+	return nil
+}
+
+type UnlockVolumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnlockVolumeResponse) Reset() {
+	*x = UnlockVolumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockVolumeResponse) ProtoMessage() {}
+
+func (x *UnlockVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockVolumeResponse.ProtoReflect.Descriptor instead.
+func (*UnlockVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+type GetBitLockerStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetBitLockerStatusRequest) Reset() {
+	*x = GetBitLockerStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBitLockerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBitLockerStatusRequest) ProtoMessage() {}
+
+func (x *GetBitLockerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBitLockerStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetBitLockerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetBitLockerStatusRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetBitLockerStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Encryption status of the volume e.g. FullyDecrypted, EncryptionInProgress
+	// or FullyEncrypted.
+	VolumeStatus string `protobuf:"bytes,1,opt,name=volume_status,json=volumeStatus,proto3" json:"volume_status,omitempty"`
+	// Protection state of the volume i.e. On, Off (the key protectors are
+	// suspended or there aren't any) or Unknown (the volume is locked).
+	ProtectionStatus string `protobuf:"bytes,2,opt,name=protection_status,json=protectionStatus,proto3" json:"protection_status,omitempty"`
+	// Lock state of the volume i.e. Locked or Unlocked.
+	LockStatus string `protobuf:"bytes,3,opt,name=lock_status,json=lockStatus,proto3" json:"lock_status,omitempty"`
+	// Percentage of the volume that's encrypted.
+	EncryptionPercentage uint32 `protobuf:"varint,4,opt,name=encryption_percentage,json=encryptionPercentage,proto3" json:"encryption_percentage,omitempty"`
+	// Encryption method of the volume e.g. XtsAes128, None if it isn't
+	// encrypted.
+	EncryptionMethod string `protobuf:"bytes,5,opt,name=encryption_method,json=encryptionMethod,proto3" json:"encryption_method,omitempty"`
+	// Types of the key protectors of the volume e.g. Password.
+	KeyProtectorTypes []string `protobuf:"bytes,6,rep,name=key_protector_types,json=keyProtectorTypes,proto3" json:"key_protector_types,omitempty"`
+}
+
+func (x *GetBitLockerStatusResponse) Reset() {
+	*x = GetBitLockerStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBitLockerStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBitLockerStatusResponse) ProtoMessage() {}
+
+func (x *GetBitLockerStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBitLockerStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetBitLockerStatusResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetBitLockerStatusResponse) GetVolumeStatus() string {
+	if x != nil {
+		return x.VolumeStatus
+	}
+	return ""
+}
+
+func (x *GetBitLockerStatusResponse) GetProtectionStatus() string {
+	if x != nil {
+		return x.ProtectionStatus
+	}
+	return ""
+}
+
+func (x *GetBitLockerStatusResponse) GetLockStatus() string {
+	if x != nil {
+		return x.LockStatus
+	}
+	return ""
+}
+
+func (x *GetBitLockerStatusResponse) GetEncryptionPercentage() uint32 {
+	if x != nil {
+		return x.EncryptionPercentage
+	}
+	return 0
+}
+
+func (x *GetBitLockerStatusResponse) GetEncryptionMethod() string {
+	if x != nil {
+		return x.EncryptionMethod
+	}
+	return ""
+}
+
+func (x *GetBitLockerStatusResponse) GetKeyProtectorTypes() []string {
+	if x != nil {
+		return x.KeyProtectorTypes
+	}
+	return nil
+}
+
+var File_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto protoreflect.FileDescriptor
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDesc = []byte{
+	0x0a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x62, 0x69, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x56, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4b, 0x65, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22,
+	0xe3, 0x01, 0x0a, 0x16, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x69, 0x74, 0x4c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x6b, 0x65, 0x79, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x10, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x26, 0x0a,
+	0x0f, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x64, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x70, 0x0a, 0x17, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0x69, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x28, 0x0a, 0x10, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6b, 0x65, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x6f, 0x0a, 0x13, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x0d, 0x6b,
+	0x65, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4b, 0x65,
+	0x79, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x6b, 0x65, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x38, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xa1, 0x02, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x42, 0x69, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x15,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2e,
+	0x0a, 0x13, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x6b, 0x65, 0x79,
+	0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2a, 0x37,
+	0x0a, 0x10, 0x4b, 0x65, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x53,
+	0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x2a, 0x4e, 0x0a, 0x10, 0x45, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0f, 0x0a, 0x0b, 0x58,
+	0x54, 0x53, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x58, 0x54, 0x53, 0x5f, 0x41, 0x45, 0x53, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x41, 0x45, 0x53, 0x5f, 0x31, 0x32, 0x38, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x45,
+	0x53, 0x5f, 0x32, 0x35, 0x36, 0x10, 0x03, 0x32, 0x99, 0x02, 0x0a, 0x09, 0x42, 0x69, 0x74, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x58, 0x0a, 0x0f, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42,
+	0x69, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x69, 0x74, 0x4c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x69, 0x74, 0x4c,
+	0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x0c, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
+	0x1d, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
+	0x6b, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x61, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x69, 0x74, 0x4c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69,
+	0x2f, 0x63, 0x73, 0x69, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x69, 0x74, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2f,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescOnce sync.Once
+	file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDesc
+)
+
+func file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescGZIP() []byte {
+	file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescOnce.Do(func() {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescData)
+	})
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDescData
+}
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_goTypes = []interface{}{
+	(KeyProtectorType)(0),              // 0: v1alpha1.KeyProtectorType
+	(EncryptionMethod)(0),              // 1: v1alpha1.EncryptionMethod
+	(*KeyProtector)(nil),               // 2: v1alpha1.KeyProtector
+	(*EnableBitLockerRequest)(nil),     // 3: v1alpha1.EnableBitLockerRequest
+	(*EnableBitLockerResponse)(nil),    // 4: v1alpha1.EnableBitLockerResponse
+	(*UnlockVolumeRequest)(nil),        // 5: v1alpha1.UnlockVolumeRequest
+	(*UnlockVolumeResponse)(nil),       // 6: v1alpha1.UnlockVolumeResponse
+	(*GetBitLockerStatusRequest)(nil),  // 7: v1alpha1.GetBitLockerStatusRequest
+	(*GetBitLockerStatusResponse)(nil), // 8: v1alpha1.GetBitLockerStatusResponse
+}
+var file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_depIdxs = []int32{
+	0, // 0: v1alpha1.KeyProtector.type:type_name -> v1alpha1.KeyProtectorType
+	2, // 1: v1alpha1.EnableBitLockerRequest.key_protector:type_name -> v1alpha1.KeyProtector
+	1, // 2: v1alpha1.EnableBitLockerRequest.encryption_method:type_name -> v1alpha1.EncryptionMethod
+	2, // 3: v1alpha1.UnlockVolumeRequest.key_protector:type_name -> v1alpha1.KeyProtector
+	3, // 4: v1alpha1.Bitlocker.EnableBitLocker:input_type -> v1alpha1.EnableBitLockerRequest
+	5, // 5: v1alpha1.Bitlocker.UnlockVolume:input_type -> v1alpha1.UnlockVolumeRequest
+	7, // 6: v1alpha1.Bitlocker.GetBitLockerStatus:input_type -> v1alpha1.GetBitLockerStatusRequest
+	4, // 7: v1alpha1.Bitlocker.EnableBitLocker:output_type -> v1alpha1.EnableBitLockerResponse
+	6, // 8: v1alpha1.Bitlocker.UnlockVolume:output_type -> v1alpha1.UnlockVolumeResponse
+	8, // 9: v1alpha1.Bitlocker.GetBitLockerStatus:output_type -> v1alpha1.GetBitLockerStatusResponse
+	7, // [7:10] is the sub-list for method output_type
+	4, // [4:7] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_init() }
+func file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_init() {
+	if File_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyProtector); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableBitLockerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableBitLockerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBitLockerStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBitLockerStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_goTypes,
+		DependencyIndexes: file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_depIdxs,
+		EnumInfos:         file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_enumTypes,
+		MessageInfos:      file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_msgTypes,
+	}.Build()
+	File_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto = out.File
+	file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_rawDesc = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_goTypes = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_bitlocker_v1alpha1_api_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BitlockerClient is the client API for Bitlocker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BitlockerClient interface {
+	// EnableBitLocker starts the encryption of a volume with BitLocker and adds
+	// a key protector to unlock it. The encryption runs in the background, its
+	// progress is tracked with GetBitLockerStatus.
+	EnableBitLocker(ctx context.Context, in *EnableBitLockerRequest, opts ...grpc.CallOption) (*EnableBitLockerResponse, error)
+	// UnlockVolume unlocks a volume encrypted with BitLocker with one of its key
+	// protectors.
+	UnlockVolume(ctx context.Context, in *UnlockVolumeRequest, opts ...grpc.CallOption) (*UnlockVolumeResponse, error)
+	// GetBitLockerStatus gets the encryption status and the protection state of
+	// a volume.
+	GetBitLockerStatus(ctx context.Context, in *GetBitLockerStatusRequest, opts ...grpc.CallOption) (*GetBitLockerStatusResponse, error)
+}
+
+type bitlockerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBitlockerClient(cc grpc.ClientConnInterface) BitlockerClient {
+	return &bitlockerClient{cc}
+}
+
+func (c *bitlockerClient) EnableBitLocker(ctx context.Context, in *EnableBitLockerRequest, opts ...grpc.CallOption) (*EnableBitLockerResponse, error) {
+	out := new(EnableBitLockerResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Bitlocker/EnableBitLocker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bitlockerClient) UnlockVolume(ctx context.Context, in *UnlockVolumeRequest, opts ...grpc.CallOption) (*UnlockVolumeResponse, error) {
+	out := new(UnlockVolumeResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Bitlocker/UnlockVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bitlockerClient) GetBitLockerStatus(ctx context.Context, in *GetBitLockerStatusRequest, opts ...grpc.CallOption) (*GetBitLockerStatusResponse, error) {
+	out := new(GetBitLockerStatusResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Bitlocker/GetBitLockerStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BitlockerServer is the server API for Bitlocker service.
+type BitlockerServer interface {
+	// EnableBitLocker starts the encryption of a volume with BitLocker and adds
+	// a key protector to unlock it. The encryption runs in the background, its
+	// progress is tracked with GetBitLockerStatus.
+	EnableBitLocker(context.Context, *EnableBitLockerRequest) (*EnableBitLockerResponse, error)
+	// UnlockVolume unlocks a volume encrypted with BitLocker with one of its key
+	// protectors.
+	UnlockVolume(context.Context, *UnlockVolumeRequest) (*UnlockVolumeResponse, error)
+	// GetBitLockerStatus gets the encryption status and the protection state of
+	// a volume.
+	GetBitLockerStatus(context.Context, *GetBitLockerStatusRequest) (*GetBitLockerStatusResponse, error)
+}
+
+// UnimplementedBitlockerServer can be embedded to have forward compatible implementations.
+type UnimplementedBitlockerServer struct {
+}
+
+func (*UnimplementedBitlockerServer) EnableBitLocker(context.Context, *EnableBitLockerRequest) (*EnableBitLockerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableBitLocker not implemented")
+}
+func (*UnimplementedBitlockerServer) UnlockVolume(context.Context, *UnlockVolumeRequest) (*UnlockVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockVolume not implemented")
+}
+func (*UnimplementedBitlockerServer) GetBitLockerStatus(context.Context, *GetBitLockerStatusRequest) (*GetBitLockerStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBitLockerStatus not implemented")
+}
+
+func RegisterBitlockerServer(s *grpc.Server, srv BitlockerServer) {
+	s.RegisterService(&_Bitlocker_serviceDesc, srv)
+}
+
+func _Bitlocker_EnableBitLocker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableBitLockerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitlockerServer).EnableBitLocker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Bitlocker/EnableBitLocker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitlockerServer).EnableBitLocker(ctx, req.(*EnableBitLockerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bitlocker_UnlockVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitlockerServer).UnlockVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Bitlocker/UnlockVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitlockerServer).UnlockVolume(ctx, req.(*UnlockVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bitlocker_GetBitLockerStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBitLockerStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitlockerServer).GetBitLockerStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Bitlocker/GetBitLockerStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitlockerServer).GetBitLockerStatus(ctx, req.(*GetBitLockerStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Bitlocker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1alpha1.Bitlocker",
+	HandlerType: (*BitlockerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EnableBitLocker",
+			Handler:    _Bitlocker_EnableBitLocker_Handler,
+		},
+		{
+			MethodName: "UnlockVolume",
+			Handler:    _Bitlocker_UnlockVolume_Handler,
+		},
+		{
+			MethodName: "GetBitLockerStatus",
+			Handler:    _Bitlocker_GetBitLockerStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/kubernetes-csi/csi-proxy/client/api/bitlocker/v1alpha1/api.proto",
+}
@@ -0,0 +1,6231 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.12.4
+// source: github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1/api.proto
+
+package v2alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PartitionStyle is the partition table of a disk.
+type PartitionStyle int32
+
+const (
+	// GUID Partition Table.
+	PartitionStyle_GPT PartitionStyle = 0
+	// Master Boot Record, for disks of at most 2 TiB.
+	PartitionStyle_MBR PartitionStyle = 1
+)
+
+// Enum value maps for PartitionStyle.
+var (
+	PartitionStyle_name = map[int32]string{
+		0: "GPT",
+		1: "MBR",
+	}
+	PartitionStyle_value = map[string]int32{
+		"GPT": 0,
+		"MBR": 1,
+	}
+)
+
+func (x PartitionStyle) Enum() *PartitionStyle {
+	p := new(PartitionStyle)
+	*p = x
+	return p
+}
+
+func (x PartitionStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartitionStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (PartitionStyle) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[0]
+}
+
+func (x PartitionStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartitionStyle.Descriptor instead.
+func (PartitionStyle) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+// SanPolicy is the SAN policy of a node i.e. the state of the disks attached
+// to it, the names are the values of Set-StorageSetting -NewDiskPolicy.
+type SanPolicy int32
+
+const (
+	// All the disks are brought online and writable.
+	SanPolicy_ONLINE_ALL SanPolicy = 0
+	// The disks on a shared bus (e.g. iSCSI, Fibre Channel or SAS) are left
+	// offline and read-only, the default of Windows Server.
+	SanPolicy_OFFLINE_SHARED SanPolicy = 1
+	// All the disks but the boot disk are left offline and read-only.
+	SanPolicy_OFFLINE_ALL SanPolicy = 2
+	// The internal disks are left offline and read-only.
+	SanPolicy_OFFLINE_INTERNAL SanPolicy = 3
+)
+
+// Enum value maps for SanPolicy.
+var (
+	SanPolicy_name = map[int32]string{
+		0: "ONLINE_ALL",
+		1: "OFFLINE_SHARED",
+		2: "OFFLINE_ALL",
+		3: "OFFLINE_INTERNAL",
+	}
+	SanPolicy_value = map[string]int32{
+		"ONLINE_ALL":       0,
+		"OFFLINE_SHARED":   1,
+		"OFFLINE_ALL":      2,
+		"OFFLINE_INTERNAL": 3,
+	}
+)
+
+func (x SanPolicy) Enum() *SanPolicy {
+	p := new(SanPolicy)
+	*p = x
+	return p
+}
+
+func (x SanPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SanPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (SanPolicy) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes[1]
+}
+
+func (x SanPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SanPolicy.Descriptor instead.
+func (SanPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+// DiskFilter restricts the disks returned by the disk listing RPCs, all the
+// conditions set must match for a disk to be returned.
+type DiskFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Bus type the disk is attached through e.g. "SAS", "iSCSI", "NVMe" (case insensitive).
+	// Empty matches any bus type.
+	BusType string `protobuf:"bytes,1,opt,name=bus_type,json=busType,proto3" json:"bus_type,omitempty"`
+	// Minimum size of the disk in bytes, 0 means no lower bound.
+	MinSizeBytes uint64 `protobuf:"varint,2,opt,name=min_size_bytes,json=minSizeBytes,proto3" json:"min_size_bytes,omitempty"`
+	// Maximum size of the disk in bytes, 0 means no upper bound.
+	MaxSizeBytes uint64 `protobuf:"varint,3,opt,name=max_size_bytes,json=maxSizeBytes,proto3" json:"max_size_bytes,omitempty"`
+	// Only return disks that haven't been initialized (raw partition style).
+	UninitializedOnly bool `protobuf:"varint,4,opt,name=uninitialized_only,json=uninitializedOnly,proto3" json:"uninitialized_only,omitempty"`
+}
+
+func (x *DiskFilter) Reset() {
+	*x = DiskFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskFilter) ProtoMessage() {}
+
+func (x *DiskFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskFilter.ProtoReflect.Descriptor instead.
+func (*DiskFilter) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DiskFilter) GetBusType() string {
+	if x != nil {
+		return x.BusType
+	}
+	return ""
+}
+
+func (x *DiskFilter) GetMinSizeBytes() uint64 {
+	if x != nil {
+		return x.MinSizeBytes
+	}
+	return 0
+}
+
+func (x *DiskFilter) GetMaxSizeBytes() uint64 {
+	if x != nil {
+		return x.MaxSizeBytes
+	}
+	return 0
+}
+
+func (x *DiskFilter) GetUninitializedOnly() bool {
+	if x != nil {
+		return x.UninitializedOnly
+	}
+	return false
+}
+
+type ListDiskLocationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional filter of the disks to list.
+	Filter *DiskFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Maximum number of disks to return in the response, 0 means no limit.
+	MaxResults uint32 `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	// Opaque token from ListDiskLocationsResponse.next_page_token used to
+	// continue a previous listing.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListDiskLocationsRequest) Reset() {
+	*x = ListDiskLocationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiskLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiskLocationsRequest) ProtoMessage() {}
+
+func (x *ListDiskLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiskLocationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDiskLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDiskLocationsRequest) GetFilter() *DiskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListDiskLocationsRequest) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *ListDiskLocationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type DiskLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Adapter string `protobuf:"bytes,1,opt,name=Adapter,proto3" json:"Adapter,omitempty"`
+	Bus     string `protobuf:"bytes,2,opt,name=Bus,proto3" json:"Bus,omitempty"`
+	Target  string `protobuf:"bytes,3,opt,name=Target,proto3" json:"Target,omitempty"`
+	LUNID   string `protobuf:"bytes,4,opt,name=LUNID,proto3" json:"LUNID,omitempty"`
+	// Serial number of the disk, it's empty for some virtual disks.
+	SerialNumber string `protobuf:"bytes,5,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+}
+
+func (x *DiskLocation) Reset() {
+	*x = DiskLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskLocation) ProtoMessage() {}
+
+func (x *DiskLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskLocation.ProtoReflect.Descriptor instead.
+func (*DiskLocation) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DiskLocation) GetAdapter() string {
+	if x != nil {
+		return x.Adapter
+	}
+	return ""
+}
+
+func (x *DiskLocation) GetBus() string {
+	if x != nil {
+		return x.Bus
+	}
+	return ""
+}
+
+func (x *DiskLocation) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *DiskLocation) GetLUNID() string {
+	if x != nil {
+		return x.LUNID
+	}
+	return ""
+}
+
+func (x *DiskLocation) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type GetFreeDiskLocationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of LUNs of each target, LUNs from 0 to max_luns - 1 are returned.
+	// 64 is used if it's 0, the number of LUNs of Hyper-V SCSI controllers.
+	MaxLuns uint32 `protobuf:"varint,1,opt,name=max_luns,json=maxLuns,proto3" json:"max_luns,omitempty"`
+}
+
+func (x *GetFreeDiskLocationsRequest) Reset() {
+	*x = GetFreeDiskLocationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFreeDiskLocationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFreeDiskLocationsRequest) ProtoMessage() {}
+
+func (x *GetFreeDiskLocationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFreeDiskLocationsRequest.ProtoReflect.Descriptor instead.
+func (*GetFreeDiskLocationsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetFreeDiskLocationsRequest) GetMaxLuns() uint32 {
+	if x != nil {
+		return x.MaxLuns
+	}
+	return 0
+}
+
+type FreeDiskLocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Adapter string `protobuf:"bytes,1,opt,name=adapter,proto3" json:"adapter,omitempty"`
+	Target  string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// LUNs of the target that aren't used by any disk, in ascending order.
+	FreeLunIds []uint32 `protobuf:"varint,3,rep,packed,name=free_lun_ids,json=freeLunIds,proto3" json:"free_lun_ids,omitempty"`
+}
+
+func (x *FreeDiskLocation) Reset() {
+	*x = FreeDiskLocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FreeDiskLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreeDiskLocation) ProtoMessage() {}
+
+func (x *FreeDiskLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreeDiskLocation.ProtoReflect.Descriptor instead.
+func (*FreeDiskLocation) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FreeDiskLocation) GetAdapter() string {
+	if x != nil {
+		return x.Adapter
+	}
+	return ""
+}
+
+func (x *FreeDiskLocation) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *FreeDiskLocation) GetFreeLunIds() []uint32 {
+	if x != nil {
+		return x.FreeLunIds
+	}
+	return nil
+}
+
+type GetFreeDiskLocationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Free LUNs of every adapter and target with disks attached, sorted by
+	// adapter and target.
+	Locations []*FreeDiskLocation `protobuf:"bytes,1,rep,name=locations,proto3" json:"locations,omitempty"`
+}
+
+func (x *GetFreeDiskLocationsResponse) Reset() {
+	*x = GetFreeDiskLocationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFreeDiskLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFreeDiskLocationsResponse) ProtoMessage() {}
+
+func (x *GetFreeDiskLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFreeDiskLocationsResponse.ProtoReflect.Descriptor instead.
+func (*GetFreeDiskLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetFreeDiskLocationsResponse) GetLocations() []*FreeDiskLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+type ListDiskLocationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Map of disk number and <adapter, bus, target, lun ID> associated with each disk device.
+	DiskLocations map[uint32]*DiskLocation `protobuf:"bytes,1,rep,name=disk_locations,json=diskLocations,proto3" json:"disk_locations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Token to get the next page of disks, empty if there are no more disks.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListDiskLocationsResponse) Reset() {
+	*x = ListDiskLocationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiskLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiskLocationsResponse) ProtoMessage() {}
+
+func (x *ListDiskLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiskLocationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDiskLocationsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListDiskLocationsResponse) GetDiskLocations() map[uint32]*DiskLocation {
+	if x != nil {
+		return x.DiskLocations
+	}
+	return nil
+}
+
+func (x *ListDiskLocationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetDiskNumberByLocationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Location of the disk as returned by ListDiskLocations, lun_id is
+	// required and the other fields that are empty match any value. The
+	// location must match a single disk.
+	Adapter string `protobuf:"bytes,1,opt,name=adapter,proto3" json:"adapter,omitempty"`
+	Bus     string `protobuf:"bytes,2,opt,name=bus,proto3" json:"bus,omitempty"`
+	Target  string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	LunId   string `protobuf:"bytes,4,opt,name=lun_id,json=lunId,proto3" json:"lun_id,omitempty"`
+}
+
+func (x *GetDiskNumberByLocationRequest) Reset() {
+	*x = GetDiskNumberByLocationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskNumberByLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskNumberByLocationRequest) ProtoMessage() {}
+
+func (x *GetDiskNumberByLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskNumberByLocationRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskNumberByLocationRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDiskNumberByLocationRequest) GetAdapter() string {
+	if x != nil {
+		return x.Adapter
+	}
+	return ""
+}
+
+func (x *GetDiskNumberByLocationRequest) GetBus() string {
+	if x != nil {
+		return x.Bus
+	}
+	return ""
+}
+
+func (x *GetDiskNumberByLocationRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *GetDiskNumberByLocationRequest) GetLunId() string {
+	if x != nil {
+		return x.LunId
+	}
+	return ""
+}
+
+type GetDiskNumberByLocationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskNumberByLocationResponse) Reset() {
+	*x = GetDiskNumberByLocationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskNumberByLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskNumberByLocationResponse) ProtoMessage() {}
+
+func (x *GetDiskNumberByLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskNumberByLocationResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskNumberByLocationResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetDiskNumberByLocationResponse) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type PartitionDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk to partition.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Partition style the disk is initialized with, a disk that's already
+	// initialized keeps its partition style.
+	PartitionStyle PartitionStyle `protobuf:"varint,2,opt,name=partition_style,json=partitionStyle,proto3,enum=v2alpha1.PartitionStyle" json:"partition_style,omitempty"`
+	// Size in bytes of the partition, the partition takes the largest free
+	// space of the disk (after its offset if it's set) if 0.
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Offset in bytes of the partition from the start of the disk, Windows
+	// picks it if 0. Sizes and offsets above 2^63-1 bytes are rejected.
+	OffsetBytes uint64 `protobuf:"varint,4,opt,name=offset_bytes,json=offsetBytes,proto3" json:"offset_bytes,omitempty"`
+	// Bring the disk online and make it writable before it's initialized if
+	// the SAN policy of the node left it offline or read-only, it can't be set
+	// for shared (clustered) disks, see SetDiskState.
+	BringOnline bool `protobuf:"varint,5,opt,name=bring_online,json=bringOnline,proto3" json:"bring_online,omitempty"`
+	// GPT partition type GUID of the partition, e.g.
+	// {ebd0a0a2-b9e5-4433-87c0-68b6b72699c7} for a basic data partition (the
+	// default if empty) or {e3c9e316-0b5c-4db8-817d-f92df00215ae} for a
+	// Microsoft Reserved Partition. It can't be set for MBR disks.
+	GptType string `protobuf:"bytes,6,opt,name=gpt_type,json=gptType,proto3" json:"gpt_type,omitempty"`
+}
+
+func (x *PartitionDiskRequest) Reset() {
+	*x = PartitionDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionDiskRequest) ProtoMessage() {}
+
+func (x *PartitionDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionDiskRequest.ProtoReflect.Descriptor instead.
+func (*PartitionDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PartitionDiskRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *PartitionDiskRequest) GetPartitionStyle() PartitionStyle {
+	if x != nil {
+		return x.PartitionStyle
+	}
+	return PartitionStyle_GPT
+}
+
+func (x *PartitionDiskRequest) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *PartitionDiskRequest) GetOffsetBytes() uint64 {
+	if x != nil {
+		return x.OffsetBytes
+	}
+	return 0
+}
+
+func (x *PartitionDiskRequest) GetBringOnline() bool {
+	if x != nil {
+		return x.BringOnline
+	}
+	return false
+}
+
+func (x *PartitionDiskRequest) GetGptType() string {
+	if x != nil {
+		return x.GptType
+	}
+	return ""
+}
+
+type PartitionDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PartitionDiskResponse) Reset() {
+	*x = PartitionDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PartitionDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartitionDiskResponse) ProtoMessage() {}
+
+func (x *PartitionDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartitionDiskResponse.ProtoReflect.Descriptor instead.
+func (*PartitionDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{10}
+}
+
+type SetPartitionTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the GPT disk of the partition.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Number of the partition.
+	PartitionNumber uint32 `protobuf:"varint,2,opt,name=partition_number,json=partitionNumber,proto3" json:"partition_number,omitempty"`
+	// GPT partition type GUID to set, see PartitionDiskRequest.gpt_type.
+	GptType string `protobuf:"bytes,3,opt,name=gpt_type,json=gptType,proto3" json:"gpt_type,omitempty"`
+}
+
+func (x *SetPartitionTypeRequest) Reset() {
+	*x = SetPartitionTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPartitionTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPartitionTypeRequest) ProtoMessage() {}
+
+func (x *SetPartitionTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPartitionTypeRequest.ProtoReflect.Descriptor instead.
+func (*SetPartitionTypeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetPartitionTypeRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *SetPartitionTypeRequest) GetPartitionNumber() uint32 {
+	if x != nil {
+		return x.PartitionNumber
+	}
+	return 0
+}
+
+func (x *SetPartitionTypeRequest) GetGptType() string {
+	if x != nil {
+		return x.GptType
+	}
+	return ""
+}
+
+type SetPartitionTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetPartitionTypeResponse) Reset() {
+	*x = SetPartitionTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPartitionTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPartitionTypeResponse) ProtoMessage() {}
+
+func (x *SetPartitionTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPartitionTypeResponse.ProtoReflect.Descriptor instead.
+func (*SetPartitionTypeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{12}
+}
+
+type DeletePartitionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk of the partition.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Number of the partition to delete, all the partitions of the disk
+	// (including the Microsoft Reserved Partition) are deleted if 0.
+	PartitionNumber uint32 `protobuf:"varint,2,opt,name=partition_number,json=partitionNumber,proto3" json:"partition_number,omitempty"`
+}
+
+func (x *DeletePartitionRequest) Reset() {
+	*x = DeletePartitionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePartitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartitionRequest) ProtoMessage() {}
+
+func (x *DeletePartitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartitionRequest.ProtoReflect.Descriptor instead.
+func (*DeletePartitionRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeletePartitionRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *DeletePartitionRequest) GetPartitionNumber() uint32 {
+	if x != nil {
+		return x.PartitionNumber
+	}
+	return 0
+}
+
+type DeletePartitionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePartitionResponse) Reset() {
+	*x = DeletePartitionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePartitionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartitionResponse) ProtoMessage() {}
+
+func (x *DeletePartitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartitionResponse.ProtoReflect.Descriptor instead.
+func (*DeletePartitionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{14}
+}
+
+type WipeDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk to wipe.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Remove the data and OEM partitions of the disk, the wipe fails if the
+	// disk has any and it's not set.
+	RemoveData bool `protobuf:"varint,2,opt,name=remove_data,json=removeData,proto3" json:"remove_data,omitempty"`
+	// Write zeros to the whole disk after it's cleared, it takes as long as
+	// writing the whole disk so the deadline of the call must allow for it.
+	ZeroFill bool `protobuf:"varint,3,opt,name=zero_fill,json=zeroFill,proto3" json:"zero_fill,omitempty"`
+}
+
+func (x *WipeDiskRequest) Reset() {
+	*x = WipeDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WipeDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WipeDiskRequest) ProtoMessage() {}
+
+func (x *WipeDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WipeDiskRequest.ProtoReflect.Descriptor instead.
+func (*WipeDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WipeDiskRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *WipeDiskRequest) GetRemoveData() bool {
+	if x != nil {
+		return x.RemoveData
+	}
+	return false
+}
+
+func (x *WipeDiskRequest) GetZeroFill() bool {
+	if x != nil {
+		return x.ZeroFill
+	}
+	return false
+}
+
+type WipeDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WipeDiskResponse) Reset() {
+	*x = WipeDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WipeDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WipeDiskResponse) ProtoMessage() {}
+
+func (x *WipeDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WipeDiskResponse.ProtoReflect.Descriptor instead.
+func (*WipeDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{16}
+}
+
+type ConvertDiskToGPTRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk to convert.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *ConvertDiskToGPTRequest) Reset() {
+	*x = ConvertDiskToGPTRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertDiskToGPTRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertDiskToGPTRequest) ProtoMessage() {}
+
+func (x *ConvertDiskToGPTRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertDiskToGPTRequest.ProtoReflect.Descriptor instead.
+func (*ConvertDiskToGPTRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ConvertDiskToGPTRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type ConvertDiskToGPTResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ConvertDiskToGPTResponse) Reset() {
+	*x = ConvertDiskToGPTResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertDiskToGPTResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertDiskToGPTResponse) ProtoMessage() {}
+
+func (x *ConvertDiskToGPTResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertDiskToGPTResponse.ProtoReflect.Descriptor instead.
+func (*ConvertDiskToGPTResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{18}
+}
+
+type RescanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RescanRequest) Reset() {
+	*x = RescanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescanRequest) ProtoMessage() {}
+
+func (x *RescanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescanRequest.ProtoReflect.Descriptor instead.
+func (*RescanRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{19}
+}
+
+type RescanResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RescanResponse) Reset() {
+	*x = RescanResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescanResponse) ProtoMessage() {}
+
+func (x *RescanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescanResponse.ProtoReflect.Descriptor instead.
+func (*RescanResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{20}
+}
+
+type ListDiskIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional filter of the disks to list.
+	Filter *DiskFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Maximum number of disks to return in the response, 0 means no limit.
+	MaxResults uint32 `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	// Opaque token from ListDiskIDsResponse.next_page_token used to continue
+	// a previous listing.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListDiskIDsRequest) Reset() {
+	*x = ListDiskIDsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiskIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiskIDsRequest) ProtoMessage() {}
+
+func (x *ListDiskIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiskIDsRequest.ProtoReflect.Descriptor instead.
+func (*ListDiskIDsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListDiskIDsRequest) GetFilter() *DiskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListDiskIDsRequest) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *ListDiskIDsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type DiskIDs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The disk page83 id.
+	Page83 string `protobuf:"bytes,1,opt,name=page83,proto3" json:"page83,omitempty"`
+	// The disk serial number.
+	SerialNumber string `protobuf:"bytes,2,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+}
+
+func (x *DiskIDs) Reset() {
+	*x = DiskIDs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskIDs) ProtoMessage() {}
+
+func (x *DiskIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskIDs.ProtoReflect.Descriptor instead.
+func (*DiskIDs) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DiskIDs) GetPage83() string {
+	if x != nil {
+		return x.Page83
+	}
+	return ""
+}
+
+func (x *DiskIDs) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type ListDiskIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Map of disk numbers and disk identifiers associated with each disk device.
+	DiskIDs map[uint32]*DiskIDs `protobuf:"bytes,1,rep,name=diskIDs,proto3" json:"diskIDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // the case is intentional for protoc to generate the field as DiskIDs
+	// Token to get the next page of disks, empty if there are no more disks.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListDiskIDsResponse) Reset() {
+	*x = ListDiskIDsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiskIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiskIDsResponse) ProtoMessage() {}
+
+func (x *ListDiskIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiskIDsResponse.ProtoReflect.Descriptor instead.
+func (*ListDiskIDsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListDiskIDsResponse) GetDiskIDs() map[uint32]*DiskIDs {
+	if x != nil {
+		return x.DiskIDs
+	}
+	return nil
+}
+
+func (x *ListDiskIDsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetDiskStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk to get the stats from.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskStatsRequest) Reset() {
+	*x = GetDiskStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskStatsRequest) ProtoMessage() {}
+
+func (x *GetDiskStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskStatsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetDiskStatsRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type GetDiskStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total size of the volume.
+	TotalBytes uint64 `protobuf:"varint,1,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// Serial number of the disk, it's empty for some virtual disks.
+	SerialNumber string `protobuf:"bytes,2,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// Size in bytes of the logical sectors of the disk, the unit of the I/Os
+	// e.g. 512, 0 if the disk doesn't report it.
+	LogicalSectorSize uint32 `protobuf:"varint,3,opt,name=logical_sector_size,json=logicalSectorSize,proto3" json:"logical_sector_size,omitempty"`
+	// Size in bytes of the physical sectors of the disk e.g. 4096, 0 if the
+	// disk doesn't report it. A 512e disk has 512 bytes logical sectors and
+	// 4096 bytes physical sectors, a 4Kn disk has 4096 bytes sectors, the
+	// writes smaller than a physical sector aren't atomic.
+	PhysicalSectorSize uint32 `protobuf:"varint,4,opt,name=physical_sector_size,json=physicalSectorSize,proto3" json:"physical_sector_size,omitempty"`
+}
+
+func (x *GetDiskStatsResponse) Reset() {
+	*x = GetDiskStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskStatsResponse) ProtoMessage() {}
+
+func (x *GetDiskStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskStatsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetDiskStatsResponse) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *GetDiskStatsResponse) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *GetDiskStatsResponse) GetLogicalSectorSize() uint32 {
+	if x != nil {
+		return x.LogicalSectorSize
+	}
+	return 0
+}
+
+func (x *GetDiskStatsResponse) GetPhysicalSectorSize() uint32 {
+	if x != nil {
+		return x.PhysicalSectorSize
+	}
+	return 0
+}
+
+type GetDiskReliabilityCountersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskReliabilityCountersRequest) Reset() {
+	*x = GetDiskReliabilityCountersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskReliabilityCountersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskReliabilityCountersRequest) ProtoMessage() {}
+
+func (x *GetDiskReliabilityCountersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskReliabilityCountersRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskReliabilityCountersRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetDiskReliabilityCountersRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+// GetDiskReliabilityCountersResponse has the counters reported by the disk,
+// each of them is -1 if the disk doesn't report it.
+type GetDiskReliabilityCountersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Current temperature of the disk in degrees Celsius.
+	TemperatureCelsius int64 `protobuf:"varint,1,opt,name=temperature_celsius,json=temperatureCelsius,proto3" json:"temperature_celsius,omitempty"`
+	// Highest temperature the disk can operate at in degrees Celsius.
+	MaxTemperatureCelsius int64 `protobuf:"varint,2,opt,name=max_temperature_celsius,json=maxTemperatureCelsius,proto3" json:"max_temperature_celsius,omitempty"`
+	// Percentage of the rated endurance of an SSD used, it's 100 or more
+	// once the endurance is exceeded.
+	WearPercentage int64 `protobuf:"varint,3,opt,name=wear_percentage,json=wearPercentage,proto3" json:"wear_percentage,omitempty"`
+	// Read errors since the disk was manufactured, corrected by the disk and
+	// uncorrected.
+	ReadErrorsTotal       int64 `protobuf:"varint,4,opt,name=read_errors_total,json=readErrorsTotal,proto3" json:"read_errors_total,omitempty"`
+	ReadErrorsCorrected   int64 `protobuf:"varint,5,opt,name=read_errors_corrected,json=readErrorsCorrected,proto3" json:"read_errors_corrected,omitempty"`
+	ReadErrorsUncorrected int64 `protobuf:"varint,6,opt,name=read_errors_uncorrected,json=readErrorsUncorrected,proto3" json:"read_errors_uncorrected,omitempty"`
+	// Write errors since the disk was manufactured, corrected by the disk and
+	// uncorrected.
+	WriteErrorsTotal       int64 `protobuf:"varint,7,opt,name=write_errors_total,json=writeErrorsTotal,proto3" json:"write_errors_total,omitempty"`
+	WriteErrorsCorrected   int64 `protobuf:"varint,8,opt,name=write_errors_corrected,json=writeErrorsCorrected,proto3" json:"write_errors_corrected,omitempty"`
+	WriteErrorsUncorrected int64 `protobuf:"varint,9,opt,name=write_errors_uncorrected,json=writeErrorsUncorrected,proto3" json:"write_errors_uncorrected,omitempty"`
+	// Hours the disk was powered on.
+	PowerOnHours int64 `protobuf:"varint,10,opt,name=power_on_hours,json=powerOnHours,proto3" json:"power_on_hours,omitempty"`
+	// Highest read, write and flush latencies observed in milliseconds.
+	ReadLatencyMaxMs  int64 `protobuf:"varint,11,opt,name=read_latency_max_ms,json=readLatencyMaxMs,proto3" json:"read_latency_max_ms,omitempty"`
+	WriteLatencyMaxMs int64 `protobuf:"varint,12,opt,name=write_latency_max_ms,json=writeLatencyMaxMs,proto3" json:"write_latency_max_ms,omitempty"`
+	FlushLatencyMaxMs int64 `protobuf:"varint,13,opt,name=flush_latency_max_ms,json=flushLatencyMaxMs,proto3" json:"flush_latency_max_ms,omitempty"`
+}
+
+func (x *GetDiskReliabilityCountersResponse) Reset() {
+	*x = GetDiskReliabilityCountersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskReliabilityCountersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskReliabilityCountersResponse) ProtoMessage() {}
+
+func (x *GetDiskReliabilityCountersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskReliabilityCountersResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskReliabilityCountersResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetTemperatureCelsius() int64 {
+	if x != nil {
+		return x.TemperatureCelsius
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetMaxTemperatureCelsius() int64 {
+	if x != nil {
+		return x.MaxTemperatureCelsius
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetWearPercentage() int64 {
+	if x != nil {
+		return x.WearPercentage
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetReadErrorsTotal() int64 {
+	if x != nil {
+		return x.ReadErrorsTotal
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetReadErrorsCorrected() int64 {
+	if x != nil {
+		return x.ReadErrorsCorrected
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetReadErrorsUncorrected() int64 {
+	if x != nil {
+		return x.ReadErrorsUncorrected
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetWriteErrorsTotal() int64 {
+	if x != nil {
+		return x.WriteErrorsTotal
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetWriteErrorsCorrected() int64 {
+	if x != nil {
+		return x.WriteErrorsCorrected
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetWriteErrorsUncorrected() int64 {
+	if x != nil {
+		return x.WriteErrorsUncorrected
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetPowerOnHours() int64 {
+	if x != nil {
+		return x.PowerOnHours
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetReadLatencyMaxMs() int64 {
+	if x != nil {
+		return x.ReadLatencyMaxMs
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetWriteLatencyMaxMs() int64 {
+	if x != nil {
+		return x.WriteLatencyMaxMs
+	}
+	return 0
+}
+
+func (x *GetDiskReliabilityCountersResponse) GetFlushLatencyMaxMs() int64 {
+	if x != nil {
+		return x.FlushLatencyMaxMs
+	}
+	return 0
+}
+
+type SetDiskStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Online state to set for the disk. true for online, false for offline.
+	IsOnline bool `protobuf:"varint,2,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+	// Opaque token that identifies the owner of a shared (clustered) disk.
+	// It's required to change the state of a shared disk and ignored otherwise.
+	// Before a shared disk is brought online a persistent reservation derived
+	// from this token is placed on it, if the disk is already reserved with a
+	// different token (e.g. it's online in a different node) the request fails.
+	// The reservation is released when the disk is taken offline.
+	ClaimToken string `protobuf:"bytes,3,opt,name=claim_token,json=claimToken,proto3" json:"claim_token,omitempty"`
+}
+
+func (x *SetDiskStateRequest) Reset() {
+	*x = SetDiskStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiskStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiskStateRequest) ProtoMessage() {}
+
+func (x *SetDiskStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiskStateRequest.ProtoReflect.Descriptor instead.
+func (*SetDiskStateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetDiskStateRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *SetDiskStateRequest) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+func (x *SetDiskStateRequest) GetClaimToken() string {
+	if x != nil {
+		return x.ClaimToken
+	}
+	return ""
+}
+
+type SetDiskStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetDiskStateResponse) Reset() {
+	*x = SetDiskStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiskStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiskStateResponse) ProtoMessage() {}
+
+func (x *SetDiskStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiskStateResponse.ProtoReflect.Descriptor instead.
+func (*SetDiskStateResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{29}
+}
+
+type GetDiskStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskStateRequest) Reset() {
+	*x = GetDiskStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskStateRequest) ProtoMessage() {}
+
+func (x *GetDiskStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskStateRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskStateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetDiskStateRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type GetDiskStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Online state of the disk. true for online, false for offline.
+	IsOnline bool `protobuf:"varint,1,opt,name=is_online,json=isOnline,proto3" json:"is_online,omitempty"`
+}
+
+func (x *GetDiskStateResponse) Reset() {
+	*x = GetDiskStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskStateResponse) ProtoMessage() {}
+
+func (x *GetDiskStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskStateResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskStateResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetDiskStateResponse) GetIsOnline() bool {
+	if x != nil {
+		return x.IsOnline
+	}
+	return false
+}
+
+type WaitForDiskSizeChangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the expanded disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Size in bytes of the expanded disk, the call returns once the size of
+	// the disk is at least this size.
+	ExpectedSizeBytes uint64 `protobuf:"varint,2,opt,name=expected_size_bytes,json=expectedSizeBytes,proto3" json:"expected_size_bytes,omitempty"`
+	// How long to wait for the new size in nanoseconds, 2 minutes if it's 0.
+	TimeoutNanos int64 `protobuf:"varint,3,opt,name=timeout_nanos,json=timeoutNanos,proto3" json:"timeout_nanos,omitempty"`
+}
+
+func (x *WaitForDiskSizeChangeRequest) Reset() {
+	*x = WaitForDiskSizeChangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForDiskSizeChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForDiskSizeChangeRequest) ProtoMessage() {}
+
+func (x *WaitForDiskSizeChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForDiskSizeChangeRequest.ProtoReflect.Descriptor instead.
+func (*WaitForDiskSizeChangeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *WaitForDiskSizeChangeRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *WaitForDiskSizeChangeRequest) GetExpectedSizeBytes() uint64 {
+	if x != nil {
+		return x.ExpectedSizeBytes
+	}
+	return 0
+}
+
+func (x *WaitForDiskSizeChangeRequest) GetTimeoutNanos() int64 {
+	if x != nil {
+		return x.TimeoutNanos
+	}
+	return 0
+}
+
+type WaitForDiskSizeChangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Size of the disk in bytes observed by Windows.
+	TotalBytes uint64 `protobuf:"varint,1,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+}
+
+func (x *WaitForDiskSizeChangeResponse) Reset() {
+	*x = WaitForDiskSizeChangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForDiskSizeChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForDiskSizeChangeResponse) ProtoMessage() {}
+
+func (x *WaitForDiskSizeChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForDiskSizeChangeResponse.ProtoReflect.Descriptor instead.
+func (*WaitForDiskSizeChangeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WaitForDiskSizeChangeResponse) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+type ListDisksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional filter of the disks to list.
+	Filter *DiskFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Maximum number of disks to return in the response, 0 means no limit.
+	MaxResults uint32 `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	// Opaque token from ListDisksResponse.next_page_token used to continue a
+	// previous listing.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListDisksRequest) Reset() {
+	*x = ListDisksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDisksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisksRequest) ProtoMessage() {}
+
+func (x *ListDisksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisksRequest.ProtoReflect.Descriptor instead.
+func (*ListDisksRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListDisksRequest) GetFilter() *DiskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListDisksRequest) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *ListDisksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type DiskInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Friendly name of the disk e.g. "Msft Virtual Disk".
+	FriendlyName string `protobuf:"bytes,2,opt,name=friendly_name,json=friendlyName,proto3" json:"friendly_name,omitempty"`
+	// Serial number of the disk, it's empty for some virtual disks.
+	SerialNumber string `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// Size of the disk in bytes.
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Bus type the disk is attached through e.g. "SAS", "iSCSI", "NVMe".
+	BusType string `protobuf:"bytes,5,opt,name=bus_type,json=busType,proto3" json:"bus_type,omitempty"`
+	// Partition style of the disk i.e. "RAW" (not initialized), "MBR" or "GPT".
+	PartitionStyle string `protobuf:"bytes,6,opt,name=partition_style,json=partitionStyle,proto3" json:"partition_style,omitempty"`
+	// The disk is offline.
+	IsOffline bool `protobuf:"varint,7,opt,name=is_offline,json=isOffline,proto3" json:"is_offline,omitempty"`
+	// The disk is read-only.
+	IsReadOnly bool `protobuf:"varint,8,opt,name=is_read_only,json=isReadOnly,proto3" json:"is_read_only,omitempty"`
+	// The disk has the boot or the system partition.
+	IsOsDisk bool `protobuf:"varint,9,opt,name=is_os_disk,json=isOsDisk,proto3" json:"is_os_disk,omitempty"`
+}
+
+func (x *DiskInfo) Reset() {
+	*x = DiskInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskInfo) ProtoMessage() {}
+
+func (x *DiskInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskInfo.ProtoReflect.Descriptor instead.
+func (*DiskInfo) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DiskInfo) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *DiskInfo) GetFriendlyName() string {
+	if x != nil {
+		return x.FriendlyName
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *DiskInfo) GetBusType() string {
+	if x != nil {
+		return x.BusType
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetPartitionStyle() string {
+	if x != nil {
+		return x.PartitionStyle
+	}
+	return ""
+}
+
+func (x *DiskInfo) GetIsOffline() bool {
+	if x != nil {
+		return x.IsOffline
+	}
+	return false
+}
+
+func (x *DiskInfo) GetIsReadOnly() bool {
+	if x != nil {
+		return x.IsReadOnly
+	}
+	return false
+}
+
+func (x *DiskInfo) GetIsOsDisk() bool {
+	if x != nil {
+		return x.IsOsDisk
+	}
+	return false
+}
+
+type ListDisksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disks sorted by disk number.
+	Disks []*DiskInfo `protobuf:"bytes,1,rep,name=disks,proto3" json:"disks,omitempty"`
+	// Token to get the next page of disks, empty if there are no more disks.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListDisksResponse) Reset() {
+	*x = ListDisksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDisksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDisksResponse) ProtoMessage() {}
+
+func (x *ListDisksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDisksResponse.ProtoReflect.Descriptor instead.
+func (*ListDisksResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListDisksResponse) GetDisks() []*DiskInfo {
+	if x != nil {
+		return x.Disks
+	}
+	return nil
+}
+
+func (x *ListDisksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetSanPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSanPolicyRequest) Reset() {
+	*x = GetSanPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSanPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSanPolicyRequest) ProtoMessage() {}
+
+func (x *GetSanPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSanPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetSanPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{37}
+}
+
+type GetSanPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SAN policy of the node.
+	SanPolicy SanPolicy `protobuf:"varint,1,opt,name=san_policy,json=sanPolicy,proto3,enum=v2alpha1.SanPolicy" json:"san_policy,omitempty"`
+}
+
+func (x *GetSanPolicyResponse) Reset() {
+	*x = GetSanPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSanPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSanPolicyResponse) ProtoMessage() {}
+
+func (x *GetSanPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSanPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetSanPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetSanPolicyResponse) GetSanPolicy() SanPolicy {
+	if x != nil {
+		return x.SanPolicy
+	}
+	return SanPolicy_ONLINE_ALL
+}
+
+type SetSanPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SAN policy to set.
+	SanPolicy SanPolicy `protobuf:"varint,1,opt,name=san_policy,json=sanPolicy,proto3,enum=v2alpha1.SanPolicy" json:"san_policy,omitempty"`
+}
+
+func (x *SetSanPolicyRequest) Reset() {
+	*x = SetSanPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSanPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSanPolicyRequest) ProtoMessage() {}
+
+func (x *SetSanPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSanPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetSanPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetSanPolicyRequest) GetSanPolicy() SanPolicy {
+	if x != nil {
+		return x.SanPolicy
+	}
+	return SanPolicy_ONLINE_ALL
+}
+
+type SetSanPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetSanPolicyResponse) Reset() {
+	*x = SetSanPolicyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSanPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSanPolicyResponse) ProtoMessage() {}
+
+func (x *SetSanPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSanPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetSanPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{40}
+}
+
+type SetDiskReadOnlyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Make the disk read-only if true, writable if false.
+	ReadOnly bool `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+}
+
+func (x *SetDiskReadOnlyRequest) Reset() {
+	*x = SetDiskReadOnlyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiskReadOnlyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiskReadOnlyRequest) ProtoMessage() {}
+
+func (x *SetDiskReadOnlyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiskReadOnlyRequest.ProtoReflect.Descriptor instead.
+func (*SetDiskReadOnlyRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetDiskReadOnlyRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *SetDiskReadOnlyRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type SetDiskReadOnlyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetDiskReadOnlyResponse) Reset() {
+	*x = SetDiskReadOnlyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDiskReadOnlyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDiskReadOnlyResponse) ProtoMessage() {}
+
+func (x *SetDiskReadOnlyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDiskReadOnlyResponse.ProtoReflect.Descriptor instead.
+func (*SetDiskReadOnlyResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{42}
+}
+
+type GetDiskReadOnlyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskReadOnlyRequest) Reset() {
+	*x = GetDiskReadOnlyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskReadOnlyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskReadOnlyRequest) ProtoMessage() {}
+
+func (x *GetDiskReadOnlyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskReadOnlyRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskReadOnlyRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDiskReadOnlyRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type GetDiskReadOnlyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The disk is read-only.
+	ReadOnly bool `protobuf:"varint,1,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+}
+
+func (x *GetDiskReadOnlyResponse) Reset() {
+	*x = GetDiskReadOnlyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskReadOnlyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskReadOnlyResponse) ProtoMessage() {}
+
+func (x *GetDiskReadOnlyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskReadOnlyResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskReadOnlyResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetDiskReadOnlyResponse) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type GetDiskNumberBySerialNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Serial number of the disk, the leading and trailing spaces and the case
+	// are ignored.
+	SerialNumber string `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+}
+
+func (x *GetDiskNumberBySerialNumberRequest) Reset() {
+	*x = GetDiskNumberBySerialNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskNumberBySerialNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskNumberBySerialNumberRequest) ProtoMessage() {}
+
+func (x *GetDiskNumberBySerialNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskNumberBySerialNumberRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskNumberBySerialNumberRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetDiskNumberBySerialNumberRequest) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type GetDiskNumberBySerialNumberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskNumberBySerialNumberResponse) Reset() {
+	*x = GetDiskNumberBySerialNumberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskNumberBySerialNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskNumberBySerialNumberResponse) ProtoMessage() {}
+
+func (x *GetDiskNumberBySerialNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskNumberBySerialNumberResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskNumberBySerialNumberResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetDiskNumberBySerialNumberResponse) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type ListDiskPage83IDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optional filter of the disks to list.
+	Filter *DiskFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Maximum number of disks to return in the response, 0 means no limit.
+	MaxResults uint32 `protobuf:"varint,2,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	// Opaque token from ListDiskPage83IDsResponse.next_page_token used to
+	// continue a previous listing.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListDiskPage83IDsRequest) Reset() {
+	*x = ListDiskPage83IDsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiskPage83IDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiskPage83IDsRequest) ProtoMessage() {}
+
+func (x *ListDiskPage83IDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiskPage83IDsRequest.ProtoReflect.Descriptor instead.
+func (*ListDiskPage83IDsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListDiskPage83IDsRequest) GetFilter() *DiskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListDiskPage83IDsRequest) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *ListDiskPage83IDsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Page83ID is an identifier of the device identification VPD page of a disk.
+type Page83ID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the identifier: NAA, EUI64, T10, SCSIName, VendorSpecific,
+	// RelativeTargetPort, TargetPortGroup, LogicalUnitGroup or MD5LogicalUnit.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Entity identified: Device (the logical unit), Port or Target.
+	Association string `protobuf:"bytes,2,opt,name=association,proto3" json:"association,omitempty"`
+	// Value of the identifier, the binary identifiers (e.g. NAA and EUI64)
+	// are hex encoded in lowercase.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Page83ID) Reset() {
+	*x = Page83ID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Page83ID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Page83ID) ProtoMessage() {}
+
+func (x *Page83ID) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Page83ID.ProtoReflect.Descriptor instead.
+func (*Page83ID) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Page83ID) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Page83ID) GetAssociation() string {
+	if x != nil {
+		return x.Association
+	}
+	return ""
+}
+
+func (x *Page83ID) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type DiskPage83IDs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifiers of the disk in the order of the VPD page.
+	Ids []*Page83ID `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *DiskPage83IDs) Reset() {
+	*x = DiskPage83IDs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskPage83IDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskPage83IDs) ProtoMessage() {}
+
+func (x *DiskPage83IDs) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskPage83IDs.ProtoReflect.Descriptor instead.
+func (*DiskPage83IDs) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DiskPage83IDs) GetIds() []*Page83ID {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ListDiskPage83IDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Map of disk numbers and the identifiers of each disk.
+	DiskPage83IDs map[uint32]*DiskPage83IDs `protobuf:"bytes,1,rep,name=diskPage83IDs,proto3" json:"diskPage83IDs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // the case is intentional for protoc to generate the field as DiskPage83IDs
+	// Token to get the next page of disks, empty if there are no more disks.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListDiskPage83IDsResponse) Reset() {
+	*x = ListDiskPage83IDsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDiskPage83IDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiskPage83IDsResponse) ProtoMessage() {}
+
+func (x *ListDiskPage83IDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiskPage83IDsResponse.ProtoReflect.Descriptor instead.
+func (*ListDiskPage83IDsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListDiskPage83IDsResponse) GetDiskPage83IDs() map[uint32]*DiskPage83IDs {
+	if x != nil {
+		return x.DiskPage83IDs
+	}
+	return nil
+}
+
+func (x *ListDiskPage83IDsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetDiskNumberByPage83IDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the logical unit, the binary identifiers are hex encoded
+	// and may have a "naa." or "eui." prefix, the case is ignored.
+	Page83Id string `protobuf:"bytes,1,opt,name=page83_id,json=page83Id,proto3" json:"page83_id,omitempty"`
+}
+
+func (x *GetDiskNumberByPage83IDRequest) Reset() {
+	*x = GetDiskNumberByPage83IDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskNumberByPage83IDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskNumberByPage83IDRequest) ProtoMessage() {}
+
+func (x *GetDiskNumberByPage83IDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskNumberByPage83IDRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskNumberByPage83IDRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetDiskNumberByPage83IDRequest) GetPage83Id() string {
+	if x != nil {
+		return x.Page83Id
+	}
+	return ""
+}
+
+type GetDiskNumberByPage83IDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskNumberByPage83IDResponse) Reset() {
+	*x = GetDiskNumberByPage83IDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskNumberByPage83IDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskNumberByPage83IDResponse) ProtoMessage() {}
+
+func (x *GetDiskNumberByPage83IDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskNumberByPage83IDResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskNumberByPage83IDResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetDiskNumberByPage83IDResponse) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+// WaitForDiskRequest matches the disk with exactly one of serial_number,
+// page83_id and lun_id.
+type WaitForDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Serial number of the disk, as in GetDiskNumberBySerialNumberRequest.
+	SerialNumber string `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// Logical unit identifier of the disk, as in GetDiskNumberByPage83IDRequest.
+	Page83Id string `protobuf:"bytes,2,opt,name=page83_id,json=page83Id,proto3" json:"page83_id,omitempty"`
+	// Location of the disk, as in GetDiskNumberByLocationRequest. lun_id is
+	// required to match the disk by location, the other fields that are empty
+	// match any value.
+	Adapter string `protobuf:"bytes,3,opt,name=adapter,proto3" json:"adapter,omitempty"`
+	Bus     string `protobuf:"bytes,4,opt,name=bus,proto3" json:"bus,omitempty"`
+	Target  string `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	LunId   string `protobuf:"bytes,6,opt,name=lun_id,json=lunId,proto3" json:"lun_id,omitempty"`
+	// How long to wait for the disk in nanoseconds, 2 minutes if it's 0.
+	TimeoutNanos int64 `protobuf:"varint,7,opt,name=timeout_nanos,json=timeoutNanos,proto3" json:"timeout_nanos,omitempty"`
+}
+
+func (x *WaitForDiskRequest) Reset() {
+	*x = WaitForDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForDiskRequest) ProtoMessage() {}
+
+func (x *WaitForDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForDiskRequest.ProtoReflect.Descriptor instead.
+func (*WaitForDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *WaitForDiskRequest) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *WaitForDiskRequest) GetPage83Id() string {
+	if x != nil {
+		return x.Page83Id
+	}
+	return ""
+}
+
+func (x *WaitForDiskRequest) GetAdapter() string {
+	if x != nil {
+		return x.Adapter
+	}
+	return ""
+}
+
+func (x *WaitForDiskRequest) GetBus() string {
+	if x != nil {
+		return x.Bus
+	}
+	return ""
+}
+
+func (x *WaitForDiskRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *WaitForDiskRequest) GetLunId() string {
+	if x != nil {
+		return x.LunId
+	}
+	return ""
+}
+
+func (x *WaitForDiskRequest) GetTimeoutNanos() int64 {
+	if x != nil {
+		return x.TimeoutNanos
+	}
+	return 0
+}
+
+type WaitForDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *WaitForDiskResponse) Reset() {
+	*x = WaitForDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitForDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitForDiskResponse) ProtoMessage() {}
+
+func (x *WaitForDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitForDiskResponse.ProtoReflect.Descriptor instead.
+func (*WaitForDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *WaitForDiskResponse) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+// DiskIdentity is the composite identity of a disk, the identifiers that a
+// disk doesn't have are empty. A disk has the identity if it has all the
+// identifiers that aren't empty.
+type DiskIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Serial number of the disk without the padding spaces.
+	SerialNumber string `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// First logical unit identifier of the device identification VPD page of
+	// the disk, hex encoded in lowercase if it's binary.
+	Page83Id string `protobuf:"bytes,2,opt,name=page83_id,json=page83Id,proto3" json:"page83_id,omitempty"`
+	// Signature of the disk if it's initialized as MBR, 0 otherwise.
+	Signature uint32 `protobuf:"varint,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// GUID of the disk if it's initialized as GPT, empty otherwise.
+	Guid string `protobuf:"bytes,4,opt,name=guid,proto3" json:"guid,omitempty"`
+}
+
+func (x *DiskIdentity) Reset() {
+	*x = DiskIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskIdentity) ProtoMessage() {}
+
+func (x *DiskIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskIdentity.ProtoReflect.Descriptor instead.
+func (*DiskIdentity) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DiskIdentity) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *DiskIdentity) GetPage83Id() string {
+	if x != nil {
+		return x.Page83Id
+	}
+	return ""
+}
+
+func (x *DiskIdentity) GetSignature() uint32 {
+	if x != nil {
+		return x.Signature
+	}
+	return 0
+}
+
+func (x *DiskIdentity) GetGuid() string {
+	if x != nil {
+		return x.Guid
+	}
+	return ""
+}
+
+type GetDiskIdentityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskIdentityRequest) Reset() {
+	*x = GetDiskIdentityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskIdentityRequest) ProtoMessage() {}
+
+func (x *GetDiskIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskIdentityRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetDiskIdentityRequest) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type GetDiskIdentityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identity *DiskIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (x *GetDiskIdentityResponse) Reset() {
+	*x = GetDiskIdentityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskIdentityResponse) ProtoMessage() {}
+
+func (x *GetDiskIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskIdentityResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetDiskIdentityResponse) GetIdentity() *DiskIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type ResolveDiskIdentityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identity of the disk returned by GetDiskIdentity, it's matched without
+	// the empty identifiers e.g. an identity taken before the disk was
+	// initialized matches the disk once it has a signature or a GUID.
+	Identity *DiskIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+}
+
+func (x *ResolveDiskIdentityRequest) Reset() {
+	*x = ResolveDiskIdentityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveDiskIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDiskIdentityRequest) ProtoMessage() {}
+
+func (x *ResolveDiskIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDiskIdentityRequest.ProtoReflect.Descriptor instead.
+func (*ResolveDiskIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ResolveDiskIdentityRequest) GetIdentity() *DiskIdentity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type ResolveDiskIdentityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk device number of the disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *ResolveDiskIdentityResponse) Reset() {
+	*x = ResolveDiskIdentityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveDiskIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveDiskIdentityResponse) ProtoMessage() {}
+
+func (x *ResolveDiskIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveDiskIdentityResponse.ProtoReflect.Descriptor instead.
+func (*ResolveDiskIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ResolveDiskIdentityResponse) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+var File_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto protoreflect.FileDescriptor
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDesc = []byte{
+	0x0a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x22, 0xa2, 0x01, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x0e,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x75, 0x6e, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x75, 0x6e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x88, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x44, 0x69, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a,
+	0x03, 0x42, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x42, 0x75, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x55, 0x4e, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x55, 0x4e, 0x49, 0x44, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0x38, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x44, 0x69, 0x73,
+	0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x4c, 0x75, 0x6e, 0x73, 0x22, 0x66, 0x0a, 0x10,
+	0x46, 0x72, 0x65, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x6c, 0x75, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x66, 0x72, 0x65, 0x65, 0x4c, 0x75,
+	0x6e, 0x49, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x44,
+	0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfc,
+	0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e,
+	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x64, 0x69,
+	0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x1a, 0x58, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x7b, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x75, 0x6e, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x1f, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xfa,
+	0x01, 0x0a, 0x14, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69,
+	0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x52, 0x0e, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x62, 0x72, 0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x67, 0x70, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x67, 0x70, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x70, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x64, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x29,
+	0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x70, 0x0a, 0x0f, 0x57, 0x69, 0x70, 0x65, 0x44, 0x69, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69,
+	0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x7a, 0x65, 0x72,
+	0x6f, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x7a, 0x65,
+	0x72, 0x6f, 0x46, 0x69, 0x6c, 0x6c, 0x22, 0x12, 0x0a, 0x10, 0x57, 0x69, 0x70, 0x65, 0x44, 0x69,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x17, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x6f, 0x47, 0x50, 0x54, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x6f, 0x47, 0x50, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61,
+	0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x07, 0x44, 0x69,
+	0x73, 0x6b, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x65, 0x38, 0x33, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x67, 0x65, 0x38, 0x33, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0xd2, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49,
+	0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x07, 0x64, 0x69,
+	0x73, 0x6b, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49,
+	0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49,
+	0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x64, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a, 0x4d, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x6b,
+	0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x36, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0xbe, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x13, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6c, 0x6f, 0x67,
+	0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x70, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a, 0x65,
+	0x22, 0x44, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x6c, 0x69, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa3, 0x05, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x13, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x65, 0x6c,
+	0x73, 0x69, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x12, 0x36,
+	0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x15, 0x6d, 0x61, 0x78, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43,
+	0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x65, 0x61, 0x72, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x77, 0x65, 0x61, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x72, 0x65, 0x61, 0x64,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12,
+	0x36, 0x0a, 0x17, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x75,
+	0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x15, 0x72, 0x65, 0x61, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x55, 0x6e, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x77, 0x72, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x75, 0x6e, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6f,
+	0x6e, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70,
+	0x6f, 0x77, 0x65, 0x72, 0x4f, 0x6e, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x65, 0x61, 0x64, 0x4c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x61, 0x78, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x6d, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x61, 0x78, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x66,
+	0x6c, 0x75, 0x73, 0x68, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x66, 0x6c, 0x75, 0x73, 0x68,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x61, 0x78, 0x4d, 0x73, 0x22, 0x74, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0x33, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x94, 0x01, 0x0a, 0x1c, 0x57, 0x61, 0x69, 0x74,
+	0x46, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64,
+	0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x40,
+	0x0a, 0x1d, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0x80, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xb7, 0x02, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x6c, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x6c, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75,
+	0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a,
+	0x0c, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
+	0x1c, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x6f, 0x73, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4f, 0x73, 0x44, 0x69, 0x73, 0x6b, 0x22, 0x65, 0x0a,
+	0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73,
+	0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4a, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x53, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x73, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x73, 0x61,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x49, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x61,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
+	0x0a, 0x0a, 0x73, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x61,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x73, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x56, 0x0a, 0x16, 0x53, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69,
+	0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x36, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0x49, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x42, 0x79, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x46, 0x0a, 0x23, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x53, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b,
+	0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x56,
+	0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x35, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61,
+	0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0xfc, 0x01,
+	0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x67, 0x65, 0x38, 0x33,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x64,
+	0x69, 0x73, 0x6b, 0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x67, 0x65,
+	0x38, 0x33, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x6b,
+	0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x1a, 0x59, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49,
+	0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44,
+	0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x1e,
+	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x50,
+	0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x38, 0x33, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x64, 0x22, 0x42, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x50, 0x61,
+	0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0xd6, 0x01, 0x0a, 0x12, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x38, 0x33, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x61, 0x70, 0x74,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x62, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x15, 0x0a, 0x06,
+	0x6c, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x75,
+	0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6e,
+	0x61, 0x6e, 0x6f, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4e, 0x61, 0x6e, 0x6f, 0x73, 0x22, 0x36, 0x0a, 0x13, 0x57, 0x61, 0x69, 0x74,
+	0x46, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x82, 0x01, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x38, 0x33,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x38,
+	0x33, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x67, 0x75, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x4d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22,
+	0x50, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a,
+	0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x22, 0x3e, 0x0a, 0x1b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6b,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x2a, 0x22, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x79, 0x6c, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x47, 0x50, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x4d, 0x42, 0x52, 0x10, 0x01, 0x2a, 0x56, 0x0a, 0x09, 0x53, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x0e, 0x0a, 0x0a, 0x4f, 0x4e, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x41, 0x4c, 0x4c,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x48,
+	0x41, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e,
+	0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x46, 0x46, 0x4c, 0x49,
+	0x4e, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x32, 0xd1, 0x12,
+	0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65,
+	0x65, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65,
+	0x65, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x72, 0x65, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x70, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x52, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x73, 0x6b, 0x12, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x5b, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x21, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08,
+	0x57, 0x69, 0x70, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x19, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x57, 0x69, 0x70, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57,
+	0x69, 0x70, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5b, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b,
+	0x54, 0x6f, 0x47, 0x50, 0x54, 0x12, 0x21, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x54, 0x6f, 0x47, 0x50,
+	0x54, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x54,
+	0x6f, 0x47, 0x50, 0x54, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x06, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x12, 0x17, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x12, 0x1c, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x44,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x6c,
+	0x69, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x6c, 0x69, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x15, 0x57, 0x61, 0x69,
+	0x74, 0x46, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x26, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61,
+	0x69, 0x74, 0x46, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x44, 0x69, 0x73,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73,
+	0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x61, 0x6e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f,
+	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x53, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x61, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x61, 0x6e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x20, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x20, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x52,
+	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73,
+	0x6b, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x7c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x2c, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x53, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x53, 0x65, 0x72, 0x69, 0x61,
+	0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x67,
+	0x65, 0x38, 0x33, 0x49, 0x44, 0x73, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x67, 0x65, 0x38, 0x33,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61,
+	0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x70, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x12, 0x28, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x79, 0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42,
+	0x79, 0x50, 0x61, 0x67, 0x65, 0x38, 0x33, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x44, 0x69,
+	0x73, 0x6b, 0x12, 0x1c, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61,
+	0x69, 0x74, 0x46, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74,
+	0x46, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x20, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x24, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63,
+	0x73, 0x69, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescOnce sync.Once
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDesc
+)
+
+func file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescGZIP() []byte {
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescOnce.Do(func() {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescData)
+	})
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDescData
+}
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_goTypes = []interface{}{
+	(PartitionStyle)(0),                         // 0: v2alpha1.PartitionStyle
+	(SanPolicy)(0),                              // 1: v2alpha1.SanPolicy
+	(*DiskFilter)(nil),                          // 2: v2alpha1.DiskFilter
+	(*ListDiskLocationsRequest)(nil),            // 3: v2alpha1.ListDiskLocationsRequest
+	(*DiskLocation)(nil),                        // 4: v2alpha1.DiskLocation
+	(*GetFreeDiskLocationsRequest)(nil),         // 5: v2alpha1.GetFreeDiskLocationsRequest
+	(*FreeDiskLocation)(nil),                    // 6: v2alpha1.FreeDiskLocation
+	(*GetFreeDiskLocationsResponse)(nil),        // 7: v2alpha1.GetFreeDiskLocationsResponse
+	(*ListDiskLocationsResponse)(nil),           // 8: v2alpha1.ListDiskLocationsResponse
+	(*GetDiskNumberByLocationRequest)(nil),      // 9: v2alpha1.GetDiskNumberByLocationRequest
+	(*GetDiskNumberByLocationResponse)(nil),     // 10: v2alpha1.GetDiskNumberByLocationResponse
+	(*PartitionDiskRequest)(nil),                // 11: v2alpha1.PartitionDiskRequest
+	(*PartitionDiskResponse)(nil),               // 12: v2alpha1.PartitionDiskResponse
+	(*SetPartitionTypeRequest)(nil),             // 13: v2alpha1.SetPartitionTypeRequest
+	(*SetPartitionTypeResponse)(nil),            // 14: v2alpha1.SetPartitionTypeResponse
+	(*DeletePartitionRequest)(nil),              // 15: v2alpha1.DeletePartitionRequest
+	(*DeletePartitionResponse)(nil),             // 16: v2alpha1.DeletePartitionResponse
+	(*WipeDiskRequest)(nil),                     // 17: v2alpha1.WipeDiskRequest
+	(*WipeDiskResponse)(nil),                    // 18: v2alpha1.WipeDiskResponse
+	(*ConvertDiskToGPTRequest)(nil),             // 19: v2alpha1.ConvertDiskToGPTRequest
+	(*ConvertDiskToGPTResponse)(nil),            // 20: v2alpha1.ConvertDiskToGPTResponse
+	(*RescanRequest)(nil),                       // 21: v2alpha1.RescanRequest
+	(*RescanResponse)(nil),                      // 22: v2alpha1.RescanResponse
+	(*ListDiskIDsRequest)(nil),                  // 23: v2alpha1.ListDiskIDsRequest
+	(*DiskIDs)(nil),                             // 24: v2alpha1.DiskIDs
+	(*ListDiskIDsResponse)(nil),                 // 25: v2alpha1.ListDiskIDsResponse
+	(*GetDiskStatsRequest)(nil),                 // 26: v2alpha1.GetDiskStatsRequest
+	(*GetDiskStatsResponse)(nil),                // 27: v2alpha1.GetDiskStatsResponse
+	(*GetDiskReliabilityCountersRequest)(nil),   // 28: v2alpha1.GetDiskReliabilityCountersRequest
+	(*GetDiskReliabilityCountersResponse)(nil),  // 29: v2alpha1.GetDiskReliabilityCountersResponse
+	(*SetDiskStateRequest)(nil),                 // 30: v2alpha1.SetDiskStateRequest
+	(*SetDiskStateResponse)(nil),                // 31: v2alpha1.SetDiskStateResponse
+	(*GetDiskStateRequest)(nil),                 // 32: v2alpha1.GetDiskStateRequest
+	(*GetDiskStateResponse)(nil),                // 33: v2alpha1.GetDiskStateResponse
+	(*WaitForDiskSizeChangeRequest)(nil),        // 34: v2alpha1.WaitForDiskSizeChangeRequest
+	(*WaitForDiskSizeChangeResponse)(nil),       // 35: v2alpha1.WaitForDiskSizeChangeResponse
+	(*ListDisksRequest)(nil),                    // 36: v2alpha1.ListDisksRequest
+	(*DiskInfo)(nil),                            // 37: v2alpha1.DiskInfo
+	(*ListDisksResponse)(nil),                   // 38: v2alpha1.ListDisksResponse
+	(*GetSanPolicyRequest)(nil),                 // 39: v2alpha1.GetSanPolicyRequest
+	(*GetSanPolicyResponse)(nil),                // 40: v2alpha1.GetSanPolicyResponse
+	(*SetSanPolicyRequest)(nil),                 // 41: v2alpha1.SetSanPolicyRequest
+	(*SetSanPolicyResponse)(nil),                // 42: v2alpha1.SetSanPolicyResponse
+	(*SetDiskReadOnlyRequest)(nil),              // 43: v2alpha1.SetDiskReadOnlyRequest
+	(*SetDiskReadOnlyResponse)(nil),             // 44: v2alpha1.SetDiskReadOnlyResponse
+	(*GetDiskReadOnlyRequest)(nil),              // 45: v2alpha1.GetDiskReadOnlyRequest
+	(*GetDiskReadOnlyResponse)(nil),             // 46: v2alpha1.GetDiskReadOnlyResponse
+	(*GetDiskNumberBySerialNumberRequest)(nil),  // 47: v2alpha1.GetDiskNumberBySerialNumberRequest
+	(*GetDiskNumberBySerialNumberResponse)(nil), // 48: v2alpha1.GetDiskNumberBySerialNumberResponse
+	(*ListDiskPage83IDsRequest)(nil),            // 49: v2alpha1.ListDiskPage83IDsRequest
+	(*Page83ID)(nil),                            // 50: v2alpha1.Page83ID
+	(*DiskPage83IDs)(nil),                       // 51: v2alpha1.DiskPage83IDs
+	(*ListDiskPage83IDsResponse)(nil),           // 52: v2alpha1.ListDiskPage83IDsResponse
+	(*GetDiskNumberByPage83IDRequest)(nil),      // 53: v2alpha1.GetDiskNumberByPage83IDRequest
+	(*GetDiskNumberByPage83IDResponse)(nil),     // 54: v2alpha1.GetDiskNumberByPage83IDResponse
+	(*WaitForDiskRequest)(nil),                  // 55: v2alpha1.WaitForDiskRequest
+	(*WaitForDiskResponse)(nil),                 // 56: v2alpha1.WaitForDiskResponse
+	(*DiskIdentity)(nil),                        // 57: v2alpha1.DiskIdentity
+	(*GetDiskIdentityRequest)(nil),              // 58: v2alpha1.GetDiskIdentityRequest
+	(*GetDiskIdentityResponse)(nil),             // 59: v2alpha1.GetDiskIdentityResponse
+	(*ResolveDiskIdentityRequest)(nil),          // 60: v2alpha1.ResolveDiskIdentityRequest
+	(*ResolveDiskIdentityResponse)(nil),         // 61: v2alpha1.ResolveDiskIdentityResponse
+	nil,                                         // 62: v2alpha1.ListDiskLocationsResponse.DiskLocationsEntry
+	nil,                                         // 63: v2alpha1.ListDiskIDsResponse.DiskIDsEntry
+	nil,                                         // 64: v2alpha1.ListDiskPage83IDsResponse.DiskPage83IDsEntry
+}
+var file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_depIdxs = []int32{
+	2,  // 0: v2alpha1.ListDiskLocationsRequest.filter:type_name -> v2alpha1.DiskFilter
+	6,  // 1: v2alpha1.GetFreeDiskLocationsResponse.locations:type_name -> v2alpha1.FreeDiskLocation
+	62, // 2: v2alpha1.ListDiskLocationsResponse.disk_locations:type_name -> v2alpha1.ListDiskLocationsResponse.DiskLocationsEntry
+	0,  // 3: v2alpha1.PartitionDiskRequest.partition_style:type_name -> v2alpha1.PartitionStyle
+	2,  // 4: v2alpha1.ListDiskIDsRequest.filter:type_name -> v2alpha1.DiskFilter
+	63, // 5: v2alpha1.ListDiskIDsResponse.diskIDs:type_name -> v2alpha1.ListDiskIDsResponse.DiskIDsEntry
+	2,  // 6: v2alpha1.ListDisksRequest.filter:type_name -> v2alpha1.DiskFilter
+	37, // 7: v2alpha1.ListDisksResponse.disks:type_name -> v2alpha1.DiskInfo
+	1,  // 8: v2alpha1.GetSanPolicyResponse.san_policy:type_name -> v2alpha1.SanPolicy
+	1,  // 9: v2alpha1.SetSanPolicyRequest.san_policy:type_name -> v2alpha1.SanPolicy
+	2,  // 10: v2alpha1.ListDiskPage83IDsRequest.filter:type_name -> v2alpha1.DiskFilter
+	50, // 11: v2alpha1.DiskPage83IDs.ids:type_name -> v2alpha1.Page83ID
+	64, // 12: v2alpha1.ListDiskPage83IDsResponse.diskPage83IDs:type_name -> v2alpha1.ListDiskPage83IDsResponse.DiskPage83IDsEntry
+	57, // 13: v2alpha1.GetDiskIdentityResponse.identity:type_name -> v2alpha1.DiskIdentity
+	57, // 14: v2alpha1.ResolveDiskIdentityRequest.identity:type_name -> v2alpha1.DiskIdentity
+	4,  // 15: v2alpha1.ListDiskLocationsResponse.DiskLocationsEntry.value:type_name -> v2alpha1.DiskLocation
+	24, // 16: v2alpha1.ListDiskIDsResponse.DiskIDsEntry.value:type_name -> v2alpha1.DiskIDs
+	51, // 17: v2alpha1.ListDiskPage83IDsResponse.DiskPage83IDsEntry.value:type_name -> v2alpha1.DiskPage83IDs
+	3,  // 18: v2alpha1.Disk.ListDiskLocations:input_type -> v2alpha1.ListDiskLocationsRequest
+	5,  // 19: v2alpha1.Disk.GetFreeDiskLocations:input_type -> v2alpha1.GetFreeDiskLocationsRequest
+	9,  // 20: v2alpha1.Disk.GetDiskNumberByLocation:input_type -> v2alpha1.GetDiskNumberByLocationRequest
+	11, // 21: v2alpha1.Disk.PartitionDisk:input_type -> v2alpha1.PartitionDiskRequest
+	15, // 22: v2alpha1.Disk.DeletePartition:input_type -> v2alpha1.DeletePartitionRequest
+	13, // 23: v2alpha1.Disk.SetPartitionType:input_type -> v2alpha1.SetPartitionTypeRequest
+	17, // 24: v2alpha1.Disk.WipeDisk:input_type -> v2alpha1.WipeDiskRequest
+	19, // 25: v2alpha1.Disk.ConvertDiskToGPT:input_type -> v2alpha1.ConvertDiskToGPTRequest
+	21, // 26: v2alpha1.Disk.Rescan:input_type -> v2alpha1.RescanRequest
+	23, // 27: v2alpha1.Disk.ListDiskIDs:input_type -> v2alpha1.ListDiskIDsRequest
+	26, // 28: v2alpha1.Disk.GetDiskStats:input_type -> v2alpha1.GetDiskStatsRequest
+	28, // 29: v2alpha1.Disk.GetDiskReliabilityCounters:input_type -> v2alpha1.GetDiskReliabilityCountersRequest
+	30, // 30: v2alpha1.Disk.SetDiskState:input_type -> v2alpha1.SetDiskStateRequest
+	32, // 31: v2alpha1.Disk.GetDiskState:input_type -> v2alpha1.GetDiskStateRequest
+	34, // 32: v2alpha1.Disk.WaitForDiskSizeChange:input_type -> v2alpha1.WaitForDiskSizeChangeRequest
+	36, // 33: v2alpha1.Disk.ListDisks:input_type -> v2alpha1.ListDisksRequest
+	39, // 34: v2alpha1.Disk.GetSanPolicy:input_type -> v2alpha1.GetSanPolicyRequest
+	41, // 35: v2alpha1.Disk.SetSanPolicy:input_type -> v2alpha1.SetSanPolicyRequest
+	43, // 36: v2alpha1.Disk.SetDiskReadOnly:input_type -> v2alpha1.SetDiskReadOnlyRequest
+	45, // 37: v2alpha1.Disk.GetDiskReadOnly:input_type -> v2alpha1.GetDiskReadOnlyRequest
+	47, // 38: v2alpha1.Disk.GetDiskNumberBySerialNumber:input_type -> v2alpha1.GetDiskNumberBySerialNumberRequest
+	49, // 39: v2alpha1.Disk.ListDiskPage83IDs:input_type -> v2alpha1.ListDiskPage83IDsRequest
+	53, // 40: v2alpha1.Disk.GetDiskNumberByPage83ID:input_type -> v2alpha1.GetDiskNumberByPage83IDRequest
+	55, // 41: v2alpha1.Disk.WaitForDisk:input_type -> v2alpha1.WaitForDiskRequest
+	58, // 42: v2alpha1.Disk.GetDiskIdentity:input_type -> v2alpha1.GetDiskIdentityRequest
+	60, // 43: v2alpha1.Disk.ResolveDiskIdentity:input_type -> v2alpha1.ResolveDiskIdentityRequest
+	8,  // 44: v2alpha1.Disk.ListDiskLocations:output_type -> v2alpha1.ListDiskLocationsResponse
+	7,  // 45: v2alpha1.Disk.GetFreeDiskLocations:output_type -> v2alpha1.GetFreeDiskLocationsResponse
+	10, // 46: v2alpha1.Disk.GetDiskNumberByLocation:output_type -> v2alpha1.GetDiskNumberByLocationResponse
+	12, // 47: v2alpha1.Disk.PartitionDisk:output_type -> v2alpha1.PartitionDiskResponse
+	16, // 48: v2alpha1.Disk.DeletePartition:output_type -> v2alpha1.DeletePartitionResponse
+	14, // 49: v2alpha1.Disk.SetPartitionType:output_type -> v2alpha1.SetPartitionTypeResponse
+	18, // 50: v2alpha1.Disk.WipeDisk:output_type -> v2alpha1.WipeDiskResponse
+	20, // 51: v2alpha1.Disk.ConvertDiskToGPT:output_type -> v2alpha1.ConvertDiskToGPTResponse
+	22, // 52: v2alpha1.Disk.Rescan:output_type -> v2alpha1.RescanResponse
+	25, // 53: v2alpha1.Disk.ListDiskIDs:output_type -> v2alpha1.ListDiskIDsResponse
+	27, // 54: v2alpha1.Disk.GetDiskStats:output_type -> v2alpha1.GetDiskStatsResponse
+	29, // 55: v2alpha1.Disk.GetDiskReliabilityCounters:output_type -> v2alpha1.GetDiskReliabilityCountersResponse
+	31, // 56: v2alpha1.Disk.SetDiskState:output_type -> v2alpha1.SetDiskStateResponse
+	33, // 57: v2alpha1.Disk.GetDiskState:output_type -> v2alpha1.GetDiskStateResponse
+	35, // 58: v2alpha1.Disk.WaitForDiskSizeChange:output_type -> v2alpha1.WaitForDiskSizeChangeResponse
+	38, // 59: v2alpha1.Disk.ListDisks:output_type -> v2alpha1.ListDisksResponse
+	40, // 60: v2alpha1.Disk.GetSanPolicy:output_type -> v2alpha1.GetSanPolicyResponse
+	42, // 61: v2alpha1.Disk.SetSanPolicy:output_type -> v2alpha1.SetSanPolicyResponse
+	44, // 62: v2alpha1.Disk.SetDiskReadOnly:output_type -> v2alpha1.SetDiskReadOnlyResponse
+	46, // 63: v2alpha1.Disk.GetDiskReadOnly:output_type -> v2alpha1.GetDiskReadOnlyResponse
+	48, // 64: v2alpha1.Disk.GetDiskNumberBySerialNumber:output_type -> v2alpha1.GetDiskNumberBySerialNumberResponse
+	52, // 65: v2alpha1.Disk.ListDiskPage83IDs:output_type -> v2alpha1.ListDiskPage83IDsResponse
+	54, // 66: v2alpha1.Disk.GetDiskNumberByPage83ID:output_type -> v2alpha1.GetDiskNumberByPage83IDResponse
+	56, // 67: v2alpha1.Disk.WaitForDisk:output_type -> v2alpha1.WaitForDiskResponse
+	59, // 68: v2alpha1.Disk.GetDiskIdentity:output_type -> v2alpha1.GetDiskIdentityResponse
+	61, // 69: v2alpha1.Disk.ResolveDiskIdentity:output_type -> v2alpha1.ResolveDiskIdentityResponse
+	44, // [44:70] is the sub-list for method output_type
+	18, // [18:44] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
+}
+
+func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_init() }
+func file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_init() {
+	if File_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiskLocationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFreeDiskLocationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FreeDiskLocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFreeDiskLocationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiskLocationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskNumberByLocationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskNumberByLocationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PartitionDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPartitionTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPartitionTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePartitionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePartitionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WipeDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WipeDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertDiskToGPTRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertDiskToGPTResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescanResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiskIDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskIDs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiskIDsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskReliabilityCountersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskReliabilityCountersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDiskStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDiskStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForDiskSizeChangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForDiskSizeChangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDisksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDisksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSanPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSanPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSanPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSanPolicyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDiskReadOnlyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDiskReadOnlyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskReadOnlyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskReadOnlyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskNumberBySerialNumberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskNumberBySerialNumberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiskPage83IDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Page83ID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskPage83IDs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDiskPage83IDsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskNumberByPage83IDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskNumberByPage83IDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitForDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskIdentityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskIdentityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveDiskIdentityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveDiskIdentityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   63,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_goTypes,
+		DependencyIndexes: file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_depIdxs,
+		EnumInfos:         file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_enumTypes,
+		MessageInfos:      file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_msgTypes,
+	}.Build()
+	File_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto = out.File
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_rawDesc = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_goTypes = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_disk_v2alpha1_api_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// DiskClient is the client API for Disk service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DiskClient interface {
+	// ListDiskLocations returns locations <Adapter, Bus, Target, LUN ID> of all
+	// disk devices enumerated by the host, the disks can be filtered and paged
+	// through.
+	ListDiskLocations(ctx context.Context, in *ListDiskLocationsRequest, opts ...grpc.CallOption) (*ListDiskLocationsResponse, error)
+	// GetFreeDiskLocations returns the LUNs that aren't used by any disk on each
+	// SCSI adapter and target of the node, so that the next disk can be attached
+	// at a LUN that doesn't conflict with the disks already attached.
+	GetFreeDiskLocations(ctx context.Context, in *GetFreeDiskLocationsRequest, opts ...grpc.CallOption) (*GetFreeDiskLocationsResponse, error)
+	// GetDiskNumberByLocation returns the number of the disk at a location
+	// e.g. the LUN of a controller slot where a cloud disk was attached. It
+	// fails with NOT_FOUND if no disk is at the location.
+	GetDiskNumberByLocation(ctx context.Context, in *GetDiskNumberByLocationRequest, opts ...grpc.CallOption) (*GetDiskNumberByLocationResponse, error)
+	// PartitionDisk initializes a disk device with the GPT or the MBR partition style
+	// (if the disk has not been initialized already) and creates a basic partition
+	// of the requested size at the requested offset (if the disk has not been
+	// partitioned already).
+	PartitionDisk(ctx context.Context, in *PartitionDiskRequest, opts ...grpc.CallOption) (*PartitionDiskResponse, error)
+	// DeletePartition removes a partition, or all the partitions, of a disk
+	// after removing their access paths (drive letters and mount points), e.g.
+	// to wipe a disk before it's returned to the storage backend or partitioned
+	// with another layout. Only the disks allowed by the disk policy of the
+	// node can be changed.
+	DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error)
+	// SetPartitionType changes the GPT partition type of a partition, e.g. to
+	// fix the type of a partition created by another tool. Only the disks
+	// allowed by the disk policy of the node can be changed.
+	SetPartitionType(ctx context.Context, in *SetPartitionTypeRequest, opts ...grpc.CallOption) (*SetPartitionTypeResponse, error)
+	// WipeDisk clears a disk (Clear-Disk), i.e. removes its partitions and
+	// returns it to the uninitialized (RAW) state, optionally writing zeros to
+	// the whole disk, e.g. to sanitize a local disk before it's given to another
+	// tenant. Only the disks allowed by the disk policy of the node can be wiped.
+	WipeDisk(ctx context.Context, in *WipeDiskRequest, opts ...grpc.CallOption) (*WipeDiskResponse, error)
+	// ConvertDiskToGPT converts an empty MBR disk to the GPT partition style,
+	// e.g. so that a disk initialized by older tooling can be partitioned and
+	// its volume resized past 2 TiB. It does nothing if the disk is already
+	// GPT and fails with FAILED_PRECONDITION if the disk has partitions or
+	// isn't initialized. Only the disks allowed by the disk policy of the
+	// node can be converted.
+	ConvertDiskToGPT(ctx context.Context, in *ConvertDiskToGPTRequest, opts ...grpc.CallOption) (*ConvertDiskToGPTResponse, error)
+	// Rescan rescans the SCSI buses so that Windows discovers the disks attached
+	// since the last scan and refreshes the host's storage cache. Only the SCSI
+	// buses are rescanned in strict mode.
+	Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*RescanResponse, error)
+	// ListDiskIDs returns a map of DiskID objects where the key is the disk number,
+	// the disks can be filtered and paged through.
+	ListDiskIDs(ctx context.Context, in *ListDiskIDsRequest, opts ...grpc.CallOption) (*ListDiskIDsResponse, error)
+	// GetDiskStats returns the stats of a disk (currently it returns the disk size).
+	GetDiskStats(ctx context.Context, in *GetDiskStatsRequest, opts ...grpc.CallOption) (*GetDiskStatsResponse, error)
+	// GetDiskReliabilityCounters returns the reliability (SMART) counters of a
+	// disk (Get-StorageReliabilityCounter) e.g. its read errors, wear and
+	// temperature, so that monitoring agents can alert on failing local disks.
+	// It fails with FAILED_PRECONDITION if the disk doesn't report any, e.g.
+	// for most virtual disks.
+	GetDiskReliabilityCounters(ctx context.Context, in *GetDiskReliabilityCountersRequest, opts ...grpc.CallOption) (*GetDiskReliabilityCountersResponse, error)
+	// SetDiskState sets the offline/online state of a disk e.g. to bring a
+	// hot-attached disk online before partitioning it, or to take a disk
+	// offline before detaching it, its write cache is flushed first.
+	// Shared (clustered) disks are reserved for the claim token of the request
+	// before they're brought online, see SetDiskStateRequest.claim_token.
+	SetDiskState(ctx context.Context, in *SetDiskStateRequest, opts ...grpc.CallOption) (*SetDiskStateResponse, error)
+	// GetDiskState gets the offline/online state of a disk, it's available in
+	// strict mode.
+	GetDiskState(ctx context.Context, in *GetDiskStateRequest, opts ...grpc.CallOption) (*GetDiskStateResponse, error)
+	// WaitForDiskSizeChange rescans the disks until Windows observes the new
+	// size of a disk expanded by the storage backend (e.g. a cloud disk), it
+	// returns once the disk is at least as large as expected so that the
+	// volume of the disk can be resized. It fails with DEADLINE_EXCEEDED if the
+	// disk isn't expanded in time.
+	WaitForDiskSizeChange(ctx context.Context, in *WaitForDiskSizeChangeRequest, opts ...grpc.CallOption) (*WaitForDiskSizeChangeResponse, error)
+	// ListDisks returns the properties of the disks of the host, the disks can
+	// be filtered and paged through.
+	ListDisks(ctx context.Context, in *ListDisksRequest, opts ...grpc.CallOption) (*ListDisksResponse, error)
+	// GetSanPolicy gets the SAN policy of the node, it decides whether the
+	// disks attached to the node are brought online automatically.
+	GetSanPolicy(ctx context.Context, in *GetSanPolicyRequest, opts ...grpc.CallOption) (*GetSanPolicyResponse, error)
+	// SetSanPolicy sets the SAN policy of the node, it applies to the disks
+	// attached afterwards.
+	SetSanPolicy(ctx context.Context, in *SetSanPolicyRequest, opts ...grpc.CallOption) (*SetSanPolicyResponse, error)
+	// SetDiskReadOnly makes a disk read-only or writable e.g. to clear the
+	// read-only attribute that some SANs set on the LUNs they present, or to
+	// enforce a read-only publish at the disk level. The write cache of the
+	// disk is flushed before it's made read-only.
+	SetDiskReadOnly(ctx context.Context, in *SetDiskReadOnlyRequest, opts ...grpc.CallOption) (*SetDiskReadOnlyResponse, error)
+	// GetDiskReadOnly gets the read-only attribute of a disk.
+	GetDiskReadOnly(ctx context.Context, in *GetDiskReadOnlyRequest, opts ...grpc.CallOption) (*GetDiskReadOnlyResponse, error)
+	// GetDiskNumberBySerialNumber returns the number of the disk with a serial
+	// number e.g. the volume identifier of a cloud disk (vSphere, Azure, EBS)
+	// that surfaces as the serial number of the disk. It fails with NOT_FOUND
+	// if no disk has the serial number.
+	GetDiskNumberBySerialNumber(ctx context.Context, in *GetDiskNumberBySerialNumberRequest, opts ...grpc.CallOption) (*GetDiskNumberBySerialNumberResponse, error)
+	// ListDiskPage83IDs returns all the identifiers of the device
+	// identification VPD page (0x83) of the disks, ListDiskIDs only returns
+	// the first identifier of the logical unit.
+	ListDiskPage83IDs(ctx context.Context, in *ListDiskPage83IDsRequest, opts ...grpc.CallOption) (*ListDiskPage83IDsResponse, error)
+	// GetDiskNumberByPage83ID returns the number of the disk with a logical
+	// unit identifier of its device identification VPD page e.g. the NAA or
+	// EUI-64 identifier of a backend device. It fails with NOT_FOUND if no
+	// disk has the identifier.
+	GetDiskNumberByPage83ID(ctx context.Context, in *GetDiskNumberByPage83IDRequest, opts ...grpc.CallOption) (*GetDiskNumberByPage83IDResponse, error)
+	// WaitForDisk waits until a disk with a serial number, a page83 id or at a
+	// location appears e.g. after the disk is attached by ControllerPublish,
+	// rescanning the disks while it polls, and returns its number. It fails
+	// with DEADLINE_EXCEEDED if the disk doesn't appear in time.
+	WaitForDisk(ctx context.Context, in *WaitForDiskRequest, opts ...grpc.CallOption) (*WaitForDiskResponse, error)
+	// GetDiskIdentity returns the identifiers of a disk that don't change when
+	// the disks are numbered in another order e.g. after a reboot, the caller
+	// stores them to find the disk with ResolveDiskIdentity later. It fails with
+	// FAILED_PRECONDITION if the disk has none of them.
+	GetDiskIdentity(ctx context.Context, in *GetDiskIdentityRequest, opts ...grpc.CallOption) (*GetDiskIdentityResponse, error)
+	// ResolveDiskIdentity returns the number of the disk with an identity
+	// returned by GetDiskIdentity. It fails with NOT_FOUND if no disk has the
+	// identity.
+	ResolveDiskIdentity(ctx context.Context, in *ResolveDiskIdentityRequest, opts ...grpc.CallOption) (*ResolveDiskIdentityResponse, error)
+}
+
+type diskClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDiskClient(cc grpc.ClientConnInterface) DiskClient {
+	return &diskClient{cc}
+}
+
+func (c *diskClient) ListDiskLocations(ctx context.Context, in *ListDiskLocationsRequest, opts ...grpc.CallOption) (*ListDiskLocationsResponse, error) {
+	out := new(ListDiskLocationsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/ListDiskLocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetFreeDiskLocations(ctx context.Context, in *GetFreeDiskLocationsRequest, opts ...grpc.CallOption) (*GetFreeDiskLocationsResponse, error) {
+	out := new(GetFreeDiskLocationsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetFreeDiskLocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskNumberByLocation(ctx context.Context, in *GetDiskNumberByLocationRequest, opts ...grpc.CallOption) (*GetDiskNumberByLocationResponse, error) {
+	out := new(GetDiskNumberByLocationResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskNumberByLocation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) PartitionDisk(ctx context.Context, in *PartitionDiskRequest, opts ...grpc.CallOption) (*PartitionDiskResponse, error) {
+	out := new(PartitionDiskResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/PartitionDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) DeletePartition(ctx context.Context, in *DeletePartitionRequest, opts ...grpc.CallOption) (*DeletePartitionResponse, error) {
+	out := new(DeletePartitionResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/DeletePartition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) SetPartitionType(ctx context.Context, in *SetPartitionTypeRequest, opts ...grpc.CallOption) (*SetPartitionTypeResponse, error) {
+	out := new(SetPartitionTypeResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/SetPartitionType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) WipeDisk(ctx context.Context, in *WipeDiskRequest, opts ...grpc.CallOption) (*WipeDiskResponse, error) {
+	out := new(WipeDiskResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/WipeDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) ConvertDiskToGPT(ctx context.Context, in *ConvertDiskToGPTRequest, opts ...grpc.CallOption) (*ConvertDiskToGPTResponse, error) {
+	out := new(ConvertDiskToGPTResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/ConvertDiskToGPT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) Rescan(ctx context.Context, in *RescanRequest, opts ...grpc.CallOption) (*RescanResponse, error) {
+	out := new(RescanResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/Rescan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) ListDiskIDs(ctx context.Context, in *ListDiskIDsRequest, opts ...grpc.CallOption) (*ListDiskIDsResponse, error) {
+	out := new(ListDiskIDsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/ListDiskIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskStats(ctx context.Context, in *GetDiskStatsRequest, opts ...grpc.CallOption) (*GetDiskStatsResponse, error) {
+	out := new(GetDiskStatsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskReliabilityCounters(ctx context.Context, in *GetDiskReliabilityCountersRequest, opts ...grpc.CallOption) (*GetDiskReliabilityCountersResponse, error) {
+	out := new(GetDiskReliabilityCountersResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskReliabilityCounters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) SetDiskState(ctx context.Context, in *SetDiskStateRequest, opts ...grpc.CallOption) (*SetDiskStateResponse, error) {
+	out := new(SetDiskStateResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/SetDiskState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskState(ctx context.Context, in *GetDiskStateRequest, opts ...grpc.CallOption) (*GetDiskStateResponse, error) {
+	out := new(GetDiskStateResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) WaitForDiskSizeChange(ctx context.Context, in *WaitForDiskSizeChangeRequest, opts ...grpc.CallOption) (*WaitForDiskSizeChangeResponse, error) {
+	out := new(WaitForDiskSizeChangeResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/WaitForDiskSizeChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) ListDisks(ctx context.Context, in *ListDisksRequest, opts ...grpc.CallOption) (*ListDisksResponse, error) {
+	out := new(ListDisksResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/ListDisks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetSanPolicy(ctx context.Context, in *GetSanPolicyRequest, opts ...grpc.CallOption) (*GetSanPolicyResponse, error) {
+	out := new(GetSanPolicyResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetSanPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) SetSanPolicy(ctx context.Context, in *SetSanPolicyRequest, opts ...grpc.CallOption) (*SetSanPolicyResponse, error) {
+	out := new(SetSanPolicyResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/SetSanPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) SetDiskReadOnly(ctx context.Context, in *SetDiskReadOnlyRequest, opts ...grpc.CallOption) (*SetDiskReadOnlyResponse, error) {
+	out := new(SetDiskReadOnlyResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/SetDiskReadOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskReadOnly(ctx context.Context, in *GetDiskReadOnlyRequest, opts ...grpc.CallOption) (*GetDiskReadOnlyResponse, error) {
+	out := new(GetDiskReadOnlyResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskReadOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskNumberBySerialNumber(ctx context.Context, in *GetDiskNumberBySerialNumberRequest, opts ...grpc.CallOption) (*GetDiskNumberBySerialNumberResponse, error) {
+	out := new(GetDiskNumberBySerialNumberResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskNumberBySerialNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) ListDiskPage83IDs(ctx context.Context, in *ListDiskPage83IDsRequest, opts ...grpc.CallOption) (*ListDiskPage83IDsResponse, error) {
+	out := new(ListDiskPage83IDsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/ListDiskPage83IDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskNumberByPage83ID(ctx context.Context, in *GetDiskNumberByPage83IDRequest, opts ...grpc.CallOption) (*GetDiskNumberByPage83IDResponse, error) {
+	out := new(GetDiskNumberByPage83IDResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskNumberByPage83ID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) WaitForDisk(ctx context.Context, in *WaitForDiskRequest, opts ...grpc.CallOption) (*WaitForDiskResponse, error) {
+	out := new(WaitForDiskResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/WaitForDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) GetDiskIdentity(ctx context.Context, in *GetDiskIdentityRequest, opts ...grpc.CallOption) (*GetDiskIdentityResponse, error) {
+	out := new(GetDiskIdentityResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/GetDiskIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *diskClient) ResolveDiskIdentity(ctx context.Context, in *ResolveDiskIdentityRequest, opts ...grpc.CallOption) (*ResolveDiskIdentityResponse, error) {
+	out := new(ResolveDiskIdentityResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Disk/ResolveDiskIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DiskServer is the server API for Disk service.
+type DiskServer interface {
+	// ListDiskLocations returns locations <Adapter, Bus, Target, LUN ID> of all
+	// disk devices enumerated by the host, the disks can be filtered and paged
+	// through.
+	ListDiskLocations(context.Context, *ListDiskLocationsRequest) (*ListDiskLocationsResponse, error)
+	// GetFreeDiskLocations returns the LUNs that aren't used by any disk on each
+	// SCSI adapter and target of the node, so that the next disk can be attached
+	// at a LUN that doesn't conflict with the disks already attached.
+	GetFreeDiskLocations(context.Context, *GetFreeDiskLocationsRequest) (*GetFreeDiskLocationsResponse, error)
+	// GetDiskNumberByLocation returns the number of the disk at a location
+	// e.g. the LUN of a controller slot where a cloud disk was attached. It
+	// fails with NOT_FOUND if no disk is at the location.
+	GetDiskNumberByLocation(context.Context, *GetDiskNumberByLocationRequest) (*GetDiskNumberByLocationResponse, error)
+	// PartitionDisk initializes a disk device with the GPT or the MBR partition style
+	// (if the disk has not been initialized already) and creates a basic partition
+	// of the requested size at the requested offset (if the disk has not been
+	// partitioned already).
+	PartitionDisk(context.Context, *PartitionDiskRequest) (*PartitionDiskResponse, error)
+	// DeletePartition removes a partition, or all the partitions, of a disk
+	// after removing their access paths (drive letters and mount points), e.g.
+	// to wipe a disk before it's returned to the storage backend or partitioned
+	// with another layout. Only the disks allowed by the disk policy of the
+	// node can be changed.
+	DeletePartition(context.Context, *DeletePartitionRequest) (*DeletePartitionResponse, error)
+	// SetPartitionType changes the GPT partition type of a partition, e.g. to
+	// fix the type of a partition created by another tool. Only the disks
+	// allowed by the disk policy of the node can be changed.
+	SetPartitionType(context.Context, *SetPartitionTypeRequest) (*SetPartitionTypeResponse, error)
+	// WipeDisk clears a disk (Clear-Disk), i.e. removes its partitions and
+	// returns it to the uninitialized (RAW) state, optionally writing zeros to
+	// the whole disk, e.g. to sanitize a local disk before it's given to another
+	// tenant. Only the disks allowed by the disk policy of the node can be wiped.
+	WipeDisk(context.Context, *WipeDiskRequest) (*WipeDiskResponse, error)
+	// ConvertDiskToGPT converts an empty MBR disk to the GPT partition style,
+	// e.g. so that a disk initialized by older tooling can be partitioned and
+	// its volume resized past 2 TiB. It does nothing if the disk is already
+	// GPT and fails with FAILED_PRECONDITION if the disk has partitions or
+	// isn't initialized. Only the disks allowed by the disk policy of the
+	// node can be converted.
+	ConvertDiskToGPT(context.Context, *ConvertDiskToGPTRequest) (*ConvertDiskToGPTResponse, error)
+	// Rescan rescans the SCSI buses so that Windows discovers the disks attached
+	// since the last scan and refreshes the host's storage cache. Only the SCSI
+	// buses are rescanned in strict mode.
+	Rescan(context.Context, *RescanRequest) (*RescanResponse, error)
+	// ListDiskIDs returns a map of DiskID objects where the key is the disk number,
+	// the disks can be filtered and paged through.
+	ListDiskIDs(context.Context, *ListDiskIDsRequest) (*ListDiskIDsResponse, error)
+	// GetDiskStats returns the stats of a disk (currently it returns the disk size).
+	GetDiskStats(context.Context, *GetDiskStatsRequest) (*GetDiskStatsResponse, error)
+	// GetDiskReliabilityCounters returns the reliability (SMART) counters of a
+	// disk (Get-StorageReliabilityCounter) e.g. its read errors, wear and
+	// temperature, so that monitoring agents can alert on failing local disks.
+	// It fails with FAILED_PRECONDITION if the disk doesn't report any, e.g.
+	// for most virtual disks.
+	GetDiskReliabilityCounters(context.Context, *GetDiskReliabilityCountersRequest) (*GetDiskReliabilityCountersResponse, error)
+	// SetDiskState sets the offline/online state of a disk e.g. to bring a
+	// hot-attached disk online before partitioning it, or to take a disk
+	// offline before detaching it, its write cache is flushed first.
+	// Shared (clustered) disks are reserved for the claim token of the request
+	// before they're brought online, see SetDiskStateRequest.claim_token.
+	SetDiskState(context.Context, *SetDiskStateRequest) (*SetDiskStateResponse, error)
+	// GetDiskState gets the offline/online state of a disk, it's available in
+	// strict mode.
+	GetDiskState(context.Context, *GetDiskStateRequest) (*GetDiskStateResponse, error)
+	// WaitForDiskSizeChange rescans the disks until Windows observes the new
+	// size of a disk expanded by the storage backend (e.g. a cloud disk), it
+	// returns once the disk is at least as large as expected so that the
+	// volume of the disk can be resized. It fails with DEADLINE_EXCEEDED if the
+	// disk isn't expanded in time.
+	WaitForDiskSizeChange(context.Context, *WaitForDiskSizeChangeRequest) (*WaitForDiskSizeChangeResponse, error)
+	// ListDisks returns the properties of the disks of the host, the disks can
+	// be filtered and paged through.
+	ListDisks(context.Context, *ListDisksRequest) (*ListDisksResponse, error)
+	// GetSanPolicy gets the SAN policy of the node, it decides whether the
+	// disks attached to the node are brought online automatically.
+	GetSanPolicy(context.Context, *GetSanPolicyRequest) (*GetSanPolicyResponse, error)
+	// SetSanPolicy sets the SAN policy of the node, it applies to the disks
+	// attached afterwards.
+	SetSanPolicy(context.Context, *SetSanPolicyRequest) (*SetSanPolicyResponse, error)
+	// SetDiskReadOnly makes a disk read-only or writable e.g. to clear the
+	// read-only attribute that some SANs set on the LUNs they present, or to
+	// enforce a read-only publish at the disk level. The write cache of the
+	// disk is flushed before it's made read-only.
+	SetDiskReadOnly(context.Context, *SetDiskReadOnlyRequest) (*SetDiskReadOnlyResponse, error)
+	// GetDiskReadOnly gets the read-only attribute of a disk.
+	GetDiskReadOnly(context.Context, *GetDiskReadOnlyRequest) (*GetDiskReadOnlyResponse, error)
+	// GetDiskNumberBySerialNumber returns the number of the disk with a serial
+	// number e.g. the volume identifier of a cloud disk (vSphere, Azure, EBS)
+	// that surfaces as the serial number of the disk. It fails with NOT_FOUND
+	// if no disk has the serial number.
+	GetDiskNumberBySerialNumber(context.Context, *GetDiskNumberBySerialNumberRequest) (*GetDiskNumberBySerialNumberResponse, error)
+	// ListDiskPage83IDs returns all the identifiers of the device
+	// identification VPD page (0x83) of the disks, ListDiskIDs only returns
+	// the first identifier of the logical unit.
+	ListDiskPage83IDs(context.Context, *ListDiskPage83IDsRequest) (*ListDiskPage83IDsResponse, error)
+	// GetDiskNumberByPage83ID returns the number of the disk with a logical
+	// unit identifier of its device identification VPD page e.g. the NAA or
+	// EUI-64 identifier of a backend device. It fails with NOT_FOUND if no
+	// disk has the identifier.
+	GetDiskNumberByPage83ID(context.Context, *GetDiskNumberByPage83IDRequest) (*GetDiskNumberByPage83IDResponse, error)
+	// WaitForDisk waits until a disk with a serial number, a page83 id or at a
+	// location appears e.g. after the disk is attached by ControllerPublish,
+	// rescanning the disks while it polls, and returns its number. It fails
+	// with DEADLINE_EXCEEDED if the disk doesn't appear in time.
+	WaitForDisk(context.Context, *WaitForDiskRequest) (*WaitForDiskResponse, error)
+	// GetDiskIdentity returns the identifiers of a disk that don't change when
+	// the disks are numbered in another order e.g. after a reboot, the caller
+	// stores them to find the disk with ResolveDiskIdentity later. It fails with
+	// FAILED_PRECONDITION if the disk has none of them.
+	GetDiskIdentity(context.Context, *GetDiskIdentityRequest) (*GetDiskIdentityResponse, error)
+	// ResolveDiskIdentity returns the number of the disk with an identity
+	// returned by GetDiskIdentity. It fails with NOT_FOUND if no disk has the
+	// identity.
+	ResolveDiskIdentity(context.Context, *ResolveDiskIdentityRequest) (*ResolveDiskIdentityResponse, error)
+}
+
+// UnimplementedDiskServer can be embedded to have forward compatible implementations.
+type UnimplementedDiskServer struct {
+}
+
+func (*UnimplementedDiskServer) ListDiskLocations(context.Context, *ListDiskLocationsRequest) (*ListDiskLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiskLocations not implemented")
+}
+func (*UnimplementedDiskServer) GetFreeDiskLocations(context.Context, *GetFreeDiskLocationsRequest) (*GetFreeDiskLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFreeDiskLocations not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskNumberByLocation(context.Context, *GetDiskNumberByLocationRequest) (*GetDiskNumberByLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskNumberByLocation not implemented")
+}
+func (*UnimplementedDiskServer) PartitionDisk(context.Context, *PartitionDiskRequest) (*PartitionDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartitionDisk not implemented")
+}
+func (*UnimplementedDiskServer) DeletePartition(context.Context, *DeletePartitionRequest) (*DeletePartitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePartition not implemented")
+}
+func (*UnimplementedDiskServer) SetPartitionType(context.Context, *SetPartitionTypeRequest) (*SetPartitionTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPartitionType not implemented")
+}
+func (*UnimplementedDiskServer) WipeDisk(context.Context, *WipeDiskRequest) (*WipeDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WipeDisk not implemented")
+}
+func (*UnimplementedDiskServer) ConvertDiskToGPT(context.Context, *ConvertDiskToGPTRequest) (*ConvertDiskToGPTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertDiskToGPT not implemented")
+}
+func (*UnimplementedDiskServer) Rescan(context.Context, *RescanRequest) (*RescanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rescan not implemented")
+}
+func (*UnimplementedDiskServer) ListDiskIDs(context.Context, *ListDiskIDsRequest) (*ListDiskIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiskIDs not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskStats(context.Context, *GetDiskStatsRequest) (*GetDiskStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskStats not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskReliabilityCounters(context.Context, *GetDiskReliabilityCountersRequest) (*GetDiskReliabilityCountersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskReliabilityCounters not implemented")
+}
+func (*UnimplementedDiskServer) SetDiskState(context.Context, *SetDiskStateRequest) (*SetDiskStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiskState not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskState(context.Context, *GetDiskStateRequest) (*GetDiskStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskState not implemented")
+}
+func (*UnimplementedDiskServer) WaitForDiskSizeChange(context.Context, *WaitForDiskSizeChangeRequest) (*WaitForDiskSizeChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForDiskSizeChange not implemented")
+}
+func (*UnimplementedDiskServer) ListDisks(context.Context, *ListDisksRequest) (*ListDisksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDisks not implemented")
+}
+func (*UnimplementedDiskServer) GetSanPolicy(context.Context, *GetSanPolicyRequest) (*GetSanPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSanPolicy not implemented")
+}
+func (*UnimplementedDiskServer) SetSanPolicy(context.Context, *SetSanPolicyRequest) (*SetSanPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSanPolicy not implemented")
+}
+func (*UnimplementedDiskServer) SetDiskReadOnly(context.Context, *SetDiskReadOnlyRequest) (*SetDiskReadOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDiskReadOnly not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskReadOnly(context.Context, *GetDiskReadOnlyRequest) (*GetDiskReadOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskReadOnly not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskNumberBySerialNumber(context.Context, *GetDiskNumberBySerialNumberRequest) (*GetDiskNumberBySerialNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskNumberBySerialNumber not implemented")
+}
+func (*UnimplementedDiskServer) ListDiskPage83IDs(context.Context, *ListDiskPage83IDsRequest) (*ListDiskPage83IDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDiskPage83IDs not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskNumberByPage83ID(context.Context, *GetDiskNumberByPage83IDRequest) (*GetDiskNumberByPage83IDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskNumberByPage83ID not implemented")
+}
+func (*UnimplementedDiskServer) WaitForDisk(context.Context, *WaitForDiskRequest) (*WaitForDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitForDisk not implemented")
+}
+func (*UnimplementedDiskServer) GetDiskIdentity(context.Context, *GetDiskIdentityRequest) (*GetDiskIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskIdentity not implemented")
+}
+func (*UnimplementedDiskServer) ResolveDiskIdentity(context.Context, *ResolveDiskIdentityRequest) (*ResolveDiskIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDiskIdentity not implemented")
+}
+
+func RegisterDiskServer(s *grpc.Server, srv DiskServer) {
+	s.RegisterService(&_Disk_serviceDesc, srv)
+}
+
+func _Disk_ListDiskLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiskLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).ListDiskLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/ListDiskLocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).ListDiskLocations(ctx, req.(*ListDiskLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetFreeDiskLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFreeDiskLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetFreeDiskLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetFreeDiskLocations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetFreeDiskLocations(ctx, req.(*GetFreeDiskLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskNumberByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskNumberByLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskNumberByLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskNumberByLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskNumberByLocation(ctx, req.(*GetDiskNumberByLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_PartitionDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PartitionDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).PartitionDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/PartitionDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).PartitionDisk(ctx, req.(*PartitionDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_DeletePartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePartitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).DeletePartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/DeletePartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).DeletePartition(ctx, req.(*DeletePartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_SetPartitionType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPartitionTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).SetPartitionType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/SetPartitionType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).SetPartitionType(ctx, req.(*SetPartitionTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_WipeDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WipeDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).WipeDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/WipeDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).WipeDisk(ctx, req.(*WipeDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_ConvertDiskToGPT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertDiskToGPTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).ConvertDiskToGPT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/ConvertDiskToGPT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).ConvertDiskToGPT(ctx, req.(*ConvertDiskToGPTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_Rescan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).Rescan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/Rescan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).Rescan(ctx, req.(*RescanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_ListDiskIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiskIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).ListDiskIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/ListDiskIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).ListDiskIDs(ctx, req.(*ListDiskIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskStats(ctx, req.(*GetDiskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskReliabilityCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskReliabilityCountersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskReliabilityCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskReliabilityCounters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskReliabilityCounters(ctx, req.(*GetDiskReliabilityCountersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_SetDiskState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDiskStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).SetDiskState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/SetDiskState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).SetDiskState(ctx, req.(*SetDiskStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskState(ctx, req.(*GetDiskStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_WaitForDiskSizeChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForDiskSizeChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).WaitForDiskSizeChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/WaitForDiskSizeChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).WaitForDiskSizeChange(ctx, req.(*WaitForDiskSizeChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_ListDisks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDisksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).ListDisks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/ListDisks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).ListDisks(ctx, req.(*ListDisksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetSanPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSanPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetSanPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetSanPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetSanPolicy(ctx, req.(*GetSanPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_SetSanPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSanPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).SetSanPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/SetSanPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).SetSanPolicy(ctx, req.(*SetSanPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_SetDiskReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDiskReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).SetDiskReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/SetDiskReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).SetDiskReadOnly(ctx, req.(*SetDiskReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskReadOnly(ctx, req.(*GetDiskReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskNumberBySerialNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskNumberBySerialNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskNumberBySerialNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskNumberBySerialNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskNumberBySerialNumber(ctx, req.(*GetDiskNumberBySerialNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_ListDiskPage83IDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDiskPage83IDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).ListDiskPage83IDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/ListDiskPage83IDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).ListDiskPage83IDs(ctx, req.(*ListDiskPage83IDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskNumberByPage83ID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskNumberByPage83IDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskNumberByPage83ID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskNumberByPage83ID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskNumberByPage83ID(ctx, req.(*GetDiskNumberByPage83IDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_WaitForDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitForDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).WaitForDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/WaitForDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).WaitForDisk(ctx, req.(*WaitForDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_GetDiskIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).GetDiskIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/GetDiskIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).GetDiskIdentity(ctx, req.(*GetDiskIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Disk_ResolveDiskIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveDiskIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiskServer).ResolveDiskIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Disk/ResolveDiskIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiskServer).ResolveDiskIdentity(ctx, req.(*ResolveDiskIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Disk_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v2alpha1.Disk",
+	HandlerType: (*DiskServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListDiskLocations",
+			Handler:    _Disk_ListDiskLocations_Handler,
+		},
+		{
+			MethodName: "GetFreeDiskLocations",
+			Handler:    _Disk_GetFreeDiskLocations_Handler,
+		},
+		{
+			MethodName: "GetDiskNumberByLocation",
+			Handler:    _Disk_GetDiskNumberByLocation_Handler,
+		},
+		{
+			MethodName: "PartitionDisk",
+			Handler:    _Disk_PartitionDisk_Handler,
+		},
+		{
+			MethodName: "DeletePartition",
+			Handler:    _Disk_DeletePartition_Handler,
+		},
+		{
+			MethodName: "SetPartitionType",
+			Handler:    _Disk_SetPartitionType_Handler,
+		},
+		{
+			MethodName: "WipeDisk",
+			Handler:    _Disk_WipeDisk_Handler,
+		},
+		{
+			MethodName: "ConvertDiskToGPT",
+			Handler:    _Disk_ConvertDiskToGPT_Handler,
+		},
+		{
+			MethodName: "Rescan",
+			Handler:    _Disk_Rescan_Handler,
+		},
+		{
+			MethodName: "ListDiskIDs",
+			Handler:    _Disk_ListDiskIDs_Handler,
+		},
+		{
+			MethodName: "GetDiskStats",
+			Handler:    _Disk_GetDiskStats_Handler,
+		},
+		{
+			MethodName: "GetDiskReliabilityCounters",
+			Handler:    _Disk_GetDiskReliabilityCounters_Handler,
+		},
+		{
+			MethodName: "SetDiskState",
+			Handler:    _Disk_SetDiskState_Handler,
+		},
+		{
+			MethodName: "GetDiskState",
+			Handler:    _Disk_GetDiskState_Handler,
+		},
+		{
+			MethodName: "WaitForDiskSizeChange",
+			Handler:    _Disk_WaitForDiskSizeChange_Handler,
+		},
+		{
+			MethodName: "ListDisks",
+			Handler:    _Disk_ListDisks_Handler,
+		},
+		{
+			MethodName: "GetSanPolicy",
+			Handler:    _Disk_GetSanPolicy_Handler,
+		},
+		{
+			MethodName: "SetSanPolicy",
+			Handler:    _Disk_SetSanPolicy_Handler,
+		},
+		{
+			MethodName: "SetDiskReadOnly",
+			Handler:    _Disk_SetDiskReadOnly_Handler,
+		},
+		{
+			MethodName: "GetDiskReadOnly",
+			Handler:    _Disk_GetDiskReadOnly_Handler,
+		},
+		{
+			MethodName: "GetDiskNumberBySerialNumber",
+			Handler:    _Disk_GetDiskNumberBySerialNumber_Handler,
+		},
+		{
+			MethodName: "ListDiskPage83IDs",
+			Handler:    _Disk_ListDiskPage83IDs_Handler,
+		},
+		{
+			MethodName: "GetDiskNumberByPage83ID",
+			Handler:    _Disk_GetDiskNumberByPage83ID_Handler,
+		},
+		{
+			MethodName: "WaitForDisk",
+			Handler:    _Disk_WaitForDisk_Handler,
+		},
+		{
+			MethodName: "GetDiskIdentity",
+			Handler:    _Disk_GetDiskIdentity_Handler,
+		},
+		{
+			MethodName: "ResolveDiskIdentity",
+			Handler:    _Disk_ResolveDiskIdentity_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1/api.proto",
+}
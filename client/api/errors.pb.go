@@ -87,6 +87,178 @@ func (x *CmdletError) GetMessage() string {
 	return ""
 }
 
+// ErrorInfo is attached to the status of failed calls whose Windows error is
+// known. Unlike the error message, its code doesn't depend on the display
+// language of Windows.
+type ErrorInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Stable machine-readable code of the error, one of NOT_FOUND,
+	// ACCESS_DENIED, ALREADY_EXISTS, IN_USE, INVALID_PARAMETER, NOT_SUPPORTED,
+	// DEVICE_NOT_READY, WRITE_PROTECTED, DISK_FULL, TIMEOUT,
+	// NETWORK_PATH_NOT_FOUND, AUTHENTICATION_FAILED, CREDENTIAL_CONFLICT or
+	// MOUNT_CONFLICT. New codes may be added. It's empty if the error isn't
+	// known but PowerShell error records are attached.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Windows error the code was mapped from e.g. "5" for a system error code,
+	// "0x80070005" for an HRESULT or "StorageWMI 40001" for a Storage cmdlet
+	// error. It's empty for errors of csi-proxy e.g. MOUNT_CONFLICT.
+	WindowsErrorCode string `protobuf:"bytes,2,opt,name=windows_error_code,json=windowsErrorCode,proto3" json:"windows_error_code,omitempty"`
+	// Error records written by PowerShell when the error comes from a
+	// PowerShell script, in the order they were written.
+	PowershellErrors []*PowerShellErrorRecord `protobuf:"bytes,3,rep,name=powershell_errors,json=powershellErrors,proto3" json:"powershell_errors,omitempty"`
+}
+
+func (x *ErrorInfo) Reset() {
+	*x = ErrorInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorInfo) ProtoMessage() {}
+
+func (x *ErrorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorInfo.ProtoReflect.Descriptor instead.
+func (*ErrorInfo) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ErrorInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ErrorInfo) GetWindowsErrorCode() string {
+	if x != nil {
+		return x.WindowsErrorCode
+	}
+	return ""
+}
+
+func (x *ErrorInfo) GetPowershellErrors() []*PowerShellErrorRecord {
+	if x != nil {
+		return x.PowershellErrors
+	}
+	return nil
+}
+
+// PowerShellErrorRecord is an error record of the error stream of PowerShell.
+type PowerShellErrorRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Message of the error, in the display language of the host.
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// Category of the error e.g. "ObjectNotFound" or "PermissionDenied".
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	// Type name of the exception e.g. "CimJobException".
+	ExceptionType string `protobuf:"bytes,3,opt,name=exception_type,json=exceptionType,proto3" json:"exception_type,omitempty"`
+	// Object the cmdlet was processing e.g. the volume ID, can be empty.
+	TargetObject string `protobuf:"bytes,4,opt,name=target_object,json=targetObject,proto3" json:"target_object,omitempty"`
+	// Cmdlet or script that wrote the error e.g. "Get-Volume", can be empty.
+	Activity string `protobuf:"bytes,5,opt,name=activity,proto3" json:"activity,omitempty"`
+	// Fully qualified ID of the error e.g.
+	// "CmdletizationQuery_NotFound_UniqueId,Get-Volume".
+	ErrorId string `protobuf:"bytes,6,opt,name=error_id,json=errorId,proto3" json:"error_id,omitempty"`
+}
+
+func (x *PowerShellErrorRecord) Reset() {
+	*x = PowerShellErrorRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PowerShellErrorRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PowerShellErrorRecord) ProtoMessage() {}
+
+func (x *PowerShellErrorRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PowerShellErrorRecord.ProtoReflect.Descriptor instead.
+func (*PowerShellErrorRecord) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PowerShellErrorRecord) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PowerShellErrorRecord) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PowerShellErrorRecord) GetExceptionType() string {
+	if x != nil {
+		return x.ExceptionType
+	}
+	return ""
+}
+
+func (x *PowerShellErrorRecord) GetTargetObject() string {
+	if x != nil {
+		return x.TargetObject
+	}
+	return ""
+}
+
+func (x *PowerShellErrorRecord) GetActivity() string {
+	if x != nil {
+		return x.Activity
+	}
+	return ""
+}
+
+func (x *PowerShellErrorRecord) GetErrorId() string {
+	if x != nil {
+		return x.ErrorId
+	}
+	return ""
+}
+
 var File_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto protoreflect.FileDescriptor
 
 var file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_rawDesc = []byte{
@@ -100,10 +272,33 @@ var file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_rawDesc = [
 	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
 	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b,
-	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73,
-	0x69, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61,
-	0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x96, 0x01, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x47, 0x0a, 0x11, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x5f, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x10, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x68,
+	0x65, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x15, 0x50, 0x6f,
+	0x77, 0x65, 0x72, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63,
+	0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x64, 0x42, 0x30, 0x5a, 0x2e,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -118,16 +313,19 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_rawDescGZI
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_rawDescData
 }
 
-var file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_goTypes = []interface{}{
-	(*CmdletError)(nil), // 0: api.CmdletError
+	(*CmdletError)(nil),           // 0: api.CmdletError
+	(*ErrorInfo)(nil),             // 1: api.ErrorInfo
+	(*PowerShellErrorRecord)(nil), // 2: api.PowerShellErrorRecord
 }
 var file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: api.ErrorInfo.powershell_errors:type_name -> api.PowerShellErrorRecord
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_init() }
@@ -148,6 +346,30 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_init() {
 				return nil
 			}
 		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PowerShellErrorRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -155,7 +377,7 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_kubernetes_csi_csi_proxy_client_api_errors_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
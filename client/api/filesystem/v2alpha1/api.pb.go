@@ -24,6 +24,124 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MountPointType is what a path checked by IsMountPoint is.
+type MountPointType int32
+
+const (
+	// A directory that isn't a mount point or a link.
+	MountPointType_DIRECTORY MountPointType = 0
+	// A volume mount point i.e. a directory a volume is mounted at, like
+	// the ones created by MountVolume.
+	MountPointType_VOLUME_MOUNT_POINT MountPointType = 1
+	// A symlink or a junction to a volume mount point, to the root directory
+	// of a volume or to an SMB share, possibly through other links.
+	MountPointType_SYMLINK_TO_VOLUME MountPointType = 2
+	// A symlink or a junction to a directory that isn't a volume, or whose
+	// target doesn't exist anymore.
+	MountPointType_SYMLINK MountPointType = 3
+)
+
+// Enum value maps for MountPointType.
+var (
+	MountPointType_name = map[int32]string{
+		0: "DIRECTORY",
+		1: "VOLUME_MOUNT_POINT",
+		2: "SYMLINK_TO_VOLUME",
+		3: "SYMLINK",
+	}
+	MountPointType_value = map[string]int32{
+		"DIRECTORY":          0,
+		"VOLUME_MOUNT_POINT": 1,
+		"SYMLINK_TO_VOLUME":  2,
+		"SYMLINK":            3,
+	}
+)
+
+func (x MountPointType) Enum() *MountPointType {
+	p := new(MountPointType)
+	*p = x
+	return p
+}
+
+func (x MountPointType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MountPointType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (MountPointType) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_enumTypes[0]
+}
+
+func (x MountPointType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MountPointType.Descriptor instead.
+func (MountPointType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+// AccessRight is the access to a path checked by CheckAccess.
+type AccessRight int32
+
+const (
+	// List the directory or read the file, their attributes and permissions.
+	AccessRight_READ AccessRight = 0
+	// Create files and subdirectories in the directory or write the file,
+	// change their attributes.
+	AccessRight_WRITE AccessRight = 1
+	// READ and WRITE.
+	AccessRight_READ_WRITE AccessRight = 2
+	// READ, WRITE, execute and delete i.e. the Modify permission.
+	AccessRight_MODIFY AccessRight = 3
+)
+
+// Enum value maps for AccessRight.
+var (
+	AccessRight_name = map[int32]string{
+		0: "READ",
+		1: "WRITE",
+		2: "READ_WRITE",
+		3: "MODIFY",
+	}
+	AccessRight_value = map[string]int32{
+		"READ":       0,
+		"WRITE":      1,
+		"READ_WRITE": 2,
+		"MODIFY":     3,
+	}
+)
+
+func (x AccessRight) Enum() *AccessRight {
+	p := new(AccessRight)
+	*p = x
+	return p
+}
+
+func (x AccessRight) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccessRight) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (AccessRight) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_enumTypes[1]
+}
+
+func (x AccessRight) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccessRight.Descriptor instead.
+func (AccessRight) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
 type PathExistsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -560,6 +678,15 @@ type IsSymlinkRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	// The path whose existence as a symlink we want to check in the host's filesystem.
+	//
+	// Restrictions:
+	// Only absolute path (indicated by a drive letter prefix: e.g. "C:\") is accepted.
+	// The path prefix needs to match the paths specified either as
+	// kubelet-csi-plugins-path or as kubelet-pod-path parameters of csi-proxy.
+	// UNC paths of the form "\\server\share\path\file" are not allowed.
+	// All directory separators need to be backslash character: "\".
+	// Characters: .. / : | ? * in the path are not allowed.
+	// Maximum path length will be capped to 260 characters.
 	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
@@ -650,208 +777,1075 @@ func (x *IsSymlinkResponse) GetIsSymlink() bool {
 	return false
 }
 
-var File_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto protoreflect.FileDescriptor
+type CreateJunctionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDesc = []byte{
-	0x0a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x32, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
-	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x27, 0x0a, 0x11, 0x50, 0x61, 0x74, 0x68,
-	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x22, 0x2c, 0x0a, 0x12, 0x50, 0x61, 0x74, 0x68, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22,
-	0x22, 0x0a, 0x0c, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x22, 0x0f, 0x0a, 0x0d, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x0c, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x0f,
-	0x0a, 0x0d, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2a, 0x0a, 0x14, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x52,
-	0x6d, 0x64, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79,
-	0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x17,
-	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x49, 0x73, 0x53, 0x79, 0x6d,
-	0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
-	0x32, 0x0a, 0x11, 0x49, 0x73, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x79, 0x6d, 0x6c, 0x69,
-	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x79, 0x6d, 0x6c,
-	0x69, 0x6e, 0x6b, 0x32, 0xbf, 0x03, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x12, 0x49, 0x0a, 0x0a, 0x50, 0x61, 0x74, 0x68, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
-	0x12, 0x1b, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68,
-	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x45, 0x78, 0x69,
-	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
-	0x05, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x12, 0x16, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x52, 0x6d, 0x64,
-	0x69, 0x72, 0x12, 0x16, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6d,
-	0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x32, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1e, 0x2e, 0x76, 0x32, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6d, 0x6c,
-	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x32, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6d, 0x6c,
-	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a,
-	0x09, 0x49, 0x73, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1a, 0x2e, 0x76, 0x32, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x49, 0x73, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63,
-	0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x2f, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	// The path of the existing directory the junction points to.
+	//
+	// Restrictions:
+	// Only absolute path (indicated by a drive letter prefix: e.g. "C:\") is accepted.
+	// The path prefix needs to match the paths specified either as
+	// kubelet-csi-plugins-path or as kubelet-pod-path parameters of csi-proxy.
+	// UNC paths of the form "\\server\share\path\file" are not allowed,
+	// junctions can only point to local directories.
+	// All directory separators need to be backslash character: "\".
+	// Characters: .. / : | ? * in the path are not allowed.
+	// Maximum path length will be capped to 260 characters.
+	SourcePath string `protobuf:"bytes,1,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	// The path of the junction point created in the host's filesystem.
+	//
+	// Restrictions:
+	// The restrictions of source_path apply.
+	// target_path cannot already exist in the host filesystem, its parent
+	// directory needs to exist.
+	TargetPath string `protobuf:"bytes,2,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
 }
 
-var (
-	file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescOnce sync.Once
-	file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDesc
-)
+func (x *CreateJunctionRequest) Reset() {
+	*x = CreateJunctionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP() []byte {
-	file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescOnce.Do(func() {
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescData)
-	})
-	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescData
+func (x *CreateJunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
-var file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_goTypes = []interface{}{
-	(*PathExistsRequest)(nil),     // 0: v2alpha1.PathExistsRequest
-	(*PathExistsResponse)(nil),    // 1: v2alpha1.PathExistsResponse
-	(*MkdirRequest)(nil),          // 2: v2alpha1.MkdirRequest
-	(*MkdirResponse)(nil),         // 3: v2alpha1.MkdirResponse
-	(*RmdirRequest)(nil),          // 4: v2alpha1.RmdirRequest
-	(*RmdirResponse)(nil),         // 5: v2alpha1.RmdirResponse
-	(*RmdirContentsRequest)(nil),  // 6: v2alpha1.RmdirContentsRequest
-	(*RmdirContentsResponse)(nil), // 7: v2alpha1.RmdirContentsResponse
-	(*CreateSymlinkRequest)(nil),  // 8: v2alpha1.CreateSymlinkRequest
-	(*CreateSymlinkResponse)(nil), // 9: v2alpha1.CreateSymlinkResponse
-	(*IsSymlinkRequest)(nil),      // 10: v2alpha1.IsSymlinkRequest
-	(*IsSymlinkResponse)(nil),     // 11: v2alpha1.IsSymlinkResponse
+func (*CreateJunctionRequest) ProtoMessage() {}
+
+func (x *CreateJunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_depIdxs = []int32{
-	0,  // 0: v2alpha1.Filesystem.PathExists:input_type -> v2alpha1.PathExistsRequest
-	2,  // 1: v2alpha1.Filesystem.Mkdir:input_type -> v2alpha1.MkdirRequest
-	4,  // 2: v2alpha1.Filesystem.Rmdir:input_type -> v2alpha1.RmdirRequest
-	6,  // 3: v2alpha1.Filesystem.RmdirContents:input_type -> v2alpha1.RmdirContentsRequest
-	8,  // 4: v2alpha1.Filesystem.CreateSymlink:input_type -> v2alpha1.CreateSymlinkRequest
-	10, // 5: v2alpha1.Filesystem.IsSymlink:input_type -> v2alpha1.IsSymlinkRequest
-	1,  // 6: v2alpha1.Filesystem.PathExists:output_type -> v2alpha1.PathExistsResponse
-	3,  // 7: v2alpha1.Filesystem.Mkdir:output_type -> v2alpha1.MkdirResponse
-	5,  // 8: v2alpha1.Filesystem.Rmdir:output_type -> v2alpha1.RmdirResponse
-	7,  // 9: v2alpha1.Filesystem.RmdirContents:output_type -> v2alpha1.RmdirContentsResponse
-	9,  // 10: v2alpha1.Filesystem.CreateSymlink:output_type -> v2alpha1.CreateSymlinkResponse
-	11, // 11: v2alpha1.Filesystem.IsSymlink:output_type -> v2alpha1.IsSymlinkResponse
-	6,  // [6:12] is the sub-list for method output_type
-	0,  // [0:6] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use CreateJunctionRequest.ProtoReflect.Descriptor instead.
+func (*CreateJunctionRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{12}
 }
 
-func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_init() }
-func file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_init() {
-	if File_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto != nil {
-		return
+func (x *CreateJunctionRequest) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PathExistsRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PathExistsResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MkdirRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MkdirResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *CreateJunctionRequest) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+type CreateJunctionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateJunctionResponse) Reset() {
+	*x = CreateJunctionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateJunctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJunctionResponse) ProtoMessage() {}
+
+func (x *CreateJunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RmdirRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJunctionResponse.ProtoReflect.Descriptor instead.
+func (*CreateJunctionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{13}
+}
+
+type ResolveJunctionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path of the junction point in the host's filesystem, the restrictions
+	// of CreateJunctionRequest.source_path apply.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ResolveJunctionRequest) Reset() {
+	*x = ResolveJunctionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveJunctionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveJunctionRequest) ProtoMessage() {}
+
+func (x *ResolveJunctionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RmdirResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveJunctionRequest.ProtoReflect.Descriptor instead.
+func (*ResolveJunctionRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ResolveJunctionRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ResolveJunctionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The absolute path of the directory the junction points to e.g.
+	// "C:\var\lib\kubelet\plugins\pv1". A junction to a volume without a
+	// drive letter points to its volume GUID path e.g. "\\?\Volume{GUID}\".
+	SourcePath string `protobuf:"bytes,1,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+}
+
+func (x *ResolveJunctionResponse) Reset() {
+	*x = ResolveJunctionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveJunctionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveJunctionResponse) ProtoMessage() {}
+
+func (x *ResolveJunctionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveJunctionResponse.ProtoReflect.Descriptor instead.
+func (*ResolveJunctionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ResolveJunctionResponse) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+type IsMountPointRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path to check in the host's filesystem, with the restrictions of
+	// IsSymlinkRequest.path. It must exist.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *IsMountPointRequest) Reset() {
+	*x = IsMountPointRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsMountPointRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMountPointRequest) ProtoMessage() {}
+
+func (x *IsMountPointRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMountPointRequest.ProtoReflect.Descriptor instead.
+func (*IsMountPointRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *IsMountPointRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type IsMountPointResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicates whether the path is a VOLUME_MOUNT_POINT or a SYMLINK_TO_VOLUME.
+	IsMountPoint bool `protobuf:"varint,1,opt,name=is_mount_point,json=isMountPoint,proto3" json:"is_mount_point,omitempty"`
+	// What the path is.
+	Type MountPointType `protobuf:"varint,2,opt,name=type,proto3,enum=v2alpha1.MountPointType" json:"type,omitempty"`
+}
+
+func (x *IsMountPointResponse) Reset() {
+	*x = IsMountPointResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsMountPointResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsMountPointResponse) ProtoMessage() {}
+
+func (x *IsMountPointResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsMountPointResponse.ProtoReflect.Descriptor instead.
+func (*IsMountPointResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IsMountPointResponse) GetIsMountPoint() bool {
+	if x != nil {
+		return x.IsMountPoint
+	}
+	return false
+}
+
+func (x *IsMountPointResponse) GetType() MountPointType {
+	if x != nil {
+		return x.Type
+	}
+	return MountPointType_DIRECTORY
+}
+
+type CheckAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path whose access is checked, with the restrictions of PathExists. It
+	// must exist.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Security identifier of the identity e.g. S-1-5-32-545 (BUILTIN\Users),
+	// its access includes the access of the groups it's a member of.
+	Sid string `protobuf:"bytes,2,opt,name=sid,proto3" json:"sid,omitempty"`
+	// Access to check.
+	DesiredAccess AccessRight `protobuf:"varint,3,opt,name=desired_access,json=desiredAccess,proto3,enum=v2alpha1.AccessRight" json:"desired_access,omitempty"`
+}
+
+func (x *CheckAccessRequest) Reset() {
+	*x = CheckAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessRequest) ProtoMessage() {}
+
+func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CheckAccessRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *CheckAccessRequest) GetDesiredAccess() AccessRight {
+	if x != nil {
+		return x.DesiredAccess
+	}
+	return AccessRight_READ
+}
+
+type CheckAccessResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicates whether the identity has the requested access to the path.
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// Windows access mask of the rights of the identity to the path e.g.
+	// 0x1F01FF (full control), for diagnostics.
+	GrantedAccess uint32 `protobuf:"varint,2,opt,name=granted_access,json=grantedAccess,proto3" json:"granted_access,omitempty"`
+}
+
+func (x *CheckAccessResponse) Reset() {
+	*x = CheckAccessResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessResponse) ProtoMessage() {}
+
+func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckAccessResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckAccessResponse) GetGrantedAccess() uint32 {
+	if x != nil {
+		return x.GrantedAccess
+	}
+	return 0
+}
+
+type GetACLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path whose ACL is read, with the restrictions of PathExists. It must
+	// exist.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetACLRequest) Reset() {
+	*x = GetACLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetACLRequest) ProtoMessage() {}
+
+func (x *GetACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetACLRequest.ProtoReflect.Descriptor instead.
+func (*GetACLRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetACLRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetACLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The owner, the group and the DACL of the path in the Security Descriptor
+	// Definition Language e.g. "O:BAG:SYD:PAI(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)".
+	Sddl string `protobuf:"bytes,1,opt,name=sddl,proto3" json:"sddl,omitempty"`
+}
+
+func (x *GetACLResponse) Reset() {
+	*x = GetACLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetACLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetACLResponse) ProtoMessage() {}
+
+func (x *GetACLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetACLResponse.ProtoReflect.Descriptor instead.
+func (*GetACLResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetACLResponse) GetSddl() string {
+	if x != nil {
+		return x.Sddl
+	}
+	return ""
+}
+
+// AccessGrant is access granted to an identity by SetACL.
+type AccessGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Security identifier of the identity e.g. S-1-5-32-545 (BUILTIN\Users) or
+	// S-1-5-93-2-1 (ContainerAdministrator).
+	Sid string `protobuf:"bytes,1,opt,name=sid,proto3" json:"sid,omitempty"`
+	// Access granted to the identity.
+	Access AccessRight `protobuf:"varint,2,opt,name=access,proto3,enum=v2alpha1.AccessRight" json:"access,omitempty"`
+}
+
+func (x *AccessGrant) Reset() {
+	*x = AccessGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccessGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessGrant) ProtoMessage() {}
+
+func (x *AccessGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessGrant.ProtoReflect.Descriptor instead.
+func (*AccessGrant) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AccessGrant) GetSid() string {
+	if x != nil {
+		return x.Sid
+	}
+	return ""
+}
+
+func (x *AccessGrant) GetAccess() AccessRight {
+	if x != nil {
+		return x.Access
+	}
+	return AccessRight_READ
+}
+
+type SetACLRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path whose ACL is set, with the restrictions of PathExists. It must
+	// exist.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// SDDL string whose DACL replaces the DACL of the path e.g.
+	// "D:PAI(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)(A;OICI;0x1301bf;;;BU)", the P flag
+	// blocks the inheritance of the ACEs of the parent directory. Its owner and
+	// group are ignored. Exactly one of sddl and grants is set.
+	Sddl string `protobuf:"bytes,2,opt,name=sddl,proto3" json:"sddl,omitempty"`
+	// Access added to the DACL of the path, it's merged with the access the
+	// identities already have.
+	Grants []*AccessGrant `protobuf:"bytes,3,rep,name=grants,proto3" json:"grants,omitempty"`
+}
+
+func (x *SetACLRequest) Reset() {
+	*x = SetACLRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetACLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetACLRequest) ProtoMessage() {}
+
+func (x *SetACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetACLRequest.ProtoReflect.Descriptor instead.
+func (*SetACLRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetACLRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SetACLRequest) GetSddl() string {
+	if x != nil {
+		return x.Sddl
+	}
+	return ""
+}
+
+func (x *SetACLRequest) GetGrants() []*AccessGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+type SetACLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetACLResponse) Reset() {
+	*x = SetACLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetACLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetACLResponse) ProtoMessage() {}
+
+func (x *SetACLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetACLResponse.ProtoReflect.Descriptor instead.
+func (*SetACLResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP(), []int{24}
+}
+
+var File_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto protoreflect.FileDescriptor
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDesc = []byte{
+	0x0a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0x27, 0x0a, 0x11, 0x50, 0x61, 0x74, 0x68,
+	0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x2c, 0x0a, 0x12, 0x50, 0x61, 0x74, 0x68, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22,
+	0x22, 0x0a, 0x0c, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x22, 0x0f, 0x0a, 0x0d, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x0a, 0x0c, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x0f,
+	0x0a, 0x0d, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2a, 0x0a, 0x14, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x52,
+	0x6d, 0x64, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79,
+	0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x17,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x0a, 0x10, 0x49, 0x73, 0x53, 0x79, 0x6d,
+	0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0x32, 0x0a, 0x11, 0x49, 0x73, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x79, 0x6d, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x79, 0x6d, 0x6c,
+	0x69, 0x6e, 0x6b, 0x22, 0x59, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x18,
+	0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x4a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x4a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x22, 0x29, 0x0a, 0x13, 0x49, 0x73, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x6a, 0x0a,
+	0x14, 0x49, 0x73, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69,
+	0x73, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x78, 0x0a, 0x12, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x0e, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x22, 0x56, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x23, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x22, 0x24, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x64, 0x64, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x64, 0x64, 0x6c, 0x22, 0x4e, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x73, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x66, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x41, 0x43, 0x4c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x64, 0x64, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x64, 0x64, 0x6c, 0x12,
+	0x2d, 0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x10,
+	0x0a, 0x0e, 0x53, 0x65, 0x74, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2a, 0x5b, 0x0a, 0x0e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x59, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x4d, 0x4f, 0x55, 0x4e,
+	0x54, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x59, 0x4d,
+	0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x4f, 0x5f, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x59, 0x4d, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x03, 0x2a, 0x3e, 0x0a,
+	0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x08, 0x0a, 0x04,
+	0x52, 0x45, 0x41, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x10,
+	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x10, 0x03, 0x32, 0x8d, 0x07,
+	0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x49, 0x0a, 0x0a,
+	0x50, 0x61, 0x74, 0x68, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x4d, 0x6b, 0x64, 0x69, 0x72,
+	0x12, 0x16, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6b, 0x64, 0x69,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x05, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x12, 0x16, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x6d, 0x64, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x52, 0x0a, 0x0d, 0x52, 0x6d, 0x64, 0x69, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6d, 0x64, 0x69,
+	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x6d, 0x64, 0x69,
+	0x72, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6d,
+	0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x09, 0x49, 0x73, 0x53, 0x79, 0x6d,
+	0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x1a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x49, 0x73, 0x53, 0x79, 0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x53, 0x79,
+	0x6d, 0x6c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x0c, 0x49, 0x73, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x75,
+	0x6e, 0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x4d, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x4a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4a, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4a,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x1c, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x41, 0x43, 0x4c, 0x12, 0x17, 0x2e, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x06, 0x53, 0x65, 0x74, 0x41, 0x43, 0x4c, 0x12, 0x17, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x41, 0x43, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x44, 0x5a,
+	0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65,
+	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescOnce sync.Once
+	file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDesc
+)
+
+func file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescGZIP() []byte {
+	file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescOnce.Do(func() {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescData)
+	})
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDescData
+}
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_goTypes = []interface{}{
+	(MountPointType)(0),             // 0: v2alpha1.MountPointType
+	(AccessRight)(0),                // 1: v2alpha1.AccessRight
+	(*PathExistsRequest)(nil),       // 2: v2alpha1.PathExistsRequest
+	(*PathExistsResponse)(nil),      // 3: v2alpha1.PathExistsResponse
+	(*MkdirRequest)(nil),            // 4: v2alpha1.MkdirRequest
+	(*MkdirResponse)(nil),           // 5: v2alpha1.MkdirResponse
+	(*RmdirRequest)(nil),            // 6: v2alpha1.RmdirRequest
+	(*RmdirResponse)(nil),           // 7: v2alpha1.RmdirResponse
+	(*RmdirContentsRequest)(nil),    // 8: v2alpha1.RmdirContentsRequest
+	(*RmdirContentsResponse)(nil),   // 9: v2alpha1.RmdirContentsResponse
+	(*CreateSymlinkRequest)(nil),    // 10: v2alpha1.CreateSymlinkRequest
+	(*CreateSymlinkResponse)(nil),   // 11: v2alpha1.CreateSymlinkResponse
+	(*IsSymlinkRequest)(nil),        // 12: v2alpha1.IsSymlinkRequest
+	(*IsSymlinkResponse)(nil),       // 13: v2alpha1.IsSymlinkResponse
+	(*CreateJunctionRequest)(nil),   // 14: v2alpha1.CreateJunctionRequest
+	(*CreateJunctionResponse)(nil),  // 15: v2alpha1.CreateJunctionResponse
+	(*ResolveJunctionRequest)(nil),  // 16: v2alpha1.ResolveJunctionRequest
+	(*ResolveJunctionResponse)(nil), // 17: v2alpha1.ResolveJunctionResponse
+	(*IsMountPointRequest)(nil),     // 18: v2alpha1.IsMountPointRequest
+	(*IsMountPointResponse)(nil),    // 19: v2alpha1.IsMountPointResponse
+	(*CheckAccessRequest)(nil),      // 20: v2alpha1.CheckAccessRequest
+	(*CheckAccessResponse)(nil),     // 21: v2alpha1.CheckAccessResponse
+	(*GetACLRequest)(nil),           // 22: v2alpha1.GetACLRequest
+	(*GetACLResponse)(nil),          // 23: v2alpha1.GetACLResponse
+	(*AccessGrant)(nil),             // 24: v2alpha1.AccessGrant
+	(*SetACLRequest)(nil),           // 25: v2alpha1.SetACLRequest
+	(*SetACLResponse)(nil),          // 26: v2alpha1.SetACLResponse
+}
+var file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_depIdxs = []int32{
+	0,  // 0: v2alpha1.IsMountPointResponse.type:type_name -> v2alpha1.MountPointType
+	1,  // 1: v2alpha1.CheckAccessRequest.desired_access:type_name -> v2alpha1.AccessRight
+	1,  // 2: v2alpha1.AccessGrant.access:type_name -> v2alpha1.AccessRight
+	24, // 3: v2alpha1.SetACLRequest.grants:type_name -> v2alpha1.AccessGrant
+	2,  // 4: v2alpha1.Filesystem.PathExists:input_type -> v2alpha1.PathExistsRequest
+	4,  // 5: v2alpha1.Filesystem.Mkdir:input_type -> v2alpha1.MkdirRequest
+	6,  // 6: v2alpha1.Filesystem.Rmdir:input_type -> v2alpha1.RmdirRequest
+	8,  // 7: v2alpha1.Filesystem.RmdirContents:input_type -> v2alpha1.RmdirContentsRequest
+	10, // 8: v2alpha1.Filesystem.CreateSymlink:input_type -> v2alpha1.CreateSymlinkRequest
+	12, // 9: v2alpha1.Filesystem.IsSymlink:input_type -> v2alpha1.IsSymlinkRequest
+	18, // 10: v2alpha1.Filesystem.IsMountPoint:input_type -> v2alpha1.IsMountPointRequest
+	14, // 11: v2alpha1.Filesystem.CreateJunction:input_type -> v2alpha1.CreateJunctionRequest
+	16, // 12: v2alpha1.Filesystem.ResolveJunction:input_type -> v2alpha1.ResolveJunctionRequest
+	20, // 13: v2alpha1.Filesystem.CheckAccess:input_type -> v2alpha1.CheckAccessRequest
+	22, // 14: v2alpha1.Filesystem.GetACL:input_type -> v2alpha1.GetACLRequest
+	25, // 15: v2alpha1.Filesystem.SetACL:input_type -> v2alpha1.SetACLRequest
+	3,  // 16: v2alpha1.Filesystem.PathExists:output_type -> v2alpha1.PathExistsResponse
+	5,  // 17: v2alpha1.Filesystem.Mkdir:output_type -> v2alpha1.MkdirResponse
+	7,  // 18: v2alpha1.Filesystem.Rmdir:output_type -> v2alpha1.RmdirResponse
+	9,  // 19: v2alpha1.Filesystem.RmdirContents:output_type -> v2alpha1.RmdirContentsResponse
+	11, // 20: v2alpha1.Filesystem.CreateSymlink:output_type -> v2alpha1.CreateSymlinkResponse
+	13, // 21: v2alpha1.Filesystem.IsSymlink:output_type -> v2alpha1.IsSymlinkResponse
+	19, // 22: v2alpha1.Filesystem.IsMountPoint:output_type -> v2alpha1.IsMountPointResponse
+	15, // 23: v2alpha1.Filesystem.CreateJunction:output_type -> v2alpha1.CreateJunctionResponse
+	17, // 24: v2alpha1.Filesystem.ResolveJunction:output_type -> v2alpha1.ResolveJunctionResponse
+	21, // 25: v2alpha1.Filesystem.CheckAccess:output_type -> v2alpha1.CheckAccessResponse
+	23, // 26: v2alpha1.Filesystem.GetACL:output_type -> v2alpha1.GetACLResponse
+	26, // 27: v2alpha1.Filesystem.SetACL:output_type -> v2alpha1.SetACLResponse
+	16, // [16:28] is the sub-list for method output_type
+	4,  // [4:16] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_init() }
+func file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_init() {
+	if File_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathExistsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathExistsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MkdirRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MkdirResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RmdirRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RmdirResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RmdirContentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RmdirContentsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSymlinkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
 			default:
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RmdirContentsRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSymlinkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -862,8 +1856,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RmdirContentsResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsSymlinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -874,8 +1868,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSymlinkRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsSymlinkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -886,8 +1880,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSymlinkResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJunctionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -898,8 +1892,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsSymlinkRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJunctionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -910,8 +1904,128 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsSymlinkResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveJunctionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveJunctionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsMountPointRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsMountPointResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAccessRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckAccessResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetACLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetACLResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetACLRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetACLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -928,13 +2042,14 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   12,
+			NumEnums:      2,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_goTypes,
 		DependencyIndexes: file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_depIdxs,
+		EnumInfos:         file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_enumTypes,
 		MessageInfos:      file_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto_msgTypes,
 	}.Build()
 	File_github_com_kubernetes_csi_csi_proxy_client_api_filesystem_v2alpha1_api_proto = out.File
@@ -971,6 +2086,34 @@ type FilesystemClient interface {
 	CreateSymlink(ctx context.Context, in *CreateSymlinkRequest, opts ...grpc.CallOption) (*CreateSymlinkResponse, error)
 	// IsSymlink checks if a given path is a symlink.
 	IsSymlink(ctx context.Context, in *IsSymlinkRequest, opts ...grpc.CallOption) (*IsSymlinkResponse, error)
+	// IsMountPoint checks if a path is a volume mount point, a symlink or a
+	// junction to a volume (e.g. a target path published with CreateSymlink from
+	// a staging path the volume is mounted at) or a plain directory, so that
+	// NodeStageVolume and NodePublishVolume can tell if they already ran.
+	IsMountPoint(ctx context.Context, in *IsMountPointRequest, opts ...grpc.CallOption) (*IsMountPointResponse, error)
+	// CreateJunction creates an NTFS junction point called target_path that
+	// points to the directory source_path in the host filesystem, like
+	// `mklink /J`. A junction is an alternative to CreateSymlink for the
+	// container runtimes that handle junctions more reliably, IsSymlink also
+	// returns true for a junction and Rmdir unlinks it.
+	CreateJunction(ctx context.Context, in *CreateJunctionRequest, opts ...grpc.CallOption) (*CreateJunctionResponse, error)
+	// ResolveJunction returns the directory a junction point points to, it fails
+	// if the path isn't a junction point.
+	ResolveJunction(ctx context.Context, in *ResolveJunctionRequest, opts ...grpc.CallOption) (*ResolveJunctionResponse, error)
+	// CheckAccess checks if an identity (e.g. the user that runs the containers
+	// of a pod) has the requested access to a path according to the security
+	// descriptor of the path, so that drivers can check that the pod will be
+	// able to use its volume before the pod starts.
+	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error)
+	// GetACL returns the owner, the group and the discretionary access control
+	// list (DACL) of a path as an SDDL string.
+	GetACL(ctx context.Context, in *GetACLRequest, opts ...grpc.CallOption) (*GetACLResponse, error)
+	// SetACL replaces the DACL of a path with the DACL of an SDDL string, or
+	// grants access to a path to identities (e.g. the user that runs the
+	// containers of a pod) after a volume is mounted at it, the Windows
+	// equivalent of fsGroup. The granted access is inherited by the files and
+	// subdirectories of the path.
+	SetACL(ctx context.Context, in *SetACLRequest, opts ...grpc.CallOption) (*SetACLResponse, error)
 }
 
 type filesystemClient struct {
@@ -1035,6 +2178,60 @@ func (c *filesystemClient) IsSymlink(ctx context.Context, in *IsSymlinkRequest,
 	return out, nil
 }
 
+func (c *filesystemClient) IsMountPoint(ctx context.Context, in *IsMountPointRequest, opts ...grpc.CallOption) (*IsMountPointResponse, error) {
+	out := new(IsMountPointResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Filesystem/IsMountPoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesystemClient) CreateJunction(ctx context.Context, in *CreateJunctionRequest, opts ...grpc.CallOption) (*CreateJunctionResponse, error) {
+	out := new(CreateJunctionResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Filesystem/CreateJunction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesystemClient) ResolveJunction(ctx context.Context, in *ResolveJunctionRequest, opts ...grpc.CallOption) (*ResolveJunctionResponse, error) {
+	out := new(ResolveJunctionResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Filesystem/ResolveJunction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesystemClient) CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error) {
+	out := new(CheckAccessResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Filesystem/CheckAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesystemClient) GetACL(ctx context.Context, in *GetACLRequest, opts ...grpc.CallOption) (*GetACLResponse, error) {
+	out := new(GetACLResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Filesystem/GetACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *filesystemClient) SetACL(ctx context.Context, in *SetACLRequest, opts ...grpc.CallOption) (*SetACLResponse, error) {
+	out := new(SetACLResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Filesystem/SetACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FilesystemServer is the server API for Filesystem service.
 type FilesystemServer interface {
 	// PathExists checks if the requested path exists in the host filesystem.
@@ -1053,6 +2250,34 @@ type FilesystemServer interface {
 	CreateSymlink(context.Context, *CreateSymlinkRequest) (*CreateSymlinkResponse, error)
 	// IsSymlink checks if a given path is a symlink.
 	IsSymlink(context.Context, *IsSymlinkRequest) (*IsSymlinkResponse, error)
+	// IsMountPoint checks if a path is a volume mount point, a symlink or a
+	// junction to a volume (e.g. a target path published with CreateSymlink from
+	// a staging path the volume is mounted at) or a plain directory, so that
+	// NodeStageVolume and NodePublishVolume can tell if they already ran.
+	IsMountPoint(context.Context, *IsMountPointRequest) (*IsMountPointResponse, error)
+	// CreateJunction creates an NTFS junction point called target_path that
+	// points to the directory source_path in the host filesystem, like
+	// `mklink /J`. A junction is an alternative to CreateSymlink for the
+	// container runtimes that handle junctions more reliably, IsSymlink also
+	// returns true for a junction and Rmdir unlinks it.
+	CreateJunction(context.Context, *CreateJunctionRequest) (*CreateJunctionResponse, error)
+	// ResolveJunction returns the directory a junction point points to, it fails
+	// if the path isn't a junction point.
+	ResolveJunction(context.Context, *ResolveJunctionRequest) (*ResolveJunctionResponse, error)
+	// CheckAccess checks if an identity (e.g. the user that runs the containers
+	// of a pod) has the requested access to a path according to the security
+	// descriptor of the path, so that drivers can check that the pod will be
+	// able to use its volume before the pod starts.
+	CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error)
+	// GetACL returns the owner, the group and the discretionary access control
+	// list (DACL) of a path as an SDDL string.
+	GetACL(context.Context, *GetACLRequest) (*GetACLResponse, error)
+	// SetACL replaces the DACL of a path with the DACL of an SDDL string, or
+	// grants access to a path to identities (e.g. the user that runs the
+	// containers of a pod) after a volume is mounted at it, the Windows
+	// equivalent of fsGroup. The granted access is inherited by the files and
+	// subdirectories of the path.
+	SetACL(context.Context, *SetACLRequest) (*SetACLResponse, error)
 }
 
 // UnimplementedFilesystemServer can be embedded to have forward compatible implementations.
@@ -1077,6 +2302,24 @@ func (*UnimplementedFilesystemServer) CreateSymlink(context.Context, *CreateSyml
 func (*UnimplementedFilesystemServer) IsSymlink(context.Context, *IsSymlinkRequest) (*IsSymlinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsSymlink not implemented")
 }
+func (*UnimplementedFilesystemServer) IsMountPoint(context.Context, *IsMountPointRequest) (*IsMountPointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsMountPoint not implemented")
+}
+func (*UnimplementedFilesystemServer) CreateJunction(context.Context, *CreateJunctionRequest) (*CreateJunctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJunction not implemented")
+}
+func (*UnimplementedFilesystemServer) ResolveJunction(context.Context, *ResolveJunctionRequest) (*ResolveJunctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveJunction not implemented")
+}
+func (*UnimplementedFilesystemServer) CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
+func (*UnimplementedFilesystemServer) GetACL(context.Context, *GetACLRequest) (*GetACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetACL not implemented")
+}
+func (*UnimplementedFilesystemServer) SetACL(context.Context, *SetACLRequest) (*SetACLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetACL not implemented")
+}
 
 func RegisterFilesystemServer(s *grpc.Server, srv FilesystemServer) {
 	s.RegisterService(&_Filesystem_serviceDesc, srv)
@@ -1190,6 +2433,114 @@ func _Filesystem_IsSymlink_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Filesystem_IsMountPoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsMountPointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesystemServer).IsMountPoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Filesystem/IsMountPoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesystemServer).IsMountPoint(ctx, req.(*IsMountPointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Filesystem_CreateJunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesystemServer).CreateJunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Filesystem/CreateJunction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesystemServer).CreateJunction(ctx, req.(*CreateJunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Filesystem_ResolveJunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveJunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesystemServer).ResolveJunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Filesystem/ResolveJunction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesystemServer).ResolveJunction(ctx, req.(*ResolveJunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Filesystem_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesystemServer).CheckAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Filesystem/CheckAccess",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesystemServer).CheckAccess(ctx, req.(*CheckAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Filesystem_GetACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesystemServer).GetACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Filesystem/GetACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesystemServer).GetACL(ctx, req.(*GetACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Filesystem_SetACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetACLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FilesystemServer).SetACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Filesystem/SetACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FilesystemServer).SetACL(ctx, req.(*SetACLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Filesystem_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v2alpha1.Filesystem",
 	HandlerType: (*FilesystemServer)(nil),
@@ -1218,6 +2569,30 @@ var _Filesystem_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsSymlink",
 			Handler:    _Filesystem_IsSymlink_Handler,
 		},
+		{
+			MethodName: "IsMountPoint",
+			Handler:    _Filesystem_IsMountPoint_Handler,
+		},
+		{
+			MethodName: "CreateJunction",
+			Handler:    _Filesystem_CreateJunction_Handler,
+		},
+		{
+			MethodName: "ResolveJunction",
+			Handler:    _Filesystem_ResolveJunction_Handler,
+		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _Filesystem_CheckAccess_Handler,
+		},
+		{
+			MethodName: "GetACL",
+			Handler:    _Filesystem_GetACL_Handler,
+		},
+		{
+			MethodName: "SetACL",
+			Handler:    _Filesystem_SetACL_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/kubernetes-csi/csi-proxy/client/api/filesystem/v2alpha1/api.proto",
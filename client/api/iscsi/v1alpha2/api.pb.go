@@ -60,7 +60,9 @@ func (AuthenticationType) EnumDescriptor() ([]byte, []int) {
 // TargetPortal is an address and port pair for a specific iSCSI storage
 // target.
 type TargetPortal struct {
-	// iSCSI Target (server) address
+	// iSCSI Target (server) address, an IPv4 address, a host name or an IPv6
+	// address with or without brackets. It may be followed by the port e.g.
+	// [fd00::1]:3261 when target_port isn't set.
 	TargetAddress string `protobuf:"bytes,1,opt,name=target_address,json=targetAddress,proto3" json:"target_address,omitempty"`
 	// iSCSI Target port (default iSCSI port is 3260)
 	TargetPort           uint32   `protobuf:"varint,2,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"`
@@ -35,9 +35,12 @@ type NewSmbGlobalMappingRequest struct {
 	//
 	// Restrictions:
 	// SMB remote path specified in the format: \\server-name\sharename, \\server.fqdn\sharename or \\a.b.c.d\sharename
+	// IPv6 addresses are specified with or without brackets e.g. \\[fd00::1]\sharename.
+	// A TCP port other than 445 is specified after the server e.g. \\server-name:8445\sharename,
+	// which requires Windows Server 2025 or later.
 	// If not an IP address, share name has to be a valid DNS name.
 	// UNC specifications to local paths or prefix: \\?\ is not allowed.
-	// Characters: + [ ] " / : ; | < > , ? * = $ are not allowed.
+	// Characters: + [ ] " / : ; | < > , ? * = $ are not allowed in the share name.
 	RemotePath string `protobuf:"bytes,1,opt,name=remote_path,json=remotePath,proto3" json:"remote_path,omitempty"`
 	// Optional local path to mount the smb on
 	LocalPath string `protobuf:"bytes,2,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"`
@@ -0,0 +1,1860 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.12.4
+// source: github.com/kubernetes-csi/csi-proxy/client/api/storagespaces/v1alpha1/api.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Resiliency is how the data of a virtual disk is laid out on the physical
+// disks of its storage pool.
+type Resiliency int32
+
+const (
+	// Striped without redundancy, it needs one physical disk.
+	Resiliency_SIMPLE Resiliency = 0
+	// Mirrored, it needs two physical disks.
+	Resiliency_MIRROR Resiliency = 1
+	// Striped with parity, it needs three physical disks.
+	Resiliency_PARITY Resiliency = 2
+)
+
+// Enum value maps for Resiliency.
+var (
+	Resiliency_name = map[int32]string{
+		0: "SIMPLE",
+		1: "MIRROR",
+		2: "PARITY",
+	}
+	Resiliency_value = map[string]int32{
+		"SIMPLE": 0,
+		"MIRROR": 1,
+		"PARITY": 2,
+	}
+)
+
+func (x Resiliency) Enum() *Resiliency {
+	p := new(Resiliency)
+	*p = x
+	return p
+}
+
+func (x Resiliency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Resiliency) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (Resiliency) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_enumTypes[0]
+}
+
+func (x Resiliency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Resiliency.Descriptor instead.
+func (Resiliency) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+type PhysicalDisk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Disk number of the physical disk, as used by the Disk API group.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Serial number of the physical disk, empty if it doesn't have one.
+	SerialNumber string `protobuf:"bytes,2,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// Bus type of the physical disk e.g. "NVMe" or "SAS".
+	BusType string `protobuf:"bytes,3,opt,name=bus_type,json=busType,proto3" json:"bus_type,omitempty"`
+	// Media type of the physical disk e.g. "SSD" or "HDD".
+	MediaType string `protobuf:"bytes,4,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	// Size of the physical disk in bytes.
+	SizeBytes uint64 `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *PhysicalDisk) Reset() {
+	*x = PhysicalDisk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PhysicalDisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhysicalDisk) ProtoMessage() {}
+
+func (x *PhysicalDisk) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhysicalDisk.ProtoReflect.Descriptor instead.
+func (*PhysicalDisk) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PhysicalDisk) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *PhysicalDisk) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *PhysicalDisk) GetBusType() string {
+	if x != nil {
+		return x.BusType
+	}
+	return ""
+}
+
+func (x *PhysicalDisk) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+func (x *PhysicalDisk) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type ListPoolablePhysicalDisksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPoolablePhysicalDisksRequest) Reset() {
+	*x = ListPoolablePhysicalDisksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPoolablePhysicalDisksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolablePhysicalDisksRequest) ProtoMessage() {}
+
+func (x *ListPoolablePhysicalDisksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolablePhysicalDisksRequest.ProtoReflect.Descriptor instead.
+func (*ListPoolablePhysicalDisksRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+type ListPoolablePhysicalDisksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhysicalDisks []*PhysicalDisk `protobuf:"bytes,1,rep,name=physical_disks,json=physicalDisks,proto3" json:"physical_disks,omitempty"`
+}
+
+func (x *ListPoolablePhysicalDisksResponse) Reset() {
+	*x = ListPoolablePhysicalDisksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPoolablePhysicalDisksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPoolablePhysicalDisksResponse) ProtoMessage() {}
+
+func (x *ListPoolablePhysicalDisksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPoolablePhysicalDisksResponse.ProtoReflect.Descriptor instead.
+func (*ListPoolablePhysicalDisksResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPoolablePhysicalDisksResponse) GetPhysicalDisks() []*PhysicalDisk {
+	if x != nil {
+		return x.PhysicalDisks
+	}
+	return nil
+}
+
+type StoragePool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Friendly name of the storage pool.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Unique ID of the storage pool.
+	UniqueId string `protobuf:"bytes,2,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	// Health status of the storage pool e.g. "Healthy" or "Warning".
+	HealthStatus string `protobuf:"bytes,3,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
+	// Total size of the physical disks of the storage pool in bytes.
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Size allocated to the virtual disks of the storage pool in bytes.
+	AllocatedSizeBytes uint64 `protobuf:"varint,5,opt,name=allocated_size_bytes,json=allocatedSizeBytes,proto3" json:"allocated_size_bytes,omitempty"`
+	// Disk numbers of the physical disks of the storage pool.
+	DiskNumbers []uint32 `protobuf:"varint,6,rep,packed,name=disk_numbers,json=diskNumbers,proto3" json:"disk_numbers,omitempty"`
+}
+
+func (x *StoragePool) Reset() {
+	*x = StoragePool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StoragePool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoragePool) ProtoMessage() {}
+
+func (x *StoragePool) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoragePool.ProtoReflect.Descriptor instead.
+func (*StoragePool) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StoragePool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StoragePool) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *StoragePool) GetHealthStatus() string {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return ""
+}
+
+func (x *StoragePool) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *StoragePool) GetAllocatedSizeBytes() uint64 {
+	if x != nil {
+		return x.AllocatedSizeBytes
+	}
+	return 0
+}
+
+func (x *StoragePool) GetDiskNumbers() []uint32 {
+	if x != nil {
+		return x.DiskNumbers
+	}
+	return nil
+}
+
+type CreateStoragePoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Friendly name of the storage pool.
+	PoolName string `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	// Disk numbers of the physical disks added to the storage pool, they must be
+	// listed by ListPoolablePhysicalDisks.
+	DiskNumbers []uint32 `protobuf:"varint,2,rep,packed,name=disk_numbers,json=diskNumbers,proto3" json:"disk_numbers,omitempty"`
+}
+
+func (x *CreateStoragePoolRequest) Reset() {
+	*x = CreateStoragePoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStoragePoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoragePoolRequest) ProtoMessage() {}
+
+func (x *CreateStoragePoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoragePoolRequest.ProtoReflect.Descriptor instead.
+func (*CreateStoragePoolRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateStoragePoolRequest) GetPoolName() string {
+	if x != nil {
+		return x.PoolName
+	}
+	return ""
+}
+
+func (x *CreateStoragePoolRequest) GetDiskNumbers() []uint32 {
+	if x != nil {
+		return x.DiskNumbers
+	}
+	return nil
+}
+
+type CreateStoragePoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoragePool *StoragePool `protobuf:"bytes,1,opt,name=storage_pool,json=storagePool,proto3" json:"storage_pool,omitempty"`
+}
+
+func (x *CreateStoragePoolResponse) Reset() {
+	*x = CreateStoragePoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateStoragePoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoragePoolResponse) ProtoMessage() {}
+
+func (x *CreateStoragePoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoragePoolResponse.ProtoReflect.Descriptor instead.
+func (*CreateStoragePoolResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateStoragePoolResponse) GetStoragePool() *StoragePool {
+	if x != nil {
+		return x.StoragePool
+	}
+	return nil
+}
+
+type DeleteStoragePoolRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Friendly name of the storage pool.
+	PoolName string `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+}
+
+func (x *DeleteStoragePoolRequest) Reset() {
+	*x = DeleteStoragePoolRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStoragePoolRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStoragePoolRequest) ProtoMessage() {}
+
+func (x *DeleteStoragePoolRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStoragePoolRequest.ProtoReflect.Descriptor instead.
+func (*DeleteStoragePoolRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeleteStoragePoolRequest) GetPoolName() string {
+	if x != nil {
+		return x.PoolName
+	}
+	return ""
+}
+
+type DeleteStoragePoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteStoragePoolResponse) Reset() {
+	*x = DeleteStoragePoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteStoragePoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteStoragePoolResponse) ProtoMessage() {}
+
+func (x *DeleteStoragePoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteStoragePoolResponse.ProtoReflect.Descriptor instead.
+func (*DeleteStoragePoolResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{7}
+}
+
+type ListStoragePoolsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListStoragePoolsRequest) Reset() {
+	*x = ListStoragePoolsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStoragePoolsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoragePoolsRequest) ProtoMessage() {}
+
+func (x *ListStoragePoolsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoragePoolsRequest.ProtoReflect.Descriptor instead.
+func (*ListStoragePoolsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{8}
+}
+
+type ListStoragePoolsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StoragePools []*StoragePool `protobuf:"bytes,1,rep,name=storage_pools,json=storagePools,proto3" json:"storage_pools,omitempty"`
+}
+
+func (x *ListStoragePoolsResponse) Reset() {
+	*x = ListStoragePoolsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListStoragePoolsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoragePoolsResponse) ProtoMessage() {}
+
+func (x *ListStoragePoolsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoragePoolsResponse.ProtoReflect.Descriptor instead.
+func (*ListStoragePoolsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListStoragePoolsResponse) GetStoragePools() []*StoragePool {
+	if x != nil {
+		return x.StoragePools
+	}
+	return nil
+}
+
+type VirtualDisk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Friendly name of the virtual disk.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Friendly name of the storage pool of the virtual disk.
+	PoolName string `protobuf:"bytes,2,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	// Unique ID of the virtual disk.
+	UniqueId string `protobuf:"bytes,3,opt,name=unique_id,json=uniqueId,proto3" json:"unique_id,omitempty"`
+	// Size of the virtual disk in bytes.
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Resiliency of the virtual disk.
+	Resiliency Resiliency `protobuf:"varint,5,opt,name=resiliency,proto3,enum=v1alpha1.Resiliency" json:"resiliency,omitempty"`
+	// Whether the space of the virtual disk is allocated on demand.
+	ThinProvisioning bool `protobuf:"varint,6,opt,name=thin_provisioning,json=thinProvisioning,proto3" json:"thin_provisioning,omitempty"`
+	// Health status of the virtual disk e.g. "Healthy" or "Warning".
+	HealthStatus string `protobuf:"bytes,7,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
+	// Whether the virtual disk is attached to the host as a disk.
+	Attached bool `protobuf:"varint,8,opt,name=attached,proto3" json:"attached,omitempty"`
+	// Disk number of the disk of the virtual disk once it's attached, as used by
+	// the Disk API group.
+	DiskNumber uint32 `protobuf:"varint,9,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *VirtualDisk) Reset() {
+	*x = VirtualDisk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VirtualDisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualDisk) ProtoMessage() {}
+
+func (x *VirtualDisk) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtualDisk.ProtoReflect.Descriptor instead.
+func (*VirtualDisk) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VirtualDisk) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VirtualDisk) GetPoolName() string {
+	if x != nil {
+		return x.PoolName
+	}
+	return ""
+}
+
+func (x *VirtualDisk) GetUniqueId() string {
+	if x != nil {
+		return x.UniqueId
+	}
+	return ""
+}
+
+func (x *VirtualDisk) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *VirtualDisk) GetResiliency() Resiliency {
+	if x != nil {
+		return x.Resiliency
+	}
+	return Resiliency_SIMPLE
+}
+
+func (x *VirtualDisk) GetThinProvisioning() bool {
+	if x != nil {
+		return x.ThinProvisioning
+	}
+	return false
+}
+
+func (x *VirtualDisk) GetHealthStatus() string {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return ""
+}
+
+func (x *VirtualDisk) GetAttached() bool {
+	if x != nil {
+		return x.Attached
+	}
+	return false
+}
+
+func (x *VirtualDisk) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type CreateVirtualDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Friendly name of the storage pool of the virtual disk.
+	PoolName string `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	// Friendly name of the virtual disk, unique within the storage pool.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Size of the virtual disk in bytes, Windows rounds it up to a multiple
+	// of the allocation unit of the storage pool.
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Resiliency of the virtual disk.
+	Resiliency Resiliency `protobuf:"varint,4,opt,name=resiliency,proto3,enum=v1alpha1.Resiliency" json:"resiliency,omitempty"`
+	// Allocate the space of the virtual disk on demand instead of up front.
+	ThinProvisioning bool `protobuf:"varint,5,opt,name=thin_provisioning,json=thinProvisioning,proto3" json:"thin_provisioning,omitempty"`
+}
+
+func (x *CreateVirtualDiskRequest) Reset() {
+	*x = CreateVirtualDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVirtualDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVirtualDiskRequest) ProtoMessage() {}
+
+func (x *CreateVirtualDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVirtualDiskRequest.ProtoReflect.Descriptor instead.
+func (*CreateVirtualDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateVirtualDiskRequest) GetPoolName() string {
+	if x != nil {
+		return x.PoolName
+	}
+	return ""
+}
+
+func (x *CreateVirtualDiskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVirtualDiskRequest) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CreateVirtualDiskRequest) GetResiliency() Resiliency {
+	if x != nil {
+		return x.Resiliency
+	}
+	return Resiliency_SIMPLE
+}
+
+func (x *CreateVirtualDiskRequest) GetThinProvisioning() bool {
+	if x != nil {
+		return x.ThinProvisioning
+	}
+	return false
+}
+
+type CreateVirtualDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VirtualDisk *VirtualDisk `protobuf:"bytes,1,opt,name=virtual_disk,json=virtualDisk,proto3" json:"virtual_disk,omitempty"`
+}
+
+func (x *CreateVirtualDiskResponse) Reset() {
+	*x = CreateVirtualDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVirtualDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVirtualDiskResponse) ProtoMessage() {}
+
+func (x *CreateVirtualDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVirtualDiskResponse.ProtoReflect.Descriptor instead.
+func (*CreateVirtualDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreateVirtualDiskResponse) GetVirtualDisk() *VirtualDisk {
+	if x != nil {
+		return x.VirtualDisk
+	}
+	return nil
+}
+
+type DeleteVirtualDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Friendly name of the storage pool of the virtual disk.
+	PoolName string `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	// Friendly name of the virtual disk.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteVirtualDiskRequest) Reset() {
+	*x = DeleteVirtualDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteVirtualDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVirtualDiskRequest) ProtoMessage() {}
+
+func (x *DeleteVirtualDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVirtualDiskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVirtualDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteVirtualDiskRequest) GetPoolName() string {
+	if x != nil {
+		return x.PoolName
+	}
+	return ""
+}
+
+func (x *DeleteVirtualDiskRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteVirtualDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteVirtualDiskResponse) Reset() {
+	*x = DeleteVirtualDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteVirtualDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVirtualDiskResponse) ProtoMessage() {}
+
+func (x *DeleteVirtualDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVirtualDiskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVirtualDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{14}
+}
+
+type ListVirtualDisksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Friendly name of the storage pool.
+	PoolName string `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+}
+
+func (x *ListVirtualDisksRequest) Reset() {
+	*x = ListVirtualDisksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVirtualDisksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVirtualDisksRequest) ProtoMessage() {}
+
+func (x *ListVirtualDisksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVirtualDisksRequest.ProtoReflect.Descriptor instead.
+func (*ListVirtualDisksRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListVirtualDisksRequest) GetPoolName() string {
+	if x != nil {
+		return x.PoolName
+	}
+	return ""
+}
+
+type ListVirtualDisksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VirtualDisks []*VirtualDisk `protobuf:"bytes,1,rep,name=virtual_disks,json=virtualDisks,proto3" json:"virtual_disks,omitempty"`
+}
+
+func (x *ListVirtualDisksResponse) Reset() {
+	*x = ListVirtualDisksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListVirtualDisksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVirtualDisksResponse) ProtoMessage() {}
+
+func (x *ListVirtualDisksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVirtualDisksResponse.ProtoReflect.Descriptor instead.
+func (*ListVirtualDisksResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListVirtualDisksResponse) GetVirtualDisks() []*VirtualDisk {
+	if x != nil {
+		return x.VirtualDisks
+	}
+	return nil
+}
+
+var File_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto protoreflect.FileDescriptor
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDesc = []byte{
+	0x0a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2f, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x08, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x22, 0xad, 0x01, 0x0a, 0x0c,
+	0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x75, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x68, 0x79, 0x73, 0x69,
+	0x63, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x62, 0x0a, 0x21, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50,
+	0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x5f, 0x64, 0x69, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x44, 0x69, 0x73, 0x6b, 0x52, 0x0d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x69,
+	0x73, 0x6b, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x5a, 0x0a,
+	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f,
+	0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x19, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c,
+	0x22, 0x37, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x56, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
+	0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0c, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x22, 0xbf, 0x02, 0x0a, 0x0b, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x69, 0x6c, 0x69,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79,
+	0x52, 0x0a, 0x72, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2b, 0x0a, 0x11,
+	0x74, 0x68, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xcd, 0x01, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x69,
+	0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2b,
+	0x0a, 0x11, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x74, 0x68, 0x69, 0x6e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x55, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x76, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x0b, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69,
+	0x73, 0x6b, 0x22, 0x4b, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x1b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f, 0x6c, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0x56, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x0d, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x0c,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x2a, 0x30, 0x0a, 0x0a,
+	0x52, 0x65, 0x73, 0x69, 0x6c, 0x69, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49,
+	0x4d, 0x50, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4d, 0x49, 0x52, 0x52, 0x4f, 0x52,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x52, 0x49, 0x54, 0x59, 0x10, 0x02, 0x32, 0xc1,
+	0x05, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x76, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x2a, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x69, 0x73,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x22, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x22, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x21, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f,
+	0x63, 0x73, 0x69, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescOnce sync.Once
+	file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDesc
+)
+
+func file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescGZIP() []byte {
+	file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescOnce.Do(func() {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescData)
+	})
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDescData
+}
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_goTypes = []interface{}{
+	(Resiliency)(0),                           // 0: v1alpha1.Resiliency
+	(*PhysicalDisk)(nil),                      // 1: v1alpha1.PhysicalDisk
+	(*ListPoolablePhysicalDisksRequest)(nil),  // 2: v1alpha1.ListPoolablePhysicalDisksRequest
+	(*ListPoolablePhysicalDisksResponse)(nil), // 3: v1alpha1.ListPoolablePhysicalDisksResponse
+	(*StoragePool)(nil),                       // 4: v1alpha1.StoragePool
+	(*CreateStoragePoolRequest)(nil),          // 5: v1alpha1.CreateStoragePoolRequest
+	(*CreateStoragePoolResponse)(nil),         // 6: v1alpha1.CreateStoragePoolResponse
+	(*DeleteStoragePoolRequest)(nil),          // 7: v1alpha1.DeleteStoragePoolRequest
+	(*DeleteStoragePoolResponse)(nil),         // 8: v1alpha1.DeleteStoragePoolResponse
+	(*ListStoragePoolsRequest)(nil),           // 9: v1alpha1.ListStoragePoolsRequest
+	(*ListStoragePoolsResponse)(nil),          // 10: v1alpha1.ListStoragePoolsResponse
+	(*VirtualDisk)(nil),                       // 11: v1alpha1.VirtualDisk
+	(*CreateVirtualDiskRequest)(nil),          // 12: v1alpha1.CreateVirtualDiskRequest
+	(*CreateVirtualDiskResponse)(nil),         // 13: v1alpha1.CreateVirtualDiskResponse
+	(*DeleteVirtualDiskRequest)(nil),          // 14: v1alpha1.DeleteVirtualDiskRequest
+	(*DeleteVirtualDiskResponse)(nil),         // 15: v1alpha1.DeleteVirtualDiskResponse
+	(*ListVirtualDisksRequest)(nil),           // 16: v1alpha1.ListVirtualDisksRequest
+	(*ListVirtualDisksResponse)(nil),          // 17: v1alpha1.ListVirtualDisksResponse
+}
+var file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_depIdxs = []int32{
+	1,  // 0: v1alpha1.ListPoolablePhysicalDisksResponse.physical_disks:type_name -> v1alpha1.PhysicalDisk
+	4,  // 1: v1alpha1.CreateStoragePoolResponse.storage_pool:type_name -> v1alpha1.StoragePool
+	4,  // 2: v1alpha1.ListStoragePoolsResponse.storage_pools:type_name -> v1alpha1.StoragePool
+	0,  // 3: v1alpha1.VirtualDisk.resiliency:type_name -> v1alpha1.Resiliency
+	0,  // 4: v1alpha1.CreateVirtualDiskRequest.resiliency:type_name -> v1alpha1.Resiliency
+	11, // 5: v1alpha1.CreateVirtualDiskResponse.virtual_disk:type_name -> v1alpha1.VirtualDisk
+	11, // 6: v1alpha1.ListVirtualDisksResponse.virtual_disks:type_name -> v1alpha1.VirtualDisk
+	2,  // 7: v1alpha1.Storagespaces.ListPoolablePhysicalDisks:input_type -> v1alpha1.ListPoolablePhysicalDisksRequest
+	5,  // 8: v1alpha1.Storagespaces.CreateStoragePool:input_type -> v1alpha1.CreateStoragePoolRequest
+	7,  // 9: v1alpha1.Storagespaces.DeleteStoragePool:input_type -> v1alpha1.DeleteStoragePoolRequest
+	9,  // 10: v1alpha1.Storagespaces.ListStoragePools:input_type -> v1alpha1.ListStoragePoolsRequest
+	12, // 11: v1alpha1.Storagespaces.CreateVirtualDisk:input_type -> v1alpha1.CreateVirtualDiskRequest
+	14, // 12: v1alpha1.Storagespaces.DeleteVirtualDisk:input_type -> v1alpha1.DeleteVirtualDiskRequest
+	16, // 13: v1alpha1.Storagespaces.ListVirtualDisks:input_type -> v1alpha1.ListVirtualDisksRequest
+	3,  // 14: v1alpha1.Storagespaces.ListPoolablePhysicalDisks:output_type -> v1alpha1.ListPoolablePhysicalDisksResponse
+	6,  // 15: v1alpha1.Storagespaces.CreateStoragePool:output_type -> v1alpha1.CreateStoragePoolResponse
+	8,  // 16: v1alpha1.Storagespaces.DeleteStoragePool:output_type -> v1alpha1.DeleteStoragePoolResponse
+	10, // 17: v1alpha1.Storagespaces.ListStoragePools:output_type -> v1alpha1.ListStoragePoolsResponse
+	13, // 18: v1alpha1.Storagespaces.CreateVirtualDisk:output_type -> v1alpha1.CreateVirtualDiskResponse
+	15, // 19: v1alpha1.Storagespaces.DeleteVirtualDisk:output_type -> v1alpha1.DeleteVirtualDiskResponse
+	17, // 20: v1alpha1.Storagespaces.ListVirtualDisks:output_type -> v1alpha1.ListVirtualDisksResponse
+	14, // [14:21] is the sub-list for method output_type
+	7,  // [7:14] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() {
+	file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_init()
+}
+func file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_init() {
+	if File_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhysicalDisk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPoolablePhysicalDisksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPoolablePhysicalDisksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StoragePool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStoragePoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateStoragePoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStoragePoolRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStoragePoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStoragePoolsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListStoragePoolsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VirtualDisk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVirtualDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVirtualDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVirtualDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteVirtualDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVirtualDisksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVirtualDisksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_goTypes,
+		DependencyIndexes: file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_depIdxs,
+		EnumInfos:         file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_enumTypes,
+		MessageInfos:      file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_msgTypes,
+	}.Build()
+	File_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto = out.File
+	file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_rawDesc = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_goTypes = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_storagespaces_v1alpha1_api_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// StoragespacesClient is the client API for Storagespaces service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StoragespacesClient interface {
+	// ListPoolablePhysicalDisks lists the physical disks that can be added to a
+	// storage pool i.e. the disks without partitions that aren't in a pool.
+	ListPoolablePhysicalDisks(ctx context.Context, in *ListPoolablePhysicalDisksRequest, opts ...grpc.CallOption) (*ListPoolablePhysicalDisksResponse, error)
+	// CreateStoragePool creates a storage pool from physical disks. It succeeds
+	// if the pool exists with the same physical disks.
+	CreateStoragePool(ctx context.Context, in *CreateStoragePoolRequest, opts ...grpc.CallOption) (*CreateStoragePoolResponse, error)
+	// DeleteStoragePool deletes a storage pool, its physical disks can be pooled
+	// again afterwards. It fails if the pool has virtual disks and succeeds if
+	// the pool doesn't exist.
+	DeleteStoragePool(ctx context.Context, in *DeleteStoragePoolRequest, opts ...grpc.CallOption) (*DeleteStoragePoolResponse, error)
+	// ListStoragePools lists the storage pools, the primordial pool of the
+	// physical disks that aren't pooled isn't listed.
+	ListStoragePools(ctx context.Context, in *ListStoragePoolsRequest, opts ...grpc.CallOption) (*ListStoragePoolsResponse, error)
+	// CreateVirtualDisk creates a virtual disk in a storage pool, it's attached
+	// to the host as a disk that's initialized and formatted with the Disk and
+	// Volume API groups. It succeeds if the virtual disk exists in the pool with
+	// at least the requested size.
+	CreateVirtualDisk(ctx context.Context, in *CreateVirtualDiskRequest, opts ...grpc.CallOption) (*CreateVirtualDiskResponse, error)
+	// DeleteVirtualDisk deletes a virtual disk and its data, it succeeds if the
+	// virtual disk doesn't exist.
+	DeleteVirtualDisk(ctx context.Context, in *DeleteVirtualDiskRequest, opts ...grpc.CallOption) (*DeleteVirtualDiskResponse, error)
+	// ListVirtualDisks lists the virtual disks of a storage pool.
+	ListVirtualDisks(ctx context.Context, in *ListVirtualDisksRequest, opts ...grpc.CallOption) (*ListVirtualDisksResponse, error)
+}
+
+type storagespacesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStoragespacesClient(cc grpc.ClientConnInterface) StoragespacesClient {
+	return &storagespacesClient{cc}
+}
+
+func (c *storagespacesClient) ListPoolablePhysicalDisks(ctx context.Context, in *ListPoolablePhysicalDisksRequest, opts ...grpc.CallOption) (*ListPoolablePhysicalDisksResponse, error) {
+	out := new(ListPoolablePhysicalDisksResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Storagespaces/ListPoolablePhysicalDisks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagespacesClient) CreateStoragePool(ctx context.Context, in *CreateStoragePoolRequest, opts ...grpc.CallOption) (*CreateStoragePoolResponse, error) {
+	out := new(CreateStoragePoolResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Storagespaces/CreateStoragePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagespacesClient) DeleteStoragePool(ctx context.Context, in *DeleteStoragePoolRequest, opts ...grpc.CallOption) (*DeleteStoragePoolResponse, error) {
+	out := new(DeleteStoragePoolResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Storagespaces/DeleteStoragePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagespacesClient) ListStoragePools(ctx context.Context, in *ListStoragePoolsRequest, opts ...grpc.CallOption) (*ListStoragePoolsResponse, error) {
+	out := new(ListStoragePoolsResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Storagespaces/ListStoragePools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagespacesClient) CreateVirtualDisk(ctx context.Context, in *CreateVirtualDiskRequest, opts ...grpc.CallOption) (*CreateVirtualDiskResponse, error) {
+	out := new(CreateVirtualDiskResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Storagespaces/CreateVirtualDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagespacesClient) DeleteVirtualDisk(ctx context.Context, in *DeleteVirtualDiskRequest, opts ...grpc.CallOption) (*DeleteVirtualDiskResponse, error) {
+	out := new(DeleteVirtualDiskResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Storagespaces/DeleteVirtualDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagespacesClient) ListVirtualDisks(ctx context.Context, in *ListVirtualDisksRequest, opts ...grpc.CallOption) (*ListVirtualDisksResponse, error) {
+	out := new(ListVirtualDisksResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Storagespaces/ListVirtualDisks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StoragespacesServer is the server API for Storagespaces service.
+type StoragespacesServer interface {
+	// ListPoolablePhysicalDisks lists the physical disks that can be added to a
+	// storage pool i.e. the disks without partitions that aren't in a pool.
+	ListPoolablePhysicalDisks(context.Context, *ListPoolablePhysicalDisksRequest) (*ListPoolablePhysicalDisksResponse, error)
+	// CreateStoragePool creates a storage pool from physical disks. It succeeds
+	// if the pool exists with the same physical disks.
+	CreateStoragePool(context.Context, *CreateStoragePoolRequest) (*CreateStoragePoolResponse, error)
+	// DeleteStoragePool deletes a storage pool, its physical disks can be pooled
+	// again afterwards. It fails if the pool has virtual disks and succeeds if
+	// the pool doesn't exist.
+	DeleteStoragePool(context.Context, *DeleteStoragePoolRequest) (*DeleteStoragePoolResponse, error)
+	// ListStoragePools lists the storage pools, the primordial pool of the
+	// physical disks that aren't pooled isn't listed.
+	ListStoragePools(context.Context, *ListStoragePoolsRequest) (*ListStoragePoolsResponse, error)
+	// CreateVirtualDisk creates a virtual disk in a storage pool, it's attached
+	// to the host as a disk that's initialized and formatted with the Disk and
+	// Volume API groups. It succeeds if the virtual disk exists in the pool with
+	// at least the requested size.
+	CreateVirtualDisk(context.Context, *CreateVirtualDiskRequest) (*CreateVirtualDiskResponse, error)
+	// DeleteVirtualDisk deletes a virtual disk and its data, it succeeds if the
+	// virtual disk doesn't exist.
+	DeleteVirtualDisk(context.Context, *DeleteVirtualDiskRequest) (*DeleteVirtualDiskResponse, error)
+	// ListVirtualDisks lists the virtual disks of a storage pool.
+	ListVirtualDisks(context.Context, *ListVirtualDisksRequest) (*ListVirtualDisksResponse, error)
+}
+
+// UnimplementedStoragespacesServer can be embedded to have forward compatible implementations.
+type UnimplementedStoragespacesServer struct {
+}
+
+func (*UnimplementedStoragespacesServer) ListPoolablePhysicalDisks(context.Context, *ListPoolablePhysicalDisksRequest) (*ListPoolablePhysicalDisksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPoolablePhysicalDisks not implemented")
+}
+func (*UnimplementedStoragespacesServer) CreateStoragePool(context.Context, *CreateStoragePoolRequest) (*CreateStoragePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStoragePool not implemented")
+}
+func (*UnimplementedStoragespacesServer) DeleteStoragePool(context.Context, *DeleteStoragePoolRequest) (*DeleteStoragePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStoragePool not implemented")
+}
+func (*UnimplementedStoragespacesServer) ListStoragePools(context.Context, *ListStoragePoolsRequest) (*ListStoragePoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStoragePools not implemented")
+}
+func (*UnimplementedStoragespacesServer) CreateVirtualDisk(context.Context, *CreateVirtualDiskRequest) (*CreateVirtualDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVirtualDisk not implemented")
+}
+func (*UnimplementedStoragespacesServer) DeleteVirtualDisk(context.Context, *DeleteVirtualDiskRequest) (*DeleteVirtualDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVirtualDisk not implemented")
+}
+func (*UnimplementedStoragespacesServer) ListVirtualDisks(context.Context, *ListVirtualDisksRequest) (*ListVirtualDisksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVirtualDisks not implemented")
+}
+
+func RegisterStoragespacesServer(s *grpc.Server, srv StoragespacesServer) {
+	s.RegisterService(&_Storagespaces_serviceDesc, srv)
+}
+
+func _Storagespaces_ListPoolablePhysicalDisks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolablePhysicalDisksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragespacesServer).ListPoolablePhysicalDisks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Storagespaces/ListPoolablePhysicalDisks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragespacesServer).ListPoolablePhysicalDisks(ctx, req.(*ListPoolablePhysicalDisksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storagespaces_CreateStoragePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStoragePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragespacesServer).CreateStoragePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Storagespaces/CreateStoragePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragespacesServer).CreateStoragePool(ctx, req.(*CreateStoragePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storagespaces_DeleteStoragePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStoragePoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragespacesServer).DeleteStoragePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Storagespaces/DeleteStoragePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragespacesServer).DeleteStoragePool(ctx, req.(*DeleteStoragePoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storagespaces_ListStoragePools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoragePoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragespacesServer).ListStoragePools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Storagespaces/ListStoragePools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragespacesServer).ListStoragePools(ctx, req.(*ListStoragePoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storagespaces_CreateVirtualDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVirtualDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragespacesServer).CreateVirtualDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Storagespaces/CreateVirtualDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragespacesServer).CreateVirtualDisk(ctx, req.(*CreateVirtualDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storagespaces_DeleteVirtualDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVirtualDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragespacesServer).DeleteVirtualDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Storagespaces/DeleteVirtualDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragespacesServer).DeleteVirtualDisk(ctx, req.(*DeleteVirtualDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storagespaces_ListVirtualDisks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVirtualDisksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragespacesServer).ListVirtualDisks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Storagespaces/ListVirtualDisks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragespacesServer).ListVirtualDisks(ctx, req.(*ListVirtualDisksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Storagespaces_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1alpha1.Storagespaces",
+	HandlerType: (*StoragespacesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListPoolablePhysicalDisks",
+			Handler:    _Storagespaces_ListPoolablePhysicalDisks_Handler,
+		},
+		{
+			MethodName: "CreateStoragePool",
+			Handler:    _Storagespaces_CreateStoragePool_Handler,
+		},
+		{
+			MethodName: "DeleteStoragePool",
+			Handler:    _Storagespaces_DeleteStoragePool_Handler,
+		},
+		{
+			MethodName: "ListStoragePools",
+			Handler:    _Storagespaces_ListStoragePools_Handler,
+		},
+		{
+			MethodName: "CreateVirtualDisk",
+			Handler:    _Storagespaces_CreateVirtualDisk_Handler,
+		},
+		{
+			MethodName: "DeleteVirtualDisk",
+			Handler:    _Storagespaces_DeleteVirtualDisk_Handler,
+		},
+		{
+			MethodName: "ListVirtualDisks",
+			Handler:    _Storagespaces_ListVirtualDisks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/kubernetes-csi/csi-proxy/client/api/storagespaces/v1alpha1/api.proto",
+}
@@ -0,0 +1,1208 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.12.4
+// source: github.com/kubernetes-csi/csi-proxy/client/api/vhd/v1alpha1/api.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VirtualDiskFormat int32
+
+const (
+	VirtualDiskFormat_VHDX VirtualDiskFormat = 0
+	VirtualDiskFormat_VHD  VirtualDiskFormat = 1
+)
+
+// Enum value maps for VirtualDiskFormat.
+var (
+	VirtualDiskFormat_name = map[int32]string{
+		0: "VHDX",
+		1: "VHD",
+	}
+	VirtualDiskFormat_value = map[string]int32{
+		"VHDX": 0,
+		"VHD":  1,
+	}
+)
+
+func (x VirtualDiskFormat) Enum() *VirtualDiskFormat {
+	p := new(VirtualDiskFormat)
+	*p = x
+	return p
+}
+
+func (x VirtualDiskFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VirtualDiskFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (VirtualDiskFormat) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_enumTypes[0]
+}
+
+func (x VirtualDiskFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VirtualDiskFormat.Descriptor instead.
+func (VirtualDiskFormat) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+type VirtualDisk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the virtual disk file.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Format of the virtual disk.
+	Format VirtualDiskFormat `protobuf:"varint,2,opt,name=format,proto3,enum=v1alpha1.VirtualDiskFormat" json:"format,omitempty"`
+	// Size in bytes of the disk seen by the host.
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Space in bytes the file takes on the host, it's smaller than size_bytes
+	// for a dynamic virtual disk that isn't full.
+	FileSizeBytes uint64 `protobuf:"varint,4,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"`
+	// True while the virtual disk is attached.
+	Attached bool `protobuf:"varint,5,opt,name=attached,proto3" json:"attached,omitempty"`
+	// Number of the disk of the virtual disk while it's attached.
+	DiskNumber uint32 `protobuf:"varint,6,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *VirtualDisk) Reset() {
+	*x = VirtualDisk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VirtualDisk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VirtualDisk) ProtoMessage() {}
+
+func (x *VirtualDisk) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VirtualDisk.ProtoReflect.Descriptor instead.
+func (*VirtualDisk) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VirtualDisk) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *VirtualDisk) GetFormat() VirtualDiskFormat {
+	if x != nil {
+		return x.Format
+	}
+	return VirtualDiskFormat_VHDX
+}
+
+func (x *VirtualDisk) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *VirtualDisk) GetFileSizeBytes() uint64 {
+	if x != nil {
+		return x.FileSizeBytes
+	}
+	return 0
+}
+
+func (x *VirtualDisk) GetAttached() bool {
+	if x != nil {
+		return x.Attached
+	}
+	return false
+}
+
+func (x *VirtualDisk) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type CreateVirtualDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the virtual disk file to create e.g.
+	// C:\var\lib\kubelet\plugins\example.csi.io\images\pvc-1.vhdx, it must end
+	// with .vhd or .vhdx and be within the working directories of csi-proxy.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Size in bytes of the disk, it's rounded up to a multiple of 1 MiB and must
+	// be at least 3 MiB. Sizes above 2^63-1 bytes are rejected.
+	SizeBytes uint64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Allocate the space of the file up front (fixed virtual disk), the file
+	// grows as the disk is written otherwise (dynamic virtual disk).
+	Fixed bool `protobuf:"varint,3,opt,name=fixed,proto3" json:"fixed,omitempty"`
+}
+
+func (x *CreateVirtualDiskRequest) Reset() {
+	*x = CreateVirtualDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVirtualDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVirtualDiskRequest) ProtoMessage() {}
+
+func (x *CreateVirtualDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVirtualDiskRequest.ProtoReflect.Descriptor instead.
+func (*CreateVirtualDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateVirtualDiskRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CreateVirtualDiskRequest) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *CreateVirtualDiskRequest) GetFixed() bool {
+	if x != nil {
+		return x.Fixed
+	}
+	return false
+}
+
+type CreateVirtualDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateVirtualDiskResponse) Reset() {
+	*x = CreateVirtualDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateVirtualDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVirtualDiskResponse) ProtoMessage() {}
+
+func (x *CreateVirtualDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVirtualDiskResponse.ProtoReflect.Descriptor instead.
+func (*CreateVirtualDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+type AttachVirtualDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the virtual disk file.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Attach the virtual disk read-only.
+	ReadOnly bool `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+}
+
+func (x *AttachVirtualDiskRequest) Reset() {
+	*x = AttachVirtualDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachVirtualDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachVirtualDiskRequest) ProtoMessage() {}
+
+func (x *AttachVirtualDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachVirtualDiskRequest.ProtoReflect.Descriptor instead.
+func (*AttachVirtualDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AttachVirtualDiskRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AttachVirtualDiskRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type AttachVirtualDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of the disk of the virtual disk.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *AttachVirtualDiskResponse) Reset() {
+	*x = AttachVirtualDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttachVirtualDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachVirtualDiskResponse) ProtoMessage() {}
+
+func (x *AttachVirtualDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachVirtualDiskResponse.ProtoReflect.Descriptor instead.
+func (*AttachVirtualDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AttachVirtualDiskResponse) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type DetachVirtualDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the virtual disk file.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *DetachVirtualDiskRequest) Reset() {
+	*x = DetachVirtualDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetachVirtualDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachVirtualDiskRequest) ProtoMessage() {}
+
+func (x *DetachVirtualDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachVirtualDiskRequest.ProtoReflect.Descriptor instead.
+func (*DetachVirtualDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DetachVirtualDiskRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type DetachVirtualDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DetachVirtualDiskResponse) Reset() {
+	*x = DetachVirtualDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DetachVirtualDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DetachVirtualDiskResponse) ProtoMessage() {}
+
+func (x *DetachVirtualDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DetachVirtualDiskResponse.ProtoReflect.Descriptor instead.
+func (*DetachVirtualDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{6}
+}
+
+type ResizeVirtualDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the virtual disk file.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// New size in bytes of the disk, it's rounded up to a multiple of 1 MiB. A
+	// size smaller than the current size is rejected, the current size is a no-op.
+	SizeBytes uint64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *ResizeVirtualDiskRequest) Reset() {
+	*x = ResizeVirtualDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResizeVirtualDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeVirtualDiskRequest) ProtoMessage() {}
+
+func (x *ResizeVirtualDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeVirtualDiskRequest.ProtoReflect.Descriptor instead.
+func (*ResizeVirtualDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResizeVirtualDiskRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ResizeVirtualDiskRequest) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type ResizeVirtualDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResizeVirtualDiskResponse) Reset() {
+	*x = ResizeVirtualDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResizeVirtualDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeVirtualDiskResponse) ProtoMessage() {}
+
+func (x *ResizeVirtualDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeVirtualDiskResponse.ProtoReflect.Descriptor instead.
+func (*ResizeVirtualDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{8}
+}
+
+type GetVirtualDiskRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the virtual disk file.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetVirtualDiskRequest) Reset() {
+	*x = GetVirtualDiskRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVirtualDiskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVirtualDiskRequest) ProtoMessage() {}
+
+func (x *GetVirtualDiskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVirtualDiskRequest.ProtoReflect.Descriptor instead.
+func (*GetVirtualDiskRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetVirtualDiskRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetVirtualDiskResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Virtual disk, the call fails with NOT_FOUND if the file doesn't exist.
+	VirtualDisk *VirtualDisk `protobuf:"bytes,1,opt,name=virtual_disk,json=virtualDisk,proto3" json:"virtual_disk,omitempty"`
+}
+
+func (x *GetVirtualDiskResponse) Reset() {
+	*x = GetVirtualDiskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVirtualDiskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVirtualDiskResponse) ProtoMessage() {}
+
+func (x *GetVirtualDiskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVirtualDiskResponse.ProtoReflect.Descriptor instead.
+func (*GetVirtualDiskResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetVirtualDiskResponse) GetVirtualDisk() *VirtualDisk {
+	if x != nil {
+		return x.VirtualDisk
+	}
+	return nil
+}
+
+var File_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto protoreflect.FileDescriptor
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDesc = []byte{
+	0x0a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x68, 0x64, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x22, 0xda, 0x01, 0x0a, 0x0b, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x33, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x63,
+	0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44,
+	0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x4b, 0x0a, 0x18, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x3c, 0x0a,
+	0x19, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69,
+	0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x2e, 0x0a, 0x18, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x1b, 0x0a, 0x19, 0x44,
+	0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x69,
+	0x7a, 0x65, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69,
+	0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x69, 0x7a,
+	0x65, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75,
+	0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44,
+	0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0c, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x0b, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61,
+	0x6c, 0x44, 0x69, 0x73, 0x6b, 0x2a, 0x26, 0x0a, 0x11, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x44, 0x69, 0x73, 0x6b, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x48,
+	0x44, 0x58, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x56, 0x48, 0x44, 0x10, 0x01, 0x32, 0xdc, 0x03,
+	0x0a, 0x03, 0x56, 0x68, 0x64, 0x12, 0x5e, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x22, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65,
+	0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x72, 0x74,
+	0x75, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x1f, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69, 0x73,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x44, 0x69,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3d, 0x5a, 0x3b,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x68, 0x64, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescOnce sync.Once
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDesc
+)
+
+func file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescGZIP() []byte {
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescOnce.Do(func() {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescData)
+	})
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDescData
+}
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_goTypes = []interface{}{
+	(VirtualDiskFormat)(0),            // 0: v1alpha1.VirtualDiskFormat
+	(*VirtualDisk)(nil),               // 1: v1alpha1.VirtualDisk
+	(*CreateVirtualDiskRequest)(nil),  // 2: v1alpha1.CreateVirtualDiskRequest
+	(*CreateVirtualDiskResponse)(nil), // 3: v1alpha1.CreateVirtualDiskResponse
+	(*AttachVirtualDiskRequest)(nil),  // 4: v1alpha1.AttachVirtualDiskRequest
+	(*AttachVirtualDiskResponse)(nil), // 5: v1alpha1.AttachVirtualDiskResponse
+	(*DetachVirtualDiskRequest)(nil),  // 6: v1alpha1.DetachVirtualDiskRequest
+	(*DetachVirtualDiskResponse)(nil), // 7: v1alpha1.DetachVirtualDiskResponse
+	(*ResizeVirtualDiskRequest)(nil),  // 8: v1alpha1.ResizeVirtualDiskRequest
+	(*ResizeVirtualDiskResponse)(nil), // 9: v1alpha1.ResizeVirtualDiskResponse
+	(*GetVirtualDiskRequest)(nil),     // 10: v1alpha1.GetVirtualDiskRequest
+	(*GetVirtualDiskResponse)(nil),    // 11: v1alpha1.GetVirtualDiskResponse
+}
+var file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_depIdxs = []int32{
+	0,  // 0: v1alpha1.VirtualDisk.format:type_name -> v1alpha1.VirtualDiskFormat
+	1,  // 1: v1alpha1.GetVirtualDiskResponse.virtual_disk:type_name -> v1alpha1.VirtualDisk
+	2,  // 2: v1alpha1.Vhd.CreateVirtualDisk:input_type -> v1alpha1.CreateVirtualDiskRequest
+	4,  // 3: v1alpha1.Vhd.AttachVirtualDisk:input_type -> v1alpha1.AttachVirtualDiskRequest
+	6,  // 4: v1alpha1.Vhd.DetachVirtualDisk:input_type -> v1alpha1.DetachVirtualDiskRequest
+	8,  // 5: v1alpha1.Vhd.ResizeVirtualDisk:input_type -> v1alpha1.ResizeVirtualDiskRequest
+	10, // 6: v1alpha1.Vhd.GetVirtualDisk:input_type -> v1alpha1.GetVirtualDiskRequest
+	3,  // 7: v1alpha1.Vhd.CreateVirtualDisk:output_type -> v1alpha1.CreateVirtualDiskResponse
+	5,  // 8: v1alpha1.Vhd.AttachVirtualDisk:output_type -> v1alpha1.AttachVirtualDiskResponse
+	7,  // 9: v1alpha1.Vhd.DetachVirtualDisk:output_type -> v1alpha1.DetachVirtualDiskResponse
+	9,  // 10: v1alpha1.Vhd.ResizeVirtualDisk:output_type -> v1alpha1.ResizeVirtualDiskResponse
+	11, // 11: v1alpha1.Vhd.GetVirtualDisk:output_type -> v1alpha1.GetVirtualDiskResponse
+	7,  // [7:12] is the sub-list for method output_type
+	2,  // [2:7] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_init() }
+func file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_init() {
+	if File_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VirtualDisk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVirtualDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateVirtualDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachVirtualDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttachVirtualDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetachVirtualDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetachVirtualDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResizeVirtualDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResizeVirtualDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVirtualDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVirtualDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_goTypes,
+		DependencyIndexes: file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_depIdxs,
+		EnumInfos:         file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_enumTypes,
+		MessageInfos:      file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_msgTypes,
+	}.Build()
+	File_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto = out.File
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_rawDesc = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_goTypes = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vhd_v1alpha1_api_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// VhdClient is the client API for Vhd service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VhdClient interface {
+	// CreateVirtualDisk creates a VHD or VHDX file, the format is chosen by the
+	// extension of its path. It succeeds if the file exists with the same size.
+	CreateVirtualDisk(ctx context.Context, in *CreateVirtualDiskRequest, opts ...grpc.CallOption) (*CreateVirtualDiskResponse, error)
+	// AttachVirtualDisk attaches a virtual disk as a disk of the host without a
+	// drive letter, it stays attached until it's detached or the host restarts.
+	// It succeeds if the virtual disk is attached already.
+	AttachVirtualDisk(ctx context.Context, in *AttachVirtualDiskRequest, opts ...grpc.CallOption) (*AttachVirtualDiskResponse, error)
+	// DetachVirtualDisk detaches a virtual disk, it succeeds if the virtual disk
+	// isn't attached or doesn't exist.
+	DetachVirtualDisk(ctx context.Context, in *DetachVirtualDiskRequest, opts ...grpc.CallOption) (*DetachVirtualDiskResponse, error)
+	// ResizeVirtualDisk expands a virtual disk, the volumes of the disk are
+	// resized with the Volume API group afterwards. Virtual disks can't be shrunk,
+	// VHD (not VHDX) files can only be expanded while they're detached.
+	ResizeVirtualDisk(ctx context.Context, in *ResizeVirtualDiskRequest, opts ...grpc.CallOption) (*ResizeVirtualDiskResponse, error)
+	// GetVirtualDisk gets the size and the attach state of a virtual disk.
+	GetVirtualDisk(ctx context.Context, in *GetVirtualDiskRequest, opts ...grpc.CallOption) (*GetVirtualDiskResponse, error)
+}
+
+type vhdClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVhdClient(cc grpc.ClientConnInterface) VhdClient {
+	return &vhdClient{cc}
+}
+
+func (c *vhdClient) CreateVirtualDisk(ctx context.Context, in *CreateVirtualDiskRequest, opts ...grpc.CallOption) (*CreateVirtualDiskResponse, error) {
+	out := new(CreateVirtualDiskResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Vhd/CreateVirtualDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vhdClient) AttachVirtualDisk(ctx context.Context, in *AttachVirtualDiskRequest, opts ...grpc.CallOption) (*AttachVirtualDiskResponse, error) {
+	out := new(AttachVirtualDiskResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Vhd/AttachVirtualDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vhdClient) DetachVirtualDisk(ctx context.Context, in *DetachVirtualDiskRequest, opts ...grpc.CallOption) (*DetachVirtualDiskResponse, error) {
+	out := new(DetachVirtualDiskResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Vhd/DetachVirtualDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vhdClient) ResizeVirtualDisk(ctx context.Context, in *ResizeVirtualDiskRequest, opts ...grpc.CallOption) (*ResizeVirtualDiskResponse, error) {
+	out := new(ResizeVirtualDiskResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Vhd/ResizeVirtualDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vhdClient) GetVirtualDisk(ctx context.Context, in *GetVirtualDiskRequest, opts ...grpc.CallOption) (*GetVirtualDiskResponse, error) {
+	out := new(GetVirtualDiskResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Vhd/GetVirtualDisk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VhdServer is the server API for Vhd service.
+type VhdServer interface {
+	// CreateVirtualDisk creates a VHD or VHDX file, the format is chosen by the
+	// extension of its path. It succeeds if the file exists with the same size.
+	CreateVirtualDisk(context.Context, *CreateVirtualDiskRequest) (*CreateVirtualDiskResponse, error)
+	// AttachVirtualDisk attaches a virtual disk as a disk of the host without a
+	// drive letter, it stays attached until it's detached or the host restarts.
+	// It succeeds if the virtual disk is attached already.
+	AttachVirtualDisk(context.Context, *AttachVirtualDiskRequest) (*AttachVirtualDiskResponse, error)
+	// DetachVirtualDisk detaches a virtual disk, it succeeds if the virtual disk
+	// isn't attached or doesn't exist.
+	DetachVirtualDisk(context.Context, *DetachVirtualDiskRequest) (*DetachVirtualDiskResponse, error)
+	// ResizeVirtualDisk expands a virtual disk, the volumes of the disk are
+	// resized with the Volume API group afterwards. Virtual disks can't be shrunk,
+	// VHD (not VHDX) files can only be expanded while they're detached.
+	ResizeVirtualDisk(context.Context, *ResizeVirtualDiskRequest) (*ResizeVirtualDiskResponse, error)
+	// GetVirtualDisk gets the size and the attach state of a virtual disk.
+	GetVirtualDisk(context.Context, *GetVirtualDiskRequest) (*GetVirtualDiskResponse, error)
+}
+
+// UnimplementedVhdServer can be embedded to have forward compatible implementations.
+type UnimplementedVhdServer struct {
+}
+
+func (*UnimplementedVhdServer) CreateVirtualDisk(context.Context, *CreateVirtualDiskRequest) (*CreateVirtualDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVirtualDisk not implemented")
+}
+func (*UnimplementedVhdServer) AttachVirtualDisk(context.Context, *AttachVirtualDiskRequest) (*AttachVirtualDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachVirtualDisk not implemented")
+}
+func (*UnimplementedVhdServer) DetachVirtualDisk(context.Context, *DetachVirtualDiskRequest) (*DetachVirtualDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachVirtualDisk not implemented")
+}
+func (*UnimplementedVhdServer) ResizeVirtualDisk(context.Context, *ResizeVirtualDiskRequest) (*ResizeVirtualDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeVirtualDisk not implemented")
+}
+func (*UnimplementedVhdServer) GetVirtualDisk(context.Context, *GetVirtualDiskRequest) (*GetVirtualDiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVirtualDisk not implemented")
+}
+
+func RegisterVhdServer(s *grpc.Server, srv VhdServer) {
+	s.RegisterService(&_Vhd_serviceDesc, srv)
+}
+
+func _Vhd_CreateVirtualDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVirtualDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VhdServer).CreateVirtualDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Vhd/CreateVirtualDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VhdServer).CreateVirtualDisk(ctx, req.(*CreateVirtualDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vhd_AttachVirtualDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachVirtualDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VhdServer).AttachVirtualDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Vhd/AttachVirtualDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VhdServer).AttachVirtualDisk(ctx, req.(*AttachVirtualDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vhd_DetachVirtualDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachVirtualDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VhdServer).DetachVirtualDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Vhd/DetachVirtualDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VhdServer).DetachVirtualDisk(ctx, req.(*DetachVirtualDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vhd_ResizeVirtualDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeVirtualDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VhdServer).ResizeVirtualDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Vhd/ResizeVirtualDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VhdServer).ResizeVirtualDisk(ctx, req.(*ResizeVirtualDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vhd_GetVirtualDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVirtualDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VhdServer).GetVirtualDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Vhd/GetVirtualDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VhdServer).GetVirtualDisk(ctx, req.(*GetVirtualDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Vhd_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1alpha1.Vhd",
+	HandlerType: (*VhdServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVirtualDisk",
+			Handler:    _Vhd_CreateVirtualDisk_Handler,
+		},
+		{
+			MethodName: "AttachVirtualDisk",
+			Handler:    _Vhd_AttachVirtualDisk_Handler,
+		},
+		{
+			MethodName: "DetachVirtualDisk",
+			Handler:    _Vhd_DetachVirtualDisk_Handler,
+		},
+		{
+			MethodName: "ResizeVirtualDisk",
+			Handler:    _Vhd_ResizeVirtualDisk_Handler,
+		},
+		{
+			MethodName: "GetVirtualDisk",
+			Handler:    _Vhd_GetVirtualDisk_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/kubernetes-csi/csi-proxy/client/api/vhd/v1alpha1/api.proto",
+}
@@ -24,6 +24,164 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type RepairMode int32
+
+const (
+	// Scan the volume online and report the corruption found.
+	RepairMode_SCAN RepairMode = 0
+	// Take the volume offline briefly to fix the corruption found by
+	// previous scans.
+	RepairMode_SPOT_FIX RepairMode = 1
+	// Take the volume offline to scan it and fix the corruption found,
+	// the volume must not be mounted.
+	RepairMode_OFFLINE_SCAN_AND_FIX RepairMode = 2
+)
+
+// Enum value maps for RepairMode.
+var (
+	RepairMode_name = map[int32]string{
+		0: "SCAN",
+		1: "SPOT_FIX",
+		2: "OFFLINE_SCAN_AND_FIX",
+	}
+	RepairMode_value = map[string]int32{
+		"SCAN":                 0,
+		"SPOT_FIX":             1,
+		"OFFLINE_SCAN_AND_FIX": 2,
+	}
+)
+
+func (x RepairMode) Enum() *RepairMode {
+	p := new(RepairMode)
+	*p = x
+	return p
+}
+
+func (x RepairMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RepairMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (RepairMode) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_enumTypes[0]
+}
+
+func (x RepairMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RepairMode.Descriptor instead.
+func (RepairMode) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+type DeduplicationUsageType int32
+
+const (
+	// General purpose file servers.
+	DeduplicationUsageType_DEFAULT DeduplicationUsageType = 0
+	// Virtual disks of VDI servers.
+	DeduplicationUsageType_HYPER_V DeduplicationUsageType = 1
+	// Virtualized backup applications.
+	DeduplicationUsageType_BACKUP DeduplicationUsageType = 2
+)
+
+// Enum value maps for DeduplicationUsageType.
+var (
+	DeduplicationUsageType_name = map[int32]string{
+		0: "DEFAULT",
+		1: "HYPER_V",
+		2: "BACKUP",
+	}
+	DeduplicationUsageType_value = map[string]int32{
+		"DEFAULT": 0,
+		"HYPER_V": 1,
+		"BACKUP":  2,
+	}
+)
+
+func (x DeduplicationUsageType) Enum() *DeduplicationUsageType {
+	p := new(DeduplicationUsageType)
+	*p = x
+	return p
+}
+
+func (x DeduplicationUsageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeduplicationUsageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (DeduplicationUsageType) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_enumTypes[1]
+}
+
+func (x DeduplicationUsageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeduplicationUsageType.Descriptor instead.
+func (DeduplicationUsageType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+type VolumeEventType int32
+
+const (
+	// The volume appeared e.g. its disk was attached or partitioned.
+	VolumeEventType_ADDED VolumeEventType = 0
+	// The volume disappeared e.g. its disk was detached.
+	VolumeEventType_REMOVED VolumeEventType = 1
+	// The state of the volume changed.
+	VolumeEventType_CHANGED VolumeEventType = 2
+)
+
+// Enum value maps for VolumeEventType.
+var (
+	VolumeEventType_name = map[int32]string{
+		0: "ADDED",
+		1: "REMOVED",
+		2: "CHANGED",
+	}
+	VolumeEventType_value = map[string]int32{
+		"ADDED":   0,
+		"REMOVED": 1,
+		"CHANGED": 2,
+	}
+)
+
+func (x VolumeEventType) Enum() *VolumeEventType {
+	p := new(VolumeEventType)
+	*p = x
+	return p
+}
+
+func (x VolumeEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VolumeEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_enumTypes[2].Descriptor()
+}
+
+func (VolumeEventType) Type() protoreflect.EnumType {
+	return &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_enumTypes[2]
+}
+
+func (x VolumeEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VolumeEventType.Descriptor instead.
+func (VolumeEventType) EnumDescriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
 type ListVolumesOnDiskRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -33,6 +191,9 @@ type ListVolumesOnDiskRequest struct {
 	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
 	// The partition number (optional), by default it uses the first partition of the disk.
 	PartitionNumber uint32 `protobuf:"varint,2,opt,name=partition_number,json=partitionNumber,proto3" json:"partition_number,omitempty"`
+	// Only list the volumes that match the filter, all the volumes are listed
+	// if it isn't set.
+	Filter *VolumeFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
 func (x *ListVolumesOnDiskRequest) Reset() {
@@ -81,6 +242,13 @@ func (x *ListVolumesOnDiskRequest) GetPartitionNumber() uint32 {
 	return 0
 }
 
+func (x *ListVolumesOnDiskRequest) GetFilter() *VolumeFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
 type ListVolumesOnDiskResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -88,6 +256,9 @@ type ListVolumesOnDiskResponse struct {
 
 	// Volume device IDs of volumes on the specified disk.
 	VolumeIds []string `protobuf:"bytes,1,rep,name=volume_ids,json=volumeIds,proto3" json:"volume_ids,omitempty"`
+	// Volumes on the specified disk in the same order as volume_ids,
+	// disk_serial_number isn't set.
+	Volumes []*VolumeInfo `protobuf:"bytes,2,rep,name=volumes,proto3" json:"volumes,omitempty"`
 }
 
 func (x *ListVolumesOnDiskResponse) Reset() {
@@ -129,19 +300,25 @@ func (x *ListVolumesOnDiskResponse) GetVolumeIds() []string {
 	return nil
 }
 
-type MountVolumeRequest struct {
+func (x *ListVolumesOnDiskResponse) GetVolumes() []*VolumeInfo {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+type ListVolumesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Volume device ID of the volume to mount.
-	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	// Path in the host's file system where the volume needs to be mounted.
-	TargetPath string `protobuf:"bytes,2,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	// Only list the volumes that match the filter, all the volumes are listed
+	// if it isn't set.
+	Filter *VolumeFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 }
 
-func (x *MountVolumeRequest) Reset() {
-	*x = MountVolumeRequest{}
+func (x *ListVolumesRequest) Reset() {
+	*x = ListVolumesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -149,13 +326,13 @@ func (x *MountVolumeRequest) Reset() {
 	}
 }
 
-func (x *MountVolumeRequest) String() string {
+func (x *ListVolumesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MountVolumeRequest) ProtoMessage() {}
+func (*ListVolumesRequest) ProtoMessage() {}
 
-func (x *MountVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *ListVolumesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -167,33 +344,40 @@ func (x *MountVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MountVolumeRequest.ProtoReflect.Descriptor instead.
-func (*MountVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListVolumesRequest.ProtoReflect.Descriptor instead.
+func (*ListVolumesRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *MountVolumeRequest) GetVolumeId() string {
-	if x != nil {
-		return x.VolumeId
-	}
-	return ""
-}
-
-func (x *MountVolumeRequest) GetTargetPath() string {
+func (x *ListVolumesRequest) GetFilter() *VolumeFilter {
 	if x != nil {
-		return x.TargetPath
+		return x.Filter
 	}
-	return ""
+	return nil
 }
 
-type MountVolumeResponse struct {
+// VolumeFilter selects the volumes of ListVolumesOnDisk and ListVolumes, a
+// volume matches if it matches all the conditions that are set.
+type VolumeFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *MountVolumeResponse) Reset() {
-	*x = MountVolumeResponse{}
+	// Only the formatted volumes.
+	FormattedOnly bool `protobuf:"varint,1,opt,name=formatted_only,json=formattedOnly,proto3" json:"formatted_only,omitempty"`
+	// File system of the volumes e.g. NTFS, case insensitive. Empty matches
+	// any file system, including unformatted volumes unless formatted_only is
+	// set.
+	FileSystem string `protobuf:"bytes,2,opt,name=file_system,json=fileSystem,proto3" json:"file_system,omitempty"`
+	// Minimum size of the volumes in bytes, 0 means no lower bound.
+	MinSizeBytes uint64 `protobuf:"varint,3,opt,name=min_size_bytes,json=minSizeBytes,proto3" json:"min_size_bytes,omitempty"`
+	// Only the volumes with an access path (a drive letter or a mount point)
+	// besides their volume device ID path.
+	WithAccessPathOnly bool `protobuf:"varint,4,opt,name=with_access_path_only,json=withAccessPathOnly,proto3" json:"with_access_path_only,omitempty"`
+}
+
+func (x *VolumeFilter) Reset() {
+	*x = VolumeFilter{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -201,13 +385,13 @@ func (x *MountVolumeResponse) Reset() {
 	}
 }
 
-func (x *MountVolumeResponse) String() string {
+func (x *VolumeFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MountVolumeResponse) ProtoMessage() {}
+func (*VolumeFilter) ProtoMessage() {}
 
-func (x *MountVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *VolumeFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -219,24 +403,76 @@ func (x *MountVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MountVolumeResponse.ProtoReflect.Descriptor instead.
-func (*MountVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use VolumeFilter.ProtoReflect.Descriptor instead.
+func (*VolumeFilter) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{3}
 }
 
-type UnmountVolumeRequest struct {
+func (x *VolumeFilter) GetFormattedOnly() bool {
+	if x != nil {
+		return x.FormattedOnly
+	}
+	return false
+}
+
+func (x *VolumeFilter) GetFileSystem() string {
+	if x != nil {
+		return x.FileSystem
+	}
+	return ""
+}
+
+func (x *VolumeFilter) GetMinSizeBytes() uint64 {
+	if x != nil {
+		return x.MinSizeBytes
+	}
+	return 0
+}
+
+func (x *VolumeFilter) GetWithAccessPathOnly() bool {
+	if x != nil {
+		return x.WithAccessPathOnly
+	}
+	return false
+}
+
+type VolumeInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Volume device ID of the volume to dismount.
+	// Volume device ID of the volume.
 	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	// Path where the volume has been mounted.
-	TargetPath string `protobuf:"bytes,2,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
-}
-
-func (x *UnmountVolumeRequest) Reset() {
-	*x = UnmountVolumeRequest{}
+	// Disk device number of the disk where the volume is.
+	DiskNumber uint32 `protobuf:"varint,2,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Number of the partition of the volume, partition numbers start at 1 so
+	// it's 0 for volumes that aren't on a partition of a disk (e.g. optical
+	// drives), in which case disk_number isn't set either.
+	PartitionNumber uint32 `protobuf:"varint,3,opt,name=partition_number,json=partitionNumber,proto3" json:"partition_number,omitempty"`
+	// Size of the volume in bytes.
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Paths where the volume is accessible e.g. drive letters ("D:\"), mount
+	// points and the volume device ID path.
+	AccessPaths []string `protobuf:"bytes,5,rep,name=access_paths,json=accessPaths,proto3" json:"access_paths,omitempty"`
+	// Serial number of the file system e.g. 1A2B-3C4D, empty if the volume
+	// isn't formatted.
+	SerialNumber string `protobuf:"bytes,6,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	// Serial number of the disk where the volume is, empty if the volume isn't
+	// on a partition of a disk or if the disk doesn't have a serial number.
+	DiskSerialNumber string `protobuf:"bytes,7,opt,name=disk_serial_number,json=diskSerialNumber,proto3" json:"disk_serial_number,omitempty"`
+	// File system of the volume e.g. NTFS, empty if the volume isn't
+	// formatted.
+	FileSystem string `protobuf:"bytes,8,opt,name=file_system,json=fileSystem,proto3" json:"file_system,omitempty"`
+	// Label of the file system, empty if the volume isn't formatted or
+	// doesn't have a label.
+	Label string `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
+	// Name of the GPT partition of the volume, empty if the volume isn't on a
+	// partition of a GPT disk.
+	GptName string `protobuf:"bytes,10,opt,name=gpt_name,json=gptName,proto3" json:"gpt_name,omitempty"`
+}
+
+func (x *VolumeInfo) Reset() {
+	*x = VolumeInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -244,13 +480,13 @@ func (x *UnmountVolumeRequest) Reset() {
 	}
 }
 
-func (x *UnmountVolumeRequest) String() string {
+func (x *VolumeInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnmountVolumeRequest) ProtoMessage() {}
+func (*VolumeInfo) ProtoMessage() {}
 
-func (x *UnmountVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *VolumeInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -262,33 +498,92 @@ func (x *UnmountVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnmountVolumeRequest.ProtoReflect.Descriptor instead.
-func (*UnmountVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VolumeInfo.ProtoReflect.Descriptor instead.
+func (*VolumeInfo) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *UnmountVolumeRequest) GetVolumeId() string {
+func (x *VolumeInfo) GetVolumeId() string {
 	if x != nil {
 		return x.VolumeId
 	}
 	return ""
 }
 
-func (x *UnmountVolumeRequest) GetTargetPath() string {
+func (x *VolumeInfo) GetDiskNumber() uint32 {
 	if x != nil {
-		return x.TargetPath
+		return x.DiskNumber
+	}
+	return 0
+}
+
+func (x *VolumeInfo) GetPartitionNumber() uint32 {
+	if x != nil {
+		return x.PartitionNumber
+	}
+	return 0
+}
+
+func (x *VolumeInfo) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *VolumeInfo) GetAccessPaths() []string {
+	if x != nil {
+		return x.AccessPaths
+	}
+	return nil
+}
+
+func (x *VolumeInfo) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
 	}
 	return ""
 }
 
-type UnmountVolumeResponse struct {
+func (x *VolumeInfo) GetDiskSerialNumber() string {
+	if x != nil {
+		return x.DiskSerialNumber
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetFileSystem() string {
+	if x != nil {
+		return x.FileSystem
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *VolumeInfo) GetGptName() string {
+	if x != nil {
+		return x.GptName
+	}
+	return ""
+}
+
+type ListVolumesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Volumes of the host.
+	Volumes []*VolumeInfo `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
 }
 
-func (x *UnmountVolumeResponse) Reset() {
-	*x = UnmountVolumeResponse{}
+func (x *ListVolumesResponse) Reset() {
+	*x = ListVolumesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,13 +591,13 @@ func (x *UnmountVolumeResponse) Reset() {
 	}
 }
 
-func (x *UnmountVolumeResponse) String() string {
+func (x *ListVolumesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnmountVolumeResponse) ProtoMessage() {}
+func (*ListVolumesResponse) ProtoMessage() {}
 
-func (x *UnmountVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *ListVolumesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -314,22 +609,41 @@ func (x *UnmountVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnmountVolumeResponse.ProtoReflect.Descriptor instead.
-func (*UnmountVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListVolumesResponse.ProtoReflect.Descriptor instead.
+func (*ListVolumesResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{5}
 }
 
-type IsVolumeFormattedRequest struct {
+func (x *ListVolumesResponse) GetVolumes() []*VolumeInfo {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+// VolumeDiscoveryRule selects the volumes of DiscoverLocalVolumes, a volume
+// matches a rule if it matches all the conditions of the rule that are set and
+// a condition with several values matches if the volume matches one of them.
+// The comparisons are case insensitive.
+type VolumeDiscoveryRule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Volume device ID of the volume to check.
-	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Name of the rule e.g. the storage class of the volumes it matches, it's
+	// returned with the volumes.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Prefixes of the access paths of the volumes e.g. C:\local-volumes\ for
+	// the volumes mounted under that directory.
+	PathPrefixes []string `protobuf:"bytes,2,rep,name=path_prefixes,json=pathPrefixes,proto3" json:"path_prefixes,omitempty"`
+	// Names of the GPT partitions of the volumes.
+	GptNames []string `protobuf:"bytes,3,rep,name=gpt_names,json=gptNames,proto3" json:"gpt_names,omitempty"`
+	// Labels of the file systems of the volumes.
+	Labels []string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
 }
 
-func (x *IsVolumeFormattedRequest) Reset() {
-	*x = IsVolumeFormattedRequest{}
+func (x *VolumeDiscoveryRule) Reset() {
+	*x = VolumeDiscoveryRule{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -337,13 +651,13 @@ func (x *IsVolumeFormattedRequest) Reset() {
 	}
 }
 
-func (x *IsVolumeFormattedRequest) String() string {
+func (x *VolumeDiscoveryRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsVolumeFormattedRequest) ProtoMessage() {}
+func (*VolumeDiscoveryRule) ProtoMessage() {}
 
-func (x *IsVolumeFormattedRequest) ProtoReflect() protoreflect.Message {
+func (x *VolumeDiscoveryRule) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -355,29 +669,51 @@ func (x *IsVolumeFormattedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsVolumeFormattedRequest.ProtoReflect.Descriptor instead.
-func (*IsVolumeFormattedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use VolumeDiscoveryRule.ProtoReflect.Descriptor instead.
+func (*VolumeDiscoveryRule) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *IsVolumeFormattedRequest) GetVolumeId() string {
+func (x *VolumeDiscoveryRule) GetName() string {
 	if x != nil {
-		return x.VolumeId
+		return x.Name
 	}
 	return ""
 }
 
-type IsVolumeFormattedResponse struct {
+func (x *VolumeDiscoveryRule) GetPathPrefixes() []string {
+	if x != nil {
+		return x.PathPrefixes
+	}
+	return nil
+}
+
+func (x *VolumeDiscoveryRule) GetGptNames() []string {
+	if x != nil {
+		return x.GptNames
+	}
+	return nil
+}
+
+func (x *VolumeDiscoveryRule) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type DiscoverLocalVolumesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Is the volume formatted with NTFS.
-	Formatted bool `protobuf:"varint,1,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	// Discovery rules, a volume is returned with the first rule it matches.
+	// Every rule must have at least one condition.
+	Rules []*VolumeDiscoveryRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
-func (x *IsVolumeFormattedResponse) Reset() {
-	*x = IsVolumeFormattedResponse{}
+func (x *DiscoverLocalVolumesRequest) Reset() {
+	*x = DiscoverLocalVolumesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -385,13 +721,13 @@ func (x *IsVolumeFormattedResponse) Reset() {
 	}
 }
 
-func (x *IsVolumeFormattedResponse) String() string {
+func (x *DiscoverLocalVolumesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IsVolumeFormattedResponse) ProtoMessage() {}
+func (*DiscoverLocalVolumesRequest) ProtoMessage() {}
 
-func (x *IsVolumeFormattedResponse) ProtoReflect() protoreflect.Message {
+func (x *DiscoverLocalVolumesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -403,29 +739,32 @@ func (x *IsVolumeFormattedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IsVolumeFormattedResponse.ProtoReflect.Descriptor instead.
-func (*IsVolumeFormattedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscoverLocalVolumesRequest.ProtoReflect.Descriptor instead.
+func (*DiscoverLocalVolumesRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *IsVolumeFormattedResponse) GetFormatted() bool {
+func (x *DiscoverLocalVolumesRequest) GetRules() []*VolumeDiscoveryRule {
 	if x != nil {
-		return x.Formatted
+		return x.Rules
 	}
-	return false
+	return nil
 }
 
-type FormatVolumeRequest struct {
+type DiscoverLocalVolumesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Volume device ID of the volume to format.
-	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Volumes that matched a rule.
+	Volumes []*VolumeInfo `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	// Names of the rules that the volumes matched in the same order as
+	// volumes.
+	RuleNames []string `protobuf:"bytes,2,rep,name=rule_names,json=ruleNames,proto3" json:"rule_names,omitempty"`
 }
 
-func (x *FormatVolumeRequest) Reset() {
-	*x = FormatVolumeRequest{}
+func (x *DiscoverLocalVolumesResponse) Reset() {
+	*x = DiscoverLocalVolumesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -433,13 +772,13 @@ func (x *FormatVolumeRequest) Reset() {
 	}
 }
 
-func (x *FormatVolumeRequest) String() string {
+func (x *DiscoverLocalVolumesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FormatVolumeRequest) ProtoMessage() {}
+func (*DiscoverLocalVolumesResponse) ProtoMessage() {}
 
-func (x *FormatVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *DiscoverLocalVolumesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -451,26 +790,67 @@ func (x *FormatVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FormatVolumeRequest.ProtoReflect.Descriptor instead.
-func (*FormatVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DiscoverLocalVolumesResponse.ProtoReflect.Descriptor instead.
+func (*DiscoverLocalVolumesResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *FormatVolumeRequest) GetVolumeId() string {
+func (x *DiscoverLocalVolumesResponse) GetVolumes() []*VolumeInfo {
 	if x != nil {
-		return x.VolumeId
+		return x.Volumes
 	}
-	return ""
+	return nil
 }
 
-type FormatVolumeResponse struct {
+func (x *DiscoverLocalVolumesResponse) GetRuleNames() []string {
+	if x != nil {
+		return x.RuleNames
+	}
+	return nil
+}
+
+type MountVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to mount.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Path in the host's file system where the volume needs to be mounted.
+	TargetPath string `protobuf:"bytes,2,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	// Mount the volume read-only, the read-only attribute of the partition of
+	// the volume is set so writes are rejected by the OS. The attribute applies
+	// to all the paths where the volume is mounted, it's cleared when the
+	// volume is mounted without this flag.
+	ReadOnly bool `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// Mount the volume at a free drive letter instead of at target_path (which
+	// must be empty), the letter is picked from the drive letters csi-proxy is
+	// configured to use. If the volume already has a drive letter it's reused.
+	// The volume is unmounted with UnmountVolume using "<letter>:\" as target path.
+	AssignDriveLetter bool `protobuf:"varint,4,opt,name=assign_drive_letter,json=assignDriveLetter,proto3" json:"assign_drive_letter,omitempty"`
+	// Create the missing directories of target_path before the volume is
+	// mounted, instead of creating them with a separate call. The directories
+	// created are removed if the mount fails.
+	CreateTargetPath bool `protobuf:"varint,5,opt,name=create_target_path,json=createTargetPath,proto3" json:"create_target_path,omitempty"`
+	// Security descriptor in SDDL form of the directories created with
+	// create_target_path e.g. "D:P(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)". The
+	// directories inherit the permissions of their parent if it's empty.
+	TargetPathSddl string `protobuf:"bytes,6,opt,name=target_path_sddl,json=targetPathSddl,proto3" json:"target_path_sddl,omitempty"`
+	// Disable the write cache of the disk of the volume before it's mounted so
+	// that the writes complete once they're on stable storage, for workloads
+	// that require strict durability. The setting applies to the whole disk
+	// and stays after the volume is unmounted.
+	WriteThrough bool `protobuf:"varint,7,opt,name=write_through,json=writeThrough,proto3" json:"write_through,omitempty"`
+	// Exclude the files created in the volume from the Windows Search
+	// indexing (FILE_ATTRIBUTE_NOT_CONTENT_INDEXED on the root directory of
+	// the volume, which the files and directories created in the volume
+	// inherit), so that the indexer doesn't hold handles that make the
+	// unmount of the volume fail.
+	DisableIndexing bool `protobuf:"varint,8,opt,name=disable_indexing,json=disableIndexing,proto3" json:"disable_indexing,omitempty"`
 }
 
-func (x *FormatVolumeResponse) Reset() {
-	*x = FormatVolumeResponse{}
+func (x *MountVolumeRequest) Reset() {
+	*x = MountVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -478,13 +858,13 @@ func (x *FormatVolumeResponse) Reset() {
 	}
 }
 
-func (x *FormatVolumeResponse) String() string {
+func (x *MountVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FormatVolumeResponse) ProtoMessage() {}
+func (*MountVolumeRequest) ProtoMessage() {}
 
-func (x *FormatVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *MountVolumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -496,24 +876,82 @@ func (x *FormatVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FormatVolumeResponse.ProtoReflect.Descriptor instead.
-func (*FormatVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MountVolumeRequest.ProtoReflect.Descriptor instead.
+func (*MountVolumeRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{9}
 }
 
-type ResizeVolumeRequest struct {
+func (x *MountVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *MountVolumeRequest) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+func (x *MountVolumeRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *MountVolumeRequest) GetAssignDriveLetter() bool {
+	if x != nil {
+		return x.AssignDriveLetter
+	}
+	return false
+}
+
+func (x *MountVolumeRequest) GetCreateTargetPath() bool {
+	if x != nil {
+		return x.CreateTargetPath
+	}
+	return false
+}
+
+func (x *MountVolumeRequest) GetTargetPathSddl() string {
+	if x != nil {
+		return x.TargetPathSddl
+	}
+	return ""
+}
+
+func (x *MountVolumeRequest) GetWriteThrough() bool {
+	if x != nil {
+		return x.WriteThrough
+	}
+	return false
+}
+
+func (x *MountVolumeRequest) GetDisableIndexing() bool {
+	if x != nil {
+		return x.DisableIndexing
+	}
+	return false
+}
+
+type MountVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Volume device ID of the volume to resize.
-	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
-	// New size in bytes of the volume.
-	SizeBytes int64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Drive letter assigned to the volume e.g. "E", only set if the volume
+	// was mounted with assign_drive_letter.
+	DriveLetter string `protobuf:"bytes,1,opt,name=drive_letter,json=driveLetter,proto3" json:"drive_letter,omitempty"`
+	// Caveats of the mount that didn't fail it, e.g. the dirty bit of the
+	// volume is set, meant to be logged by the caller.
+	Warnings []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (x *ResizeVolumeRequest) Reset() {
-	*x = ResizeVolumeRequest{}
+func (x *MountVolumeResponse) Reset() {
+	*x = MountVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -521,13 +959,13 @@ func (x *ResizeVolumeRequest) Reset() {
 	}
 }
 
-func (x *ResizeVolumeRequest) String() string {
+func (x *MountVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResizeVolumeRequest) ProtoMessage() {}
+func (*MountVolumeResponse) ProtoMessage() {}
 
-func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
+func (x *MountVolumeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -539,33 +977,46 @@ func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResizeVolumeRequest.ProtoReflect.Descriptor instead.
-func (*ResizeVolumeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MountVolumeResponse.ProtoReflect.Descriptor instead.
+func (*MountVolumeResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ResizeVolumeRequest) GetVolumeId() string {
+func (x *MountVolumeResponse) GetDriveLetter() string {
 	if x != nil {
-		return x.VolumeId
+		return x.DriveLetter
 	}
 	return ""
 }
 
-func (x *ResizeVolumeRequest) GetSizeBytes() int64 {
+func (x *MountVolumeResponse) GetWarnings() []string {
 	if x != nil {
-		return x.SizeBytes
+		return x.Warnings
 	}
-	return 0
+	return nil
 }
 
-type ResizeVolumeResponse struct {
+type UnmountVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to dismount.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Path where the volume has been mounted.
+	TargetPath string `protobuf:"bytes,2,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	// Look for the open handles of the processes to the files of the volume and
+	// dismount its file system forcibly if there are any, the handles become
+	// invalid. The file system isn't dismounted if the volume is still mounted
+	// at other paths.
+	// When the unmount fails because of open handles, the error has the IN_USE
+	// code and names the processes, including Windows Search and Microsoft
+	// Defender scans.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
 }
 
-func (x *ResizeVolumeResponse) Reset() {
-	*x = ResizeVolumeResponse{}
+func (x *UnmountVolumeRequest) Reset() {
+	*x = UnmountVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -573,13 +1024,13 @@ func (x *ResizeVolumeResponse) Reset() {
 	}
 }
 
-func (x *ResizeVolumeResponse) String() string {
+func (x *UnmountVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResizeVolumeResponse) ProtoMessage() {}
+func (*UnmountVolumeRequest) ProtoMessage() {}
 
-func (x *ResizeVolumeResponse) ProtoReflect() protoreflect.Message {
+func (x *UnmountVolumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,22 +1042,48 @@ func (x *ResizeVolumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResizeVolumeResponse.ProtoReflect.Descriptor instead.
-func (*ResizeVolumeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnmountVolumeRequest.ProtoReflect.Descriptor instead.
+func (*UnmountVolumeRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{11}
 }
 
-type GetVolumeStatsRequest struct {
+func (x *UnmountVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *UnmountVolumeRequest) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+func (x *UnmountVolumeRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type OpenHandle struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Volume device Id of the volume to get the stats for.
-	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// ID of the process.
+	ProcessId uint32 `protobuf:"varint,1,opt,name=process_id,json=processId,proto3" json:"process_id,omitempty"`
+	// Executable name of the process e.g. pause.exe, empty if it can't be
+	// queried.
+	ProcessName string `protobuf:"bytes,2,opt,name=process_name,json=processName,proto3" json:"process_name,omitempty"`
+	// Path of the file or directory starting with the volume device ID.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *GetVolumeStatsRequest) Reset() {
-	*x = GetVolumeStatsRequest{}
+func (x *OpenHandle) Reset() {
+	*x = OpenHandle{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -614,13 +1091,13 @@ func (x *GetVolumeStatsRequest) Reset() {
 	}
 }
 
-func (x *GetVolumeStatsRequest) String() string {
+func (x *OpenHandle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVolumeStatsRequest) ProtoMessage() {}
+func (*OpenHandle) ProtoMessage() {}
 
-func (x *GetVolumeStatsRequest) ProtoReflect() protoreflect.Message {
+func (x *OpenHandle) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -632,31 +1109,43 @@ func (x *GetVolumeStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVolumeStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetVolumeStatsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenHandle.ProtoReflect.Descriptor instead.
+func (*OpenHandle) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetVolumeStatsRequest) GetVolumeId() string {
+func (x *OpenHandle) GetProcessId() uint32 {
 	if x != nil {
-		return x.VolumeId
+		return x.ProcessId
+	}
+	return 0
+}
+
+func (x *OpenHandle) GetProcessName() string {
+	if x != nil {
+		return x.ProcessName
 	}
 	return ""
 }
 
-type GetVolumeStatsResponse struct {
+func (x *OpenHandle) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type UnmountVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Total bytes
-	TotalBytes int64 `protobuf:"varint,1,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
-	// Used bytes
-	UsedBytes int64 `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	// Open handles to the files of the volume found when force is set.
+	OpenHandles []*OpenHandle `protobuf:"bytes,1,rep,name=open_handles,json=openHandles,proto3" json:"open_handles,omitempty"`
 }
 
-func (x *GetVolumeStatsResponse) Reset() {
-	*x = GetVolumeStatsResponse{}
+func (x *UnmountVolumeResponse) Reset() {
+	*x = UnmountVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -664,13 +1153,13 @@ func (x *GetVolumeStatsResponse) Reset() {
 	}
 }
 
-func (x *GetVolumeStatsResponse) String() string {
+func (x *UnmountVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVolumeStatsResponse) ProtoMessage() {}
+func (*UnmountVolumeResponse) ProtoMessage() {}
 
-func (x *GetVolumeStatsResponse) ProtoReflect() protoreflect.Message {
+func (x *UnmountVolumeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -682,36 +1171,29 @@ func (x *GetVolumeStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVolumeStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetVolumeStatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnmountVolumeResponse.ProtoReflect.Descriptor instead.
+func (*UnmountVolumeResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetVolumeStatsResponse) GetTotalBytes() int64 {
-	if x != nil {
-		return x.TotalBytes
-	}
-	return 0
-}
-
-func (x *GetVolumeStatsResponse) GetUsedBytes() int64 {
+func (x *UnmountVolumeResponse) GetOpenHandles() []*OpenHandle {
 	if x != nil {
-		return x.UsedBytes
+		return x.OpenHandles
 	}
-	return 0
+	return nil
 }
 
-type GetDiskNumberFromVolumeIDRequest struct {
+type IsVolumeFormattedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Volume device ID of the volume to get the disk number for.
+	// Volume device ID of the volume to check.
 	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
 }
 
-func (x *GetDiskNumberFromVolumeIDRequest) Reset() {
-	*x = GetDiskNumberFromVolumeIDRequest{}
+func (x *IsVolumeFormattedRequest) Reset() {
+	*x = IsVolumeFormattedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -719,13 +1201,13 @@ func (x *GetDiskNumberFromVolumeIDRequest) Reset() {
 	}
 }
 
-func (x *GetDiskNumberFromVolumeIDRequest) String() string {
+func (x *IsVolumeFormattedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiskNumberFromVolumeIDRequest) ProtoMessage() {}
+func (*IsVolumeFormattedRequest) ProtoMessage() {}
 
-func (x *GetDiskNumberFromVolumeIDRequest) ProtoReflect() protoreflect.Message {
+func (x *IsVolumeFormattedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -737,29 +1219,38 @@ func (x *GetDiskNumberFromVolumeIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiskNumberFromVolumeIDRequest.ProtoReflect.Descriptor instead.
-func (*GetDiskNumberFromVolumeIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IsVolumeFormattedRequest.ProtoReflect.Descriptor instead.
+func (*IsVolumeFormattedRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetDiskNumberFromVolumeIDRequest) GetVolumeId() string {
+func (x *IsVolumeFormattedRequest) GetVolumeId() string {
 	if x != nil {
 		return x.VolumeId
 	}
 	return ""
 }
 
-type GetDiskNumberFromVolumeIDResponse struct {
+type IsVolumeFormattedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Corresponding disk number.
-	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+	// Is the volume formatted with NTFS.
+	Formatted bool `protobuf:"varint,1,opt,name=formatted,proto3" json:"formatted,omitempty"`
+	// File system of the volume e.g. NTFS or ReFS, empty if the volume isn't
+	// formatted. It lets drivers detect that a volume is formatted with a file
+	// system other than the requested one.
+	FileSystemType string `protobuf:"bytes,2,opt,name=file_system_type,json=fileSystemType,proto3" json:"file_system_type,omitempty"`
+	// The volume isn't formatted with a file system that Windows recognizes but
+	// it isn't raw either, its first sectors have data: its file system may be
+	// damaged or unsupported by Windows. Formatting it would erase its data, it
+	// should be checked (e.g. with RepairVolume) or reported instead.
+	Unrecognized bool `protobuf:"varint,3,opt,name=unrecognized,proto3" json:"unrecognized,omitempty"`
 }
 
-func (x *GetDiskNumberFromVolumeIDResponse) Reset() {
-	*x = GetDiskNumberFromVolumeIDResponse{}
+func (x *IsVolumeFormattedResponse) Reset() {
+	*x = IsVolumeFormattedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -767,13 +1258,13 @@ func (x *GetDiskNumberFromVolumeIDResponse) Reset() {
 	}
 }
 
-func (x *GetDiskNumberFromVolumeIDResponse) String() string {
+func (x *IsVolumeFormattedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiskNumberFromVolumeIDResponse) ProtoMessage() {}
+func (*IsVolumeFormattedResponse) ProtoMessage() {}
 
-func (x *GetDiskNumberFromVolumeIDResponse) ProtoReflect() protoreflect.Message {
+func (x *IsVolumeFormattedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -785,29 +1276,66 @@ func (x *GetDiskNumberFromVolumeIDResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiskNumberFromVolumeIDResponse.ProtoReflect.Descriptor instead.
-func (*GetDiskNumberFromVolumeIDResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IsVolumeFormattedResponse.ProtoReflect.Descriptor instead.
+func (*IsVolumeFormattedResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetDiskNumberFromVolumeIDResponse) GetDiskNumber() uint32 {
+func (x *IsVolumeFormattedResponse) GetFormatted() bool {
 	if x != nil {
-		return x.DiskNumber
+		return x.Formatted
 	}
-	return 0
+	return false
 }
 
-type GetVolumeIDFromTargetPathRequest struct {
+func (x *IsVolumeFormattedResponse) GetFileSystemType() string {
+	if x != nil {
+		return x.FileSystemType
+	}
+	return ""
+}
+
+func (x *IsVolumeFormattedResponse) GetUnrecognized() bool {
+	if x != nil {
+		return x.Unrecognized
+	}
+	return false
+}
+
+type FormatVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The target path.
-	TargetPath string `protobuf:"bytes,1,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	// Volume device ID of the volume to format.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Allocation unit (cluster) size in bytes of the file system e.g. 65536 (64K),
+	// it must be one of the cluster sizes supported by the file system.
+	// 0 uses the default allocation unit size of the file system.
+	AllocationUnitSize uint32 `protobuf:"varint,2,opt,name=allocation_unit_size,json=allocationUnitSize,proto3" json:"allocation_unit_size,omitempty"`
+	// Label of the file system e.g. the name of the PV, it can't be longer
+	// than 32 characters (NTFS limit). An empty label leaves the file system
+	// without a label.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// Do a full format (the volume is scanned for bad sectors and zeroed)
+	// instead of a quick format. A full format of a big volume can take hours
+	// so it runs in the background, FormatVolume returns once the format has
+	// started and GetFormatVolumeStatus reports when it completes.
+	FullFormat bool `protobuf:"varint,4,opt,name=full_format,json=fullFormat,proto3" json:"full_format,omitempty"`
+	// Enable the NTFS compression of the files of the volume e.g. for log heavy
+	// workloads that request it in their mount options.
+	Compress bool `protobuf:"varint,5,opt,name=compress,proto3" json:"compress,omitempty"`
+	// Format with large (4K) file record segments, recommended with compression
+	// since compressed files are heavily fragmented and their extents may not
+	// fit in the default (1K) file record segments.
+	UseLargeFrs bool `protobuf:"varint,6,opt,name=use_large_frs,json=useLargeFrs,proto3" json:"use_large_frs,omitempty"`
+	// Disable the write cache of the disk of the volume before it's formatted,
+	// see MountVolumeRequest.write_through.
+	WriteThrough bool `protobuf:"varint,7,opt,name=write_through,json=writeThrough,proto3" json:"write_through,omitempty"`
 }
 
-func (x *GetVolumeIDFromTargetPathRequest) Reset() {
-	*x = GetVolumeIDFromTargetPathRequest{}
+func (x *FormatVolumeRequest) Reset() {
+	*x = FormatVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,13 +1343,13 @@ func (x *GetVolumeIDFromTargetPathRequest) Reset() {
 	}
 }
 
-func (x *GetVolumeIDFromTargetPathRequest) String() string {
+func (x *FormatVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVolumeIDFromTargetPathRequest) ProtoMessage() {}
+func (*FormatVolumeRequest) ProtoMessage() {}
 
-func (x *GetVolumeIDFromTargetPathRequest) ProtoReflect() protoreflect.Message {
+func (x *FormatVolumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -833,29 +1361,68 @@ func (x *GetVolumeIDFromTargetPathRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVolumeIDFromTargetPathRequest.ProtoReflect.Descriptor instead.
-func (*GetVolumeIDFromTargetPathRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FormatVolumeRequest.ProtoReflect.Descriptor instead.
+func (*FormatVolumeRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetVolumeIDFromTargetPathRequest) GetTargetPath() string {
+func (x *FormatVolumeRequest) GetVolumeId() string {
 	if x != nil {
-		return x.TargetPath
+		return x.VolumeId
 	}
 	return ""
 }
 
-type GetVolumeIDFromTargetPathResponse struct {
+func (x *FormatVolumeRequest) GetAllocationUnitSize() uint32 {
+	if x != nil {
+		return x.AllocationUnitSize
+	}
+	return 0
+}
+
+func (x *FormatVolumeRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *FormatVolumeRequest) GetFullFormat() bool {
+	if x != nil {
+		return x.FullFormat
+	}
+	return false
+}
+
+func (x *FormatVolumeRequest) GetCompress() bool {
+	if x != nil {
+		return x.Compress
+	}
+	return false
+}
+
+func (x *FormatVolumeRequest) GetUseLargeFrs() bool {
+	if x != nil {
+		return x.UseLargeFrs
+	}
+	return false
+}
+
+func (x *FormatVolumeRequest) GetWriteThrough() bool {
+	if x != nil {
+		return x.WriteThrough
+	}
+	return false
+}
+
+type FormatVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The volume device ID.
-	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
 }
 
-func (x *GetVolumeIDFromTargetPathResponse) Reset() {
-	*x = GetVolumeIDFromTargetPathResponse{}
+func (x *FormatVolumeResponse) Reset() {
+	*x = FormatVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -863,13 +1430,13 @@ func (x *GetVolumeIDFromTargetPathResponse) Reset() {
 	}
 }
 
-func (x *GetVolumeIDFromTargetPathResponse) String() string {
+func (x *FormatVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVolumeIDFromTargetPathResponse) ProtoMessage() {}
+func (*FormatVolumeResponse) ProtoMessage() {}
 
-func (x *GetVolumeIDFromTargetPathResponse) ProtoReflect() protoreflect.Message {
+func (x *FormatVolumeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -881,29 +1448,22 @@ func (x *GetVolumeIDFromTargetPathResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVolumeIDFromTargetPathResponse.ProtoReflect.Descriptor instead.
-func (*GetVolumeIDFromTargetPathResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FormatVolumeResponse.ProtoReflect.Descriptor instead.
+func (*FormatVolumeResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetVolumeIDFromTargetPathResponse) GetVolumeId() string {
-	if x != nil {
-		return x.VolumeId
-	}
-	return ""
-}
-
-type GetClosestVolumeIDFromTargetPathRequest struct {
+type GetFormatVolumeStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The target path.
-	TargetPath string `protobuf:"bytes,1,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+	// Volume device ID of the volume being formatted.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
 }
 
-func (x *GetClosestVolumeIDFromTargetPathRequest) Reset() {
-	*x = GetClosestVolumeIDFromTargetPathRequest{}
+func (x *GetFormatVolumeStatusRequest) Reset() {
+	*x = GetFormatVolumeStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -911,13 +1471,13 @@ func (x *GetClosestVolumeIDFromTargetPathRequest) Reset() {
 	}
 }
 
-func (x *GetClosestVolumeIDFromTargetPathRequest) String() string {
+func (x *GetFormatVolumeStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClosestVolumeIDFromTargetPathRequest) ProtoMessage() {}
+func (*GetFormatVolumeStatusRequest) ProtoMessage() {}
 
-func (x *GetClosestVolumeIDFromTargetPathRequest) ProtoReflect() protoreflect.Message {
+func (x *GetFormatVolumeStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -929,29 +1489,31 @@ func (x *GetClosestVolumeIDFromTargetPathRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClosestVolumeIDFromTargetPathRequest.ProtoReflect.Descriptor instead.
-func (*GetClosestVolumeIDFromTargetPathRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFormatVolumeStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetFormatVolumeStatusRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetClosestVolumeIDFromTargetPathRequest) GetTargetPath() string {
+func (x *GetFormatVolumeStatusRequest) GetVolumeId() string {
 	if x != nil {
-		return x.TargetPath
+		return x.VolumeId
 	}
 	return ""
 }
 
-type GetClosestVolumeIDFromTargetPathResponse struct {
+type GetFormatVolumeStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The volume device ID.
-	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// True while the full format of the volume is running.
+	InProgress bool `protobuf:"varint,1,opt,name=in_progress,json=inProgress,proto3" json:"in_progress,omitempty"`
+	// Error of the full format if it failed, empty while it's running or if it succeeded.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *GetClosestVolumeIDFromTargetPathResponse) Reset() {
-	*x = GetClosestVolumeIDFromTargetPathResponse{}
+func (x *GetFormatVolumeStatusResponse) Reset() {
+	*x = GetFormatVolumeStatusResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -959,13 +1521,13 @@ func (x *GetClosestVolumeIDFromTargetPathResponse) Reset() {
 	}
 }
 
-func (x *GetClosestVolumeIDFromTargetPathResponse) String() string {
+func (x *GetFormatVolumeStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClosestVolumeIDFromTargetPathResponse) ProtoMessage() {}
+func (*GetFormatVolumeStatusResponse) ProtoMessage() {}
 
-func (x *GetClosestVolumeIDFromTargetPathResponse) ProtoReflect() protoreflect.Message {
+func (x *GetFormatVolumeStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -977,29 +1539,42 @@ func (x *GetClosestVolumeIDFromTargetPathResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClosestVolumeIDFromTargetPathResponse.ProtoReflect.Descriptor instead.
-func (*GetClosestVolumeIDFromTargetPathResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFormatVolumeStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetFormatVolumeStatusResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetClosestVolumeIDFromTargetPathResponse) GetVolumeId() string {
+func (x *GetFormatVolumeStatusResponse) GetInProgress() bool {
 	if x != nil {
-		return x.VolumeId
+		return x.InProgress
+	}
+	return false
+}
+
+func (x *GetFormatVolumeStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-type WriteVolumeCacheRequest struct {
+type ResizeVolumeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Volume device ID of the volume to flush the cache.
+	// Volume device ID of the volume to resize.
 	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// New size in bytes of the volume, sizes above 2^63-1 bytes are rejected.
+	SizeBytes uint64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Shrink the volume if size_bytes is smaller than its current size, the
+	// volume is left as is otherwise. A volume can't be shrunk below the
+	// minimum size supported by its partition i.e. the space used by its data.
+	AllowShrink bool `protobuf:"varint,3,opt,name=allow_shrink,json=allowShrink,proto3" json:"allow_shrink,omitempty"`
 }
 
-func (x *WriteVolumeCacheRequest) Reset() {
-	*x = WriteVolumeCacheRequest{}
+func (x *ResizeVolumeRequest) Reset() {
+	*x = ResizeVolumeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1007,13 +1582,13 @@ func (x *WriteVolumeCacheRequest) Reset() {
 	}
 }
 
-func (x *WriteVolumeCacheRequest) String() string {
+func (x *ResizeVolumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteVolumeCacheRequest) ProtoMessage() {}
+func (*ResizeVolumeRequest) ProtoMessage() {}
 
-func (x *WriteVolumeCacheRequest) ProtoReflect() protoreflect.Message {
+func (x *ResizeVolumeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,26 +1600,44 @@ func (x *WriteVolumeCacheRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteVolumeCacheRequest.ProtoReflect.Descriptor instead.
-func (*WriteVolumeCacheRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResizeVolumeRequest.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *WriteVolumeCacheRequest) GetVolumeId() string {
+func (x *ResizeVolumeRequest) GetVolumeId() string {
 	if x != nil {
 		return x.VolumeId
 	}
 	return ""
 }
 
-type WriteVolumeCacheResponse struct {
+func (x *ResizeVolumeRequest) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ResizeVolumeRequest) GetAllowShrink() bool {
+	if x != nil {
+		return x.AllowShrink
+	}
+	return false
+}
+
+type ResizeVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Caveats of the resize that didn't fail it, e.g. the volume wasn't
+	// shrunk because allow_shrink isn't set, meant to be logged by the caller.
+	Warnings []string `protobuf:"bytes,1,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (x *WriteVolumeCacheResponse) Reset() {
-	*x = WriteVolumeCacheResponse{}
+func (x *ResizeVolumeResponse) Reset() {
+	*x = ResizeVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1052,13 +1645,13 @@ func (x *WriteVolumeCacheResponse) Reset() {
 	}
 }
 
-func (x *WriteVolumeCacheResponse) String() string {
+func (x *ResizeVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteVolumeCacheResponse) ProtoMessage() {}
+func (*ResizeVolumeResponse) ProtoMessage() {}
 
-func (x *WriteVolumeCacheResponse) ProtoReflect() protoreflect.Message {
+func (x *ResizeVolumeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1070,278 +1663,4893 @@ func (x *WriteVolumeCacheResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteVolumeCacheResponse.ProtoReflect.Descriptor instead.
-func (*WriteVolumeCacheResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResizeVolumeResponse.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{21}
 }
 
-var File_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto protoreflect.FileDescriptor
+func (x *ResizeVolumeResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
 
-var file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDesc = []byte{
-	0x0a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2f, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x76, 0x32, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x31, 0x22, 0x66, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x73, 0x4f, 0x6e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72,
-	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3a, 0x0a, 0x19,
-	0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x4f, 0x6e, 0x44, 0x69, 0x73,
-	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x12, 0x4d, 0x6f, 0x75, 0x6e,
-	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x15, 0x0a, 0x13,
-	0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x14, 0x55, 0x6e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x6e, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x37, 0x0a, 0x18, 0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x19, 0x49,
-	0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x51, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x3f, 0x0a,
-	0x20, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x72,
-	0x6f, 0x6d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x44,
-	0x0a, 0x21, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46,
-	0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74,
-	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x40, 0x0a, 0x21, 0x47, 0x65, 0x74,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x27, 0x47,
-	0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
-	0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x47, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f,
-	0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64,
-	0x22, 0x36, 0x0a, 0x17, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43,
-	0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xbe, 0x08, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
-	0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x4f, 0x6e,
-	0x44, 0x69, 0x73, 0x6b, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x4f, 0x6e, 0x44, 0x69, 0x73,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
-	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x4f,
-	0x6e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4c, 0x0a, 0x0b, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c,
-	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76,
-	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a,
-	0x0d, 0x55, 0x6e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1e,
-	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x55, 0x6e, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x5e, 0x0a, 0x11, 0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x32, 0x61,
-	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
-	0x65, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x56,
-	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
-	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x46, 0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x76, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
-	0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x2a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x32,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
-	0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8b, 0x01, 0x0a, 0x20, 0x47,
-	0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
-	0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x31, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f,
-	0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44,
-	0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x57, 0x72, 0x69, 0x74,
-	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x21, 0x2e, 0x76,
-	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x6f, 0x6c,
-	0x75, 0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65,
-	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63,
-	0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2f, 0x76,
-	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type RepairVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to repair.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Repair mode.
+	Mode RepairMode `protobuf:"varint,2,opt,name=mode,proto3,enum=v2alpha1.RepairMode" json:"mode,omitempty"`
 }
 
-var (
-	file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescOnce sync.Once
-	file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDesc
-)
+func (x *RepairVolumeRequest) Reset() {
+	*x = RepairVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP() []byte {
-	file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescOnce.Do(func() {
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescData)
-	})
-	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescData
+func (x *RepairVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
-var file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_goTypes = []interface{}{
-	(*ListVolumesOnDiskRequest)(nil),                 // 0: v2alpha1.ListVolumesOnDiskRequest
-	(*ListVolumesOnDiskResponse)(nil),                // 1: v2alpha1.ListVolumesOnDiskResponse
-	(*MountVolumeRequest)(nil),                       // 2: v2alpha1.MountVolumeRequest
-	(*MountVolumeResponse)(nil),                      // 3: v2alpha1.MountVolumeResponse
-	(*UnmountVolumeRequest)(nil),                     // 4: v2alpha1.UnmountVolumeRequest
-	(*UnmountVolumeResponse)(nil),                    // 5: v2alpha1.UnmountVolumeResponse
-	(*IsVolumeFormattedRequest)(nil),                 // 6: v2alpha1.IsVolumeFormattedRequest
-	(*IsVolumeFormattedResponse)(nil),                // 7: v2alpha1.IsVolumeFormattedResponse
-	(*FormatVolumeRequest)(nil),                      // 8: v2alpha1.FormatVolumeRequest
-	(*FormatVolumeResponse)(nil),                     // 9: v2alpha1.FormatVolumeResponse
-	(*ResizeVolumeRequest)(nil),                      // 10: v2alpha1.ResizeVolumeRequest
-	(*ResizeVolumeResponse)(nil),                     // 11: v2alpha1.ResizeVolumeResponse
-	(*GetVolumeStatsRequest)(nil),                    // 12: v2alpha1.GetVolumeStatsRequest
-	(*GetVolumeStatsResponse)(nil),                   // 13: v2alpha1.GetVolumeStatsResponse
-	(*GetDiskNumberFromVolumeIDRequest)(nil),         // 14: v2alpha1.GetDiskNumberFromVolumeIDRequest
-	(*GetDiskNumberFromVolumeIDResponse)(nil),        // 15: v2alpha1.GetDiskNumberFromVolumeIDResponse
-	(*GetVolumeIDFromTargetPathRequest)(nil),         // 16: v2alpha1.GetVolumeIDFromTargetPathRequest
-	(*GetVolumeIDFromTargetPathResponse)(nil),        // 17: v2alpha1.GetVolumeIDFromTargetPathResponse
-	(*GetClosestVolumeIDFromTargetPathRequest)(nil),  // 18: v2alpha1.GetClosestVolumeIDFromTargetPathRequest
-	(*GetClosestVolumeIDFromTargetPathResponse)(nil), // 19: v2alpha1.GetClosestVolumeIDFromTargetPathResponse
-	(*WriteVolumeCacheRequest)(nil),                  // 20: v2alpha1.WriteVolumeCacheRequest
-	(*WriteVolumeCacheResponse)(nil),                 // 21: v2alpha1.WriteVolumeCacheResponse
+func (*RepairVolumeRequest) ProtoMessage() {}
+
+func (x *RepairVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_depIdxs = []int32{
-	0,  // 0: v2alpha1.Volume.ListVolumesOnDisk:input_type -> v2alpha1.ListVolumesOnDiskRequest
-	2,  // 1: v2alpha1.Volume.MountVolume:input_type -> v2alpha1.MountVolumeRequest
-	4,  // 2: v2alpha1.Volume.UnmountVolume:input_type -> v2alpha1.UnmountVolumeRequest
-	6,  // 3: v2alpha1.Volume.IsVolumeFormatted:input_type -> v2alpha1.IsVolumeFormattedRequest
-	8,  // 4: v2alpha1.Volume.FormatVolume:input_type -> v2alpha1.FormatVolumeRequest
-	10, // 5: v2alpha1.Volume.ResizeVolume:input_type -> v2alpha1.ResizeVolumeRequest
-	12, // 6: v2alpha1.Volume.GetVolumeStats:input_type -> v2alpha1.GetVolumeStatsRequest
-	14, // 7: v2alpha1.Volume.GetDiskNumberFromVolumeID:input_type -> v2alpha1.GetDiskNumberFromVolumeIDRequest
-	16, // 8: v2alpha1.Volume.GetVolumeIDFromTargetPath:input_type -> v2alpha1.GetVolumeIDFromTargetPathRequest
-	18, // 9: v2alpha1.Volume.GetClosestVolumeIDFromTargetPath:input_type -> v2alpha1.GetClosestVolumeIDFromTargetPathRequest
-	20, // 10: v2alpha1.Volume.WriteVolumeCache:input_type -> v2alpha1.WriteVolumeCacheRequest
-	1,  // 11: v2alpha1.Volume.ListVolumesOnDisk:output_type -> v2alpha1.ListVolumesOnDiskResponse
-	3,  // 12: v2alpha1.Volume.MountVolume:output_type -> v2alpha1.MountVolumeResponse
-	5,  // 13: v2alpha1.Volume.UnmountVolume:output_type -> v2alpha1.UnmountVolumeResponse
-	7,  // 14: v2alpha1.Volume.IsVolumeFormatted:output_type -> v2alpha1.IsVolumeFormattedResponse
-	9,  // 15: v2alpha1.Volume.FormatVolume:output_type -> v2alpha1.FormatVolumeResponse
-	11, // 16: v2alpha1.Volume.ResizeVolume:output_type -> v2alpha1.ResizeVolumeResponse
-	13, // 17: v2alpha1.Volume.GetVolumeStats:output_type -> v2alpha1.GetVolumeStatsResponse
-	15, // 18: v2alpha1.Volume.GetDiskNumberFromVolumeID:output_type -> v2alpha1.GetDiskNumberFromVolumeIDResponse
-	17, // 19: v2alpha1.Volume.GetVolumeIDFromTargetPath:output_type -> v2alpha1.GetVolumeIDFromTargetPathResponse
-	19, // 20: v2alpha1.Volume.GetClosestVolumeIDFromTargetPath:output_type -> v2alpha1.GetClosestVolumeIDFromTargetPathResponse
-	21, // 21: v2alpha1.Volume.WriteVolumeCache:output_type -> v2alpha1.WriteVolumeCacheResponse
-	11, // [11:22] is the sub-list for method output_type
-	0,  // [0:11] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+
+// Deprecated: Use RepairVolumeRequest.ProtoReflect.Descriptor instead.
+func (*RepairVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{22}
 }
 
-func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_init() }
-func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_init() {
-	if File_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto != nil {
-		return
+func (x *RepairVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListVolumesOnDiskRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListVolumesOnDiskResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MountVolumeRequest); i {
-			case 0:
-				return &v.state
+	return ""
+}
+
+func (x *RepairVolumeRequest) GetMode() RepairMode {
+	if x != nil {
+		return x.Mode
+	}
+	return RepairMode_SCAN
+}
+
+type RepairVolumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Result reported by Repair-Volume e.g. NoErrorsFound.
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *RepairVolumeResponse) Reset() {
+	*x = RepairVolumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairVolumeResponse) ProtoMessage() {}
+
+func (x *RepairVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairVolumeResponse.ProtoReflect.Descriptor instead.
+func (*RepairVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RepairVolumeResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type IsVolumeDirtyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to check.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *IsVolumeDirtyRequest) Reset() {
+	*x = IsVolumeDirtyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsVolumeDirtyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsVolumeDirtyRequest) ProtoMessage() {}
+
+func (x *IsVolumeDirtyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsVolumeDirtyRequest.ProtoReflect.Descriptor instead.
+func (*IsVolumeDirtyRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *IsVolumeDirtyRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type IsVolumeDirtyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// True if the dirty bit of the volume is set.
+	Dirty bool `protobuf:"varint,1,opt,name=dirty,proto3" json:"dirty,omitempty"`
+}
+
+func (x *IsVolumeDirtyResponse) Reset() {
+	*x = IsVolumeDirtyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IsVolumeDirtyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsVolumeDirtyResponse) ProtoMessage() {}
+
+func (x *IsVolumeDirtyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsVolumeDirtyResponse.ProtoReflect.Descriptor instead.
+func (*IsVolumeDirtyResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *IsVolumeDirtyResponse) GetDirty() bool {
+	if x != nil {
+		return x.Dirty
+	}
+	return false
+}
+
+// OptimizeVolumeRequest selects the optimizations of a volume, if none is set
+// the volume gets the default optimization of its media type, like the
+// Optimize Drives tool does.
+type OptimizeVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to optimize.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Send TRIM requests for the free space of the volume.
+	Retrim bool `protobuf:"varint,2,opt,name=retrim,proto3" json:"retrim,omitempty"`
+	// Defragment the volume.
+	Defrag bool `protobuf:"varint,3,opt,name=defrag,proto3" json:"defrag,omitempty"`
+	// Consolidate the slabs of a thin provisioned volume.
+	SlabConsolidate bool `protobuf:"varint,4,opt,name=slab_consolidate,json=slabConsolidate,proto3" json:"slab_consolidate,omitempty"`
+}
+
+func (x *OptimizeVolumeRequest) Reset() {
+	*x = OptimizeVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizeVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeVolumeRequest) ProtoMessage() {}
+
+func (x *OptimizeVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeVolumeRequest.ProtoReflect.Descriptor instead.
+func (*OptimizeVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OptimizeVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *OptimizeVolumeRequest) GetRetrim() bool {
+	if x != nil {
+		return x.Retrim
+	}
+	return false
+}
+
+func (x *OptimizeVolumeRequest) GetDefrag() bool {
+	if x != nil {
+		return x.Defrag
+	}
+	return false
+}
+
+func (x *OptimizeVolumeRequest) GetSlabConsolidate() bool {
+	if x != nil {
+		return x.SlabConsolidate
+	}
+	return false
+}
+
+type OptimizeVolumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OptimizeVolumeResponse) Reset() {
+	*x = OptimizeVolumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizeVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeVolumeResponse) ProtoMessage() {}
+
+func (x *OptimizeVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeVolumeResponse.ProtoReflect.Descriptor instead.
+func (*OptimizeVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{27}
+}
+
+type FormatVolumeAsyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Format to run in the background, full formats are rejected since
+	// FormatVolume runs them in the background already.
+	Request *FormatVolumeRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *FormatVolumeAsyncRequest) Reset() {
+	*x = FormatVolumeAsyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatVolumeAsyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatVolumeAsyncRequest) ProtoMessage() {}
+
+func (x *FormatVolumeAsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatVolumeAsyncRequest.ProtoReflect.Descriptor instead.
+func (*FormatVolumeAsyncRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *FormatVolumeAsyncRequest) GetRequest() *FormatVolumeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type FormatVolumeAsyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation to poll with GetOperation.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *FormatVolumeAsyncResponse) Reset() {
+	*x = FormatVolumeAsyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FormatVolumeAsyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FormatVolumeAsyncResponse) ProtoMessage() {}
+
+func (x *FormatVolumeAsyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FormatVolumeAsyncResponse.ProtoReflect.Descriptor instead.
+func (*FormatVolumeAsyncResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FormatVolumeAsyncResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type ResizeVolumeAsyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Resize to run in the background.
+	Request *ResizeVolumeRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *ResizeVolumeAsyncRequest) Reset() {
+	*x = ResizeVolumeAsyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResizeVolumeAsyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeVolumeAsyncRequest) ProtoMessage() {}
+
+func (x *ResizeVolumeAsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeVolumeAsyncRequest.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeAsyncRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResizeVolumeAsyncRequest) GetRequest() *ResizeVolumeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ResizeVolumeAsyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation to poll with GetOperation.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *ResizeVolumeAsyncResponse) Reset() {
+	*x = ResizeVolumeAsyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResizeVolumeAsyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeVolumeAsyncResponse) ProtoMessage() {}
+
+func (x *ResizeVolumeAsyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeVolumeAsyncResponse.ProtoReflect.Descriptor instead.
+func (*ResizeVolumeAsyncResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResizeVolumeAsyncResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type RepairVolumeAsyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Repair to run in the background.
+	Request *RepairVolumeRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *RepairVolumeAsyncRequest) Reset() {
+	*x = RepairVolumeAsyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairVolumeAsyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairVolumeAsyncRequest) ProtoMessage() {}
+
+func (x *RepairVolumeAsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairVolumeAsyncRequest.ProtoReflect.Descriptor instead.
+func (*RepairVolumeAsyncRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *RepairVolumeAsyncRequest) GetRequest() *RepairVolumeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type RepairVolumeAsyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation to poll with GetOperation.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *RepairVolumeAsyncResponse) Reset() {
+	*x = RepairVolumeAsyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairVolumeAsyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairVolumeAsyncResponse) ProtoMessage() {}
+
+func (x *RepairVolumeAsyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairVolumeAsyncResponse.ProtoReflect.Descriptor instead.
+func (*RepairVolumeAsyncResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RepairVolumeAsyncResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type OptimizeVolumeAsyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Optimization to run in the background.
+	Request *OptimizeVolumeRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+}
+
+func (x *OptimizeVolumeAsyncRequest) Reset() {
+	*x = OptimizeVolumeAsyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizeVolumeAsyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeVolumeAsyncRequest) ProtoMessage() {}
+
+func (x *OptimizeVolumeAsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeVolumeAsyncRequest.ProtoReflect.Descriptor instead.
+func (*OptimizeVolumeAsyncRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *OptimizeVolumeAsyncRequest) GetRequest() *OptimizeVolumeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type OptimizeVolumeAsyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation to poll with GetOperation.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *OptimizeVolumeAsyncResponse) Reset() {
+	*x = OptimizeVolumeAsyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OptimizeVolumeAsyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OptimizeVolumeAsyncResponse) ProtoMessage() {}
+
+func (x *OptimizeVolumeAsyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OptimizeVolumeAsyncResponse.ProtoReflect.Descriptor instead.
+func (*OptimizeVolumeAsyncResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *OptimizeVolumeAsyncResponse) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type GetOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the operation returned by the asynchronous method that started it.
+	// The operations are kept in memory for an hour after they complete, the
+	// operations unknown after a restart of csi-proxy fail with NOT_FOUND.
+	OperationId string `protobuf:"bytes,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+}
+
+func (x *GetOperationRequest) Reset() {
+	*x = GetOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationRequest) ProtoMessage() {}
+
+func (x *GetOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetOperationRequest) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+type GetOperationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Method of the operation e.g. FormatVolume.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// Volume device ID of the volume of the operation.
+	VolumeId string `protobuf:"bytes,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// True once the operation completed, whether it succeeded or failed.
+	Done bool `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	// Error of the operation if it failed, empty while it's running or if it succeeded.
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Stable code of the error e.g. ACCESS_DENIED, empty if the error is unknown.
+	ErrorCode string `protobuf:"bytes,5,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// Result of ResizeVolumeAsync once it succeeded.
+	ResizeVolumeResult *ResizeVolumeResponse `protobuf:"bytes,6,opt,name=resize_volume_result,json=resizeVolumeResult,proto3" json:"resize_volume_result,omitempty"`
+	// Result of RepairVolumeAsync once it succeeded.
+	RepairVolumeResult *RepairVolumeResponse `protobuf:"bytes,7,opt,name=repair_volume_result,json=repairVolumeResult,proto3" json:"repair_volume_result,omitempty"`
+}
+
+func (x *GetOperationResponse) Reset() {
+	*x = GetOperationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOperationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationResponse) ProtoMessage() {}
+
+func (x *GetOperationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationResponse.ProtoReflect.Descriptor instead.
+func (*GetOperationResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetOperationResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *GetOperationResponse) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *GetOperationResponse) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *GetOperationResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GetOperationResponse) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *GetOperationResponse) GetResizeVolumeResult() *ResizeVolumeResponse {
+	if x != nil {
+		return x.ResizeVolumeResult
+	}
+	return nil
+}
+
+func (x *GetOperationResponse) GetRepairVolumeResult() *RepairVolumeResponse {
+	if x != nil {
+		return x.RepairVolumeResult
+	}
+	return nil
+}
+
+type GetVolumeLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetVolumeLabelRequest) Reset() {
+	*x = GetVolumeLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeLabelRequest) ProtoMessage() {}
+
+func (x *GetVolumeLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeLabelRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeLabelRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetVolumeLabelRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetVolumeLabelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Label of the file system, empty if it doesn't have a label.
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *GetVolumeLabelResponse) Reset() {
+	*x = GetVolumeLabelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeLabelResponse) ProtoMessage() {}
+
+func (x *GetVolumeLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeLabelResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeLabelResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetVolumeLabelResponse) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type GetVolumeSerialNumberRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetVolumeSerialNumberRequest) Reset() {
+	*x = GetVolumeSerialNumberRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeSerialNumberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeSerialNumberRequest) ProtoMessage() {}
+
+func (x *GetVolumeSerialNumberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeSerialNumberRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeSerialNumberRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetVolumeSerialNumberRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetVolumeSerialNumberResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Serial number of the file system e.g. 1A2B-3C4D as displayed by the vol
+	// command, empty if the volume isn't formatted.
+	SerialNumber string `protobuf:"bytes,1,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+}
+
+func (x *GetVolumeSerialNumberResponse) Reset() {
+	*x = GetVolumeSerialNumberResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeSerialNumberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeSerialNumberResponse) ProtoMessage() {}
+
+func (x *GetVolumeSerialNumberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeSerialNumberResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeSerialNumberResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetVolumeSerialNumberResponse) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type SetVolumeLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// New label of the file system, it can't be longer than 32 characters
+	// (NTFS limit). An empty label removes the label of the file system.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *SetVolumeLabelRequest) Reset() {
+	*x = SetVolumeLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVolumeLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVolumeLabelRequest) ProtoMessage() {}
+
+func (x *SetVolumeLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVolumeLabelRequest.ProtoReflect.Descriptor instead.
+func (*SetVolumeLabelRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetVolumeLabelRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *SetVolumeLabelRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type SetVolumeLabelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetVolumeLabelResponse) Reset() {
+	*x = SetVolumeLabelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVolumeLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVolumeLabelResponse) ProtoMessage() {}
+
+func (x *SetVolumeLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVolumeLabelResponse.ProtoReflect.Descriptor instead.
+func (*SetVolumeLabelResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{43}
+}
+
+type GetVolumeStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device Id of the volume to get the stats for.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Only get the sizes, the file system type and the label of the volume,
+	// health_status and operational_status are left empty. The sizes are queried
+	// with a system call, the health requires a much slower WMI query.
+	SizesOnly bool `protobuf:"varint,2,opt,name=sizes_only,json=sizesOnly,proto3" json:"sizes_only,omitempty"`
+}
+
+func (x *GetVolumeStatsRequest) Reset() {
+	*x = GetVolumeStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeStatsRequest) ProtoMessage() {}
+
+func (x *GetVolumeStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeStatsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetVolumeStatsRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *GetVolumeStatsRequest) GetSizesOnly() bool {
+	if x != nil {
+		return x.SizesOnly
+	}
+	return false
+}
+
+type GetVolumeStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Total bytes
+	TotalBytes uint64 `protobuf:"varint,1,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+	// Used bytes
+	UsedBytes uint64 `protobuf:"varint,2,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	// File system of the volume e.g. NTFS, empty if the volume isn't formatted.
+	FileSystemType string `protobuf:"bytes,3,opt,name=file_system_type,json=fileSystemType,proto3" json:"file_system_type,omitempty"`
+	// Label of the file system.
+	FileSystemLabel string `protobuf:"bytes,4,opt,name=file_system_label,json=fileSystemLabel,proto3" json:"file_system_label,omitempty"`
+	// Health of the volume: Healthy, Warning or Unhealthy.
+	HealthStatus string `protobuf:"bytes,5,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
+	// Operational status of the volume e.g. OK, Full Repair Needed, Scan Needed.
+	OperationalStatus string `protobuf:"bytes,6,opt,name=operational_status,json=operationalStatus,proto3" json:"operational_status,omitempty"`
+	// Serial number of the file system e.g. 1A2B-3C4D, empty if the volume
+	// isn't formatted.
+	SerialNumber string `protobuf:"bytes,7,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+}
+
+func (x *GetVolumeStatsResponse) Reset() {
+	*x = GetVolumeStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeStatsResponse) ProtoMessage() {}
+
+func (x *GetVolumeStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeStatsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetVolumeStatsResponse) GetTotalBytes() uint64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *GetVolumeStatsResponse) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *GetVolumeStatsResponse) GetFileSystemType() string {
+	if x != nil {
+		return x.FileSystemType
+	}
+	return ""
+}
+
+func (x *GetVolumeStatsResponse) GetFileSystemLabel() string {
+	if x != nil {
+		return x.FileSystemLabel
+	}
+	return ""
+}
+
+func (x *GetVolumeStatsResponse) GetHealthStatus() string {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return ""
+}
+
+func (x *GetVolumeStatsResponse) GetOperationalStatus() string {
+	if x != nil {
+		return x.OperationalStatus
+	}
+	return ""
+}
+
+func (x *GetVolumeStatsResponse) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+type GetVolumeHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetVolumeHealthRequest) Reset() {
+	*x = GetVolumeHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeHealthRequest) ProtoMessage() {}
+
+func (x *GetVolumeHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeHealthRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetVolumeHealthRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetVolumeHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Health of the volume: Healthy, Warning, Unhealthy or Unknown.
+	HealthStatus string `protobuf:"bytes,1,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
+	// Operational status of the volume e.g. OK, Scan Needed, Spot Fix Needed
+	// or Full Repair Needed.
+	OperationalStatus string `protobuf:"bytes,2,opt,name=operational_status,json=operationalStatus,proto3" json:"operational_status,omitempty"`
+	// The volume isn't healthy or its operational status isn't OK, the
+	// abnormal condition of a CSI VolumeCondition.
+	Abnormal bool `protobuf:"varint,3,opt,name=abnormal,proto3" json:"abnormal,omitempty"`
+	// Description of the condition of the volume, the message of a CSI
+	// VolumeCondition. It names the RepairVolume mode that fixes the volume
+	// if its file system needs a repair.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GetVolumeHealthResponse) Reset() {
+	*x = GetVolumeHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeHealthResponse) ProtoMessage() {}
+
+func (x *GetVolumeHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeHealthResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetVolumeHealthResponse) GetHealthStatus() string {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return ""
+}
+
+func (x *GetVolumeHealthResponse) GetOperationalStatus() string {
+	if x != nil {
+		return x.OperationalStatus
+	}
+	return ""
+}
+
+func (x *GetVolumeHealthResponse) GetAbnormal() bool {
+	if x != nil {
+		return x.Abnormal
+	}
+	return false
+}
+
+func (x *GetVolumeHealthResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetDiskNumberFromVolumeIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to get the disk number for.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetDiskNumberFromVolumeIDRequest) Reset() {
+	*x = GetDiskNumberFromVolumeIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskNumberFromVolumeIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskNumberFromVolumeIDRequest) ProtoMessage() {}
+
+func (x *GetDiskNumberFromVolumeIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskNumberFromVolumeIDRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskNumberFromVolumeIDRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetDiskNumberFromVolumeIDRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetDiskNumberFromVolumeIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Corresponding disk number.
+	DiskNumber uint32 `protobuf:"varint,1,opt,name=disk_number,json=diskNumber,proto3" json:"disk_number,omitempty"`
+}
+
+func (x *GetDiskNumberFromVolumeIDResponse) Reset() {
+	*x = GetDiskNumberFromVolumeIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskNumberFromVolumeIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskNumberFromVolumeIDResponse) ProtoMessage() {}
+
+func (x *GetDiskNumberFromVolumeIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskNumberFromVolumeIDResponse.ProtoReflect.Descriptor instead.
+func (*GetDiskNumberFromVolumeIDResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetDiskNumberFromVolumeIDResponse) GetDiskNumber() uint32 {
+	if x != nil {
+		return x.DiskNumber
+	}
+	return 0
+}
+
+type GetVolumeIDFromTargetPathRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The target path.
+	TargetPath string `protobuf:"bytes,1,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+}
+
+func (x *GetVolumeIDFromTargetPathRequest) Reset() {
+	*x = GetVolumeIDFromTargetPathRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeIDFromTargetPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeIDFromTargetPathRequest) ProtoMessage() {}
+
+func (x *GetVolumeIDFromTargetPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeIDFromTargetPathRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeIDFromTargetPathRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetVolumeIDFromTargetPathRequest) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+type GetVolumeIDFromTargetPathResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The volume device ID.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetVolumeIDFromTargetPathResponse) Reset() {
+	*x = GetVolumeIDFromTargetPathResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeIDFromTargetPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeIDFromTargetPathResponse) ProtoMessage() {}
+
+func (x *GetVolumeIDFromTargetPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeIDFromTargetPathResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeIDFromTargetPathResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetVolumeIDFromTargetPathResponse) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetClosestVolumeIDFromTargetPathRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The target path.
+	TargetPath string `protobuf:"bytes,1,opt,name=target_path,json=targetPath,proto3" json:"target_path,omitempty"`
+}
+
+func (x *GetClosestVolumeIDFromTargetPathRequest) Reset() {
+	*x = GetClosestVolumeIDFromTargetPathRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClosestVolumeIDFromTargetPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClosestVolumeIDFromTargetPathRequest) ProtoMessage() {}
+
+func (x *GetClosestVolumeIDFromTargetPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClosestVolumeIDFromTargetPathRequest.ProtoReflect.Descriptor instead.
+func (*GetClosestVolumeIDFromTargetPathRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetClosestVolumeIDFromTargetPathRequest) GetTargetPath() string {
+	if x != nil {
+		return x.TargetPath
+	}
+	return ""
+}
+
+type GetClosestVolumeIDFromTargetPathResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The volume device ID.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetClosestVolumeIDFromTargetPathResponse) Reset() {
+	*x = GetClosestVolumeIDFromTargetPathResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClosestVolumeIDFromTargetPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClosestVolumeIDFromTargetPathResponse) ProtoMessage() {}
+
+func (x *GetClosestVolumeIDFromTargetPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClosestVolumeIDFromTargetPathResponse.ProtoReflect.Descriptor instead.
+func (*GetClosestVolumeIDFromTargetPathResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetClosestVolumeIDFromTargetPathResponse) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetVolumeAccessPathsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetVolumeAccessPathsRequest) Reset() {
+	*x = GetVolumeAccessPathsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeAccessPathsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeAccessPathsRequest) ProtoMessage() {}
+
+func (x *GetVolumeAccessPathsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeAccessPathsRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeAccessPathsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetVolumeAccessPathsRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetVolumeAccessPathsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Paths where the volume is accessible e.g. drive letters ("D:"), mount
+	// points ("C:ar\lib\kubelet\...\globalmount") and the volume device ID
+	// path, like the access paths of Get-Partition.
+	AccessPaths []string `protobuf:"bytes,1,rep,name=access_paths,json=accessPaths,proto3" json:"access_paths,omitempty"`
+	// Drive letters of the volume e.g. "D", they're in access_paths too.
+	DriveLetters []string `protobuf:"bytes,2,rep,name=drive_letters,json=driveLetters,proto3" json:"drive_letters,omitempty"`
+}
+
+func (x *GetVolumeAccessPathsResponse) Reset() {
+	*x = GetVolumeAccessPathsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeAccessPathsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeAccessPathsResponse) ProtoMessage() {}
+
+func (x *GetVolumeAccessPathsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeAccessPathsResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeAccessPathsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetVolumeAccessPathsResponse) GetAccessPaths() []string {
+	if x != nil {
+		return x.AccessPaths
+	}
+	return nil
+}
+
+func (x *GetVolumeAccessPathsResponse) GetDriveLetters() []string {
+	if x != nil {
+		return x.DriveLetters
+	}
+	return nil
+}
+
+type CleanupVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume, it must be mounted by csi-proxy.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Clean up only if the used space of the volume is at least this
+	// percentage of its size e.g. 80. 0 always cleans up.
+	UsageThresholdPercent uint32 `protobuf:"varint,2,opt,name=usage_threshold_percent,json=usageThresholdPercent,proto3" json:"usage_threshold_percent,omitempty"`
+	// Empty the recycle bin of the volume.
+	RecycleBin bool `protobuf:"varint,3,opt,name=recycle_bin,json=recycleBin,proto3" json:"recycle_bin,omitempty"`
+	// Directories relative to the root of the volume whose content is deleted
+	// e.g. "tmp", the directories themselves are kept.
+	TempDirs []string `protobuf:"bytes,4,rep,name=temp_dirs,json=tempDirs,proto3" json:"temp_dirs,omitempty"`
+}
+
+func (x *CleanupVolumeRequest) Reset() {
+	*x = CleanupVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanupVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupVolumeRequest) ProtoMessage() {}
+
+func (x *CleanupVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupVolumeRequest.ProtoReflect.Descriptor instead.
+func (*CleanupVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CleanupVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *CleanupVolumeRequest) GetUsageThresholdPercent() uint32 {
+	if x != nil {
+		return x.UsageThresholdPercent
+	}
+	return 0
+}
+
+func (x *CleanupVolumeRequest) GetRecycleBin() bool {
+	if x != nil {
+		return x.RecycleBin
+	}
+	return false
+}
+
+func (x *CleanupVolumeRequest) GetTempDirs() []string {
+	if x != nil {
+		return x.TempDirs
+	}
+	return nil
+}
+
+type CleanupVolumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The usage crossed the threshold and the volume was cleaned up.
+	CleanedUp bool `protobuf:"varint,1,opt,name=cleaned_up,json=cleanedUp,proto3" json:"cleaned_up,omitempty"`
+	// Used bytes of the volume before the cleanup.
+	UsedBytesBefore uint64 `protobuf:"varint,2,opt,name=used_bytes_before,json=usedBytesBefore,proto3" json:"used_bytes_before,omitempty"`
+	// Used bytes of the volume after the cleanup, equal to used_bytes_before
+	// if the volume wasn't cleaned up.
+	UsedBytesAfter uint64 `protobuf:"varint,3,opt,name=used_bytes_after,json=usedBytesAfter,proto3" json:"used_bytes_after,omitempty"`
+	// Files that couldn't be deleted e.g. because they're in use, meant to be
+	// logged by the caller.
+	Warnings []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+}
+
+func (x *CleanupVolumeResponse) Reset() {
+	*x = CleanupVolumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanupVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupVolumeResponse) ProtoMessage() {}
+
+func (x *CleanupVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupVolumeResponse.ProtoReflect.Descriptor instead.
+func (*CleanupVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CleanupVolumeResponse) GetCleanedUp() bool {
+	if x != nil {
+		return x.CleanedUp
+	}
+	return false
+}
+
+func (x *CleanupVolumeResponse) GetUsedBytesBefore() uint64 {
+	if x != nil {
+		return x.UsedBytesBefore
+	}
+	return 0
+}
+
+func (x *CleanupVolumeResponse) GetUsedBytesAfter() uint64 {
+	if x != nil {
+		return x.UsedBytesAfter
+	}
+	return 0
+}
+
+func (x *CleanupVolumeResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+type EnableDeduplicationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Workload the deduplication is tuned for.
+	UsageType DeduplicationUsageType `protobuf:"varint,2,opt,name=usage_type,json=usageType,proto3,enum=v2alpha1.DeduplicationUsageType" json:"usage_type,omitempty"`
+}
+
+func (x *EnableDeduplicationRequest) Reset() {
+	*x = EnableDeduplicationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableDeduplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableDeduplicationRequest) ProtoMessage() {}
+
+func (x *EnableDeduplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableDeduplicationRequest.ProtoReflect.Descriptor instead.
+func (*EnableDeduplicationRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *EnableDeduplicationRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *EnableDeduplicationRequest) GetUsageType() DeduplicationUsageType {
+	if x != nil {
+		return x.UsageType
+	}
+	return DeduplicationUsageType_DEFAULT
+}
+
+type EnableDeduplicationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EnableDeduplicationResponse) Reset() {
+	*x = EnableDeduplicationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnableDeduplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnableDeduplicationResponse) ProtoMessage() {}
+
+func (x *EnableDeduplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnableDeduplicationResponse.ProtoReflect.Descriptor instead.
+func (*EnableDeduplicationResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{59}
+}
+
+type GetDeduplicationStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetDeduplicationStatusRequest) Reset() {
+	*x = GetDeduplicationStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeduplicationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeduplicationStatusRequest) ProtoMessage() {}
+
+func (x *GetDeduplicationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeduplicationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetDeduplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetDeduplicationStatusRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetDeduplicationStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The deduplication of the volume is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Workload the deduplication is tuned for e.g. Default, empty if the
+	// deduplication was never enabled.
+	UsageType string `protobuf:"bytes,2,opt,name=usage_type,json=usageType,proto3" json:"usage_type,omitempty"`
+	// Bytes saved by the deduplication.
+	SavedBytes uint64 `protobuf:"varint,3,opt,name=saved_bytes,json=savedBytes,proto3" json:"saved_bytes,omitempty"`
+	// Percentage of the size of the files saved by the deduplication.
+	SavingsRate uint32 `protobuf:"varint,4,opt,name=savings_rate,json=savingsRate,proto3" json:"savings_rate,omitempty"`
+	// Number of files deduplicated.
+	OptimizedFilesCount uint64 `protobuf:"varint,5,opt,name=optimized_files_count,json=optimizedFilesCount,proto3" json:"optimized_files_count,omitempty"`
+	// Number of files that are eligible for deduplication.
+	InPolicyFilesCount uint64 `protobuf:"varint,6,opt,name=in_policy_files_count,json=inPolicyFilesCount,proto3" json:"in_policy_files_count,omitempty"`
+	// Time of the last optimization job in seconds since the Unix epoch, 0 if
+	// the volume was never optimized.
+	LastOptimizationTime int64 `protobuf:"varint,7,opt,name=last_optimization_time,json=lastOptimizationTime,proto3" json:"last_optimization_time,omitempty"`
+	// Result of the last optimization job.
+	LastOptimizationResult string `protobuf:"bytes,8,opt,name=last_optimization_result,json=lastOptimizationResult,proto3" json:"last_optimization_result,omitempty"`
+}
+
+func (x *GetDeduplicationStatusResponse) Reset() {
+	*x = GetDeduplicationStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDeduplicationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeduplicationStatusResponse) ProtoMessage() {}
+
+func (x *GetDeduplicationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeduplicationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetDeduplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetDeduplicationStatusResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetDeduplicationStatusResponse) GetUsageType() string {
+	if x != nil {
+		return x.UsageType
+	}
+	return ""
+}
+
+func (x *GetDeduplicationStatusResponse) GetSavedBytes() uint64 {
+	if x != nil {
+		return x.SavedBytes
+	}
+	return 0
+}
+
+func (x *GetDeduplicationStatusResponse) GetSavingsRate() uint32 {
+	if x != nil {
+		return x.SavingsRate
+	}
+	return 0
+}
+
+func (x *GetDeduplicationStatusResponse) GetOptimizedFilesCount() uint64 {
+	if x != nil {
+		return x.OptimizedFilesCount
+	}
+	return 0
+}
+
+func (x *GetDeduplicationStatusResponse) GetInPolicyFilesCount() uint64 {
+	if x != nil {
+		return x.InPolicyFilesCount
+	}
+	return 0
+}
+
+func (x *GetDeduplicationStatusResponse) GetLastOptimizationTime() int64 {
+	if x != nil {
+		return x.LastOptimizationTime
+	}
+	return 0
+}
+
+func (x *GetDeduplicationStatusResponse) GetLastOptimizationResult() string {
+	if x != nil {
+		return x.LastOptimizationResult
+	}
+	return ""
+}
+
+type SetVolumeCompressionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Enable (true) or disable (false) the compression.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SetVolumeCompressionRequest) Reset() {
+	*x = SetVolumeCompressionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVolumeCompressionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVolumeCompressionRequest) ProtoMessage() {}
+
+func (x *SetVolumeCompressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVolumeCompressionRequest.ProtoReflect.Descriptor instead.
+func (*SetVolumeCompressionRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetVolumeCompressionRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *SetVolumeCompressionRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetVolumeCompressionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetVolumeCompressionResponse) Reset() {
+	*x = SetVolumeCompressionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetVolumeCompressionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVolumeCompressionResponse) ProtoMessage() {}
+
+func (x *SetVolumeCompressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVolumeCompressionResponse.ProtoReflect.Descriptor instead.
+func (*SetVolumeCompressionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{63}
+}
+
+type WriteVolumeCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to flush the cache.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *WriteVolumeCacheRequest) Reset() {
+	*x = WriteVolumeCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteVolumeCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteVolumeCacheRequest) ProtoMessage() {}
+
+func (x *WriteVolumeCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteVolumeCacheRequest.ProtoReflect.Descriptor instead.
+func (*WriteVolumeCacheRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *WriteVolumeCacheRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type WriteVolumeCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WriteVolumeCacheResponse) Reset() {
+	*x = WriteVolumeCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteVolumeCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteVolumeCacheResponse) ProtoMessage() {}
+
+func (x *WriteVolumeCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteVolumeCacheResponse.ProtoReflect.Descriptor instead.
+func (*WriteVolumeCacheResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{65}
+}
+
+type WriteVolumeCachesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device IDs of the volumes to flush the cache, the volumes mounted
+	// by csi-proxy are flushed if it's empty.
+	VolumeIds []string `protobuf:"bytes,1,rep,name=volume_ids,json=volumeIds,proto3" json:"volume_ids,omitempty"`
+}
+
+func (x *WriteVolumeCachesRequest) Reset() {
+	*x = WriteVolumeCachesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteVolumeCachesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteVolumeCachesRequest) ProtoMessage() {}
+
+func (x *WriteVolumeCachesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteVolumeCachesRequest.ProtoReflect.Descriptor instead.
+func (*WriteVolumeCachesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *WriteVolumeCachesRequest) GetVolumeIds() []string {
+	if x != nil {
+		return x.VolumeIds
+	}
+	return nil
+}
+
+type VolumeCacheError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume whose cache couldn't be flushed.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Error that occurred while flushing the cache of the volume.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *VolumeCacheError) Reset() {
+	*x = VolumeCacheError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeCacheError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeCacheError) ProtoMessage() {}
+
+func (x *VolumeCacheError) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeCacheError.ProtoReflect.Descriptor instead.
+func (*VolumeCacheError) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *VolumeCacheError) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *VolumeCacheError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type WriteVolumeCachesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volumes whose cache couldn't be flushed, the caches of the other
+	// volumes were written to disk.
+	Errors []*VolumeCacheError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *WriteVolumeCachesResponse) Reset() {
+	*x = WriteVolumeCachesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WriteVolumeCachesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WriteVolumeCachesResponse) ProtoMessage() {}
+
+func (x *WriteVolumeCachesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WriteVolumeCachesResponse.ProtoReflect.Descriptor instead.
+func (*WriteVolumeCachesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *WriteVolumeCachesResponse) GetErrors() []*VolumeCacheError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type SyncVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to sync.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *SyncVolumeRequest) Reset() {
+	*x = SyncVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncVolumeRequest) ProtoMessage() {}
+
+func (x *SyncVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncVolumeRequest.ProtoReflect.Descriptor instead.
+func (*SyncVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SyncVolumeRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type SyncVolumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SyncVolumeResponse) Reset() {
+	*x = SyncVolumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncVolumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncVolumeResponse) ProtoMessage() {}
+
+func (x *SyncVolumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncVolumeResponse.ProtoReflect.Descriptor instead.
+func (*SyncVolumeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{70}
+}
+
+type GetVolumeWriteCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume whose disk is queried.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *GetVolumeWriteCacheRequest) Reset() {
+	*x = GetVolumeWriteCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeWriteCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeWriteCacheRequest) ProtoMessage() {}
+
+func (x *GetVolumeWriteCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeWriteCacheRequest.ProtoReflect.Descriptor instead.
+func (*GetVolumeWriteCacheRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetVolumeWriteCacheRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type GetVolumeWriteCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The write cache of the disk of the volume is enabled, i.e. the volume
+	// isn't write-through.
+	WriteCacheEnabled bool `protobuf:"varint,1,opt,name=write_cache_enabled,json=writeCacheEnabled,proto3" json:"write_cache_enabled,omitempty"`
+}
+
+func (x *GetVolumeWriteCacheResponse) Reset() {
+	*x = GetVolumeWriteCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVolumeWriteCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolumeWriteCacheResponse) ProtoMessage() {}
+
+func (x *GetVolumeWriteCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolumeWriteCacheResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeWriteCacheResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetVolumeWriteCacheResponse) GetWriteCacheEnabled() bool {
+	if x != nil {
+		return x.WriteCacheEnabled
+	}
+	return false
+}
+
+type WatchVolumesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device IDs of the volumes to watch, all the volumes are watched if
+	// it's empty.
+	VolumeIds []string `protobuf:"bytes,1,rep,name=volume_ids,json=volumeIds,proto3" json:"volume_ids,omitempty"`
+}
+
+func (x *WatchVolumesRequest) Reset() {
+	*x = WatchVolumesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchVolumesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVolumesRequest) ProtoMessage() {}
+
+func (x *WatchVolumesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVolumesRequest.ProtoReflect.Descriptor instead.
+func (*WatchVolumesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *WatchVolumesRequest) GetVolumeIds() []string {
+	if x != nil {
+		return x.VolumeIds
+	}
+	return nil
+}
+
+// VolumeEvent is a change of a volume, the state of the volume is its state
+// after the event, before the event for a removed volume.
+type VolumeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the event.
+	Type VolumeEventType `protobuf:"varint,1,opt,name=type,proto3,enum=v2alpha1.VolumeEventType" json:"type,omitempty"`
+	// Volume device ID of the volume.
+	VolumeId string `protobuf:"bytes,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Size of the volume in bytes.
+	SizeBytes uint64 `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Drive letter of the volume, empty if it doesn't have one.
+	DriveLetter string `protobuf:"bytes,4,opt,name=drive_letter,json=driveLetter,proto3" json:"drive_letter,omitempty"`
+	// File system of the volume e.g. "NTFS", "Unknown" if it isn't formatted.
+	FileSystemType string `protobuf:"bytes,5,opt,name=file_system_type,json=fileSystemType,proto3" json:"file_system_type,omitempty"`
+	// Health status of the volume e.g. "Healthy".
+	HealthStatus string `protobuf:"bytes,6,opt,name=health_status,json=healthStatus,proto3" json:"health_status,omitempty"`
+	// Operational status of the volume e.g. "OK".
+	OperationalStatus string `protobuf:"bytes,7,opt,name=operational_status,json=operationalStatus,proto3" json:"operational_status,omitempty"`
+}
+
+func (x *VolumeEvent) Reset() {
+	*x = VolumeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeEvent) ProtoMessage() {}
+
+func (x *VolumeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeEvent.ProtoReflect.Descriptor instead.
+func (*VolumeEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *VolumeEvent) GetType() VolumeEventType {
+	if x != nil {
+		return x.Type
+	}
+	return VolumeEventType_ADDED
+}
+
+func (x *VolumeEvent) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *VolumeEvent) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *VolumeEvent) GetDriveLetter() string {
+	if x != nil {
+		return x.DriveLetter
+	}
+	return ""
+}
+
+func (x *VolumeEvent) GetFileSystemType() string {
+	if x != nil {
+		return x.FileSystemType
+	}
+	return ""
+}
+
+func (x *VolumeEvent) GetHealthStatus() string {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return ""
+}
+
+func (x *VolumeEvent) GetOperationalStatus() string {
+	if x != nil {
+		return x.OperationalStatus
+	}
+	return ""
+}
+
+type PrepareStagingPathRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Absolute path of the staging directory e.g.
+	// "C:\var\lib\kubelet\plugins\kubernetes.io\csi\driver\volume\globalmount",
+	// its missing parent directories are created too.
+	StagingPath string `protobuf:"bytes,1,opt,name=staging_path,json=stagingPath,proto3" json:"staging_path,omitempty"`
+	// Security descriptor in SDDL form of the staging directory e.g.
+	// "D:P(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)", it's applied to the directories
+	// created. The permissions of an existing directory aren't changed, the call
+	// fails if it isn't a staging path prepared before. The directories inherit
+	// the permissions of their parent if it's empty.
+	Sddl string `protobuf:"bytes,2,opt,name=sddl,proto3" json:"sddl,omitempty"`
+}
+
+func (x *PrepareStagingPathRequest) Reset() {
+	*x = PrepareStagingPathRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrepareStagingPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareStagingPathRequest) ProtoMessage() {}
+
+func (x *PrepareStagingPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareStagingPathRequest.ProtoReflect.Descriptor instead.
+func (*PrepareStagingPathRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *PrepareStagingPathRequest) GetStagingPath() string {
+	if x != nil {
+		return x.StagingPath
+	}
+	return ""
+}
+
+func (x *PrepareStagingPathRequest) GetSddl() string {
+	if x != nil {
+		return x.Sddl
+	}
+	return ""
+}
+
+type PrepareStagingPathResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Indicates whether the staging directory was created, it's false if it
+	// already existed.
+	Created bool `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *PrepareStagingPathResponse) Reset() {
+	*x = PrepareStagingPathResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrepareStagingPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrepareStagingPathResponse) ProtoMessage() {}
+
+func (x *PrepareStagingPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrepareStagingPathResponse.ProtoReflect.Descriptor instead.
+func (*PrepareStagingPathResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PrepareStagingPathResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type CreateBoundedDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Absolute path of the directory within the working directories e.g.
+	// "C:\var\lib\kubelet\pods\<uid>\volumes\kubernetes.io~empty-dir\cache",
+	// its missing parent directories are created too. It must be empty if it
+	// exists.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Maximum size of the content of the directory in bytes, it's rounded up to
+	// a multiple of 1MiB and must be at least 64MiB. The size of an existing
+	// bounded directory isn't changed.
+	SizeBytes uint64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (x *CreateBoundedDirectoryRequest) Reset() {
+	*x = CreateBoundedDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBoundedDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoundedDirectoryRequest) ProtoMessage() {}
+
+func (x *CreateBoundedDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoundedDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateBoundedDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateBoundedDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CreateBoundedDirectoryRequest) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+type CreateBoundedDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume mounted at the directory.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *CreateBoundedDirectoryResponse) Reset() {
+	*x = CreateBoundedDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBoundedDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBoundedDirectoryResponse) ProtoMessage() {}
+
+func (x *CreateBoundedDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBoundedDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateBoundedDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CreateBoundedDirectoryResponse) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type RemoveBoundedDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Absolute path of the bounded directory, it's left empty.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveBoundedDirectoryRequest) Reset() {
+	*x = RemoveBoundedDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveBoundedDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBoundedDirectoryRequest) ProtoMessage() {}
+
+func (x *RemoveBoundedDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBoundedDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBoundedDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RemoveBoundedDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveBoundedDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveBoundedDirectoryResponse) Reset() {
+	*x = RemoveBoundedDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveBoundedDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBoundedDirectoryResponse) ProtoMessage() {}
+
+func (x *RemoveBoundedDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBoundedDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBoundedDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP(), []int{80}
+}
+
+var File_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto protoreflect.FileDescriptor
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDesc = []byte{
+	0x0a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2f, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x22, 0x96, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x4f, 0x6e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x6a, 0x0a,
+	0x19, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x4f, 0x6e, 0x44, 0x69,
+	0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0x44, 0x0a, 0x12, 0x4c, 0x69, 0x73,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
+	0xaf, 0x01, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x74, 0x65, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69,
+	0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x31,
+	0x0a, 0x15, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x77,
+	0x69, 0x74, 0x68, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x4f, 0x6e, 0x6c,
+	0x79, 0x22, 0xdc, 0x02, 0x0a, 0x0a, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x29,
+	0x0a, 0x10, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x2c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x69,
+	0x73, 0x6b, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x70, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x70, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x45, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x22, 0x83, 0x01, 0x0a, 0x13, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x74, 0x68,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x70, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x70, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0x52, 0x0a,
+	0x1b, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x22, 0x6d, 0x0a, 0x1c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x22, 0xc7, 0x02, 0x0a, 0x12, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x72, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f,
+	0x73, 0x64, 0x64, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x53, 0x64, 0x64, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x12,
+	0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x69, 0x6e, 0x67, 0x22, 0x54, 0x0a, 0x13, 0x4d, 0x6f,
+	0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x72, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x72, 0x69, 0x76, 0x65, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x6a, 0x0a, 0x14, 0x55, 0x6e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22, 0x62, 0x0a, 0x0a,
+	0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x22, 0x50, 0x0a, 0x15, 0x55, 0x6e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x6f, 0x70, 0x65,
+	0x6e, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x73, 0x22, 0x37, 0x0a, 0x18, 0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x19,
+	0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x63, 0x6f, 0x67, 0x6e, 0x69, 0x7a, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x63, 0x6f, 0x67,
+	0x6e, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x80, 0x02, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x69, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x22, 0x0a, 0x0d, 0x75, 0x73, 0x65, 0x5f, 0x6c, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x66, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x4c, 0x61, 0x72, 0x67, 0x65,
+	0x46, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74, 0x68, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x56, 0x0a,
+	0x1d, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x74, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73,
+	0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x5f, 0x73, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x22, 0x32, 0x0a, 0x14, 0x52,
+	0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x5c, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x2e, 0x0a,
+	0x14, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x33, 0x0a,
+	0x14, 0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x74, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x49, 0x64, 0x22, 0x2d, 0x0a, 0x15, 0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x69,
+	0x72, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x69, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x69, 0x72, 0x74,
+	0x79, 0x22, 0x8f, 0x01, 0x0a, 0x15, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x74, 0x72,
+	0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x74, 0x72, 0x69, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x66, 0x72, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x64, 0x65, 0x66, 0x72, 0x61, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x62,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x73, 0x6c, 0x61, 0x62, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a,
+	0x18, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x73, 0x79,
+	0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x3e, 0x0a, 0x19, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x22, 0x53, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a,
+	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x69, 0x7a,
+	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x61, 0x69,
+	0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x19,
+	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x1a,
+	0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x73,
+	0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x1b, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a,
+	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x22, 0xb8, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64,
+	0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x50, 0x0a, 0x14, 0x72, 0x65, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x12, 0x72, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x50, 0x0a, 0x14, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x12, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x34, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x49, 0x64, 0x22, 0x2e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x22, 0x3b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53,
+	0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22,
+	0x44, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x22, 0x18, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0xa7, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x35, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x64, 0x22, 0xa3, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x62, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x62, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3f, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x44,
+	0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x43,
+	0x0a, 0x20, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f,
+	0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x22, 0x40, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x4a, 0x0a, 0x27, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74,
+	0x68, 0x22, 0x47, 0x0a, 0x28, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74,
+	0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x64, 0x72, 0x69, 0x76, 0x65, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x22, 0xa9,
+	0x01, 0x0a, 0x14, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x75, 0x73, 0x61, 0x67, 0x65, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x62, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x64, 0x69, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x44, 0x69, 0x72, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x15, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x64, 0x5f,
+	0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65,
+	0x64, 0x55, 0x70, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12,
+	0x28, 0x0a, 0x10, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x66,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x64, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x7a, 0x0a, 0x1a, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44,
+	0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x3f, 0x0a, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x75, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x1d, 0x0a, 0x1b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3c, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0xf4,
+	0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x61,
+	0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x73, 0x61, 0x76, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x61, 0x74, 0x65, 0x12, 0x32,
+	0x0a, 0x15, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6f,
+	0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x69, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6d,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x6c,
+	0x61, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x54, 0x0a, 0x1b, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x53,
+	0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x0a, 0x17, 0x57,
+	0x72, 0x69, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x39, 0x0a, 0x18, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x22, 0x45, 0x0a, 0x10, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x22, 0x4f, 0x0a, 0x19, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x30, 0x0a, 0x11, 0x53, 0x79, 0x6e, 0x63, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x79, 0x6e, 0x63, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x4d, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x77, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x73, 0x22, 0x99, 0x02, 0x0a, 0x0b, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x72, 0x69, 0x76, 0x65, 0x5f, 0x6c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x72, 0x69,
+	0x76, 0x65, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x52, 0x0a, 0x19, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x53, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x64, 0x64, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x64, 0x64, 0x6c, 0x22, 0x36, 0x0a, 0x1a, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x52, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x75, 0x6e,
+	0x64, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x69, 0x7a,
+	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42,
+	0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x20, 0x0a, 0x1e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x3e, 0x0a, 0x0a,
+	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x43,
+	0x41, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x50, 0x4f, 0x54, 0x5f, 0x46, 0x49, 0x58,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x53, 0x43,
+	0x41, 0x4e, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x46, 0x49, 0x58, 0x10, 0x02, 0x2a, 0x3e, 0x0a, 0x16,
+	0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x59, 0x50, 0x45, 0x52, 0x5f, 0x56, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x10, 0x02, 0x2a, 0x36, 0x0a, 0x0f,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x44, 0x10, 0x02, 0x32, 0xbf, 0x1c, 0x0a, 0x06, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
+	0x5e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x4f, 0x6e,
+	0x44, 0x69, 0x73, 0x6b, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x4f, 0x6e, 0x44, 0x69, 0x73,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x4f,
+	0x6e, 0x44, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4c, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x1c,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a,
+	0x14, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4d,
+	0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x55, 0x6e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x55, 0x6e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x55, 0x6e, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x49, 0x73, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x12, 0x22, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x26, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x49, 0x73, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x74, 0x79, 0x12, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x49, 0x73, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x4f,
+	0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1f, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a,
+	0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69,
+	0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41,
+	0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x24, 0x2e, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6d,
+	0x69, 0x7a, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1f, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x26, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x20, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x76,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x46,
+	0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x12, 0x2a, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x46, 0x72, 0x6f, 0x6d, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x2a, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2b, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x8b,
+	0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x31, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49,
+	0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x49, 0x44, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x12, 0x25, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12,
+	0x1e, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x64, 0x0a, 0x13, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x64, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44,
+	0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x27, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x64, 0x75, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x21, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x76, 0x32, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x53, 0x79, 0x6e, 0x63, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x79,
+	0x6e, 0x63, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x56,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x64, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x24, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x76,
+	0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x12, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x53, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x53, 0x74,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x27, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x76, 0x32,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x75,
+	0x6e, 0x64, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x27, 0x2e, 0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x76, 0x32, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x75, 0x6e,
+	0x64, 0x65, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d,
+	0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x2f,
+	0x76, 0x32, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescOnce sync.Once
+	file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDesc
+)
+
+func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescGZIP() []byte {
+	file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescOnce.Do(func() {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescData)
+	})
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDescData
+}
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_goTypes = []interface{}{
+	(RepairMode)(0),                                  // 0: v2alpha1.RepairMode
+	(DeduplicationUsageType)(0),                      // 1: v2alpha1.DeduplicationUsageType
+	(VolumeEventType)(0),                             // 2: v2alpha1.VolumeEventType
+	(*ListVolumesOnDiskRequest)(nil),                 // 3: v2alpha1.ListVolumesOnDiskRequest
+	(*ListVolumesOnDiskResponse)(nil),                // 4: v2alpha1.ListVolumesOnDiskResponse
+	(*ListVolumesRequest)(nil),                       // 5: v2alpha1.ListVolumesRequest
+	(*VolumeFilter)(nil),                             // 6: v2alpha1.VolumeFilter
+	(*VolumeInfo)(nil),                               // 7: v2alpha1.VolumeInfo
+	(*ListVolumesResponse)(nil),                      // 8: v2alpha1.ListVolumesResponse
+	(*VolumeDiscoveryRule)(nil),                      // 9: v2alpha1.VolumeDiscoveryRule
+	(*DiscoverLocalVolumesRequest)(nil),              // 10: v2alpha1.DiscoverLocalVolumesRequest
+	(*DiscoverLocalVolumesResponse)(nil),             // 11: v2alpha1.DiscoverLocalVolumesResponse
+	(*MountVolumeRequest)(nil),                       // 12: v2alpha1.MountVolumeRequest
+	(*MountVolumeResponse)(nil),                      // 13: v2alpha1.MountVolumeResponse
+	(*UnmountVolumeRequest)(nil),                     // 14: v2alpha1.UnmountVolumeRequest
+	(*OpenHandle)(nil),                               // 15: v2alpha1.OpenHandle
+	(*UnmountVolumeResponse)(nil),                    // 16: v2alpha1.UnmountVolumeResponse
+	(*IsVolumeFormattedRequest)(nil),                 // 17: v2alpha1.IsVolumeFormattedRequest
+	(*IsVolumeFormattedResponse)(nil),                // 18: v2alpha1.IsVolumeFormattedResponse
+	(*FormatVolumeRequest)(nil),                      // 19: v2alpha1.FormatVolumeRequest
+	(*FormatVolumeResponse)(nil),                     // 20: v2alpha1.FormatVolumeResponse
+	(*GetFormatVolumeStatusRequest)(nil),             // 21: v2alpha1.GetFormatVolumeStatusRequest
+	(*GetFormatVolumeStatusResponse)(nil),            // 22: v2alpha1.GetFormatVolumeStatusResponse
+	(*ResizeVolumeRequest)(nil),                      // 23: v2alpha1.ResizeVolumeRequest
+	(*ResizeVolumeResponse)(nil),                     // 24: v2alpha1.ResizeVolumeResponse
+	(*RepairVolumeRequest)(nil),                      // 25: v2alpha1.RepairVolumeRequest
+	(*RepairVolumeResponse)(nil),                     // 26: v2alpha1.RepairVolumeResponse
+	(*IsVolumeDirtyRequest)(nil),                     // 27: v2alpha1.IsVolumeDirtyRequest
+	(*IsVolumeDirtyResponse)(nil),                    // 28: v2alpha1.IsVolumeDirtyResponse
+	(*OptimizeVolumeRequest)(nil),                    // 29: v2alpha1.OptimizeVolumeRequest
+	(*OptimizeVolumeResponse)(nil),                   // 30: v2alpha1.OptimizeVolumeResponse
+	(*FormatVolumeAsyncRequest)(nil),                 // 31: v2alpha1.FormatVolumeAsyncRequest
+	(*FormatVolumeAsyncResponse)(nil),                // 32: v2alpha1.FormatVolumeAsyncResponse
+	(*ResizeVolumeAsyncRequest)(nil),                 // 33: v2alpha1.ResizeVolumeAsyncRequest
+	(*ResizeVolumeAsyncResponse)(nil),                // 34: v2alpha1.ResizeVolumeAsyncResponse
+	(*RepairVolumeAsyncRequest)(nil),                 // 35: v2alpha1.RepairVolumeAsyncRequest
+	(*RepairVolumeAsyncResponse)(nil),                // 36: v2alpha1.RepairVolumeAsyncResponse
+	(*OptimizeVolumeAsyncRequest)(nil),               // 37: v2alpha1.OptimizeVolumeAsyncRequest
+	(*OptimizeVolumeAsyncResponse)(nil),              // 38: v2alpha1.OptimizeVolumeAsyncResponse
+	(*GetOperationRequest)(nil),                      // 39: v2alpha1.GetOperationRequest
+	(*GetOperationResponse)(nil),                     // 40: v2alpha1.GetOperationResponse
+	(*GetVolumeLabelRequest)(nil),                    // 41: v2alpha1.GetVolumeLabelRequest
+	(*GetVolumeLabelResponse)(nil),                   // 42: v2alpha1.GetVolumeLabelResponse
+	(*GetVolumeSerialNumberRequest)(nil),             // 43: v2alpha1.GetVolumeSerialNumberRequest
+	(*GetVolumeSerialNumberResponse)(nil),            // 44: v2alpha1.GetVolumeSerialNumberResponse
+	(*SetVolumeLabelRequest)(nil),                    // 45: v2alpha1.SetVolumeLabelRequest
+	(*SetVolumeLabelResponse)(nil),                   // 46: v2alpha1.SetVolumeLabelResponse
+	(*GetVolumeStatsRequest)(nil),                    // 47: v2alpha1.GetVolumeStatsRequest
+	(*GetVolumeStatsResponse)(nil),                   // 48: v2alpha1.GetVolumeStatsResponse
+	(*GetVolumeHealthRequest)(nil),                   // 49: v2alpha1.GetVolumeHealthRequest
+	(*GetVolumeHealthResponse)(nil),                  // 50: v2alpha1.GetVolumeHealthResponse
+	(*GetDiskNumberFromVolumeIDRequest)(nil),         // 51: v2alpha1.GetDiskNumberFromVolumeIDRequest
+	(*GetDiskNumberFromVolumeIDResponse)(nil),        // 52: v2alpha1.GetDiskNumberFromVolumeIDResponse
+	(*GetVolumeIDFromTargetPathRequest)(nil),         // 53: v2alpha1.GetVolumeIDFromTargetPathRequest
+	(*GetVolumeIDFromTargetPathResponse)(nil),        // 54: v2alpha1.GetVolumeIDFromTargetPathResponse
+	(*GetClosestVolumeIDFromTargetPathRequest)(nil),  // 55: v2alpha1.GetClosestVolumeIDFromTargetPathRequest
+	(*GetClosestVolumeIDFromTargetPathResponse)(nil), // 56: v2alpha1.GetClosestVolumeIDFromTargetPathResponse
+	(*GetVolumeAccessPathsRequest)(nil),              // 57: v2alpha1.GetVolumeAccessPathsRequest
+	(*GetVolumeAccessPathsResponse)(nil),             // 58: v2alpha1.GetVolumeAccessPathsResponse
+	(*CleanupVolumeRequest)(nil),                     // 59: v2alpha1.CleanupVolumeRequest
+	(*CleanupVolumeResponse)(nil),                    // 60: v2alpha1.CleanupVolumeResponse
+	(*EnableDeduplicationRequest)(nil),               // 61: v2alpha1.EnableDeduplicationRequest
+	(*EnableDeduplicationResponse)(nil),              // 62: v2alpha1.EnableDeduplicationResponse
+	(*GetDeduplicationStatusRequest)(nil),            // 63: v2alpha1.GetDeduplicationStatusRequest
+	(*GetDeduplicationStatusResponse)(nil),           // 64: v2alpha1.GetDeduplicationStatusResponse
+	(*SetVolumeCompressionRequest)(nil),              // 65: v2alpha1.SetVolumeCompressionRequest
+	(*SetVolumeCompressionResponse)(nil),             // 66: v2alpha1.SetVolumeCompressionResponse
+	(*WriteVolumeCacheRequest)(nil),                  // 67: v2alpha1.WriteVolumeCacheRequest
+	(*WriteVolumeCacheResponse)(nil),                 // 68: v2alpha1.WriteVolumeCacheResponse
+	(*WriteVolumeCachesRequest)(nil),                 // 69: v2alpha1.WriteVolumeCachesRequest
+	(*VolumeCacheError)(nil),                         // 70: v2alpha1.VolumeCacheError
+	(*WriteVolumeCachesResponse)(nil),                // 71: v2alpha1.WriteVolumeCachesResponse
+	(*SyncVolumeRequest)(nil),                        // 72: v2alpha1.SyncVolumeRequest
+	(*SyncVolumeResponse)(nil),                       // 73: v2alpha1.SyncVolumeResponse
+	(*GetVolumeWriteCacheRequest)(nil),               // 74: v2alpha1.GetVolumeWriteCacheRequest
+	(*GetVolumeWriteCacheResponse)(nil),              // 75: v2alpha1.GetVolumeWriteCacheResponse
+	(*WatchVolumesRequest)(nil),                      // 76: v2alpha1.WatchVolumesRequest
+	(*VolumeEvent)(nil),                              // 77: v2alpha1.VolumeEvent
+	(*PrepareStagingPathRequest)(nil),                // 78: v2alpha1.PrepareStagingPathRequest
+	(*PrepareStagingPathResponse)(nil),               // 79: v2alpha1.PrepareStagingPathResponse
+	(*CreateBoundedDirectoryRequest)(nil),            // 80: v2alpha1.CreateBoundedDirectoryRequest
+	(*CreateBoundedDirectoryResponse)(nil),           // 81: v2alpha1.CreateBoundedDirectoryResponse
+	(*RemoveBoundedDirectoryRequest)(nil),            // 82: v2alpha1.RemoveBoundedDirectoryRequest
+	(*RemoveBoundedDirectoryResponse)(nil),           // 83: v2alpha1.RemoveBoundedDirectoryResponse
+}
+var file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_depIdxs = []int32{
+	6,  // 0: v2alpha1.ListVolumesOnDiskRequest.filter:type_name -> v2alpha1.VolumeFilter
+	7,  // 1: v2alpha1.ListVolumesOnDiskResponse.volumes:type_name -> v2alpha1.VolumeInfo
+	6,  // 2: v2alpha1.ListVolumesRequest.filter:type_name -> v2alpha1.VolumeFilter
+	7,  // 3: v2alpha1.ListVolumesResponse.volumes:type_name -> v2alpha1.VolumeInfo
+	9,  // 4: v2alpha1.DiscoverLocalVolumesRequest.rules:type_name -> v2alpha1.VolumeDiscoveryRule
+	7,  // 5: v2alpha1.DiscoverLocalVolumesResponse.volumes:type_name -> v2alpha1.VolumeInfo
+	15, // 6: v2alpha1.UnmountVolumeResponse.open_handles:type_name -> v2alpha1.OpenHandle
+	0,  // 7: v2alpha1.RepairVolumeRequest.mode:type_name -> v2alpha1.RepairMode
+	19, // 8: v2alpha1.FormatVolumeAsyncRequest.request:type_name -> v2alpha1.FormatVolumeRequest
+	23, // 9: v2alpha1.ResizeVolumeAsyncRequest.request:type_name -> v2alpha1.ResizeVolumeRequest
+	25, // 10: v2alpha1.RepairVolumeAsyncRequest.request:type_name -> v2alpha1.RepairVolumeRequest
+	29, // 11: v2alpha1.OptimizeVolumeAsyncRequest.request:type_name -> v2alpha1.OptimizeVolumeRequest
+	24, // 12: v2alpha1.GetOperationResponse.resize_volume_result:type_name -> v2alpha1.ResizeVolumeResponse
+	26, // 13: v2alpha1.GetOperationResponse.repair_volume_result:type_name -> v2alpha1.RepairVolumeResponse
+	1,  // 14: v2alpha1.EnableDeduplicationRequest.usage_type:type_name -> v2alpha1.DeduplicationUsageType
+	70, // 15: v2alpha1.WriteVolumeCachesResponse.errors:type_name -> v2alpha1.VolumeCacheError
+	2,  // 16: v2alpha1.VolumeEvent.type:type_name -> v2alpha1.VolumeEventType
+	3,  // 17: v2alpha1.Volume.ListVolumesOnDisk:input_type -> v2alpha1.ListVolumesOnDiskRequest
+	5,  // 18: v2alpha1.Volume.ListVolumes:input_type -> v2alpha1.ListVolumesRequest
+	10, // 19: v2alpha1.Volume.DiscoverLocalVolumes:input_type -> v2alpha1.DiscoverLocalVolumesRequest
+	12, // 20: v2alpha1.Volume.MountVolume:input_type -> v2alpha1.MountVolumeRequest
+	14, // 21: v2alpha1.Volume.UnmountVolume:input_type -> v2alpha1.UnmountVolumeRequest
+	17, // 22: v2alpha1.Volume.IsVolumeFormatted:input_type -> v2alpha1.IsVolumeFormattedRequest
+	19, // 23: v2alpha1.Volume.FormatVolume:input_type -> v2alpha1.FormatVolumeRequest
+	21, // 24: v2alpha1.Volume.GetFormatVolumeStatus:input_type -> v2alpha1.GetFormatVolumeStatusRequest
+	23, // 25: v2alpha1.Volume.ResizeVolume:input_type -> v2alpha1.ResizeVolumeRequest
+	25, // 26: v2alpha1.Volume.RepairVolume:input_type -> v2alpha1.RepairVolumeRequest
+	27, // 27: v2alpha1.Volume.IsVolumeDirty:input_type -> v2alpha1.IsVolumeDirtyRequest
+	29, // 28: v2alpha1.Volume.OptimizeVolume:input_type -> v2alpha1.OptimizeVolumeRequest
+	31, // 29: v2alpha1.Volume.FormatVolumeAsync:input_type -> v2alpha1.FormatVolumeAsyncRequest
+	33, // 30: v2alpha1.Volume.ResizeVolumeAsync:input_type -> v2alpha1.ResizeVolumeAsyncRequest
+	35, // 31: v2alpha1.Volume.RepairVolumeAsync:input_type -> v2alpha1.RepairVolumeAsyncRequest
+	37, // 32: v2alpha1.Volume.OptimizeVolumeAsync:input_type -> v2alpha1.OptimizeVolumeAsyncRequest
+	39, // 33: v2alpha1.Volume.GetOperation:input_type -> v2alpha1.GetOperationRequest
+	41, // 34: v2alpha1.Volume.GetVolumeLabel:input_type -> v2alpha1.GetVolumeLabelRequest
+	45, // 35: v2alpha1.Volume.SetVolumeLabel:input_type -> v2alpha1.SetVolumeLabelRequest
+	43, // 36: v2alpha1.Volume.GetVolumeSerialNumber:input_type -> v2alpha1.GetVolumeSerialNumberRequest
+	47, // 37: v2alpha1.Volume.GetVolumeStats:input_type -> v2alpha1.GetVolumeStatsRequest
+	49, // 38: v2alpha1.Volume.GetVolumeHealth:input_type -> v2alpha1.GetVolumeHealthRequest
+	76, // 39: v2alpha1.Volume.WatchVolumes:input_type -> v2alpha1.WatchVolumesRequest
+	51, // 40: v2alpha1.Volume.GetDiskNumberFromVolumeID:input_type -> v2alpha1.GetDiskNumberFromVolumeIDRequest
+	53, // 41: v2alpha1.Volume.GetVolumeIDFromTargetPath:input_type -> v2alpha1.GetVolumeIDFromTargetPathRequest
+	55, // 42: v2alpha1.Volume.GetClosestVolumeIDFromTargetPath:input_type -> v2alpha1.GetClosestVolumeIDFromTargetPathRequest
+	57, // 43: v2alpha1.Volume.GetVolumeAccessPaths:input_type -> v2alpha1.GetVolumeAccessPathsRequest
+	65, // 44: v2alpha1.Volume.SetVolumeCompression:input_type -> v2alpha1.SetVolumeCompressionRequest
+	59, // 45: v2alpha1.Volume.CleanupVolume:input_type -> v2alpha1.CleanupVolumeRequest
+	61, // 46: v2alpha1.Volume.EnableDeduplication:input_type -> v2alpha1.EnableDeduplicationRequest
+	63, // 47: v2alpha1.Volume.GetDeduplicationStatus:input_type -> v2alpha1.GetDeduplicationStatusRequest
+	67, // 48: v2alpha1.Volume.WriteVolumeCache:input_type -> v2alpha1.WriteVolumeCacheRequest
+	69, // 49: v2alpha1.Volume.WriteVolumeCaches:input_type -> v2alpha1.WriteVolumeCachesRequest
+	72, // 50: v2alpha1.Volume.SyncVolume:input_type -> v2alpha1.SyncVolumeRequest
+	74, // 51: v2alpha1.Volume.GetVolumeWriteCache:input_type -> v2alpha1.GetVolumeWriteCacheRequest
+	78, // 52: v2alpha1.Volume.PrepareStagingPath:input_type -> v2alpha1.PrepareStagingPathRequest
+	80, // 53: v2alpha1.Volume.CreateBoundedDirectory:input_type -> v2alpha1.CreateBoundedDirectoryRequest
+	82, // 54: v2alpha1.Volume.RemoveBoundedDirectory:input_type -> v2alpha1.RemoveBoundedDirectoryRequest
+	4,  // 55: v2alpha1.Volume.ListVolumesOnDisk:output_type -> v2alpha1.ListVolumesOnDiskResponse
+	8,  // 56: v2alpha1.Volume.ListVolumes:output_type -> v2alpha1.ListVolumesResponse
+	11, // 57: v2alpha1.Volume.DiscoverLocalVolumes:output_type -> v2alpha1.DiscoverLocalVolumesResponse
+	13, // 58: v2alpha1.Volume.MountVolume:output_type -> v2alpha1.MountVolumeResponse
+	16, // 59: v2alpha1.Volume.UnmountVolume:output_type -> v2alpha1.UnmountVolumeResponse
+	18, // 60: v2alpha1.Volume.IsVolumeFormatted:output_type -> v2alpha1.IsVolumeFormattedResponse
+	20, // 61: v2alpha1.Volume.FormatVolume:output_type -> v2alpha1.FormatVolumeResponse
+	22, // 62: v2alpha1.Volume.GetFormatVolumeStatus:output_type -> v2alpha1.GetFormatVolumeStatusResponse
+	24, // 63: v2alpha1.Volume.ResizeVolume:output_type -> v2alpha1.ResizeVolumeResponse
+	26, // 64: v2alpha1.Volume.RepairVolume:output_type -> v2alpha1.RepairVolumeResponse
+	28, // 65: v2alpha1.Volume.IsVolumeDirty:output_type -> v2alpha1.IsVolumeDirtyResponse
+	30, // 66: v2alpha1.Volume.OptimizeVolume:output_type -> v2alpha1.OptimizeVolumeResponse
+	32, // 67: v2alpha1.Volume.FormatVolumeAsync:output_type -> v2alpha1.FormatVolumeAsyncResponse
+	34, // 68: v2alpha1.Volume.ResizeVolumeAsync:output_type -> v2alpha1.ResizeVolumeAsyncResponse
+	36, // 69: v2alpha1.Volume.RepairVolumeAsync:output_type -> v2alpha1.RepairVolumeAsyncResponse
+	38, // 70: v2alpha1.Volume.OptimizeVolumeAsync:output_type -> v2alpha1.OptimizeVolumeAsyncResponse
+	40, // 71: v2alpha1.Volume.GetOperation:output_type -> v2alpha1.GetOperationResponse
+	42, // 72: v2alpha1.Volume.GetVolumeLabel:output_type -> v2alpha1.GetVolumeLabelResponse
+	46, // 73: v2alpha1.Volume.SetVolumeLabel:output_type -> v2alpha1.SetVolumeLabelResponse
+	44, // 74: v2alpha1.Volume.GetVolumeSerialNumber:output_type -> v2alpha1.GetVolumeSerialNumberResponse
+	48, // 75: v2alpha1.Volume.GetVolumeStats:output_type -> v2alpha1.GetVolumeStatsResponse
+	50, // 76: v2alpha1.Volume.GetVolumeHealth:output_type -> v2alpha1.GetVolumeHealthResponse
+	77, // 77: v2alpha1.Volume.WatchVolumes:output_type -> v2alpha1.VolumeEvent
+	52, // 78: v2alpha1.Volume.GetDiskNumberFromVolumeID:output_type -> v2alpha1.GetDiskNumberFromVolumeIDResponse
+	54, // 79: v2alpha1.Volume.GetVolumeIDFromTargetPath:output_type -> v2alpha1.GetVolumeIDFromTargetPathResponse
+	56, // 80: v2alpha1.Volume.GetClosestVolumeIDFromTargetPath:output_type -> v2alpha1.GetClosestVolumeIDFromTargetPathResponse
+	58, // 81: v2alpha1.Volume.GetVolumeAccessPaths:output_type -> v2alpha1.GetVolumeAccessPathsResponse
+	66, // 82: v2alpha1.Volume.SetVolumeCompression:output_type -> v2alpha1.SetVolumeCompressionResponse
+	60, // 83: v2alpha1.Volume.CleanupVolume:output_type -> v2alpha1.CleanupVolumeResponse
+	62, // 84: v2alpha1.Volume.EnableDeduplication:output_type -> v2alpha1.EnableDeduplicationResponse
+	64, // 85: v2alpha1.Volume.GetDeduplicationStatus:output_type -> v2alpha1.GetDeduplicationStatusResponse
+	68, // 86: v2alpha1.Volume.WriteVolumeCache:output_type -> v2alpha1.WriteVolumeCacheResponse
+	71, // 87: v2alpha1.Volume.WriteVolumeCaches:output_type -> v2alpha1.WriteVolumeCachesResponse
+	73, // 88: v2alpha1.Volume.SyncVolume:output_type -> v2alpha1.SyncVolumeResponse
+	75, // 89: v2alpha1.Volume.GetVolumeWriteCache:output_type -> v2alpha1.GetVolumeWriteCacheResponse
+	79, // 90: v2alpha1.Volume.PrepareStagingPath:output_type -> v2alpha1.PrepareStagingPathResponse
+	81, // 91: v2alpha1.Volume.CreateBoundedDirectory:output_type -> v2alpha1.CreateBoundedDirectoryResponse
+	83, // 92: v2alpha1.Volume.RemoveBoundedDirectory:output_type -> v2alpha1.RemoveBoundedDirectoryResponse
+	55, // [55:93] is the sub-list for method output_type
+	17, // [17:55] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_init() }
+func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_init() {
+	if File_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVolumesOnDiskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVolumesOnDiskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVolumesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListVolumesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeDiscoveryRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverLocalVolumesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverLocalVolumesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MountVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MountVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmountVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenHandle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnmountVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsVolumeFormattedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsVolumeFormattedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFormatVolumeStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFormatVolumeStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResizeVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResizeVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsVolumeDirtyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IsVolumeDirtyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizeVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizeVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatVolumeAsyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FormatVolumeAsyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResizeVolumeAsyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResizeVolumeAsyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairVolumeAsyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairVolumeAsyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizeVolumeAsyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OptimizeVolumeAsyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOperationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeSerialNumberRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeSerialNumberResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVolumeLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVolumeLabelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskNumberFromVolumeIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskNumberFromVolumeIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeIDFromTargetPathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeIDFromTargetPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClosestVolumeIDFromTargetPathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClosestVolumeIDFromTargetPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeAccessPathsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeAccessPathsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupVolumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableDeduplicationRequest); i {
+			case 0:
+				return &v.state
 			case 1:
 				return &v.sizeCache
 			case 2:
@@ -1350,8 +6558,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MountVolumeResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnableDeduplicationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1362,8 +6570,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnmountVolumeRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeduplicationStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1374,8 +6582,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnmountVolumeResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDeduplicationStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1386,8 +6594,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsVolumeFormattedRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVolumeCompressionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1398,8 +6606,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IsVolumeFormattedResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVolumeCompressionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1410,8 +6618,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FormatVolumeRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteVolumeCacheRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1422,8 +6630,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FormatVolumeResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteVolumeCacheResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1434,8 +6642,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResizeVolumeRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteVolumeCachesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1446,8 +6654,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResizeVolumeResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeCacheError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1458,8 +6666,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVolumeStatsRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteVolumeCachesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1470,8 +6678,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVolumeStatsResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncVolumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1482,8 +6690,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDiskNumberFromVolumeIDRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncVolumeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1494,8 +6702,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDiskNumberFromVolumeIDResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeWriteCacheRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1506,8 +6714,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVolumeIDFromTargetPathRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeWriteCacheResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1518,8 +6726,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVolumeIDFromTargetPathResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchVolumesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1530,8 +6738,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClosestVolumeIDFromTargetPathRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1542,8 +6750,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetClosestVolumeIDFromTargetPathResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepareStagingPathRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1554,8 +6762,8 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteVolumeCacheRequest); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrepareStagingPathResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1566,8 +6774,44 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 				return nil
 			}
 		}
-		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteVolumeCacheResponse); i {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBoundedDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBoundedDirectoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBoundedDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBoundedDirectoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1584,13 +6828,14 @@ func file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_pro
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   22,
+			NumEnums:      3,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_goTypes,
 		DependencyIndexes: file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_depIdxs,
+		EnumInfos:         file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_enumTypes,
 		MessageInfos:      file_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto_msgTypes,
 	}.Build()
 	File_github_com_kubernetes_csi_csi_proxy_client_api_volume_v2alpha1_api_proto = out.File
@@ -1614,18 +6859,71 @@ type VolumeClient interface {
 	// ListVolumesOnDisk returns the volume IDs (in \\.\Volume{GUID} format) for all volumes from a
 	// given disk number and partition number (optional)
 	ListVolumesOnDisk(ctx context.Context, in *ListVolumesOnDiskRequest, opts ...grpc.CallOption) (*ListVolumesOnDiskResponse, error)
+	// ListVolumes returns all the volumes of the host with the disk and the
+	// partition where they are, e.g. to reconcile the volumes of the host with
+	// the volumes known by a driver.
+	ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
+	// DiscoverLocalVolumes returns the volumes of the host that match the
+	// discovery rules of the request, e.g. for a local static provisioner that
+	// creates a persistent volume for every volume an operator prepared.
+	DiscoverLocalVolumes(ctx context.Context, in *DiscoverLocalVolumesRequest, opts ...grpc.CallOption) (*DiscoverLocalVolumesResponse, error)
 	// MountVolume mounts the volume at the requested global staging path.
+	// A volume can be mounted at multiple paths, all of them read-only or read-write.
 	MountVolume(ctx context.Context, in *MountVolumeRequest, opts ...grpc.CallOption) (*MountVolumeResponse, error)
 	// UnmountVolume flushes data cache to disk and removes the global staging path.
 	UnmountVolume(ctx context.Context, in *UnmountVolumeRequest, opts ...grpc.CallOption) (*UnmountVolumeResponse, error)
 	// IsVolumeFormatted checks if a volume is formatted.
 	IsVolumeFormatted(ctx context.Context, in *IsVolumeFormattedRequest, opts ...grpc.CallOption) (*IsVolumeFormattedResponse, error)
 	// FormatVolume formats a volume with NTFS.
+	// A full format runs in the background, its progress is tracked with GetFormatVolumeStatus.
 	FormatVolume(ctx context.Context, in *FormatVolumeRequest, opts ...grpc.CallOption) (*FormatVolumeResponse, error)
+	// GetFormatVolumeStatus gets the status of the full format of a volume.
+	GetFormatVolumeStatus(ctx context.Context, in *GetFormatVolumeStatusRequest, opts ...grpc.CallOption) (*GetFormatVolumeStatusResponse, error)
 	// ResizeVolume performs resizing of the partition and file system for a block based volume.
 	ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error)
-	// GetVolumeStats gathers total bytes and used bytes for a volume.
+	// RepairVolume scans the file system of a volume for corruption and
+	// optionally fixes it, like chkdsk.
+	RepairVolume(ctx context.Context, in *RepairVolumeRequest, opts ...grpc.CallOption) (*RepairVolumeResponse, error)
+	// IsVolumeDirty checks the dirty bit of the file system of a volume, it's
+	// set when the volume wasn't dismounted cleanly or corruption was detected,
+	// i.e. the volume should be repaired before it's mounted.
+	IsVolumeDirty(ctx context.Context, in *IsVolumeDirtyRequest, opts ...grpc.CallOption) (*IsVolumeDirtyResponse, error)
+	// OptimizeVolume optimizes a volume with Optimize-Volume, e.g. it sends
+	// TRIM requests for the free space of the volume so that thin provisioned
+	// storage can reclaim the space of deleted files.
+	OptimizeVolume(ctx context.Context, in *OptimizeVolumeRequest, opts ...grpc.CallOption) (*OptimizeVolumeResponse, error)
+	// FormatVolumeAsync, ResizeVolumeAsync, RepairVolumeAsync and OptimizeVolumeAsync
+	// start the operation in the background and return its ID right away, its
+	// status is polled with GetOperation. They're meant for the clients whose
+	// gRPC deadline is shorter than the operation, e.g. the CSI sidecars.
+	FormatVolumeAsync(ctx context.Context, in *FormatVolumeAsyncRequest, opts ...grpc.CallOption) (*FormatVolumeAsyncResponse, error)
+	ResizeVolumeAsync(ctx context.Context, in *ResizeVolumeAsyncRequest, opts ...grpc.CallOption) (*ResizeVolumeAsyncResponse, error)
+	RepairVolumeAsync(ctx context.Context, in *RepairVolumeAsyncRequest, opts ...grpc.CallOption) (*RepairVolumeAsyncResponse, error)
+	OptimizeVolumeAsync(ctx context.Context, in *OptimizeVolumeAsyncRequest, opts ...grpc.CallOption) (*OptimizeVolumeAsyncResponse, error)
+	// GetOperation gets the status of an operation started by one of the
+	// asynchronous methods.
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error)
+	// GetVolumeLabel gets the label of the file system of a volume.
+	GetVolumeLabel(ctx context.Context, in *GetVolumeLabelRequest, opts ...grpc.CallOption) (*GetVolumeLabelResponse, error)
+	// SetVolumeLabel changes the label of the file system of a volume without
+	// formatting it, e.g. to relabel a volume restored from a snapshot.
+	SetVolumeLabel(ctx context.Context, in *SetVolumeLabelRequest, opts ...grpc.CallOption) (*SetVolumeLabelResponse, error)
+	// GetVolumeSerialNumber gets the serial number of the file system of a
+	// volume, e.g. to correlate the mounted volumes with the volume serial
+	// numbers reported by backup and asset tracking tools.
+	GetVolumeSerialNumber(ctx context.Context, in *GetVolumeSerialNumberRequest, opts ...grpc.CallOption) (*GetVolumeSerialNumberResponse, error)
+	// GetVolumeStats gathers total bytes, used bytes, the file system and the health of a volume.
 	GetVolumeStats(ctx context.Context, in *GetVolumeStatsRequest, opts ...grpc.CallOption) (*GetVolumeStatsResponse, error)
+	// GetVolumeHealth gets the health and the operational status of a volume
+	// and whether they're abnormal, e.g. to report the condition of the volume
+	// in the CSI NodeGetVolumeStats response.
+	GetVolumeHealth(ctx context.Context, in *GetVolumeHealthRequest, opts ...grpc.CallOption) (*GetVolumeHealthResponse, error)
+	// WatchVolumes streams an event every time a volume appears, disappears or
+	// changes (size, drive letter, file system, health status or operational
+	// status) until the call is canceled, so that hot added disks are handled
+	// without polling. The volumes are watched with a WMI event subscription
+	// that checks them every few seconds.
+	WatchVolumes(ctx context.Context, in *WatchVolumesRequest, opts ...grpc.CallOption) (Volume_WatchVolumesClient, error)
 	// GetDiskNumberFromVolumeID gets the disk number of the disk where the volume is located.
 	GetDiskNumberFromVolumeID(ctx context.Context, in *GetDiskNumberFromVolumeIDRequest, opts ...grpc.CallOption) (*GetDiskNumberFromVolumeIDResponse, error)
 	// GetVolumeIDFromTargetPath gets the volume id for a given target path.
@@ -1634,8 +6932,57 @@ type VolumeClient interface {
 	// by following symlinks and moving up in the filesystem, if after moving up in the filesystem
 	// we get to a DriveLetter then the volume corresponding to this drive letter is returned instead.
 	GetClosestVolumeIDFromTargetPath(ctx context.Context, in *GetClosestVolumeIDFromTargetPathRequest, opts ...grpc.CallOption) (*GetClosestVolumeIDFromTargetPathResponse, error)
+	// GetVolumeAccessPaths gets the paths where a volume is accessible i.e. its
+	// drive letters, its mount points and its volume device ID path, e.g. to
+	// find and remove the unexpected mount points of a volume.
+	GetVolumeAccessPaths(ctx context.Context, in *GetVolumeAccessPathsRequest, opts ...grpc.CallOption) (*GetVolumeAccessPathsResponse, error)
+	// SetVolumeCompression enables or disables the NTFS compression of a volume
+	// after it was formatted. The compression applies to the files and the
+	// directories created afterwards, the existing files are left as they are.
+	SetVolumeCompression(ctx context.Context, in *SetVolumeCompressionRequest, opts ...grpc.CallOption) (*SetVolumeCompressionResponse, error)
+	// CleanupVolume deletes the temporary files and empties the recycle bin of
+	// a volume mounted by csi-proxy once its usage crosses a threshold, e.g. to
+	// extend the life of the node local scratch volumes of ephemeral volumes.
+	CleanupVolume(ctx context.Context, in *CleanupVolumeRequest, opts ...grpc.CallOption) (*CleanupVolumeResponse, error)
+	// EnableDeduplication enables the Data Deduplication of a volume, e.g. for
+	// file server workloads. The files are deduplicated in the background by the
+	// optimization jobs of the Data Deduplication feature, which must be installed.
+	EnableDeduplication(ctx context.Context, in *EnableDeduplicationRequest, opts ...grpc.CallOption) (*EnableDeduplicationResponse, error)
+	// GetDeduplicationStatus gets the Data Deduplication status and savings of a volume.
+	GetDeduplicationStatus(ctx context.Context, in *GetDeduplicationStatusRequest, opts ...grpc.CallOption) (*GetDeduplicationStatusResponse, error)
 	// WriteVolumeCache write volume cache to disk.
 	WriteVolumeCache(ctx context.Context, in *WriteVolumeCacheRequest, opts ...grpc.CallOption) (*WriteVolumeCacheResponse, error)
+	// WriteVolumeCaches writes the caches of multiple volumes to disk in
+	// parallel, e.g. to flush all the mounted volumes before the node is
+	// drained or shut down.
+	WriteVolumeCaches(ctx context.Context, in *WriteVolumeCachesRequest, opts ...grpc.CallOption) (*WriteVolumeCachesResponse, error)
+	// SyncVolume makes the writes to a volume durable, when it returns the data
+	// and the metadata of all the files of the volume written before the call
+	// was flushed from the file system cache to the disk and the disk was asked
+	// to write its volatile write cache to stable storage (FlushFileBuffers on
+	// the volume). Unlike WriteVolumeCache it doesn't return until the storage
+	// acknowledged the flush, so it can be used as a write barrier e.g. before
+	// a crash consistent snapshot of the volume.
+	SyncVolume(ctx context.Context, in *SyncVolumeRequest, opts ...grpc.CallOption) (*SyncVolumeResponse, error)
+	// GetVolumeWriteCache returns whether the write cache of the disk of a
+	// volume is enabled, e.g. to check that a volume mounted or formatted with
+	// write_through is still write-through.
+	GetVolumeWriteCache(ctx context.Context, in *GetVolumeWriteCacheRequest, opts ...grpc.CallOption) (*GetVolumeWriteCacheResponse, error)
+	// PrepareStagingPath creates the staging directory of a volume with its
+	// security descriptor, checks that it's within the working directories, on
+	// the OS volume and not inside a volume mounted by csi-proxy, and records it
+	// in the mount metadata, so that drivers don't create, secure and check the
+	// directory with separate calls.
+	PrepareStagingPath(ctx context.Context, in *PrepareStagingPathRequest, opts ...grpc.CallOption) (*PrepareStagingPathResponse, error)
+	// CreateBoundedDirectory creates a directory whose content can't grow
+	// beyond a size e.g. for the emptyDir volumes with a size limit, like the
+	// project quotas do on Linux. NTFS quotas are per user and volume, not per
+	// directory, so the directory is the mount point of a volume on a dynamic
+	// VHDX of that size, which only takes the space used from the host volume.
+	CreateBoundedDirectory(ctx context.Context, in *CreateBoundedDirectoryRequest, opts ...grpc.CallOption) (*CreateBoundedDirectoryResponse, error)
+	// RemoveBoundedDirectory unmounts the volume of a bounded directory and
+	// deletes its VHDX with the content of the directory.
+	RemoveBoundedDirectory(ctx context.Context, in *RemoveBoundedDirectoryRequest, opts ...grpc.CallOption) (*RemoveBoundedDirectoryResponse, error)
 }
 
 type volumeClient struct {
@@ -1655,6 +7002,24 @@ func (c *volumeClient) ListVolumesOnDisk(ctx context.Context, in *ListVolumesOnD
 	return out, nil
 }
 
+func (c *volumeClient) ListVolumes(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
+	out := new(ListVolumesResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/ListVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) DiscoverLocalVolumes(ctx context.Context, in *DiscoverLocalVolumesRequest, opts ...grpc.CallOption) (*DiscoverLocalVolumesResponse, error) {
+	out := new(DiscoverLocalVolumesResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/DiscoverLocalVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *volumeClient) MountVolume(ctx context.Context, in *MountVolumeRequest, opts ...grpc.CallOption) (*MountVolumeResponse, error) {
 	out := new(MountVolumeResponse)
 	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/MountVolume", in, out, opts...)
@@ -1691,54 +7056,302 @@ func (c *volumeClient) FormatVolume(ctx context.Context, in *FormatVolumeRequest
 	return out, nil
 }
 
-func (c *volumeClient) ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error) {
-	out := new(ResizeVolumeResponse)
-	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/ResizeVolume", in, out, opts...)
+func (c *volumeClient) GetFormatVolumeStatus(ctx context.Context, in *GetFormatVolumeStatusRequest, opts ...grpc.CallOption) (*GetFormatVolumeStatusResponse, error) {
+	out := new(GetFormatVolumeStatusResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetFormatVolumeStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*ResizeVolumeResponse, error) {
+	out := new(ResizeVolumeResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/ResizeVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) RepairVolume(ctx context.Context, in *RepairVolumeRequest, opts ...grpc.CallOption) (*RepairVolumeResponse, error) {
+	out := new(RepairVolumeResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/RepairVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) IsVolumeDirty(ctx context.Context, in *IsVolumeDirtyRequest, opts ...grpc.CallOption) (*IsVolumeDirtyResponse, error) {
+	out := new(IsVolumeDirtyResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/IsVolumeDirty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) OptimizeVolume(ctx context.Context, in *OptimizeVolumeRequest, opts ...grpc.CallOption) (*OptimizeVolumeResponse, error) {
+	out := new(OptimizeVolumeResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/OptimizeVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) FormatVolumeAsync(ctx context.Context, in *FormatVolumeAsyncRequest, opts ...grpc.CallOption) (*FormatVolumeAsyncResponse, error) {
+	out := new(FormatVolumeAsyncResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/FormatVolumeAsync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) ResizeVolumeAsync(ctx context.Context, in *ResizeVolumeAsyncRequest, opts ...grpc.CallOption) (*ResizeVolumeAsyncResponse, error) {
+	out := new(ResizeVolumeAsyncResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/ResizeVolumeAsync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) RepairVolumeAsync(ctx context.Context, in *RepairVolumeAsyncRequest, opts ...grpc.CallOption) (*RepairVolumeAsyncResponse, error) {
+	out := new(RepairVolumeAsyncResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/RepairVolumeAsync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) OptimizeVolumeAsync(ctx context.Context, in *OptimizeVolumeAsyncRequest, opts ...grpc.CallOption) (*OptimizeVolumeAsyncResponse, error) {
+	out := new(OptimizeVolumeAsyncResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/OptimizeVolumeAsync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error) {
+	out := new(GetOperationResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) GetVolumeLabel(ctx context.Context, in *GetVolumeLabelRequest, opts ...grpc.CallOption) (*GetVolumeLabelResponse, error) {
+	out := new(GetVolumeLabelResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetVolumeLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) SetVolumeLabel(ctx context.Context, in *SetVolumeLabelRequest, opts ...grpc.CallOption) (*SetVolumeLabelResponse, error) {
+	out := new(SetVolumeLabelResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/SetVolumeLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) GetVolumeSerialNumber(ctx context.Context, in *GetVolumeSerialNumberRequest, opts ...grpc.CallOption) (*GetVolumeSerialNumberResponse, error) {
+	out := new(GetVolumeSerialNumberResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetVolumeSerialNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) GetVolumeStats(ctx context.Context, in *GetVolumeStatsRequest, opts ...grpc.CallOption) (*GetVolumeStatsResponse, error) {
+	out := new(GetVolumeStatsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetVolumeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) GetVolumeHealth(ctx context.Context, in *GetVolumeHealthRequest, opts ...grpc.CallOption) (*GetVolumeHealthResponse, error) {
+	out := new(GetVolumeHealthResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetVolumeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) WatchVolumes(ctx context.Context, in *WatchVolumesRequest, opts ...grpc.CallOption) (Volume_WatchVolumesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Volume_serviceDesc.Streams[0], "/v2alpha1.Volume/WatchVolumes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volumeWatchVolumesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Volume_WatchVolumesClient interface {
+	Recv() (*VolumeEvent, error)
+	grpc.ClientStream
+}
+
+type volumeWatchVolumesClient struct {
+	grpc.ClientStream
+}
+
+func (x *volumeWatchVolumesClient) Recv() (*VolumeEvent, error) {
+	m := new(VolumeEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *volumeClient) GetDiskNumberFromVolumeID(ctx context.Context, in *GetDiskNumberFromVolumeIDRequest, opts ...grpc.CallOption) (*GetDiskNumberFromVolumeIDResponse, error) {
+	out := new(GetDiskNumberFromVolumeIDResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetDiskNumberFromVolumeID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) GetVolumeIDFromTargetPath(ctx context.Context, in *GetVolumeIDFromTargetPathRequest, opts ...grpc.CallOption) (*GetVolumeIDFromTargetPathResponse, error) {
+	out := new(GetVolumeIDFromTargetPathResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetVolumeIDFromTargetPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) GetClosestVolumeIDFromTargetPath(ctx context.Context, in *GetClosestVolumeIDFromTargetPathRequest, opts ...grpc.CallOption) (*GetClosestVolumeIDFromTargetPathResponse, error) {
+	out := new(GetClosestVolumeIDFromTargetPathResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetClosestVolumeIDFromTargetPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) GetVolumeAccessPaths(ctx context.Context, in *GetVolumeAccessPathsRequest, opts ...grpc.CallOption) (*GetVolumeAccessPathsResponse, error) {
+	out := new(GetVolumeAccessPathsResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetVolumeAccessPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) SetVolumeCompression(ctx context.Context, in *SetVolumeCompressionRequest, opts ...grpc.CallOption) (*SetVolumeCompressionResponse, error) {
+	out := new(SetVolumeCompressionResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/SetVolumeCompression", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) CleanupVolume(ctx context.Context, in *CleanupVolumeRequest, opts ...grpc.CallOption) (*CleanupVolumeResponse, error) {
+	out := new(CleanupVolumeResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/CleanupVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) EnableDeduplication(ctx context.Context, in *EnableDeduplicationRequest, opts ...grpc.CallOption) (*EnableDeduplicationResponse, error) {
+	out := new(EnableDeduplicationResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/EnableDeduplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) GetDeduplicationStatus(ctx context.Context, in *GetDeduplicationStatusRequest, opts ...grpc.CallOption) (*GetDeduplicationStatusResponse, error) {
+	out := new(GetDeduplicationStatusResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetDeduplicationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) WriteVolumeCache(ctx context.Context, in *WriteVolumeCacheRequest, opts ...grpc.CallOption) (*WriteVolumeCacheResponse, error) {
+	out := new(WriteVolumeCacheResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/WriteVolumeCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumeClient) WriteVolumeCaches(ctx context.Context, in *WriteVolumeCachesRequest, opts ...grpc.CallOption) (*WriteVolumeCachesResponse, error) {
+	out := new(WriteVolumeCachesResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/WriteVolumeCaches", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *volumeClient) GetVolumeStats(ctx context.Context, in *GetVolumeStatsRequest, opts ...grpc.CallOption) (*GetVolumeStatsResponse, error) {
-	out := new(GetVolumeStatsResponse)
-	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetVolumeStats", in, out, opts...)
+func (c *volumeClient) SyncVolume(ctx context.Context, in *SyncVolumeRequest, opts ...grpc.CallOption) (*SyncVolumeResponse, error) {
+	out := new(SyncVolumeResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/SyncVolume", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *volumeClient) GetDiskNumberFromVolumeID(ctx context.Context, in *GetDiskNumberFromVolumeIDRequest, opts ...grpc.CallOption) (*GetDiskNumberFromVolumeIDResponse, error) {
-	out := new(GetDiskNumberFromVolumeIDResponse)
-	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetDiskNumberFromVolumeID", in, out, opts...)
+func (c *volumeClient) GetVolumeWriteCache(ctx context.Context, in *GetVolumeWriteCacheRequest, opts ...grpc.CallOption) (*GetVolumeWriteCacheResponse, error) {
+	out := new(GetVolumeWriteCacheResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetVolumeWriteCache", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *volumeClient) GetVolumeIDFromTargetPath(ctx context.Context, in *GetVolumeIDFromTargetPathRequest, opts ...grpc.CallOption) (*GetVolumeIDFromTargetPathResponse, error) {
-	out := new(GetVolumeIDFromTargetPathResponse)
-	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetVolumeIDFromTargetPath", in, out, opts...)
+func (c *volumeClient) PrepareStagingPath(ctx context.Context, in *PrepareStagingPathRequest, opts ...grpc.CallOption) (*PrepareStagingPathResponse, error) {
+	out := new(PrepareStagingPathResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/PrepareStagingPath", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *volumeClient) GetClosestVolumeIDFromTargetPath(ctx context.Context, in *GetClosestVolumeIDFromTargetPathRequest, opts ...grpc.CallOption) (*GetClosestVolumeIDFromTargetPathResponse, error) {
-	out := new(GetClosestVolumeIDFromTargetPathResponse)
-	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/GetClosestVolumeIDFromTargetPath", in, out, opts...)
+func (c *volumeClient) CreateBoundedDirectory(ctx context.Context, in *CreateBoundedDirectoryRequest, opts ...grpc.CallOption) (*CreateBoundedDirectoryResponse, error) {
+	out := new(CreateBoundedDirectoryResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/CreateBoundedDirectory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *volumeClient) WriteVolumeCache(ctx context.Context, in *WriteVolumeCacheRequest, opts ...grpc.CallOption) (*WriteVolumeCacheResponse, error) {
-	out := new(WriteVolumeCacheResponse)
-	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/WriteVolumeCache", in, out, opts...)
+func (c *volumeClient) RemoveBoundedDirectory(ctx context.Context, in *RemoveBoundedDirectoryRequest, opts ...grpc.CallOption) (*RemoveBoundedDirectoryResponse, error) {
+	out := new(RemoveBoundedDirectoryResponse)
+	err := c.cc.Invoke(ctx, "/v2alpha1.Volume/RemoveBoundedDirectory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1750,18 +7363,71 @@ type VolumeServer interface {
 	// ListVolumesOnDisk returns the volume IDs (in \\.\Volume{GUID} format) for all volumes from a
 	// given disk number and partition number (optional)
 	ListVolumesOnDisk(context.Context, *ListVolumesOnDiskRequest) (*ListVolumesOnDiskResponse, error)
+	// ListVolumes returns all the volumes of the host with the disk and the
+	// partition where they are, e.g. to reconcile the volumes of the host with
+	// the volumes known by a driver.
+	ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
+	// DiscoverLocalVolumes returns the volumes of the host that match the
+	// discovery rules of the request, e.g. for a local static provisioner that
+	// creates a persistent volume for every volume an operator prepared.
+	DiscoverLocalVolumes(context.Context, *DiscoverLocalVolumesRequest) (*DiscoverLocalVolumesResponse, error)
 	// MountVolume mounts the volume at the requested global staging path.
+	// A volume can be mounted at multiple paths, all of them read-only or read-write.
 	MountVolume(context.Context, *MountVolumeRequest) (*MountVolumeResponse, error)
 	// UnmountVolume flushes data cache to disk and removes the global staging path.
 	UnmountVolume(context.Context, *UnmountVolumeRequest) (*UnmountVolumeResponse, error)
 	// IsVolumeFormatted checks if a volume is formatted.
 	IsVolumeFormatted(context.Context, *IsVolumeFormattedRequest) (*IsVolumeFormattedResponse, error)
 	// FormatVolume formats a volume with NTFS.
+	// A full format runs in the background, its progress is tracked with GetFormatVolumeStatus.
 	FormatVolume(context.Context, *FormatVolumeRequest) (*FormatVolumeResponse, error)
+	// GetFormatVolumeStatus gets the status of the full format of a volume.
+	GetFormatVolumeStatus(context.Context, *GetFormatVolumeStatusRequest) (*GetFormatVolumeStatusResponse, error)
 	// ResizeVolume performs resizing of the partition and file system for a block based volume.
 	ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error)
-	// GetVolumeStats gathers total bytes and used bytes for a volume.
+	// RepairVolume scans the file system of a volume for corruption and
+	// optionally fixes it, like chkdsk.
+	RepairVolume(context.Context, *RepairVolumeRequest) (*RepairVolumeResponse, error)
+	// IsVolumeDirty checks the dirty bit of the file system of a volume, it's
+	// set when the volume wasn't dismounted cleanly or corruption was detected,
+	// i.e. the volume should be repaired before it's mounted.
+	IsVolumeDirty(context.Context, *IsVolumeDirtyRequest) (*IsVolumeDirtyResponse, error)
+	// OptimizeVolume optimizes a volume with Optimize-Volume, e.g. it sends
+	// TRIM requests for the free space of the volume so that thin provisioned
+	// storage can reclaim the space of deleted files.
+	OptimizeVolume(context.Context, *OptimizeVolumeRequest) (*OptimizeVolumeResponse, error)
+	// FormatVolumeAsync, ResizeVolumeAsync, RepairVolumeAsync and OptimizeVolumeAsync
+	// start the operation in the background and return its ID right away, its
+	// status is polled with GetOperation. They're meant for the clients whose
+	// gRPC deadline is shorter than the operation, e.g. the CSI sidecars.
+	FormatVolumeAsync(context.Context, *FormatVolumeAsyncRequest) (*FormatVolumeAsyncResponse, error)
+	ResizeVolumeAsync(context.Context, *ResizeVolumeAsyncRequest) (*ResizeVolumeAsyncResponse, error)
+	RepairVolumeAsync(context.Context, *RepairVolumeAsyncRequest) (*RepairVolumeAsyncResponse, error)
+	OptimizeVolumeAsync(context.Context, *OptimizeVolumeAsyncRequest) (*OptimizeVolumeAsyncResponse, error)
+	// GetOperation gets the status of an operation started by one of the
+	// asynchronous methods.
+	GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error)
+	// GetVolumeLabel gets the label of the file system of a volume.
+	GetVolumeLabel(context.Context, *GetVolumeLabelRequest) (*GetVolumeLabelResponse, error)
+	// SetVolumeLabel changes the label of the file system of a volume without
+	// formatting it, e.g. to relabel a volume restored from a snapshot.
+	SetVolumeLabel(context.Context, *SetVolumeLabelRequest) (*SetVolumeLabelResponse, error)
+	// GetVolumeSerialNumber gets the serial number of the file system of a
+	// volume, e.g. to correlate the mounted volumes with the volume serial
+	// numbers reported by backup and asset tracking tools.
+	GetVolumeSerialNumber(context.Context, *GetVolumeSerialNumberRequest) (*GetVolumeSerialNumberResponse, error)
+	// GetVolumeStats gathers total bytes, used bytes, the file system and the health of a volume.
 	GetVolumeStats(context.Context, *GetVolumeStatsRequest) (*GetVolumeStatsResponse, error)
+	// GetVolumeHealth gets the health and the operational status of a volume
+	// and whether they're abnormal, e.g. to report the condition of the volume
+	// in the CSI NodeGetVolumeStats response.
+	GetVolumeHealth(context.Context, *GetVolumeHealthRequest) (*GetVolumeHealthResponse, error)
+	// WatchVolumes streams an event every time a volume appears, disappears or
+	// changes (size, drive letter, file system, health status or operational
+	// status) until the call is canceled, so that hot added disks are handled
+	// without polling. The volumes are watched with a WMI event subscription
+	// that checks them every few seconds.
+	WatchVolumes(*WatchVolumesRequest, Volume_WatchVolumesServer) error
 	// GetDiskNumberFromVolumeID gets the disk number of the disk where the volume is located.
 	GetDiskNumberFromVolumeID(context.Context, *GetDiskNumberFromVolumeIDRequest) (*GetDiskNumberFromVolumeIDResponse, error)
 	// GetVolumeIDFromTargetPath gets the volume id for a given target path.
@@ -1770,8 +7436,57 @@ type VolumeServer interface {
 	// by following symlinks and moving up in the filesystem, if after moving up in the filesystem
 	// we get to a DriveLetter then the volume corresponding to this drive letter is returned instead.
 	GetClosestVolumeIDFromTargetPath(context.Context, *GetClosestVolumeIDFromTargetPathRequest) (*GetClosestVolumeIDFromTargetPathResponse, error)
+	// GetVolumeAccessPaths gets the paths where a volume is accessible i.e. its
+	// drive letters, its mount points and its volume device ID path, e.g. to
+	// find and remove the unexpected mount points of a volume.
+	GetVolumeAccessPaths(context.Context, *GetVolumeAccessPathsRequest) (*GetVolumeAccessPathsResponse, error)
+	// SetVolumeCompression enables or disables the NTFS compression of a volume
+	// after it was formatted. The compression applies to the files and the
+	// directories created afterwards, the existing files are left as they are.
+	SetVolumeCompression(context.Context, *SetVolumeCompressionRequest) (*SetVolumeCompressionResponse, error)
+	// CleanupVolume deletes the temporary files and empties the recycle bin of
+	// a volume mounted by csi-proxy once its usage crosses a threshold, e.g. to
+	// extend the life of the node local scratch volumes of ephemeral volumes.
+	CleanupVolume(context.Context, *CleanupVolumeRequest) (*CleanupVolumeResponse, error)
+	// EnableDeduplication enables the Data Deduplication of a volume, e.g. for
+	// file server workloads. The files are deduplicated in the background by the
+	// optimization jobs of the Data Deduplication feature, which must be installed.
+	EnableDeduplication(context.Context, *EnableDeduplicationRequest) (*EnableDeduplicationResponse, error)
+	// GetDeduplicationStatus gets the Data Deduplication status and savings of a volume.
+	GetDeduplicationStatus(context.Context, *GetDeduplicationStatusRequest) (*GetDeduplicationStatusResponse, error)
 	// WriteVolumeCache write volume cache to disk.
 	WriteVolumeCache(context.Context, *WriteVolumeCacheRequest) (*WriteVolumeCacheResponse, error)
+	// WriteVolumeCaches writes the caches of multiple volumes to disk in
+	// parallel, e.g. to flush all the mounted volumes before the node is
+	// drained or shut down.
+	WriteVolumeCaches(context.Context, *WriteVolumeCachesRequest) (*WriteVolumeCachesResponse, error)
+	// SyncVolume makes the writes to a volume durable, when it returns the data
+	// and the metadata of all the files of the volume written before the call
+	// was flushed from the file system cache to the disk and the disk was asked
+	// to write its volatile write cache to stable storage (FlushFileBuffers on
+	// the volume). Unlike WriteVolumeCache it doesn't return until the storage
+	// acknowledged the flush, so it can be used as a write barrier e.g. before
+	// a crash consistent snapshot of the volume.
+	SyncVolume(context.Context, *SyncVolumeRequest) (*SyncVolumeResponse, error)
+	// GetVolumeWriteCache returns whether the write cache of the disk of a
+	// volume is enabled, e.g. to check that a volume mounted or formatted with
+	// write_through is still write-through.
+	GetVolumeWriteCache(context.Context, *GetVolumeWriteCacheRequest) (*GetVolumeWriteCacheResponse, error)
+	// PrepareStagingPath creates the staging directory of a volume with its
+	// security descriptor, checks that it's within the working directories, on
+	// the OS volume and not inside a volume mounted by csi-proxy, and records it
+	// in the mount metadata, so that drivers don't create, secure and check the
+	// directory with separate calls.
+	PrepareStagingPath(context.Context, *PrepareStagingPathRequest) (*PrepareStagingPathResponse, error)
+	// CreateBoundedDirectory creates a directory whose content can't grow
+	// beyond a size e.g. for the emptyDir volumes with a size limit, like the
+	// project quotas do on Linux. NTFS quotas are per user and volume, not per
+	// directory, so the directory is the mount point of a volume on a dynamic
+	// VHDX of that size, which only takes the space used from the host volume.
+	CreateBoundedDirectory(context.Context, *CreateBoundedDirectoryRequest) (*CreateBoundedDirectoryResponse, error)
+	// RemoveBoundedDirectory unmounts the volume of a bounded directory and
+	// deletes its VHDX with the content of the directory.
+	RemoveBoundedDirectory(context.Context, *RemoveBoundedDirectoryRequest) (*RemoveBoundedDirectoryResponse, error)
 }
 
 // UnimplementedVolumeServer can be embedded to have forward compatible implementations.
@@ -1781,6 +7496,12 @@ type UnimplementedVolumeServer struct {
 func (*UnimplementedVolumeServer) ListVolumesOnDisk(context.Context, *ListVolumesOnDiskRequest) (*ListVolumesOnDiskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListVolumesOnDisk not implemented")
 }
+func (*UnimplementedVolumeServer) ListVolumes(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVolumes not implemented")
+}
+func (*UnimplementedVolumeServer) DiscoverLocalVolumes(context.Context, *DiscoverLocalVolumesRequest) (*DiscoverLocalVolumesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverLocalVolumes not implemented")
+}
 func (*UnimplementedVolumeServer) MountVolume(context.Context, *MountVolumeRequest) (*MountVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MountVolume not implemented")
 }
@@ -1793,12 +7514,54 @@ func (*UnimplementedVolumeServer) IsVolumeFormatted(context.Context, *IsVolumeFo
 func (*UnimplementedVolumeServer) FormatVolume(context.Context, *FormatVolumeRequest) (*FormatVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FormatVolume not implemented")
 }
+func (*UnimplementedVolumeServer) GetFormatVolumeStatus(context.Context, *GetFormatVolumeStatusRequest) (*GetFormatVolumeStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFormatVolumeStatus not implemented")
+}
 func (*UnimplementedVolumeServer) ResizeVolume(context.Context, *ResizeVolumeRequest) (*ResizeVolumeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResizeVolume not implemented")
 }
+func (*UnimplementedVolumeServer) RepairVolume(context.Context, *RepairVolumeRequest) (*RepairVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairVolume not implemented")
+}
+func (*UnimplementedVolumeServer) IsVolumeDirty(context.Context, *IsVolumeDirtyRequest) (*IsVolumeDirtyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsVolumeDirty not implemented")
+}
+func (*UnimplementedVolumeServer) OptimizeVolume(context.Context, *OptimizeVolumeRequest) (*OptimizeVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimizeVolume not implemented")
+}
+func (*UnimplementedVolumeServer) FormatVolumeAsync(context.Context, *FormatVolumeAsyncRequest) (*FormatVolumeAsyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FormatVolumeAsync not implemented")
+}
+func (*UnimplementedVolumeServer) ResizeVolumeAsync(context.Context, *ResizeVolumeAsyncRequest) (*ResizeVolumeAsyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeVolumeAsync not implemented")
+}
+func (*UnimplementedVolumeServer) RepairVolumeAsync(context.Context, *RepairVolumeAsyncRequest) (*RepairVolumeAsyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairVolumeAsync not implemented")
+}
+func (*UnimplementedVolumeServer) OptimizeVolumeAsync(context.Context, *OptimizeVolumeAsyncRequest) (*OptimizeVolumeAsyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OptimizeVolumeAsync not implemented")
+}
+func (*UnimplementedVolumeServer) GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (*UnimplementedVolumeServer) GetVolumeLabel(context.Context, *GetVolumeLabelRequest) (*GetVolumeLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolumeLabel not implemented")
+}
+func (*UnimplementedVolumeServer) SetVolumeLabel(context.Context, *SetVolumeLabelRequest) (*SetVolumeLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVolumeLabel not implemented")
+}
+func (*UnimplementedVolumeServer) GetVolumeSerialNumber(context.Context, *GetVolumeSerialNumberRequest) (*GetVolumeSerialNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolumeSerialNumber not implemented")
+}
 func (*UnimplementedVolumeServer) GetVolumeStats(context.Context, *GetVolumeStatsRequest) (*GetVolumeStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVolumeStats not implemented")
 }
+func (*UnimplementedVolumeServer) GetVolumeHealth(context.Context, *GetVolumeHealthRequest) (*GetVolumeHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolumeHealth not implemented")
+}
+func (*UnimplementedVolumeServer) WatchVolumes(*WatchVolumesRequest, Volume_WatchVolumesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchVolumes not implemented")
+}
 func (*UnimplementedVolumeServer) GetDiskNumberFromVolumeID(context.Context, *GetDiskNumberFromVolumeIDRequest) (*GetDiskNumberFromVolumeIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDiskNumberFromVolumeID not implemented")
 }
@@ -1808,118 +7571,403 @@ func (*UnimplementedVolumeServer) GetVolumeIDFromTargetPath(context.Context, *Ge
 func (*UnimplementedVolumeServer) GetClosestVolumeIDFromTargetPath(context.Context, *GetClosestVolumeIDFromTargetPathRequest) (*GetClosestVolumeIDFromTargetPathResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClosestVolumeIDFromTargetPath not implemented")
 }
+func (*UnimplementedVolumeServer) GetVolumeAccessPaths(context.Context, *GetVolumeAccessPathsRequest) (*GetVolumeAccessPathsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolumeAccessPaths not implemented")
+}
+func (*UnimplementedVolumeServer) SetVolumeCompression(context.Context, *SetVolumeCompressionRequest) (*SetVolumeCompressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVolumeCompression not implemented")
+}
+func (*UnimplementedVolumeServer) CleanupVolume(context.Context, *CleanupVolumeRequest) (*CleanupVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupVolume not implemented")
+}
+func (*UnimplementedVolumeServer) EnableDeduplication(context.Context, *EnableDeduplicationRequest) (*EnableDeduplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableDeduplication not implemented")
+}
+func (*UnimplementedVolumeServer) GetDeduplicationStatus(context.Context, *GetDeduplicationStatusRequest) (*GetDeduplicationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeduplicationStatus not implemented")
+}
 func (*UnimplementedVolumeServer) WriteVolumeCache(context.Context, *WriteVolumeCacheRequest) (*WriteVolumeCacheResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteVolumeCache not implemented")
 }
+func (*UnimplementedVolumeServer) WriteVolumeCaches(context.Context, *WriteVolumeCachesRequest) (*WriteVolumeCachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteVolumeCaches not implemented")
+}
+func (*UnimplementedVolumeServer) SyncVolume(context.Context, *SyncVolumeRequest) (*SyncVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncVolume not implemented")
+}
+func (*UnimplementedVolumeServer) GetVolumeWriteCache(context.Context, *GetVolumeWriteCacheRequest) (*GetVolumeWriteCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolumeWriteCache not implemented")
+}
+func (*UnimplementedVolumeServer) PrepareStagingPath(context.Context, *PrepareStagingPathRequest) (*PrepareStagingPathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepareStagingPath not implemented")
+}
+func (*UnimplementedVolumeServer) CreateBoundedDirectory(context.Context, *CreateBoundedDirectoryRequest) (*CreateBoundedDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBoundedDirectory not implemented")
+}
+func (*UnimplementedVolumeServer) RemoveBoundedDirectory(context.Context, *RemoveBoundedDirectoryRequest) (*RemoveBoundedDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBoundedDirectory not implemented")
+}
 
 func RegisterVolumeServer(s *grpc.Server, srv VolumeServer) {
 	s.RegisterService(&_Volume_serviceDesc, srv)
 }
 
-func _Volume_ListVolumesOnDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListVolumesOnDiskRequest)
+func _Volume_ListVolumesOnDisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesOnDiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).ListVolumesOnDisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/ListVolumesOnDisk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).ListVolumesOnDisk(ctx, req.(*ListVolumesOnDiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_ListVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).ListVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/ListVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).ListVolumes(ctx, req.(*ListVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_DiscoverLocalVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverLocalVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).DiscoverLocalVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/DiscoverLocalVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).DiscoverLocalVolumes(ctx, req.(*DiscoverLocalVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_MountVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MountVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).MountVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/MountVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).MountVolume(ctx, req.(*MountVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_UnmountVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmountVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).UnmountVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/UnmountVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).UnmountVolume(ctx, req.(*UnmountVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_IsVolumeFormatted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsVolumeFormattedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).IsVolumeFormatted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/IsVolumeFormatted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).IsVolumeFormatted(ctx, req.(*IsVolumeFormattedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_FormatVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).FormatVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/FormatVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).FormatVolume(ctx, req.(*FormatVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_GetFormatVolumeStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFormatVolumeStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).GetFormatVolumeStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/GetFormatVolumeStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).GetFormatVolumeStatus(ctx, req.(*GetFormatVolumeStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_ResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).ResizeVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/ResizeVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).ResizeVolume(ctx, req.(*ResizeVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_RepairVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).RepairVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/RepairVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).RepairVolume(ctx, req.(*RepairVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_IsVolumeDirty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsVolumeDirtyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).IsVolumeDirty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/IsVolumeDirty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).IsVolumeDirty(ctx, req.(*IsVolumeDirtyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_OptimizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizeVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).OptimizeVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/OptimizeVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).OptimizeVolume(ctx, req.(*OptimizeVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_FormatVolumeAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FormatVolumeAsyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).FormatVolumeAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/FormatVolumeAsync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).FormatVolumeAsync(ctx, req.(*FormatVolumeAsyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_ResizeVolumeAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeVolumeAsyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).ResizeVolumeAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/ResizeVolumeAsync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).ResizeVolumeAsync(ctx, req.(*ResizeVolumeAsyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_RepairVolumeAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairVolumeAsyncRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VolumeServer).ListVolumesOnDisk(ctx, in)
+		return srv.(VolumeServer).RepairVolumeAsync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v2alpha1.Volume/ListVolumesOnDisk",
+		FullMethod: "/v2alpha1.Volume/RepairVolumeAsync",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VolumeServer).ListVolumesOnDisk(ctx, req.(*ListVolumesOnDiskRequest))
+		return srv.(VolumeServer).RepairVolumeAsync(ctx, req.(*RepairVolumeAsyncRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Volume_MountVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MountVolumeRequest)
+func _Volume_OptimizeVolumeAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptimizeVolumeAsyncRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VolumeServer).MountVolume(ctx, in)
+		return srv.(VolumeServer).OptimizeVolumeAsync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v2alpha1.Volume/MountVolume",
+		FullMethod: "/v2alpha1.Volume/OptimizeVolumeAsync",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VolumeServer).MountVolume(ctx, req.(*MountVolumeRequest))
+		return srv.(VolumeServer).OptimizeVolumeAsync(ctx, req.(*OptimizeVolumeAsyncRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Volume_UnmountVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnmountVolumeRequest)
+func _Volume_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VolumeServer).UnmountVolume(ctx, in)
+		return srv.(VolumeServer).GetOperation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v2alpha1.Volume/UnmountVolume",
+		FullMethod: "/v2alpha1.Volume/GetOperation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VolumeServer).UnmountVolume(ctx, req.(*UnmountVolumeRequest))
+		return srv.(VolumeServer).GetOperation(ctx, req.(*GetOperationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Volume_IsVolumeFormatted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IsVolumeFormattedRequest)
+func _Volume_GetVolumeLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VolumeServer).IsVolumeFormatted(ctx, in)
+		return srv.(VolumeServer).GetVolumeLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v2alpha1.Volume/IsVolumeFormatted",
+		FullMethod: "/v2alpha1.Volume/GetVolumeLabel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VolumeServer).IsVolumeFormatted(ctx, req.(*IsVolumeFormattedRequest))
+		return srv.(VolumeServer).GetVolumeLabel(ctx, req.(*GetVolumeLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Volume_FormatVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FormatVolumeRequest)
+func _Volume_SetVolumeLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVolumeLabelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VolumeServer).FormatVolume(ctx, in)
+		return srv.(VolumeServer).SetVolumeLabel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v2alpha1.Volume/FormatVolume",
+		FullMethod: "/v2alpha1.Volume/SetVolumeLabel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VolumeServer).FormatVolume(ctx, req.(*FormatVolumeRequest))
+		return srv.(VolumeServer).SetVolumeLabel(ctx, req.(*SetVolumeLabelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Volume_ResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResizeVolumeRequest)
+func _Volume_GetVolumeSerialNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeSerialNumberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VolumeServer).ResizeVolume(ctx, in)
+		return srv.(VolumeServer).GetVolumeSerialNumber(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/v2alpha1.Volume/ResizeVolume",
+		FullMethod: "/v2alpha1.Volume/GetVolumeSerialNumber",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VolumeServer).ResizeVolume(ctx, req.(*ResizeVolumeRequest))
+		return srv.(VolumeServer).GetVolumeSerialNumber(ctx, req.(*GetVolumeSerialNumberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1942,6 +7990,45 @@ func _Volume_GetVolumeStats_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Volume_GetVolumeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).GetVolumeHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/GetVolumeHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).GetVolumeHealth(ctx, req.(*GetVolumeHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_WatchVolumes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchVolumesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VolumeServer).WatchVolumes(m, &volumeWatchVolumesServer{stream})
+}
+
+type Volume_WatchVolumesServer interface {
+	Send(*VolumeEvent) error
+	grpc.ServerStream
+}
+
+type volumeWatchVolumesServer struct {
+	grpc.ServerStream
+}
+
+func (x *volumeWatchVolumesServer) Send(m *VolumeEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Volume_GetDiskNumberFromVolumeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDiskNumberFromVolumeIDRequest)
 	if err := dec(in); err != nil {
@@ -1996,6 +8083,96 @@ func _Volume_GetClosestVolumeIDFromTargetPath_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Volume_GetVolumeAccessPaths_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeAccessPathsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).GetVolumeAccessPaths(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/GetVolumeAccessPaths",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).GetVolumeAccessPaths(ctx, req.(*GetVolumeAccessPathsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_SetVolumeCompression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVolumeCompressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).SetVolumeCompression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/SetVolumeCompression",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).SetVolumeCompression(ctx, req.(*SetVolumeCompressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_CleanupVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).CleanupVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/CleanupVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).CleanupVolume(ctx, req.(*CleanupVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_EnableDeduplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableDeduplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).EnableDeduplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/EnableDeduplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).EnableDeduplication(ctx, req.(*EnableDeduplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_GetDeduplicationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeduplicationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).GetDeduplicationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/GetDeduplicationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).GetDeduplicationStatus(ctx, req.(*GetDeduplicationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Volume_WriteVolumeCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WriteVolumeCacheRequest)
 	if err := dec(in); err != nil {
@@ -2014,6 +8191,114 @@ func _Volume_WriteVolumeCache_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Volume_WriteVolumeCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WriteVolumeCachesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).WriteVolumeCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/WriteVolumeCaches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).WriteVolumeCaches(ctx, req.(*WriteVolumeCachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_SyncVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).SyncVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/SyncVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).SyncVolume(ctx, req.(*SyncVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_GetVolumeWriteCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeWriteCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).GetVolumeWriteCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/GetVolumeWriteCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).GetVolumeWriteCache(ctx, req.(*GetVolumeWriteCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_PrepareStagingPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareStagingPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).PrepareStagingPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/PrepareStagingPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).PrepareStagingPath(ctx, req.(*PrepareStagingPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_CreateBoundedDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBoundedDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).CreateBoundedDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/CreateBoundedDirectory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).CreateBoundedDirectory(ctx, req.(*CreateBoundedDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volume_RemoveBoundedDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBoundedDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumeServer).RemoveBoundedDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v2alpha1.Volume/RemoveBoundedDirectory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumeServer).RemoveBoundedDirectory(ctx, req.(*RemoveBoundedDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Volume_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v2alpha1.Volume",
 	HandlerType: (*VolumeServer)(nil),
@@ -2022,6 +8307,14 @@ var _Volume_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListVolumesOnDisk",
 			Handler:    _Volume_ListVolumesOnDisk_Handler,
 		},
+		{
+			MethodName: "ListVolumes",
+			Handler:    _Volume_ListVolumes_Handler,
+		},
+		{
+			MethodName: "DiscoverLocalVolumes",
+			Handler:    _Volume_DiscoverLocalVolumes_Handler,
+		},
 		{
 			MethodName: "MountVolume",
 			Handler:    _Volume_MountVolume_Handler,
@@ -2038,14 +8331,66 @@ var _Volume_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FormatVolume",
 			Handler:    _Volume_FormatVolume_Handler,
 		},
+		{
+			MethodName: "GetFormatVolumeStatus",
+			Handler:    _Volume_GetFormatVolumeStatus_Handler,
+		},
 		{
 			MethodName: "ResizeVolume",
 			Handler:    _Volume_ResizeVolume_Handler,
 		},
+		{
+			MethodName: "RepairVolume",
+			Handler:    _Volume_RepairVolume_Handler,
+		},
+		{
+			MethodName: "IsVolumeDirty",
+			Handler:    _Volume_IsVolumeDirty_Handler,
+		},
+		{
+			MethodName: "OptimizeVolume",
+			Handler:    _Volume_OptimizeVolume_Handler,
+		},
+		{
+			MethodName: "FormatVolumeAsync",
+			Handler:    _Volume_FormatVolumeAsync_Handler,
+		},
+		{
+			MethodName: "ResizeVolumeAsync",
+			Handler:    _Volume_ResizeVolumeAsync_Handler,
+		},
+		{
+			MethodName: "RepairVolumeAsync",
+			Handler:    _Volume_RepairVolumeAsync_Handler,
+		},
+		{
+			MethodName: "OptimizeVolumeAsync",
+			Handler:    _Volume_OptimizeVolumeAsync_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _Volume_GetOperation_Handler,
+		},
+		{
+			MethodName: "GetVolumeLabel",
+			Handler:    _Volume_GetVolumeLabel_Handler,
+		},
+		{
+			MethodName: "SetVolumeLabel",
+			Handler:    _Volume_SetVolumeLabel_Handler,
+		},
+		{
+			MethodName: "GetVolumeSerialNumber",
+			Handler:    _Volume_GetVolumeSerialNumber_Handler,
+		},
 		{
 			MethodName: "GetVolumeStats",
 			Handler:    _Volume_GetVolumeStats_Handler,
 		},
+		{
+			MethodName: "GetVolumeHealth",
+			Handler:    _Volume_GetVolumeHealth_Handler,
+		},
 		{
 			MethodName: "GetDiskNumberFromVolumeID",
 			Handler:    _Volume_GetDiskNumberFromVolumeID_Handler,
@@ -2058,11 +8403,61 @@ var _Volume_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetClosestVolumeIDFromTargetPath",
 			Handler:    _Volume_GetClosestVolumeIDFromTargetPath_Handler,
 		},
+		{
+			MethodName: "GetVolumeAccessPaths",
+			Handler:    _Volume_GetVolumeAccessPaths_Handler,
+		},
+		{
+			MethodName: "SetVolumeCompression",
+			Handler:    _Volume_SetVolumeCompression_Handler,
+		},
+		{
+			MethodName: "CleanupVolume",
+			Handler:    _Volume_CleanupVolume_Handler,
+		},
+		{
+			MethodName: "EnableDeduplication",
+			Handler:    _Volume_EnableDeduplication_Handler,
+		},
+		{
+			MethodName: "GetDeduplicationStatus",
+			Handler:    _Volume_GetDeduplicationStatus_Handler,
+		},
 		{
 			MethodName: "WriteVolumeCache",
 			Handler:    _Volume_WriteVolumeCache_Handler,
 		},
+		{
+			MethodName: "WriteVolumeCaches",
+			Handler:    _Volume_WriteVolumeCaches_Handler,
+		},
+		{
+			MethodName: "SyncVolume",
+			Handler:    _Volume_SyncVolume_Handler,
+		},
+		{
+			MethodName: "GetVolumeWriteCache",
+			Handler:    _Volume_GetVolumeWriteCache_Handler,
+		},
+		{
+			MethodName: "PrepareStagingPath",
+			Handler:    _Volume_PrepareStagingPath_Handler,
+		},
+		{
+			MethodName: "CreateBoundedDirectory",
+			Handler:    _Volume_CreateBoundedDirectory_Handler,
+		},
+		{
+			MethodName: "RemoveBoundedDirectory",
+			Handler:    _Volume_RemoveBoundedDirectory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchVolumes",
+			Handler:       _Volume_WatchVolumes_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha1/api.proto",
 }
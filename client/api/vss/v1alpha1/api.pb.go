@@ -0,0 +1,950 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.12.4
+// source: github.com/kubernetes-csi/csi-proxy/client/api/vss/v1alpha1/api.proto
+
+package v1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ShadowCopy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the shadow copy e.g. {d5c8a3e6-3b8b-4d0f-9a2e-6b1c1f0a7e21}.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Volume device ID of the volume of the shadow copy.
+	VolumeId string `protobuf:"bytes,2,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// Device of the shadow copy, its files can be read at this path e.g.
+	// \\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy1
+	DeviceObject string `protobuf:"bytes,3,opt,name=device_object,json=deviceObject,proto3" json:"device_object,omitempty"`
+	// Creation time of the shadow copy in seconds since the Unix epoch.
+	CreationTime int64 `protobuf:"varint,4,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+}
+
+func (x *ShadowCopy) Reset() {
+	*x = ShadowCopy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShadowCopy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShadowCopy) ProtoMessage() {}
+
+func (x *ShadowCopy) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShadowCopy.ProtoReflect.Descriptor instead.
+func (*ShadowCopy) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ShadowCopy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShadowCopy) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *ShadowCopy) GetDeviceObject() string {
+	if x != nil {
+		return x.DeviceObject
+	}
+	return ""
+}
+
+func (x *ShadowCopy) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+type CreateShadowCopyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to snapshot.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *CreateShadowCopyRequest) Reset() {
+	*x = CreateShadowCopyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShadowCopyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShadowCopyRequest) ProtoMessage() {}
+
+func (x *CreateShadowCopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShadowCopyRequest.ProtoReflect.Descriptor instead.
+func (*CreateShadowCopyRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateShadowCopyRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type CreateShadowCopyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Shadow copy created.
+	ShadowCopy *ShadowCopy `protobuf:"bytes,1,opt,name=shadow_copy,json=shadowCopy,proto3" json:"shadow_copy,omitempty"`
+}
+
+func (x *CreateShadowCopyResponse) Reset() {
+	*x = CreateShadowCopyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShadowCopyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShadowCopyResponse) ProtoMessage() {}
+
+func (x *CreateShadowCopyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShadowCopyResponse.ProtoReflect.Descriptor instead.
+func (*CreateShadowCopyResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateShadowCopyResponse) GetShadowCopy() *ShadowCopy {
+	if x != nil {
+		return x.ShadowCopy
+	}
+	return nil
+}
+
+type ListShadowCopiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume whose shadow copies are listed.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+}
+
+func (x *ListShadowCopiesRequest) Reset() {
+	*x = ListShadowCopiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListShadowCopiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShadowCopiesRequest) ProtoMessage() {}
+
+func (x *ListShadowCopiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShadowCopiesRequest.ProtoReflect.Descriptor instead.
+func (*ListShadowCopiesRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListShadowCopiesRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+type ListShadowCopiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Shadow copies of the volume sorted by creation time.
+	ShadowCopies []*ShadowCopy `protobuf:"bytes,1,rep,name=shadow_copies,json=shadowCopies,proto3" json:"shadow_copies,omitempty"`
+}
+
+func (x *ListShadowCopiesResponse) Reset() {
+	*x = ListShadowCopiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListShadowCopiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShadowCopiesResponse) ProtoMessage() {}
+
+func (x *ListShadowCopiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShadowCopiesResponse.ProtoReflect.Descriptor instead.
+func (*ListShadowCopiesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListShadowCopiesResponse) GetShadowCopies() []*ShadowCopy {
+	if x != nil {
+		return x.ShadowCopies
+	}
+	return nil
+}
+
+type DeleteShadowCopyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the shadow copy to delete.
+	ShadowCopyId string `protobuf:"bytes,1,opt,name=shadow_copy_id,json=shadowCopyId,proto3" json:"shadow_copy_id,omitempty"`
+}
+
+func (x *DeleteShadowCopyRequest) Reset() {
+	*x = DeleteShadowCopyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteShadowCopyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShadowCopyRequest) ProtoMessage() {}
+
+func (x *DeleteShadowCopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShadowCopyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShadowCopyRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteShadowCopyRequest) GetShadowCopyId() string {
+	if x != nil {
+		return x.ShadowCopyId
+	}
+	return ""
+}
+
+type DeleteShadowCopyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteShadowCopyResponse) Reset() {
+	*x = DeleteShadowCopyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteShadowCopyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShadowCopyResponse) ProtoMessage() {}
+
+func (x *DeleteShadowCopyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShadowCopyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShadowCopyResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescGZIP(), []int{6}
+}
+
+type RevertToShadowCopyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Volume device ID of the volume to revert.
+	VolumeId string `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	// ID of the shadow copy of the volume to revert to.
+	ShadowCopyId string `protobuf:"bytes,2,opt,name=shadow_copy_id,json=shadowCopyId,proto3" json:"shadow_copy_id,omitempty"`
+	// Dismount the volume even if it has open handles, otherwise the revert
+	// fails when the volume is in use.
+	ForceDismount bool `protobuf:"varint,3,opt,name=force_dismount,json=forceDismount,proto3" json:"force_dismount,omitempty"`
+}
+
+func (x *RevertToShadowCopyRequest) Reset() {
+	*x = RevertToShadowCopyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertToShadowCopyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertToShadowCopyRequest) ProtoMessage() {}
+
+func (x *RevertToShadowCopyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertToShadowCopyRequest.ProtoReflect.Descriptor instead.
+func (*RevertToShadowCopyRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RevertToShadowCopyRequest) GetVolumeId() string {
+	if x != nil {
+		return x.VolumeId
+	}
+	return ""
+}
+
+func (x *RevertToShadowCopyRequest) GetShadowCopyId() string {
+	if x != nil {
+		return x.ShadowCopyId
+	}
+	return ""
+}
+
+func (x *RevertToShadowCopyRequest) GetForceDismount() bool {
+	if x != nil {
+		return x.ForceDismount
+	}
+	return false
+}
+
+type RevertToShadowCopyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevertToShadowCopyResponse) Reset() {
+	*x = RevertToShadowCopyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertToShadowCopyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertToShadowCopyResponse) ProtoMessage() {}
+
+func (x *RevertToShadowCopyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertToShadowCopyResponse.ProtoReflect.Descriptor instead.
+func (*RevertToShadowCopyResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescGZIP(), []int{8}
+}
+
+var File_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto protoreflect.FileDescriptor
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDesc = []byte{
+	0x0a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d, 0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x70,
+	0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x22, 0x83, 0x01, 0x0a, 0x0a, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22,
+	0x51, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43,
+	0x6f, 0x70, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x73,
+	0x68, 0x61, 0x64, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x64,
+	0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f,
+	0x70, 0x79, 0x22, 0x36, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77,
+	0x43, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x18, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77,
+	0x5f, 0x63, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43,
+	0x6f, 0x70, 0x79, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x69, 0x65,
+	0x73, 0x22, 0x3f, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x61, 0x64, 0x6f,
+	0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e,
+	0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79,
+	0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x61, 0x64,
+	0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85,
+	0x01, 0x0a, 0x19, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x61, 0x64, 0x6f,
+	0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x68, 0x61,
+	0x64, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x73, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x44, 0x69,
+	0x73, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x54, 0x6f, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xff, 0x02, 0x0a, 0x03, 0x56, 0x73, 0x73, 0x12, 0x5b, 0x0a, 0x10,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79,
+	0x12, 0x21, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61,
+	0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x21, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x61, 0x64,
+	0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x12, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x53,
+	0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x12, 0x23, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x6f, 0x53, 0x68, 0x61,
+	0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x54, 0x6f, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x43, 0x6f, 0x70, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x2d,
+	0x63, 0x73, 0x69, 0x2f, 0x63, 0x73, 0x69, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x73, 0x73, 0x2f, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescOnce sync.Once
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescData = file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDesc
+)
+
+func file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescGZIP() []byte {
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescOnce.Do(func() {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescData)
+	})
+	return file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDescData
+}
+
+var file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_goTypes = []interface{}{
+	(*ShadowCopy)(nil),                 // 0: v1alpha1.ShadowCopy
+	(*CreateShadowCopyRequest)(nil),    // 1: v1alpha1.CreateShadowCopyRequest
+	(*CreateShadowCopyResponse)(nil),   // 2: v1alpha1.CreateShadowCopyResponse
+	(*ListShadowCopiesRequest)(nil),    // 3: v1alpha1.ListShadowCopiesRequest
+	(*ListShadowCopiesResponse)(nil),   // 4: v1alpha1.ListShadowCopiesResponse
+	(*DeleteShadowCopyRequest)(nil),    // 5: v1alpha1.DeleteShadowCopyRequest
+	(*DeleteShadowCopyResponse)(nil),   // 6: v1alpha1.DeleteShadowCopyResponse
+	(*RevertToShadowCopyRequest)(nil),  // 7: v1alpha1.RevertToShadowCopyRequest
+	(*RevertToShadowCopyResponse)(nil), // 8: v1alpha1.RevertToShadowCopyResponse
+}
+var file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_depIdxs = []int32{
+	0, // 0: v1alpha1.CreateShadowCopyResponse.shadow_copy:type_name -> v1alpha1.ShadowCopy
+	0, // 1: v1alpha1.ListShadowCopiesResponse.shadow_copies:type_name -> v1alpha1.ShadowCopy
+	1, // 2: v1alpha1.Vss.CreateShadowCopy:input_type -> v1alpha1.CreateShadowCopyRequest
+	3, // 3: v1alpha1.Vss.ListShadowCopies:input_type -> v1alpha1.ListShadowCopiesRequest
+	5, // 4: v1alpha1.Vss.DeleteShadowCopy:input_type -> v1alpha1.DeleteShadowCopyRequest
+	7, // 5: v1alpha1.Vss.RevertToShadowCopy:input_type -> v1alpha1.RevertToShadowCopyRequest
+	2, // 6: v1alpha1.Vss.CreateShadowCopy:output_type -> v1alpha1.CreateShadowCopyResponse
+	4, // 7: v1alpha1.Vss.ListShadowCopies:output_type -> v1alpha1.ListShadowCopiesResponse
+	6, // 8: v1alpha1.Vss.DeleteShadowCopy:output_type -> v1alpha1.DeleteShadowCopyResponse
+	8, // 9: v1alpha1.Vss.RevertToShadowCopy:output_type -> v1alpha1.RevertToShadowCopyResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_init() }
+func file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_init() {
+	if File_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShadowCopy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShadowCopyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShadowCopyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListShadowCopiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListShadowCopiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteShadowCopyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteShadowCopyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertToShadowCopyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertToShadowCopyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_goTypes,
+		DependencyIndexes: file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_depIdxs,
+		MessageInfos:      file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_msgTypes,
+	}.Build()
+	File_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto = out.File
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_rawDesc = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_goTypes = nil
+	file_github_com_kubernetes_csi_csi_proxy_client_api_vss_v1alpha1_api_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// VssClient is the client API for Vss service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VssClient interface {
+	// CreateShadowCopy creates a crash consistent shadow copy (VSS snapshot)
+	// of a volume.
+	CreateShadowCopy(ctx context.Context, in *CreateShadowCopyRequest, opts ...grpc.CallOption) (*CreateShadowCopyResponse, error)
+	// ListShadowCopies lists the shadow copies of a volume.
+	ListShadowCopies(ctx context.Context, in *ListShadowCopiesRequest, opts ...grpc.CallOption) (*ListShadowCopiesResponse, error)
+	// DeleteShadowCopy deletes a shadow copy, it succeeds if the shadow copy
+	// doesn't exist.
+	DeleteShadowCopy(ctx context.Context, in *DeleteShadowCopyRequest, opts ...grpc.CallOption) (*DeleteShadowCopyResponse, error)
+	// RevertToShadowCopy reverts a volume in place to the content of one of its
+	// shadow copies, the changes made to the volume since the shadow copy was
+	// created are lost. The volume is dismounted during the revert. It fails if
+	// the shadow copy provider doesn't support reverts, e.g. in client versions
+	// of Windows.
+	RevertToShadowCopy(ctx context.Context, in *RevertToShadowCopyRequest, opts ...grpc.CallOption) (*RevertToShadowCopyResponse, error)
+}
+
+type vssClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVssClient(cc grpc.ClientConnInterface) VssClient {
+	return &vssClient{cc}
+}
+
+func (c *vssClient) CreateShadowCopy(ctx context.Context, in *CreateShadowCopyRequest, opts ...grpc.CallOption) (*CreateShadowCopyResponse, error) {
+	out := new(CreateShadowCopyResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Vss/CreateShadowCopy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vssClient) ListShadowCopies(ctx context.Context, in *ListShadowCopiesRequest, opts ...grpc.CallOption) (*ListShadowCopiesResponse, error) {
+	out := new(ListShadowCopiesResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Vss/ListShadowCopies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vssClient) DeleteShadowCopy(ctx context.Context, in *DeleteShadowCopyRequest, opts ...grpc.CallOption) (*DeleteShadowCopyResponse, error) {
+	out := new(DeleteShadowCopyResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Vss/DeleteShadowCopy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vssClient) RevertToShadowCopy(ctx context.Context, in *RevertToShadowCopyRequest, opts ...grpc.CallOption) (*RevertToShadowCopyResponse, error) {
+	out := new(RevertToShadowCopyResponse)
+	err := c.cc.Invoke(ctx, "/v1alpha1.Vss/RevertToShadowCopy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VssServer is the server API for Vss service.
+type VssServer interface {
+	// CreateShadowCopy creates a crash consistent shadow copy (VSS snapshot)
+	// of a volume.
+	CreateShadowCopy(context.Context, *CreateShadowCopyRequest) (*CreateShadowCopyResponse, error)
+	// ListShadowCopies lists the shadow copies of a volume.
+	ListShadowCopies(context.Context, *ListShadowCopiesRequest) (*ListShadowCopiesResponse, error)
+	// DeleteShadowCopy deletes a shadow copy, it succeeds if the shadow copy
+	// doesn't exist.
+	DeleteShadowCopy(context.Context, *DeleteShadowCopyRequest) (*DeleteShadowCopyResponse, error)
+	// RevertToShadowCopy reverts a volume in place to the content of one of its
+	// shadow copies, the changes made to the volume since the shadow copy was
+	// created are lost. The volume is dismounted during the revert. It fails if
+	// the shadow copy provider doesn't support reverts, e.g. in client versions
+	// of Windows.
+	RevertToShadowCopy(context.Context, *RevertToShadowCopyRequest) (*RevertToShadowCopyResponse, error)
+}
+
+// UnimplementedVssServer can be embedded to have forward compatible implementations.
+type UnimplementedVssServer struct {
+}
+
+func (*UnimplementedVssServer) CreateShadowCopy(context.Context, *CreateShadowCopyRequest) (*CreateShadowCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShadowCopy not implemented")
+}
+func (*UnimplementedVssServer) ListShadowCopies(context.Context, *ListShadowCopiesRequest) (*ListShadowCopiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShadowCopies not implemented")
+}
+func (*UnimplementedVssServer) DeleteShadowCopy(context.Context, *DeleteShadowCopyRequest) (*DeleteShadowCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShadowCopy not implemented")
+}
+func (*UnimplementedVssServer) RevertToShadowCopy(context.Context, *RevertToShadowCopyRequest) (*RevertToShadowCopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertToShadowCopy not implemented")
+}
+
+func RegisterVssServer(s *grpc.Server, srv VssServer) {
+	s.RegisterService(&_Vss_serviceDesc, srv)
+}
+
+func _Vss_CreateShadowCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShadowCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VssServer).CreateShadowCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Vss/CreateShadowCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VssServer).CreateShadowCopy(ctx, req.(*CreateShadowCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vss_ListShadowCopies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShadowCopiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VssServer).ListShadowCopies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Vss/ListShadowCopies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VssServer).ListShadowCopies(ctx, req.(*ListShadowCopiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vss_DeleteShadowCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShadowCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VssServer).DeleteShadowCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Vss/DeleteShadowCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VssServer).DeleteShadowCopy(ctx, req.(*DeleteShadowCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vss_RevertToShadowCopy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertToShadowCopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VssServer).RevertToShadowCopy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1alpha1.Vss/RevertToShadowCopy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VssServer).RevertToShadowCopy(ctx, req.(*RevertToShadowCopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Vss_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1alpha1.Vss",
+	HandlerType: (*VssServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateShadowCopy",
+			Handler:    _Vss_CreateShadowCopy_Handler,
+		},
+		{
+			MethodName: "ListShadowCopies",
+			Handler:    _Vss_ListShadowCopies_Handler,
+		},
+		{
+			MethodName: "DeleteShadowCopy",
+			Handler:    _Vss_DeleteShadowCopy_Handler,
+		},
+		{
+			MethodName: "RevertToShadowCopy",
+			Handler:    _Vss_RevertToShadowCopy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/kubernetes-csi/csi-proxy/client/api/vss/v1alpha1/api.proto",
+}
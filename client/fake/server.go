@@ -0,0 +1,154 @@
+//go:build windows
+// +build windows
+
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides a fake CSI proxy server for the unit tests of CSI drivers.
+// It serves every API group and version on a temporary named pipe with the
+// responses scripted by the test, the drivers connect to it with the real
+// clients e.g.
+//
+//	s, err := fake.NewServer()
+//	...
+//	defer s.Close()
+//	s.Handle("/v2alpha1.Volume/MountVolume", func(ctx context.Context, request *v2alpha1.MountVolumeRequest) (*v2alpha1.MountVolumeResponse, error) {
+//		return &v2alpha1.MountVolumeResponse{}, nil
+//	})
+//	client, err := volumeclient.NewClientWithPipePath(s.PipePath())
+//
+// The package is only available on Windows like the named pipes.
+package fake
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Microsoft/go-winio"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pipeCounter makes the pipes of the fake servers of a process unique.
+var pipeCounter uint32
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// Server is a fake CSI proxy server, the calls of the methods that don't have a
+// handler fail with codes.Unimplemented.
+type Server struct {
+	pipePath string
+	server   *grpc.Server
+
+	lock     sync.Mutex
+	handlers map[string]reflect.Value
+	requests map[string][]interface{}
+}
+
+// NewServer starts a fake server on a new named pipe. It's the caller's
+// responsibility to Close the server when done.
+func NewServer() (*Server, error) {
+	pipePath := fmt.Sprintf(`\\.\pipe\csi-proxy-fake-%d-%d`, os.Getpid(), atomic.AddUint32(&pipeCounter, 1))
+	listener, err := winio.ListenPipe(pipePath, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		pipePath: pipePath,
+		handlers: make(map[string]reflect.Value),
+		requests: make(map[string][]interface{}),
+	}
+	s.server = grpc.NewServer(grpc.UnknownServiceHandler(s.handleStream))
+	go s.server.Serve(listener)
+	return s, nil
+}
+
+// PipePath returns the path of the named pipe of the server, to pass to the
+// NewClientWithPipePath functions of the clients.
+func (s *Server) PipePath() string {
+	return s.pipePath
+}
+
+// Handle sets the handler of the full gRPC method `method` e.g.
+// /v2alpha1.Volume/MountVolume, replacing its previous handler. `handler` is a
+// function with the signature of the method in the server interface of its API
+// e.g. func(context.Context, *v2alpha1.MountVolumeRequest) (*v2alpha1.MountVolumeResponse, error),
+// Handle panics if it isn't.
+func (s *Server) Handle(method string, handler interface{}) {
+	h := reflect.ValueOf(handler)
+	t := h.Type()
+	if t.Kind() != reflect.Func || t.NumIn() != 2 || t.NumOut() != 2 ||
+		t.In(0) != contextType || t.In(1).Kind() != reflect.Ptr ||
+		t.Out(0).Kind() != reflect.Ptr || t.Out(1) != errorType {
+		panic(fmt.Sprintf("handler of %s must be a func(context.Context, *Request) (*Response, error), got %T", method, handler))
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.handlers[method] = h
+}
+
+// Requests returns the requests of the calls of `method` received so far, in
+// the order they were received.
+func (s *Server) Requests(method string) []interface{} {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]interface{}{}, s.requests[method]...)
+}
+
+// Close stops the server and closes its named pipe.
+func (s *Server) Close() {
+	s.server.Stop()
+}
+
+// handleStream handles every call, the server doesn't register the services of
+// the APIs.
+func (s *Server) handleStream(srv interface{}, stream grpc.ServerStream) error {
+	method, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "method of the call not found")
+	}
+	s.lock.Lock()
+	handler, ok := s.handlers[method]
+	s.lock.Unlock()
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "method %s isn't handled by the fake server", method)
+	}
+
+	request := reflect.New(handler.Type().In(1).Elem())
+	if err := stream.RecvMsg(request.Interface()); err != nil {
+		return err
+	}
+	s.lock.Lock()
+	s.requests[method] = append(s.requests[method], request.Interface())
+	s.lock.Unlock()
+
+	out := handler.Call([]reflect.Value{reflect.ValueOf(stream.Context()), request})
+	if err, _ := out[1].Interface().(error); err != nil {
+		return err
+	}
+	if out[0].IsNil() {
+		return status.Errorf(codes.Internal, "handler of %s returned a nil response", method)
+	}
+	return stream.SendMsg(out[0].Interface())
+}
@@ -0,0 +1,73 @@
+//go:build windows
+// +build windows
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha1"
+	volumeclient "github.com/kubernetes-csi/csi-proxy/client/groups/volume/v2alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer(t *testing.T) {
+	s, err := NewServer()
+	if err != nil {
+		t.Fatalf("NewServer failed: %v", err)
+	}
+	defer s.Close()
+
+	s.Handle("/v2alpha1.Volume/GetVolumeStats", func(ctx context.Context, request *v2alpha1.GetVolumeStatsRequest) (*v2alpha1.GetVolumeStatsResponse, error) {
+		if request.VolumeId == "missing" {
+			return nil, status.Error(codes.NotFound, "volume not found")
+		}
+		return &v2alpha1.GetVolumeStatsResponse{TotalBytes: 1024}, nil
+	})
+
+	client, err := volumeclient.NewClientWithPipePath(s.PipePath())
+	if err != nil {
+		t.Fatalf("NewClientWithPipePath failed: %v", err)
+	}
+	defer client.Close()
+
+	response, err := client.GetVolumeStats(context.TODO(), &v2alpha1.GetVolumeStatsRequest{VolumeId: "volume"})
+	if err != nil {
+		t.Fatalf("GetVolumeStats failed: %v", err)
+	}
+	if response.TotalBytes != 1024 {
+		t.Errorf("expected TotalBytes 1024, got %d", response.TotalBytes)
+	}
+
+	_, err = client.GetVolumeStats(context.TODO(), &v2alpha1.GetVolumeStatsRequest{VolumeId: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected code NotFound, got %v", err)
+	}
+
+	_, err = client.MountVolume(context.TODO(), &v2alpha1.MountVolumeRequest{VolumeId: "volume"})
+	if status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected code Unimplemented for a method without a handler, got %v", err)
+	}
+
+	volumeIDs := []string{}
+	for _, request := range s.Requests("/v2alpha1.Volume/GetVolumeStats") {
+		volumeIDs = append(volumeIDs, request.(*v2alpha1.GetVolumeStatsRequest).VolumeId)
+	}
+	if fmt.Sprint(volumeIDs) != "[volume missing]" {
+		t.Errorf("unexpected requests %v", volumeIDs)
+	}
+}
+
+func TestHandleInvalidHandler(t *testing.T) {
+	s := &Server{handlers: make(map[string]reflect.Value)}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected Handle to panic")
+		}
+	}()
+	s.Handle("/v2alpha1.Volume/MountVolume", func(request *v2alpha1.MountVolumeRequest) error { return nil })
+}
@@ -0,0 +1,170 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v2alpha1
+
+import (
+	"context"
+	"net"
+
+	"github.com/Microsoft/go-winio"
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+// GroupName is the group name of this API.
+const GroupName = "disk"
+
+// Version is the api version.
+var Version = apiversion.NewVersionOrPanic("v2alpha1")
+
+type Client struct {
+	client     v2alpha1.DiskClient
+	connection *grpc.ClientConn
+}
+
+// NewClient returns a client to make calls to the disk API group version v2alpha1.
+// It's the caller's responsibility to Close the client when done.
+func NewClient() (*Client, error) {
+	pipePath := client.PipePath(GroupName, Version)
+	return NewClientWithPipePath(pipePath)
+}
+
+// NewClientWithPipePath returns a client to make calls to the named pipe located at "pipePath".
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithPipePath(pipePath string) (*Client, error) {
+
+	// verify that the pipe exists
+	_, err := winio.DialPipe(pipePath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := grpc.Dial(pipePath,
+		grpc.WithContextDialer(func(context context.Context, s string) (net.Conn, error) {
+			return winio.DialPipeContext(context, s)
+		}),
+		grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	client := v2alpha1.NewDiskClient(connection)
+	return &Client{
+		client:     client,
+		connection: connection,
+	}, nil
+}
+
+// Close closes the client. It must be called before the client gets GC-ed.
+func (w *Client) Close() error {
+	return w.connection.Close()
+}
+
+// ensures we implement all the required methods
+var _ v2alpha1.DiskClient = &Client{}
+
+func (w *Client) ConvertDiskToGPT(context context.Context, request *v2alpha1.ConvertDiskToGPTRequest, opts ...grpc.CallOption) (*v2alpha1.ConvertDiskToGPTResponse, error) {
+	return w.client.ConvertDiskToGPT(context, request, opts...)
+}
+
+func (w *Client) DeletePartition(context context.Context, request *v2alpha1.DeletePartitionRequest, opts ...grpc.CallOption) (*v2alpha1.DeletePartitionResponse, error) {
+	return w.client.DeletePartition(context, request, opts...)
+}
+
+func (w *Client) GetDiskIdentity(context context.Context, request *v2alpha1.GetDiskIdentityRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskIdentityResponse, error) {
+	return w.client.GetDiskIdentity(context, request, opts...)
+}
+
+func (w *Client) GetDiskNumberByLocation(context context.Context, request *v2alpha1.GetDiskNumberByLocationRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskNumberByLocationResponse, error) {
+	return w.client.GetDiskNumberByLocation(context, request, opts...)
+}
+
+func (w *Client) GetDiskNumberByPage83ID(context context.Context, request *v2alpha1.GetDiskNumberByPage83IDRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskNumberByPage83IDResponse, error) {
+	return w.client.GetDiskNumberByPage83ID(context, request, opts...)
+}
+
+func (w *Client) GetDiskNumberBySerialNumber(context context.Context, request *v2alpha1.GetDiskNumberBySerialNumberRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskNumberBySerialNumberResponse, error) {
+	return w.client.GetDiskNumberBySerialNumber(context, request, opts...)
+}
+
+func (w *Client) GetDiskReadOnly(context context.Context, request *v2alpha1.GetDiskReadOnlyRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskReadOnlyResponse, error) {
+	return w.client.GetDiskReadOnly(context, request, opts...)
+}
+
+func (w *Client) GetDiskReliabilityCounters(context context.Context, request *v2alpha1.GetDiskReliabilityCountersRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskReliabilityCountersResponse, error) {
+	return w.client.GetDiskReliabilityCounters(context, request, opts...)
+}
+
+func (w *Client) GetDiskState(context context.Context, request *v2alpha1.GetDiskStateRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskStateResponse, error) {
+	return w.client.GetDiskState(context, request, opts...)
+}
+
+func (w *Client) GetDiskStats(context context.Context, request *v2alpha1.GetDiskStatsRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskStatsResponse, error) {
+	return w.client.GetDiskStats(context, request, opts...)
+}
+
+func (w *Client) GetFreeDiskLocations(context context.Context, request *v2alpha1.GetFreeDiskLocationsRequest, opts ...grpc.CallOption) (*v2alpha1.GetFreeDiskLocationsResponse, error) {
+	return w.client.GetFreeDiskLocations(context, request, opts...)
+}
+
+func (w *Client) GetSanPolicy(context context.Context, request *v2alpha1.GetSanPolicyRequest, opts ...grpc.CallOption) (*v2alpha1.GetSanPolicyResponse, error) {
+	return w.client.GetSanPolicy(context, request, opts...)
+}
+
+func (w *Client) ListDiskIDs(context context.Context, request *v2alpha1.ListDiskIDsRequest, opts ...grpc.CallOption) (*v2alpha1.ListDiskIDsResponse, error) {
+	return w.client.ListDiskIDs(context, request, opts...)
+}
+
+func (w *Client) ListDiskLocations(context context.Context, request *v2alpha1.ListDiskLocationsRequest, opts ...grpc.CallOption) (*v2alpha1.ListDiskLocationsResponse, error) {
+	return w.client.ListDiskLocations(context, request, opts...)
+}
+
+func (w *Client) ListDiskPage83IDs(context context.Context, request *v2alpha1.ListDiskPage83IDsRequest, opts ...grpc.CallOption) (*v2alpha1.ListDiskPage83IDsResponse, error) {
+	return w.client.ListDiskPage83IDs(context, request, opts...)
+}
+
+func (w *Client) ListDisks(context context.Context, request *v2alpha1.ListDisksRequest, opts ...grpc.CallOption) (*v2alpha1.ListDisksResponse, error) {
+	return w.client.ListDisks(context, request, opts...)
+}
+
+func (w *Client) PartitionDisk(context context.Context, request *v2alpha1.PartitionDiskRequest, opts ...grpc.CallOption) (*v2alpha1.PartitionDiskResponse, error) {
+	return w.client.PartitionDisk(context, request, opts...)
+}
+
+func (w *Client) Rescan(context context.Context, request *v2alpha1.RescanRequest, opts ...grpc.CallOption) (*v2alpha1.RescanResponse, error) {
+	return w.client.Rescan(context, request, opts...)
+}
+
+func (w *Client) ResolveDiskIdentity(context context.Context, request *v2alpha1.ResolveDiskIdentityRequest, opts ...grpc.CallOption) (*v2alpha1.ResolveDiskIdentityResponse, error) {
+	return w.client.ResolveDiskIdentity(context, request, opts...)
+}
+
+func (w *Client) SetDiskReadOnly(context context.Context, request *v2alpha1.SetDiskReadOnlyRequest, opts ...grpc.CallOption) (*v2alpha1.SetDiskReadOnlyResponse, error) {
+	return w.client.SetDiskReadOnly(context, request, opts...)
+}
+
+func (w *Client) SetDiskState(context context.Context, request *v2alpha1.SetDiskStateRequest, opts ...grpc.CallOption) (*v2alpha1.SetDiskStateResponse, error) {
+	return w.client.SetDiskState(context, request, opts...)
+}
+
+func (w *Client) SetPartitionType(context context.Context, request *v2alpha1.SetPartitionTypeRequest, opts ...grpc.CallOption) (*v2alpha1.SetPartitionTypeResponse, error) {
+	return w.client.SetPartitionType(context, request, opts...)
+}
+
+func (w *Client) SetSanPolicy(context context.Context, request *v2alpha1.SetSanPolicyRequest, opts ...grpc.CallOption) (*v2alpha1.SetSanPolicyResponse, error) {
+	return w.client.SetSanPolicy(context, request, opts...)
+}
+
+func (w *Client) WaitForDisk(context context.Context, request *v2alpha1.WaitForDiskRequest, opts ...grpc.CallOption) (*v2alpha1.WaitForDiskResponse, error) {
+	return w.client.WaitForDisk(context, request, opts...)
+}
+
+func (w *Client) WaitForDiskSizeChange(context context.Context, request *v2alpha1.WaitForDiskSizeChangeRequest, opts ...grpc.CallOption) (*v2alpha1.WaitForDiskSizeChangeResponse, error) {
+	return w.client.WaitForDiskSizeChange(context, request, opts...)
+}
+
+func (w *Client) WipeDisk(context context.Context, request *v2alpha1.WipeDiskRequest, opts ...grpc.CallOption) (*v2alpha1.WipeDiskResponse, error) {
+	return w.client.WipeDisk(context, request, opts...)
+}
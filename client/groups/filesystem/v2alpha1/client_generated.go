@@ -65,10 +65,26 @@ func (w *Client) Close() error {
 // ensures we implement all the required methods
 var _ v2alpha1.FilesystemClient = &Client{}
 
+func (w *Client) CheckAccess(context context.Context, request *v2alpha1.CheckAccessRequest, opts ...grpc.CallOption) (*v2alpha1.CheckAccessResponse, error) {
+	return w.client.CheckAccess(context, request, opts...)
+}
+
+func (w *Client) CreateJunction(context context.Context, request *v2alpha1.CreateJunctionRequest, opts ...grpc.CallOption) (*v2alpha1.CreateJunctionResponse, error) {
+	return w.client.CreateJunction(context, request, opts...)
+}
+
 func (w *Client) CreateSymlink(context context.Context, request *v2alpha1.CreateSymlinkRequest, opts ...grpc.CallOption) (*v2alpha1.CreateSymlinkResponse, error) {
 	return w.client.CreateSymlink(context, request, opts...)
 }
 
+func (w *Client) GetACL(context context.Context, request *v2alpha1.GetACLRequest, opts ...grpc.CallOption) (*v2alpha1.GetACLResponse, error) {
+	return w.client.GetACL(context, request, opts...)
+}
+
+func (w *Client) IsMountPoint(context context.Context, request *v2alpha1.IsMountPointRequest, opts ...grpc.CallOption) (*v2alpha1.IsMountPointResponse, error) {
+	return w.client.IsMountPoint(context, request, opts...)
+}
+
 func (w *Client) IsSymlink(context context.Context, request *v2alpha1.IsSymlinkRequest, opts ...grpc.CallOption) (*v2alpha1.IsSymlinkResponse, error) {
 	return w.client.IsSymlink(context, request, opts...)
 }
@@ -81,6 +97,10 @@ func (w *Client) PathExists(context context.Context, request *v2alpha1.PathExist
 	return w.client.PathExists(context, request, opts...)
 }
 
+func (w *Client) ResolveJunction(context context.Context, request *v2alpha1.ResolveJunctionRequest, opts ...grpc.CallOption) (*v2alpha1.ResolveJunctionResponse, error) {
+	return w.client.ResolveJunction(context, request, opts...)
+}
+
 func (w *Client) Rmdir(context context.Context, request *v2alpha1.RmdirRequest, opts ...grpc.CallOption) (*v2alpha1.RmdirResponse, error) {
 	return w.client.Rmdir(context, request, opts...)
 }
@@ -88,3 +108,7 @@ func (w *Client) Rmdir(context context.Context, request *v2alpha1.RmdirRequest,
 func (w *Client) RmdirContents(context context.Context, request *v2alpha1.RmdirContentsRequest, opts ...grpc.CallOption) (*v2alpha1.RmdirContentsResponse, error) {
 	return w.client.RmdirContents(context, request, opts...)
 }
+
+func (w *Client) SetACL(context context.Context, request *v2alpha1.SetACLRequest, opts ...grpc.CallOption) (*v2alpha1.SetACLResponse, error) {
+	return w.client.SetACL(context, request, opts...)
+}
@@ -0,0 +1,94 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"net"
+
+	"github.com/Microsoft/go-winio"
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/api/storagespaces/v1alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+// GroupName is the group name of this API.
+const GroupName = "storagespaces"
+
+// Version is the api version.
+var Version = apiversion.NewVersionOrPanic("v1alpha1")
+
+type Client struct {
+	client     v1alpha1.StoragespacesClient
+	connection *grpc.ClientConn
+}
+
+// NewClient returns a client to make calls to the storagespaces API group version v1alpha1.
+// It's the caller's responsibility to Close the client when done.
+func NewClient() (*Client, error) {
+	pipePath := client.PipePath(GroupName, Version)
+	return NewClientWithPipePath(pipePath)
+}
+
+// NewClientWithPipePath returns a client to make calls to the named pipe located at "pipePath".
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithPipePath(pipePath string) (*Client, error) {
+
+	// verify that the pipe exists
+	_, err := winio.DialPipe(pipePath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := grpc.Dial(pipePath,
+		grpc.WithContextDialer(func(context context.Context, s string) (net.Conn, error) {
+			return winio.DialPipeContext(context, s)
+		}),
+		grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	client := v1alpha1.NewStoragespacesClient(connection)
+	return &Client{
+		client:     client,
+		connection: connection,
+	}, nil
+}
+
+// Close closes the client. It must be called before the client gets GC-ed.
+func (w *Client) Close() error {
+	return w.connection.Close()
+}
+
+// ensures we implement all the required methods
+var _ v1alpha1.StoragespacesClient = &Client{}
+
+func (w *Client) CreateStoragePool(context context.Context, request *v1alpha1.CreateStoragePoolRequest, opts ...grpc.CallOption) (*v1alpha1.CreateStoragePoolResponse, error) {
+	return w.client.CreateStoragePool(context, request, opts...)
+}
+
+func (w *Client) CreateVirtualDisk(context context.Context, request *v1alpha1.CreateVirtualDiskRequest, opts ...grpc.CallOption) (*v1alpha1.CreateVirtualDiskResponse, error) {
+	return w.client.CreateVirtualDisk(context, request, opts...)
+}
+
+func (w *Client) DeleteStoragePool(context context.Context, request *v1alpha1.DeleteStoragePoolRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteStoragePoolResponse, error) {
+	return w.client.DeleteStoragePool(context, request, opts...)
+}
+
+func (w *Client) DeleteVirtualDisk(context context.Context, request *v1alpha1.DeleteVirtualDiskRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteVirtualDiskResponse, error) {
+	return w.client.DeleteVirtualDisk(context, request, opts...)
+}
+
+func (w *Client) ListPoolablePhysicalDisks(context context.Context, request *v1alpha1.ListPoolablePhysicalDisksRequest, opts ...grpc.CallOption) (*v1alpha1.ListPoolablePhysicalDisksResponse, error) {
+	return w.client.ListPoolablePhysicalDisks(context, request, opts...)
+}
+
+func (w *Client) ListStoragePools(context context.Context, request *v1alpha1.ListStoragePoolsRequest, opts ...grpc.CallOption) (*v1alpha1.ListStoragePoolsResponse, error) {
+	return w.client.ListStoragePools(context, request, opts...)
+}
+
+func (w *Client) ListVirtualDisks(context context.Context, request *v1alpha1.ListVirtualDisksRequest, opts ...grpc.CallOption) (*v1alpha1.ListVirtualDisksResponse, error) {
+	return w.client.ListVirtualDisks(context, request, opts...)
+}
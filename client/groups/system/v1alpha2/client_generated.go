@@ -0,0 +1,98 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"context"
+	"net"
+
+	"github.com/Microsoft/go-winio"
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/api/system/v1alpha2"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+// GroupName is the group name of this API.
+const GroupName = "system"
+
+// Version is the api version.
+var Version = apiversion.NewVersionOrPanic("v1alpha2")
+
+type Client struct {
+	client     v1alpha2.SystemClient
+	connection *grpc.ClientConn
+}
+
+// NewClient returns a client to make calls to the system API group version v1alpha2.
+// It's the caller's responsibility to Close the client when done.
+func NewClient() (*Client, error) {
+	pipePath := client.PipePath(GroupName, Version)
+	return NewClientWithPipePath(pipePath)
+}
+
+// NewClientWithPipePath returns a client to make calls to the named pipe located at "pipePath".
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithPipePath(pipePath string) (*Client, error) {
+
+	// verify that the pipe exists
+	_, err := winio.DialPipe(pipePath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := grpc.Dial(pipePath,
+		grpc.WithContextDialer(func(context context.Context, s string) (net.Conn, error) {
+			return winio.DialPipeContext(context, s)
+		}),
+		grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	client := v1alpha2.NewSystemClient(connection)
+	return &Client{
+		client:     client,
+		connection: connection,
+	}, nil
+}
+
+// Close closes the client. It must be called before the client gets GC-ed.
+func (w *Client) Close() error {
+	return w.connection.Close()
+}
+
+// ensures we implement all the required methods
+var _ v1alpha2.SystemClient = &Client{}
+
+func (w *Client) ExportState(context context.Context, request *v1alpha2.ExportStateRequest, opts ...grpc.CallOption) (*v1alpha2.ExportStateResponse, error) {
+	return w.client.ExportState(context, request, opts...)
+}
+
+func (w *Client) GetBIOSSerialNumber(context context.Context, request *v1alpha2.GetBIOSSerialNumberRequest, opts ...grpc.CallOption) (*v1alpha2.GetBIOSSerialNumberResponse, error) {
+	return w.client.GetBIOSSerialNumber(context, request, opts...)
+}
+
+func (w *Client) GetCapabilities(context context.Context, request *v1alpha2.GetCapabilitiesRequest, opts ...grpc.CallOption) (*v1alpha2.GetCapabilitiesResponse, error) {
+	return w.client.GetCapabilities(context, request, opts...)
+}
+
+func (w *Client) GetService(context context.Context, request *v1alpha2.GetServiceRequest, opts ...grpc.CallOption) (*v1alpha2.GetServiceResponse, error) {
+	return w.client.GetService(context, request, opts...)
+}
+
+func (w *Client) ImportState(context context.Context, request *v1alpha2.ImportStateRequest, opts ...grpc.CallOption) (*v1alpha2.ImportStateResponse, error) {
+	return w.client.ImportState(context, request, opts...)
+}
+
+func (w *Client) ListSlowestOperations(context context.Context, request *v1alpha2.ListSlowestOperationsRequest, opts ...grpc.CallOption) (*v1alpha2.ListSlowestOperationsResponse, error) {
+	return w.client.ListSlowestOperations(context, request, opts...)
+}
+
+func (w *Client) StartService(context context.Context, request *v1alpha2.StartServiceRequest, opts ...grpc.CallOption) (*v1alpha2.StartServiceResponse, error) {
+	return w.client.StartService(context, request, opts...)
+}
+
+func (w *Client) StopService(context context.Context, request *v1alpha2.StopServiceRequest, opts ...grpc.CallOption) (*v1alpha2.StopServiceResponse, error) {
+	return w.client.StopService(context, request, opts...)
+}
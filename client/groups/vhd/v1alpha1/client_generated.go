@@ -0,0 +1,86 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"net"
+
+	"github.com/Microsoft/go-winio"
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/api/vhd/v1alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+// GroupName is the group name of this API.
+const GroupName = "vhd"
+
+// Version is the api version.
+var Version = apiversion.NewVersionOrPanic("v1alpha1")
+
+type Client struct {
+	client     v1alpha1.VhdClient
+	connection *grpc.ClientConn
+}
+
+// NewClient returns a client to make calls to the vhd API group version v1alpha1.
+// It's the caller's responsibility to Close the client when done.
+func NewClient() (*Client, error) {
+	pipePath := client.PipePath(GroupName, Version)
+	return NewClientWithPipePath(pipePath)
+}
+
+// NewClientWithPipePath returns a client to make calls to the named pipe located at "pipePath".
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithPipePath(pipePath string) (*Client, error) {
+
+	// verify that the pipe exists
+	_, err := winio.DialPipe(pipePath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := grpc.Dial(pipePath,
+		grpc.WithContextDialer(func(context context.Context, s string) (net.Conn, error) {
+			return winio.DialPipeContext(context, s)
+		}),
+		grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	client := v1alpha1.NewVhdClient(connection)
+	return &Client{
+		client:     client,
+		connection: connection,
+	}, nil
+}
+
+// Close closes the client. It must be called before the client gets GC-ed.
+func (w *Client) Close() error {
+	return w.connection.Close()
+}
+
+// ensures we implement all the required methods
+var _ v1alpha1.VhdClient = &Client{}
+
+func (w *Client) AttachVirtualDisk(context context.Context, request *v1alpha1.AttachVirtualDiskRequest, opts ...grpc.CallOption) (*v1alpha1.AttachVirtualDiskResponse, error) {
+	return w.client.AttachVirtualDisk(context, request, opts...)
+}
+
+func (w *Client) CreateVirtualDisk(context context.Context, request *v1alpha1.CreateVirtualDiskRequest, opts ...grpc.CallOption) (*v1alpha1.CreateVirtualDiskResponse, error) {
+	return w.client.CreateVirtualDisk(context, request, opts...)
+}
+
+func (w *Client) DetachVirtualDisk(context context.Context, request *v1alpha1.DetachVirtualDiskRequest, opts ...grpc.CallOption) (*v1alpha1.DetachVirtualDiskResponse, error) {
+	return w.client.DetachVirtualDisk(context, request, opts...)
+}
+
+func (w *Client) GetVirtualDisk(context context.Context, request *v1alpha1.GetVirtualDiskRequest, opts ...grpc.CallOption) (*v1alpha1.GetVirtualDiskResponse, error) {
+	return w.client.GetVirtualDisk(context, request, opts...)
+}
+
+func (w *Client) ResizeVirtualDisk(context context.Context, request *v1alpha1.ResizeVirtualDiskRequest, opts ...grpc.CallOption) (*v1alpha1.ResizeVirtualDiskResponse, error) {
+	return w.client.ResizeVirtualDisk(context, request, opts...)
+}
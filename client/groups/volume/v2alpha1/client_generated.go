@@ -65,30 +65,90 @@ func (w *Client) Close() error {
 // ensures we implement all the required methods
 var _ v2alpha1.VolumeClient = &Client{}
 
+func (w *Client) CleanupVolume(context context.Context, request *v2alpha1.CleanupVolumeRequest, opts ...grpc.CallOption) (*v2alpha1.CleanupVolumeResponse, error) {
+	return w.client.CleanupVolume(context, request, opts...)
+}
+
+func (w *Client) CreateBoundedDirectory(context context.Context, request *v2alpha1.CreateBoundedDirectoryRequest, opts ...grpc.CallOption) (*v2alpha1.CreateBoundedDirectoryResponse, error) {
+	return w.client.CreateBoundedDirectory(context, request, opts...)
+}
+
+func (w *Client) DiscoverLocalVolumes(context context.Context, request *v2alpha1.DiscoverLocalVolumesRequest, opts ...grpc.CallOption) (*v2alpha1.DiscoverLocalVolumesResponse, error) {
+	return w.client.DiscoverLocalVolumes(context, request, opts...)
+}
+
+func (w *Client) EnableDeduplication(context context.Context, request *v2alpha1.EnableDeduplicationRequest, opts ...grpc.CallOption) (*v2alpha1.EnableDeduplicationResponse, error) {
+	return w.client.EnableDeduplication(context, request, opts...)
+}
+
 func (w *Client) FormatVolume(context context.Context, request *v2alpha1.FormatVolumeRequest, opts ...grpc.CallOption) (*v2alpha1.FormatVolumeResponse, error) {
 	return w.client.FormatVolume(context, request, opts...)
 }
 
+func (w *Client) FormatVolumeAsync(context context.Context, request *v2alpha1.FormatVolumeAsyncRequest, opts ...grpc.CallOption) (*v2alpha1.FormatVolumeAsyncResponse, error) {
+	return w.client.FormatVolumeAsync(context, request, opts...)
+}
+
 func (w *Client) GetClosestVolumeIDFromTargetPath(context context.Context, request *v2alpha1.GetClosestVolumeIDFromTargetPathRequest, opts ...grpc.CallOption) (*v2alpha1.GetClosestVolumeIDFromTargetPathResponse, error) {
 	return w.client.GetClosestVolumeIDFromTargetPath(context, request, opts...)
 }
 
+func (w *Client) GetDeduplicationStatus(context context.Context, request *v2alpha1.GetDeduplicationStatusRequest, opts ...grpc.CallOption) (*v2alpha1.GetDeduplicationStatusResponse, error) {
+	return w.client.GetDeduplicationStatus(context, request, opts...)
+}
+
 func (w *Client) GetDiskNumberFromVolumeID(context context.Context, request *v2alpha1.GetDiskNumberFromVolumeIDRequest, opts ...grpc.CallOption) (*v2alpha1.GetDiskNumberFromVolumeIDResponse, error) {
 	return w.client.GetDiskNumberFromVolumeID(context, request, opts...)
 }
 
+func (w *Client) GetFormatVolumeStatus(context context.Context, request *v2alpha1.GetFormatVolumeStatusRequest, opts ...grpc.CallOption) (*v2alpha1.GetFormatVolumeStatusResponse, error) {
+	return w.client.GetFormatVolumeStatus(context, request, opts...)
+}
+
+func (w *Client) GetOperation(context context.Context, request *v2alpha1.GetOperationRequest, opts ...grpc.CallOption) (*v2alpha1.GetOperationResponse, error) {
+	return w.client.GetOperation(context, request, opts...)
+}
+
+func (w *Client) GetVolumeAccessPaths(context context.Context, request *v2alpha1.GetVolumeAccessPathsRequest, opts ...grpc.CallOption) (*v2alpha1.GetVolumeAccessPathsResponse, error) {
+	return w.client.GetVolumeAccessPaths(context, request, opts...)
+}
+
+func (w *Client) GetVolumeHealth(context context.Context, request *v2alpha1.GetVolumeHealthRequest, opts ...grpc.CallOption) (*v2alpha1.GetVolumeHealthResponse, error) {
+	return w.client.GetVolumeHealth(context, request, opts...)
+}
+
 func (w *Client) GetVolumeIDFromTargetPath(context context.Context, request *v2alpha1.GetVolumeIDFromTargetPathRequest, opts ...grpc.CallOption) (*v2alpha1.GetVolumeIDFromTargetPathResponse, error) {
 	return w.client.GetVolumeIDFromTargetPath(context, request, opts...)
 }
 
+func (w *Client) GetVolumeLabel(context context.Context, request *v2alpha1.GetVolumeLabelRequest, opts ...grpc.CallOption) (*v2alpha1.GetVolumeLabelResponse, error) {
+	return w.client.GetVolumeLabel(context, request, opts...)
+}
+
+func (w *Client) GetVolumeSerialNumber(context context.Context, request *v2alpha1.GetVolumeSerialNumberRequest, opts ...grpc.CallOption) (*v2alpha1.GetVolumeSerialNumberResponse, error) {
+	return w.client.GetVolumeSerialNumber(context, request, opts...)
+}
+
 func (w *Client) GetVolumeStats(context context.Context, request *v2alpha1.GetVolumeStatsRequest, opts ...grpc.CallOption) (*v2alpha1.GetVolumeStatsResponse, error) {
 	return w.client.GetVolumeStats(context, request, opts...)
 }
 
+func (w *Client) GetVolumeWriteCache(context context.Context, request *v2alpha1.GetVolumeWriteCacheRequest, opts ...grpc.CallOption) (*v2alpha1.GetVolumeWriteCacheResponse, error) {
+	return w.client.GetVolumeWriteCache(context, request, opts...)
+}
+
+func (w *Client) IsVolumeDirty(context context.Context, request *v2alpha1.IsVolumeDirtyRequest, opts ...grpc.CallOption) (*v2alpha1.IsVolumeDirtyResponse, error) {
+	return w.client.IsVolumeDirty(context, request, opts...)
+}
+
 func (w *Client) IsVolumeFormatted(context context.Context, request *v2alpha1.IsVolumeFormattedRequest, opts ...grpc.CallOption) (*v2alpha1.IsVolumeFormattedResponse, error) {
 	return w.client.IsVolumeFormatted(context, request, opts...)
 }
 
+func (w *Client) ListVolumes(context context.Context, request *v2alpha1.ListVolumesRequest, opts ...grpc.CallOption) (*v2alpha1.ListVolumesResponse, error) {
+	return w.client.ListVolumes(context, request, opts...)
+}
+
 func (w *Client) ListVolumesOnDisk(context context.Context, request *v2alpha1.ListVolumesOnDiskRequest, opts ...grpc.CallOption) (*v2alpha1.ListVolumesOnDiskResponse, error) {
 	return w.client.ListVolumesOnDisk(context, request, opts...)
 }
@@ -97,14 +157,62 @@ func (w *Client) MountVolume(context context.Context, request *v2alpha1.MountVol
 	return w.client.MountVolume(context, request, opts...)
 }
 
+func (w *Client) OptimizeVolume(context context.Context, request *v2alpha1.OptimizeVolumeRequest, opts ...grpc.CallOption) (*v2alpha1.OptimizeVolumeResponse, error) {
+	return w.client.OptimizeVolume(context, request, opts...)
+}
+
+func (w *Client) OptimizeVolumeAsync(context context.Context, request *v2alpha1.OptimizeVolumeAsyncRequest, opts ...grpc.CallOption) (*v2alpha1.OptimizeVolumeAsyncResponse, error) {
+	return w.client.OptimizeVolumeAsync(context, request, opts...)
+}
+
+func (w *Client) PrepareStagingPath(context context.Context, request *v2alpha1.PrepareStagingPathRequest, opts ...grpc.CallOption) (*v2alpha1.PrepareStagingPathResponse, error) {
+	return w.client.PrepareStagingPath(context, request, opts...)
+}
+
+func (w *Client) RemoveBoundedDirectory(context context.Context, request *v2alpha1.RemoveBoundedDirectoryRequest, opts ...grpc.CallOption) (*v2alpha1.RemoveBoundedDirectoryResponse, error) {
+	return w.client.RemoveBoundedDirectory(context, request, opts...)
+}
+
+func (w *Client) RepairVolume(context context.Context, request *v2alpha1.RepairVolumeRequest, opts ...grpc.CallOption) (*v2alpha1.RepairVolumeResponse, error) {
+	return w.client.RepairVolume(context, request, opts...)
+}
+
+func (w *Client) RepairVolumeAsync(context context.Context, request *v2alpha1.RepairVolumeAsyncRequest, opts ...grpc.CallOption) (*v2alpha1.RepairVolumeAsyncResponse, error) {
+	return w.client.RepairVolumeAsync(context, request, opts...)
+}
+
 func (w *Client) ResizeVolume(context context.Context, request *v2alpha1.ResizeVolumeRequest, opts ...grpc.CallOption) (*v2alpha1.ResizeVolumeResponse, error) {
 	return w.client.ResizeVolume(context, request, opts...)
 }
 
+func (w *Client) ResizeVolumeAsync(context context.Context, request *v2alpha1.ResizeVolumeAsyncRequest, opts ...grpc.CallOption) (*v2alpha1.ResizeVolumeAsyncResponse, error) {
+	return w.client.ResizeVolumeAsync(context, request, opts...)
+}
+
+func (w *Client) SetVolumeCompression(context context.Context, request *v2alpha1.SetVolumeCompressionRequest, opts ...grpc.CallOption) (*v2alpha1.SetVolumeCompressionResponse, error) {
+	return w.client.SetVolumeCompression(context, request, opts...)
+}
+
+func (w *Client) SetVolumeLabel(context context.Context, request *v2alpha1.SetVolumeLabelRequest, opts ...grpc.CallOption) (*v2alpha1.SetVolumeLabelResponse, error) {
+	return w.client.SetVolumeLabel(context, request, opts...)
+}
+
+func (w *Client) SyncVolume(context context.Context, request *v2alpha1.SyncVolumeRequest, opts ...grpc.CallOption) (*v2alpha1.SyncVolumeResponse, error) {
+	return w.client.SyncVolume(context, request, opts...)
+}
+
 func (w *Client) UnmountVolume(context context.Context, request *v2alpha1.UnmountVolumeRequest, opts ...grpc.CallOption) (*v2alpha1.UnmountVolumeResponse, error) {
 	return w.client.UnmountVolume(context, request, opts...)
 }
 
+func (w *Client) WatchVolumes(context context.Context, request *v2alpha1.WatchVolumesRequest, opts ...grpc.CallOption) (v2alpha1.Volume_WatchVolumesClient, error) {
+	return w.client.WatchVolumes(context, request, opts...)
+}
+
 func (w *Client) WriteVolumeCache(context context.Context, request *v2alpha1.WriteVolumeCacheRequest, opts ...grpc.CallOption) (*v2alpha1.WriteVolumeCacheResponse, error) {
 	return w.client.WriteVolumeCache(context, request, opts...)
 }
+
+func (w *Client) WriteVolumeCaches(context context.Context, request *v2alpha1.WriteVolumeCachesRequest, opts ...grpc.CallOption) (*v2alpha1.WriteVolumeCachesResponse, error) {
+	return w.client.WriteVolumeCaches(context, request, opts...)
+}
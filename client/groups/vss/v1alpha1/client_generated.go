@@ -0,0 +1,82 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"net"
+
+	"github.com/Microsoft/go-winio"
+	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/client/api/vss/v1alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+// GroupName is the group name of this API.
+const GroupName = "vss"
+
+// Version is the api version.
+var Version = apiversion.NewVersionOrPanic("v1alpha1")
+
+type Client struct {
+	client     v1alpha1.VssClient
+	connection *grpc.ClientConn
+}
+
+// NewClient returns a client to make calls to the vss API group version v1alpha1.
+// It's the caller's responsibility to Close the client when done.
+func NewClient() (*Client, error) {
+	pipePath := client.PipePath(GroupName, Version)
+	return NewClientWithPipePath(pipePath)
+}
+
+// NewClientWithPipePath returns a client to make calls to the named pipe located at "pipePath".
+// It's the caller's responsibility to Close the client when done.
+func NewClientWithPipePath(pipePath string) (*Client, error) {
+
+	// verify that the pipe exists
+	_, err := winio.DialPipe(pipePath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := grpc.Dial(pipePath,
+		grpc.WithContextDialer(func(context context.Context, s string) (net.Conn, error) {
+			return winio.DialPipeContext(context, s)
+		}),
+		grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	client := v1alpha1.NewVssClient(connection)
+	return &Client{
+		client:     client,
+		connection: connection,
+	}, nil
+}
+
+// Close closes the client. It must be called before the client gets GC-ed.
+func (w *Client) Close() error {
+	return w.connection.Close()
+}
+
+// ensures we implement all the required methods
+var _ v1alpha1.VssClient = &Client{}
+
+func (w *Client) CreateShadowCopy(context context.Context, request *v1alpha1.CreateShadowCopyRequest, opts ...grpc.CallOption) (*v1alpha1.CreateShadowCopyResponse, error) {
+	return w.client.CreateShadowCopy(context, request, opts...)
+}
+
+func (w *Client) DeleteShadowCopy(context context.Context, request *v1alpha1.DeleteShadowCopyRequest, opts ...grpc.CallOption) (*v1alpha1.DeleteShadowCopyResponse, error) {
+	return w.client.DeleteShadowCopy(context, request, opts...)
+}
+
+func (w *Client) ListShadowCopies(context context.Context, request *v1alpha1.ListShadowCopiesRequest, opts ...grpc.CallOption) (*v1alpha1.ListShadowCopiesResponse, error) {
+	return w.client.ListShadowCopies(context, request, opts...)
+}
+
+func (w *Client) RevertToShadowCopy(context context.Context, request *v1alpha1.RevertToShadowCopyRequest, opts ...grpc.CallOption) (*v1alpha1.RevertToShadowCopyResponse, error) {
+	return w.client.RevertToShadowCopy(context, request, opts...)
+}
@@ -33,3 +33,11 @@ const (
 func PipePath(apiGroupName string, apiVersion apiversion.Version) string {
 	return pipePrefix + csiProxyNamedPipePrefix + apiGroupName + "-" + apiVersion.String()
 }
+
+// DriverPipePath returns the path of the named pipe dedicated to the driver
+// `driverName` for the API group and version, e.g.
+// "\\.\\pipe\\csi-proxy-smb-v1-driverA". The clients of a driver pass it to
+// the NewClientWithPipePath functions.
+func DriverPipePath(apiGroupName string, apiVersion apiversion.Version, driverName string) string {
+	return PipePath(apiGroupName, apiVersion) + "-" + driverName
+}
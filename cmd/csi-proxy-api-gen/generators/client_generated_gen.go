@@ -21,6 +21,9 @@ func (g *clientGeneratedGenerator) Namers(*generator.Context) namer.NameSystems
 		"shortenVersionPackage": &shortenVersionPackageNamer{
 			version: g.version,
 		},
+		"clientStream": &clientStreamNamer{
+			version: g.version,
+		},
 	}
 }
 
@@ -103,6 +106,10 @@ var _ $.version$.$.camelGroupName$Client = &Client{}
 	})
 
 	for _, namedCallback := range g.version.serverCallbacks {
+		if namedCallback.stream != nil {
+			g.writeStreamWrapperFunction(namedCallback.name, namedCallback.callback, snippetWriter)
+			continue
+		}
 		g.writeWrapperFunction(namedCallback.name, namedCallback.callback, snippetWriter)
 	}
 
@@ -129,3 +136,17 @@ func (g *clientGeneratedGenerator) writeWrapperFunction(callbackName string, cal
 
 	snippetWriter.Do("}\n\n", nil)
 }
+
+// writeStreamWrapperFunction writes the wrapper of a server streaming callback,
+// it returns the client side of the stream e.g. Volume_WatchVolumesClient for the
+// server side Volume_WatchVolumesServer.
+func (g *clientGeneratedGenerator) writeStreamWrapperFunction(callbackName string, callback *types.Type, snippetWriter *generator.SnippetWriter) {
+	request := callback.Signature.Parameters[0]
+	streamParam := callback.Signature.Parameters[1]
+
+	snippetWriter.Do("func (w *Client) $.$(context context.Context, ", callbackName)
+	snippetWriter.Do("$.|short$ $.|shortenVersionPackage$, opts ...grpc.CallOption) (", request)
+	snippetWriter.Do("$.|clientStream$, error) {\n", streamParam)
+	snippetWriter.Do("return w.client.$.$(context, ", callbackName)
+	snippetWriter.Do("$.|short$, opts...)\n}\n\n", request)
+}
@@ -31,6 +31,9 @@ type apiVersion struct {
 type namedCallback struct {
 	name     string
 	callback *types.Type
+	// stream is the type of the messages sent by a server streaming callback,
+	// nil for unary callbacks.
+	stream *types.Type
 }
 
 // orderedCallbacks is an alphabetically sorted list of named callbacks.
@@ -100,16 +103,19 @@ func (d *groupDefinition) addVersion(versionPkg *types.Package) {
 	d.versions = append(d.versions, version)
 
 	for callbackName, versionedCallback := range serverInterface.Methods {
-		d.validateServerCallback(callbackName, versionedCallback, version)
+		stream := streamedType(versionedCallback, version)
+		d.validateServerCallback(callbackName, versionedCallback, version, stream != nil)
 
 		version.serverCallbacks.getOrInsert(namedCallback{
 			name:     callbackName,
 			callback: versionedCallback,
+			stream:   stream,
 		})
 
 		namedServerCallback := namedCallback{
 			name:     callbackName,
 			callback: replaceTypesPackage(versionedCallback, versionPkg.Path, pkgPlaceholder),
+			stream:   replaceTypesPackage(stream, versionPkg.Path, pkgPlaceholder),
 		}
 
 		if previousCallback := d.serverCallbacks.getOrInsert(namedServerCallback); previousCallback != nil {
@@ -128,12 +134,34 @@ func (d *groupDefinition) addVersion(versionPkg *types.Package) {
 	}
 }
 
+// streamedType returns the type of the messages sent by the server streaming
+// callback `callback`, i.e. the parameter of the Send method of its last parameter
+// (the versioned stream), nil if it's a unary callback.
+func streamedType(callback *types.Type, version *apiVersion) *types.Type {
+	params := callback.Signature.Parameters
+	if len(params) != 2 {
+		return nil
+	}
+	stream := params[1]
+	if stream.Kind != types.Interface || !isVersionedVariable(stream, version) {
+		return nil
+	}
+	send, ok := stream.Methods["Send"]
+	if !ok || send.Signature == nil || len(send.Signature.Parameters) != 1 {
+		return nil
+	}
+	return send.Signature.Parameters[0]
+}
+
 // validateServerCallback checks that server callbacks have the expected shape, i.e.:
-// * all versioned (i.e. in the same package) parameter should be pointers
+// * all versioned (i.e. in the same package) parameter should be pointers, except for the stream of streaming callbacks
 // * return values should all be pointers, except for the last one, which must be an error
 // These assumptions are necessary for some of the generators in this package.
-func (d *groupDefinition) validateServerCallback(callbackName string, callback *types.Type, version *apiVersion) {
-	for _, param := range callback.Signature.Parameters {
+func (d *groupDefinition) validateServerCallback(callbackName string, callback *types.Type, version *apiVersion, streaming bool) {
+	for i, param := range callback.Signature.Parameters {
+		if streaming && i == len(callback.Signature.Parameters)-1 {
+			continue
+		}
 		if isVersionedVariable(param, version) && param.Kind != types.Pointer {
 			klog.Fatalf("Server callback %s in API %s version %s has a non-pointer versioned parameter: %v",
 				callbackName, d.name, version.Name, param)
@@ -34,6 +34,18 @@ func (n *shortenVersionPackageNamer) Name(t *types.Type) string {
 	return strings.ReplaceAll(t.Name.String(), n.version.Package.Path, n.version.Package.Name)
 }
 
+// a clientStreamNamer returns the name of the client side of a server stream, with
+// the package shortened like a shortenVersionPackageNamer; e.g.
+// "v1.Foo_WatchClient" for "github.com/.../v1.Foo_WatchServer".
+type clientStreamNamer struct {
+	version *apiVersion
+}
+
+func (n *clientStreamNamer) Name(t *types.Type) string {
+	name := strings.ReplaceAll(t.Name.String(), n.version.Package.Path, n.version.Package.Name)
+	return strings.TrimSuffix(name, "Server") + "Client"
+}
+
 // a versionedVariableNamer returns suitable short variable names, derived from the type's name,
 // akin to a shortNamer; it also prefixes variables from types belonging to version-specific
 // packages with the string "versioned".
@@ -36,6 +36,14 @@ func (g *serverGenerator) Init(context *generator.Context, writer io.Writer) err
 	for _, namedCallback := range g.groupDefinition.serverCallbacks {
 		callback := replaceTypesPackage(namedCallback.callback, pkgPlaceholder, "internal")
 
+		if namedCallback.stream != nil {
+			stream := replaceTypesPackage(namedCallback.stream, pkgPlaceholder, "internal")
+			snippetWriter.Do("func (s *Server) "+namedCallback.name+"(context context.Context, request $.$, ", callback.Signature.Parameters[0])
+			snippetWriter.Do("send func($.$) error, version apiversion.Version) error {\n", stream)
+			snippetWriter.Do("// TODO: auto-generated stub\nreturn nil\n}\n\n", nil)
+			continue
+		}
+
 		snippetWriter.Do("func (s *Server) "+namedCallback.name+"(", nil)
 		for _, param := range callback.Signature.Parameters {
 			snippetWriter.Do("$.|short$ $.$, ", param)
@@ -68,6 +68,10 @@ func (s *versionedAPI) Register(grpcServer *grpc.Server) {
 
 	// write a request handler for each server callback
 	for _, namedCallback := range g.version.serverCallbacks {
+		if namedCallback.stream != nil {
+			g.writeStreamWrapperFunction(namedCallback.name, namedCallback.callback, namedCallback.stream, snippetWriter)
+			continue
+		}
 		g.writeWrapperFunction(namedCallback.name, namedCallback.callback, snippetWriter)
 	}
 
@@ -136,3 +140,30 @@ func (g *serverGeneratedGenerator) writeWrapperFunction(callbackName string, cal
 	// end of the request handler
 	snippetWriter.Do("\n}\n\n", nil)
 }
+
+// writeStreamWrapperFunction writes the request handler of a server streaming
+// callback, the internal server sends the messages with a function that converts
+// them to versioned messages and sends them on the stream.
+func (g *serverGeneratedGenerator) writeStreamWrapperFunction(callbackName string, callback *types.Type, stream *types.Type, snippetWriter *generator.SnippetWriter) {
+	request := callback.Signature.Parameters[0]
+	streamParam := callback.Signature.Parameters[1]
+
+	// write the func signature
+	snippetWriter.Do("func (s *versionedAPI) $.$(", callbackName)
+	snippetWriter.Do("$.|versionedVariable$ $.|shortenVersionPackage$, ", request)
+	snippetWriter.Do("stream $.|shortenVersionPackage$) error {\n", streamParam)
+
+	// convert the versioned request to an internal struct
+	snippetWriter.Do("$.|short$ := &impl.$.|removePackage${}\n", request)
+	snippetWriter.Do("if err := Convert_"+g.version.Name+"_$.|removePackage$_To_impl_$.|removePackage$($.|versionedVariable$, $.|short$); err != nil {\n", request)
+	snippetWriter.Do("return err\n}\n\n", nil)
+
+	// call the internal server with a function that converts and sends the messages
+	snippetWriter.Do("return s.apiGroupServer."+callbackName+"(stream.Context(), $.|short$, ", request)
+	snippetWriter.Do("func($.|short$ *impl.$.|removePackage$) error {\n", stream)
+	snippetWriter.Do("$.|versionedVariable$ := &"+g.version.Name+".$.|removePackage${}\n", stream)
+	snippetWriter.Do("if err := Convert_impl_$.|removePackage$_To_"+g.version.Name+"_$.|removePackage$($.|short$, $.|versionedVariable$); err != nil {\n", stream)
+	snippetWriter.Do("return err\n}\n", nil)
+	snippetWriter.Do("return stream.Send($.|versionedVariable$)\n", stream)
+	snippetWriter.Do("}, version)\n}\n\n", nil)
+}
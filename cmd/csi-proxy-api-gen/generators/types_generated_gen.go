@@ -39,6 +39,14 @@ type ServerInterface interface {
 	for _, namedCallback := range g.groupDefinition.serverCallbacks {
 		callback := replaceTypesPackage(namedCallback.callback, pkgPlaceholder, "")
 
+		if namedCallback.stream != nil {
+			// server streaming callbacks send the messages with a function
+			stream := replaceTypesPackage(namedCallback.stream, pkgPlaceholder, "")
+			snippetWriter.Do(namedCallback.name+"(context.Context, $.$, ", callback.Signature.Parameters[0])
+			snippetWriter.Do("func($.$) error, apiversion.Version) error\n", stream)
+			continue
+		}
+
 		snippetWriter.Do(namedCallback.name+"(", nil)
 		for _, param := range callback.Signature.Parameters {
 			snippetWriter.Do("$.$, ", param)
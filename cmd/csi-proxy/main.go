@@ -1,22 +1,42 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/kubernetes-csi/csi-proxy/pkg/cache"
+	"github.com/kubernetes-csi/csi-proxy/pkg/metrics"
+	"github.com/kubernetes-csi/csi-proxy/pkg/nodelabels"
+	bitlockerapi "github.com/kubernetes-csi/csi-proxy/pkg/os/bitlocker"
 	diskapi "github.com/kubernetes-csi/csi-proxy/pkg/os/disk"
 	filesystemapi "github.com/kubernetes-csi/csi-proxy/pkg/os/filesystem"
 	iscsiapi "github.com/kubernetes-csi/csi-proxy/pkg/os/iscsi"
 	smbapi "github.com/kubernetes-csi/csi-proxy/pkg/os/smb"
+	storagespacesapi "github.com/kubernetes-csi/csi-proxy/pkg/os/storagespaces"
 	sysapi "github.com/kubernetes-csi/csi-proxy/pkg/os/system"
+	vhdapi "github.com/kubernetes-csi/csi-proxy/pkg/os/vhd"
 	volumeapi "github.com/kubernetes-csi/csi-proxy/pkg/os/volume"
+	vssapi "github.com/kubernetes-csi/csi-proxy/pkg/os/vss"
 	"github.com/kubernetes-csi/csi-proxy/pkg/server"
+	bitlockersrv "github.com/kubernetes-csi/csi-proxy/pkg/server/bitlocker"
 	disksrv "github.com/kubernetes-csi/csi-proxy/pkg/server/disk"
 	filesystemsrv "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem"
 	iscsisrv "github.com/kubernetes-csi/csi-proxy/pkg/server/iscsi"
 	smbsrv "github.com/kubernetes-csi/csi-proxy/pkg/server/smb"
+	storagespacessrv "github.com/kubernetes-csi/csi-proxy/pkg/server/storagespaces"
 	syssrv "github.com/kubernetes-csi/csi-proxy/pkg/server/system"
 	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
+	vhdsrv "github.com/kubernetes-csi/csi-proxy/pkg/server/vhd"
 	volumesrv "github.com/kubernetes-csi/csi-proxy/pkg/server/volume"
+	vsssrv "github.com/kubernetes-csi/csi-proxy/pkg/server/vss"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
 	"golang.org/x/sys/windows"
 	"golang.org/x/sys/windows/svc"
 	"k8s.io/klog/v2"
@@ -34,15 +54,46 @@ func (i *workingDirFlags) Set(value string) error {
 }
 
 var (
-	kubeletPath = flag.String("kubelet-path", `C:\var\lib\kubelet`, "Prefix path of the kubelet directory in the host file system")
-	windowsSvc  = flag.Bool("windows-service", false, "Configure as a Windows Service")
-	service     *handler
-	workingDirs workingDirFlags
+	kubeletPath       = flag.String("kubelet-path", `C:\var\lib\kubelet`, "Prefix path of the kubelet directory in the host file system")
+	windowsSvc        = flag.Bool("windows-service", false, "Configure as a Windows Service")
+	mountMetadataFile = flag.String("mount-metadata-file", `C:\var\lib\csi-proxy\mounts.json`, "File where the paths where volumes are mounted are stored")
+	driveLetters      = flag.String("drive-letters", "", "Drive letters (e.g. STUVWXYZ) that can be assigned to volumes mounted at a drive letter, any free drive letter is assigned if empty")
+	metricsAddr       = flag.String("metrics-address", "", "The address (e.g. localhost:9765) to serve the metrics at, metrics aren't served if empty")
+	stateFile         = flag.String("state-file", `C:\var\lib\csi-proxy\state.json`, "File where the configuration imported with ImportState is saved, it's used for the flags that aren't set in the command line")
+	strictMode        = flag.Bool("strict-mode", false, "Never run PowerShell, the operations that require it fail as unimplemented")
+	cacheMemoryLimit  = flag.Int64("cache-memory-limit", cache.DefaultMemoryLimit, "Memory in bytes that the internal caches can use, the least recently used entries are evicted when it's exceeded")
+	diskBusTypes      = flag.String("allowed-disk-bus-types", "", "Comma separated bus types (e.g. SAS,iSCSI) of the disks that can be initialized and formatted, all the bus types are allowed if empty")
+	minDiskSize       = flag.Int64("min-disk-size", 0, "Minimum size in bytes of the disks that can be initialized and formatted, no minimum if 0")
+	maxDiskSize       = flag.Int64("max-disk-size", 0, "Maximum size in bytes of the disks that can be initialized and formatted, no maximum if 0")
+	protectOSDisks    = flag.Bool("protect-os-disks", true, "Never initialize or format the disks of the boot and system partitions")
+	operationSLOs     = flag.String("operation-slos", "", "Comma separated latency SLOs of the operations (e.g. Volume/MountVolume=30s,FormatVolume=5m,*=2m), the operations that exceed their SLO are logged with the commands that ran meanwhile")
+	fencingHook       = flag.String("fencing-hook", "", "Command run with the volume ID and the node name as arguments before mounting a volume still marked as mounted on another node, the volume is mounted if it exits with 0. Volumes aren't fenced if empty")
+	volumeHookCmds    = flag.String("volume-hook-commands", "", "Comma separated commands run with the phase (before or after), the operation (mount, format or unmount), the volume ID and the path as arguments before and after the volume operations, the operation fails if a before command doesn't exit with 0. No commands are run if empty")
+	volumeHookPlugins = flag.String("volume-hook-plugins", "", "Comma separated names of the compiled-in volume hooks that run before and after the volume operations, after the hook commands. No compiled-in hooks run if empty")
+	disabledAPIGroups = flag.String("disabled-api-groups", "", "Comma separated API groups (e.g. iscsi,system) that aren't served, all the API groups are served if empty")
+	journaledStats    = flag.Bool("journaled-volume-stats", false, "Keep the stats of the volumes and query them again only when the USN change journal of the volume has changes of the used space since the last query")
+	redactSecrets     = flag.Bool("redact-secrets", true, "Redact the credentials (e.g. SMB passwords and CHAP secrets) of the requests from the logs, the command log and the errors")
+	warmUpTimeout     = flag.Duration("warm-up-timeout", time.Minute, "How long the storage stack is warmed up at startup with a cheap query per API group before serving, it isn't warmed up if 0")
+	driverEndpoints   = flag.String("driver-endpoints-file", "", "JSON file with the endpoints dedicated to CSI drivers (e.g. [{\"name\": \"driverA\", \"allowedMethods\": [\"Smb/*\"], \"maxConcurrentOperations\": 4}]), each driver is served on its own named pipes e.g. \\\\.\\pipe\\csi-proxy-smb-v1-driverA with its own policy, quota and metrics, there are no driver endpoints if empty")
+	nodeLabelsConfig  = flag.String("node-labels-kubeconfig", "", "Kubeconfig used to publish the storage capabilities of the node (ReFS, MPIO, NVMe, max disks) as labels of its Node object, they aren't published if empty")
+	nodeName          = flag.String("node-name", "", "Name of the Node object of the node, the lowercase host name if empty")
+	nodeMaxDisks      = flag.Int("node-max-disks", 0, "Maximum number of data disks that can be attached to the node, published as a node label if set")
+	nodeLabelsPeriod  = flag.Duration("node-labels-interval", 10*time.Minute, "How often the storage capabilities of the node are published again")
+	service           *handler
+	workingDirs       workingDirFlags
 )
 
+// shutdownFlushTimeout is how long the mounted volumes are flushed for when the node
+// shuts down, Windows gives services a few seconds to stop at shutdown.
+const shutdownFlushTimeout = 5 * time.Second
+
 type handler struct {
 	tosvc   chan bool
 	fromsvc chan error
+
+	// shutdownHooks are called when the node shuts down
+	shutdownHooks     []func()
+	shutdownHooksLock sync.Mutex
 }
 
 func init() {
@@ -54,6 +105,14 @@ func main() {
 	klog.InitFlags(nil)
 
 	flag.Parse()
+	if err := loadConfigState(*stateFile); err != nil {
+		panic(err)
+	}
+
+	utils.SetRedaction(*redactSecrets)
+	if *redactSecrets {
+		klog.SetLogFilter(utils.RedactionLogFilter{})
+	}
 
 	if *windowsSvc {
 		if err := initService(); err != nil {
@@ -63,59 +122,343 @@ func main() {
 
 	klog.Info("Starting CSI-Proxy Server ...")
 	klog.Infof("Version: %s", version)
-	apiGroups, err := apiGroups()
+	if *metricsAddr != "" {
+		metrics.StartServer(*metricsAddr)
+	}
+	cache.Memory.SetLimit(*cacheMemoryLimit)
+	slos, err := metrics.ParseSLOs(*operationSLOs)
 	if err != nil {
 		panic(err)
 	}
+	metrics.Latency.SetSLOs(slos)
+	disabled, err := parseDisabledAPIGroups(*disabledAPIGroups)
+	if err != nil {
+		panic(err)
+	}
+	if len(disabled) > 0 {
+		klog.Infof("Disabled API groups: %s", *disabledAPIGroups)
+	}
+	// the modules of the disabled API groups aren't needed, don't probe them
+	utils.PowerShellModules = enabledModules(utils.PowerShellModules, disabled)
+	if *strictMode {
+		klog.Info("Strict mode is enabled, PowerShell is disabled")
+		utils.DisablePowerShell()
+	}
+	capabilities := utils.DetectCapabilities()
+	klog.Infof("Installation type: %q, build: %d, PowerShell: %t, PowerShell modules: %v",
+		capabilities.InstallationType, capabilities.Build, capabilities.PowerShell, capabilities.Modules)
+	if !capabilities.PowerShell && !utils.PowerShellDisabled() {
+		// the operations that require PowerShell fail as unimplemented like in strict mode
+		klog.Warning("PowerShell isn't available, only the operations that don't require it are served")
+		utils.DisablePowerShell()
+	}
+	utils.SetCapabilities(capabilities)
+	if capabilities.PowerShell && !utils.HasPowerShellModule(utils.ModuleStorage) {
+		klog.Warning("The Storage PowerShell module isn't available, disks and volumes are managed with CIM queries")
+	}
+	apiGroups, err := apiGroups(disabled)
+	if err != nil {
+		panic(err)
+	}
+	if *warmUpTimeout > 0 && !utils.PowerShellDisabled() {
+		// the first operations after a restart load the PowerShell modules and
+		// the WMI providers, do it before serving
+		server.WarmUp(*warmUpTimeout, apiGroups...)
+	}
 	s := server.NewServer(apiGroups...)
+	if *driverEndpoints != "" {
+		endpoints, err := loadDriverEndpoints(*driverEndpoints)
+		if err != nil {
+			panic(err)
+		}
+		if err := s.SetDriverEndpoints(endpoints); err != nil {
+			panic(err)
+		}
+	}
+
+	if *nodeLabelsConfig != "" {
+		if err := startNodeLabelsPublisher(*nodeLabelsConfig); err != nil {
+			panic(err)
+		}
+	}
 
 	if err := s.Start(nil); err != nil {
 		panic(err)
 	}
 }
 
-// apiGroups returns the list of enabled API groups.
-func apiGroups() ([]srvtypes.APIGroup, error) {
-	workingDirs = append(workingDirs, *kubeletPath)
-	fssrv, err := filesystemsrv.NewServer(workingDirs, filesystemapi.New())
+// startNodeLabelsPublisher publishes the storage capabilities of the node in the background
+// with the kubeconfig file `kubeconfig`.
+func startNodeLabelsPublisher(kubeconfig string) error {
+	if *nodeLabelsPeriod <= 0 {
+		return fmt.Errorf("invalid node labels interval %v", *nodeLabelsPeriod)
+	}
+	name := *nodeName
+	if name == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return fmt.Errorf("error getting the host name: %v", err)
+		}
+		// the kubelet uses the lowercase host name as the node name by default
+		name = strings.ToLower(hostname)
+	}
+	disks := diskapi.New()
+	detect := func() (nodelabels.StorageCapabilities, error) {
+		list, err := disks.ListDisks()
+		if err != nil {
+			return nodelabels.StorageCapabilities{}, err
+		}
+		return nodelabels.DetectStorageCapabilities(os.Getenv("SystemRoot"), list, *nodeMaxDisks), nil
+	}
+	publisher, err := nodelabels.NewPublisher(kubeconfig, name, detect)
 	if err != nil {
-		return []srvtypes.APIGroup{}, err
+		return err
 	}
-	klog.Info("Working directories: %v", fssrv.GetWorkingDirs())
+	klog.Infof("Publishing the storage capabilities of the node %s every %v", name, *nodeLabelsPeriod)
+	ctx, cancel := context.WithCancel(context.Background())
+	onShutdown(cancel)
+	go publisher.Run(ctx, *nodeLabelsPeriod)
+	return nil
+}
 
-	volumesrv, err := volumesrv.NewServer(volumeapi.New())
+// loadDriverEndpoints reads the driver endpoints from the JSON file `file`.
+func loadDriverEndpoints(file string) ([]server.DriverEndpoint, error) {
+	data, err := ioutil.ReadFile(file)
 	if err != nil {
-		return []srvtypes.APIGroup{}, err
+		return nil, fmt.Errorf("error reading the driver endpoints file %s: %v", file, err)
 	}
-
-	disksrv, err := disksrv.NewServer(diskapi.New())
+	endpoints, err := server.ParseDriverEndpoints(data)
 	if err != nil {
-		return []srvtypes.APIGroup{}, err
+		return nil, err
+	}
+	for _, endpoint := range endpoints {
+		klog.Infof("Driver endpoint: %+v", endpoint)
 	}
+	return endpoints, nil
+}
 
-	smbsrv, err := smbsrv.NewServer(smbapi.New(), fssrv)
-	if err != nil {
-		return []srvtypes.APIGroup{}, err
+// addVolumeHooks enables the volume hooks of the flags in the volume server `srv`.
+func addVolumeHooks(srv *volumesrv.Server) error {
+	for _, command := range strings.Split(*volumeHookCmds, ",") {
+		if command = strings.TrimSpace(command); command != "" {
+			klog.Infof("Volume hook command: %s", command)
+			srv.AddVolumeHook(volumesrv.CommandVolumeHook{Command: command})
+		}
 	}
+	for _, name := range strings.Split(*volumeHookPlugins, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		hook, ok := volumesrv.VolumeHookPlugin(name)
+		if !ok {
+			return fmt.Errorf("volume hook %q isn't compiled in", name)
+		}
+		klog.Infof("Volume hook plugin: %s", name)
+		srv.AddVolumeHook(hook)
+	}
+	return nil
+}
 
-	syssrv, err := syssrv.NewServer(sysapi.New())
-	if err != nil {
-		return []srvtypes.APIGroup{}, err
+// apiGroupNames are the names of the API groups served by csi-proxy.
+var apiGroupNames = []string{"filesystem", "disk", "volume", "smb", "system", "iscsi", "bitlocker", "vss", "vhd", "storagespaces"}
+
+// apiGroupModules are the PowerShell modules that are only used by an API group.
+var apiGroupModules = map[string][]string{
+	"volume":    {utils.ModuleDeduplication},
+	"smb":       {utils.ModuleSmbShare},
+	"iscsi":     {utils.ModuleISCSI},
+	"bitlocker": {utils.ModuleBitLocker},
+}
+
+// parseDisabledAPIGroups parses the comma separated names of API groups `s`.
+func parseDisabledAPIGroups(s string) (map[string]bool, error) {
+	disabled := make(map[string]bool)
+	for _, name := range strings.Split(s, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		known := false
+		for _, n := range apiGroupNames {
+			known = known || n == name
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown API group %q, the API groups are %s", name, strings.Join(apiGroupNames, ","))
+		}
+		disabled[name] = true
 	}
+	return disabled, nil
+}
 
-	iscsisrv, err := iscsisrv.NewServer(iscsiapi.New())
+// enabledModules returns the modules of `modules` that aren't only used by the
+// disabled API groups.
+func enabledModules(modules []string, disabled map[string]bool) []string {
+	unused := make(map[string]bool)
+	for name := range disabled {
+		for _, module := range apiGroupModules[name] {
+			unused[module] = true
+		}
+	}
+	enabled := []string{}
+	for _, module := range modules {
+		if !unused[module] {
+			enabled = append(enabled, module)
+		}
+	}
+	return enabled
+}
+
+// apiGroups returns the list of enabled API groups, the API groups in `disabled`
+// aren't created.
+func apiGroups(disabled map[string]bool) ([]srvtypes.APIGroup, error) {
+	config := newConfigState(*stateFile)
+	groups := []srvtypes.APIGroup{}
+
+	// the filesystem server is created even if its API group is disabled, the SMB
+	// server checks the paths with it
+	workingDirs = append(workingDirs, *kubeletPath)
+	fssrv, err := filesystemsrv.NewServer(workingDirs, filesystemapi.New())
 	if err != nil {
 		return []srvtypes.APIGroup{}, err
 	}
+	if !disabled["filesystem"] {
+		klog.Info("Working directories: %v", fssrv.GetWorkingDirs())
+		groups = append(groups, fssrv)
+	}
 
-	return []srvtypes.APIGroup{
-		fssrv,
-		disksrv,
-		volumesrv,
-		smbsrv,
-		syssrv,
-		iscsisrv,
-	}, nil
+	diskPolicy := diskPolicy()
+	if !disabled["disk"] {
+		disksrv, err := disksrv.NewServer(diskPolicy, diskapi.New())
+		if err != nil {
+			return []srvtypes.APIGroup{}, err
+		}
+		groups = append(groups, disksrv)
+	}
+
+	stateProviders := map[string]syssrv.StateProvider{
+		"config": config,
+	}
+	if !disabled["volume"] {
+		fencing := volumesrv.CommandFencingHook{Command: *fencingHook}
+		volumesrv, err := volumesrv.NewServer(*mountMetadataFile, *driveLetters, diskPolicy, volumeapi.New())
+		if err != nil {
+			return []srvtypes.APIGroup{}, err
+		}
+		volumesrv.SetPathValidator(fssrv)
+		onShutdown(func() {
+			volumesrv.FlushMountedVolumes(shutdownFlushTimeout)
+		})
+		if *fencingHook != "" {
+			nodeName, err := os.Hostname()
+			if err != nil {
+				return []srvtypes.APIGroup{}, err
+			}
+			klog.Infof("Volumes mounted on other nodes are fenced with %s", *fencingHook)
+			volumesrv.SetFencingHook(nodeName, fencing)
+		}
+		if err := addVolumeHooks(volumesrv); err != nil {
+			return []srvtypes.APIGroup{}, err
+		}
+		if *journaledStats {
+			klog.Info("The stats of the volumes are queried when their USN journal has changes")
+			volumesrv.SetJournaledStats(true)
+		}
+		stateProviders["mounts"] = volumesrv
+		groups = append(groups, volumesrv)
+	}
+
+	if !disabled["smb"] {
+		smbsrv, err := smbsrv.NewServer(smbapi.New(), fssrv)
+		if err != nil {
+			return []srvtypes.APIGroup{}, err
+		}
+		groups = append(groups, smbsrv)
+	}
+
+	if !disabled["system"] {
+		syssrv, err := syssrv.NewServer(sysapi.New(), stateProviders)
+		if err != nil {
+			return []srvtypes.APIGroup{}, err
+		}
+		groups = append(groups, syssrv)
+	}
+
+	if !disabled["iscsi"] {
+		iscsisrv, err := iscsisrv.NewServer(iscsiapi.New())
+		if err != nil {
+			return []srvtypes.APIGroup{}, err
+		}
+		groups = append(groups, iscsisrv)
+	}
+
+	if !disabled["bitlocker"] {
+		bitlockersrv, err := bitlockersrv.NewServer(bitlockerapi.New())
+		if err != nil {
+			return []srvtypes.APIGroup{}, err
+		}
+		groups = append(groups, bitlockersrv)
+	}
+
+	if !disabled["vss"] {
+		vsssrv, err := vsssrv.NewServer(vssapi.New())
+		if err != nil {
+			return []srvtypes.APIGroup{}, err
+		}
+		groups = append(groups, vsssrv)
+	}
+
+	if !disabled["vhd"] {
+		vhdsrv, err := vhdsrv.NewServer(vhdapi.New(), fssrv)
+		if err != nil {
+			return []srvtypes.APIGroup{}, err
+		}
+		groups = append(groups, vhdsrv)
+	}
+
+	if !disabled["storagespaces"] {
+		storagespacessrv, err := storagespacessrv.NewServer(storagespacesapi.New())
+		if err != nil {
+			return []srvtypes.APIGroup{}, err
+		}
+		groups = append(groups, storagespacessrv)
+	}
+
+	return groups, nil
+}
+
+// diskPolicy returns the policy of the disks that can be initialized and formatted.
+func diskPolicy() shared.DiskPolicy {
+	policy := shared.DiskPolicy{
+		MinSizeBytes:   *minDiskSize,
+		MaxSizeBytes:   *maxDiskSize,
+		ProtectOSDisks: *protectOSDisks,
+	}
+	for _, busType := range strings.Split(*diskBusTypes, ",") {
+		if busType = strings.TrimSpace(busType); busType != "" {
+			policy.AllowedBusTypes = append(policy.AllowedBusTypes, busType)
+		}
+	}
+	klog.Infof("Disk policy: %+v", policy)
+	return policy
+}
+
+// onShutdown registers `hook` to be called when the node shuts down, csi-proxy is
+// only notified of shutdowns when it runs as a Windows service.
+func onShutdown(hook func()) {
+	if service == nil {
+		return
+	}
+	service.shutdownHooksLock.Lock()
+	defer service.shutdownHooksLock.Unlock()
+	service.shutdownHooks = append(service.shutdownHooks, hook)
+}
+
+func (h *handler) runShutdownHooks() {
+	h.shutdownHooksLock.Lock()
+	defer h.shutdownHooksLock.Unlock()
+	for _, hook := range h.shutdownHooks {
+		hook()
+	}
 }
 
 // configure as a Windows service managed by Windows SCM
@@ -159,11 +502,19 @@ Loop:
 			switch c.Cmd {
 			case svc.Interrogate:
 				s <- c.CurrentStatus
-			case svc.Stop, svc.Shutdown:
+			case svc.Stop:
 				// todo: need to add a ctx to servers
 				// from main and cancel it from here
 				s <- svc.Status{State: svc.StopPending}
 				break Loop
+			case svc.Shutdown:
+				// flush the mounted volumes before Windows dismounts them. The
+				// vendored svc package doesn't accept the preshutdown control, the
+				// shutdown control is sent to services when the system shuts down
+				// too but they get less time to stop.
+				s <- svc.Status{State: svc.StopPending, WaitHint: uint32(shutdownFlushTimeout / time.Millisecond)}
+				h.runShutdownHooks()
+				break Loop
 			}
 		}
 	}
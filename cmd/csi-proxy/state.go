@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"k8s.io/klog/v2"
+)
+
+// importableFlags are the flags that can be imported with ImportState, i.e. the
+// flags that only tune csi-proxy. The flags of the commands it runs (e.g. the
+// fencing hook), of the paths it serves and of its endpoints are left out so that
+// the clients of the pipes can't change them.
+var importableFlags = map[string]bool{
+	"drive-letters":          true,
+	"strict-mode":            true,
+	"cache-memory-limit":     true,
+	"allowed-disk-bus-types": true,
+	"min-disk-size":          true,
+	"max-disk-size":          true,
+	"operation-slos":         true,
+	"disabled-api-groups":    true,
+	"journaled-volume-stats": true,
+	"warm-up-timeout":        true,
+	"node-max-disks":         true,
+	"node-labels-interval":   true,
+	"v":                      true,
+}
+
+// flagNotImportableError is returned by ImportState for the flags that are
+// unknown or that can't be imported.
+type flagNotImportableError struct {
+	name string
+}
+
+func (e *flagNotImportableError) Error() string {
+	if flag.Lookup(e.name) == nil {
+		return fmt.Sprintf("unknown flag %q", e.name)
+	}
+	return fmt.Sprintf("flag %q can't be imported", e.name)
+}
+
+// ErrorCode returns the stable code of the error.
+func (e *flagNotImportableError) ErrorCode() string {
+	return utils.ErrorCodeInvalidParameter
+}
+
+// configState is the configuration of csi-proxy in the state exported by the
+// system API group, i.e. the values of its command line flags.
+type configState struct {
+	// file is the state file where the imported configuration is saved.
+	file string
+	// flags are the values of the command line flags at startup.
+	flags map[string][]string
+}
+
+// newConfigState returns the configuration with the current values of the
+// importable flags.
+func newConfigState(file string) *configState {
+	flags := make(map[string][]string)
+	flag.VisitAll(func(f *flag.Flag) {
+		if importableFlags[f.Name] {
+			flags[f.Name] = []string{f.Value.String()}
+		}
+	})
+	return &configState{file: file, flags: flags}
+}
+
+// ExportState returns the values of the flags as JSON.
+func (c *configState) ExportState() (json.RawMessage, error) {
+	return json.Marshal(c.flags)
+}
+
+// ImportState saves the values of the flags in `state` to the state file, they
+// are used from the next start of csi-proxy.
+func (c *configState) ImportState(state json.RawMessage) error {
+	var flags map[string][]string
+	if err := json.Unmarshal(state, &flags); err != nil {
+		return fmt.Errorf("error parsing the configuration: %v", err)
+	}
+	for name := range flags {
+		if flag.Lookup(name) == nil || !importableFlags[name] {
+			return &flagNotImportableError{name: name}
+		}
+	}
+	if c.file == "" {
+		return fmt.Errorf("the configuration can't be imported without a state file")
+	}
+
+	data, err := json.Marshal(flags)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.file), 0755); err != nil {
+		return fmt.Errorf("error creating the directory of the state file %s: %v", c.file, err)
+	}
+	tmpFile := c.file + ".tmp"
+	if err := ioutil.WriteFile(tmpFile, data, 0600); err != nil {
+		return fmt.Errorf("error writing the state file %s: %v", tmpFile, err)
+	}
+	if err := os.Rename(tmpFile, c.file); err != nil {
+		return fmt.Errorf("error replacing the state file %s: %v", c.file, err)
+	}
+	return nil
+}
+
+// loadConfigState sets the importable flags that aren't set in the command line
+// to their values in the state file `file`, if it exists.
+func loadConfigState(file string) error {
+	if file == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error reading the state file %s: %v", file, err)
+	}
+	var flags map[string][]string
+	if err := json.Unmarshal(data, &flags); err != nil {
+		return fmt.Errorf("error parsing the state file %s: %v", file, err)
+	}
+
+	explicit := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+	})
+	names := make([]string, 0, len(flags))
+	for name := range flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if explicit[name] {
+			continue
+		}
+		if !importableFlags[name] {
+			klog.Warningf("Flag %s of the state file %s ignored, it can't be imported", name, file)
+			continue
+		}
+		for _, value := range flags[name] {
+			if err := flag.Set(name, value); err != nil {
+				return fmt.Errorf("error setting flag %s from the state file %s: %v", name, file, err)
+			}
+		}
+		klog.V(2).Infof("Flag %s set from the state file to %v", name, flags[name])
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+)
+
+func TestImportConfigState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "csi-proxy-state")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "state.json")
+	config := &configState{file: file}
+
+	testCases := []struct {
+		name        string
+		state       string
+		expectError bool
+	}{
+		{name: "tuning flags", state: `{"drive-letters":["STUV"],"cache-memory-limit":["1024"]}`},
+		{name: "fencing hook", state: `{"fencing-hook":["C:\\evil.exe"]}`, expectError: true},
+		{name: "volume hook commands", state: `{"volume-hook-commands":["C:\\evil.exe"]}`, expectError: true},
+		{name: "kubeconfig", state: `{"node-labels-kubeconfig":["C:\\kubeconfig"]}`, expectError: true},
+		{name: "endpoints", state: `{"driver-endpoints-file":["C:\\endpoints.json"]}`, expectError: true},
+		{name: "state file", state: `{"state-file":["C:\\state.json"]}`, expectError: true},
+		{name: "unknown flag", state: `{"no-such-flag":["1"]}`, expectError: true},
+	}
+	for _, tc := range testCases {
+		os.Remove(file)
+		err := config.ImportState(json.RawMessage(tc.state))
+		if !tc.expectError {
+			if err != nil {
+				t.Errorf("%s: error %v not expected", tc.name, err)
+			}
+			continue
+		}
+		if code, _ := utils.StableErrorCode(err); code != utils.ErrorCodeInvalidParameter {
+			t.Errorf("%s: expected an invalid parameter error, got %v", tc.name, err)
+		}
+		if _, err := os.Stat(file); !os.IsNotExist(err) {
+			t.Errorf("%s: the state file must not be written", tc.name)
+		}
+	}
+}
@@ -29,6 +29,7 @@ require (
 	golang.org/x/text v0.3.2 // indirect
 	google.golang.org/grpc v1.38.0
 	google.golang.org/protobuf v1.25.0
+	gopkg.in/yaml.v2 v2.2.2
 	k8s.io/gengo v0.0.0-00010101000000-000000000000
 	k8s.io/klog v1.0.0 // indirect
 	k8s.io/klog/v2 v2.9.0
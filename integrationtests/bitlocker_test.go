@@ -0,0 +1,75 @@
+package integrationtests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/bitlocker/v1alpha1"
+	bitlockerclient "github.com/kubernetes-csi/csi-proxy/client/groups/bitlocker/v1alpha1"
+	volumev2alpha1client "github.com/kubernetes-csi/csi-proxy/client/groups/volume/v2alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+// isBitLockerInstalled returns true if the BitLocker feature and its cmdlets are installed.
+func isBitLockerInstalled(t *testing.T) bool {
+	_, err := runPowershellCmd(t, "Get-Command Enable-BitLocker -ErrorAction Stop")
+	return err == nil
+}
+
+func TestBitLockerAPIGroup(t *testing.T) {
+	skipTestOnCondition(t, isRunningOnGhActions() || !isBitLockerInstalled(t))
+
+	bitlockerClient, err := bitlockerclient.NewClient()
+	require.NoError(t, err)
+	defer bitlockerClient.Close()
+
+	volumeClient, err := volumev2alpha1client.NewClient()
+	require.NoError(t, err)
+	defer volumeClient.Close()
+
+	_, volumeID, vhdCleanup := volumeInit(volumeClient, t)
+	defer vhdCleanup()
+
+	password := randomString(10) + "!"
+	enableResponse, err := bitlockerClient.EnableBitLocker(context.TODO(), &v1alpha1.EnableBitLockerRequest{
+		VolumeId: volumeID,
+		KeyProtector: &v1alpha1.KeyProtector{
+			Type:   v1alpha1.KeyProtectorType_PASSWORD,
+			Secret: password,
+		},
+		UsedSpaceOnly: true,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, enableResponse.KeyProtectorId)
+
+	statusResponse, err := bitlockerClient.GetBitLockerStatus(context.TODO(), &v1alpha1.GetBitLockerStatusRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	require.Equal(t, "Unlocked", statusResponse.LockStatus)
+	require.Equal(t, "XtsAes128", statusResponse.EncryptionMethod)
+	require.Contains(t, statusResponse.KeyProtectorTypes, "Password")
+
+	// lock the volume (BitLocker doesn't lock a volume that's still being encrypted) and unlock it with its password
+	cmd := fmt.Sprintf(`while ((Get-BitLockerVolume -MountPoint "%s").VolumeStatus -ne "FullyEncrypted") { Start-Sleep 1 }; Lock-BitLocker -MountPoint "%s" -ForceDismount`, volumeID, volumeID)
+	out, err := runPowershellCmd(t, cmd)
+	require.NoError(t, err, out)
+
+	statusResponse, err = bitlockerClient.GetBitLockerStatus(context.TODO(), &v1alpha1.GetBitLockerStatusRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	require.Equal(t, "Locked", statusResponse.LockStatus)
+
+	_, err = bitlockerClient.UnlockVolume(context.TODO(), &v1alpha1.UnlockVolumeRequest{
+		VolumeId: volumeID,
+		KeyProtector: &v1alpha1.KeyProtector{
+			Type:   v1alpha1.KeyProtectorType_PASSWORD,
+			Secret: password,
+		},
+	})
+	require.NoError(t, err)
+
+	statusResponse, err = bitlockerClient.GetBitLockerStatus(context.TODO(), &v1alpha1.GetBitLockerStatusRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	require.Equal(t, "Unlocked", statusResponse.LockStatus)
+	require.Equal(t, "FullyEncrypted", statusResponse.VolumeStatus)
+	require.EqualValues(t, 100, statusResponse.EncryptionPercentage)
+}
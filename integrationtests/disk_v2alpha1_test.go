@@ -0,0 +1,520 @@
+package integrationtests
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	v2alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	diskv2alpha1client "github.com/kubernetes-csi/csi-proxy/client/groups/disk/v2alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func v2alpha1DiskTests(t *testing.T) {
+	t.Run("ListDiskIDs,ListDiskLocations", func(t *testing.T) {
+		// even though this test doesn't need the VHD API it failed in Github Actions
+		//     disk_v2alpha1_test.go:30:
+		// Error Trace:	disk_v2alpha1_test.go:30
+		// Error:      	Expected nil, but got: &status.statusError{state:impl.MessageState{NoUnkeyedLiterals:pragma.NoUnkeyedLiterals{}, DoNotCompare:pragma.DoNotCompare{}, DoNotCopy:pragma.DoNotCopy{}, atomicMessageInfo:(*impl.MessageInfo)(nil)}, sizeCache:0, unknownFields:[]uint8(nil), Code:2, Message:"Could not get page83 ID: IOCTL_STORAGE_QUERY_PROPERTY failed: Incorrect function.", Details:[]*anypb.Any(nil)}
+		// Test:       	TestDiskAPIGroup/v2alpha1Tests/ListDiskIDs,ListDiskLocations
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		client, err := diskv2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		listRequest := &v2alpha1.ListDiskIDsRequest{}
+		diskIDsResponse, err := client.ListDiskIDs(context.TODO(), listRequest)
+		require.Nil(t, err)
+
+		// example output for GCE (0 is ok, others are virtual disks)
+		// diskIDs:{key:0  value:{page83:"Google  persistent-disk-0"  serial_number:"                    "}}
+		// diskIDs:{key:1  value:{page83:"4d53465420202020328d59b360875845ac645473be8267bf"}}
+		// diskIDs:{key:2  value:{page83:"4d534654202020208956a91dadfe3d48865f9b9bcbdb8d3e"}}
+		// diskIDs:{key:3  value:{page83:"4d534654202020207a3d18d72787ee47bdc127cb4f06403a"}}
+		t.Logf("diskIDsResponse=%v", diskIDsResponse)
+
+		cmd := "hostname"
+		hostname, err := runPowershellCmd(t, cmd)
+		if err != nil {
+			t.Errorf("Error: %v. Command: %s. Out: %s", err, cmd, hostname)
+		}
+
+		hostname = strings.TrimSpace(hostname)
+		diskIDsMap := diskIDsResponse.DiskIDs
+		if len(diskIDsMap) == 0 {
+			t.Errorf("Expected to get at least one diskIDs, instead got diskIDsResponse.DiskIDs=%+v", diskIDsMap)
+		}
+
+		// some disks may have the field Page83, if it's a GCE Persistent disk
+		// it'll have a nonempty SerialNumber
+		// first disk is the VM disk (other disks might be VHD)
+		for diskNumber, diskIDs := range diskIDsMap {
+			if len(diskIDs.SerialNumber) > 0 {
+				// the nvme disks don't have a Page83 number
+				if strings.HasPrefix(diskIDs.SerialNumber, "nvme") {
+					continue
+				}
+				page83 := diskIDs.Page83
+				if page83 == "" {
+					t.Errorf("page83 field of diskNumber=%d should be defined, instead got diskIDs=%v", diskNumber, diskIDs)
+				}
+			}
+		}
+
+		listDiskLocationsRequest := &v2alpha1.ListDiskLocationsRequest{}
+		listDiskLocationsResponse, err := client.ListDiskLocations(context.TODO(), listDiskLocationsRequest)
+		require.Nil(t, err)
+		t.Logf("listDiskLocationsResponse=%v", listDiskLocationsResponse)
+		if len(listDiskLocationsResponse.DiskLocations) == 0 {
+			t.Errorf("Expected to get at least one diskLocation, instead got DiskLocations=%+v", listDiskLocationsResponse.DiskLocations)
+		}
+	})
+
+	t.Run("ListDisks", func(t *testing.T) {
+		client, err := diskv2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		listDisksResponse, err := client.ListDisks(context.TODO(), &v2alpha1.ListDisksRequest{})
+		require.Nil(t, err)
+		t.Logf("listDisksResponse=%v", listDisksResponse)
+
+		// the VM disk is online, initialized and has the OS
+		osDisks := 0
+		for _, disk := range listDisksResponse.Disks {
+			if disk.IsOsDisk {
+				osDisks++
+				assert.False(t, disk.IsOffline, "OS disk %d is offline", disk.DiskNumber)
+				assert.NotEqual(t, "RAW", disk.PartitionStyle, "OS disk %d isn't initialized", disk.DiskNumber)
+			}
+			assert.Greater(t, disk.SizeBytes, uint64(0), "disk %d has no size", disk.DiskNumber)
+		}
+		if osDisks == 0 {
+			t.Errorf("Expected to get the OS disk, instead got Disks=%+v", listDisksResponse.Disks)
+		}
+	})
+
+	t.Run("SanPolicy", func(t *testing.T) {
+		client, err := diskv2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		getResponse, err := client.GetSanPolicy(context.TODO(), &v2alpha1.GetSanPolicyRequest{})
+		require.Nil(t, err)
+		original := getResponse.SanPolicy
+		defer func() {
+			_, err := client.SetSanPolicy(context.TODO(), &v2alpha1.SetSanPolicyRequest{SanPolicy: original})
+			assert.Nil(t, err)
+		}()
+
+		_, err = client.SetSanPolicy(context.TODO(), &v2alpha1.SetSanPolicyRequest{SanPolicy: v2alpha1.SanPolicy_ONLINE_ALL})
+		require.Nil(t, err)
+		getResponse, err = client.GetSanPolicy(context.TODO(), &v2alpha1.GetSanPolicyRequest{})
+		require.Nil(t, err)
+		assert.Equal(t, v2alpha1.SanPolicy_ONLINE_ALL, getResponse.SanPolicy)
+	})
+
+	t.Run("DiskReadOnly", func(t *testing.T) {
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		client, err := diskv2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		_, err = client.SetDiskReadOnly(context.TODO(), &v2alpha1.SetDiskReadOnlyRequest{DiskNumber: vhd.DiskNumber, ReadOnly: true})
+		require.Nil(t, err)
+		getResponse, err := client.GetDiskReadOnly(context.TODO(), &v2alpha1.GetDiskReadOnlyRequest{DiskNumber: vhd.DiskNumber})
+		require.Nil(t, err)
+		assert.True(t, getResponse.ReadOnly)
+
+		_, err = client.SetDiskReadOnly(context.TODO(), &v2alpha1.SetDiskReadOnlyRequest{DiskNumber: vhd.DiskNumber})
+		require.Nil(t, err)
+		getResponse, err = client.GetDiskReadOnly(context.TODO(), &v2alpha1.GetDiskReadOnlyRequest{DiskNumber: vhd.DiskNumber})
+		require.Nil(t, err)
+		assert.False(t, getResponse.ReadOnly)
+	})
+
+	t.Run("GetDiskReliabilityCounters", func(t *testing.T) {
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		client, err := diskv2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		// virtual disks usually don't report reliability counters, the counters
+		// they report are valid
+		response, err := client.GetDiskReliabilityCounters(context.TODO(), &v2alpha1.GetDiskReliabilityCountersRequest{DiskNumber: vhd.DiskNumber})
+		if err != nil {
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err), "unexpected error %v", err)
+			return
+		}
+		assert.GreaterOrEqual(t, response.ReadErrorsTotal, int64(-1))
+		assert.GreaterOrEqual(t, response.PowerOnHours, int64(-1))
+	})
+
+	t.Run("GetDiskNumberBySerialNumber", func(t *testing.T) {
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		client, err := diskv2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		listResponse, err := client.ListDisks(context.TODO(), &v2alpha1.ListDisksRequest{})
+		require.Nil(t, err)
+		serialNumbers := map[string][]uint32{}
+		for _, d := range listResponse.Disks {
+			serialNumber := strings.ToLower(strings.TrimSpace(d.SerialNumber))
+			serialNumbers[serialNumber] = append(serialNumbers[serialNumber], d.DiskNumber)
+		}
+		for serialNumber, diskNumbers := range serialNumbers {
+			if serialNumber == "" || len(diskNumbers) > 1 {
+				continue
+			}
+			response, err := client.GetDiskNumberBySerialNumber(context.TODO(), &v2alpha1.GetDiskNumberBySerialNumberRequest{SerialNumber: serialNumber})
+			require.Nil(t, err)
+			assert.Equal(t, diskNumbers[0], response.DiskNumber)
+		}
+
+		_, err = client.GetDiskNumberBySerialNumber(context.TODO(), &v2alpha1.GetDiskNumberBySerialNumberRequest{SerialNumber: "csi-proxy-missing-serial"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("GetDiskNumberByPage83ID", func(t *testing.T) {
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		client, err := diskv2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		listResponse, err := client.ListDiskPage83IDs(context.TODO(), &v2alpha1.ListDiskPage83IDsRequest{})
+		require.Nil(t, err)
+		disks := map[string][]uint32{}
+		for diskNumber, ids := range listResponse.DiskPage83IDs {
+			for _, id := range ids.Ids {
+				if id.Association == "Device" && (id.Type == "NAA" || id.Type == "EUI64") {
+					disks[id.Value] = append(disks[id.Value], diskNumber)
+				}
+			}
+		}
+		for value, diskNumbers := range disks {
+			if len(diskNumbers) > 1 {
+				continue
+			}
+			response, err := client.GetDiskNumberByPage83ID(context.TODO(), &v2alpha1.GetDiskNumberByPage83IDRequest{Page83Id: value})
+			require.Nil(t, err)
+			assert.Equal(t, diskNumbers[0], response.DiskNumber)
+		}
+	})
+
+	t.Run("DiskIdentity", func(t *testing.T) {
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		client, err := diskv2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		response, err := client.GetDiskIdentity(context.TODO(), &v2alpha1.GetDiskIdentityRequest{DiskNumber: vhd.DiskNumber})
+		require.Nil(t, err)
+		resolveResponse, err := client.ResolveDiskIdentity(context.TODO(), &v2alpha1.ResolveDiskIdentityRequest{Identity: response.Identity})
+		require.Nil(t, err)
+		assert.Equal(t, vhd.DiskNumber, resolveResponse.DiskNumber)
+	})
+
+	t.Run("ListDiskLocations paging", func(t *testing.T) {
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		client, err := diskv2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		listDiskLocationsResponse, err := client.ListDiskLocations(context.TODO(), &v2alpha1.ListDiskLocationsRequest{})
+		require.Nil(t, err)
+
+		// page through the disks one at a time, every disk must be listed exactly once
+		pagedDisks := map[uint32]bool{}
+		request := &v2alpha1.ListDiskLocationsRequest{MaxResults: 1}
+		for {
+			response, err := client.ListDiskLocations(context.TODO(), request)
+			require.Nil(t, err)
+			if len(response.DiskLocations) > 1 {
+				t.Fatalf("Expected at most one disk per page, instead got DiskLocations=%+v", response.DiskLocations)
+			}
+			for diskNumber := range response.DiskLocations {
+				if pagedDisks[diskNumber] {
+					t.Fatalf("Disk %d was listed in more than one page", diskNumber)
+				}
+				pagedDisks[diskNumber] = true
+			}
+			if response.NextPageToken == "" {
+				break
+			}
+			request.PageToken = response.NextPageToken
+		}
+		if len(pagedDisks) != len(listDiskLocationsResponse.DiskLocations) {
+			t.Errorf("Expected %d paged disks, instead got %d", len(listDiskLocationsResponse.DiskLocations), len(pagedDisks))
+		}
+	})
+
+	t.Run("Get/SetDiskState", func(t *testing.T) {
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+
+		defer client.Close()
+
+		// initialize disk
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		// disk stats
+		diskStatsRequest := &v2alpha1.GetDiskStatsRequest{
+			DiskNumber: vhd.DiskNumber,
+		}
+		diskStatsResponse, err := client.GetDiskStats(context.TODO(), diskStatsRequest)
+		require.NoError(t, err)
+		if !sizeIsAround(t, int64(diskStatsResponse.TotalBytes), vhd.InitialSize) {
+			t.Fatalf("DiskStats doesn't have the expected size, wanted (close to)=%d got=%d", vhd.InitialSize, diskStatsResponse.TotalBytes)
+		}
+		// VHDX disks have 512 bytes logical sectors and 4096 bytes physical sectors by default
+		assert.Greater(t, diskStatsResponse.LogicalSectorSize, uint32(0))
+		assert.GreaterOrEqual(t, diskStatsResponse.PhysicalSectorSize, diskStatsResponse.LogicalSectorSize)
+
+		// Rescan
+		_, err = client.Rescan(context.TODO(), &v2alpha1.RescanRequest{})
+		require.NoError(t, err)
+
+		// change disk state
+		out, err := runPowershellCmd(t, fmt.Sprintf("Get-Disk -Number %d | Set-Disk -IsOffline $true", vhd.DiskNumber))
+		require.NoError(t, err, "failed setting disk offline, out=%v", out)
+
+		getReq := &v2alpha1.GetDiskStateRequest{DiskNumber: vhd.DiskNumber}
+		getResp, err := client.GetDiskState(context.TODO(), getReq)
+
+		if assert.NoError(t, err) {
+			assert.False(t, getResp.IsOnline, "Expected disk to be offline")
+		}
+
+		setReq := &v2alpha1.SetDiskStateRequest{DiskNumber: vhd.DiskNumber, IsOnline: true}
+		_, err = client.SetDiskState(context.TODO(), setReq)
+		assert.NoError(t, err)
+
+		out, err = runPowershellCmd(t, fmt.Sprintf("Get-Disk -Number %d | Select-Object -ExpandProperty IsOffline", vhd.DiskNumber))
+		assert.NoError(t, err)
+
+		result, err := strconv.ParseBool(strings.TrimSpace(out))
+		assert.NoError(t, err)
+		assert.False(t, result, "Expected disk to be online")
+
+		getReq = &v2alpha1.GetDiskStateRequest{DiskNumber: vhd.DiskNumber}
+		getResp, err = client.GetDiskState(context.TODO(), getReq)
+
+		if assert.NoError(t, err) {
+			assert.True(t, getResp.IsOnline, "Expected disk is online")
+		}
+
+		setReq = &v2alpha1.SetDiskStateRequest{DiskNumber: vhd.DiskNumber, IsOnline: false}
+		_, err = client.SetDiskState(context.TODO(), setReq)
+		assert.NoError(t, err)
+
+		out, err = runPowershellCmd(t, fmt.Sprintf("Get-Disk -Number %d | Select-Object -ExpandProperty IsOffline", vhd.DiskNumber))
+		assert.NoError(t, err)
+
+		result, err = strconv.ParseBool(strings.TrimSpace(out))
+		assert.NoError(t, err)
+		assert.True(t, result, "Expected disk to be offline")
+	})
+
+	t.Run("PartitionDisk", func(t *testing.T) {
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		var err error
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+		defer client.Close()
+
+		// initialize disk but don't partition it using `diskInit`
+		s1 := rand.NewSource(time.Now().UTC().UnixNano())
+		r1 := rand.New(s1)
+
+		testPluginPath := fmt.Sprintf("C:\\var\\lib\\kubelet\\plugins\\testplugin-%d.csi.io\\", r1.Intn(100))
+		mountPath := fmt.Sprintf("%smount-%d", testPluginPath, r1.Intn(100))
+		vhdxPath := fmt.Sprintf("%sdisk-%d.vhdx", testPluginPath, r1.Intn(100))
+
+		var cmd, out string
+		const initialSize = 1 * 1024 * 1024 * 1024
+		const partitionStyle = "GPT"
+
+		cmd = fmt.Sprintf("mkdir %s", mountPath)
+		if out, err = runPowershellCmd(t, cmd); err != nil {
+			t.Fatalf("Error: %v. Command: %q. Out: %s", err, cmd, out)
+		}
+		cmd = fmt.Sprintf("New-VHD -Path %s -SizeBytes %d", vhdxPath, initialSize)
+		if out, err = runPowershellCmd(t, cmd); err != nil {
+			t.Fatalf("Error: %v. Command: %q. Out: %s.", err, cmd, out)
+		}
+		cmd = fmt.Sprintf("Mount-VHD -Path %s", vhdxPath)
+		if out, err = runPowershellCmd(t, cmd); err != nil {
+			t.Fatalf("Error: %v. Command: %q. Out: %s", err, cmd, out)
+		}
+
+		var diskNum uint64
+		var diskNumUnparsed string
+		cmd = fmt.Sprintf("(Get-VHD -Path %s).DiskNumber", vhdxPath)
+		if diskNumUnparsed, err = runPowershellCmd(t, cmd); err != nil {
+			t.Fatalf("Error: %v. Command: %s", err, cmd)
+		}
+		if diskNum, err = strconv.ParseUint(strings.TrimRight(diskNumUnparsed, "\r\n"), 10, 32); err != nil {
+			t.Fatalf("Error: %v", err)
+		}
+
+		// make disk partition request
+		diskPartitionRequest := &v2alpha1.PartitionDiskRequest{
+			DiskNumber:     uint32(diskNum),
+			PartitionStyle: v2alpha1.PartitionStyle_MBR,
+			SizeBytes:      initialSize / 2,
+		}
+		_, err = client.PartitionDisk(context.TODO(), diskPartitionRequest)
+		require.NoError(t, err)
+
+		cmd = fmt.Sprintf("(Get-Disk -Number %d).PartitionStyle", diskNum)
+		out, err = runPowershellCmd(t, cmd)
+		require.NoError(t, err)
+		assert.Equal(t, "MBR", strings.TrimSpace(out))
+
+		// wipe the partitions of the disk
+		deletePartitionRequest := &v2alpha1.DeletePartitionRequest{DiskNumber: uint32(diskNum)}
+		_, err = client.DeletePartition(context.TODO(), deletePartitionRequest)
+		require.NoError(t, err)
+
+		cmd = fmt.Sprintf("@(Get-Partition | Where DiskNumber -eq %d).Count", diskNum)
+		out, err = runPowershellCmd(t, cmd)
+		require.NoError(t, err)
+		assert.Equal(t, "0", strings.TrimSpace(out))
+
+		// the empty MBR disk can be converted to GPT
+		convertRequest := &v2alpha1.ConvertDiskToGPTRequest{DiskNumber: uint32(diskNum)}
+		_, err = client.ConvertDiskToGPT(context.TODO(), convertRequest)
+		require.NoError(t, err)
+
+		cmd = fmt.Sprintf("(Get-Disk -Number %d).PartitionStyle", diskNum)
+		out, err = runPowershellCmd(t, cmd)
+		require.NoError(t, err)
+		assert.Equal(t, "GPT", strings.TrimSpace(out))
+
+		// and clear it back to the uninitialized state
+		wipeDiskRequest := &v2alpha1.WipeDiskRequest{DiskNumber: uint32(diskNum), RemoveData: true}
+		_, err = client.WipeDisk(context.TODO(), wipeDiskRequest)
+		require.NoError(t, err)
+
+		cmd = fmt.Sprintf("(Get-Disk -Number %d).PartitionStyle", diskNum)
+		out, err = runPowershellCmd(t, cmd)
+		require.NoError(t, err)
+		assert.Equal(t, "RAW", strings.TrimSpace(out))
+
+		// partition it again with a wrong GPT type and fix it
+		const reservedGptType = "{e3c9e316-0b5c-4db8-817d-f92df00215ae}"
+		const basicDataGptType = "{ebd0a0a2-b9e5-4433-87c0-68b6b72699c7}"
+		diskPartitionRequest = &v2alpha1.PartitionDiskRequest{
+			DiskNumber: uint32(diskNum),
+			SizeBytes:  initialSize / 2,
+			GptType:    reservedGptType,
+		}
+		_, err = client.PartitionDisk(context.TODO(), diskPartitionRequest)
+		require.NoError(t, err)
+
+		cmd = fmt.Sprintf("(Get-Partition -DiskNumber %d | Where GptType -eq '%s').PartitionNumber", diskNum, reservedGptType)
+		out, err = runPowershellCmd(t, cmd)
+		require.NoError(t, err)
+		partitionNumber, err := strconv.ParseUint(strings.TrimSpace(out), 10, 32)
+		require.NoError(t, err)
+
+		setPartitionTypeRequest := &v2alpha1.SetPartitionTypeRequest{
+			DiskNumber:      uint32(diskNum),
+			PartitionNumber: uint32(partitionNumber),
+			GptType:         basicDataGptType,
+		}
+		_, err = client.SetPartitionType(context.TODO(), setPartitionTypeRequest)
+		require.NoError(t, err)
+
+		cmd = fmt.Sprintf("(Get-Partition -DiskNumber %d -PartitionNumber %d).GptType", diskNum, partitionNumber)
+		out, err = runPowershellCmd(t, cmd)
+		require.NoError(t, err)
+		assert.Equal(t, basicDataGptType, strings.TrimSpace(out))
+	})
+
+	t.Run("WaitForDiskSizeChange", func(t *testing.T) {
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+		defer client.Close()
+
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		// expand the backing disk like a cloud provider would
+		newSize := vhd.InitialSize * 2
+		cmd := fmt.Sprintf("Resize-VHD -Path %s -SizeBytes %d", vhd.Path, newSize)
+		if out, err := runPowershellCmd(t, cmd); err != nil {
+			t.Fatalf("Error: %v. Command: %q. Out: %s.", err, cmd, out)
+		}
+
+		response, err := client.WaitForDiskSizeChange(context.TODO(), &v2alpha1.WaitForDiskSizeChangeRequest{
+			DiskNumber:        vhd.DiskNumber,
+			ExpectedSizeBytes: uint64(newSize),
+			TimeoutNanos:      time.Minute.Nanoseconds(),
+		})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, response.TotalBytes, uint64(newSize))
+	})
+
+	t.Run("WaitForDisk", func(t *testing.T) {
+		skipTestOnCondition(t, isRunningOnGhActions())
+
+		client, err := diskv2alpha1client.NewClient()
+		require.NoError(t, err)
+		defer client.Close()
+
+		vhd, vhdCleanup := diskInit(t)
+		defer vhdCleanup()
+
+		locationsResponse, err := client.ListDiskLocations(context.TODO(), &v2alpha1.ListDiskLocationsRequest{})
+		require.NoError(t, err)
+		location, ok := locationsResponse.DiskLocations[vhd.DiskNumber]
+		require.True(t, ok, "no location for disk %d", vhd.DiskNumber)
+
+		response, err := client.WaitForDisk(context.TODO(), &v2alpha1.WaitForDiskRequest{
+			Adapter:      location.Adapter,
+			Bus:          location.Bus,
+			Target:       location.Target,
+			LunId:        location.LUNID,
+			TimeoutNanos: time.Minute.Nanoseconds(),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, vhd.DiskNumber, response.DiskNumber)
+
+		_, err = client.WaitForDisk(context.TODO(), &v2alpha1.WaitForDiskRequest{
+			SerialNumber: "csi-proxy-missing-serial",
+			TimeoutNanos: (5 * time.Second).Nanoseconds(),
+		})
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	})
+}
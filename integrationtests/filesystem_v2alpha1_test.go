@@ -244,4 +244,143 @@ func v2alpha1FilesystemTests(t *testing.T) {
 			t.Fatalf("File outsideFile=%s doesn't exist", outsideFile)
 		}
 	})
+
+	t.Run("Junction", func(t *testing.T) {
+		client, err := v2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		r1 := rand.New(rand.NewSource(time.Now().UnixNano()))
+		rootPath := getKubeletPathForTest(fmt.Sprintf("testplugin-%d.csi.io", r1.Intn(100)), t)
+		defer os.RemoveAll(rootPath)
+		sourcePath := filepath.Join(rootPath, "source")
+		targetPath := filepath.Join(rootPath, "target")
+		err = os.MkdirAll(sourcePath, os.ModeDir)
+		require.Nil(t, err)
+
+		junctionReq := &v2alpha1.CreateJunctionRequest{SourcePath: sourcePath, TargetPath: targetPath}
+		_, err = client.CreateJunction(context.Background(), junctionReq)
+		require.NoError(t, err)
+
+		resolveResp, err := client.ResolveJunction(context.Background(), &v2alpha1.ResolveJunctionRequest{Path: targetPath})
+		require.NoError(t, err)
+		assert.Equal(t, sourcePath, resolveResp.SourcePath)
+
+		isSymlinkResp, err := client.IsSymlink(context.Background(), &v2alpha1.IsSymlinkRequest{Path: targetPath})
+		require.NoError(t, err)
+		assert.True(t, isSymlinkResp.IsSymlink)
+
+		// the source directory isn't a junction
+		_, err = client.ResolveJunction(context.Background(), &v2alpha1.ResolveJunctionRequest{Path: sourcePath})
+		assert.Error(t, err)
+
+		// removing the junction doesn't remove the source directory
+		_, err = client.Rmdir(context.Background(), &v2alpha1.RmdirRequest{Path: targetPath})
+		require.NoError(t, err)
+		exists, err := pathExists(sourcePath)
+		require.Nil(t, err)
+		assert.True(t, exists, "The source of the junction should exist")
+	})
+
+	t.Run("IsMountPoint", func(t *testing.T) {
+		client, err := v2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		r1 := rand.New(rand.NewSource(time.Now().UnixNano()))
+		rootPath := getKubeletPathForTest(fmt.Sprintf("testplugin-%d.csi.io", r1.Intn(100)), t)
+		defer os.RemoveAll(rootPath)
+		dirPath := filepath.Join(rootPath, "dir")
+		err = os.MkdirAll(dirPath, os.ModeDir)
+		require.Nil(t, err)
+		// the root of the system volume stands for a volume
+		volumeLinkPath := filepath.Join(rootPath, "volume")
+		err = os.Symlink(os.Getenv("SystemDrive")+`\`, volumeLinkPath)
+		require.Nil(t, err)
+		dirLinkPath := filepath.Join(rootPath, "dir-link")
+		_, err = client.CreateSymlink(context.Background(), &v2alpha1.CreateSymlinkRequest{SourcePath: dirPath, TargetPath: dirLinkPath})
+		require.NoError(t, err)
+		// a junction to a link to a volume
+		junctionPath := filepath.Join(rootPath, "junction")
+		_, err = client.CreateJunction(context.Background(), &v2alpha1.CreateJunctionRequest{SourcePath: volumeLinkPath, TargetPath: junctionPath})
+		require.NoError(t, err)
+
+		expected := map[string]v2alpha1.MountPointType{
+			dirPath:        v2alpha1.MountPointType_DIRECTORY,
+			volumeLinkPath: v2alpha1.MountPointType_SYMLINK_TO_VOLUME,
+			dirLinkPath:    v2alpha1.MountPointType_SYMLINK,
+			junctionPath:   v2alpha1.MountPointType_SYMLINK_TO_VOLUME,
+		}
+		for path, mountPointType := range expected {
+			response, err := client.IsMountPoint(context.Background(), &v2alpha1.IsMountPointRequest{Path: path})
+			require.NoError(t, err)
+			assert.Equal(t, mountPointType, response.Type, "type of %s", path)
+			assert.Equal(t, mountPointType == v2alpha1.MountPointType_SYMLINK_TO_VOLUME, response.IsMountPoint, "mount point %s", path)
+		}
+
+		_, err = client.IsMountPoint(context.Background(), &v2alpha1.IsMountPointRequest{Path: filepath.Join(rootPath, "missing")})
+		assert.Error(t, err)
+	})
+
+	t.Run("CheckAccess", func(t *testing.T) {
+		client, err := v2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		r1 := rand.New(rand.NewSource(time.Now().UnixNano()))
+		path := getKubeletPathForTest(fmt.Sprintf("testplugin-%d.csi.io\\access%d", r1.Intn(100), r1.Intn(100)), t)
+		_, err = client.Mkdir(context.Background(), &v2alpha1.MkdirRequest{Path: path})
+		require.NoError(t, err)
+		defer os.RemoveAll(path)
+
+		// LocalSystem has full control of the kubelet directories
+		checkAccessReq := &v2alpha1.CheckAccessRequest{
+			Path:          path,
+			Sid:           "S-1-5-18",
+			DesiredAccess: v2alpha1.AccessRight_MODIFY,
+		}
+		checkAccessResp, err := client.CheckAccess(context.Background(), checkAccessReq)
+		require.NoError(t, err)
+		assert.True(t, checkAccessResp.Allowed, "expected LocalSystem to have the Modify access, granted 0x%X", checkAccessResp.GrantedAccess)
+
+		// an invalid SID fails the check
+		checkAccessReq.Sid = "not-a-sid"
+		_, err = client.CheckAccess(context.Background(), checkAccessReq)
+		assert.Error(t, err)
+	})
+
+	t.Run("ACL", func(t *testing.T) {
+		client, err := v2alpha1client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		r1 := rand.New(rand.NewSource(time.Now().UnixNano()))
+		path := getKubeletPathForTest(fmt.Sprintf("testplugin-%d.csi.io\\acl%d", r1.Intn(100), r1.Intn(100)), t)
+		_, err = client.Mkdir(context.Background(), &v2alpha1.MkdirRequest{Path: path})
+		require.NoError(t, err)
+		defer os.RemoveAll(path)
+
+		// only LocalSystem and the Administrators have access
+		setACLReq := &v2alpha1.SetACLRequest{Path: path, Sddl: "D:PAI(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)"}
+		_, err = client.SetACL(context.Background(), setACLReq)
+		require.NoError(t, err)
+		checkAccessReq := &v2alpha1.CheckAccessRequest{Path: path, Sid: "S-1-5-32-545", DesiredAccess: v2alpha1.AccessRight_READ}
+		checkAccessResp, err := client.CheckAccess(context.Background(), checkAccessReq)
+		require.NoError(t, err)
+		assert.False(t, checkAccessResp.Allowed)
+
+		// grant Modify to BUILTIN\Users
+		setACLReq = &v2alpha1.SetACLRequest{Path: path, Grants: []*v2alpha1.AccessGrant{{Sid: "S-1-5-32-545", Access: v2alpha1.AccessRight_MODIFY}}}
+		_, err = client.SetACL(context.Background(), setACLReq)
+		require.NoError(t, err)
+		checkAccessReq.DesiredAccess = v2alpha1.AccessRight_MODIFY
+		checkAccessResp, err = client.CheckAccess(context.Background(), checkAccessReq)
+		require.NoError(t, err)
+		assert.True(t, checkAccessResp.Allowed, "expected BUILTIN\\Users to have the Modify access, granted 0x%X", checkAccessResp.GrantedAccess)
+
+		getACLResp, err := client.GetACL(context.Background(), &v2alpha1.GetACLRequest{Path: path})
+		require.NoError(t, err)
+		assert.Contains(t, getACLResp.Sddl, ";;;BU)")
+		assert.Contains(t, getACLResp.Sddl, "D:P")
+	})
 }
@@ -0,0 +1,68 @@
+package integrationtests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/storagespaces/v1alpha1"
+	storagespacesclient "github.com/kubernetes-csi/csi-proxy/client/groups/storagespaces/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageSpacesAPIGroup(t *testing.T) {
+	skipTestOnCondition(t, isRunningOnGhActions())
+
+	client, err := storagespacesclient.NewClient()
+	require.NoError(t, err)
+	defer client.Close()
+
+	listResponse, err := client.ListPoolablePhysicalDisks(context.TODO(), &v1alpha1.ListPoolablePhysicalDisksRequest{})
+	require.NoError(t, err)
+	if len(listResponse.PhysicalDisks) == 0 {
+		t.Skip("no physical disk can be pooled")
+	}
+	diskNumber := listResponse.PhysicalDisks[0].DiskNumber
+
+	_, testId := getTestPluginPath()
+	poolName := fmt.Sprintf("testpool-%d", testId)
+	createPoolRequest := &v1alpha1.CreateStoragePoolRequest{PoolName: poolName, DiskNumbers: []uint32{diskNumber}}
+	createPoolResponse, err := client.CreateStoragePool(context.TODO(), createPoolRequest)
+	require.NoError(t, err)
+	defer client.DeleteStoragePool(context.TODO(), &v1alpha1.DeleteStoragePoolRequest{PoolName: poolName})
+	require.Equal(t, []uint32{diskNumber}, createPoolResponse.StoragePool.DiskNumbers)
+
+	// a retry succeeds
+	_, err = client.CreateStoragePool(context.TODO(), createPoolRequest)
+	require.NoError(t, err)
+
+	createRequest := &v1alpha1.CreateVirtualDiskRequest{
+		PoolName:         poolName,
+		Name:             "disk",
+		SizeBytes:        1024 * 1024 * 1024,
+		Resiliency:       v1alpha1.Resiliency_SIMPLE,
+		ThinProvisioning: true,
+	}
+	createResponse, err := client.CreateVirtualDisk(context.TODO(), createRequest)
+	require.NoError(t, err)
+	defer client.DeleteVirtualDisk(context.TODO(), &v1alpha1.DeleteVirtualDiskRequest{PoolName: poolName, Name: "disk"})
+	disk := createResponse.VirtualDisk
+	require.True(t, disk.Attached)
+	require.GreaterOrEqual(t, disk.SizeBytes, createRequest.SizeBytes)
+
+	virtualDisksResponse, err := client.ListVirtualDisks(context.TODO(), &v1alpha1.ListVirtualDisksRequest{PoolName: poolName})
+	require.NoError(t, err)
+	require.Len(t, virtualDisksResponse.VirtualDisks, 1)
+	require.Equal(t, disk.UniqueId, virtualDisksResponse.VirtualDisks[0].UniqueId)
+
+	_, err = client.DeleteVirtualDisk(context.TODO(), &v1alpha1.DeleteVirtualDiskRequest{PoolName: poolName, Name: "disk"})
+	require.NoError(t, err)
+	_, err = client.DeleteStoragePool(context.TODO(), &v1alpha1.DeleteStoragePoolRequest{PoolName: poolName})
+	require.NoError(t, err)
+
+	poolsResponse, err := client.ListStoragePools(context.TODO(), &v1alpha1.ListStoragePoolsRequest{})
+	require.NoError(t, err)
+	for _, pool := range poolsResponse.StoragePools {
+		require.NotEqual(t, poolName, pool.Name)
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"testing"
 
 	"github.com/kubernetes-csi/csi-proxy/client/api/system/v1alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/api/system/v1alpha2"
 	v1alpha1client "github.com/kubernetes-csi/csi-proxy/client/groups/system/v1alpha1"
+	v1alpha2client "github.com/kubernetes-csi/csi-proxy/client/groups/system/v1alpha2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -116,3 +118,59 @@ func assertServiceStatus(t *testing.T, serviceName string, status string) {
 
 	assert.Equal(t, strings.TrimSpace(out), status)
 }
+
+func TestListSlowestOperations(t *testing.T) {
+	t.Run("ListSlowestOperations", func(t *testing.T) {
+		client, err := v1alpha2client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		// make sure that there's at least one recent operation
+		_, err = client.GetBIOSSerialNumber(context.TODO(), &v1alpha2.GetBIOSSerialNumberRequest{})
+		require.Nil(t, err)
+
+		request := &v1alpha2.ListSlowestOperationsRequest{MaxResults: 1}
+		response, err := client.ListSlowestOperations(context.TODO(), request)
+		require.Nil(t, err)
+		require.Len(t, response.Operations, 1)
+
+		op := response.Operations[0]
+		t.Logf("The slowest recent operation is %+v", op)
+		assert.NotEmpty(t, op.Method)
+		assert.True(t, op.StartedAgoNanos >= op.DurationNanos)
+	})
+}
+
+func TestExportState(t *testing.T) {
+	t.Run("ExportState", func(t *testing.T) {
+		client, err := v1alpha2client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		response, err := client.ExportState(context.TODO(), &v1alpha2.ExportStateRequest{})
+		require.Nil(t, err)
+
+		var state struct {
+			Version  int                        `json:"version"`
+			Sections map[string]json.RawMessage `json:"sections"`
+		}
+		require.Nil(t, json.Unmarshal(response.State, &state))
+		assert.Equal(t, 1, state.Version)
+		assert.Contains(t, state.Sections, "config")
+		assert.Contains(t, state.Sections, "mounts")
+	})
+}
+
+func TestGetCapabilities(t *testing.T) {
+	t.Run("GetCapabilities", func(t *testing.T) {
+		client, err := v1alpha2client.NewClient()
+		require.Nil(t, err)
+		defer client.Close()
+
+		response, err := client.GetCapabilities(context.TODO(), &v1alpha2.GetCapabilitiesRequest{})
+		require.Nil(t, err)
+		t.Logf("The capabilities of the host are %+v", response)
+		assert.NotEmpty(t, response.InstallationType)
+		assert.Len(t, append(response.AvailableModules, response.MissingModules...), 6)
+	})
+}
@@ -0,0 +1,62 @@
+package integrationtests
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/vhd/v1alpha1"
+	vhdclient "github.com/kubernetes-csi/csi-proxy/client/groups/vhd/v1alpha1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestVhdAPIGroup(t *testing.T) {
+	skipTestOnCondition(t, isRunningOnGhActions())
+
+	client, err := vhdclient.NewClient()
+	require.NoError(t, err)
+	defer client.Close()
+
+	testPluginPath, testId := getTestPluginPath()
+	require.NoError(t, os.MkdirAll(testPluginPath, 0755))
+	defer os.RemoveAll(testPluginPath)
+	path := fmt.Sprintf("%sdisk-%d.vhdx", testPluginPath, testId)
+
+	_, err = client.GetVirtualDisk(context.TODO(), &v1alpha1.GetVirtualDiskRequest{Path: path})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	createRequest := &v1alpha1.CreateVirtualDiskRequest{Path: path, SizeBytes: 10 * 1024 * 1024}
+	_, err = client.CreateVirtualDisk(context.TODO(), createRequest)
+	require.NoError(t, err)
+	// a retry succeeds
+	_, err = client.CreateVirtualDisk(context.TODO(), createRequest)
+	require.NoError(t, err)
+
+	attachResponse, err := client.AttachVirtualDisk(context.TODO(), &v1alpha1.AttachVirtualDiskRequest{Path: path})
+	require.NoError(t, err)
+	defer client.DetachVirtualDisk(context.TODO(), &v1alpha1.DetachVirtualDiskRequest{Path: path})
+
+	_, err = client.ResizeVirtualDisk(context.TODO(), &v1alpha1.ResizeVirtualDiskRequest{Path: path, SizeBytes: 20 * 1024 * 1024})
+	require.NoError(t, err)
+
+	getResponse, err := client.GetVirtualDisk(context.TODO(), &v1alpha1.GetVirtualDiskRequest{Path: path})
+	require.NoError(t, err)
+	disk := getResponse.VirtualDisk
+	require.Equal(t, v1alpha1.VirtualDiskFormat_VHDX, disk.Format)
+	require.Equal(t, uint64(20*1024*1024), disk.SizeBytes)
+	require.True(t, disk.Attached)
+	require.Equal(t, attachResponse.DiskNumber, disk.DiskNumber)
+
+	_, err = client.DetachVirtualDisk(context.TODO(), &v1alpha1.DetachVirtualDiskRequest{Path: path})
+	require.NoError(t, err)
+	// detaching a virtual disk that isn't attached succeeds
+	_, err = client.DetachVirtualDisk(context.TODO(), &v1alpha1.DetachVirtualDiskRequest{Path: path})
+	require.NoError(t, err)
+
+	getResponse, err = client.GetVirtualDisk(context.TODO(), &v1alpha1.GetVirtualDiskRequest{Path: path})
+	require.NoError(t, err)
+	require.False(t, getResponse.VirtualDisk.Attached)
+}
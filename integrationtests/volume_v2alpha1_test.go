@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	diskv1 "github.com/kubernetes-csi/csi-proxy/client/api/disk/v1"
 	"github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha1"
@@ -34,6 +35,11 @@ func volumeInit(volumeClient *v2alpha1client.Client, t *testing.T) (*VirtualHard
 	}
 	volumeID := listResponse.VolumeIds[0]
 	t.Logf("VolumeId %v", volumeID)
+	if len(listResponse.Volumes) != 1 || listResponse.Volumes[0].VolumeId != volumeID ||
+		listResponse.Volumes[0].DiskNumber != vhd.DiskNumber || listResponse.Volumes[0].SizeBytes <= 0 ||
+		listResponse.Volumes[0].FileSystem != "" {
+		t.Fatalf("Unexpected volumes of the unformatted disk: %v", listResponse.Volumes)
+	}
 
 	isVolumeFormattedRequest := &v2alpha1.IsVolumeFormattedRequest{
 		VolumeId: volumeID,
@@ -45,15 +51,28 @@ func volumeInit(volumeClient *v2alpha1client.Client, t *testing.T) (*VirtualHard
 	if isVolumeFormattedResponse.Formatted {
 		t.Fatal("Volume formatted. Unexpected !!")
 	}
+	if isVolumeFormattedResponse.Unrecognized {
+		t.Fatal("The new volume has data, expected it to be raw")
+	}
 
 	formatVolumeRequest := &v2alpha1.FormatVolumeRequest{
 		VolumeId: volumeID,
+		Label:    "csi-proxy-test",
 	}
 	_, err = volumeClient.FormatVolume(context.TODO(), formatVolumeRequest)
 	if err != nil {
 		t.Fatalf("Volume format failed. Error: %v", err)
 	}
 
+	cmd := fmt.Sprintf("(Get-Volume -UniqueId \"%s\").FileSystemLabel", volumeID)
+	label, err := runPowershellCmd(t, cmd)
+	if err != nil {
+		t.Fatalf("Error: %v. Command: %s. Out: %s", err, cmd, label)
+	}
+	if strings.TrimSpace(label) != formatVolumeRequest.Label {
+		t.Fatalf("Expected volume label %q, got %q", formatVolumeRequest.Label, label)
+	}
+
 	isVolumeFormattedResponse, err = volumeClient.IsVolumeFormatted(context.TODO(), isVolumeFormattedRequest)
 	if err != nil {
 		t.Fatalf("Is volume formatted request error: %v", err)
@@ -61,6 +80,30 @@ func volumeInit(volumeClient *v2alpha1client.Client, t *testing.T) (*VirtualHard
 	if !isVolumeFormattedResponse.Formatted {
 		t.Fatal("Volume should be formatted. Unexpected !!")
 	}
+	if isVolumeFormattedResponse.FileSystemType != "NTFS" {
+		t.Fatalf("Expected the volume to be formatted with NTFS, got %q", isVolumeFormattedResponse.FileSystemType)
+	}
+
+	healthResponse, err := volumeClient.GetVolumeHealth(context.TODO(), &v2alpha1.GetVolumeHealthRequest{VolumeId: volumeID})
+	if err != nil {
+		t.Fatalf("GetVolumeHealth of volume %s failed. Error: %v", volumeID, err)
+	}
+	if healthResponse.Abnormal || healthResponse.HealthStatus != "Healthy" {
+		t.Fatalf("Expected the new volume %s to be healthy, got %+v", volumeID, healthResponse)
+	}
+
+	serialNumberResponse, err := volumeClient.GetVolumeSerialNumber(context.TODO(), &v2alpha1.GetVolumeSerialNumberRequest{VolumeId: volumeID})
+	if err != nil {
+		t.Fatalf("GetVolumeSerialNumber of volume %s failed. Error: %v", volumeID, err)
+	}
+	cmd = fmt.Sprintf("'{0:X8}' -f (Get-CimInstance -ClassName Win32_Volume | Where-Object DeviceID -eq '%s').SerialNumber", volumeID)
+	serialNumber, err := runPowershellCmd(t, cmd)
+	if err != nil {
+		t.Fatalf("Error: %v. Command: %s. Out: %s", err, cmd, serialNumber)
+	}
+	if strings.ReplaceAll(serialNumberResponse.SerialNumber, "-", "") != strings.TrimSpace(serialNumber) {
+		t.Fatalf("Expected the serial number of volume %s to be %s, got %s", volumeID, serialNumber, serialNumberResponse.SerialNumber)
+	}
 	return vhd, volumeID, vhdCleanup
 }
 
@@ -211,9 +254,124 @@ func v2alpha1MountVolumeTests(diskClient *diskv1client.Client, volumeClient *v2a
 	}
 	// For a volume formatted with 1GB it should be around 1GB, in practice it was 1056947712 bytes or 0.9844GB
 	// let's compare with a range of +- 20MB
-	if !sizeIsAround(t, volumeStatsResponse.TotalBytes, vhd.InitialSize) {
+	if !sizeIsAround(t, int64(volumeStatsResponse.TotalBytes), vhd.InitialSize) {
 		t.Fatalf("volumeStatsResponse.TotalBytes reported is not valid, it is %v", volumeStatsResponse.TotalBytes)
 	}
+	if volumeStatsResponse.FileSystemType != "NTFS" {
+		t.Fatalf("volumeStatsResponse.FileSystemType should be NTFS, it is %q", volumeStatsResponse.FileSystemType)
+	}
+	if volumeStatsResponse.SerialNumber == "" {
+		t.Fatalf("volumeStatsResponse.SerialNumber should be set for a formatted volume")
+	}
+	if volumeStatsResponse.HealthStatus != "Healthy" {
+		t.Fatalf("volumeStatsResponse.HealthStatus should be Healthy, it is %q", volumeStatsResponse.HealthStatus)
+	}
+
+	volumeStatsRequest.SizesOnly = true
+	sizesResponse, err := volumeClient.GetVolumeStats(context.TODO(), volumeStatsRequest)
+	if err != nil {
+		t.Fatalf("VolumeStats request error: %v", err)
+	}
+	if sizesResponse.TotalBytes != volumeStatsResponse.TotalBytes || sizesResponse.HealthStatus != "" {
+		t.Fatalf("VolumeStats with sizes only returned %d bytes and health %q, expected %d bytes without health",
+			sizesResponse.TotalBytes, sizesResponse.HealthStatus, volumeStatsResponse.TotalBytes)
+	}
+
+	listVolumesResponse, err := volumeClient.ListVolumes(context.TODO(), &v2alpha1.ListVolumesRequest{})
+	if err != nil {
+		t.Fatalf("ListVolumes request error: %v", err)
+	}
+	found := false
+	for _, v := range listVolumesResponse.Volumes {
+		if v.VolumeId != volumeID {
+			continue
+		}
+		found = true
+		if v.DiskNumber != vhd.DiskNumber || v.PartitionNumber == 0 {
+			t.Fatalf("ListVolumes returned volume %s on disk %d partition %d, expected disk %d", volumeID, v.DiskNumber, v.PartitionNumber, vhd.DiskNumber)
+		}
+	}
+	if !found {
+		t.Fatalf("ListVolumes didn't return volume %s", volumeID)
+	}
+
+	repairVolumeResponse, err := volumeClient.RepairVolume(context.TODO(), &v2alpha1.RepairVolumeRequest{
+		VolumeId: volumeID,
+		Mode:     v2alpha1.RepairMode_SCAN,
+	})
+	if err != nil {
+		t.Fatalf("RepairVolume request error: %v", err)
+	}
+	if repairVolumeResponse.Result != "NoErrorsFound" {
+		t.Fatalf("A scan of a new volume should not find errors, it reported %q", repairVolumeResponse.Result)
+	}
+
+	isVolumeDirtyResponse, err := volumeClient.IsVolumeDirty(context.TODO(), &v2alpha1.IsVolumeDirtyRequest{VolumeId: volumeID})
+	if err != nil {
+		t.Fatalf("IsVolumeDirty request error: %v", err)
+	}
+	if isVolumeDirtyResponse.Dirty {
+		t.Fatalf("A new volume should not be dirty")
+	}
+
+	_, err = volumeClient.SetVolumeLabel(context.TODO(), &v2alpha1.SetVolumeLabelRequest{
+		VolumeId: volumeID,
+		Label:    "restored",
+	})
+	if err != nil {
+		t.Fatalf("SetVolumeLabel request error: %v", err)
+	}
+	getVolumeLabelResponse, err := volumeClient.GetVolumeLabel(context.TODO(), &v2alpha1.GetVolumeLabelRequest{VolumeId: volumeID})
+	if err != nil {
+		t.Fatalf("GetVolumeLabel request error: %v", err)
+	}
+	if getVolumeLabelResponse.Label != "restored" {
+		t.Fatalf("The label of the volume should be %q, it is %q", "restored", getVolumeLabelResponse.Label)
+	}
+
+	_, err = volumeClient.SyncVolume(context.TODO(), &v2alpha1.SyncVolumeRequest{VolumeId: volumeID})
+	if err != nil {
+		t.Fatalf("SyncVolume request error: %v", err)
+	}
+
+	writeVolumeCachesResponse, err := volumeClient.WriteVolumeCaches(context.TODO(), &v2alpha1.WriteVolumeCachesRequest{VolumeIds: []string{volumeID}})
+	if err != nil {
+		t.Fatalf("WriteVolumeCaches request error: %v", err)
+	}
+	if len(writeVolumeCachesResponse.Errors) != 0 {
+		t.Fatalf("WriteVolumeCaches failed to flush volumes: %v", writeVolumeCachesResponse.Errors)
+	}
+
+	// a VHD may not support TRIM, a defrag works on any NTFS volume
+	_, err = volumeClient.OptimizeVolume(context.TODO(), &v2alpha1.OptimizeVolumeRequest{
+		VolumeId: volumeID,
+		Defrag:   true,
+	})
+	if err != nil {
+		t.Fatalf("OptimizeVolume request error: %v", err)
+	}
+
+	startResponse, err := volumeClient.OptimizeVolumeAsync(context.TODO(), &v2alpha1.OptimizeVolumeAsyncRequest{
+		Request: &v2alpha1.OptimizeVolumeRequest{VolumeId: volumeID, Defrag: true},
+	})
+	if err != nil {
+		t.Fatalf("OptimizeVolumeAsync request error: %v", err)
+	}
+	for deadline := time.Now().Add(5 * time.Minute); ; time.Sleep(time.Second) {
+		operation, err := volumeClient.GetOperation(context.TODO(), &v2alpha1.GetOperationRequest{OperationId: startResponse.OperationId})
+		if err != nil {
+			t.Fatalf("GetOperation request error: %v", err)
+		}
+		if operation.Done {
+			if operation.Error != "" {
+				t.Fatalf("OptimizeVolumeAsync failed: %s", operation.Error)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("OptimizeVolumeAsync didn't complete")
+		}
+	}
 
 	// Resize the disk to twice its size (from 1GB to 2GB)
 	// To resize a volume we need to resize the virtual hard disk first and then the partition
@@ -224,7 +382,7 @@ func v2alpha1MountVolumeTests(diskClient *diskv1client.Client, volumeClient *v2a
 
 	// Resize the volume to 1.5GB
 	oldVolumeSize := volumeStatsResponse.TotalBytes
-	newVolumeSize := int64(float32(oldVolumeSize) * 1.5)
+	newVolumeSize := uint64(float32(oldVolumeSize) * 1.5)
 
 	// This is the max partition size when doing a resize to 2GB
 	//
@@ -251,10 +409,37 @@ func v2alpha1MountVolumeTests(diskClient *diskv1client.Client, volumeClient *v2a
 		t.Fatalf("VolumeStats request after resize error: %v", err)
 	}
 	// resizing from 1GB to approximately 1.5GB
-	if !sizeIsAround(t, volumeStatsResponse.TotalBytes, newVolumeSize) {
+	if !sizeIsAround(t, int64(volumeStatsResponse.TotalBytes), int64(newVolumeSize)) {
 		t.Fatalf("VolumeSize reported should be greater than the old size, it is %v", volumeStatsResponse.TotalBytes)
 	}
 
+	// Shrink the volume back to its original size
+	_, err = volumeClient.ResizeVolume(context.TODO(), &v2alpha1.ResizeVolumeRequest{
+		VolumeId:    volumeID,
+		SizeBytes:   oldVolumeSize,
+		AllowShrink: true,
+	})
+	if err != nil {
+		t.Fatalf("Volume shrink request failed. Error: %v", err)
+	}
+	volumeStatsResponse, err = volumeClient.GetVolumeStats(context.TODO(), volumeStatsRequest)
+	if err != nil {
+		t.Fatalf("VolumeStats request after shrink error: %v", err)
+	}
+	if !sizeIsAround(t, int64(volumeStatsResponse.TotalBytes), int64(oldVolumeSize)) {
+		t.Fatalf("VolumeSize reported should be the old size after shrinking, it is %v", volumeStatsResponse.TotalBytes)
+	}
+
+	// Shrinking below the space used by the data of the volume fails
+	_, err = volumeClient.ResizeVolume(context.TODO(), &v2alpha1.ResizeVolumeRequest{
+		VolumeId:    volumeID,
+		SizeBytes:   1024 * 1024,
+		AllowShrink: true,
+	})
+	if err == nil {
+		t.Fatalf("Expected shrinking the volume below its minimum size to fail")
+	}
+
 	volumeDiskNumberRequest := &v2alpha1.GetDiskNumberFromVolumeIDRequest{
 		VolumeId: volumeID,
 	}
@@ -287,6 +472,23 @@ func v2alpha1MountVolumeTests(diskClient *diskv1client.Client, volumeClient *v2a
 		t.Fatalf("Volume id %s mount to path %s failed. Error: %v", volumeID, vhd.Mount, err)
 	}
 
+	// the mount point is an access path of the volume
+	accessPathsResponse, err := volumeClient.GetVolumeAccessPaths(context.TODO(), &v2alpha1.GetVolumeAccessPathsRequest{
+		VolumeId: volumeID,
+	})
+	if err != nil {
+		t.Fatalf("GetVolumeAccessPaths of volume %s failed. Error: %v", volumeID, err)
+	}
+	mounted := false
+	for _, path := range accessPathsResponse.AccessPaths {
+		if strings.EqualFold(strings.TrimSuffix(path, `\`), strings.TrimSuffix(vhd.Mount, `\`)) {
+			mounted = true
+		}
+	}
+	if !mounted {
+		t.Errorf("Expected the access paths %v of volume %s to include %s", accessPathsResponse.AccessPaths, volumeID, vhd.Mount)
+	}
+
 	// Unmount the volume
 	unmountVolumeRequest := &v2alpha1.UnmountVolumeRequest{
 		VolumeId:   volumeID,
@@ -298,6 +500,325 @@ func v2alpha1MountVolumeTests(diskClient *diskv1client.Client, volumeClient *v2a
 	}
 }
 
+func v2alpha1ReadOnlyMountVolumeTests(diskClient *diskv1client.Client, volumeClient *v2alpha1client.Client, t *testing.T) {
+	vhd, volumeID, vhdCleanup := volumeInit(volumeClient, t)
+	defer vhdCleanup()
+
+	mountVolumeRequest := &v2alpha1.MountVolumeRequest{
+		VolumeId:   volumeID,
+		TargetPath: vhd.Mount,
+		ReadOnly:   true,
+	}
+	_, err := volumeClient.MountVolume(context.TODO(), mountVolumeRequest)
+	if err != nil {
+		t.Fatalf("Volume id %s mount to path %s failed. Error: %v", volumeID, vhd.Mount, err)
+	}
+
+	// writes to the volume are rejected by the OS
+	err = os.WriteFile(filepath.Join(vhd.Mount, "file.txt"), []byte("data"), 0644)
+	if err == nil {
+		t.Errorf("Expected error writing to the read-only volume mounted at %s", vhd.Mount)
+	}
+
+	unmountVolumeRequest := &v2alpha1.UnmountVolumeRequest{
+		VolumeId:   volumeID,
+		TargetPath: vhd.Mount,
+	}
+	_, err = volumeClient.UnmountVolume(context.TODO(), unmountVolumeRequest)
+	if err != nil {
+		t.Fatalf("Volume id %s unmount from path %s failed. Error: %v", volumeID, vhd.Mount, err)
+	}
+
+	// mounting the volume read-write clears the read-only attribute
+	mountVolumeRequest.ReadOnly = false
+	_, err = volumeClient.MountVolume(context.TODO(), mountVolumeRequest)
+	if err != nil {
+		t.Fatalf("Volume id %s mount to path %s failed. Error: %v", volumeID, vhd.Mount, err)
+	}
+	defer func() {
+		_, err = volumeClient.UnmountVolume(context.TODO(), unmountVolumeRequest)
+		if err != nil {
+			t.Fatalf("Volume id %s unmount from path %s failed. Error: %v", volumeID, vhd.Mount, err)
+		}
+	}()
+	err = os.WriteFile(filepath.Join(vhd.Mount, "file.txt"), []byte("data"), 0644)
+	if err != nil {
+		t.Errorf("Error writing to the volume mounted at %s: %v", vhd.Mount, err)
+	}
+}
+
+func v2alpha1ForceUnmountVolumeTests(diskClient *diskv1client.Client, volumeClient *v2alpha1client.Client, t *testing.T) {
+	vhd, volumeID, vhdCleanup := volumeInit(volumeClient, t)
+	defer vhdCleanup()
+
+	mountVolumeRequest := &v2alpha1.MountVolumeRequest{
+		VolumeId:   volumeID,
+		TargetPath: vhd.Mount,
+	}
+	_, err := volumeClient.MountVolume(context.TODO(), mountVolumeRequest)
+	if err != nil {
+		t.Fatalf("Volume id %s mount to path %s failed. Error: %v", volumeID, vhd.Mount, err)
+	}
+
+	// leak a handle of a file of the volume
+	f, err := os.Create(filepath.Join(vhd.Mount, "file.txt"))
+	if err != nil {
+		t.Fatalf("Error creating a file in the volume mounted at %s: %v", vhd.Mount, err)
+	}
+	defer f.Close()
+
+	unmountVolumeRequest := &v2alpha1.UnmountVolumeRequest{
+		VolumeId:   volumeID,
+		TargetPath: vhd.Mount,
+		Force:      true,
+	}
+	unmountVolumeResponse, err := volumeClient.UnmountVolume(context.TODO(), unmountVolumeRequest)
+	if err != nil {
+		t.Fatalf("Volume id %s unmount from path %s failed. Error: %v", volumeID, vhd.Mount, err)
+	}
+	found := false
+	for _, h := range unmountVolumeResponse.OpenHandles {
+		if h.ProcessId == uint32(os.Getpid()) {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected the open handles %v to include a handle of process %d", unmountVolumeResponse.OpenHandles, os.Getpid())
+	}
+}
+
+func v2alpha1CreateTargetPathTests(diskClient *diskv1client.Client, volumeClient *v2alpha1client.Client, t *testing.T) {
+	vhd, volumeID, vhdCleanup := volumeInit(volumeClient, t)
+	defer vhdCleanup()
+
+	// a failed mount doesn't leave the directories behind
+	parent := filepath.Join(vhd.Mount, "nested")
+	mountVolumeRequest := &v2alpha1.MountVolumeRequest{
+		VolumeId:         `\\?\Volume{00000000-0000-0000-0000-000000000000}\`,
+		TargetPath:       filepath.Join(parent, "globalmount"),
+		CreateTargetPath: true,
+	}
+	if _, err := volumeClient.MountVolume(context.TODO(), mountVolumeRequest); err == nil {
+		t.Fatalf("Expected the mount of an unknown volume to fail")
+	}
+	if _, err := os.Stat(parent); !os.IsNotExist(err) {
+		t.Errorf("Expected %s to be removed after the failed mount, err=%v", parent, err)
+	}
+
+	mountVolumeRequest.VolumeId = volumeID
+	mountVolumeRequest.TargetPathSddl = "D:P(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)"
+	if _, err := volumeClient.MountVolume(context.TODO(), mountVolumeRequest); err != nil {
+		t.Fatalf("Volume id %s mount to path %s failed. Error: %v", volumeID, mountVolumeRequest.TargetPath, err)
+	}
+	defer volumeClient.UnmountVolume(context.TODO(), &v2alpha1.UnmountVolumeRequest{VolumeId: volumeID, TargetPath: mountVolumeRequest.TargetPath})
+
+	cmd := fmt.Sprintf("(Get-Acl -Path '%s').Sddl", parent)
+	out, err := runPowershellCmd(t, cmd)
+	if err != nil {
+		t.Fatalf("Error: %v. Command: %s. Out: %s", err, cmd, out)
+	}
+	if !strings.Contains(out, "D:P(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)") {
+		t.Errorf("Expected %s to have the security descriptor of the request, got %s", parent, out)
+	}
+}
+
+func v2alpha1PrepareStagingPathTests(diskClient *diskv1client.Client, volumeClient *v2alpha1client.Client, t *testing.T) {
+	vhd, volumeID, vhdCleanup := volumeInit(volumeClient, t)
+	defer vhdCleanup()
+
+	sddl := "D:P(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)"
+	stagingPath := filepath.Join(vhd.TestPluginPath, "staging", "globalmount")
+	prepareRequest := &v2alpha1.PrepareStagingPathRequest{StagingPath: stagingPath, Sddl: sddl}
+	prepareResponse, err := volumeClient.PrepareStagingPath(context.TODO(), prepareRequest)
+	if err != nil {
+		t.Fatalf("PrepareStagingPath of %s failed. Error: %v", stagingPath, err)
+	}
+	if !prepareResponse.Created {
+		t.Errorf("Expected the staging path %s to be created", stagingPath)
+	}
+	cmd := fmt.Sprintf("(Get-Acl -Path '%s').Sddl", stagingPath)
+	out, err := runPowershellCmd(t, cmd)
+	if err != nil {
+		t.Fatalf("Error: %v. Command: %s. Out: %s", err, cmd, out)
+	}
+	if !strings.Contains(out, sddl) {
+		t.Errorf("Expected %s to have the security descriptor of the request, got %s", stagingPath, out)
+	}
+
+	// a staging path inside a volume mounted by csi-proxy is rejected
+	mountVolumeRequest := &v2alpha1.MountVolumeRequest{
+		VolumeId:   volumeID,
+		TargetPath: stagingPath,
+	}
+	if _, err := volumeClient.MountVolume(context.TODO(), mountVolumeRequest); err != nil {
+		t.Fatalf("Volume id %s mount to path %s failed. Error: %v", volumeID, stagingPath, err)
+	}
+	defer volumeClient.UnmountVolume(context.TODO(), &v2alpha1.UnmountVolumeRequest{VolumeId: volumeID, TargetPath: stagingPath})
+	prepareRequest = &v2alpha1.PrepareStagingPathRequest{StagingPath: filepath.Join(stagingPath, "nested")}
+	if _, err := volumeClient.PrepareStagingPath(context.TODO(), prepareRequest); err == nil {
+		t.Errorf("Expected PrepareStagingPath inside volume %s to fail", volumeID)
+	}
+}
+
+func v2alpha1BoundedDirectoryTests(volumeClient *v2alpha1client.Client, t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kubernetes.io~empty-dir", "cache")
+	sizeBytes := uint64(64 * 1024 * 1024)
+	createRequest := &v2alpha1.CreateBoundedDirectoryRequest{Path: path, SizeBytes: sizeBytes}
+	createResponse, err := volumeClient.CreateBoundedDirectory(context.TODO(), createRequest)
+	if err != nil {
+		t.Fatalf("CreateBoundedDirectory of %s failed. Error: %v", path, err)
+	}
+	removeRequest := &v2alpha1.RemoveBoundedDirectoryRequest{Path: path}
+	defer volumeClient.RemoveBoundedDirectory(context.TODO(), removeRequest)
+
+	statsResponse, err := volumeClient.GetVolumeStats(context.TODO(), &v2alpha1.GetVolumeStatsRequest{VolumeId: createResponse.VolumeId})
+	if err != nil {
+		t.Fatalf("GetVolumeStats of volume %s failed. Error: %v", createResponse.VolumeId, err)
+	}
+	if statsResponse.TotalBytes == 0 || statsResponse.TotalBytes > sizeBytes {
+		t.Errorf("Expected the bounded directory %s to have at most %d bytes, got %d", path, sizeBytes, statsResponse.TotalBytes)
+	}
+	// the content of the directory can't grow beyond its size
+	if err := os.WriteFile(filepath.Join(path, "large.bin"), make([]byte, 2*sizeBytes), 0644); err == nil {
+		t.Errorf("Expected writing %d bytes to the bounded directory %s to fail", 2*sizeBytes, path)
+	}
+
+	if _, err := volumeClient.RemoveBoundedDirectory(context.TODO(), removeRequest); err != nil {
+		t.Fatalf("RemoveBoundedDirectory of %s failed. Error: %v", path, err)
+	}
+	cmd := fmt.Sprintf("(Get-Item -Path '%s').Attributes", path)
+	out, err := runPowershellCmd(t, cmd)
+	if err != nil {
+		t.Fatalf("Error: %v. Command: %s. Out: %s", err, cmd, out)
+	}
+	if strings.Contains(out, "ReparsePoint") {
+		t.Errorf("Expected %s not to be a mount point after RemoveBoundedDirectory, got %s", path, out)
+	}
+}
+
+func v2alpha1VolumeCompressionTests(diskClient *diskv1client.Client, volumeClient *v2alpha1client.Client, t *testing.T) {
+	vhd, volumeID, vhdCleanup := volumeInit(volumeClient, t)
+	defer vhdCleanup()
+
+	mountVolumeRequest := &v2alpha1.MountVolumeRequest{
+		VolumeId:   volumeID,
+		TargetPath: vhd.Mount,
+	}
+	_, err := volumeClient.MountVolume(context.TODO(), mountVolumeRequest)
+	if err != nil {
+		t.Fatalf("Volume id %s mount to path %s failed. Error: %v", volumeID, vhd.Mount, err)
+	}
+	defer volumeClient.UnmountVolume(context.TODO(), &v2alpha1.UnmountVolumeRequest{VolumeId: volumeID, TargetPath: vhd.Mount})
+
+	for _, enabled := range []bool{true, false} {
+		_, err = volumeClient.SetVolumeCompression(context.TODO(), &v2alpha1.SetVolumeCompressionRequest{VolumeId: volumeID, Enabled: enabled})
+		if err != nil {
+			t.Fatalf("SetVolumeCompression of volume %s failed. Error: %v", volumeID, err)
+		}
+
+		// the new files inherit the compression of the root directory
+		file := filepath.Join(vhd.Mount, fmt.Sprintf("compressed-%t.log", enabled))
+		if err := os.WriteFile(file, []byte("log"), 0644); err != nil {
+			t.Fatalf("Error writing %s: %v", file, err)
+		}
+		cmd := fmt.Sprintf("[bool]((Get-Item -Path '%s').Attributes -band [IO.FileAttributes]::Compressed)", file)
+		out, err := runPowershellCmd(t, cmd)
+		if err != nil {
+			t.Fatalf("Error: %v. Command: %s. Out: %s", err, cmd, out)
+		}
+		if compressed := strings.TrimSpace(out) == "True"; compressed != enabled {
+			t.Errorf("Expected the compression of %s to be %t, got %s", file, enabled, out)
+		}
+	}
+}
+
+func v2alpha1CleanupVolumeTests(diskClient *diskv1client.Client, volumeClient *v2alpha1client.Client, t *testing.T) {
+	vhd, volumeID, vhdCleanup := volumeInit(volumeClient, t)
+	defer vhdCleanup()
+
+	mountVolumeRequest := &v2alpha1.MountVolumeRequest{
+		VolumeId:   volumeID,
+		TargetPath: vhd.Mount,
+	}
+	_, err := volumeClient.MountVolume(context.TODO(), mountVolumeRequest)
+	if err != nil {
+		t.Fatalf("Volume id %s mount to path %s failed. Error: %v", volumeID, vhd.Mount, err)
+	}
+	defer volumeClient.UnmountVolume(context.TODO(), &v2alpha1.UnmountVolumeRequest{VolumeId: volumeID, TargetPath: vhd.Mount})
+
+	tmpDir := filepath.Join(vhd.Mount, "tmp")
+	if err := os.MkdirAll(filepath.Join(tmpDir, "cache"), 0755); err != nil {
+		t.Fatalf("Error creating %s: %v", tmpDir, err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "cache", "scratch.bin"), make([]byte, 1024*1024), 0644); err != nil {
+		t.Fatalf("Error writing a file in %s: %v", tmpDir, err)
+	}
+	keptFile := filepath.Join(vhd.Mount, "data.txt")
+	if err := os.WriteFile(keptFile, []byte("data"), 0644); err != nil {
+		t.Fatalf("Error writing %s: %v", keptFile, err)
+	}
+
+	// the volume is nearly empty, it's below the threshold
+	cleanupRequest := &v2alpha1.CleanupVolumeRequest{
+		VolumeId:              volumeID,
+		UsageThresholdPercent: 90,
+		RecycleBin:            true,
+		TempDirs:              []string{"tmp"},
+	}
+	cleanupResponse, err := volumeClient.CleanupVolume(context.TODO(), cleanupRequest)
+	if err != nil {
+		t.Fatalf("CleanupVolume of volume %s failed. Error: %v", volumeID, err)
+	}
+	if cleanupResponse.CleanedUp {
+		t.Fatalf("Expected volume %s below the threshold not to be cleaned up", volumeID)
+	}
+
+	cleanupRequest.UsageThresholdPercent = 0
+	cleanupResponse, err = volumeClient.CleanupVolume(context.TODO(), cleanupRequest)
+	if err != nil {
+		t.Fatalf("CleanupVolume of volume %s failed. Error: %v", volumeID, err)
+	}
+	if !cleanupResponse.CleanedUp || cleanupResponse.UsedBytesAfter >= cleanupResponse.UsedBytesBefore {
+		t.Errorf("Expected volume %s to be cleaned up, got %+v", volumeID, cleanupResponse)
+	}
+	if entries, err := os.ReadDir(tmpDir); err != nil || len(entries) != 0 {
+		t.Errorf("Expected %s to be kept empty, got %v (err=%v)", tmpDir, entries, err)
+	}
+	if _, err := os.Stat(keptFile); err != nil {
+		t.Errorf("Expected %s to be kept: %v", keptFile, err)
+	}
+}
+
+func v2alpha1DeduplicationTests(diskClient *diskv1client.Client, volumeClient *v2alpha1client.Client, t *testing.T) {
+	// the Data Deduplication feature is only available on Windows Server
+	out, err := runPowershellCmd(t, "[bool](Get-Command Enable-DedupVolume -ErrorAction SilentlyContinue)")
+	skipTestOnCondition(t, err != nil || strings.TrimSpace(out) != "True")
+
+	_, volumeID, vhdCleanup := volumeInit(volumeClient, t)
+	defer vhdCleanup()
+
+	statusRequest := &v2alpha1.GetDeduplicationStatusRequest{VolumeId: volumeID}
+	statusResponse, err := volumeClient.GetDeduplicationStatus(context.TODO(), statusRequest)
+	if err != nil {
+		t.Fatalf("GetDeduplicationStatus of volume %s failed. Error: %v", volumeID, err)
+	}
+	if statusResponse.Enabled {
+		t.Fatalf("Expected the deduplication of the new volume %s to be disabled", volumeID)
+	}
+
+	enableRequest := &v2alpha1.EnableDeduplicationRequest{VolumeId: volumeID, UsageType: v2alpha1.DeduplicationUsageType_DEFAULT}
+	if _, err := volumeClient.EnableDeduplication(context.TODO(), enableRequest); err != nil {
+		t.Fatalf("EnableDeduplication of volume %s failed. Error: %v", volumeID, err)
+	}
+	statusResponse, err = volumeClient.GetDeduplicationStatus(context.TODO(), statusRequest)
+	if err != nil {
+		t.Fatalf("GetDeduplicationStatus of volume %s failed. Error: %v", volumeID, err)
+	}
+	if !statusResponse.Enabled || statusResponse.UsageType != "Default" {
+		t.Errorf("Expected the deduplication of volume %s to be enabled with usage type Default, got %+v", volumeID, statusResponse)
+	}
+}
+
 func v2alpha1VolumeTests(t *testing.T) {
 	var volumeClient *v2alpha1client.Client
 	var diskClient *diskv1client.Client
@@ -316,7 +837,31 @@ func v2alpha1VolumeTests(t *testing.T) {
 	t.Run("MountVolume", func(t *testing.T) {
 		v2alpha1MountVolumeTests(diskClient, volumeClient, t)
 	})
+	t.Run("ReadOnlyMountVolume", func(t *testing.T) {
+		v2alpha1ReadOnlyMountVolumeTests(diskClient, volumeClient, t)
+	})
+	t.Run("ForceUnmountVolume", func(t *testing.T) {
+		v2alpha1ForceUnmountVolumeTests(diskClient, volumeClient, t)
+	})
 	t.Run("GetClosestVolumeFromTargetPath", func(t *testing.T) {
 		v2alpha1GetClosestVolumeFromTargetPathTests(diskClient, volumeClient, t)
 	})
+	t.Run("CreateTargetPath", func(t *testing.T) {
+		v2alpha1CreateTargetPathTests(diskClient, volumeClient, t)
+	})
+	t.Run("PrepareStagingPath", func(t *testing.T) {
+		v2alpha1PrepareStagingPathTests(diskClient, volumeClient, t)
+	})
+	t.Run("BoundedDirectory", func(t *testing.T) {
+		v2alpha1BoundedDirectoryTests(volumeClient, t)
+	})
+	t.Run("VolumeCompression", func(t *testing.T) {
+		v2alpha1VolumeCompressionTests(diskClient, volumeClient, t)
+	})
+	t.Run("CleanupVolume", func(t *testing.T) {
+		v2alpha1CleanupVolumeTests(diskClient, volumeClient, t)
+	})
+	t.Run("Deduplication", func(t *testing.T) {
+		v2alpha1DeduplicationTests(diskClient, volumeClient, t)
+	})
 }
@@ -0,0 +1,48 @@
+package integrationtests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/vss/v1alpha1"
+	volumev2alpha1client "github.com/kubernetes-csi/csi-proxy/client/groups/volume/v2alpha1"
+	vssclient "github.com/kubernetes-csi/csi-proxy/client/groups/vss/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVssAPIGroup(t *testing.T) {
+	skipTestOnCondition(t, isRunningOnGhActions())
+
+	vssClient, err := vssclient.NewClient()
+	require.NoError(t, err)
+	defer vssClient.Close()
+
+	volumeClient, err := volumev2alpha1client.NewClient()
+	require.NoError(t, err)
+	defer volumeClient.Close()
+
+	_, volumeID, vhdCleanup := volumeInit(volumeClient, t)
+	defer vhdCleanup()
+
+	createResponse, err := vssClient.CreateShadowCopy(context.TODO(), &v1alpha1.CreateShadowCopyRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	shadowCopy := createResponse.ShadowCopy
+	require.NotEmpty(t, shadowCopy.Id)
+	require.NotEmpty(t, shadowCopy.DeviceObject)
+
+	listResponse, err := vssClient.ListShadowCopies(context.TODO(), &v1alpha1.ListShadowCopiesRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	require.Len(t, listResponse.ShadowCopies, 1)
+	require.Equal(t, shadowCopy.Id, listResponse.ShadowCopies[0].Id)
+
+	_, err = vssClient.DeleteShadowCopy(context.TODO(), &v1alpha1.DeleteShadowCopyRequest{ShadowCopyId: shadowCopy.Id})
+	require.NoError(t, err)
+
+	// deleting a shadow copy that doesn't exist succeeds
+	_, err = vssClient.DeleteShadowCopy(context.TODO(), &v1alpha1.DeleteShadowCopyRequest{ShadowCopyId: shadowCopy.Id})
+	require.NoError(t, err)
+
+	listResponse, err = vssClient.ListShadowCopies(context.TODO(), &v1alpha1.ListShadowCopiesRequest{VolumeId: volumeID})
+	require.NoError(t, err)
+	require.Empty(t, listResponse.ShadowCopies)
+}
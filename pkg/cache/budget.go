@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"expvar"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// DefaultMemoryLimit is the default memory budget of the caches in bytes.
+const DefaultMemoryLimit = 16 * 1024 * 1024
+
+// Memory is the memory budget shared by the caches of csi-proxy, its usage is
+// published as `cache_memory` in the metrics server.
+var Memory = NewBudget(DefaultMemoryLimit, expvar.NewMap("cache_memory"))
+
+// Evictor is implemented by the caches that use a Budget.
+type Evictor interface {
+	// EvictOldest evicts the least recently used entry of the cache and returns
+	// its size in bytes, 0 if the cache is empty. The size is released from the
+	// account of the cache by the budget.
+	EvictOldest() int64
+}
+
+// Budget limits the memory used by a set of caches. The caches report the
+// estimated size of their entries to their Account, and when they use more
+// than the limit the least recently used entries of the caches with the lowest
+// priority are evicted until they fit in the budget again.
+type Budget struct {
+	limit int64
+	used  int64
+
+	// reclaimLock serializes evictions.
+	reclaimLock sync.Mutex
+
+	lock     sync.Mutex
+	accounts []*Account
+}
+
+// Account is the usage of a Budget by a cache.
+type Account struct {
+	name     string
+	priority int
+	evictor  Evictor
+	budget   *Budget
+	used     int64
+}
+
+// NewBudget creates a budget of `limit` bytes, its usage is published in
+// `metrics`.
+func NewBudget(limit int64, metrics *expvar.Map) *Budget {
+	b := &Budget{limit: limit}
+	metrics.Set("limit", expvar.Func(func() interface{} {
+		return atomic.LoadInt64(&b.limit)
+	}))
+	metrics.Set("used", expvar.Func(func() interface{} {
+		return atomic.LoadInt64(&b.used)
+	}))
+	metrics.Set("caches", expvar.Func(func() interface{} {
+		b.lock.Lock()
+		defer b.lock.Unlock()
+		caches := make(map[string]int64, len(b.accounts))
+		for _, a := range b.accounts {
+			caches[a.name] = atomic.LoadInt64(&a.used)
+		}
+		return caches
+	}))
+	return b
+}
+
+// SetLimit changes the limit of the budget to `limit` bytes, evicting entries
+// if the caches use more than it.
+func (b *Budget) SetLimit(limit int64) {
+	atomic.StoreInt64(&b.limit, limit)
+	b.reclaim()
+}
+
+// Register adds the cache `name` to the budget, the entries of caches with a
+// lower priority are evicted first.
+func (b *Budget) Register(name string, priority int, evictor Evictor) *Account {
+	a := &Account{
+		name:     name,
+		priority: priority,
+		evictor:  evictor,
+		budget:   b,
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.accounts = append(b.accounts, a)
+	sort.SliceStable(b.accounts, func(i, j int) bool {
+		return b.accounts[i].priority < b.accounts[j].priority
+	})
+	return a
+}
+
+// Charge records that the cache added an entry of `bytes` bytes, entries are
+// evicted if the budget is exceeded. It must be called without any lock of the
+// cache held as it may call its Evictor.
+func (a *Account) Charge(bytes int64) {
+	atomic.AddInt64(&a.used, bytes)
+	atomic.AddInt64(&a.budget.used, bytes)
+	a.budget.reclaim()
+}
+
+// Release records that the cache removed an entry of `bytes` bytes.
+func (a *Account) Release(bytes int64) {
+	atomic.AddInt64(&a.used, -bytes)
+	atomic.AddInt64(&a.budget.used, -bytes)
+}
+
+// reclaim evicts entries until the caches fit in the budget.
+func (b *Budget) reclaim() {
+	if atomic.LoadInt64(&b.used) <= atomic.LoadInt64(&b.limit) {
+		return
+	}
+	b.reclaimLock.Lock()
+	defer b.reclaimLock.Unlock()
+	for atomic.LoadInt64(&b.used) > atomic.LoadInt64(&b.limit) {
+		a := b.victim()
+		if a == nil {
+			return
+		}
+		if freed := a.evictor.EvictOldest(); freed > 0 {
+			a.Release(freed)
+		} else {
+			// the cache is empty but hasn't released its usage yet, e.g. it's
+			// concurrently removing an entry, don't spin on it
+			return
+		}
+	}
+}
+
+// victim returns the account with the lowest priority that uses memory.
+func (b *Budget) victim() *Account {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, a := range b.accounts {
+		if atomic.LoadInt64(&a.used) > 0 {
+			return a
+		}
+	}
+	return nil
+}
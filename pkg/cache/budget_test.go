@@ -0,0 +1,60 @@
+package cache
+
+import (
+	"expvar"
+	"testing"
+)
+
+// fakeCache is a cache whose entries are evicted in insertion order.
+type fakeCache struct {
+	account *Account
+	entries []int64
+}
+
+func (c *fakeCache) add(size int64) {
+	c.entries = append(c.entries, size)
+	c.account.Charge(size)
+}
+
+func (c *fakeCache) EvictOldest() int64 {
+	if len(c.entries) == 0 {
+		return 0
+	}
+	size := c.entries[0]
+	c.entries = c.entries[1:]
+	return size
+}
+
+func TestBudget(t *testing.T) {
+	metrics := new(expvar.Map).Init()
+	budget := NewBudget(100, metrics)
+	high := &fakeCache{}
+	high.account = budget.Register("high", 1, high)
+	low := &fakeCache{}
+	low.account = budget.Register("low", 0, low)
+
+	high.add(40)
+	low.add(30)
+	low.add(30)
+	if len(high.entries) != 1 || len(low.entries) != 2 {
+		t.Fatalf("expected no evictions within the budget, got high=%v low=%v", high.entries, low.entries)
+	}
+
+	// the entries of the cache with the lowest priority are evicted first
+	high.add(40)
+	if len(high.entries) != 2 || len(low.entries) != 0 {
+		t.Errorf("expected the low priority entries to be evicted, got high=%v low=%v", high.entries, low.entries)
+	}
+	if got := metrics.Get("used").String(); got != "80" {
+		t.Errorf("expected 80 bytes used, got %s", got)
+	}
+
+	// entries of higher priority caches are evicted once the lower ones are empty
+	budget.SetLimit(50)
+	if len(high.entries) != 1 {
+		t.Errorf("expected one high priority entry left, got %v", high.entries)
+	}
+	if got := metrics.Get("caches").String(); got != `{"high":40,"low":0}` {
+		t.Errorf("unexpected cache usage: %s", got)
+	}
+}
@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// CommandTextLogLevel is the verbosity from which the full text of the commands
+// is logged, the commands are summarized below it since their text contains host
+// paths. The command log keeps the full text at every verbosity.
+const CommandTextLogLevel klog.Level = 5
+
+// maxRecentCommands is the number of recent commands kept by Commands.
+const maxRecentCommands = 1000
+
+// Commands is the log of the recent commands (e.g. PowerShell scripts) run by
+// csi-proxy, it's the timeline of the slow operations.
+var Commands = NewCommandLog(maxRecentCommands)
+
+// Command is a command run by csi-proxy.
+type Command struct {
+	// Command is the command line or the script that was run.
+	Command string
+
+	// Summary is the command without its parameters, e.g. the cmdlets of a script.
+	Summary string
+
+	// Start is the start time of the command.
+	Start time.Time
+
+	// Duration of the command measured with the monotonic clock.
+	Duration time.Duration
+
+	// Err is the error of the command, nil if it succeeded.
+	Err error
+}
+
+// CommandLog keeps the most recent commands in a ring buffer.
+type CommandLog struct {
+	lock     sync.Mutex
+	commands []Command
+	next     int
+}
+
+// NewCommandLog creates a log that keeps the last `size` commands.
+func NewCommandLog(size int) *CommandLog {
+	return &CommandLog{
+		commands: make([]Command, 0, size),
+	}
+}
+
+// Record records a command and its summary, the command started at `start` and
+// just finished.
+func (l *CommandLog) Record(command, summary string, start time.Time, err error) {
+	c := Command{
+		Command:  command,
+		Summary:  summary,
+		Start:    start,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if len(l.commands) < cap(l.commands) {
+		l.commands = append(l.commands, c)
+		return
+	}
+	l.commands[l.next] = c
+	l.next = (l.next + 1) % len(l.commands)
+}
+
+// Between returns the recent commands that ran between `start` and `end`
+// sorted by start time. Operations don't pass their identity to the commands
+// they run, the commands of concurrent operations are returned too.
+func (l *CommandLog) Between(start, end time.Time) []Command {
+	l.lock.Lock()
+	commands := []Command{}
+	for _, c := range l.commands {
+		if c.Start.Before(end) && !c.Start.Add(c.Duration).Before(start) {
+			commands = append(commands, c)
+		}
+	}
+	l.lock.Unlock()
+	sort.SliceStable(commands, func(i, j int) bool {
+		return commands[i].Start.Before(commands[j].Start)
+	})
+	return commands
+}
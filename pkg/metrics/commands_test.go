@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCommandLog(t *testing.T) {
+	log := NewCommandLog(3)
+	now := time.Now()
+	log.Record("a", "", now.Add(-10*time.Second), nil)
+	log.Record("b", "", now.Add(-5*time.Second), fmt.Errorf("failed"))
+	log.Record("c", "", now.Add(-1*time.Second), nil)
+	// the log is full, the oldest command (a) is replaced
+	log.Record("d", "", now.Add(-3*time.Second), nil)
+
+	testCases := []struct {
+		start          time.Time
+		end            time.Time
+		expectCommands []string
+	}{
+		{start: now.Add(-20 * time.Second), end: now.Add(time.Second), expectCommands: []string{"b", "d", "c"}},
+		// commands that were running at the start of the window are included
+		{start: now.Add(-2 * time.Second), end: now.Add(time.Second), expectCommands: []string{"b", "d", "c"}},
+		{start: now.Add(-20 * time.Second), end: now.Add(-4 * time.Second), expectCommands: []string{"b"}},
+		{start: now.Add(time.Second), end: now.Add(2 * time.Second), expectCommands: []string{}},
+	}
+	for _, tc := range testCases {
+		commands := []string{}
+		for _, c := range log.Between(tc.start, tc.end) {
+			commands = append(commands, c.Command)
+		}
+		if fmt.Sprint(commands) != fmt.Sprint(tc.expectCommands) {
+			t.Errorf("Between(%v, %v): expected %v, got %v", tc.start.Sub(now), tc.end.Sub(now), tc.expectCommands, commands)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"expvar"
+	"sync"
+)
+
+// Drivers counts the operations served on the named pipes of each driver
+// endpoint by operation type, they're published as
+// {"driverA": {"Volume/MountVolume": {"count": 12, "errors": 1, "rejected": 0}}}
+var Drivers = NewDriverStats()
+
+func init() {
+	expvar.Publish("driver_operations", expvar.Func(func() interface{} {
+		return Drivers.Summary()
+	}))
+}
+
+// DriverOperationStats are the counters of an operation type of a driver.
+type DriverOperationStats struct {
+	// Count is the number of operations served.
+	Count int64 `json:"count"`
+	// Errors is the number of operations served that failed.
+	Errors int64 `json:"errors"`
+	// Rejected is the number of operations rejected by the policy or the quota
+	// of the driver, they aren't counted in Count.
+	Rejected int64 `json:"rejected"`
+}
+
+// DriverStats are the counters of the operations of each driver.
+type DriverStats struct {
+	lock    sync.Mutex
+	drivers map[string]map[string]*DriverOperationStats
+}
+
+// NewDriverStats creates empty driver counters.
+func NewDriverStats() *DriverStats {
+	return &DriverStats{
+		drivers: make(map[string]map[string]*DriverOperationStats),
+	}
+}
+
+// Record counts an operation of the gRPC method `method` served for the driver
+// `driver`.
+func (d *DriverStats) Record(driver, method string, err error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	stats := d.stats(driver, method)
+	stats.Count++
+	if err != nil {
+		stats.Errors++
+	}
+}
+
+// Reject counts an operation of the gRPC method `method` of the driver `driver`
+// that was rejected before it ran.
+func (d *DriverStats) Reject(driver, method string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.stats(driver, method).Rejected++
+}
+
+// stats returns the counters of the operation type of `method` of `driver`, it
+// must be called with the lock held.
+func (d *DriverStats) stats(driver, method string) *DriverOperationStats {
+	operations, ok := d.drivers[driver]
+	if !ok {
+		operations = make(map[string]*DriverOperationStats)
+		d.drivers[driver] = operations
+	}
+	name := operationName(method)
+	stats, ok := operations[name]
+	if !ok {
+		stats = &DriverOperationStats{}
+		operations[name] = stats
+	}
+	return stats
+}
+
+// Summary returns the counters of every operation type of every driver.
+func (d *DriverStats) Summary() map[string]map[string]DriverOperationStats {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	summary := make(map[string]map[string]DriverOperationStats, len(d.drivers))
+	for driver, operations := range d.drivers {
+		summary[driver] = make(map[string]DriverOperationStats, len(operations))
+		for name, stats := range operations {
+			summary[driver][name] = *stats
+		}
+	}
+	return summary
+}
@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDriverStats(t *testing.T) {
+	stats := NewDriverStats()
+	stats.Record("driverA", "/v1.Volume/MountVolume", nil)
+	stats.Record("driverA", "/v2alpha1.Volume/MountVolume", fmt.Errorf("failed"))
+	stats.Reject("driverA", "/v1.Disk/PartitionDisk")
+	stats.Record("driverB", "/v1.Smb/NewSmbGlobalMapping", nil)
+
+	expected := map[string]map[string]DriverOperationStats{
+		"driverA": {
+			"Volume/MountVolume": {Count: 2, Errors: 1},
+			"Disk/PartitionDisk": {Rejected: 1},
+		},
+		"driverB": {
+			"Smb/NewSmbGlobalMapping": {Count: 1},
+		},
+	}
+	summary := stats.Summary()
+	if fmt.Sprint(summary) != fmt.Sprint(expected) {
+		t.Errorf("expected %v, got %v", expected, summary)
+	}
+}
@@ -0,0 +1,239 @@
+package metrics
+
+import (
+	"expvar"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// latencyWindow is the number of recent durations of each operation type the
+// latency percentiles are computed from.
+const latencyWindow = 1000
+
+// Latency tracks the latency of the operations served by csi-proxy against
+// their SLOs, the percentiles are published as
+// {"Volume/MountVolume": {"count": 12, "p50_ms": 850.2, "p99_ms": 4210.5, ...}}
+var Latency = NewLatencyTracker(latencyWindow)
+
+func init() {
+	expvar.Publish("operation_latency", expvar.Func(func() interface{} {
+		return Latency.Summary()
+	}))
+}
+
+// LatencySummary is the latency of an operation type.
+type LatencySummary struct {
+	Count         int64   `json:"count"`
+	P50Ms         float64 `json:"p50_ms"`
+	P99Ms         float64 `json:"p99_ms"`
+	SLOMs         float64 `json:"slo_ms,omitempty"`
+	SLOViolations int64   `json:"slo_violations"`
+}
+
+// LatencyTracker keeps the recent durations of each operation type (e.g.
+// Volume/MountVolume, whatever the API version) and logs the operations that
+// take longer than their SLO.
+type LatencyTracker struct {
+	window int
+
+	lock       sync.Mutex
+	slos       map[string]time.Duration
+	operations map[string]*operationLatency
+}
+
+// operationLatency are the recent durations of an operation type in a ring
+// buffer.
+type operationLatency struct {
+	durations     []time.Duration
+	next          int
+	count         int64
+	sloViolations int64
+}
+
+// NewLatencyTracker creates a tracker that computes the percentiles from the
+// last `window` durations of each operation type.
+func NewLatencyTracker(window int) *LatencyTracker {
+	return &LatencyTracker{
+		window:     window,
+		slos:       make(map[string]time.Duration),
+		operations: make(map[string]*operationLatency),
+	}
+}
+
+// ParseSLOs parses comma separated SLOs e.g.
+// "Volume/MountVolume=30s,FormatVolume=5m,*=2m". The operations are named by
+// their API group and method, their method or * for all the other operations.
+func ParseSLOs(s string) (map[string]time.Duration, error) {
+	slos := make(map[string]time.Duration)
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid SLO %q, expected <operation>=<duration>", item)
+		}
+		slo, err := time.ParseDuration(parts[1])
+		if err != nil || slo <= 0 {
+			return nil, fmt.Errorf("invalid duration of SLO %q", item)
+		}
+		slos[parts[0]] = slo
+	}
+	return slos, nil
+}
+
+// SetSLOs replaces the SLOs of the operations, see ParseSLOs.
+func (t *LatencyTracker) SetSLOs(slos map[string]time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.slos = slos
+}
+
+// slo returns the SLO of the operation type `name`, 0 if it has none. It must be
+// called with the lock held.
+func (t *LatencyTracker) slo(name string) time.Duration {
+	if slo, ok := t.slos[name]; ok {
+		return slo
+	}
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		if slo, ok := t.slos[name[i+1:]]; ok {
+			return slo
+		}
+	}
+	return t.slos["*"]
+}
+
+// Observe records the duration of the operation `op` and logs it with the
+// commands that ran meanwhile if it took longer than its SLO. It returns true
+// if the SLO was exceeded.
+func (t *LatencyTracker) Observe(op Operation) bool {
+	name := operationName(op.Method)
+	t.lock.Lock()
+	l, ok := t.operations[name]
+	if !ok {
+		l = &operationLatency{durations: make([]time.Duration, 0, t.window)}
+		t.operations[name] = l
+	}
+	if len(l.durations) < cap(l.durations) {
+		l.durations = append(l.durations, op.Duration)
+	} else {
+		l.durations[l.next] = op.Duration
+		l.next = (l.next + 1) % len(l.durations)
+	}
+	l.count++
+	slo := t.slo(name)
+	exceeded := slo > 0 && op.Duration > slo
+	if exceeded {
+		l.sloViolations++
+	}
+	p50, p99 := l.percentiles()
+	t.lock.Unlock()
+
+	if exceeded {
+		klog.InfoS("Operation exceeded its SLO", "operation", name, "method", op.Method,
+			"duration", op.Duration, "slo", slo, "p50", p50, "p99", p99, "err", op.Err,
+			"timeline", timeline(op))
+	}
+	return exceeded
+}
+
+// Percentiles returns the 50th and the 99th percentiles of the recent durations
+// of the operation type `name`.
+func (t *LatencyTracker) Percentiles(name string) (p50, p99 time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	l, ok := t.operations[name]
+	if !ok {
+		return 0, 0
+	}
+	return l.percentiles()
+}
+
+// Summary returns the latency of every operation type.
+func (t *LatencyTracker) Summary() map[string]LatencySummary {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	summary := make(map[string]LatencySummary, len(t.operations))
+	for name, l := range t.operations {
+		p50, p99 := l.percentiles()
+		summary[name] = LatencySummary{
+			Count:         l.count,
+			P50Ms:         milliseconds(p50),
+			P99Ms:         milliseconds(p99),
+			SLOMs:         milliseconds(t.slo(name)),
+			SLOViolations: l.sloViolations,
+		}
+	}
+	return summary
+}
+
+// percentiles returns the 50th and the 99th percentiles (nearest rank) of the
+// recent durations.
+func (l *operationLatency) percentiles() (time.Duration, time.Duration) {
+	if len(l.durations) == 0 {
+		return 0, 0
+	}
+	durations := append([]time.Duration{}, l.durations...)
+	sort.Slice(durations, func(i, j int) bool {
+		return durations[i] < durations[j]
+	})
+	rank := func(p float64) time.Duration {
+		return durations[int(math.Ceil(p*float64(len(durations))))-1]
+	}
+	return rank(0.50), rank(0.99)
+}
+
+// timelineEntry is a command that ran during a slow operation.
+type timelineEntry struct {
+	// Offset is when the command started relative to the start of the operation,
+	// it's negative if it started before the operation.
+	Offset   time.Duration
+	Duration time.Duration
+	Command  string
+	Err      error
+}
+
+// timeline returns the commands that ran during the operation `op`, the commands
+// are summarized below CommandTextLogLevel.
+func timeline(op Operation) []timelineEntry {
+	entries := []timelineEntry{}
+	fullText := klog.V(CommandTextLogLevel).Enabled()
+	for _, c := range Commands.Between(op.Start, op.Start.Add(op.Duration)) {
+		command := c.Summary
+		if fullText || command == "" {
+			command = c.Command
+		}
+		entries = append(entries, timelineEntry{
+			Offset:   c.Start.Sub(op.Start),
+			Duration: c.Duration,
+			Command:  command,
+			Err:      c.Err,
+		})
+	}
+	return entries
+}
+
+// operationName returns the API group and the method of the gRPC method
+// `method` e.g. Volume/MountVolume for /v2alpha1.Volume/MountVolume.
+func operationName(method string) string {
+	parts := strings.SplitN(strings.TrimPrefix(method, "/"), "/", 2)
+	if len(parts) != 2 {
+		return method
+	}
+	service := parts[0]
+	if i := strings.LastIndex(service, "."); i >= 0 {
+		service = service[i+1:]
+	}
+	return service + "/" + parts[1]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,104 @@
+package metrics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseSLOs(t *testing.T) {
+	testCases := []struct {
+		s           string
+		expectSLOs  map[string]time.Duration
+		expectError bool
+	}{
+		{s: "", expectSLOs: map[string]time.Duration{}},
+		{
+			s:          "Volume/MountVolume=30s, FormatVolume=5m,*=2m",
+			expectSLOs: map[string]time.Duration{"Volume/MountVolume": 30 * time.Second, "FormatVolume": 5 * time.Minute, "*": 2 * time.Minute},
+		},
+		{s: "MountVolume", expectError: true},
+		{s: "=30s", expectError: true},
+		{s: "MountVolume=fast", expectError: true},
+		{s: "MountVolume=0s", expectError: true},
+	}
+	for _, tc := range testCases {
+		slos, err := ParseSLOs(tc.s)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("ParseSLOs(%q): expected an error", tc.s)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseSLOs(%q): unexpected error: %v", tc.s, err)
+			continue
+		}
+		if fmt.Sprint(slos) != fmt.Sprint(tc.expectSLOs) {
+			t.Errorf("ParseSLOs(%q): expected %v, got %v", tc.s, tc.expectSLOs, slos)
+		}
+	}
+}
+
+func TestLatencyTracker(t *testing.T) {
+	tracker := NewLatencyTracker(100)
+	tracker.SetSLOs(map[string]time.Duration{
+		"Volume/MountVolume": 50 * time.Millisecond,
+		"FormatVolume":       time.Second,
+		"*":                  time.Minute,
+	})
+
+	// the durations of both API versions are tracked together
+	for i := 1; i <= 100; i++ {
+		method := "/v1.Volume/MountVolume"
+		if i%2 == 0 {
+			method = "/v2alpha1.Volume/MountVolume"
+		}
+		tracker.Observe(Operation{Method: method, Start: time.Now(), Duration: time.Duration(i) * time.Millisecond})
+	}
+	p50, p99 := tracker.Percentiles("Volume/MountVolume")
+	if p50 != 50*time.Millisecond || p99 != 99*time.Millisecond {
+		t.Errorf("expected percentiles (50ms, 99ms), got (%v, %v)", p50, p99)
+	}
+
+	testCases := []struct {
+		method         string
+		duration       time.Duration
+		expectExceeded bool
+	}{
+		{method: "/v2alpha1.Volume/MountVolume", duration: 40 * time.Millisecond, expectExceeded: false},
+		{method: "/v2alpha1.Volume/MountVolume", duration: 60 * time.Millisecond, expectExceeded: true},
+		{method: "/v2alpha1.Volume/FormatVolume", duration: 2 * time.Second, expectExceeded: true},
+		{method: "/v1.Disk/ListDiskIDs", duration: 2 * time.Second, expectExceeded: false},
+		{method: "/v1.Disk/ListDiskIDs", duration: 2 * time.Minute, expectExceeded: true},
+	}
+	for _, tc := range testCases {
+		exceeded := tracker.Observe(Operation{Method: tc.method, Start: time.Now(), Duration: tc.duration})
+		if exceeded != tc.expectExceeded {
+			t.Errorf("%s took %v: expected exceeded=%v, got %v", tc.method, tc.duration, tc.expectExceeded, exceeded)
+		}
+	}
+
+	summary := tracker.Summary()
+	mount := summary["Volume/MountVolume"]
+	// 50 of the first 100 operations and one of the test cases exceeded 50ms
+	if mount.Count != 102 || mount.SLOViolations != 51 || mount.SLOMs != 50 {
+		t.Errorf("unexpected summary of Volume/MountVolume: %+v", mount)
+	}
+	if summary["Disk/ListDiskIDs"].SLOViolations != 1 {
+		t.Errorf("unexpected summary of Disk/ListDiskIDs: %+v", summary["Disk/ListDiskIDs"])
+	}
+}
+
+func TestOperationName(t *testing.T) {
+	testCases := map[string]string{
+		"/v2alpha1.Volume/MountVolume": "Volume/MountVolume",
+		"/v1.Disk/ListDiskIDs":         "Disk/ListDiskIDs",
+		"MountVolume":                  "MountVolume",
+	}
+	for method, expectName := range testCases {
+		if name := operationName(method); name != expectName {
+			t.Errorf("operationName(%q): expected %q, got %q", method, expectName, name)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// Package metrics serves the internal metrics of csi-proxy. Metrics are
+// published with expvar by the packages that own them and are served as JSON
+// at /debug/vars by the metrics server.
+package metrics
+
+import (
+	"expvar"
+	"net/http"
+
+	"k8s.io/klog/v2"
+)
+
+// StartServer starts serving the metrics at `address` in the background.
+func StartServer(address string) {
+	mux := http.NewServeMux()
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	go func() {
+		klog.Infof("Serving metrics at %s/debug/vars", address)
+		if err := http.ListenAndServe(address, mux); err != nil {
+			klog.Errorf("Metrics server stopped: %v", err)
+		}
+	}()
+}
@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// maxRecentOperations is the number of recent operations kept by Operations.
+const maxRecentOperations = 1000
+
+// Operations is the log of the recent operations served by csi-proxy.
+var Operations = NewOperationLog(maxRecentOperations)
+
+// Operation is an operation served by csi-proxy.
+type Operation struct {
+	// Method is the full gRPC method of the operation.
+	Method string
+	// Start is the start time of the operation, it has a monotonic clock
+	// reading so time.Since(Start) isn't affected by changes of the wall clock.
+	Start time.Time
+	// Duration of the operation measured with the monotonic clock.
+	Duration time.Duration
+	// Err is the error of the operation, nil if it succeeded.
+	Err error
+}
+
+// OperationLog keeps the most recent operations in a ring buffer.
+type OperationLog struct {
+	lock       sync.Mutex
+	operations []Operation
+	next       int
+}
+
+// NewOperationLog creates a log that keeps the last `size` operations.
+func NewOperationLog(size int) *OperationLog {
+	return &OperationLog{
+		operations: make([]Operation, 0, size),
+	}
+}
+
+// Record records an operation that started at `start` and just finished, and
+// returns it.
+func (l *OperationLog) Record(method string, start time.Time, err error) Operation {
+	op := Operation{
+		Method:   method,
+		Start:    start,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if len(l.operations) < cap(l.operations) {
+		l.operations = append(l.operations, op)
+		return op
+	}
+	l.operations[l.next] = op
+	l.next = (l.next + 1) % len(l.operations)
+	return op
+}
+
+// Slowest returns the `n` slowest recent operations sorted by duration (slowest
+// first), all the recent operations are returned if `n` is 0.
+func (l *OperationLog) Slowest(n int) []Operation {
+	l.lock.Lock()
+	ops := append([]Operation{}, l.operations...)
+	l.lock.Unlock()
+
+	sort.SliceStable(ops, func(i, j int) bool {
+		return ops[i].Duration > ops[j].Duration
+	})
+	if n > 0 && n < len(ops) {
+		ops = ops[:n]
+	}
+	return ops
+}
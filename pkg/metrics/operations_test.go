@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestOperationLog(t *testing.T) {
+	log := NewOperationLog(3)
+	now := time.Now()
+	log.Record("a", now.Add(-2*time.Second), nil)
+	log.Record("b", now.Add(-5*time.Second), fmt.Errorf("failed"))
+	log.Record("c", now.Add(-1*time.Second), nil)
+	// the log is full, the oldest operation (a) is replaced
+	log.Record("d", now.Add(-3*time.Second), nil)
+
+	testCases := []struct {
+		n             int
+		expectMethods []string
+	}{
+		{n: 0, expectMethods: []string{"b", "d", "c"}},
+		{n: 2, expectMethods: []string{"b", "d"}},
+		{n: 5, expectMethods: []string{"b", "d", "c"}},
+	}
+	for _, tc := range testCases {
+		ops := log.Slowest(tc.n)
+		methods := []string{}
+		for _, op := range ops {
+			methods = append(methods, op.Method)
+		}
+		if fmt.Sprint(methods) != fmt.Sprint(tc.expectMethods) {
+			t.Errorf("Slowest(%d): expected %v, got %v", tc.n, tc.expectMethods, methods)
+		}
+	}
+}
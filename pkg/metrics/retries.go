@@ -0,0 +1,51 @@
+package metrics
+
+import (
+	"expvar"
+	"strconv"
+	"sync"
+)
+
+var (
+	// operationAttempts is the histogram of the number of attempts that operations
+	// needed to succeed, per operation type e.g.
+	// {"OpenDisk": {"1": 120, "2": 3, "failed": 1}}
+	operationAttempts = expvar.NewMap("operation_attempts")
+
+	// retriedErrors counts the Windows error codes that caused operations to be
+	// retried, per operation type e.g. {"OpenDisk": {"32": 3}}
+	retriedErrors = expvar.NewMap("retried_errors")
+
+	// operationMetricsMu serializes the creation of the per operation maps.
+	operationMetricsMu sync.Mutex
+)
+
+// ObserveOperationAttempts records that `operation` succeeded after `attempts`
+// attempts, or that it failed after exhausting its attempts if !succeeded.
+func ObserveOperationAttempts(operation string, attempts int, succeeded bool) {
+	bucket := "failed"
+	if succeeded {
+		bucket = strconv.Itoa(attempts)
+	}
+	operationMap(operationAttempts, operation).Add(bucket, 1)
+}
+
+// ObserveRetriedError records that `operation` was retried because it failed
+// with the Windows error code `errorCode`.
+func ObserveRetriedError(operation string, errorCode string) {
+	operationMap(retriedErrors, operation).Add(errorCode, 1)
+}
+
+func operationMap(m *expvar.Map, operation string) *expvar.Map {
+	if v, ok := m.Get(operation).(*expvar.Map); ok {
+		return v
+	}
+	operationMetricsMu.Lock()
+	defer operationMetricsMu.Unlock()
+	if v, ok := m.Get(operation).(*expvar.Map); ok {
+		return v
+	}
+	v := new(expvar.Map).Init()
+	m.Set(operation, v)
+	return v
+}
@@ -0,0 +1,71 @@
+package nodelabels
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+)
+
+// LabelPrefix is the prefix of the labels with the storage capabilities of the node.
+const LabelPrefix = "storage.csi-proxy.io/"
+
+const (
+	// LabelReFS is "true" if volumes can be formatted with ReFS on the node
+	LabelReFS = LabelPrefix + "refs"
+	// LabelMPIO is "true" if Multipath I/O is enabled on the node
+	LabelMPIO = LabelPrefix + "mpio"
+	// LabelNVMe is "true" if the node has NVMe disks
+	LabelNVMe = LabelPrefix + "nvme"
+	// LabelMaxDisks is the maximum number of data disks that can be attached to the node
+	LabelMaxDisks = LabelPrefix + "max-disks"
+)
+
+// StorageCapabilities are the storage capabilities of a node.
+type StorageCapabilities struct {
+	ReFS bool
+	MPIO bool
+	NVMe bool
+	// MaxDisks is the maximum number of data disks that can be attached, it's unknown if 0
+	MaxDisks int
+}
+
+// DetectStorageCapabilities detects the storage capabilities of the node from the drivers
+// installed in the Windows directory `systemRoot` and the attached disks `disks`, `maxDisks`
+// is the configured maximum number of data disks.
+func DetectStorageCapabilities(systemRoot string, disks []shared.DiskInfo, maxDisks int) StorageCapabilities {
+	drivers := filepath.Join(systemRoot, "System32", "drivers")
+	capabilities := StorageCapabilities{
+		// the ReFS and the MPIO drivers are only installed with their features
+		ReFS:     fileExists(filepath.Join(drivers, "refs.sys")),
+		MPIO:     fileExists(filepath.Join(drivers, "mpio.sys")),
+		MaxDisks: maxDisks,
+	}
+	for _, disk := range disks {
+		if disk.BusType == "NVMe" {
+			capabilities.NVMe = true
+			break
+		}
+	}
+	return capabilities
+}
+
+// Labels returns the node labels of the capabilities, every label is set so that the stale
+// values published earlier are overwritten.
+func (c StorageCapabilities) Labels() map[string]string {
+	labels := map[string]string{
+		LabelReFS: strconv.FormatBool(c.ReFS),
+		LabelMPIO: strconv.FormatBool(c.MPIO),
+		LabelNVMe: strconv.FormatBool(c.NVMe),
+	}
+	if c.MaxDisks > 0 {
+		labels[LabelMaxDisks] = strconv.Itoa(c.MaxDisks)
+	}
+	return labels
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
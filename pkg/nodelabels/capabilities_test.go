@@ -0,0 +1,47 @@
+package nodelabels
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+)
+
+func TestDetectStorageCapabilities(t *testing.T) {
+	systemRoot, err := ioutil.TempDir("", "nodelabels")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(systemRoot)
+	drivers := filepath.Join(systemRoot, "System32", "drivers")
+	if err := os.MkdirAll(drivers, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(drivers, "refs.sys"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	disks := []shared.DiskInfo{{Number: 0, BusType: "SAS"}, {Number: 1, BusType: "NVMe"}}
+	capabilities := DetectStorageCapabilities(systemRoot, disks, 16)
+	expected := StorageCapabilities{ReFS: true, NVMe: true, MaxDisks: 16}
+	if capabilities != expected {
+		t.Errorf("expected %+v, got %+v", expected, capabilities)
+	}
+	expectedLabels := map[string]string{
+		LabelReFS:     "true",
+		LabelMPIO:     "false",
+		LabelNVMe:     "true",
+		LabelMaxDisks: "16",
+	}
+	if labels := capabilities.Labels(); fmt.Sprint(labels) != fmt.Sprint(expectedLabels) {
+		t.Errorf("expected labels %v, got %v", expectedLabels, labels)
+	}
+
+	capabilities = DetectStorageCapabilities(systemRoot, disks[:1], 0)
+	if _, ok := capabilities.Labels()[LabelMaxDisks]; ok || capabilities.NVMe {
+		t.Errorf("expected no NVMe and no max disks, got %+v", capabilities)
+	}
+}
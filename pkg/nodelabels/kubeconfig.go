@@ -0,0 +1,176 @@
+package nodelabels
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// kubeconfig is the subset of a kubeconfig file used to reach the API server, only the
+// certificates and the bearer tokens are supported to authenticate.
+type kubeconfig struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthority     string `yaml:"certificate-authority"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+			InsecureSkipTLSVerify    bool   `yaml:"insecure-skip-tls-verify"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			ClientCertificate     string `yaml:"client-certificate"`
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKey             string `yaml:"client-key"`
+			ClientKeyData         string `yaml:"client-key-data"`
+			Token                 string `yaml:"token"`
+			TokenFile             string `yaml:"tokenFile"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+// kubeClient sends requests to the API server of a kubeconfig.
+type kubeClient struct {
+	server     string
+	httpClient *http.Client
+	token      string
+	// tokenFile is read for every request, the projected tokens are rotated
+	tokenFile string
+}
+
+// newKubeClient creates a client for the current context of the kubeconfig file `file`.
+func newKubeClient(file string) (*kubeClient, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading the kubeconfig %s: %v", file, err)
+	}
+	config := kubeconfig{}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("error parsing the kubeconfig %s: %v", file, err)
+	}
+	// the relative paths are relative to the directory of the kubeconfig
+	dir := filepath.Dir(file)
+	resolve := func(path string) string {
+		if path == "" || filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(dir, path)
+	}
+
+	contextName := config.CurrentContext
+	if contextName == "" && len(config.Contexts) == 1 {
+		contextName = config.Contexts[0].Name
+	}
+	clusterName, userName := "", ""
+	found := false
+	for _, c := range config.Contexts {
+		if c.Name == contextName {
+			clusterName, userName, found = c.Context.Cluster, c.Context.User, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("context %q not found in the kubeconfig %s", contextName, file)
+	}
+
+	client := &kubeClient{}
+	tlsConfig := &tls.Config{}
+	found = false
+	for _, c := range config.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		found = true
+		client.server = strings.TrimSuffix(c.Cluster.Server, "/")
+		tlsConfig.InsecureSkipVerify = c.Cluster.InsecureSkipTLSVerify
+		ca, err := readData(c.Cluster.CertificateAuthorityData, resolve(c.Cluster.CertificateAuthority))
+		if err != nil {
+			return nil, fmt.Errorf("error reading the certificate authority of the cluster %q: %v", clusterName, err)
+		}
+		if ca != nil {
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(ca) {
+				return nil, fmt.Errorf("invalid certificate authority of the cluster %q", clusterName)
+			}
+		}
+		break
+	}
+	if !found {
+		return nil, fmt.Errorf("cluster %q not found in the kubeconfig %s", clusterName, file)
+	}
+	if client.server == "" {
+		return nil, fmt.Errorf("no server for the cluster %q in the kubeconfig %s", clusterName, file)
+	}
+
+	for _, u := range config.Users {
+		if u.Name != userName {
+			continue
+		}
+		cert, err := readData(u.User.ClientCertificateData, resolve(u.User.ClientCertificate))
+		if err != nil {
+			return nil, fmt.Errorf("error reading the client certificate of the user %q: %v", userName, err)
+		}
+		key, err := readData(u.User.ClientKeyData, resolve(u.User.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("error reading the client key of the user %q: %v", userName, err)
+		}
+		if cert != nil || key != nil {
+			pair, err := tls.X509KeyPair(cert, key)
+			if err != nil {
+				return nil, fmt.Errorf("invalid client certificate of the user %q: %v", userName, err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{pair}
+		}
+		client.token = u.User.Token
+		client.tokenFile = resolve(u.User.TokenFile)
+		break
+	}
+
+	client.httpClient = &http.Client{
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		Timeout:   30 * time.Second,
+	}
+	return client, nil
+}
+
+// readData returns the base64 decoded `data` if set, the content of the file `path` otherwise,
+// nil if neither is set.
+func readData(data, path string) ([]byte, error) {
+	if data != "" {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	if path != "" {
+		return ioutil.ReadFile(path)
+	}
+	return nil, nil
+}
+
+// bearerToken returns the token used to authenticate, empty if there is none.
+func (c *kubeClient) bearerToken() (string, error) {
+	if c.tokenFile == "" {
+		return c.token, nil
+	}
+	token, err := ioutil.ReadFile(c.tokenFile)
+	if err != nil {
+		return "", fmt.Errorf("error reading the token file %s: %v", c.tokenFile, err)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
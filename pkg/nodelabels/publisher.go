@@ -0,0 +1,99 @@
+package nodelabels
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// Publisher publishes the storage capabilities of the node as labels of its Node object.
+type Publisher struct {
+	client   *kubeClient
+	nodeName string
+	// detect detects the storage capabilities, it's called before every publication
+	detect func() (StorageCapabilities, error)
+}
+
+// NewPublisher creates a publisher of the capabilities detected with `detect` to the Node
+// `nodeName` of the cluster of the kubeconfig file `kubeconfigFile`.
+func NewPublisher(kubeconfigFile, nodeName string, detect func() (StorageCapabilities, error)) (*Publisher, error) {
+	if nodeName == "" {
+		return nil, fmt.Errorf("node name is empty")
+	}
+	client, err := newKubeClient(kubeconfigFile)
+	if err != nil {
+		return nil, err
+	}
+	return &Publisher{
+		client:   client,
+		nodeName: nodeName,
+		detect:   detect,
+	}, nil
+}
+
+// Publish detects the storage capabilities and sets the labels of the Node.
+func (p *Publisher) Publish(ctx context.Context) error {
+	capabilities, err := p.detect()
+	if err != nil {
+		return fmt.Errorf("error detecting the storage capabilities: %v", err)
+	}
+	// a merge patch only changes the labels that are set
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": capabilities.Labels(),
+		},
+	}
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	nodeURL := fmt.Sprintf("%s/api/v1/nodes/%s", p.client.server, url.PathEscape(p.nodeName))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, nodeURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("Accept", "application/json")
+	token, err := p.client.bearerToken()
+	if err != nil {
+		return err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := p.client.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error patching the node %s: %v", p.nodeName, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("error patching the node %s: %s: %s", p.nodeName, resp.Status, bytes.TrimSpace(message))
+	}
+	klog.V(2).Infof("Published the storage capabilities of the node %s: %+v", p.nodeName, capabilities)
+	return nil
+}
+
+// Run publishes the storage capabilities now and then every `interval` until `ctx` is done,
+// the capabilities change when features are installed and disks are attached.
+func (p *Publisher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := p.Publish(ctx); err != nil {
+			klog.Errorf("failed publishing the storage capabilities %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package nodelabels
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+current-context: node
+clusters:
+- name: cluster
+  cluster:
+    server: %s
+contexts:
+- name: other
+  context:
+    cluster: other
+    user: other
+- name: node
+  context:
+    cluster: cluster
+    user: node
+users:
+- name: node
+  user:
+    tokenFile: token
+`
+
+func TestPublish(t *testing.T) {
+	var patched map[string]map[string]map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method != http.MethodPatch || r.URL.Path != "/api/v1/nodes/node-1":
+			http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusNotFound)
+		case r.Header.Get("Content-Type") != "application/merge-patch+json":
+			http.Error(w, "unexpected content type", http.StatusUnsupportedMediaType)
+		case r.Header.Get("Authorization") != "Bearer secret":
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+		default:
+			if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, "{}")
+		}
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "nodelabels")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	kubeconfigFile := filepath.Join(dir, "kubeconfig")
+	if err := ioutil.WriteFile(kubeconfigFile, []byte(fmt.Sprintf(testKubeconfig, server.URL)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// the token file is relative to the kubeconfig
+	if err := ioutil.WriteFile(filepath.Join(dir, "token"), []byte("secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	detect := func() (StorageCapabilities, error) {
+		return StorageCapabilities{MPIO: true, MaxDisks: 8}, nil
+	}
+	publisher, err := NewPublisher(kubeconfigFile, "node-1", detect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := publisher.Publish(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]string{LabelReFS: "false", LabelMPIO: "true", LabelNVMe: "false", LabelMaxDisks: "8"}
+	if labels := patched["metadata"]["labels"]; fmt.Sprint(labels) != fmt.Sprint(expected) {
+		t.Errorf("expected labels %v, got %v", expected, labels)
+	}
+
+	publisher, err = NewPublisher(kubeconfigFile, "node-2", detect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := publisher.Publish(context.Background()); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestNewKubeClientErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nodelabels")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	testCases := []struct {
+		name       string
+		kubeconfig string
+	}{
+		{
+			name:       "missing context",
+			kubeconfig: strings.Replace(testKubeconfig, "current-context: node", "current-context: missing", 1),
+		},
+		{
+			name:       "missing cluster",
+			kubeconfig: strings.Replace(testKubeconfig, "- name: cluster", "- name: another", 1),
+		},
+		{
+			name:       "no server",
+			kubeconfig: strings.Replace(testKubeconfig, "server: %s", "server: \"\"", 1),
+		},
+		{
+			name:       "invalid certificate authority",
+			kubeconfig: strings.Replace(testKubeconfig, "server: %s", "server: https://localhost\n    certificate-authority-data: aW52YWxpZA==", 1),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			file := filepath.Join(dir, "kubeconfig")
+			if err := ioutil.WriteFile(file, []byte(strings.Replace(tc.kubeconfig, "%s", "https://localhost", 1)), 0600); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := newKubeClient(file); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
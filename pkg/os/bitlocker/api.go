@@ -0,0 +1,114 @@
+package bitlocker
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+)
+
+// Implements the BitLocker OS API calls with the BitLocker cmdlets. The
+// secrets of the key protectors are passed in environment variables so that
+// they aren't part of the command line or of the errors.
+
+type API interface {
+	// EnableBitLocker starts the encryption of a volume and adds the key protector `protector`.
+	EnableBitLocker(volumeID string, protector KeyProtector, method EncryptionMethod, usedSpaceOnly bool) (*AddedKeyProtector, error)
+	// UnlockVolume unlocks a volume with the key protector `protector`.
+	UnlockVolume(volumeID string, protector KeyProtector) error
+	// GetBitLockerStatus gets the BitLocker status of a volume.
+	GetBitLockerStatus(volumeID string) (*Status, error)
+}
+
+type BitLockerAPI struct{}
+
+var _ API = &BitLockerAPI{}
+
+func New() BitLockerAPI {
+	return BitLockerAPI{}
+}
+
+// securePassword converts the secret of a key protector to the SecureString expected by the cmdlets.
+const securePassword = `(ConvertTo-SecureString -String $Env:bitlockersecret -AsPlainText -Force)`
+
+func (BitLockerAPI) EnableBitLocker(volumeID string, protector KeyProtector, method EncryptionMethod, usedSpaceOnly bool) (*AddedKeyProtector, error) {
+	cmdLine := fmt.Sprintf(`$volume = Enable-BitLocker -MountPoint $Env:bitlockervolume -EncryptionMethod %s`, method)
+	if usedSpaceOnly {
+		cmdLine += " -UsedSpaceOnly"
+	}
+	switch protector.Type {
+	case KeyProtectorTypePassword:
+		cmdLine += " -PasswordProtector -Password " + securePassword
+	case KeyProtectorTypeRecoveryPassword:
+		cmdLine += " -RecoveryPasswordProtector"
+		if protector.Secret != "" {
+			cmdLine += " -RecoveryPassword $Env:bitlockersecret"
+		}
+	default:
+		return nil, fmt.Errorf("unknown key protector type %q", protector.Type)
+	}
+	// the warning about saving the recovery password would be mixed with the JSON output
+	cmdLine += " -WarningAction SilentlyContinue -ErrorAction Stop" +
+		fmt.Sprintf(`; $protector = $volume.KeyProtector | Where-Object { [string]$_.KeyProtectorType -eq '%s' } | Select-Object -Last 1`, protector.Type) +
+		`; ConvertTo-Json @{KeyProtectorId = $protector.KeyProtectorId; RecoveryPassword = [string]$protector.RecoveryPassword}`
+
+	out, err := utils.RunPowershellCmd(cmdLine,
+		fmt.Sprintf("bitlockervolume=%s", volumeID),
+		fmt.Sprintf("bitlockersecret=%s", protector.Secret),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error enabling BitLocker on volume %s. cmd: %s, output: %s, error: %v", volumeID, utils.CommandText(cmdLine), string(out), err)
+	}
+
+	added := &AddedKeyProtector{}
+	if err := json.Unmarshal(out, added); err != nil {
+		return nil, fmt.Errorf("error parsing the key protector of volume %s. cmd: %s, error: %v", volumeID, utils.CommandText(cmdLine), err)
+	}
+	return added, nil
+}
+
+func (BitLockerAPI) UnlockVolume(volumeID string, protector KeyProtector) error {
+	cmdLine := `Unlock-BitLocker -MountPoint $Env:bitlockervolume`
+	switch protector.Type {
+	case KeyProtectorTypePassword:
+		cmdLine += " -Password " + securePassword
+	case KeyProtectorTypeRecoveryPassword:
+		cmdLine += " -RecoveryPassword $Env:bitlockersecret"
+	default:
+		return fmt.Errorf("unknown key protector type %q", protector.Type)
+	}
+	cmdLine += " -ErrorAction Stop"
+
+	out, err := utils.RunPowershellCmd(cmdLine,
+		fmt.Sprintf("bitlockervolume=%s", volumeID),
+		fmt.Sprintf("bitlockersecret=%s", protector.Secret),
+	)
+	if err != nil {
+		return fmt.Errorf("error unlocking volume %s. cmd: %s, output: %s, error: %v", volumeID, utils.CommandText(cmdLine), string(out), err)
+	}
+	return nil
+}
+
+func (BitLockerAPI) GetBitLockerStatus(volumeID string) (*Status, error) {
+	// the enums are converted to strings, ConvertTo-Json outputs their values
+	cmdLine := `Get-BitLockerVolume -MountPoint $Env:bitlockervolume -ErrorAction Stop | Select-Object ` + strings.Join([]string{
+		`@{n='VolumeStatus';e={[string]$_.VolumeStatus}}`,
+		`@{n='ProtectionStatus';e={[string]$_.ProtectionStatus}}`,
+		`@{n='LockStatus';e={[string]$_.LockStatus}}`,
+		`EncryptionPercentage`,
+		`@{n='EncryptionMethod';e={[string]$_.EncryptionMethod}}`,
+		`@{n='KeyProtectorTypes';e={@($_.KeyProtector | ForEach-Object { [string]$_.KeyProtectorType })}}`,
+	}, ", ") + ` | ConvertTo-Json`
+
+	out, err := utils.RunPowershellCmd(cmdLine, fmt.Sprintf("bitlockervolume=%s", volumeID))
+	if err != nil {
+		return nil, fmt.Errorf("error getting the BitLocker status of volume %s. cmd: %s, output: %s, error: %v", volumeID, utils.CommandText(cmdLine), string(out), err)
+	}
+
+	status := &Status{}
+	if err := json.Unmarshal(out, status); err != nil {
+		return nil, fmt.Errorf("error parsing the BitLocker status of volume %s. cmd: %s, output: %s, error: %v", volumeID, utils.CommandText(cmdLine), string(out), err)
+	}
+	return status, nil
+}
@@ -0,0 +1,59 @@
+package bitlocker
+
+// KeyProtectorType is the type of a key protector, named like the parameters of
+// Enable-BitLocker and Unlock-BitLocker.
+type KeyProtectorType string
+
+const (
+	KeyProtectorTypePassword         KeyProtectorType = "Password"
+	KeyProtectorTypeRecoveryPassword KeyProtectorType = "RecoveryPassword"
+)
+
+// KeyProtector is a key protector that unlocks a volume.
+type KeyProtector struct {
+	Type KeyProtectorType
+
+	// Secret is the password or the recovery password of the key protector, a
+	// recovery password is generated if it's empty.
+	Secret string
+}
+
+// EncryptionMethod is the -EncryptionMethod of Enable-BitLocker.
+type EncryptionMethod string
+
+const (
+	EncryptionMethodXtsAes128 EncryptionMethod = "XtsAes128"
+	EncryptionMethodXtsAes256 EncryptionMethod = "XtsAes256"
+	EncryptionMethodAes128    EncryptionMethod = "Aes128"
+	EncryptionMethodAes256    EncryptionMethod = "Aes256"
+)
+
+// AddedKeyProtector is the key protector added to a volume by EnableBitLocker.
+type AddedKeyProtector struct {
+	KeyProtectorId string
+
+	// RecoveryPassword is the recovery password of the key protector, empty if
+	// it isn't a recovery password.
+	RecoveryPassword string
+}
+
+// Status is the BitLocker status of a volume.
+type Status struct {
+	// Encryption status e.g. FullyDecrypted, EncryptionInProgress or FullyEncrypted
+	VolumeStatus string
+
+	// Protection state i.e. On, Off or Unknown
+	ProtectionStatus string
+
+	// Lock state i.e. Locked or Unlocked
+	LockStatus string
+
+	// Percentage of the volume that's encrypted
+	EncryptionPercentage float64
+
+	// Encryption method e.g. XtsAes128, None if the volume isn't encrypted
+	EncryptionMethod string
+
+	// Types of the key protectors of the volume e.g. Password
+	KeyProtectorTypes []string
+}
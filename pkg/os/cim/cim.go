@@ -0,0 +1,128 @@
+package cim
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Decodes the instances of the CIM (WMI) classes queried by the OS APIs with
+// PowerShell e.g. `ConvertTo-Json @(Get-Disk | Select Number, Location)`.
+//
+// The properties of a class change between Windows builds, Select-Object
+// outputs null for a property that doesn't exist in the running build and
+// encoding/json would silently leave the zero value in its field. Fields of
+// properties that may be missing are pointers so that callers handle their
+// absence explicitly, a missing property of a non pointer field is an error.
+
+// MissingPropertyError is returned when a required property of a CIM class is
+// null or isn't in the output of a query.
+type MissingPropertyError struct {
+	Class    string
+	Property string
+}
+
+func (e *MissingPropertyError) Error() string {
+	return fmt.Sprintf("property %s of %s is missing, it may not be available in this version of Windows", e.Property, e.Class)
+}
+
+// Unmarshal decodes the output of ConvertTo-Json for instances of the CIM class
+// `class` into `v`, a pointer to a struct or to a slice of structs. `properties`
+// are the properties selected by the query, they must be set in every instance
+// unless their field is a pointer.
+func Unmarshal(data []byte, class string, v interface{}, properties ...string) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("cannot decode %s into %T, a non-nil pointer is required", class, v)
+	}
+
+	data = bytes.TrimSpace(data)
+	var instances []map[string]json.RawMessage
+	t := rv.Elem().Type()
+	switch t.Kind() {
+	case reflect.Slice:
+		t = t.Elem()
+		if len(data) == 0 {
+			// ConvertTo-Json doesn't output anything for an empty pipeline
+			data = []byte("[]")
+		}
+		if data[0] == '{' {
+			// ConvertTo-Json outputs a single object instead of an array with
+			// one element if its input isn't wrapped with @()
+			data = append(append([]byte("["), data...), ']')
+		}
+		if err := json.Unmarshal(data, &instances); err != nil {
+			return fmt.Errorf("error decoding %s instances. output: %s, error: %v", class, string(data), err)
+		}
+	case reflect.Struct:
+		var instance map[string]json.RawMessage
+		if err := json.Unmarshal(data, &instance); err != nil {
+			return fmt.Errorf("error decoding %s instance. output: %s, error: %v", class, string(data), err)
+		}
+		instances = append(instances, instance)
+	default:
+		return fmt.Errorf("cannot decode %s into %T, a struct or a slice of structs is required", class, v)
+	}
+	if t.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot decode %s into %T, a struct or a slice of structs is required", class, v)
+	}
+
+	for _, property := range properties {
+		field, ok := fieldByProperty(t, property)
+		if !ok {
+			return fmt.Errorf("%s doesn't have a field for property %s of %s", t, property, class)
+		}
+		if field.Type.Kind() == reflect.Ptr {
+			continue
+		}
+		for _, instance := range instances {
+			if value, ok := lookup(instance, property); !ok || string(value) == "null" {
+				return &MissingPropertyError{Class: class, Property: property}
+			}
+		}
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("error decoding %s. output: %s, error: %v", class, string(data), err)
+	}
+	return nil
+}
+
+// StringProperty returns a calculated property for Select-Object that converts
+// the enum property `name` to the name of its value e.g. "GPT" instead of 1.
+// Unlike `[string]$_.Property` it keeps null if the property is missing.
+func StringProperty(name string) string {
+	return fmt.Sprintf("@{n='%s';e={if ($null -ne $_.%s) {[string]$_.%s}}}", name, name, name)
+}
+
+// fieldByProperty returns the field of `t` that encoding/json decodes the
+// property `property` into.
+func fieldByProperty(t reflect.Type, property string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Name
+		if tag := strings.Split(f.Tag.Get("json"), ",")[0]; tag != "" {
+			name = tag
+		}
+		if strings.EqualFold(name, property) {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// lookup returns the value of `property` in `instance`, property names are
+// matched case insensitively like encoding/json does.
+func lookup(instance map[string]json.RawMessage, property string) (json.RawMessage, bool) {
+	if value, ok := instance[property]; ok {
+		return value, true
+	}
+	for k, value := range instance {
+		if strings.EqualFold(k, property) {
+			return value, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,287 @@
+package cim_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/cim"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/iscsi"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/vss"
+)
+
+// windowsVersions are the Windows versions with fixtures in testdata, each
+// fixture is the output of ConvertTo-Json for the properties selected by the OS
+// APIs.
+var windowsVersions = []string{"windows-2012r2", "windows-2016", "windows-2019", "windows-2022"}
+
+func fixture(t *testing.T, version, class string) []byte {
+	data, err := ioutil.ReadFile(filepath.Join("testdata", version, class+".json"))
+	if err != nil {
+		t.Fatalf("error reading fixture: %v", err)
+	}
+	return data
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestUnmarshalDisk(t *testing.T) {
+	for _, version := range windowsVersions {
+		t.Run(version, func(t *testing.T) {
+			var disks []cim.Disk
+			err := cim.Unmarshal(fixture(t, version, "MSFT_Disk"), "MSFT_Disk", &disks,
+				"Number", "Path", "Location", "SerialNumber", "BusType", "Size", "PartitionStyle", "IsBoot", "IsSystem")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(disks) != 2 {
+				t.Fatalf("expected 2 disks, got %d", len(disks))
+			}
+			if disks[0].Number != 0 || disks[0].Size != 107374182400 || disks[0].BusType != "SCSI" || disks[0].PartitionStyle != "GPT" {
+				t.Errorf("unexpected disk: %+v", disks[0])
+			}
+			if disks[0].Location == nil || *disks[0].Location != "PCI Slot 3 : Adapter 0 : Port 0 : Target 1 : LUN 0" {
+				t.Errorf("unexpected location of disk 0: %v", disks[0].Location)
+			}
+			if !disks[0].IsBoot || !disks[0].IsSystem || disks[1].IsBoot || disks[1].IsSystem {
+				t.Errorf("expected only disk 0 to be the boot and system disk, got %+v", disks)
+			}
+			if disks[1].SerialNumber != nil {
+				t.Errorf("expected the serial number of the virtual disk to be nil, got %q", *disks[1].SerialNumber)
+			}
+		})
+	}
+}
+
+func TestUnmarshalVolume(t *testing.T) {
+	expected := map[string]cim.Volume{
+		"windows-2012r2": {
+			Size:            1056964608,
+			SizeRemaining:   1040187392,
+			FileSystemLabel: "data",
+			FileSystem:      stringPtr("NTFS"),
+			HealthStatus:    "Healthy",
+		},
+	}
+	for _, version := range []string{"windows-2016", "windows-2019", "windows-2022"} {
+		expected[version] = cim.Volume{
+			Size:              1056964608,
+			SizeRemaining:     1040187392,
+			FileSystemLabel:   "data",
+			FileSystem:        stringPtr("NTFS"),
+			FileSystemType:    stringPtr("NTFS"),
+			HealthStatus:      "Healthy",
+			OperationalStatus: stringPtr("OK"),
+		}
+	}
+
+	for _, version := range windowsVersions {
+		t.Run(version, func(t *testing.T) {
+			var volume cim.Volume
+			err := cim.Unmarshal(fixture(t, version, "MSFT_Volume"), "MSFT_Volume", &volume,
+				"SizeRemaining", "Size", "FileSystem", "FileSystemType", "FileSystemLabel", "HealthStatus", "OperationalStatus")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(volume, expected[version]) {
+				t.Errorf("expected %+v, got %+v", expected[version], volume)
+			}
+		})
+	}
+}
+
+func TestUnmarshalPartitionSupportedSize(t *testing.T) {
+	for _, version := range windowsVersions {
+		t.Run(version, func(t *testing.T) {
+			var size cim.PartitionSupportedSize
+			err := cim.Unmarshal(fixture(t, version, "MSFT_Partition.GetSupportedSize"), "MSFT_Partition.GetSupportedSize", &size, "SizeMin", "SizeMax")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if size.SizeMin != 33554432 || size.SizeMax != 2146418688 {
+				t.Errorf("expected SizeMin 33554432 and SizeMax 2146418688, got %+v", size)
+			}
+		})
+	}
+}
+
+func TestUnmarshalPartition(t *testing.T) {
+	// the reserved partitions don't have access paths
+	data := `[{"DiskNumber": 1, "PartitionNumber": 1, "Size": 16777216, "AccessPaths": null},
+	{"DiskNumber": 1, "PartitionNumber": 2, "Size": 1073741824, "AccessPaths": ["C:\\mnt\\", "\\\\?\\Volume{1}\\"]}]`
+	var partitions []cim.Partition
+	err := cim.Unmarshal([]byte(data), "MSFT_Partition", &partitions, "DiskNumber", "PartitionNumber", "Size")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []cim.Partition{
+		{DiskNumber: 1, PartitionNumber: 1, Size: 16777216},
+		{DiskNumber: 1, PartitionNumber: 2, Size: 1073741824, AccessPaths: []string{`C:\mnt\`, `\\?\Volume{1}\`}},
+	}
+	if !reflect.DeepEqual(partitions, expected) {
+		t.Errorf("expected %+v, got %+v", expected, partitions)
+	}
+}
+
+func TestUnmarshalStorageReliabilityCounter(t *testing.T) {
+	// the counters that the device doesn't report are null
+	data := `{"Temperature": 38, "TemperatureMax": 70, "Wear": 2, "ReadErrorsTotal": 0, "ReadErrorsCorrected": null,
+	"ReadErrorsUncorrected": null, "WriteErrorsTotal": null, "WriteErrorsCorrected": null, "WriteErrorsUncorrected": null,
+	"PowerOnHours": 1234, "ReadLatencyMax": 97, "WriteLatencyMax": 211, "FlushLatencyMax": 4}`
+	var counters []cim.StorageReliabilityCounter
+	if err := cim.Unmarshal([]byte(data), "MSFT_StorageReliabilityCounter", &counters); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(counters) != 1 {
+		t.Fatalf("expected 1 instance, got %d", len(counters))
+	}
+	c := counters[0]
+	if c.Temperature == nil || *c.Temperature != 38 || c.Wear == nil || *c.Wear != 2 || c.ReadErrorsTotal == nil || *c.ReadErrorsTotal != 0 {
+		t.Errorf("unexpected counters %+v", c)
+	}
+	if c.ReadErrorsCorrected != nil || c.WriteErrorsTotal != nil {
+		t.Errorf("expected the unreported counters to be nil, got %+v", c)
+	}
+}
+
+func TestUnmarshalTargetPortal(t *testing.T) {
+	for _, version := range windowsVersions {
+		t.Run(version, func(t *testing.T) {
+			var portals []iscsi.TargetPortal
+			err := cim.Unmarshal(fixture(t, version, "MSFT_iSCSITargetPortal"), "MSFT_iSCSITargetPortal", &portals,
+				"TargetPortalAddress", "TargetPortalPortNumber")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			expected := []iscsi.TargetPortal{{Address: "10.0.0.4", Port: 3260}}
+			if !reflect.DeepEqual(portals, expected) {
+				t.Errorf("expected %+v, got %+v", expected, portals)
+			}
+		})
+	}
+}
+
+func TestUnmarshalShadowCopy(t *testing.T) {
+	for _, version := range windowsVersions {
+		t.Run(version, func(t *testing.T) {
+			var shadowCopies []vss.ShadowCopy
+			err := cim.Unmarshal(fixture(t, version, "Win32_ShadowCopy"), "Win32_ShadowCopy", &shadowCopies,
+				"ID", "VolumeName", "DeviceObject", "InstallDate")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			expected := []vss.ShadowCopy{{
+				ID:           "{D5C8A3E6-3B8B-4D0F-9A2E-6B1C1F0A7E21}",
+				VolumeID:     `\\?\Volume{452e318a-5cde-421e-9831-b9853c521012}\`,
+				DeviceObject: `\\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy1`,
+				CreationTime: time.Date(2021, 10, 15, 11, 3, 27, 459055000, time.UTC),
+			}}
+			if !reflect.DeepEqual(shadowCopies, expected) {
+				t.Errorf("expected %+v, got %+v", expected, shadowCopies)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	testCases := []struct {
+		name          string
+		data          string
+		properties    []string
+		expectDisks   []cim.Disk
+		expectMissing string
+		expectError   bool
+	}{
+		{
+			name:        "single object",
+			data:        `{"Number": 1, "Size": 1024}`,
+			properties:  []string{"Number", "Size"},
+			expectDisks: []cim.Disk{{Number: 1, Size: 1024}},
+		},
+		{
+			name:        "empty output",
+			data:        "\r\n",
+			properties:  []string{"Number"},
+			expectDisks: []cim.Disk{},
+		},
+		{
+			name:          "missing required property",
+			data:          `[{"Number": 1, "Size": 1024}, {"Number": 2}]`,
+			properties:    []string{"Number", "Size"},
+			expectMissing: "Size",
+		},
+		{
+			name:          "null required property",
+			data:          `[{"Number": 1, "BusType": null}]`,
+			properties:    []string{"Number", "BusType"},
+			expectMissing: "BusType",
+		},
+		{
+			name:        "null optional property",
+			data:        `[{"Number": 1, "Location": null}]`,
+			properties:  []string{"Number", "Location"},
+			expectDisks: []cim.Disk{{Number: 1}},
+		},
+		{
+			name:        "property without field",
+			data:        `[{"Number": 1, "IsClustered": false}]`,
+			properties:  []string{"Number", "IsClustered"},
+			expectError: true,
+		},
+		{
+			name:        "invalid output",
+			data:        `Get-Disk : No MSFT_Disk objects found`,
+			properties:  []string{"Number"},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			disks := []cim.Disk{}
+			err := cim.Unmarshal([]byte(tc.data), "MSFT_Disk", &disks, tc.properties...)
+			var missing *cim.MissingPropertyError
+			switch {
+			case tc.expectMissing != "":
+				if !errors.As(err, &missing) || missing.Property != tc.expectMissing {
+					t.Fatalf("expected property %s to be missing, got error %v", tc.expectMissing, err)
+				}
+			case tc.expectError:
+				if err == nil {
+					t.Fatalf("expected an error")
+				}
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case !reflect.DeepEqual(disks, tc.expectDisks):
+				t.Errorf("expected %+v, got %+v", tc.expectDisks, disks)
+			}
+		})
+	}
+}
+
+func TestEnumName(t *testing.T) {
+	testCases := []struct {
+		property string
+		value    string
+		expected string
+	}{
+		{property: "PartitionStyle", value: "2", expected: "GPT"},
+		{property: "PartitionStyle", value: "GPT", expected: "GPT"},
+		{property: "NewDiskPolicy", value: "2", expected: "OfflineShared"},
+		{property: "BusType", value: "15", expected: "File Backed Virtual"},
+		{property: "HealthStatus", value: "0", expected: "Healthy"},
+		{property: "HealthStatus", value: "42", expected: "42"},
+		{property: "OperationalStatus", value: "53263", expected: "Full Repair Needed"},
+		{property: "FileSystemType", value: "15", expected: "ReFS"},
+		{property: "Size", value: "1", expected: "1"},
+	}
+	for _, tc := range testCases {
+		if name := cim.EnumName(tc.property, tc.value); name != tc.expected {
+			t.Errorf("expected %s %s to be %q, got %q", tc.property, tc.value, tc.expected, name)
+		}
+	}
+}
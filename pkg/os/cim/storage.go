@@ -0,0 +1,83 @@
+package cim
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Queries of the classes of the Storage module (e.g. MSFT_Disk) with the cmdlets
+// of the CimCmdlets module, they replace the cmdlets of the Storage module (e.g.
+// Get-Disk) on the minimal SKUs of Windows that don't have it. The instances have
+// the same properties as the ones of the Storage cmdlets but their enums are
+// numbers, EnumName converts them to the names output by the Storage cmdlets.
+
+// StorageNamespace is the namespace of the classes of the Storage module.
+const StorageNamespace = "root/Microsoft/Windows/Storage"
+
+// StorageQuery returns the Get-CimInstance invocation that gets the instances of
+// the storage class `class` matching the WQL condition `filter`, all the instances
+// if it's empty.
+func StorageQuery(class, filter string) string {
+	if filter == "" {
+		return fmt.Sprintf("Get-CimInstance -Namespace %s -ClassName %s", StorageNamespace, class)
+	}
+	return fmt.Sprintf("Get-CimInstance -Namespace %s -ClassName %s -Filter \"%s\"", StorageNamespace, class, filter)
+}
+
+// StorageMethod returns the pipeline stage that calls the method `method` of the
+// storage class instances of the pipeline with the hashtable `arguments` e.g.
+// "@{PartitionStyle=[uint16]2}". The methods return an error code instead of
+// failing, the stage fails with the code like the Storage cmdlets do.
+func StorageMethod(method, arguments string) string {
+	if arguments == "" {
+		arguments = "@{}"
+	}
+	return fmt.Sprintf("ForEach-Object { $r = Invoke-CimMethod -InputObject $_ -MethodName %s -Arguments %s; "+
+		"if ($r.ReturnValue -ne 0) { throw \"%s failed with StorageWMI $($r.ReturnValue)\" } }", method, arguments, method)
+}
+
+// enumNames are the names of the values of the enum properties of the storage
+// classes, as output by the Storage cmdlets.
+var enumNames = map[string]map[uint64]string{
+	"BusType": {
+		0: "Unknown", 1: "SCSI", 2: "ATAPI", 3: "ATA", 4: "1394", 5: "SSA", 6: "Fibre Channel", 7: "USB",
+		8: "RAID", 9: "iSCSI", 10: "SAS", 11: "SATA", 12: "SD", 13: "MMC", 14: "Virtual",
+		15: "File Backed Virtual", 16: "Storage Spaces", 17: "NVMe",
+	},
+	"PartitionStyle": {
+		0: "RAW", 1: "MBR", 2: "GPT",
+	},
+	"NewDiskPolicy": {
+		0: "Unknown", 1: "OnlineAll", 2: "OfflineShared", 3: "OfflineAll", 4: "OfflineInternal",
+	},
+	"FileSystemType": {
+		0: "Unknown", 2: "UFS", 3: "HFS", 4: "FAT", 5: "FAT16", 6: "FAT32", 7: "NTFS4", 8: "NTFS5", 9: "XFS",
+		10: "AFS", 11: "EXT2", 12: "EXT3", 13: "ReiserFS", 14: "NTFS", 15: "ReFS",
+		0x8000: "CSVFS_NTFS", 0x8001: "CSVFS_ReFS",
+	},
+	"HealthStatus": {
+		0: "Healthy", 1: "Warning", 2: "Unhealthy", 5: "Unknown",
+	},
+	"OperationalStatus": {
+		0: "Unknown", 1: "Other", 2: "OK", 3: "Degraded", 4: "Stressed", 5: "Predictive Failure", 6: "Error",
+		7: "Non-Recoverable Error", 8: "Starting", 9: "Stopping", 10: "Stopped", 11: "In Service",
+		12: "No Contact", 13: "Lost Communication", 14: "Aborted", 15: "Dormant",
+		16: "Supporting Entity in Error", 17: "Completed", 18: "Power Mode",
+		// file system states of MSFT_Volume
+		0xD00D: "Scan Needed", 0xD00E: "Spot Fix Needed", 0xD00F: "Full Repair Needed",
+	},
+}
+
+// EnumName returns the name of the value `value` of the enum property `property`
+// of a storage class e.g. "GPT" for PartitionStyle 2. Names and unknown values are
+// returned unchanged.
+func EnumName(property, value string) string {
+	n, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return value
+	}
+	if name, ok := enumNames[property][n]; ok {
+		return name
+	}
+	return value
+}
@@ -0,0 +1,89 @@
+package cim
+
+// The CIM classes queried by the OS APIs. Only the properties selected by a
+// query are set, the fields of properties that aren't available in every
+// supported Windows build are pointers.
+
+// Disk is an instance of MSFT_Disk, the class of the disks returned by Get-Disk.
+type Disk struct {
+	Number uint32
+	Path   string
+	// Location isn't set by every storage provider e.g. it's null for some
+	// virtual disks.
+	Location *string
+	// SerialNumber is null for virtual disks.
+	SerialNumber *string
+	// BusType and PartitionStyle are the names of the enum values, they must be
+	// selected with StringProperty.
+	BusType        string
+	Size           int64
+	PartitionStyle string
+	// IsBoot and IsSystem are set for the disks of the boot and system
+	// partitions i.e. the OS disks.
+	IsBoot   bool
+	IsSystem bool
+	// FriendlyName is the model of the disk.
+	FriendlyName string
+	IsOffline    bool
+	IsReadOnly   bool
+	// Signature is the signature of an MBR disk and Guid the GUID of a GPT
+	// disk, they're null or 0 for the other partition styles.
+	Signature *uint32
+	Guid      *string
+}
+
+// Volume is an instance of MSFT_Volume, the class of the volumes returned by
+// Get-Volume.
+type Volume struct {
+	Size            int64
+	SizeRemaining   int64
+	FileSystemLabel string
+	// FileSystem is the file system of the volume in Windows Server 2012 R2, it's
+	// deprecated by FileSystemType.
+	FileSystem *string
+	// FileSystemType, HealthStatus and OperationalStatus are the names of the enum
+	// values, they must be selected with StringProperty.
+	// FileSystemType and OperationalStatus were added in Windows Server 2016.
+	FileSystemType    *string
+	HealthStatus      string
+	OperationalStatus *string
+}
+
+// Partition is an instance of MSFT_Partition, the class of the partitions
+// returned by Get-Partition.
+type Partition struct {
+	DiskNumber      uint32
+	PartitionNumber uint32
+	Size            int64
+	// AccessPaths are the drive letters, the mount points and the volume GUID
+	// path of the volume of the partition, null if it has none e.g. for the
+	// reserved partitions.
+	AccessPaths []string
+}
+
+// PartitionSupportedSize is the output of MSFT_Partition.GetSupportedSize, the
+// method called by Get-PartitionSupportedSize.
+type PartitionSupportedSize struct {
+	SizeMin int64
+	SizeMax int64
+}
+
+// StorageReliabilityCounter is an instance of MSFT_StorageReliabilityCounter, the
+// class of the reliability (SMART) counters returned by Get-StorageReliabilityCounter.
+// Each counter is null if the device or its driver doesn't report it, e.g. virtual
+// disks report none of them.
+type StorageReliabilityCounter struct {
+	Temperature            *int64
+	TemperatureMax         *int64
+	Wear                   *int64
+	ReadErrorsTotal        *int64
+	ReadErrorsCorrected    *int64
+	ReadErrorsUncorrected  *int64
+	WriteErrorsTotal       *int64
+	WriteErrorsCorrected   *int64
+	WriteErrorsUncorrected *int64
+	PowerOnHours           *int64
+	ReadLatencyMax         *int64
+	WriteLatencyMax        *int64
+	FlushLatencyMax        *int64
+}
@@ -1,17 +1,19 @@
 package disk
 
 import (
+	"encoding/binary"
 	"encoding/hex"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"os/exec"
-	"regexp"
 	"strconv"
 	"strings"
 	"syscall"
+	"time"
 	"unsafe"
 
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/cim"
 	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
 	"k8s.io/klog/v2"
 )
 
@@ -20,35 +22,137 @@ var (
 )
 
 const (
-	IOCTL_STORAGE_GET_DEVICE_NUMBER = 0x2D1080
-	IOCTL_STORAGE_QUERY_PROPERTY    = 0x002d1400
+	IOCTL_STORAGE_GET_DEVICE_NUMBER      = 0x2D1080
+	IOCTL_STORAGE_QUERY_PROPERTY         = 0x002d1400
+	IOCTL_STORAGE_PERSISTENT_RESERVE_IN  = 0x2D5018
+	IOCTL_STORAGE_PERSISTENT_RESERVE_OUT = 0x2DD01C
+	IOCTL_SCSI_RESCAN_BUS                = 0x4101C
+	IOCTL_DISK_GET_DISK_ATTRIBUTES       = 0x700F0
+	IOCTL_DISK_SET_DISK_ATTRIBUTES       = 0x7C0F4
+	IOCTL_DISK_UPDATE_PROPERTIES         = 0x70140
+	IOCTL_DISK_GET_LENGTH_INFO           = 0x7405C
+	IOCTL_DISK_GET_DRIVE_LAYOUT_EX       = 0x70050
+	IOCTL_DISK_GET_CLUSTER_INFO          = 0x70214
+)
+
+// Attributes of GET_DISK_ATTRIBUTES and SET_DISK_ATTRIBUTES.
+const (
+	DISK_ATTRIBUTE_OFFLINE   = 0x1
+	DISK_ATTRIBUTE_READ_ONLY = 0x2
+)
+
+// DISK_CLUSTER_FLAG_ENABLED is the flag of DISK_CLUSTER_INFO of the disks used by a failover cluster.
+const DISK_CLUSTER_FLAG_ENABLED = 0x1
+
+// diskClusterInfo is DISK_CLUSTER_INFO, the output of IOCTL_DISK_GET_CLUSTER_INFO.
+type diskClusterInfo struct {
+	Version    uint32
+	_          uint32
+	Flags      uint64
+	AddMask    uint64
+	RemoveMask uint64
+}
+
+// getDiskAttributes is GET_DISK_ATTRIBUTES.
+type getDiskAttributes struct {
+	Version    uint32
+	Reserved1  uint32
+	Attributes uint64
+}
+
+// setDiskAttributes is SET_DISK_ATTRIBUTES.
+type setDiskAttributes struct {
+	Version        uint32
+	Persist        bool
+	Reserved1      [3]byte
+	Attributes     uint64
+	AttributesMask uint64
+	Reserved2      [4]uint32
+}
+
+// maxSCSIPorts is the number of SCSI port devices (\\.\ScsiN:) that are rescanned,
+// the port numbers can have gaps after adapters are removed.
+const maxSCSIPorts = 64
+
+// reservedPartitionGptType is the GPT type of the Microsoft Reserved Partition.
+const reservedPartitionGptType = "{e3c9e316-0b5c-4db8-817d-f92df00215ae}"
+
+const (
+	openDiskAttempts      = 5
+	openDiskRetryInterval = 200 * time.Millisecond
 )
 
 // API declares the interface exposed by the internal API
 type API interface {
 	// ListDiskLocations - constructs a map with the disk number as the key and the DiskLocation structure
 	// as the value. The DiskLocation struct has various fields like the Adapter, Bus, Target and LUNID.
-	ListDiskLocations() (map[uint32]shared.DiskLocation, error)
+	// Only the disks in `diskNumbers` are listed, all the disks are listed if it's nil.
+	ListDiskLocations(diskNumbers []uint32) (map[uint32]shared.DiskLocation, error)
 	// IsDiskInitialized returns true if the disk identified by `diskNumber` is initialized.
 	IsDiskInitialized(diskNumber uint32) (bool, error)
-	// InitializeDisk initializes the disk `diskNumber`
-	InitializeDisk(diskNumber uint32) error
+	// InitializeDisk initializes the disk `diskNumber` with the partition style `style`.
+	InitializeDisk(diskNumber uint32, style PartitionStyle) error
 	// BasicPartitionsExist checks if the disk `diskNumber` has any basic partitions.
 	BasicPartitionsExist(diskNumber uint32) (bool, error)
-	// CreateBasicPartition creates a partition in disk `diskNumber`
-	CreateBasicPartition(diskNumber uint32) error
-	// Rescan updates the host storage cache (re-enumerates disk, partition and volume objects)
+	// CreateBasicPartition creates a partition in disk `diskNumber` of `sizeBytes` bytes (the largest
+	// free space if 0) at `offsetBytes` bytes from the start of the disk (picked by Windows if 0), with
+	// the GPT type `gptType` (a basic data partition if empty).
+	CreateBasicPartition(diskNumber uint32, sizeBytes int64, offsetBytes int64, gptType string) error
+	// SetPartitionType sets the GPT type of the partition `partitionNumber` of the GPT disk `diskNumber`.
+	SetPartitionType(diskNumber uint32, partitionNumber uint32, gptType string) error
+	// DeletePartition removes the access paths (drive letters and mount points) of the partition
+	// `partitionNumber` of the disk `diskNumber` and deletes it, all the partitions of the disk are
+	// deleted if `partitionNumber` is 0.
+	DeletePartition(diskNumber uint32, partitionNumber uint32) error
+	// WipeDisk clears the initialized disk `diskNumber` i.e. removes its partitions, its data and OEM
+	// partitions too if `removeData` is set, and writes zeros to the whole disk if `zeroFill` is set.
+	WipeDisk(diskNumber uint32, removeData bool, zeroFill bool) error
+	// ConvertPartitionStyle converts the empty initialized disk `diskNumber` to the partition style `style`.
+	ConvertPartitionStyle(diskNumber uint32, style PartitionStyle) error
+	// Rescan rescans the SCSI buses and updates the host storage cache (re-enumerates disk, partition
+	// and volume objects), only the SCSI buses are rescanned when PowerShell is disabled.
 	Rescan() error
 	// GetDiskNumberByName gets a disk number by page83 ID (disk name)
 	GetDiskNumberByName(page83ID string) (uint32, error)
-	// ListDiskIDs list all disks by disk number.
-	ListDiskIDs() (map[uint32]shared.DiskIDs, error)
-	// GetDiskStats gets the disk stats of the disk `diskNumber`.
-	GetDiskStats(diskNumber uint32) (int64, error)
+	// ListDiskIDs list disks by disk number, only the disks in `diskNumbers` are listed,
+	// all the disks are listed if it's nil.
+	ListDiskIDs(diskNumbers []uint32) (map[uint32]shared.DiskIDs, error)
+	// ListDiskPage83IDs returns all the identifiers of the device identification VPD page of the disks,
+	// all the disks if `diskNumbers` is nil.
+	ListDiskPage83IDs(diskNumbers []uint32) (map[uint32][]shared.Page83ID, error)
+	// ListDisks lists the properties used to filter disks (bus type, size, partition style) of all the disks.
+	ListDisks() ([]shared.DiskInfo, error)
+	// GetDiskStats gets the size and the serial number of the disk `diskNumber`.
+	GetDiskStats(diskNumber uint32) (size int64, serialNumber string, err error)
+	// GetDiskSectorSizes returns the logical and the physical sector sizes of a disk in bytes e.g.
+	// 512 and 4096 for a 512e disk.
+	GetDiskSectorSizes(diskNumber uint32) (logical uint32, physical uint32, err error)
+	// GetReliabilityCounters returns the reliability (SMART) counters of the disk `diskNumber`.
+	GetReliabilityCounters(diskNumber uint32) (shared.ReliabilityCounters, error)
 	// SetDiskState sets the offline/online state of the disk `diskNumber`.
 	SetDiskState(diskNumber uint32, isOnline bool) error
 	// GetDiskState gets the offline/online state of the disk `diskNumber`.
 	GetDiskState(diskNumber uint32) (bool, error)
+	// SetDiskReadOnly makes the disk `diskNumber` read-only or writable.
+	SetDiskReadOnly(diskNumber uint32, readOnly bool) error
+	// GetDiskReadOnly returns true if the disk `diskNumber` is read-only.
+	GetDiskReadOnly(diskNumber uint32) (bool, error)
+	// BringDiskOnline brings the disk `diskNumber` online and makes it writable, for the disks left offline
+	// or read-only by the SAN policy.
+	BringDiskOnline(diskNumber uint32) error
+	// GetSanPolicy gets the SAN policy of the node.
+	GetSanPolicy() (SanPolicy, error)
+	// SetSanPolicy sets the SAN policy of the node.
+	SetSanPolicy(policy SanPolicy) error
+	// IsDiskClustered returns true if the disk `diskNumber` is a shared disk used by a cluster.
+	IsDiskClustered(diskNumber uint32) (bool, error)
+	// GetDiskReservation returns the key of the persistent reservation held on the disk `diskNumber`,
+	// reserved is false if the disk isn't reserved.
+	GetDiskReservation(diskNumber uint32) (key uint64, reserved bool, err error)
+	// ReserveDisk registers `key` and places a write exclusive persistent reservation with it on the disk `diskNumber`.
+	ReserveDisk(diskNumber uint32, key uint64) error
+	// ReleaseDisk releases the persistent reservation held with `key` on the disk `diskNumber` and unregisters `key`.
+	ReleaseDisk(diskNumber uint32, key uint64) error
 }
 
 // DiskAPI implements the OS API calls related to Disk Devices. All code here should be very simple
@@ -65,36 +169,44 @@ func New() DiskAPI {
 }
 
 func runExec(command string) ([]byte, error) {
-	cmd := exec.Command("powershell", "/c", command)
-	klog.V(4).Infof("Executing command: %q", cmd.String())
-	out, err := cmd.CombinedOutput()
-	return out, err
+	return utils.RunPowershellCmd(command)
+}
+
+// storageModule returns true if the cmdlets of the Storage module are available,
+// the disks are queried and changed with the CimCmdlets module otherwise.
+func storageModule() bool {
+	return utils.HasPowerShellModule(utils.ModuleStorage)
 }
 
 // ListDiskLocations - constructs a map with the disk number as the key and the DiskLocation structure
 // as the value. The DiskLocation struct has various fields like the Adapter, Bus, Target and LUNID.
-func (DiskAPI) ListDiskLocations() (map[uint32]shared.DiskLocation, error) {
+func (DiskAPI) ListDiskLocations(diskNumbers []uint32) (map[uint32]shared.DiskLocation, error) {
 	// sample response
 	// [{
 	//    "number":  0,
-	//    "location":  "PCI Slot 3 : Adapter 0 : Port 0 : Target 1 : LUN 0"
+	//    "location":  "PCI Slot 3 : Adapter 0 : Port 0 : Target 1 : LUN 0",
+	//    "serialNumber":  "6002248004e3f3ba1d6a2a3f4d2b5d9b"
 	// }, ...]
-	cmd := fmt.Sprintf("ConvertTo-Json @(Get-Disk | select Number, Location)")
+	cmd := fmt.Sprintf("ConvertTo-Json @(%s | select Number, Location, SerialNumber)", getDiskCmd(diskNumbers))
 	out, err := runExec(cmd)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list disk location. cmd: %q, output: %q, err %v", cmd, string(out), err)
+		return nil, fmt.Errorf("failed to list disk location. cmd: %q, output: %q, err %v", utils.CommandText(cmd), string(out), err)
 	}
 
-	var getDisk []map[string]interface{}
-	err = json.Unmarshal(out, &getDisk)
+	var disks []cim.Disk
+	err = cim.Unmarshal(out, "MSFT_Disk", &disks, "Number", "Location", "SerialNumber")
 	if err != nil {
 		return nil, err
 	}
 
 	m := make(map[uint32]shared.DiskLocation)
-	for _, v := range getDisk {
-		str := v["Location"].(string)
-		num := v["Number"].(float64)
+	for _, v := range disks {
+		if v.Location == nil {
+			klog.V(4).Infof("Disk %d doesn't have a location", v.Number)
+			continue
+		}
+		str := *v.Location
+		num := v.Number
 
 		found := false
 		s := strings.Split(str, ":")
@@ -108,6 +220,9 @@ func (DiskAPI) ListDiskLocations() (map[uint32]shared.DiskLocation, error) {
 					switch strings.TrimSpace(itemSplit[0]) {
 					case "Adapter":
 						d.Adapter = strings.TrimSpace(itemSplit[1])
+					case "Port":
+						// the port of the location is the bus (path ID) of the SCSI address
+						d.Bus = strings.TrimSpace(itemSplit[1])
 					case "Target":
 						d.Target = strings.TrimSpace(itemSplit[1])
 					case "LUN":
@@ -119,7 +234,10 @@ func (DiskAPI) ListDiskLocations() (map[uint32]shared.DiskLocation, error) {
 			}
 
 			if found {
-				m[uint32(num)] = d
+				if v.SerialNumber != nil {
+					d.SerialNumber = *v.SerialNumber
+				}
+				m[num] = d
 			}
 		}
 	}
@@ -127,16 +245,70 @@ func (DiskAPI) ListDiskLocations() (map[uint32]shared.DiskLocation, error) {
 }
 
 func (DiskAPI) Rescan() error {
+	if err := rescanSCSIBuses(); err != nil {
+		return err
+	}
+	if utils.PowerShellDisabled() {
+		return nil
+	}
+
 	cmd := "Update-HostStorageCache"
+	if !storageModule() {
+		cmd = fmt.Sprintf("$r = Invoke-CimMethod -Namespace %s -ClassName MSFT_StorageSetting -MethodName UpdateHostStorageCache; "+
+			"if ($r.ReturnValue -ne 0) { throw \"UpdateHostStorageCache failed with StorageWMI $($r.ReturnValue)\" }", cim.StorageNamespace)
+	}
+	// the SCSI buses were rescanned and the disks are queried natively, the cache of
+	// the Storage module is only refreshed for the cmdlets
 	out, err := runExec(cmd)
 	if err != nil {
-		return fmt.Errorf("error updating host storage cache output: %q, err: %v", string(out), err)
+		klog.Warningf("error updating host storage cache output: %q, err: %v", string(out), err)
+	}
+	return nil
+}
+
+// rescanSCSIBuses sends IOCTL_SCSI_RESCAN_BUS to the SCSI port devices so that the
+// LUNs attached to the SCSI adapters since the last scan are discovered, like the
+// rescan of diskpart. The ports that don't exist are skipped.
+func rescanSCSIBuses() error {
+	rescanned := 0
+	for port := 0; port < maxSCSIPorts; port++ {
+		path, err := syscall.UTF16PtrFromString(fmt.Sprintf(`\\.\Scsi%d:`, port))
+		if err != nil {
+			return err
+		}
+		h, err := syscall.CreateFile(path, syscall.GENERIC_READ|syscall.GENERIC_WRITE,
+			syscall.FILE_SHARE_READ|syscall.FILE_SHARE_WRITE, nil, syscall.OPEN_EXISTING, 0, 0)
+		if err == syscall.ERROR_FILE_NOT_FOUND || err == syscall.ERROR_PATH_NOT_FOUND {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error opening SCSI port %d: %w", port, err)
+		}
+		var bytes uint32
+		err = syscall.DeviceIoControl(h, IOCTL_SCSI_RESCAN_BUS, nil, 0, nil, 0, &bytes, nil)
+		syscall.CloseHandle(h)
+		if err != nil {
+			return fmt.Errorf("error rescanning SCSI port %d: %w", port, err)
+		}
+		rescanned++
 	}
+	klog.V(4).Infof("Rescanned %d SCSI ports", rescanned)
 	return nil
 }
 
+// IsDiskInitialized returns true if the disk `diskNumber` has a partition table, it's
+// read with IOCTL_DISK_GET_DRIVE_LAYOUT_EX and Get-Disk is only used if the IOCTL fails.
 func (DiskAPI) IsDiskInitialized(diskNumber uint32) (bool, error) {
+	layout, err := getDriveLayout(diskNumber)
+	if err == nil || utils.PowerShellDisabled() {
+		return err == nil && layout.PartitionStyle != PARTITION_STYLE_RAW, err
+	}
+	klog.V(4).Infof("Getting the partition style of disk %d natively failed, falling back to Get-Disk: %v", diskNumber, err)
+
 	cmd := fmt.Sprintf("Get-Disk -Number %d | Where partitionstyle -eq 'raw'", diskNumber)
+	if !storageModule() {
+		cmd = fmt.Sprintf("%s | Where PartitionStyle -eq 0", getDiskCmd([]uint32{diskNumber}))
+	}
 	out, err := runExec(cmd)
 	if err != nil {
 		return false, fmt.Errorf("error checking initialized status of disk %d: %v, %v", diskNumber, out, err)
@@ -148,8 +320,16 @@ func (DiskAPI) IsDiskInitialized(diskNumber uint32) (bool, error) {
 	return false, nil
 }
 
-func (DiskAPI) InitializeDisk(diskNumber uint32) error {
-	cmd := fmt.Sprintf("Initialize-Disk -Number %d -PartitionStyle GPT", diskNumber)
+func (DiskAPI) InitializeDisk(diskNumber uint32, style PartitionStyle) error {
+	value, ok := partitionStyleValues[style]
+	if !ok {
+		return fmt.Errorf("invalid partition style %q", style)
+	}
+	cmd := fmt.Sprintf("Initialize-Disk -Number %d -PartitionStyle %s", diskNumber, style)
+	if !storageModule() {
+		cmd = fmt.Sprintf("%s | %s", getDiskCmd([]uint32{diskNumber}),
+			cim.StorageMethod("Initialize", fmt.Sprintf("@{PartitionStyle=[uint16]%d}", value)))
+	}
 	out, err := runExec(cmd)
 	if err != nil {
 		return fmt.Errorf("error initializing disk %d: %v, %v", diskNumber, out, err)
@@ -157,8 +337,21 @@ func (DiskAPI) InitializeDisk(diskNumber uint32) error {
 	return nil
 }
 
+// BasicPartitionsExist returns true if the disk `diskNumber` has partitions other
+// than the Microsoft Reserved Partition, they're read with IOCTL_DISK_GET_DRIVE_LAYOUT_EX
+// and Get-Partition is only used if the IOCTL fails.
 func (DiskAPI) BasicPartitionsExist(diskNumber uint32) (bool, error) {
+	layout, err := getDriveLayout(diskNumber)
+	if err == nil || utils.PowerShellDisabled() {
+		return err == nil && layout.BasicPartitions > 0, err
+	}
+	klog.V(4).Infof("Getting the partitions of disk %d natively failed, falling back to Get-Partition: %v", diskNumber, err)
+
 	cmd := fmt.Sprintf("Get-Partition | Where DiskNumber -eq %d | Where Type -ne Reserved", diskNumber)
+	if !storageModule() {
+		cmd = fmt.Sprintf("%s | Where GptType -ne '%s'",
+			cim.StorageQuery("MSFT_Partition", fmt.Sprintf("DiskNumber=%d", diskNumber)), reservedPartitionGptType)
+	}
 	out, err := runExec(cmd)
 	if err != nil {
 		return false, fmt.Errorf("error checking presence of partitions on disk %d: %v, %v", diskNumber, out, err)
@@ -170,8 +363,25 @@ func (DiskAPI) BasicPartitionsExist(diskNumber uint32) (bool, error) {
 	return false, nil
 }
 
-func (DiskAPI) CreateBasicPartition(diskNumber uint32) error {
-	cmd := fmt.Sprintf("New-Partition -DiskNumber %d -UseMaximumSize", diskNumber)
+func (DiskAPI) CreateBasicPartition(diskNumber uint32, sizeBytes int64, offsetBytes int64, gptType string) error {
+	params := "-UseMaximumSize"
+	args := "UseMaximumSize=$true"
+	if sizeBytes > 0 {
+		params = fmt.Sprintf("-Size %d", sizeBytes)
+		args = fmt.Sprintf("Size=[uint64]%d", sizeBytes)
+	}
+	if offsetBytes > 0 {
+		params += fmt.Sprintf(" -Offset %d", offsetBytes)
+		args += fmt.Sprintf(";Offset=[uint64]%d", offsetBytes)
+	}
+	if gptType != "" {
+		params += fmt.Sprintf(" -GptType '%s'", gptType)
+		args += fmt.Sprintf(";GptType='%s'", gptType)
+	}
+	cmd := fmt.Sprintf("New-Partition -DiskNumber %d %s", diskNumber, params)
+	if !storageModule() {
+		cmd = fmt.Sprintf("%s | %s", getDiskCmd([]uint32{diskNumber}), cim.StorageMethod("CreatePartition", "@{"+args+"}"))
+	}
 	out, err := runExec(cmd)
 	if err != nil {
 		return fmt.Errorf("error creating parition on disk %d: %v, %v", diskNumber, out, err)
@@ -179,6 +389,90 @@ func (DiskAPI) CreateBasicPartition(diskNumber uint32) error {
 	return nil
 }
 
+func (DiskAPI) SetPartitionType(diskNumber uint32, partitionNumber uint32, gptType string) error {
+	cmd := fmt.Sprintf("Set-Partition -DiskNumber %d -PartitionNumber %d -GptType '%s'", diskNumber, partitionNumber, gptType)
+	if !storageModule() {
+		// the query doesn't fail like Set-Partition if the partition doesn't exist
+		cmd = fmt.Sprintf(`$p = @(%s); if ($p.Count -eq 0) { throw "partition %d of disk %d not found" }; $p | %s`,
+			cim.StorageQuery("MSFT_Partition", fmt.Sprintf("DiskNumber=%d AND PartitionNumber=%d", diskNumber, partitionNumber)),
+			partitionNumber, diskNumber, cim.StorageMethod("SetAttributes", fmt.Sprintf("@{GptType='%s'}", gptType)))
+	}
+	out, err := runExec(cmd)
+	if err != nil {
+		return fmt.Errorf("error setting the GPT type of partition %d of disk %d: %v, %v", partitionNumber, diskNumber, string(out), err)
+	}
+	return nil
+}
+
+func (DiskAPI) DeletePartition(diskNumber uint32, partitionNumber uint32) error {
+	query := fmt.Sprintf("Get-Partition | Where DiskNumber -eq %d", diskNumber)
+	if partitionNumber != 0 {
+		query += fmt.Sprintf(" | Where PartitionNumber -eq %d", partitionNumber)
+	}
+	removeAccessPath := "Remove-PartitionAccessPath -AccessPath $path"
+	remove := "Remove-Partition -Confirm:$false"
+	if !storageModule() {
+		filter := fmt.Sprintf("DiskNumber=%d", diskNumber)
+		if partitionNumber != 0 {
+			filter += fmt.Sprintf(" AND PartitionNumber=%d", partitionNumber)
+		}
+		query = cim.StorageQuery("MSFT_Partition", filter)
+		removeAccessPath = cim.StorageMethod("RemoveAccessPath", "@{AccessPath=$path}")
+		remove = cim.StorageMethod("DeleteObject", "")
+	}
+	// the volume device ID paths (\\?\Volume{GUID}\) go away with the partition
+	cmd := fmt.Sprintf(`$partitions = @(%s)
+if ($partitions.Count -eq 0 -and %d -ne 0) { throw "partition %d of disk %d not found" }
+foreach ($p in $partitions) {
+	foreach ($path in $p.AccessPaths) {
+		if ($path -notlike '\\?\Volume*') { $p | %s }
+	}
+	$p | %s
+}`, query, partitionNumber, partitionNumber, diskNumber, removeAccessPath, remove)
+	out, err := runExec(cmd)
+	if err != nil {
+		return fmt.Errorf("error deleting partition %d of disk %d: %v, %v", partitionNumber, diskNumber, string(out), err)
+	}
+	return nil
+}
+
+func (DiskAPI) ConvertPartitionStyle(diskNumber uint32, style PartitionStyle) error {
+	value, ok := partitionStyleValues[style]
+	if !ok {
+		return fmt.Errorf("invalid partition style %q", style)
+	}
+	cmd := fmt.Sprintf("Set-Disk -Number %d -PartitionStyle %s", diskNumber, style)
+	if !storageModule() {
+		cmd = fmt.Sprintf("%s | %s", getDiskCmd([]uint32{diskNumber}),
+			cim.StorageMethod("ConvertStyle", fmt.Sprintf("@{PartitionStyle=[uint16]%d}", value)))
+	}
+	out, err := runExec(cmd)
+	if err != nil {
+		return fmt.Errorf("error converting disk %d to the %s partition style: %v, %v", diskNumber, style, out, err)
+	}
+	return nil
+}
+
+func (DiskAPI) WipeDisk(diskNumber uint32, removeData bool, zeroFill bool) error {
+	params := ""
+	if removeData {
+		params += " -RemoveData -RemoveOEM"
+	}
+	if zeroFill {
+		params += " -ZeroOutEntireDisk"
+	}
+	cmd := fmt.Sprintf("Clear-Disk -Number %d%s -Confirm:$false", diskNumber, params)
+	if !storageModule() {
+		cmd = fmt.Sprintf("%s | %s", getDiskCmd([]uint32{diskNumber}), cim.StorageMethod("Clear",
+			fmt.Sprintf("@{RemoveData=$%t;RemoveOEM=$%t;ZeroOutEntireDisk=$%t}", removeData, removeData, zeroFill)))
+	}
+	out, err := runExec(cmd)
+	if err != nil {
+		return fmt.Errorf("error wiping disk %d: %v, %v", diskNumber, string(out), err)
+	}
+	return nil
+}
+
 func (imp DiskAPI) GetDiskNumberByName(page83ID string) (uint32, error) {
 	diskNumber, err := imp.GetDiskNumberWithID(page83ID)
 	return diskNumber, err
@@ -194,26 +488,34 @@ func (DiskAPI) GetDiskNumber(disk syscall.Handle) (uint32, error) {
 	return devNum.DeviceNumber, err
 }
 
-func (DiskAPI) GetDiskPage83ID(disk syscall.Handle) (string, error) {
+// queryDeviceIDDescriptor returns the STORAGE_DEVICE_ID_DESCRIPTOR of a disk, the storage
+// class driver builds it from the device identification VPD page (0x83) of the disk.
+func queryDeviceIDDescriptor(disk syscall.Handle) (*StorageDeviceIDDescriptor, error) {
 	query := StoragePropertyQuery{}
 
 	bufferSize := uint32(4 * 1024)
 	buffer := make([]byte, 4*1024)
 	var size uint32
-	var n uint32
-	var m uint16
 
 	query.QueryType = PropertyStandardQuery
 	query.PropertyID = StorageDeviceIDProperty
 
-	querySize := uint32(unsafe.Sizeof(query.PropertyID)) + uint32(unsafe.Sizeof(query.QueryType)) + uint32(unsafe.Sizeof(query.Byte))
-	querySize = uint32(unsafe.Sizeof(query))
+	querySize := uint32(unsafe.Sizeof(query))
 	err := syscall.DeviceIoControl(disk, IOCTL_STORAGE_QUERY_PROPERTY, (*byte)(unsafe.Pointer(&query)), querySize, (*byte)(unsafe.Pointer(&buffer[0])), bufferSize, &size, nil)
 	if err != nil {
-		return "", fmt.Errorf("IOCTL_STORAGE_QUERY_PROPERTY failed: %v", err)
+		return nil, fmt.Errorf("IOCTL_STORAGE_QUERY_PROPERTY failed: %v", err)
 	}
+	return (*StorageDeviceIDDescriptor)(unsafe.Pointer(&buffer[0])), nil
+}
 
-	devIDDesc := (*StorageDeviceIDDescriptor)(unsafe.Pointer(&buffer[0]))
+func (DiskAPI) GetDiskPage83ID(disk syscall.Handle) (string, error) {
+	var n uint32
+	var m uint16
+
+	devIDDesc, err := queryDeviceIDDescriptor(disk)
+	if err != nil {
+		return "", err
+	}
 
 	pID := (*StorageIdentifier)(unsafe.Pointer(&devIDDesc.Identifiers[0]))
 
@@ -236,16 +538,99 @@ func (DiskAPI) GetDiskPage83ID(disk syscall.Handle) (string, error) {
 	return "", nil
 }
 
+// storageIdentifierTypes are the names of the types of the page 83 identifiers.
+var storageIdentifierTypes = map[StorageIdentifierType]string{
+	StorageIdTypeVendorSpecific:           "VendorSpecific",
+	StorageIDTypeVendorID:                 "T10",
+	StorageIDTypeEUI64:                    "EUI64",
+	StorageIDTypeFCPHName:                 "NAA",
+	StorageIDTypePortRelative:             "RelativeTargetPort",
+	StorageIDTypeTargetPortGroup:          "TargetPortGroup",
+	StorageIDTypeLogicalUnitGroup:         "LogicalUnitGroup",
+	StorageIDTypeMD5LogicalUnitIdentifier: "MD5LogicalUnit",
+	StorageIDTypeScsiNameString:           "SCSIName",
+}
+
+// storageAssociationTypes are the names of the associations of the page 83 identifiers.
+var storageAssociationTypes = map[StorageAssociationType]string{
+	StorageIDAssocDevice: "Device",
+	StorageIDAssocPort:   "Port",
+	StorageIDAssocTarget: "Target",
+}
+
+// GetDiskPage83IDs returns all the identifiers of the device identification VPD page of a disk,
+// unlike GetDiskPage83ID which returns the first identifier of the logical unit.
+func (DiskAPI) GetDiskPage83IDs(disk syscall.Handle) ([]shared.Page83ID, error) {
+	devIDDesc, err := queryDeviceIDDescriptor(disk)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []shared.Page83ID{}
+	pID := (*StorageIdentifier)(unsafe.Pointer(&devIDDesc.Identifiers[0]))
+	for n := uint32(0); n < devIDDesc.NumberOfIdentifiers; n++ {
+		value := make([]byte, pID.IdentifierSize)
+		for m := range value {
+			value[m] = *(*byte)(unsafe.Pointer(uintptr(unsafe.Pointer(&pID.Identifier[0])) + uintptr(m)))
+		}
+		id := shared.Page83ID{
+			Type:        storageIdentifierTypes[pID.Type],
+			Association: storageAssociationTypes[pID.Association],
+		}
+		if pID.CodeSet == StorageIDCodeSetBinary {
+			id.Value = hex.EncodeToString(value)
+		} else {
+			// the ASCII and UTF-8 identifiers may be padded with NUL characters
+			id.Value = strings.TrimRight(string(value), "\x00")
+		}
+		ids = append(ids, id)
+		pID = (*StorageIdentifier)(unsafe.Pointer(uintptr(unsafe.Pointer(pID)) + uintptr(pID.NextOffset)))
+	}
+	return ids, nil
+}
+
+// ListDiskPage83IDs returns all the identifiers of the device identification VPD page of the
+// disks `diskNumbers` by disk number.
+func (imp DiskAPI) ListDiskPage83IDs(diskNumbers []uint32) (map[uint32][]shared.Page83ID, error) {
+	cmd := fmt.Sprintf("ConvertTo-Json @(%s | Select Path)", getDiskCmd(diskNumbers))
+	out, err := runExec(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("Could not query disk paths")
+	}
+
+	var disks []cim.Disk
+	err = cim.Unmarshal(out, "MSFT_Disk", &disks, "Path")
+	if err != nil {
+		return nil, err
+	}
+
+	m := make(map[uint32][]shared.Page83ID)
+	for i := range disks {
+		h, err := openDisk(disks[i].Path, syscall.O_RDONLY)
+		if err != nil {
+			return nil, err
+		}
+		diskNumber, err := imp.GetDiskNumber(h)
+		if err == nil {
+			m[diskNumber], err = imp.GetDiskPage83IDs(h)
+		}
+		syscall.Close(h)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
 func (imp DiskAPI) GetDiskNumberWithID(page83ID string) (uint32, error) {
-	cmd := "ConvertTo-Json @(Get-Disk | Select Path)"
+	cmd := fmt.Sprintf("ConvertTo-Json @(%s | Select Path)", getDiskCmd(nil))
 	out, err := runExec(cmd)
 	if err != nil {
 		return 0, fmt.Errorf("Could not query disk paths")
 	}
 
-	outString := string(out)
-	disks := []Disk{}
-	err = json.Unmarshal([]byte(outString), &disks)
+	var disks []cim.Disk
+	err = cim.Unmarshal(out, "MSFT_Disk", &disks, "Path")
 	if err != nil {
 		return 0, err
 	}
@@ -265,11 +650,11 @@ func (imp DiskAPI) GetDiskNumberWithID(page83ID string) (uint32, error) {
 }
 
 func (imp DiskAPI) GetDiskNumberAndPage83ID(path string) (uint32, string, error) {
-	h, err := syscall.Open(path, syscall.O_RDONLY, 0)
-	defer syscall.Close(h)
+	h, err := openDisk(path, syscall.O_RDONLY)
 	if err != nil {
 		return 0, "", err
 	}
+	defer syscall.Close(h)
 
 	diskNumber, err := imp.GetDiskNumber(h)
 	if err != nil {
@@ -286,7 +671,7 @@ func (imp DiskAPI) GetDiskNumberAndPage83ID(path string) (uint32, string, error)
 
 // ListDiskIDs - constructs a map with the disk number as the key and the DiskID structure
 // as the value. The DiskID struct has a field for the page83 ID.
-func (imp DiskAPI) ListDiskIDs() (map[uint32]shared.DiskIDs, error) {
+func (imp DiskAPI) ListDiskIDs(diskNumbers []uint32) (map[uint32]shared.DiskIDs, error) {
 	// sample response
 	// [
 	// {
@@ -297,15 +682,14 @@ func (imp DiskAPI) ListDiskIDs() (map[uint32]shared.DiskIDs, error) {
 	//     "Path":  "\\\\?\\scsi#disk\u0026ven_msft\u0026prod_virtual_disk#2\u00261f4adffe\u00260\u0026000001#{53f56307-b6bf-11d0-94f2-00a0c91efb8b}",
 	//     "SerialNumber":  null
 	// }, ]
-	cmd := "ConvertTo-Json @(Get-Disk | Select Path, SerialNumber)"
+	cmd := fmt.Sprintf("ConvertTo-Json @(%s | Select Path, SerialNumber)", getDiskCmd(diskNumbers))
 	out, err := runExec(cmd)
 	if err != nil {
 		return nil, fmt.Errorf("Could not query disk paths")
 	}
 
-	outString := string(out)
-	disks := []Disk{}
-	err = json.Unmarshal([]byte(outString), &disks)
+	var disks []cim.Disk
+	err = cim.Unmarshal(out, "MSFT_Disk", &disks, "Path", "SerialNumber")
 	if err != nil {
 		return nil, err
 	}
@@ -318,59 +702,653 @@ func (imp DiskAPI) ListDiskIDs() (map[uint32]shared.DiskIDs, error) {
 			return nil, err
 		}
 
+		var serialNumber string
+		if disks[i].SerialNumber != nil {
+			serialNumber = *disks[i].SerialNumber
+		}
 		m[diskNumber] = shared.DiskIDs{
 			Page83:       page83,
-			SerialNumber: disks[i].SerialNumber,
+			SerialNumber: serialNumber,
 		}
 	}
 
 	return m, nil
 }
 
-func (imp DiskAPI) GetDiskStats(diskNumber uint32) (int64, error) {
-	cmd := fmt.Sprintf("(Get-Disk -Number %d).Size", diskNumber)
+// ListDisks lists the number, the friendly name, the serial number, the bus type, the size,
+// the partition style and the state of all the disks.
+func (DiskAPI) ListDisks() ([]shared.DiskInfo, error) {
+	// sample response
+	// [{
+	//    "Number":  0,
+	//    "FriendlyName":  "Msft Virtual Disk",
+	//    "SerialNumber":  null,
+	//    "BusType":  "SAS",
+	//    "Size":  107374182400,
+	//    "PartitionStyle":  "GPT",
+	//    "IsBoot":  true,
+	//    "IsSystem":  true,
+	//    "IsOffline":  false,
+	//    "IsReadOnly":  false,
+	//    "Signature":  null,
+	//    "Guid":  "{0d6a3e9c-0a5b-4c9e-8e3f-4b1f2a7c9d10}"
+	// }, ...]
+	cmd := fmt.Sprintf("ConvertTo-Json @(%s | select Number, FriendlyName, SerialNumber, %s, Size, %s, IsBoot, IsSystem, IsOffline, IsReadOnly, Signature, Guid)",
+		getDiskCmd(nil), cim.StringProperty("BusType"), cim.StringProperty("PartitionStyle"))
 	out, err := runExec(cmd)
-	if err != nil || len(out) == 0 {
-		return -1, fmt.Errorf("error getting size of disk. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disks. cmd: %q, output: %q, err %v", utils.CommandText(cmd), string(out), err)
 	}
 
-	reg, err := regexp.Compile("[^0-9]+")
+	var disks []cim.Disk
+	err = cim.Unmarshal(out, "MSFT_Disk", &disks, "Number", "FriendlyName", "BusType", "Size", "PartitionStyle",
+		"IsBoot", "IsSystem", "IsOffline", "IsReadOnly")
 	if err != nil {
-		return -1, fmt.Errorf("error compiling regex. err: %v", err)
+		return nil, err
+	}
+	infos := make([]shared.DiskInfo, len(disks))
+	for i, d := range disks {
+		infos[i] = shared.DiskInfo{
+			Number:         d.Number,
+			BusType:        cim.EnumName("BusType", d.BusType),
+			Size:           d.Size,
+			PartitionStyle: cim.EnumName("PartitionStyle", d.PartitionStyle),
+			IsOSDisk:       d.IsBoot || d.IsSystem,
+			FriendlyName:   d.FriendlyName,
+			IsOffline:      d.IsOffline,
+			IsReadOnly:     d.IsReadOnly,
+		}
+		if d.SerialNumber != nil {
+			infos[i].SerialNumber = *d.SerialNumber
+		}
+		if d.Signature != nil {
+			infos[i].Signature = *d.Signature
+		}
+		if d.Guid != nil {
+			infos[i].GUID = *d.Guid
+		}
+	}
+	return infos, nil
+}
+
+// getDiskCmd returns the Get-Disk cmdlet invocation restricted to `diskNumbers`,
+// all the disks are returned by it if `diskNumbers` is nil. The MSFT_Disk instances
+// are queried with Get-CimInstance if the Storage module isn't available.
+func getDiskCmd(diskNumbers []uint32) string {
+	if !storageModule() {
+		conditions := make([]string, len(diskNumbers))
+		for i, n := range diskNumbers {
+			conditions[i] = fmt.Sprintf("Number=%d", n)
+		}
+		return cim.StorageQuery("MSFT_Disk", strings.Join(conditions, " OR "))
 	}
-	diskSizeOutput := reg.ReplaceAllString(string(out), "")
+	if diskNumbers == nil {
+		return "Get-Disk"
+	}
+	numbers := make([]string, len(diskNumbers))
+	for i, n := range diskNumbers {
+		numbers[i] = strconv.FormatUint(uint64(n), 10)
+	}
+	return fmt.Sprintf("Get-Disk -Number %s", strings.Join(numbers, ","))
+}
 
-	diskSize, err := strconv.ParseInt(diskSizeOutput, 10, 64)
+// GetDiskStats returns the size and the serial number of the disk `diskNumber`, they're
+// read with IOCTL_DISK_GET_LENGTH_INFO and IOCTL_STORAGE_QUERY_PROPERTY and Get-Disk is
+// only used if the IOCTLs fail.
+func (imp DiskAPI) GetDiskStats(diskNumber uint32) (int64, string, error) {
+	size, serialNumber, err := getDiskLengthAndSerialNumber(diskNumber)
+	if err == nil || utils.PowerShellDisabled() {
+		return size, serialNumber, err
+	}
+	klog.V(4).Infof("Getting the size of disk %d natively failed, falling back to Get-Disk: %v", diskNumber, err)
 
-	if err != nil {
-		return -1, fmt.Errorf("error parsing size of disk. cmd: %s, output: %s, error: %v", cmd, diskSizeOutput, err)
+	cmd := fmt.Sprintf("%s | Select Size, SerialNumber | ConvertTo-Json", getDiskCmd([]uint32{diskNumber}))
+	out, err := runExec(cmd)
+	if err != nil || len(out) == 0 {
+		return -1, "", fmt.Errorf("error getting size of disk. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
 	}
 
-	return diskSize, nil
+	var d cim.Disk
+	err = cim.Unmarshal(out, "MSFT_Disk", &d, "Size", "SerialNumber")
+	if err != nil {
+		return -1, "", err
+	}
+	serialNumber = ""
+	if d.SerialNumber != nil {
+		serialNumber = *d.SerialNumber
+	}
+	return d.Size, serialNumber, nil
 }
 
+// SetDiskState brings the disk `diskNumber` online or takes it offline with
+// IOCTL_DISK_SET_DISK_ATTRIBUTES, Set-Disk is only used if the IOCTL fails.
 func (imp DiskAPI) SetDiskState(diskNumber uint32, isOnline bool) error {
+	err := setDiskAttribute(diskNumber, DISK_ATTRIBUTE_OFFLINE, !isOnline)
+	if err == nil || utils.PowerShellDisabled() {
+		return err
+	}
+	klog.V(4).Infof("Setting the state of disk %d natively failed, falling back to Set-Disk: %v", diskNumber, err)
+
 	cmd := fmt.Sprintf("(Get-Disk -Number %d) | Set-Disk -IsOffline $%t", diskNumber, !isOnline)
+	if !storageModule() {
+		method := "Offline"
+		if isOnline {
+			method = "Online"
+		}
+		cmd = fmt.Sprintf("%s | %s", getDiskCmd([]uint32{diskNumber}), cim.StorageMethod(method, ""))
+	}
 	out, err := runExec(cmd)
 	if err != nil {
-		return fmt.Errorf("error setting disk attach state. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+		return fmt.Errorf("error setting disk attach state. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
 	}
 
 	return nil
 }
 
+// GetDiskState returns true if the disk `diskNumber` is online, it's read with
+// IOCTL_DISK_GET_DISK_ATTRIBUTES and Get-Disk is only used if the IOCTL fails.
 func (imp DiskAPI) GetDiskState(diskNumber uint32) (bool, error) {
-	cmd := fmt.Sprintf("(Get-Disk -Number %d) | Select-Object -ExpandProperty IsOffline", diskNumber)
+	isOffline, err := hasDiskAttribute(diskNumber, DISK_ATTRIBUTE_OFFLINE)
+	if err == nil || utils.PowerShellDisabled() {
+		return !isOffline, err
+	}
+	klog.V(4).Infof("Getting the state of disk %d natively failed, falling back to Get-Disk: %v", diskNumber, err)
+
+	cmd := fmt.Sprintf("(%s) | Select-Object -ExpandProperty IsOffline", getDiskCmd([]uint32{diskNumber}))
 	out, err := runExec(cmd)
 	if err != nil {
-		return false, fmt.Errorf("error getting disk state. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+		return false, fmt.Errorf("error getting disk state. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
 	}
 
 	sout := strings.TrimSpace(string(out))
-	isOffline, err := strconv.ParseBool(sout)
+	isOffline, err = strconv.ParseBool(sout)
 	if err != nil {
 		return false, fmt.Errorf("error parsing disk state. output: %s, error: %v", sout, err)
 	}
 
 	return !isOffline, nil
 }
+
+// GetDiskSectorSizes queries the access alignment of the disk, the storage class driver
+// builds it from the READ CAPACITY (16) data of the disk.
+func (DiskAPI) GetDiskSectorSizes(diskNumber uint32) (uint32, uint32, error) {
+	h, err := openPhysicalDrive(diskNumber, syscall.O_RDONLY)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer syscall.Close(h)
+
+	query := StoragePropertyQuery{
+		PropertyID: StorageAccessAlignmentProperty,
+		QueryType:  PropertyStandardQuery,
+	}
+	var alignment StorageAccessAlignmentDescriptor
+	var size uint32
+	err = syscall.DeviceIoControl(h, IOCTL_STORAGE_QUERY_PROPERTY, (*byte)(unsafe.Pointer(&query)), uint32(unsafe.Sizeof(query)),
+		(*byte)(unsafe.Pointer(&alignment)), uint32(unsafe.Sizeof(alignment)), &size, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("IOCTL_STORAGE_QUERY_PROPERTY failed for the access alignment of disk %d: %w", diskNumber, err)
+	}
+	return alignment.BytesPerLogicalSector, alignment.BytesPerPhysicalSector, nil
+}
+
+func (DiskAPI) GetReliabilityCounters(diskNumber uint32) (shared.ReliabilityCounters, error) {
+	// sample response
+	// [{
+	//    "Temperature":  38,
+	//    "TemperatureMax":  70,
+	//    "Wear":  2,
+	//    "ReadErrorsTotal":  0,
+	//    "ReadErrorsCorrected":  null,
+	//    ...
+	//    "PowerOnHours":  1234,
+	//    "ReadLatencyMax":  97,
+	//    "WriteLatencyMax":  211,
+	//    "FlushLatencyMax":  4
+	// }]
+	counters := "Get-StorageReliabilityCounter"
+	if !storageModule() {
+		counters = "Get-CimAssociatedInstance -ResultClassName MSFT_StorageReliabilityCounter"
+	}
+	cmd := fmt.Sprintf("ConvertTo-Json @(%s | %s | Select Temperature, TemperatureMax, Wear, "+
+		"ReadErrorsTotal, ReadErrorsCorrected, ReadErrorsUncorrected, WriteErrorsTotal, WriteErrorsCorrected, WriteErrorsUncorrected, "+
+		"PowerOnHours, ReadLatencyMax, WriteLatencyMax, FlushLatencyMax)", getDiskCmd([]uint32{diskNumber}), counters)
+	out, err := runExec(cmd)
+	if err != nil {
+		return shared.ReliabilityCounters{}, fmt.Errorf("error getting the reliability counters of disk %d: %v, %v", diskNumber, string(out), err)
+	}
+	var instances []cim.StorageReliabilityCounter
+	if err := cim.Unmarshal(out, "MSFT_StorageReliabilityCounter", &instances); err != nil {
+		return shared.ReliabilityCounters{}, err
+	}
+	if len(instances) == 0 {
+		return shared.ReliabilityCounters{}, &ReliabilityCountersNotSupportedError{DiskNumber: diskNumber}
+	}
+	c := instances[0]
+	return shared.ReliabilityCounters{
+		TemperatureCelsius:     reliabilityCounter(c.Temperature),
+		MaxTemperatureCelsius:  reliabilityCounter(c.TemperatureMax),
+		WearPercentage:         reliabilityCounter(c.Wear),
+		ReadErrorsTotal:        reliabilityCounter(c.ReadErrorsTotal),
+		ReadErrorsCorrected:    reliabilityCounter(c.ReadErrorsCorrected),
+		ReadErrorsUncorrected:  reliabilityCounter(c.ReadErrorsUncorrected),
+		WriteErrorsTotal:       reliabilityCounter(c.WriteErrorsTotal),
+		WriteErrorsCorrected:   reliabilityCounter(c.WriteErrorsCorrected),
+		WriteErrorsUncorrected: reliabilityCounter(c.WriteErrorsUncorrected),
+		PowerOnHours:           reliabilityCounter(c.PowerOnHours),
+		ReadLatencyMaxMs:       reliabilityCounter(c.ReadLatencyMax),
+		WriteLatencyMaxMs:      reliabilityCounter(c.WriteLatencyMax),
+		FlushLatencyMaxMs:      reliabilityCounter(c.FlushLatencyMax),
+	}, nil
+}
+
+// reliabilityCounter returns the value of a reliability counter, CounterNotReported if it's null.
+func reliabilityCounter(value *int64) int64 {
+	if value == nil {
+		return shared.CounterNotReported
+	}
+	return *value
+}
+
+// hasDiskAttribute returns true if the disk `diskNumber` has the attribute
+// `attribute` e.g. DISK_ATTRIBUTE_OFFLINE.
+func hasDiskAttribute(diskNumber uint32, attribute uint64) (bool, error) {
+	h, err := openPhysicalDrive(diskNumber, syscall.O_RDONLY)
+	if err != nil {
+		return false, err
+	}
+	defer syscall.Close(h)
+
+	var attributes getDiskAttributes
+	var size uint32
+	err = syscall.DeviceIoControl(h, IOCTL_DISK_GET_DISK_ATTRIBUTES, nil, 0,
+		(*byte)(unsafe.Pointer(&attributes)), uint32(unsafe.Sizeof(attributes)), &size, nil)
+	if err != nil {
+		return false, fmt.Errorf("IOCTL_DISK_GET_DISK_ATTRIBUTES failed for disk %d: %w", diskNumber, err)
+	}
+	return attributes.Attributes&attribute != 0, nil
+}
+
+// isDiskClusterEnabled returns true if the clustering of the disk `diskNumber` is
+// enabled, i.e. the disk is used by a failover cluster.
+func isDiskClusterEnabled(diskNumber uint32) (bool, error) {
+	h, err := openPhysicalDrive(diskNumber, syscall.O_RDONLY)
+	if err != nil {
+		return false, err
+	}
+	defer syscall.Close(h)
+
+	var info diskClusterInfo
+	var size uint32
+	err = syscall.DeviceIoControl(h, IOCTL_DISK_GET_CLUSTER_INFO, nil, 0,
+		(*byte)(unsafe.Pointer(&info)), uint32(unsafe.Sizeof(info)), &size, nil)
+	if err != nil {
+		return false, fmt.Errorf("IOCTL_DISK_GET_CLUSTER_INFO failed for disk %d: %w", diskNumber, err)
+	}
+	return info.Flags&DISK_CLUSTER_FLAG_ENABLED != 0, nil
+}
+
+// getDriveLayout reads the partition table of the disk `diskNumber` with
+// IOCTL_DISK_GET_DRIVE_LAYOUT_EX, the partition manager returns it from its cache
+// without going through the Storage module.
+func getDriveLayout(diskNumber uint32) (*driveLayout, error) {
+	h, err := openPhysicalDrive(diskNumber, syscall.O_RDONLY)
+	if err != nil {
+		return nil, err
+	}
+	defer syscall.Close(h)
+
+	buffer := make([]byte, driveLayoutInformationExHeaderSize+maxGptPartitions*partitionInformationExSize)
+	var size uint32
+	err = syscall.DeviceIoControl(h, IOCTL_DISK_GET_DRIVE_LAYOUT_EX, nil, 0, &buffer[0], uint32(len(buffer)), &size, nil)
+	if err != nil {
+		return nil, fmt.Errorf("IOCTL_DISK_GET_DRIVE_LAYOUT_EX failed for disk %d: %w", diskNumber, err)
+	}
+	return parseDriveLayout(buffer[:size])
+}
+
+// parseDriveLayout parses DRIVE_LAYOUT_INFORMATION_EX, the unused entries of an MBR
+// partition table and the Microsoft Reserved Partition aren't counted as basic
+// partitions like with Get-Partition.
+func parseDriveLayout(buffer []byte) (*driveLayout, error) {
+	if len(buffer) < driveLayoutInformationExHeaderSize {
+		return nil, fmt.Errorf("drive layout of %d bytes is too short", len(buffer))
+	}
+	layout := &driveLayout{PartitionStyle: binary.LittleEndian.Uint32(buffer[0:4])}
+	count := int(binary.LittleEndian.Uint32(buffer[4:8]))
+	if len(buffer) < driveLayoutInformationExHeaderSize+count*partitionInformationExSize {
+		return nil, fmt.Errorf("drive layout of %d bytes is too short for %d partitions", len(buffer), count)
+	}
+	for i := 0; i < count; i++ {
+		entry := buffer[driveLayoutInformationExHeaderSize+i*partitionInformationExSize:][:partitionInformationExSize]
+		// the union of PARTITION_INFORMATION_MBR and PARTITION_INFORMATION_GPT
+		info := entry[partitionInformationExUnionOffset:]
+		switch binary.LittleEndian.Uint32(entry[0:4]) {
+		case PARTITION_STYLE_MBR:
+			if info[0] == PARTITION_ENTRY_UNUSED {
+				continue
+			}
+		case PARTITION_STYLE_GPT:
+			if formatGUID(info[0:16]) == reservedPartitionGptType {
+				continue
+			}
+		}
+		layout.BasicPartitions++
+	}
+	return layout, nil
+}
+
+// formatGUID formats a GUID in its Windows memory layout like PowerShell e.g.
+// {e3c9e316-0b5c-4db8-817d-f92df00215ae}.
+func formatGUID(b []byte) string {
+	return fmt.Sprintf("{%08x-%04x-%04x-%x-%x}", binary.LittleEndian.Uint32(b[0:4]),
+		binary.LittleEndian.Uint16(b[4:6]), binary.LittleEndian.Uint16(b[6:8]), b[8:10], b[10:16])
+}
+
+// getDiskLengthAndSerialNumber reads the size of the disk `diskNumber` with
+// IOCTL_DISK_GET_LENGTH_INFO and its serial number from its STORAGE_DEVICE_DESCRIPTOR,
+// the serial number is empty if the disk doesn't have one.
+func getDiskLengthAndSerialNumber(diskNumber uint32) (int64, string, error) {
+	h, err := openPhysicalDrive(diskNumber, syscall.O_RDONLY)
+	if err != nil {
+		return -1, "", err
+	}
+	defer syscall.Close(h)
+
+	var length int64
+	var size uint32
+	err = syscall.DeviceIoControl(h, IOCTL_DISK_GET_LENGTH_INFO, nil, 0,
+		(*byte)(unsafe.Pointer(&length)), uint32(unsafe.Sizeof(length)), &size, nil)
+	if err != nil {
+		return -1, "", fmt.Errorf("IOCTL_DISK_GET_LENGTH_INFO failed for disk %d: %w", diskNumber, err)
+	}
+
+	query := StoragePropertyQuery{
+		PropertyID: StorageDeviceProperty,
+		QueryType:  PropertyStandardQuery,
+	}
+	buffer := make([]byte, 4*1024)
+	err = syscall.DeviceIoControl(h, IOCTL_STORAGE_QUERY_PROPERTY, (*byte)(unsafe.Pointer(&query)), uint32(unsafe.Sizeof(query)),
+		&buffer[0], uint32(len(buffer)), &size, nil)
+	if err != nil {
+		return -1, "", fmt.Errorf("IOCTL_STORAGE_QUERY_PROPERTY failed for the device descriptor of disk %d: %w", diskNumber, err)
+	}
+	descriptor := (*StorageDeviceDescriptor)(unsafe.Pointer(&buffer[0]))
+	return length, descriptorString(buffer[:size], descriptor.SerialNumberOffset), nil
+}
+
+// descriptorString returns the null-terminated string at `offset` of a descriptor,
+// an offset of 0 means that the descriptor doesn't have the string.
+func descriptorString(buffer []byte, offset uint32) string {
+	if offset == 0 || int(offset) >= len(buffer) {
+		return ""
+	}
+	s := buffer[offset:]
+	if i := strings.IndexByte(string(s), 0); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(string(s))
+}
+
+// setDiskAttribute sets or clears the attribute `attribute` of the disk
+// `diskNumber`, persistently like Set-Disk. Before a disk is taken offline (e.g.
+// before it's detached) or made read-only its write cache is flushed, Windows
+// then dismounts the volumes of the disk which flushes them.
+func setDiskAttribute(diskNumber uint32, attribute uint64, set bool) error {
+	h, err := openPhysicalDrive(diskNumber, syscall.O_RDWR)
+	if err != nil {
+		return err
+	}
+	defer syscall.Close(h)
+
+	if set {
+		if err := syscall.FlushFileBuffers(h); err != nil {
+			return fmt.Errorf("error flushing disk %d: %w", diskNumber, err)
+		}
+	}
+	attributes := setDiskAttributes{
+		Persist:        true,
+		AttributesMask: attribute,
+	}
+	attributes.Version = uint32(unsafe.Sizeof(attributes))
+	if set {
+		attributes.Attributes = attribute
+	}
+	var size uint32
+	err = syscall.DeviceIoControl(h, IOCTL_DISK_SET_DISK_ATTRIBUTES,
+		(*byte)(unsafe.Pointer(&attributes)), attributes.Version, nil, 0, &size, nil)
+	if err != nil {
+		return fmt.Errorf("IOCTL_DISK_SET_DISK_ATTRIBUTES failed for disk %d: %w", diskNumber, err)
+	}
+	// refresh the cached layout of the disk, the volumes of a disk brought online
+	// are discovered again
+	if err := syscall.DeviceIoControl(h, IOCTL_DISK_UPDATE_PROPERTIES, nil, 0, nil, 0, &size, nil); err != nil {
+		klog.V(4).Infof("IOCTL_DISK_UPDATE_PROPERTIES failed for disk %d: %v", diskNumber, err)
+	}
+	return nil
+}
+
+// SetDiskReadOnly makes the disk `diskNumber` read-only or writable with
+// IOCTL_DISK_SET_DISK_ATTRIBUTES, Set-Disk is only used if the IOCTL fails.
+func (imp DiskAPI) SetDiskReadOnly(diskNumber uint32, readOnly bool) error {
+	err := setDiskAttribute(diskNumber, DISK_ATTRIBUTE_READ_ONLY, readOnly)
+	if err == nil || utils.PowerShellDisabled() {
+		return err
+	}
+	klog.V(4).Infof("Setting the read-only attribute of disk %d natively failed, falling back to Set-Disk: %v", diskNumber, err)
+
+	cmd := fmt.Sprintf("Get-Disk -Number %d | Set-Disk -IsReadOnly $%t", diskNumber, readOnly)
+	if !storageModule() {
+		cmd = fmt.Sprintf("%s | %s", getDiskCmd([]uint32{diskNumber}),
+			cim.StorageMethod("SetAttributes", fmt.Sprintf("@{IsReadOnly=$%t}", readOnly)))
+	}
+	out, err := runExec(cmd)
+	if err != nil {
+		return fmt.Errorf("error setting the read-only attribute of disk %d. cmd: %s, output: %s, error: %v", diskNumber, utils.CommandText(cmd), string(out), err)
+	}
+	return nil
+}
+
+// GetDiskReadOnly returns true if the disk `diskNumber` is read-only, it's read
+// with IOCTL_DISK_GET_DISK_ATTRIBUTES and Get-Disk is only used if the IOCTL fails.
+func (imp DiskAPI) GetDiskReadOnly(diskNumber uint32) (bool, error) {
+	readOnly, err := hasDiskAttribute(diskNumber, DISK_ATTRIBUTE_READ_ONLY)
+	if err == nil || utils.PowerShellDisabled() {
+		return readOnly, err
+	}
+	klog.V(4).Infof("Getting the read-only attribute of disk %d natively failed, falling back to Get-Disk: %v", diskNumber, err)
+
+	cmd := fmt.Sprintf("(%s) | Select-Object -ExpandProperty IsReadOnly", getDiskCmd([]uint32{diskNumber}))
+	out, err := runExec(cmd)
+	if err != nil {
+		return false, fmt.Errorf("error getting the read-only attribute of disk %d. cmd: %s, output: %s, error: %v", diskNumber, utils.CommandText(cmd), string(out), err)
+	}
+	sout := strings.TrimSpace(string(out))
+	readOnly, err = strconv.ParseBool(sout)
+	if err != nil {
+		return false, fmt.Errorf("error parsing the read-only attribute of disk %d. output: %s, error: %v", diskNumber, sout, err)
+	}
+	return readOnly, nil
+}
+
+func (imp DiskAPI) BringDiskOnline(diskNumber uint32) error {
+	cmd := fmt.Sprintf("Get-Disk -Number %d | Where IsOffline | Set-Disk -IsOffline $false; "+
+		"Get-Disk -Number %d | Where IsReadOnly | Set-Disk -IsReadOnly $false", diskNumber, diskNumber)
+	if !storageModule() {
+		cmd = fmt.Sprintf("%s | Where IsOffline | %s; %s | Where IsReadOnly | %s",
+			getDiskCmd([]uint32{diskNumber}), cim.StorageMethod("Online", ""),
+			getDiskCmd([]uint32{diskNumber}), cim.StorageMethod("SetAttributes", "@{IsReadOnly=$false}"))
+	}
+	out, err := runExec(cmd)
+	if err != nil {
+		return fmt.Errorf("error bringing disk %d online. cmd: %s, output: %s, error: %v", diskNumber, utils.CommandText(cmd), string(out), err)
+	}
+	return nil
+}
+
+func (imp DiskAPI) GetSanPolicy() (SanPolicy, error) {
+	cmd := "Get-StorageSetting | Select-Object -ExpandProperty NewDiskPolicy"
+	if !storageModule() {
+		cmd = fmt.Sprintf("$r = Invoke-CimMethod -Namespace %s -ClassName MSFT_StorageSetting -MethodName Get; "+
+			"if ($r.ReturnValue -ne 0) { throw \"Get failed with StorageWMI $($r.ReturnValue)\" }; $r.StorageSetting.NewDiskPolicy", cim.StorageNamespace)
+	}
+	out, err := runExec(cmd)
+	if err != nil {
+		return "", fmt.Errorf("error getting the SAN policy. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
+	policy := SanPolicy(cim.EnumName("NewDiskPolicy", strings.TrimSpace(string(out))))
+	if _, ok := sanPolicyValues[policy]; !ok {
+		return "", fmt.Errorf("unknown SAN policy %q", policy)
+	}
+	return policy, nil
+}
+
+func (imp DiskAPI) SetSanPolicy(policy SanPolicy) error {
+	value, ok := sanPolicyValues[policy]
+	if !ok {
+		return fmt.Errorf("invalid SAN policy %q", policy)
+	}
+	cmd := fmt.Sprintf("Set-StorageSetting -NewDiskPolicy %s", policy)
+	if !storageModule() {
+		cmd = fmt.Sprintf("$r = Invoke-CimMethod -Namespace %s -ClassName MSFT_StorageSetting -MethodName Set -Arguments @{NewDiskPolicy=[uint16]%d}; "+
+			"if ($r.ReturnValue -ne 0) { throw \"Set failed with StorageWMI $($r.ReturnValue)\" }", cim.StorageNamespace, value)
+	}
+	out, err := runExec(cmd)
+	if err != nil {
+		return fmt.Errorf("error setting the SAN policy to %s. cmd: %s, output: %s, error: %v", policy, utils.CommandText(cmd), string(out), err)
+	}
+	return nil
+}
+
+// IsDiskClustered returns true if the disk `diskNumber` is used by a failover cluster, it's read
+// with IOCTL_DISK_GET_CLUSTER_INFO and Get-Disk is only used if the IOCTL fails.
+func (imp DiskAPI) IsDiskClustered(diskNumber uint32) (bool, error) {
+	clustered, err := isDiskClusterEnabled(diskNumber)
+	if err == nil || utils.PowerShellDisabled() {
+		return clustered, err
+	}
+	klog.V(4).Infof("Checking if disk %d is clustered natively failed, falling back to Get-Disk: %v", diskNumber, err)
+
+	cmd := fmt.Sprintf("(%s).IsClustered", getDiskCmd([]uint32{diskNumber}))
+	out, err := runExec(cmd)
+	if err != nil {
+		return false, fmt.Errorf("error checking if disk is clustered. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
+
+	sout := strings.TrimSpace(string(out))
+	isClustered, err := strconv.ParseBool(sout)
+	if err != nil {
+		return false, fmt.Errorf("error parsing disk clustered state. output: %s, error: %v", sout, err)
+	}
+
+	return isClustered, nil
+}
+
+// openDisk opens the disk device `path`, opening a disk fails transiently while
+// other processes (e.g. the storage service after a rescan) have it open, so
+// it's retried on sharing violations and while the device isn't ready.
+func openDisk(path string, mode int) (syscall.Handle, error) {
+	var h syscall.Handle
+	err := utils.RetryOnError("OpenDisk", openDiskAttempts, openDiskRetryInterval, isTransientOpenError, func() error {
+		var err error
+		h, err = syscall.Open(path, mode, 0)
+		return err
+	})
+	return h, err
+}
+
+func isTransientOpenError(err error) bool {
+	var errno syscall.Errno
+	if !errors.As(err, &errno) {
+		return false
+	}
+	switch errno {
+	case ERROR_NOT_READY, ERROR_SHARING_VIOLATION, ERROR_LOCK_VIOLATION, ERROR_BUSY:
+		return true
+	}
+	return false
+}
+
+// openPhysicalDrive opens a handle to the disk `diskNumber` that can be used for IOCTLs.
+func openPhysicalDrive(diskNumber uint32, mode int) (syscall.Handle, error) {
+	path := fmt.Sprintf(`\\.\PhysicalDrive%d`, diskNumber)
+	h, err := openDisk(path, mode)
+	if err != nil {
+		return syscall.InvalidHandle, fmt.Errorf("error opening disk %d: %v", diskNumber, err)
+	}
+	return h, nil
+}
+
+func (imp DiskAPI) GetDiskReservation(diskNumber uint32) (uint64, bool, error) {
+	h, err := openPhysicalDrive(diskNumber, syscall.O_RDONLY)
+	if err != nil {
+		return 0, false, err
+	}
+	defer syscall.Close(h)
+
+	// PERSISTENT_RESERVE_COMMAND with the PR_IN member, the output buffer has space
+	// for the PRI_RESERVATION_LIST header and a single reservation descriptor
+	// (at most one reservation can be held on a logical unit)
+	output := make([]byte, priReservationListHeaderSize+priReservationDescriptorSize)
+	command := make([]byte, persistentReserveCommandSize)
+	binary.LittleEndian.PutUint32(command[0:], persistentReserveCommandSize)
+	binary.LittleEndian.PutUint32(command[4:], persistentReserveCommandSize)
+	command[8] = ReservationActionReadReservation
+	binary.LittleEndian.PutUint16(command[10:], uint16(len(output)))
+
+	var size uint32
+	err = syscall.DeviceIoControl(h, IOCTL_STORAGE_PERSISTENT_RESERVE_IN, &command[0], uint32(len(command)), &output[0], uint32(len(output)), &size, nil)
+	if err != nil {
+		return 0, false, fmt.Errorf("IOCTL_STORAGE_PERSISTENT_RESERVE_IN failed for disk %d: %v", diskNumber, err)
+	}
+
+	// the fields in the reservation list are big endian as returned by the device
+	additionalLength := binary.BigEndian.Uint32(output[4:])
+	if additionalLength < priReservationDescriptorSize {
+		return 0, false, nil
+	}
+	key := binary.BigEndian.Uint64(output[priReservationListHeaderSize:])
+	return key, true, nil
+}
+
+func (imp DiskAPI) ReserveDisk(diskNumber uint32, key uint64) error {
+	if err := persistentReserveOut(diskNumber, ReservationActionRegisterIgnoreExisting, 0, key); err != nil {
+		return err
+	}
+	return persistentReserveOut(diskNumber, ReservationActionReserve, key, 0)
+}
+
+func (imp DiskAPI) ReleaseDisk(diskNumber uint32, key uint64) error {
+	if err := persistentReserveOut(diskNumber, ReservationActionRelease, key, 0); err != nil {
+		return err
+	}
+	// registering a zero service action key removes the registration of `key`
+	return persistentReserveOut(diskNumber, ReservationActionRegister, key, 0)
+}
+
+// persistentReserveOut sends a PERSISTENT RESERVE OUT command with the service action `action`
+// to the disk `diskNumber`, the reservations are always of type write exclusive.
+func persistentReserveOut(diskNumber uint32, action byte, reservationKey, serviceActionKey uint64) error {
+	h, err := openPhysicalDrive(diskNumber, syscall.O_RDWR)
+	if err != nil {
+		return err
+	}
+	defer syscall.Close(h)
+
+	// PERSISTENT_RESERVE_COMMAND with the PR_OUT member followed by a PRO_PARAMETER_LIST
+	command := make([]byte, persistentReserveCommandSize+proParameterListSize)
+	binary.LittleEndian.PutUint32(command[0:], persistentReserveCommandSize)
+	binary.LittleEndian.PutUint32(command[4:], uint32(len(command)))
+	command[8] = action
+	command[9] = ReservationTypeWriteExclusive | ReservationScopeLogicalUnit<<4
+	// the keys are sent to the device as is so they must be big endian
+	binary.BigEndian.PutUint64(command[10:], reservationKey)
+	binary.BigEndian.PutUint64(command[18:], serviceActionKey)
+
+	var size uint32
+	err = syscall.DeviceIoControl(h, IOCTL_STORAGE_PERSISTENT_RESERVE_OUT, &command[0], uint32(len(command)), nil, 0, &size, nil)
+	if err != nil {
+		return fmt.Errorf("IOCTL_STORAGE_PERSISTENT_RESERVE_OUT (service action %d) failed for disk %d: %v", action, diskNumber, err)
+	}
+	return nil
+}
@@ -1,5 +1,12 @@
 package disk
 
+import (
+	"fmt"
+	"syscall"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+)
+
 type StorageDeviceNumber struct {
 	DeviceType      DeviceType
 	DeviceNumber    uint32
@@ -62,6 +69,18 @@ type StoragePropertyQuery struct {
 
 type AdditionalParameters byte
 
+// StorageAccessAlignmentDescriptor is STORAGE_ACCESS_ALIGNMENT_DESCRIPTOR, the output of the
+// StorageAccessAlignmentProperty query.
+type StorageAccessAlignmentDescriptor struct {
+	Version                       uint32
+	Size                          uint32
+	BytesPerCacheLine             uint32
+	BytesOffsetForCacheAlignment  uint32
+	BytesPerLogicalSector         uint32
+	BytesPerPhysicalSector        uint32
+	BytesOffsetForSectorAlignment uint32
+}
+
 type StorageDeviceIDDescriptor struct {
 	Version             uint32
 	Size                uint32
@@ -109,7 +128,132 @@ type StorageIdentifier struct {
 	Identifier     [1]byte
 }
 
-type Disk struct {
-	Path         string `json:"Path"`
-	SerialNumber string `json:"SerialNumber"`
+// StorageDeviceDescriptor is STORAGE_DEVICE_DESCRIPTOR without its raw device
+// properties, the output of the StorageDeviceProperty query.
+type StorageDeviceDescriptor struct {
+	Version               uint32
+	Size                  uint32
+	DeviceType            byte
+	DeviceTypeModifier    byte
+	RemovableMedia        bool
+	CommandQueueing       bool
+	VendorIDOffset        uint32
+	ProductIDOffset       uint32
+	ProductRevisionOffset uint32
+	SerialNumberOffset    uint32
+	BusType               uint32
+	RawPropertiesLength   uint32
+}
+
+// Partition styles of DRIVE_LAYOUT_INFORMATION_EX and PARTITION_INFORMATION_EX.
+const (
+	PARTITION_STYLE_MBR = 0
+	PARTITION_STYLE_GPT = 1
+	PARTITION_STYLE_RAW = 2
+
+	// PARTITION_ENTRY_UNUSED is the PartitionType of the unused entries of an MBR partition table.
+	PARTITION_ENTRY_UNUSED = 0x00
+)
+
+// Sizes and offsets of DRIVE_LAYOUT_INFORMATION_EX, it has unions so it's
+// deserialized manually.
+const (
+	// DRIVE_LAYOUT_INFORMATION_EX without the partition entries
+	driveLayoutInformationExHeaderSize = 48
+	// PARTITION_INFORMATION_EX
+	partitionInformationExSize = 144
+	// offset of the union of PARTITION_INFORMATION_MBR and PARTITION_INFORMATION_GPT
+	// in PARTITION_INFORMATION_EX
+	partitionInformationExUnionOffset = 32
+	// maxGptPartitions is the number of partition entries of a GPT partition table
+	// created by Windows.
+	maxGptPartitions = 128
+)
+
+// driveLayout is the partition table of a disk.
+type driveLayout struct {
+	// PartitionStyle is PARTITION_STYLE_MBR, PARTITION_STYLE_GPT or PARTITION_STYLE_RAW.
+	PartitionStyle uint32
+	// BasicPartitions is the number of partitions, without the Microsoft Reserved Partition.
+	BasicPartitions int
+}
+
+// Persistent reservation service actions, types and scopes as defined in ntddstor.h
+const (
+	ReservationActionReadReservation        = 0x01
+	ReservationActionRegister               = 0x00
+	ReservationActionReserve                = 0x01
+	ReservationActionRelease                = 0x02
+	ReservationActionRegisterIgnoreExisting = 0x06
+
+	ReservationTypeWriteExclusive = 0x01
+	ReservationScopeLogicalUnit   = 0x00
+)
+
+// Sizes of the structs used with IOCTL_STORAGE_PERSISTENT_RESERVE_IN/OUT, these structs
+// have bit fields so they're serialized manually.
+const (
+	// PERSISTENT_RESERVE_COMMAND
+	persistentReserveCommandSize = 12
+	// PRO_PARAMETER_LIST
+	proParameterListSize = 24
+	// PRI_RESERVATION_LIST without the reservation descriptors
+	priReservationListHeaderSize = 8
+	// PRI_RESERVATION_DESCRIPTOR
+	priReservationDescriptorSize = 16
+)
+
+// System error codes of transient failures opening a disk
+const (
+	ERROR_NOT_READY         syscall.Errno = 21
+	ERROR_SHARING_VIOLATION syscall.Errno = 32
+	ERROR_LOCK_VIOLATION    syscall.Errno = 33
+	ERROR_BUSY              syscall.Errno = 170
+)
+
+// PartitionStyle is the -PartitionStyle of Initialize-Disk i.e. the partition table of a disk.
+type PartitionStyle string
+
+const (
+	PartitionStyleGPT PartitionStyle = "GPT"
+	PartitionStyleMBR PartitionStyle = "MBR"
+)
+
+// partitionStyleValues are the values of the PartitionStyle enum of MSFT_Disk.
+var partitionStyleValues = map[PartitionStyle]int{
+	PartitionStyleMBR: 1,
+	PartitionStyleGPT: 2,
+}
+
+// SanPolicy is the -NewDiskPolicy of Set-StorageSetting i.e. the state of the disks attached to the node.
+type SanPolicy string
+
+const (
+	SanPolicyOnlineAll       SanPolicy = "OnlineAll"
+	SanPolicyOfflineShared   SanPolicy = "OfflineShared"
+	SanPolicyOfflineAll      SanPolicy = "OfflineAll"
+	SanPolicyOfflineInternal SanPolicy = "OfflineInternal"
+)
+
+// sanPolicyValues are the values of the NewDiskPolicy enum of MSFT_StorageSetting.
+var sanPolicyValues = map[SanPolicy]int{
+	SanPolicyOnlineAll:       1,
+	SanPolicyOfflineShared:   2,
+	SanPolicyOfflineAll:      3,
+	SanPolicyOfflineInternal: 4,
+}
+
+// ReliabilityCountersNotSupportedError is returned when the storage provider of a
+// disk doesn't expose its reliability counters.
+type ReliabilityCountersNotSupportedError struct {
+	DiskNumber uint32
+}
+
+func (e *ReliabilityCountersNotSupportedError) Error() string {
+	return fmt.Sprintf("disk %d doesn't report reliability counters", e.DiskNumber)
+}
+
+// ErrorCode returns the stable code of the error.
+func (e *ReliabilityCountersNotSupportedError) ErrorCode() string {
+	return utils.ErrorCodeNotSupported
 }
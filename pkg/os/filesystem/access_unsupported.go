@@ -0,0 +1,24 @@
+//go:build !windows
+// +build !windows
+
+package filesystem
+
+import "errors"
+
+// getEffectiveAccess and the ACL functions are only available on Windows, these
+// stubs let the packages that depend on the filesystem API build on other platforms.
+func getEffectiveAccess(path, sid string) (uint32, error) {
+	return 0, errors.New("access checks are only supported on Windows")
+}
+
+func getSecurityDescriptor(path string) (string, error) {
+	return "", errors.New("ACLs are only supported on Windows")
+}
+
+func setDACL(path, sddl string) error {
+	return errors.New("ACLs are only supported on Windows")
+}
+
+func grantAccess(path string, grants map[string]uint32) error {
+	return errors.New("ACLs are only supported on Windows")
+}
@@ -0,0 +1,169 @@
+package filesystem
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// The effective access of an identity to a path is computed by the Authz API from
+// the security descriptor of the path and the groups of the identity, the way the
+// access checks of Windows grant it when the identity opens the path.
+
+const (
+	// authzRMFlagNoAudit is the flag of a resource manager that doesn't generate audits
+	authzRMFlagNoAudit = 0x1
+	// maximumAllowed is the desired access that checks all the granted rights
+	maximumAllowed = 0x02000000
+)
+
+var (
+	modauthz = windows.NewLazySystemDLL("authz.dll")
+
+	procAuthzInitializeResourceManager = modauthz.NewProc("AuthzInitializeResourceManager")
+	procAuthzFreeResourceManager       = modauthz.NewProc("AuthzFreeResourceManager")
+	procAuthzInitializeContextFromSid  = modauthz.NewProc("AuthzInitializeContextFromSid")
+	procAuthzFreeContext               = modauthz.NewProc("AuthzFreeContext")
+	procAuthzAccessCheck               = modauthz.NewProc("AuthzAccessCheck")
+)
+
+// authzAccessRequest is AUTHZ_ACCESS_REQUEST.
+type authzAccessRequest struct {
+	DesiredAccess        uint32
+	PrincipalSelfSid     *windows.SID
+	ObjectTypeList       uintptr
+	ObjectTypeListLength uint32
+	OptionalArguments    uintptr
+}
+
+// authzAccessReply is AUTHZ_ACCESS_REPLY with a result list of one entry.
+type authzAccessReply struct {
+	ResultListLength      uint32
+	GrantedAccessMask     *uint32
+	SaclEvaluationResults *uint32
+	Error                 *uint32
+}
+
+// getEffectiveAccess returns the access mask granted to the identity `sid` by the
+// security descriptor of `path`.
+func getEffectiveAccess(path, sid string) (uint32, error) {
+	userSid, err := windows.StringToSid(sid)
+	if err != nil {
+		return 0, fmt.Errorf("invalid SID %q: %w", sid, err)
+	}
+	sd, err := windows.GetNamedSecurityInfo(path, windows.SE_FILE_OBJECT,
+		windows.OWNER_SECURITY_INFORMATION|windows.GROUP_SECURITY_INFORMATION|windows.DACL_SECURITY_INFORMATION)
+	if err != nil {
+		return 0, fmt.Errorf("error getting the security descriptor of %s: %w", path, err)
+	}
+
+	var rm uintptr
+	r, _, err := procAuthzInitializeResourceManager.Call(authzRMFlagNoAudit, 0, 0, 0, 0, uintptr(unsafe.Pointer(&rm)))
+	if r == 0 {
+		return 0, fmt.Errorf("error initializing the Authz resource manager: %w", err)
+	}
+	defer procAuthzFreeResourceManager.Call(rm)
+
+	// the LUID identifier is passed by value, it fits in a register on amd64
+	var context uintptr
+	r, _, err = procAuthzInitializeContextFromSid.Call(0, uintptr(unsafe.Pointer(userSid)), rm, 0, 0, 0, uintptr(unsafe.Pointer(&context)))
+	if r == 0 {
+		return 0, fmt.Errorf("error getting the groups of %s: %w", sid, err)
+	}
+	defer procAuthzFreeContext.Call(context)
+
+	request := authzAccessRequest{DesiredAccess: maximumAllowed}
+	var grantedAccess, saclEvaluationResult, accessError uint32
+	reply := authzAccessReply{
+		ResultListLength:      1,
+		GrantedAccessMask:     &grantedAccess,
+		SaclEvaluationResults: &saclEvaluationResult,
+		Error:                 &accessError,
+	}
+	r, _, err = procAuthzAccessCheck.Call(0, context, uintptr(unsafe.Pointer(&request)), 0,
+		uintptr(unsafe.Pointer(sd)), 0, 0, uintptr(unsafe.Pointer(&reply)), 0)
+	if r == 0 {
+		return 0, fmt.Errorf("error checking the access of %s to %s: %w", sid, path, err)
+	}
+	// the reply error is ERROR_ACCESS_DENIED if no right is granted
+	if accessError != 0 {
+		return 0, nil
+	}
+	return grantedAccess, nil
+}
+
+// getSecurityDescriptor returns the owner, the group and the DACL of `path` in SDDL.
+func getSecurityDescriptor(path string) (string, error) {
+	sd, err := windows.GetNamedSecurityInfo(path, windows.SE_FILE_OBJECT,
+		windows.OWNER_SECURITY_INFORMATION|windows.GROUP_SECURITY_INFORMATION|windows.DACL_SECURITY_INFORMATION)
+	if err != nil {
+		return "", fmt.Errorf("error getting the security descriptor of %s: %w", path, err)
+	}
+	return sd.String(), nil
+}
+
+// setDACL replaces the DACL of `path` with the DACL of `sddl`, the ACEs of the parent
+// directory are inherited unless the DACL is protected. Windows propagates the
+// inheritable ACEs to the children of `path`.
+func setDACL(path, sddl string) error {
+	sd, err := windows.SecurityDescriptorFromString(sddl)
+	if err != nil {
+		return fmt.Errorf("invalid SDDL %q: %w", sddl, err)
+	}
+	dacl, _, err := sd.DACL()
+	if err != nil {
+		return fmt.Errorf("SDDL %q has no DACL: %w", sddl, err)
+	}
+	control, _, err := sd.Control()
+	if err != nil {
+		return fmt.Errorf("error getting the control of SDDL %q: %w", sddl, err)
+	}
+	info := windows.SECURITY_INFORMATION(windows.DACL_SECURITY_INFORMATION | windows.UNPROTECTED_DACL_SECURITY_INFORMATION)
+	if control&windows.SE_DACL_PROTECTED != 0 {
+		info = windows.DACL_SECURITY_INFORMATION | windows.PROTECTED_DACL_SECURITY_INFORMATION
+	}
+	if err := windows.SetNamedSecurityInfo(path, windows.SE_FILE_OBJECT, info, nil, nil, dacl, nil); err != nil {
+		return fmt.Errorf("error setting the DACL of %s: %w", path, err)
+	}
+	return nil
+}
+
+// grantAccess merges access allowed ACEs for `grants` into the DACL of `path`, like
+// icacls /grant with the (OI)(CI) inheritance.
+func grantAccess(path string, grants map[string]uint32) error {
+	entries := make([]windows.EXPLICIT_ACCESS, 0, len(grants))
+	for sid, mask := range grants {
+		trustee, err := windows.StringToSid(sid)
+		if err != nil {
+			return fmt.Errorf("invalid SID %q: %w", sid, err)
+		}
+		entries = append(entries, windows.EXPLICIT_ACCESS{
+			AccessPermissions: windows.ACCESS_MASK(mask),
+			AccessMode:        windows.GRANT_ACCESS,
+			Inheritance:       windows.SUB_CONTAINERS_AND_OBJECTS_INHERIT,
+			Trustee: windows.TRUSTEE{
+				TrusteeForm:  windows.TRUSTEE_IS_SID,
+				TrusteeType:  windows.TRUSTEE_IS_UNKNOWN,
+				TrusteeValue: windows.TrusteeValueFromSID(trustee),
+			},
+		})
+	}
+
+	sd, err := windows.GetNamedSecurityInfo(path, windows.SE_FILE_OBJECT, windows.DACL_SECURITY_INFORMATION)
+	if err != nil {
+		return fmt.Errorf("error getting the security descriptor of %s: %w", path, err)
+	}
+	dacl, _, err := sd.DACL()
+	if err != nil {
+		return fmt.Errorf("error getting the DACL of %s: %w", path, err)
+	}
+	merged, err := windows.ACLFromEntries(entries, dacl)
+	if err != nil {
+		return fmt.Errorf("error adding the grants to the DACL of %s: %w", path, err)
+	}
+	if err := windows.SetNamedSecurityInfo(path, windows.SE_FILE_OBJECT, windows.DACL_SECURITY_INFORMATION, nil, nil, merged, nil); err != nil {
+		return fmt.Errorf("error setting the DACL of %s: %w", path, err)
+	}
+	return nil
+}
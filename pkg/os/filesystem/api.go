@@ -3,9 +3,10 @@ package filesystem
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
 )
 
 // Implements the Filesystem OS API calls. All code here should be very simple
@@ -22,8 +23,49 @@ type API interface {
 	RmdirContents(path string) error
 	CreateSymlink(oldname string, newname string) error
 	IsSymlink(path string) (bool, error)
+	// GetMountPointType returns whether `path` is a volume mount point, a link to a
+	// volume, another link or a directory.
+	GetMountPointType(path string) (MountPointType, error)
+	// CreateJunction creates the junction point `junction` to the directory `target`.
+	CreateJunction(target string, junction string) error
+	// ResolveJunction returns the directory the junction point `path` points to.
+	ResolveJunction(path string) (string, error)
+	// GetEffectiveAccess returns the access mask granted to the identity `sid` by the
+	// security descriptor of `path`, including the rights of its groups.
+	GetEffectiveAccess(path string, sid string) (uint32, error)
+	// GetSecurityDescriptor returns the owner, the group and the DACL of `path` in SDDL.
+	GetSecurityDescriptor(path string) (string, error)
+	// SetDACL replaces the DACL of `path` with the DACL of the SDDL string `sddl`.
+	SetDACL(path string, sddl string) error
+	// GrantAccess adds the access masks `grants` of the identities they're keyed by
+	// the SID of to the DACL of `path`, they're inherited by its children.
+	GrantAccess(path string, grants map[string]uint32) error
 }
 
+// Access masks of the file rights, see winnt.h.
+const (
+	FileGenericRead    = 0x120089
+	FileGenericWrite   = 0x120116
+	FileGenericExecute = 0x1200A0
+	Delete             = 0x10000
+)
+
+// MountPointType is what a path is, see GetMountPointType.
+type MountPointType int
+
+const (
+	// Directory isn't a mount point or a link.
+	Directory MountPointType = iota
+	// VolumeMountPoint is a directory a volume is mounted at.
+	VolumeMountPoint
+	// SymlinkToVolume is a symlink or a junction to a volume mount point, to the
+	// root of a volume or to an SMB share, possibly through other links.
+	SymlinkToVolume
+	// Symlink is a symlink or a junction to a directory that isn't a volume, or
+	// whose target doesn't exist.
+	Symlink
+)
+
 type filesystemAPI struct{}
 
 // check that filesystemAPI implements API
@@ -49,9 +91,7 @@ func (filesystemAPI) PathExists(path string) (bool, error) {
 }
 
 func pathValid(path string) (bool, error) {
-	cmd := exec.Command("powershell", "/c", `Test-Path $Env:remotepath`)
-	cmd.Env = append(os.Environ(), fmt.Sprintf("remotepath=%s", path))
-	output, err := cmd.CombinedOutput()
+	output, err := utils.RunPowershellCmd(`Test-Path $Env:remotepath`, fmt.Sprintf("remotepath=%s", path))
 	if err != nil {
 		return false, fmt.Errorf("returned output: %s, error: %v", string(output), err)
 	}
@@ -139,3 +179,38 @@ func (filesystemAPI) IsSymlink(tgt string) (bool, error) {
 
 	return false, nil
 }
+
+// GetMountPointType reads the reparse points of path and of the links it leads to.
+func (filesystemAPI) GetMountPointType(path string) (MountPointType, error) {
+	return getMountPointType(path)
+}
+
+// CreateJunction creates junction as a junction point to target with FSCTL_SET_REPARSE_POINT.
+func (filesystemAPI) CreateJunction(target, junction string) error {
+	return createJunction(target, junction)
+}
+
+// ResolveJunction reads the target of the junction point path with FSCTL_GET_REPARSE_POINT.
+func (filesystemAPI) ResolveJunction(path string) (string, error) {
+	return resolveJunction(path)
+}
+
+// GetEffectiveAccess checks the access of `sid` to `path` with AuthzAccessCheck.
+func (filesystemAPI) GetEffectiveAccess(path string, sid string) (uint32, error) {
+	return getEffectiveAccess(path, sid)
+}
+
+// GetSecurityDescriptor reads the security descriptor of path with GetNamedSecurityInfo.
+func (filesystemAPI) GetSecurityDescriptor(path string) (string, error) {
+	return getSecurityDescriptor(path)
+}
+
+// SetDACL sets the DACL of path with SetNamedSecurityInfo.
+func (filesystemAPI) SetDACL(path string, sddl string) error {
+	return setDACL(path, sddl)
+}
+
+// GrantAccess merges grants into the DACL of path with SetEntriesInAcl.
+func (filesystemAPI) GrantAccess(path string, grants map[string]uint32) error {
+	return grantAccess(path, grants)
+}
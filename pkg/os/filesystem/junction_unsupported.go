@@ -0,0 +1,16 @@
+//go:build !windows
+// +build !windows
+
+package filesystem
+
+import "errors"
+
+// createJunction and resolveJunction are only available on Windows, these stubs
+// let the packages that depend on the filesystem API build on other platforms.
+func createJunction(target, junction string) error {
+	return errors.New("junction points are only supported on Windows")
+}
+
+func resolveJunction(path string) (string, error) {
+	return "", errors.New("junction points are only supported on Windows")
+}
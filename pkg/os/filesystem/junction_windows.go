@@ -0,0 +1,117 @@
+package filesystem
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Microsoft/go-winio"
+	"golang.org/x/sys/windows"
+)
+
+// A junction point is a directory with a mount point reparse point, like the ones
+// created by `mklink /J`. Its reparse data holds the NT path of the directory it
+// points to e.g. \??\C:\var\lib\kubelet\plugins\pv1.
+
+// fsctlSetReparsePoint is FSCTL_SET_REPARSE_POINT, x/sys/windows only defines the get.
+const fsctlSetReparsePoint = 0x900A4
+
+// openReparsePoint opens the reparse point `path` itself instead of what it points to.
+func openReparsePoint(path string, access uint32) (windows.Handle, error) {
+	p, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return windows.InvalidHandle, err
+	}
+	return windows.CreateFile(p, access, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE|windows.FILE_SHARE_DELETE, nil,
+		windows.OPEN_EXISTING, windows.FILE_FLAG_OPEN_REPARSE_POINT|windows.FILE_FLAG_BACKUP_SEMANTICS, 0)
+}
+
+// createJunction creates the directory `junction` and makes it a junction point to
+// the directory `target`, the directory is removed if it can't be made a junction.
+func createJunction(target, junction string) error {
+	if err := os.Mkdir(junction, 0755); err != nil {
+		return err
+	}
+	if err := setJunctionTarget(target, junction); err != nil {
+		os.Remove(junction)
+		return err
+	}
+	return nil
+}
+
+func setJunctionTarget(target, junction string) error {
+	h, err := openReparsePoint(junction, windows.GENERIC_WRITE)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", junction, err)
+	}
+	defer windows.CloseHandle(h)
+
+	data := winio.EncodeReparsePoint(&winio.ReparsePoint{Target: target, IsMountPoint: true})
+	var size uint32
+	err = windows.DeviceIoControl(h, fsctlSetReparsePoint, &data[0], uint32(len(data)), nil, 0, &size, nil)
+	if err != nil {
+		return fmt.Errorf("FSCTL_SET_REPARSE_POINT failed for %s: %w", junction, err)
+	}
+	return nil
+}
+
+// resolveJunction returns the directory the junction point `path` points to, in
+// its Win32 form.
+func resolveJunction(path string) (string, error) {
+	rp, err := readReparsePoint(path)
+	if err != nil {
+		return "", err
+	}
+	if rp == nil {
+		return "", fmt.Errorf("%s is not a junction point", path)
+	}
+	if !rp.IsMountPoint {
+		return "", fmt.Errorf("%s is a symlink, not a junction point", path)
+	}
+	return ntPathToWin32(rp.Target), nil
+}
+
+// readReparsePoint reads the symlink or mount point reparse point of `path`, it
+// returns nil if `path` isn't a reparse point or has another kind of reparse point
+// e.g. a deduplicated file.
+func readReparsePoint(path string) (*winio.ReparsePoint, error) {
+	h, err := openReparsePoint(path, windows.GENERIC_READ)
+	if err != nil {
+		return nil, fmt.Errorf("error opening %s: %w", path, err)
+	}
+	defer windows.CloseHandle(h)
+
+	data := make([]byte, windows.MAXIMUM_REPARSE_DATA_BUFFER_SIZE)
+	var size uint32
+	err = windows.DeviceIoControl(h, windows.FSCTL_GET_REPARSE_POINT, nil, 0, &data[0], uint32(len(data)), &size, nil)
+	if err == windows.ERROR_NOT_A_REPARSE_POINT {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("FSCTL_GET_REPARSE_POINT failed for %s: %w", path, err)
+	}
+	rp, err := winio.DecodeReparsePoint(data[:size])
+	var unsupported *winio.UnsupportedReparsePointError
+	if errors.As(err, &unsupported) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error decoding the reparse point of %s: %w", path, err)
+	}
+	return rp, nil
+}
+
+// ntPathToWin32 converts the NT path of a link target to a Win32 path, \??\C:\dir
+// becomes C:\dir, \??\Volume{GUID}\ becomes \\?\Volume{GUID}\ and \??\UNC\server\share
+// becomes \\?\UNC\server\share.
+func ntPathToWin32(path string) string {
+	if !strings.HasPrefix(path, `\??\`) {
+		return path
+	}
+	path = strings.TrimPrefix(path, `\??\`)
+	if len(path) >= 2 && path[1] == ':' {
+		return path
+	}
+	return `\\?\` + path
+}
@@ -0,0 +1,12 @@
+//go:build !windows
+// +build !windows
+
+package filesystem
+
+import "errors"
+
+// getMountPointType is only available on Windows, this stub lets the packages
+// that depend on the filesystem API build on other platforms.
+func getMountPointType(path string) (MountPointType, error) {
+	return Directory, errors.New("mount points are only supported on Windows")
+}
@@ -0,0 +1,82 @@
+package filesystem
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/windows"
+)
+
+// maxLinkDepth is the number of links followed by getMountPointType, like the
+// limit of the symlinks followed by Windows when it opens a path.
+const maxLinkDepth = 31
+
+// getMountPointType returns what `path` is. A mount point reparse point to a volume
+// GUID path is a volume mount point, the links are followed to check if they lead
+// to a volume.
+func getMountPointType(path string) (MountPointType, error) {
+	return mountPointType(path, 0)
+}
+
+func mountPointType(path string, depth int) (MountPointType, error) {
+	if _, err := os.Lstat(path); err != nil {
+		return Directory, err
+	}
+	rp, err := readReparsePoint(path)
+	if err != nil {
+		return Directory, err
+	}
+	if rp == nil {
+		return Directory, nil
+	}
+	if rp.IsMountPoint && strings.HasPrefix(rp.Target, `\??\Volume{`) {
+		return VolumeMountPoint, nil
+	}
+
+	if depth == maxLinkDepth {
+		return Directory, fmt.Errorf("too many levels of links at %s", path)
+	}
+	target := ntPathToWin32(rp.Target)
+	if !filepath.IsAbs(target) && !strings.HasPrefix(target, `\\`) {
+		// the target of a relative symlink is relative to the directory of the symlink
+		target = filepath.Join(filepath.Dir(path), target)
+	}
+	if _, err := os.Stat(target); os.IsNotExist(err) {
+		return Symlink, nil
+	}
+	targetType, err := mountPointType(target, depth+1)
+	if err != nil {
+		return Directory, err
+	}
+	switch targetType {
+	case VolumeMountPoint, SymlinkToVolume:
+		return SymlinkToVolume, nil
+	case Symlink:
+		return Symlink, nil
+	}
+	isRoot, err := isVolumeRoot(target)
+	if err != nil {
+		return Directory, err
+	}
+	if isRoot {
+		return SymlinkToVolume, nil
+	}
+	return Symlink, nil
+}
+
+// isVolumeRoot returns true if `path` is the root directory of a volume e.g. D:\,
+// \\?\Volume{GUID}\ or the root of an SMB share.
+func isVolumeRoot(path string) (bool, error) {
+	p, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return false, err
+	}
+	volumePath := make([]uint16, windows.MAX_LONG_PATH)
+	if err := windows.GetVolumePathName(p, &volumePath[0], uint32(len(volumePath))); err != nil {
+		return false, fmt.Errorf("error getting the volume of %s: %w", path, err)
+	}
+	trim := func(s string) string { return strings.ToLower(strings.TrimSuffix(s, `\`)) }
+	return trim(windows.UTF16ToString(volumePath)) == trim(path), nil
+}
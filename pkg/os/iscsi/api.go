@@ -3,8 +3,9 @@ package iscsi
 import (
 	"encoding/json"
 	"fmt"
-	"os"
-	"os/exec"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/cim"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
 )
 
 // Implements the iSCSI OS API calls. All code here should be very simple
@@ -22,14 +23,12 @@ func (APIImplementor) AddTargetPortal(portal *TargetPortal) error {
 	cmdLine := fmt.Sprintf(
 		`New-IscsiTargetPortal -TargetPortalAddress ${Env:iscsi_tp_address} ` +
 			`-TargetPortalPortNumber ${Env:iscsi_tp_port}`)
-	cmd := exec.Command("powershell.exe", "/c", cmdLine)
-	cmd.Env = append(os.Environ(),
+	out, err := utils.RunPowershellCmd(cmdLine,
 		fmt.Sprintf("iscsi_tp_address=%s", portal.Address),
-		fmt.Sprintf("iscsi_tp_port=%d", portal.Port))
-
-	out, err := cmd.CombinedOutput()
+		fmt.Sprintf("iscsi_tp_port=%d", portal.Port),
+	)
 	if err != nil {
-		return fmt.Errorf("error adding target portal. cmd %s, output: %s, err: %v", cmdLine, string(out), err)
+		return fmt.Errorf("error adding target portal. cmd %s, output: %s, err: %v", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	return nil
@@ -42,20 +41,18 @@ func (APIImplementor) DiscoverTargetPortal(portal *TargetPortal) ([]string, erro
 		`ConvertTo-Json -InputObject @(Get-IscsiTargetPortal -TargetPortalAddress ` +
 			`${Env:iscsi_tp_address} -TargetPortalPortNumber ${Env:iscsi_tp_port} | ` +
 			`Get-IscsiTarget | Select-Object -ExpandProperty NodeAddress)`)
-	cmd := exec.Command("powershell.exe", "/c", cmdLine)
-	cmd.Env = append(os.Environ(),
+	out, err := utils.RunPowershellCmd(cmdLine,
 		fmt.Sprintf("iscsi_tp_address=%s", portal.Address),
-		fmt.Sprintf("iscsi_tp_port=%d", portal.Port))
-
-	out, err := cmd.CombinedOutput()
+		fmt.Sprintf("iscsi_tp_port=%d", portal.Port),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error discovering target portal. cmd: %s, output: %s, err: %w", cmdLine, string(out), err)
+		return nil, fmt.Errorf("error discovering target portal. cmd: %s, output: %s, err: %w", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	var iqns []string
 	err = json.Unmarshal(out, &iqns)
 	if err != nil {
-		return nil, fmt.Errorf("failed parsing iqn list. cmd: %s output: %s, err: %w", cmdLine, string(out), err)
+		return nil, fmt.Errorf("failed parsing iqn list. cmd: %s output: %s, err: %w", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	return iqns, nil
@@ -66,16 +63,15 @@ func (APIImplementor) ListTargetPortals() ([]TargetPortal, error) {
 		`ConvertTo-Json -InputObject @(Get-IscsiTargetPortal | ` +
 			`Select-Object TargetPortalAddress, TargetPortalPortNumber)`)
 
-	cmd := exec.Command("powershell.exe", "/c", cmdLine)
-	out, err := cmd.CombinedOutput()
+	out, err := utils.RunPowershellCmd(cmdLine)
 	if err != nil {
-		return nil, fmt.Errorf("error listing target portals. cmd %s, output: %s, err: %w", cmdLine, string(out), err)
+		return nil, fmt.Errorf("error listing target portals. cmd %s, output: %s, err: %w", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	var portals []TargetPortal
-	err = json.Unmarshal(out, &portals)
+	err = cim.Unmarshal(out, "MSFT_iSCSITargetPortal", &portals, "TargetPortalAddress", "TargetPortalPortNumber")
 	if err != nil {
-		return nil, fmt.Errorf("failed parsing target portal list. cmd: %s output: %s, err: %w", cmdLine, string(out), err)
+		return nil, fmt.Errorf("failed parsing target portal list. cmd: %s output: %s, err: %w", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	return portals, nil
@@ -87,14 +83,12 @@ func (APIImplementor) RemoveTargetPortal(portal *TargetPortal) error {
 			`-TargetPortalPortNumber ${Env:iscsi_tp_port} | Remove-IscsiTargetPortal ` +
 			`-Confirm:$false`)
 
-	cmd := exec.Command("powershell.exe", "/c", cmdLine)
-	cmd.Env = append(os.Environ(),
+	out, err := utils.RunPowershellCmd(cmdLine,
 		fmt.Sprintf("iscsi_tp_address=%s", portal.Address),
-		fmt.Sprintf("iscsi_tp_port=%d", portal.Port))
-
-	out, err := cmd.CombinedOutput()
+		fmt.Sprintf("iscsi_tp_port=%d", portal.Port),
+	)
 	if err != nil {
-		return fmt.Errorf("error removing target portal. cmd %s, output: %s, err: %w", cmdLine, string(out), err)
+		return fmt.Errorf("error removing target portal. cmd %s, output: %s, err: %w", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	return nil
@@ -118,8 +112,7 @@ func (APIImplementor) ConnectTarget(portal *TargetPortal, iqn string,
 		cmdLine += fmt.Sprintf(` -ChapSecret ${Env:iscsi_chap_secret}`)
 	}
 
-	cmd := exec.Command("powershell.exe", "/c", cmdLine)
-	cmd.Env = append(os.Environ(),
+	out, err := utils.RunPowershellCmd(cmdLine,
 		fmt.Sprintf("iscsi_tp_address=%s", portal.Address),
 		fmt.Sprintf("iscsi_tp_port=%d", portal.Port),
 		fmt.Sprintf("iscsi_target_iqn=%s", iqn),
@@ -127,10 +120,8 @@ func (APIImplementor) ConnectTarget(portal *TargetPortal, iqn string,
 		fmt.Sprintf("iscsi_chap_user=%s", chapUser),
 		fmt.Sprintf("iscsi_chap_secret=%s", chapSecret),
 	)
-
-	out, err := cmd.CombinedOutput()
 	if err != nil {
-		return fmt.Errorf("error connecting to target portal. cmd %s, output: %s, err: %w", cmdLine, string(out), err)
+		return fmt.Errorf("error connecting to target portal. cmd %s, output: %s, err: %w", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	return nil
@@ -144,15 +135,13 @@ func (APIImplementor) DisconnectTarget(portal *TargetPortal, iqn string) error {
 			` | Get-IscsiTarget | Where-Object { $_.NodeAddress -eq ${Env:iscsi_target_iqn} }) ` +
 			`-Confirm:$false`)
 
-	cmd := exec.Command("powershell.exe", "/c", cmdLine)
-	cmd.Env = append(os.Environ(),
+	out, err := utils.RunPowershellCmd(cmdLine,
 		fmt.Sprintf("iscsi_tp_address=%s", portal.Address),
 		fmt.Sprintf("iscsi_tp_port=%d", portal.Port),
-		fmt.Sprintf("iscsi_target_iqn=%s", iqn))
-
-	out, err := cmd.CombinedOutput()
+		fmt.Sprintf("iscsi_target_iqn=%s", iqn),
+	)
 	if err != nil {
-		return fmt.Errorf("error disconnecting from target portal. cmd %s, output: %s, err: %w", cmdLine, string(out), err)
+		return fmt.Errorf("error disconnecting from target portal. cmd %s, output: %s, err: %w", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	return nil
@@ -169,21 +158,19 @@ func (APIImplementor) GetTargetDisks(portal *TargetPortal, iqn string) ([]string
 			`$ids = $c | Get-Disk | Select -ExpandProperty Number | Out-String -Stream; ` +
 			`ConvertTo-Json -InputObject @($ids)`)
 
-	cmd := exec.Command("powershell.exe", "/c", cmdLine)
-	cmd.Env = append(os.Environ(),
+	out, err := utils.RunPowershellCmd(cmdLine,
 		fmt.Sprintf("iscsi_tp_address=%s", portal.Address),
 		fmt.Sprintf("iscsi_tp_port=%d", portal.Port),
-		fmt.Sprintf("iscsi_target_iqn=%s", iqn))
-
-	out, err := cmd.CombinedOutput()
+		fmt.Sprintf("iscsi_target_iqn=%s", iqn),
+	)
 	if err != nil {
-		return nil, fmt.Errorf("error getting target disks. cmd %s, output: %s, err: %w", cmdLine, string(out), err)
+		return nil, fmt.Errorf("error getting target disks. cmd %s, output: %s, err: %w", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	var ids []string
 	err = json.Unmarshal(out, &ids)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing iqn target disks. cmd: %s output: %s, err: %w", cmdLine, string(out), err)
+		return nil, fmt.Errorf("error parsing iqn target disks. cmd: %s output: %s, err: %w", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	return ids, nil
@@ -192,14 +179,12 @@ func (APIImplementor) GetTargetDisks(portal *TargetPortal, iqn string) ([]string
 func (APIImplementor) SetMutualChapSecret(mutualChapSecret string) error {
 	cmdLine := fmt.Sprintf(
 		`Set-IscsiChapSecret -ChapSecret ${Env:iscsi_mutual_chap_secret}`)
-	cmd := exec.Command("powershell.exe", "/c", cmdLine)
-	cmd.Env = append(os.Environ(),
-		fmt.Sprintf("iscsi_mutual_chap_secret=%s", mutualChapSecret))
-
-	out, err := cmd.CombinedOutput()
+	out, err := utils.RunPowershellCmd(cmdLine,
+		fmt.Sprintf("iscsi_mutual_chap_secret=%s", mutualChapSecret),
+	)
 	if err != nil {
 		return fmt.Errorf("error setting mutual chap secret. cmd %s,"+
-			" output: %s, err: %v", cmdLine, string(out), err)
+			" output: %s, err: %v", utils.CommandText(cmdLine), string(out), err)
 	}
 
 	return nil
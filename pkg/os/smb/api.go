@@ -2,15 +2,15 @@ package smb
 
 import (
 	"fmt"
-	"os"
-	"os/exec"
 	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
 )
 
 type API interface {
 	IsSmbMapped(remotePath string) (bool, error)
 	NewSmbLink(remotePath, localPath string) error
-	NewSmbGlobalMapping(remotePath, username, password string) error
+	NewSmbGlobalMapping(remotePath string, port uint32, username, password string) error
 	RemoveSmbGlobalMapping(remotePath string) error
 }
 
@@ -24,11 +24,9 @@ func New() SmbAPI {
 
 func (SmbAPI) IsSmbMapped(remotePath string) (bool, error) {
 	cmdLine := fmt.Sprintf(`$(Get-SmbGlobalMapping -RemotePath $Env:smbremotepath -ErrorAction Stop).Status `)
-	cmd := exec.Command("powershell", "/c", cmdLine)
-	cmd.Env = append(os.Environ(),
-		fmt.Sprintf("smbremotepath=%s", remotePath))
-
-	out, err := cmd.CombinedOutput()
+	out, err := utils.RunPowershellCmd(cmdLine,
+		fmt.Sprintf("smbremotepath=%s", remotePath),
+	)
 	if err != nil {
 		return false, fmt.Errorf("error checking smb mapping. cmd %s, output: %s, err: %v", remotePath, string(out), err)
 	}
@@ -55,12 +53,10 @@ func (SmbAPI) NewSmbLink(remotePath, localPath string) error {
 	}
 
 	cmdLine := fmt.Sprintf(`New-Item -ItemType SymbolicLink $Env:smblocalPath -Target $Env:smbremotepath`)
-	cmd := exec.Command("powershell", "/c", cmdLine)
-	cmd.Env = append(os.Environ(),
+	output, err := utils.RunPowershellCmd(cmdLine,
 		fmt.Sprintf("smbremotepath=%s", remotePath),
 		fmt.Sprintf("smblocalpath=%s", localPath),
 	)
-	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return fmt.Errorf("error linking %s to %s. output: %s, err: %v", remotePath, localPath, string(output), err)
 	}
@@ -68,28 +64,32 @@ func (SmbAPI) NewSmbLink(remotePath, localPath string) error {
 	return nil
 }
 
-func (SmbAPI) NewSmbGlobalMapping(remotePath, username, password string) error {
+// NewSmbGlobalMapping maps the remote path `remotePath` with the credentials of
+// `username`. The server is reached on the TCP port `port` if it's not 0, SMB over
+// alternative ports requires Windows Server 2025 or later.
+func (SmbAPI) NewSmbGlobalMapping(remotePath string, port uint32, username, password string) error {
 	// use PowerShell Environment Variables to store user input string to prevent command line injection
 	// https://docs.microsoft.com/en-us/powershell/module/microsoft.powershell.core/about/about_environment_variables?view=powershell-5.1
 	cmdLine := fmt.Sprintf(`$PWord = ConvertTo-SecureString -String $Env:smbpassword -AsPlainText -Force` +
 		`;$Credential = New-Object -TypeName System.Management.Automation.PSCredential -ArgumentList $Env:smbuser, $PWord` +
 		`;New-SmbGlobalMapping -RemotePath $Env:smbremotepath -Credential $Credential -RequirePrivacy $true`)
+	if port != 0 {
+		cmdLine += ` -TcpPort $Env:smbport`
+	}
 
-	cmd := exec.Command("powershell", "/c", cmdLine)
-	cmd.Env = append(os.Environ(),
+	if output, err := utils.RunPowershellCmd(cmdLine,
 		fmt.Sprintf("smbuser=%s", username),
 		fmt.Sprintf("smbpassword=%s", password),
-		fmt.Sprintf("smbremotepath=%s", remotePath))
-	if output, err := cmd.CombinedOutput(); err != nil {
+		fmt.Sprintf("smbremotepath=%s", remotePath),
+		fmt.Sprintf("smbport=%d", port),
+	); err != nil {
 		return fmt.Errorf("NewSmbGlobalMapping failed. output: %q, err: %v", string(output), err)
 	}
 	return nil
 }
 
 func (SmbAPI) RemoveSmbGlobalMapping(remotePath string) error {
-	cmd := exec.Command("powershell", "/c", `Remove-SmbGlobalMapping -RemotePath $Env:smbremotepath -Force`)
-	cmd.Env = append(os.Environ(), fmt.Sprintf("smbremotepath=%s", remotePath))
-	if output, err := cmd.CombinedOutput(); err != nil {
+	if output, err := utils.RunPowershellCmd(`Remove-SmbGlobalMapping -RemotePath $Env:smbremotepath -Force`, fmt.Sprintf("smbremotepath=%s", remotePath)); err != nil {
 		return fmt.Errorf("UnmountSmbShare failed. output: %q, err: %v", string(output), err)
 	}
 	return nil
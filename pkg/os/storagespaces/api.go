@@ -0,0 +1,202 @@
+package storagespaces
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/cim"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+)
+
+// Implements the Storage Spaces OS API calls with the cmdlets of the Storage
+// module. All code here should be very simple pass-through to the OS APIs, any
+// logic around the APIs goes in pkg/server/storagespaces/server.go.
+//
+// The names of the pools and of the virtual disks are passed in environment
+// variables and compared with -eq instead of the -FriendlyName parameters of the
+// cmdlets, which treat them as wildcard patterns. The Get cmdlets are called
+// without filter parameters as they fail when no instance matches.
+
+type API interface {
+	// ListPoolablePhysicalDisks lists the physical disks that can be added to a storage pool.
+	ListPoolablePhysicalDisks() ([]PhysicalDisk, error)
+	// CreateStoragePool creates the storage pool `name` from the physical disks `diskNumbers`.
+	CreateStoragePool(name string, diskNumbers []uint32) (*StoragePool, error)
+	// DeleteStoragePool deletes a storage pool, it doesn't fail if it doesn't exist.
+	DeleteStoragePool(name string) error
+	// ListStoragePools lists the storage pools except the primordial pool.
+	ListStoragePools() ([]StoragePool, error)
+	// CreateVirtualDisk creates the virtual disk `name` of `sizeBytes` bytes in the storage pool `poolName`.
+	CreateVirtualDisk(poolName, name string, sizeBytes int64, resiliency Resiliency, thinProvisioning bool) (*VirtualDisk, error)
+	// DeleteVirtualDisk deletes a virtual disk of a storage pool, it doesn't fail if it doesn't exist.
+	DeleteVirtualDisk(poolName, name string) error
+	// ListVirtualDisks lists the virtual disks of a storage pool.
+	ListVirtualDisks(poolName string) ([]VirtualDisk, error)
+}
+
+type StorageSpacesAPI struct{}
+
+var _ API = &StorageSpacesAPI{}
+
+func New() StorageSpacesAPI {
+	return StorageSpacesAPI{}
+}
+
+// storagePoolProperties are the properties of MSFT_StoragePool selected by listStoragePools.
+var storagePoolProperties = []string{"FriendlyName", "UniqueId", "HealthStatus", "Size", "AllocatedSize", "DiskNumbers"}
+
+// virtualDiskProperties are the properties of MSFT_VirtualDisk selected by ListVirtualDisks.
+var virtualDiskProperties = []string{"FriendlyName", "StoragePoolFriendlyName", "UniqueId", "Size", "ResiliencySettingName", "ThinProvisioning", "HealthStatus", "DiskNumber"}
+
+// getStoragePool gets the storage pool named $Env:storagepool, its result is empty
+// if it doesn't exist.
+const getStoragePool = `Get-StoragePool -ErrorAction Stop | Where-Object { -not $_.IsPrimordial -and $_.FriendlyName -eq $Env:storagepool }`
+
+func (StorageSpacesAPI) ListPoolablePhysicalDisks() ([]PhysicalDisk, error) {
+	// ConvertTo-Json is not part of the pipeline because powershell converts an
+	// array with one element to a single element
+	cmdLine := `ConvertTo-Json @(Get-PhysicalDisk -ErrorAction Stop | Where-Object { $_.CanPool } | Select-Object ` + strings.Join([]string{
+		`@{n='DiskNumber';e={[uint32]$_.DeviceId}}`,
+		`@{n='SerialNumber';e={([string]$_.SerialNumber).Trim()}}`,
+		`@{n='BusType';e={[string]$_.BusType}}`,
+		`@{n='MediaType';e={[string]$_.MediaType}}`,
+		`Size`,
+	}, ", ") + `)`
+	out, err := utils.RunPowershellCmd(cmdLine)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the poolable physical disks. cmd: %s, output: %s, error: %v", utils.CommandText(cmdLine), string(out), err)
+	}
+
+	var disks []PhysicalDisk
+	if err := cim.Unmarshal(out, "MSFT_PhysicalDisk", &disks, "DiskNumber", "SerialNumber", "BusType", "MediaType", "Size"); err != nil {
+		return nil, fmt.Errorf("failed parsing the poolable physical disks. cmd: %s, output: %s, error: %v", utils.CommandText(cmdLine), string(out), err)
+	}
+	return disks, nil
+}
+
+func (StorageSpacesAPI) CreateStoragePool(name string, diskNumbers []uint32) (*StoragePool, error) {
+	numbers := make([]string, len(diskNumbers))
+	for i, n := range diskNumbers {
+		numbers[i] = strconv.FormatUint(uint64(n), 10)
+	}
+	cmdLine := `$numbers = $Env:storagedisks.Split(','); ` +
+		`$disks = @(Get-PhysicalDisk -ErrorAction Stop | Where-Object { $_.CanPool -and $numbers -contains $_.DeviceId }); ` +
+		`if ($disks.Count -ne $numbers.Count) { throw "physical disks $Env:storagedisks can't all be pooled" }; ` +
+		`New-StoragePool -FriendlyName $Env:storagepool -StorageSubSystemFriendlyName 'Windows Storage*' -PhysicalDisks $disks -ErrorAction Stop | Out-Null`
+	out, err := utils.RunPowershellCmd(cmdLine, fmt.Sprintf("storagepool=%s", name), fmt.Sprintf("storagedisks=%s", strings.Join(numbers, ",")))
+	if err != nil {
+		return nil, fmt.Errorf("error creating storage pool %s. cmd: %s, output: %s, error: %v", name, utils.CommandText(cmdLine), string(out), err)
+	}
+
+	pools, err := listStoragePools(`$_.FriendlyName -eq $Env:storagepool`, fmt.Sprintf("storagepool=%s", name))
+	if err != nil {
+		return nil, err
+	}
+	if len(pools) != 1 {
+		return nil, fmt.Errorf("storage pool %s not found after it was created", name)
+	}
+	return &pools[0], nil
+}
+
+func (StorageSpacesAPI) DeleteStoragePool(name string) error {
+	cmdLine := getStoragePool + ` | Remove-StoragePool -Confirm:$false -ErrorAction Stop`
+	out, err := utils.RunPowershellCmd(cmdLine, fmt.Sprintf("storagepool=%s", name))
+	if err != nil {
+		return fmt.Errorf("error deleting storage pool %s. cmd: %s, output: %s, error: %v", name, utils.CommandText(cmdLine), string(out), err)
+	}
+	return nil
+}
+
+func (StorageSpacesAPI) ListStoragePools() ([]StoragePool, error) {
+	return listStoragePools(`$true`)
+}
+
+func (StorageSpacesAPI) CreateVirtualDisk(poolName, name string, sizeBytes int64, resiliency Resiliency, thinProvisioning bool) (*VirtualDisk, error) {
+	provisioning := "Fixed"
+	if thinProvisioning {
+		provisioning = "Thin"
+	}
+	cmdLine := `$pool = ` + getStoragePool + `; ` +
+		`if (-not $pool) { throw "storage pool $Env:storagepool not found" }; ` +
+		fmt.Sprintf(`New-VirtualDisk -StoragePoolUniqueId $pool.UniqueId -FriendlyName $Env:storagevirtualdisk -Size %d -ResiliencySettingName %s -ProvisioningType %s -ErrorAction Stop | Out-Null`,
+			sizeBytes, resiliency, provisioning)
+	out, err := utils.RunPowershellCmd(cmdLine, fmt.Sprintf("storagepool=%s", poolName), fmt.Sprintf("storagevirtualdisk=%s", name))
+	if err != nil {
+		return nil, fmt.Errorf("error creating virtual disk %s in storage pool %s. cmd: %s, output: %s, error: %v", name, poolName, utils.CommandText(cmdLine), string(out), err)
+	}
+
+	disks, err := listVirtualDisks(poolName, `$_.FriendlyName -eq $Env:storagevirtualdisk`, fmt.Sprintf("storagevirtualdisk=%s", name))
+	if err != nil {
+		return nil, err
+	}
+	if len(disks) != 1 {
+		return nil, fmt.Errorf("virtual disk %s of storage pool %s not found after it was created", name, poolName)
+	}
+	return &disks[0], nil
+}
+
+func (StorageSpacesAPI) DeleteVirtualDisk(poolName, name string) error {
+	cmdLine := `Get-VirtualDisk -ErrorAction Stop | ` +
+		`Where-Object { $_.FriendlyName -eq $Env:storagevirtualdisk -and ($_ | Get-StoragePool).FriendlyName -eq $Env:storagepool } | ` +
+		`Remove-VirtualDisk -Confirm:$false -ErrorAction Stop`
+	out, err := utils.RunPowershellCmd(cmdLine, fmt.Sprintf("storagepool=%s", poolName), fmt.Sprintf("storagevirtualdisk=%s", name))
+	if err != nil {
+		return fmt.Errorf("error deleting virtual disk %s of storage pool %s. cmd: %s, output: %s, error: %v", name, poolName, utils.CommandText(cmdLine), string(out), err)
+	}
+	return nil
+}
+
+func (StorageSpacesAPI) ListVirtualDisks(poolName string) ([]VirtualDisk, error) {
+	return listVirtualDisks(poolName, `$true`)
+}
+
+// listStoragePools lists the storage pools that match the PowerShell condition
+// `filter`, the values it compares are passed in the environment variables `envs`.
+func listStoragePools(filter string, envs ...string) ([]StoragePool, error) {
+	cmdLine := fmt.Sprintf(`ConvertTo-Json @(Get-StoragePool -ErrorAction Stop | `+
+		`Where-Object { -not $_.IsPrimordial -and (%s) } | Select-Object `, filter) + strings.Join([]string{
+		`FriendlyName`,
+		`UniqueId`,
+		`@{n='HealthStatus';e={[string]$_.HealthStatus}}`,
+		`Size`,
+		`AllocatedSize`,
+		`@{n='DiskNumbers';e={@($_ | Get-PhysicalDisk -ErrorAction Stop | ForEach-Object { [uint32]$_.DeviceId })}}`,
+	}, ", ") + `)`
+	out, err := utils.RunPowershellCmd(cmdLine, envs...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing storage pools. cmd: %s, output: %s, error: %v", utils.CommandText(cmdLine), string(out), err)
+	}
+
+	var pools []StoragePool
+	if err := cim.Unmarshal(out, "MSFT_StoragePool", &pools, storagePoolProperties...); err != nil {
+		return nil, fmt.Errorf("failed parsing storage pools. cmd: %s, output: %s, error: %v", utils.CommandText(cmdLine), string(out), err)
+	}
+	return pools, nil
+}
+
+// listVirtualDisks lists the virtual disks of the storage pool `poolName` that
+// match the PowerShell condition `filter`, the values it compares are passed in
+// the environment variables `envs`.
+func listVirtualDisks(poolName, filter string, envs ...string) ([]VirtualDisk, error) {
+	cmdLine := `ConvertTo-Json @(Get-VirtualDisk -ErrorAction Stop | Select-Object ` + strings.Join([]string{
+		`FriendlyName`,
+		`@{n='StoragePoolFriendlyName';e={($_ | Get-StoragePool -ErrorAction Stop).FriendlyName}}`,
+		`UniqueId`,
+		`Size`,
+		`ResiliencySettingName`,
+		`@{n='ThinProvisioning';e={[string]$_.ProvisioningType -eq 'Thin'}}`,
+		`@{n='HealthStatus';e={[string]$_.HealthStatus}}`,
+		`@{n='DiskNumber';e={($_ | Get-Disk -ErrorAction SilentlyContinue).Number}}`,
+	}, ", ") + fmt.Sprintf(` | Where-Object { $_.StoragePoolFriendlyName -eq $Env:storagepool -and (%s) })`, filter)
+	out, err := utils.RunPowershellCmd(cmdLine, append([]string{fmt.Sprintf("storagepool=%s", poolName)}, envs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the virtual disks of storage pool %s. cmd: %s, output: %s, error: %v", poolName, utils.CommandText(cmdLine), string(out), err)
+	}
+
+	var disks []VirtualDisk
+	if err := cim.Unmarshal(out, "MSFT_VirtualDisk", &disks, virtualDiskProperties...); err != nil {
+		return nil, fmt.Errorf("failed parsing the virtual disks of storage pool %s. cmd: %s, output: %s, error: %v", poolName, utils.CommandText(cmdLine), string(out), err)
+	}
+	return disks, nil
+}
@@ -0,0 +1,46 @@
+package storagespaces
+
+// Resiliency is the resiliency setting of a virtual disk, the values are the
+// names of the resiliency settings of the Storage cmdlets.
+type Resiliency string
+
+const (
+	ResiliencySimple Resiliency = "Simple"
+	ResiliencyMirror Resiliency = "Mirror"
+	ResiliencyParity Resiliency = "Parity"
+)
+
+// PhysicalDisk is a physical disk that can be added to a storage pool.
+// JSON field names are the MSFT_PhysicalDisk field names, DiskNumber is its DeviceId.
+type PhysicalDisk struct {
+	DiskNumber   uint32 `json:"DiskNumber"`
+	SerialNumber string `json:"SerialNumber"`
+	BusType      string `json:"BusType"`
+	MediaType    string `json:"MediaType"`
+	Size         int64  `json:"Size"`
+}
+
+// StoragePool is a storage pool that isn't the primordial pool.
+// JSON field names are the MSFT_StoragePool field names.
+type StoragePool struct {
+	Name          string   `json:"FriendlyName"`
+	UniqueID      string   `json:"UniqueId"`
+	HealthStatus  string   `json:"HealthStatus"`
+	Size          int64    `json:"Size"`
+	AllocatedSize int64    `json:"AllocatedSize"`
+	DiskNumbers   []uint32 `json:"DiskNumbers"`
+}
+
+// VirtualDisk is a virtual disk of a storage pool.
+// JSON field names are the MSFT_VirtualDisk field names.
+type VirtualDisk struct {
+	Name             string     `json:"FriendlyName"`
+	PoolName         string     `json:"StoragePoolFriendlyName"`
+	UniqueID         string     `json:"UniqueId"`
+	Size             int64      `json:"Size"`
+	Resiliency       Resiliency `json:"ResiliencySettingName"`
+	ThinProvisioning bool       `json:"ThinProvisioning"`
+	HealthStatus     string     `json:"HealthStatus"`
+	// DiskNumber is nil if the virtual disk isn't attached
+	DiskNumber *uint32 `json:"DiskNumber"`
+}
@@ -0,0 +1,68 @@
+package vhd
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// Implements the VHD and VHDX OS API calls with the virtual disk functions of
+// virtdisk.dll, they don't require the Hyper-V role unlike the Hyper-V cmdlets
+// (e.g. New-VHD). All code here should be very simple pass-through to the OS
+// APIs, any logic around the APIs goes in pkg/server/vhd/server.go.
+
+type API interface {
+	// CreateVirtualDisk creates the virtual disk file `path` of `sizeBytes` bytes,
+	// its space is allocated up front if `fixed` is set.
+	CreateVirtualDisk(path string, sizeBytes int64, fixed bool) error
+	// AttachVirtualDisk attaches a virtual disk without a drive letter until it's
+	// detached or the host restarts and returns the number of its disk, it returns
+	// the number of the disk if it's attached already.
+	AttachVirtualDisk(path string, readOnly bool) (uint32, error)
+	// DetachVirtualDisk detaches a virtual disk, it doesn't fail if it isn't attached.
+	DetachVirtualDisk(path string) error
+	// ResizeVirtualDisk expands a virtual disk to `sizeBytes` bytes.
+	ResizeVirtualDisk(path string, sizeBytes int64) error
+	// GetVirtualDisk gets a virtual disk, the error wraps os.ErrNotExist if the
+	// file doesn't exist.
+	GetVirtualDisk(path string) (*VirtualDisk, error)
+}
+
+type VhdAPI struct{}
+
+var _ API = &VhdAPI{}
+
+func New() VhdAPI {
+	return VhdAPI{}
+}
+
+// FormatOf returns the format of the virtual disk file `path` by its extension,
+// false if it isn't a VHD or VHDX file.
+func FormatOf(path string) (Format, bool) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".vhd":
+		return FormatVHD, true
+	case ".vhdx":
+		return FormatVHDX, true
+	}
+	return "", false
+}
+
+func (VhdAPI) CreateVirtualDisk(path string, sizeBytes int64, fixed bool) error {
+	return createVirtualDisk(path, sizeBytes, fixed)
+}
+
+func (VhdAPI) AttachVirtualDisk(path string, readOnly bool) (uint32, error) {
+	return attachVirtualDisk(path, readOnly)
+}
+
+func (VhdAPI) DetachVirtualDisk(path string) error {
+	return detachVirtualDisk(path)
+}
+
+func (VhdAPI) ResizeVirtualDisk(path string, sizeBytes int64) error {
+	return resizeVirtualDisk(path, sizeBytes)
+}
+
+func (VhdAPI) GetVirtualDisk(path string) (*VirtualDisk, error) {
+	return getVirtualDisk(path)
+}
@@ -0,0 +1,32 @@
+//go:build !windows
+// +build !windows
+
+package vhd
+
+import "errors"
+
+// The virtual disk functions are only available on Windows, these stubs let the
+// packages that depend on the VHD API build on other platforms e.g. to run their
+// unit tests with a fake API.
+
+var errNotSupported = errors.New("virtual disk operations are only supported on Windows")
+
+func createVirtualDisk(path string, sizeBytes int64, fixed bool) error {
+	return errNotSupported
+}
+
+func attachVirtualDisk(path string, readOnly bool) (uint32, error) {
+	return 0, errNotSupported
+}
+
+func detachVirtualDisk(path string) error {
+	return errNotSupported
+}
+
+func resizeVirtualDisk(path string, sizeBytes int64) error {
+	return errNotSupported
+}
+
+func getVirtualDisk(path string) (*VirtualDisk, error) {
+	return nil, errNotSupported
+}
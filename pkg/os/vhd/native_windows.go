@@ -0,0 +1,181 @@
+package vhd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/Microsoft/go-winio/vhd"
+	"golang.org/x/sys/windows"
+)
+
+// The functions of virtdisk.dll that go-winio doesn't wrap.
+var (
+	modvirtdisk                   = windows.NewLazySystemDLL("virtdisk.dll")
+	procResizeVirtualDisk         = modvirtdisk.NewProc("ResizeVirtualDisk")
+	procGetVirtualDiskInformation = modvirtdisk.NewProc("GetVirtualDiskInformation")
+)
+
+const (
+	resizeVirtualDiskVersion1 = 1
+	resizeVirtualDiskFlagNone = 0
+	getVirtualDiskInfoSize    = 1
+)
+
+// resizeVirtualDiskParameters is RESIZE_VIRTUAL_DISK_PARAMETERS version 1, the
+// union is aligned on 8 bytes.
+type resizeVirtualDiskParameters struct {
+	Version uint32
+	_       uint32
+	NewSize uint64
+}
+
+// virtualDiskSizeInfo is GET_VIRTUAL_DISK_INFO with its Size member, the largest
+// member of the union.
+type virtualDiskSizeInfo struct {
+	Version      uint32
+	_            uint32
+	VirtualSize  uint64
+	PhysicalSize uint64
+	BlockSize    uint32
+	SectorSize   uint32
+}
+
+// openVirtualDisk opens the virtual disk `path`, the error wraps os.ErrNotExist
+// if the file doesn't exist.
+func openVirtualDisk(path string) (syscall.Handle, error) {
+	handle, err := vhd.OpenVirtualDisk(path, vhd.VirtualDiskAccessNone, vhd.OpenVirtualDiskFlagNone)
+	if err != nil {
+		if _, statErr := os.Stat(path); os.IsNotExist(statErr) {
+			return 0, fmt.Errorf("virtual disk %s: %w", path, os.ErrNotExist)
+		}
+		return 0, fmt.Errorf("error opening virtual disk %s: %w", path, err)
+	}
+	return handle, nil
+}
+
+// attachedDiskNumber returns the number of the disk of the virtual disk `path`
+// opened as `handle`, false if it isn't attached.
+func attachedDiskNumber(handle syscall.Handle, path string) (uint32, bool, error) {
+	// the physical path of a virtual disk is only available while it's attached
+	physicalPath, err := vhd.GetVirtualDiskPhysicalPath(handle)
+	if err != nil {
+		return 0, false, nil
+	}
+	// e.g. \\.\PhysicalDrive3
+	n, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(physicalPath), `\\.\physicaldrive`), 10, 32)
+	if err != nil {
+		return 0, false, fmt.Errorf("unexpected physical path %q of virtual disk %s", physicalPath, path)
+	}
+	return uint32(n), true, nil
+}
+
+func createVirtualDisk(path string, sizeBytes int64, fixed bool) error {
+	// the format is chosen by the extension of the path
+	params := vhd.CreateVirtualDiskParameters{
+		Version:  2,
+		Version2: vhd.CreateVersion2{MaximumSize: uint64(sizeBytes)},
+	}
+	flags := vhd.CreateVirtualDiskFlagNone
+	if fixed {
+		flags = vhd.CreateVirtualDiskFlagFullPhysicalAllocation
+	}
+	handle, err := vhd.CreateVirtualDisk(path, vhd.VirtualDiskAccessNone, flags, &params)
+	if err != nil {
+		return fmt.Errorf("error creating virtual disk %s: %w", path, err)
+	}
+	return syscall.CloseHandle(handle)
+}
+
+func attachVirtualDisk(path string, readOnly bool) (uint32, error) {
+	handle, err := openVirtualDisk(path)
+	if err != nil {
+		return 0, err
+	}
+	defer syscall.CloseHandle(handle)
+	if n, attached, err := attachedDiskNumber(handle, path); err != nil || attached {
+		return n, err
+	}
+
+	// the disk is detached when the handle is closed without a permanent lifetime
+	params := vhd.AttachVirtualDiskParameters{Version: 2}
+	flags := vhd.AttachVirtualDiskFlagNoDriveLetter | vhd.AttachVirtualDiskFlagPermanentLifetime
+	if readOnly {
+		flags |= vhd.AttachVirtualDiskFlagReadOnly
+	}
+	if err := vhd.AttachVirtualDisk(handle, flags, &params); err != nil {
+		return 0, fmt.Errorf("error attaching virtual disk %s: %w", path, err)
+	}
+	n, attached, err := attachedDiskNumber(handle, path)
+	if err == nil && !attached {
+		err = fmt.Errorf("error getting the disk of virtual disk %s", path)
+	}
+	return n, err
+}
+
+func detachVirtualDisk(path string) error {
+	handle, err := openVirtualDisk(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	defer syscall.CloseHandle(handle)
+	if _, attached, _ := attachedDiskNumber(handle, path); !attached {
+		return nil
+	}
+	if err := vhd.DetachVirtualDisk(handle); err != nil {
+		return fmt.Errorf("error detaching virtual disk %s: %w", path, err)
+	}
+	return nil
+}
+
+func resizeVirtualDisk(path string, sizeBytes int64) error {
+	handle, err := openVirtualDisk(path)
+	if err != nil {
+		return err
+	}
+	defer syscall.CloseHandle(handle)
+	params := resizeVirtualDiskParameters{
+		Version: resizeVirtualDiskVersion1,
+		NewSize: uint64(sizeBytes),
+	}
+	r, _, _ := procResizeVirtualDisk.Call(uintptr(handle), resizeVirtualDiskFlagNone, uintptr(unsafe.Pointer(&params)), 0)
+	if r != 0 {
+		return fmt.Errorf("error resizing virtual disk %s: %w", path, syscall.Errno(r))
+	}
+	return nil
+}
+
+func getVirtualDisk(path string) (*VirtualDisk, error) {
+	handle, err := openVirtualDisk(path)
+	if err != nil {
+		return nil, err
+	}
+	defer syscall.CloseHandle(handle)
+	info := virtualDiskSizeInfo{Version: getVirtualDiskInfoSize}
+	size := uint32(unsafe.Sizeof(info))
+	// the size used by the information isn't needed
+	r, _, _ := procGetVirtualDiskInformation.Call(uintptr(handle), uintptr(unsafe.Pointer(&size)), uintptr(unsafe.Pointer(&info)), 0)
+	if r != 0 {
+		return nil, fmt.Errorf("error getting the size of virtual disk %s: %w", path, syscall.Errno(r))
+	}
+	diskNumber, attached, err := attachedDiskNumber(handle, path)
+	if err != nil {
+		return nil, err
+	}
+	format, _ := FormatOf(path)
+	return &VirtualDisk{
+		Path:          path,
+		Format:        format,
+		SizeBytes:     int64(info.VirtualSize),
+		FileSizeBytes: int64(info.PhysicalSize),
+		Attached:      attached,
+		DiskNumber:    diskNumber,
+	}, nil
+}
@@ -0,0 +1,23 @@
+package vhd
+
+// Format is the format of a virtual disk file, it's chosen by the extension of the file.
+type Format string
+
+const (
+	FormatVHD  Format = "VHD"
+	FormatVHDX Format = "VHDX"
+)
+
+// VirtualDisk is a VHD or VHDX file.
+type VirtualDisk struct {
+	Path   string
+	Format Format
+	// SizeBytes is the size of the disk seen by the host.
+	SizeBytes int64
+	// FileSizeBytes is the space the file takes on the host, it's smaller than
+	// SizeBytes for a dynamic disk that isn't full.
+	FileSizeBytes int64
+	Attached      bool
+	// DiskNumber is the number of the disk while it's attached.
+	DiskNumber uint32
+}
@@ -1,43 +1,129 @@
 package volume
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"regexp"
-	"strconv"
+	"sort"
 	"strings"
 
+	"github.com/kubernetes-csi/csi-proxy/pkg/metrics"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/cim"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
 	"k8s.io/klog/v2"
 )
 
 // API exposes the internal volume operations available in the server
 type API interface {
-	// ListVolumesOnDisk lists volumes on a disk identified by a `diskNumber` and optionally a partition identified by `partitionNumber`.
-	ListVolumesOnDisk(diskNumber uint32, partitionNumber uint32) (volumeIDs []string, err error)
-	// MountVolume mounts the volume at the requested global staging target path.
-	MountVolume(volumeID, targetPath string) error
+	// ListVolumesOnDisk lists volumes on a disk identified by a `diskNumber` and optionally a partition identified by `partitionNumber`,
+	// sorted by partition number. DiskSerialNumber isn't set.
+	ListVolumesOnDisk(diskNumber uint32, partitionNumber uint32) ([]VolumeInfo, error)
+	// ListVolumes lists all the volumes of the host with the disk and the partition where they are.
+	ListVolumes() ([]VolumeInfo, error)
+	// MountVolume mounts the volume at the requested global staging target path,
+	// the volume is made read-only if `readOnly` is set.
+	MountVolume(volumeID, targetPath string, readOnly bool) error
+	// CreateDirectories creates the missing directories of `path` with the security descriptor `sddl`
+	// (SDDL form, they inherit the permissions of their parent if it's empty) and returns them from the
+	// top most one.
+	CreateDirectories(path string, sddl string) (created []string, err error)
+	// RemoveDirectories removes the directories returned by CreateDirectories if they're empty.
+	RemoveDirectories(dirs []string) error
+	// GetMountMarker gets the mount marker of a volume, nil if it doesn't have one.
+	GetMountMarker(volumeID string) (*MountMarker, error)
+	// SetMountMarker sets the mount marker of a volume, a nil `marker` removes it.
+	SetMountMarker(volumeID string, marker *MountMarker) error
 	// UnmountVolume gracefully dismounts a volume.
 	UnmountVolume(volumeID, targetPath string) error
-	// IsVolumeFormatted checks if a volume is formatted with NTFS.
-	IsVolumeFormatted(volumeID string) (bool, error)
-	// FormatVolume formats a volume with the NTFS format.
-	FormatVolume(volumeID string) error
-	// ResizeVolume performs resizing of the partition and file system for a block based volume.
-	ResizeVolume(volumeID string, sizeBytes int64) error
-	// GetVolumeStats gets the volume information.
-	GetVolumeStats(volumeID string) (int64, int64, error)
+	// ListOpenHandles lists the handles of the processes to the files of a volume.
+	ListOpenHandles(volumeID string) ([]OpenHandle, error)
+	// DismountVolume dismounts the file system of a volume even if it has open handles.
+	DismountVolume(volumeID string) error
+	// IsVolumeFormatted checks if a volume is formatted and returns its file system e.g. NTFS or ReFS.
+	IsVolumeFormatted(volumeID string) (formatted bool, fileSystemType string, err error)
+	// IsVolumeRaw checks if the first sectors of a volume are zero i.e. it was never formatted, unlike a
+	// volume with a damaged file system or a file system that Windows doesn't recognize.
+	IsVolumeRaw(volumeID string) (bool, error)
+	// FormatVolume formats a volume with the NTFS format and the options `options`.
+	FormatVolume(volumeID string, options FormatOptions) error
+	// CleanupVolume deletes the temporary files of a volume, it returns the files that couldn't be
+	// deleted as warnings.
+	CleanupVolume(volumeID string, options CleanupOptions) (warnings []string, err error)
+	// EnableDeduplication enables the Data Deduplication of a volume tuned for the workload `usageType`.
+	EnableDeduplication(volumeID string, usageType DedupUsageType) error
+	// GetDeduplicationStatus gets the Data Deduplication status and savings of a volume.
+	GetDeduplicationStatus(volumeID string) (*DedupStatus, error)
+	// SetVolumeCompression enables or disables the NTFS compression of the files created in a volume.
+	SetVolumeCompression(volumeID string, enabled bool) error
+	// SetVolumeIndexing allows or prevents the Windows Search indexing of the contents of the files
+	// created in a volume.
+	SetVolumeIndexing(volumeID string, enabled bool) error
+	// ResizeVolume performs resizing of the partition and file system for a block based volume,
+	// the volume is only shrunk if `allowShrink` is set. The caveats of a resize that don't fail it
+	// are returned as warnings.
+	ResizeVolume(volumeID string, sizeBytes int64, allowShrink bool) (warnings []string, err error)
+	// RepairVolume repairs the file system of a volume and returns the result reported by Repair-Volume.
+	RepairVolume(volumeID string, mode RepairMode) (string, error)
+	// IsVolumeDirty checks if the dirty bit of the file system of a volume is set.
+	IsVolumeDirty(volumeID string) (bool, error)
+	// OptimizeVolume optimizes a volume with Optimize-Volume.
+	OptimizeVolume(volumeID string, options OptimizeOptions) error
+	// GetVolumeLabel returns the file system label of a volume.
+	GetVolumeLabel(volumeID string) (string, error)
+	// GetVolumeSerialNumber gets the serial number of the file system of a volume e.g. 1A2B-3C4D, empty if the
+	// volume isn't formatted.
+	GetVolumeSerialNumber(volumeID string) (string, error)
+	// SetVolumeLabel changes the file system label of a volume, an empty `label` removes it.
+	SetVolumeLabel(volumeID string, label string) error
+	// GetVolumeStats gets the sizes, the file system and the label of a volume, HealthStatus and
+	// OperationalStatus aren't set.
+	GetVolumeStats(volumeID string) (*VolumeStats, error)
+	// HasVolumeSpaceChanged checks in the USN change journal of a volume if files were created, deleted,
+	// extended or truncated since the journal position `since`, it's always true if `since` is nil. The
+	// journal is created if the volume doesn't have one. It also returns the current journal position.
+	HasVolumeSpaceChanged(volumeID string, since *JournalPosition) (changed bool, position *JournalPosition, err error)
+	// GetVolumeHealth gets the health status and the operational status of a volume.
+	GetVolumeHealth(volumeID string) (healthStatus string, operationalStatus string, err error)
 	// GetDiskNumberFromVolumeID returns the disk number for a given volumeID.
 	GetDiskNumberFromVolumeID(volumeID string) (uint32, error)
+	// GetVolumeDisk returns the properties of the disk of the volume `volumeID` used by the disk policy.
+	GetVolumeDisk(volumeID string) (*shared.DiskInfo, error)
 	// GetVolumeIDFromTargetPath returns the volume id of a given target path.
 	GetVolumeIDFromTargetPath(targetPath string) (string, error)
 	// WriteVolumeCache writes the volume `volumeID`'s cache to disk.
 	WriteVolumeCache(volumeID string) error
+	// SyncVolume flushes the data and the metadata of the files of the volume `volumeID` to
+	// stable storage, it returns when the disk acknowledged the flush.
+	SyncVolume(volumeID string) error
+	// SetVolumeWriteCache enables or disables the write cache of the disk of the volume `volumeID`,
+	// the writes to a disk whose write cache is disabled complete once they're on stable storage.
+	SetVolumeWriteCache(volumeID string, enabled bool) error
+	// GetVolumeWriteCache checks if the write cache of the disk of the volume `volumeID` is enabled.
+	GetVolumeWriteCache(volumeID string) (bool, error)
 	// GetVolumeIDFromTargetPath returns the volume id of a given target path.
 	GetClosestVolumeIDFromTargetPath(targetPath string) (string, error)
+	// GetVolumeDriveLetter returns the drive letter of the volume `volumeID`, empty if it doesn't have one.
+	GetVolumeDriveLetter(volumeID string) (string, error)
+	// GetVolumeAccessPaths returns the paths where the volume `volumeID` is accessible (its drive letters,
+	// its mount points and its volume device ID path) and its drive letters.
+	GetVolumeAccessPaths(volumeID string) (accessPaths []string, driveLetters []string, err error)
+	// ListDriveLetters returns the drive letters in use in the host.
+	ListDriveLetters() ([]string, error)
+	// WatchVolumes calls `handler` with the volumes that appear, disappear or change until `ctx` is done
+	// or `handler` returns an error, which is returned.
+	WatchVolumes(ctx context.Context, handler func(VolumeEvent) error) error
+	// CreateBoundedVolume creates the dynamic VHDX `vhdPath` of `sizeBytes` bytes if it doesn't exist,
+	// attaches it if it isn't attached and returns the ID of its NTFS volume, which is created the first
+	// time. The VHDX stays attached until it's deleted or the host restarts.
+	CreateBoundedVolume(vhdPath string, sizeBytes int64) (string, error)
+	// DeleteBoundedVolume detaches the VHDX `vhdPath` of a bounded volume and deletes it.
+	DeleteBoundedVolume(vhdPath string) error
 }
 
 // VolumeAPI implements the internal Volume APIs
@@ -63,203 +149,854 @@ func New() VolumeAPI {
 }
 
 func runExec(command string) ([]byte, error) {
-	cmd := exec.Command("powershell", "/c", command)
-	klog.V(4).Infof("Executing command: %q", cmd.String())
-	out, err := cmd.CombinedOutput()
-	return out, err
+	return utils.RunPowershellCmd(command)
 }
 
-func getVolumeSize(volumeID string) (int64, error) {
-	cmd := fmt.Sprintf("(Get-Volume -UniqueId \"%s\" | Get-partition).Size", volumeID)
-	out, err := runExec(cmd)
+// storageModule returns true if the cmdlets of the Storage module are available,
+// the volumes are queried and changed with the CimCmdlets module or with system
+// calls otherwise.
+func storageModule() bool {
+	return utils.HasPowerShellModule(utils.ModuleStorage)
+}
 
-	if err != nil || len(out) == 0 {
-		return -1, fmt.Errorf("error getting size of the partition from mount. cmd %s, output: %s, error: %v", cmd, string(out), err)
+// getVolumeCmd returns the Get-Volume cmdlet invocation that gets the volume
+// `volumeID`, the MSFT_Volume instance is queried with Get-CimInstance if the
+// Storage module isn't available.
+func getVolumeCmd(volumeID string) string {
+	if !storageModule() {
+		// the backslashes of the ID would have to be escaped in a WQL filter
+		return fmt.Sprintf("(%s | Where UniqueId -eq \"%s\")", cim.StorageQuery("MSFT_Volume", ""), volumeID)
 	}
+	return fmt.Sprintf("Get-Volume -UniqueId \"%s\"", volumeID)
+}
 
-	outString := strings.TrimSpace(string(out))
-	volumeSize, err := strconv.ParseInt(outString, 10, 64)
+// getVolumePartition returns the partition backing the volume `volumeID`, the
+// partitions are matched by the volume GUID path in their access paths instead
+// of piping the volume to Get-Partition, which returns nothing for a volume
+// without partition and every partition for a volume that spans several ones.
+func getVolumePartition(volumeID string) (*cim.Partition, error) {
+	query := "Get-Partition"
+	if !storageModule() {
+		query = cim.StorageQuery("MSFT_Partition", "")
+	}
+	cmd := fmt.Sprintf("ConvertTo-Json @(%s | Select DiskNumber, PartitionNumber, Size, AccessPaths)", query)
+	out, err := runExec(cmd)
 	if err != nil {
-		return -1, fmt.Errorf("error parsing size of volume %s received %v trimmed to %v err %v", volumeID, out, outString, err)
+		return nil, fmt.Errorf("error listing partitions. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
+	var partitions []cim.Partition
+	if err := cim.Unmarshal(out, "MSFT_Partition", &partitions, "DiskNumber", "PartitionNumber", "Size"); err != nil {
+		return nil, err
 	}
+	return selectVolumePartition(volumeID, partitions)
+}
 
-	return volumeSize, nil
+// selectVolumePartition returns the partition of `partitions` whose access paths
+// have the volume GUID path of `volumeID`. It returns a *NoPartitionError if none
+// has it, and an error if several have it, they can't be resized as one.
+func selectVolumePartition(volumeID string, partitions []cim.Partition) (*cim.Partition, error) {
+	var matches []*cim.Partition
+	for i := range partitions {
+		for _, path := range partitions[i].AccessPaths {
+			if sameVolumeID(path, volumeID) {
+				matches = append(matches, &partitions[i])
+				break
+			}
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, &NoPartitionError{VolumeID: volumeID}
+	case 1:
+		return matches[0], nil
+	}
+	locations := make([]string, 0, len(matches))
+	for _, p := range matches {
+		locations = append(locations, fmt.Sprintf("disk %d partition %d", p.DiskNumber, p.PartitionNumber))
+	}
+	return nil, fmt.Errorf("volume %s is on %d partitions (%s)", volumeID, len(matches), strings.Join(locations, ", "))
 }
 
-// ListVolumesOnDisk - returns back list of volumes(volumeIDs) in a disk and a partition.
-func (VolumeAPI) ListVolumesOnDisk(diskNumber uint32, partitionNumber uint32) (volumeIDs []string, err error) {
-	var cmd string
-	if partitionNumber == 0 {
+// ListVolumesOnDisk - returns back list of volumes in a disk and a partition with their size, file system
+// and access paths. The volumes are sorted by partition number, they're listed with system calls.
+func (VolumeAPI) ListVolumesOnDisk(diskNumber uint32, partitionNumber uint32) ([]VolumeInfo, error) {
+	allVolumeIDs, err := listVolumeIDs()
+	if err != nil {
+		return []VolumeInfo{}, err
+	}
+
+	volumes := []VolumeInfo{}
+	for _, volumeID := range allVolumeIDs {
+		volumeDiskNumber, volumePartitionNumber, err := getVolumeDeviceNumber(volumeID)
+		if err != nil {
+			// e.g. volumes of optical drives and volumes that span multiple disks
+			klog.V(6).Infof("Skipping volume %s: %v", volumeID, err)
+			continue
+		}
 		// 0 means that the partitionNumber wasn't set so we list all the partitions
-		cmd = fmt.Sprintf("(Get-Disk -Number %d | Get-Partition | Get-Volume).UniqueId", diskNumber)
-	} else {
-		cmd = fmt.Sprintf("(Get-Disk -Number %d | Get-Partition -PartitionNumber %d | Get-Volume).UniqueId", diskNumber, partitionNumber)
+		if volumeDiskNumber != diskNumber || (partitionNumber != 0 && volumePartitionNumber != partitionNumber) {
+			continue
+		}
+		volume := VolumeInfo{
+			VolumeID:        volumeID,
+			DiskNumber:      &volumeDiskNumber,
+			PartitionNumber: &volumePartitionNumber,
+		}
+		if volume.SizeBytes, err = getVolumeLength(volumeID); err != nil {
+			return nil, err
+		}
+		if volume.AccessPaths, err = getVolumeAccessPaths(volumeID); err != nil {
+			return nil, err
+		}
+		if info, err := getVolumeInformation(volumeID); err != nil {
+			klog.V(6).Infof("Skipping the file system of volume %s: %v", volumeID, err)
+		} else {
+			volume.FileSystem = info.fileSystem
+			volume.Label = info.label
+			volume.SerialNumber = info.serialNumberString()
+		}
+		if volume.GptName, err = getVolumeGptName(volumeID); err != nil {
+			klog.V(6).Infof("Skipping the GPT name of volume %s: %v", volumeID, err)
+		}
+		volumes = append(volumes, volume)
+	}
+	sort.Slice(volumes, func(i, j int) bool {
+		return *volumes[i].PartitionNumber < *volumes[j].PartitionNumber
+	})
+	return volumes, nil
+}
+
+// ListVolumes returns all the volumes of the host, the partitions and the disks are
+// listed once and matched with the volumes instead of calling Get-Partition and
+// Get-Disk for every volume. The serial numbers of the file systems are queried with
+// system calls.
+func (VolumeAPI) ListVolumes() ([]VolumeInfo, error) {
+	cmd := `$partitions = @{}
+Get-Partition | ForEach-Object { foreach ($path in $_.AccessPaths) { $partitions[$path] = $_ } }
+$disks = @{}
+Get-Disk | ForEach-Object { $disks[$_.Number] = $_.SerialNumber }
+ConvertTo-Json @(Get-Volume | ForEach-Object {
+	$partition = $partitions[$_.Path]
+	[pscustomobject]@{
+		UniqueId = $_.UniqueId
+		Size = $_.Size
+		DiskNumber = $partition.DiskNumber
+		PartitionNumber = $partition.PartitionNumber
+		AccessPaths = [string[]]$partition.AccessPaths
+		DiskSerialNumber = if ($partition) { $disks[$partition.DiskNumber] }
 	}
+})`
 	out, err := runExec(cmd)
 	if err != nil {
-		return []string{}, fmt.Errorf("error list volumes on disk. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+		return nil, fmt.Errorf("error listing volumes. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
+
+	var volumes []VolumeInfo
+	if err := cim.Unmarshal(out, "MSFT_Volume", &volumes, "UniqueId", "Size"); err != nil {
+		return nil, err
+	}
+	for i := range volumes {
+		if volumes[i].PartitionNumber != nil {
+			if volumes[i].GptName, err = getVolumeGptName(volumes[i].VolumeID); err != nil {
+				klog.V(6).Infof("Skipping the GPT name of volume %s: %v", volumes[i].VolumeID, err)
+			}
+		}
+		info, err := getVolumeInformation(volumes[i].VolumeID)
+		if err != nil {
+			// e.g. volumes of empty optical drives
+			klog.V(6).Infof("Skipping the serial number of volume %s: %v", volumes[i].VolumeID, err)
+			continue
+		}
+		volumes[i].SerialNumber = info.serialNumberString()
+		volumes[i].FileSystem = info.fileSystem
+		volumes[i].Label = info.label
 	}
+	return volumes, nil
+}
+
+// rawCheckSize is the size of the start of a volume that must be zero for the volume to
+// be raw, it covers the boot sectors and the superblocks of the common file systems.
+const rawCheckSize = 64 * 1024
 
-	volumeIds := strings.Split(strings.TrimSpace(string(out)), "\r\n")
-	return volumeIds, nil
+// IsVolumeRaw - checks if the first 64K of a volume are zero, a format writes the boot
+// sector or the superblock of the file system there.
+func (VolumeAPI) IsVolumeRaw(volumeID string) (bool, error) {
+	data, err := readVolumeStart(volumeID, rawCheckSize)
+	if err != nil {
+		return false, err
+	}
+	for _, b := range data {
+		if b != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 // FormatVolume - Formats a volume with the NTFS format.
-func (VolumeAPI) FormatVolume(volumeID string) (err error) {
+func (VolumeAPI) FormatVolume(volumeID string, options FormatOptions) (err error) {
+	if !storageModule() {
+		return formatVolumeCim(volumeID, options)
+	}
 	cmd := fmt.Sprintf("Get-Volume -UniqueId \"%s\" | Format-Volume -FileSystem ntfs -Confirm:$false", volumeID)
+	if options.AllocationUnitSize != 0 {
+		cmd = fmt.Sprintf("%s -AllocationUnitSize %d", cmd, options.AllocationUnitSize)
+	}
+	if options.Label != "" {
+		// single quotes are escaped by doubling them in PowerShell single quoted strings
+		cmd = fmt.Sprintf("%s -NewFileSystemLabel '%s'", cmd, strings.ReplaceAll(options.Label, "'", "''"))
+	}
+	if options.Full {
+		cmd = fmt.Sprintf("%s -Full", cmd)
+	}
+	if options.Compress {
+		cmd = fmt.Sprintf("%s -Compress", cmd)
+	}
+	if options.UseLargeFRS {
+		cmd = fmt.Sprintf("%s -UseLargeFRS", cmd)
+	}
 	out, err := runExec(cmd)
 	if err != nil {
-		return fmt.Errorf("error formatting volume. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+		return fmt.Errorf("error formatting volume. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
 	}
 	// TODO: Do we need to handle anything for len(out) == 0
 	return nil
 }
 
-// WriteVolumeCache - Writes the file system cache to disk with the given volume id
-func (VolumeAPI) WriteVolumeCache(volumeID string) (err error) {
-	return writeCache(volumeID)
+// formatVolumeCim formats a volume with the NTFS format with the Format method of MSFT_Volume,
+// the method called by Format-Volume.
+func formatVolumeCim(volumeID string, options FormatOptions) error {
+	arguments := []string{"FileSystem='NTFS'"}
+	if options.AllocationUnitSize != 0 {
+		arguments = append(arguments, fmt.Sprintf("AllocationUnitSize=[uint32]%d", options.AllocationUnitSize))
+	}
+	if options.Label != "" {
+		arguments = append(arguments, fmt.Sprintf("FileSystemLabel='%s'", strings.ReplaceAll(options.Label, "'", "''")))
+	}
+	if options.Full {
+		arguments = append(arguments, "Full=$true")
+	}
+	if options.Compress {
+		arguments = append(arguments, "Compress=$true")
+	}
+	if options.UseLargeFRS {
+		arguments = append(arguments, "UseLargeFRS=$true")
+	}
+	cmd := fmt.Sprintf("%s | %s", getVolumeCmd(volumeID), cim.StorageMethod("Format", "@{"+strings.Join(arguments, "; ")+"}"))
+	out, err := runExec(cmd)
+	if err != nil {
+		return fmt.Errorf("error formatting volume. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
+	return nil
 }
 
-// IsVolumeFormatted - Check if the volume is formatted with the pre specified filesystem(typically ntfs).
-func (VolumeAPI) IsVolumeFormatted(volumeID string) (bool, error) {
-	cmd := fmt.Sprintf("(Get-Volume -UniqueId \"%s\" -ErrorAction Stop).FileSystemType", volumeID)
+// dedupFeature returns an error if the Data Deduplication feature isn't installed.
+func dedupFeature() error {
+	if !utils.HasPowerShellModule(utils.ModuleDeduplication) {
+		return fmt.Errorf("the Data Deduplication feature isn't installed, it's installed with Install-WindowsFeature -Name FS-Data-Deduplication")
+	}
+	return nil
+}
+
+// EnableDeduplication - enables the Data Deduplication of the volume, the files are optimized in the background
+// by the jobs of the deduplication schedule.
+func (VolumeAPI) EnableDeduplication(volumeID string, usageType DedupUsageType) error {
+	if err := dedupFeature(); err != nil {
+		return err
+	}
+	cmd := fmt.Sprintf("Enable-DedupVolume -Volume \"%s\" -UsageType %s -ErrorAction Stop | Out-Null", volumeID, usageType)
 	out, err := runExec(cmd)
 	if err != nil {
-		return false, fmt.Errorf("error checking if volume is formatted. cmd: %s, output: %s, error: %v", cmd, string(out), err)
-	}
-	stringOut := strings.TrimSpace(string(out))
-	if len(stringOut) == 0 || strings.EqualFold(stringOut, "Unknown") {
-		return false, nil
+		return fmt.Errorf("error enabling the deduplication of volume %s. cmd: %s, output: %s, error: %v", volumeID, utils.CommandText(cmd), string(out), err)
 	}
-	return true, nil
+	return nil
 }
 
-// MountVolume - mounts a volume to a path. This is done using the Add-PartitionAccessPath for presenting the volume via a path.
-func (VolumeAPI) MountVolume(volumeID, path string) error {
-	cmd := fmt.Sprintf("Get-Volume -UniqueId \"%s\" | Get-Partition | Add-PartitionAccessPath -AccessPath %s", volumeID, path)
+// GetDeduplicationStatus - gets the Data Deduplication status of the volume, Get-DedupVolume and
+// Get-DedupStatus don't return anything for the volumes whose deduplication was never enabled.
+func (VolumeAPI) GetDeduplicationStatus(volumeID string) (*DedupStatus, error) {
+	if err := dedupFeature(); err != nil {
+		return nil, err
+	}
+	cmd := fmt.Sprintf("$volume = Get-DedupVolume -Volume \"%s\" -ErrorAction SilentlyContinue; "+
+		"$status = Get-DedupStatus -Volume \"%s\" -ErrorAction SilentlyContinue; "+
+		"ConvertTo-Json @{Enabled = [bool]$volume.Enabled; UsageType = [string]$volume.UsageType; "+
+		"SavedSpace = [int64]$status.SavedSpace; SavingsRate = [uint32]$volume.SavingsRate; "+
+		"OptimizedFilesCount = [uint64]$status.OptimizedFilesCount; InPolicyFilesCount = [uint64]$status.InPolicyFilesCount; "+
+		"LastOptimizationTime = $(if ($status.LastOptimizationTime) { $status.LastOptimizationTime.ToUniversalTime().ToString('o') }); "+
+		"LastOptimizationResultMessage = [string]$status.LastOptimizationResultMessage}", volumeID, volumeID)
 	out, err := runExec(cmd)
 	if err != nil {
-		return fmt.Errorf("error mount volume to path. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+		return nil, fmt.Errorf("error getting the deduplication status of volume %s. cmd: %s, output: %s, error: %v", volumeID, utils.CommandText(cmd), string(out), err)
+	}
+
+	status := &DedupStatus{}
+	if err := json.Unmarshal(out, status); err != nil {
+		return nil, fmt.Errorf("error parsing the deduplication status of volume %s. cmd: %s, output: %s, error: %v", volumeID, utils.CommandText(cmd), string(out), err)
+	}
+	return status, nil
+}
+
+// recycleBinDir is the directory of the recycle bin at the root of a volume.
+const recycleBinDir = "$RECYCLE.BIN"
+
+// CleanupVolume - deletes the content of the temporary directories and of the recycle bin of the volume. The
+// files that can't be deleted e.g. because they're in use are skipped and returned as warnings.
+func (VolumeAPI) CleanupVolume(volumeID string, options CleanupOptions) ([]string, error) {
+	root := volumeID
+	if !strings.HasSuffix(root, `\`) {
+		root += `\`
+	}
+	dirs := append([]string{}, options.TempDirs...)
+	if options.RecycleBin {
+		// the recycle bin has a directory per user, Windows recreates them when needed
+		dirs = append(dirs, recycleBinDir)
+	}
+
+	warnings := []string{}
+	for _, dir := range dirs {
+		dirPath := root + strings.Trim(dir, `\/`)
+		entries, err := os.ReadDir(dirPath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return warnings, fmt.Errorf("error listing %s of volume %s: %w", dir, volumeID, err)
+		}
+		for _, entry := range entries {
+			path := dirPath + `\` + entry.Name()
+			if err := os.RemoveAll(path); err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s wasn't deleted: %v", path, err))
+			}
+		}
+	}
+	return warnings, nil
+}
+
+// SetVolumeCompression - enables or disables the NTFS compression of the root directory of the volume, the
+// files and the directories created in the volume inherit it. The existing files are left as they are.
+func (VolumeAPI) SetVolumeCompression(volumeID string, enabled bool) error {
+	return setCompression(volumeID, enabled)
+}
+
+// SetVolumeIndexing - sets or clears the not content indexed attribute of the root directory of the volume,
+// the files and the directories created in the volume inherit it. The existing files are left as they are.
+func (VolumeAPI) SetVolumeIndexing(volumeID string, enabled bool) error {
+	return setContentIndexing(volumeID, enabled)
+}
+
+// WriteVolumeCache - Writes the file system cache to disk with the given volume id, it doesn't wait for
+// the disk to flush its write cache.
+func (VolumeAPI) WriteVolumeCache(volumeID string) (err error) {
+	return flushVolumeCache(volumeID)
+}
+
+// SyncVolume - Flushes the file system cache and the write cache of the disk of the given volume id.
+func (VolumeAPI) SyncVolume(volumeID string) error {
+	return syncVolume(volumeID)
+}
+
+// SetVolumeWriteCache - Enables or disables the write cache of the disk of the given volume id.
+func (VolumeAPI) SetVolumeWriteCache(volumeID string, enabled bool) error {
+	return setVolumeWriteCache(volumeID, enabled)
+}
+
+// GetVolumeWriteCache - Checks if the write cache of the disk of the given volume id is enabled.
+func (VolumeAPI) GetVolumeWriteCache(volumeID string) (bool, error) {
+	return isVolumeWriteCacheEnabled(volumeID)
+}
+
+// syncVolume flushes the volume `volumeID`. FlushFileBuffers (Sync) on a handle of a volume
+// flushes all the files of the volume and then sends a flush command to the storage, opening
+// the volume for writing requires administrator rights.
+func syncVolume(volumeID string) error {
+	// the volume device is opened with its ID without the trailing separator, the
+	// ID with the separator is the root directory of the file system
+	path := strings.TrimSuffix(volumeID, `\`)
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return fmt.Errorf("error opening volume %s: %v", volumeID, err)
+	}
+	defer f.Close()
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("error flushing volume %s: %v", volumeID, err)
 	}
 	return nil
 }
 
-// UnmountVolume - unmounts the volume path by removing the partition access path
-func (VolumeAPI) UnmountVolume(volumeID, path string) error {
-	if err := writeCache(volumeID); err != nil {
-		return err
+// IsVolumeFormatted - Check if the volume is formatted with the pre specified filesystem(typically ntfs).
+func (VolumeAPI) IsVolumeFormatted(volumeID string) (bool, string, error) {
+	info, err := getVolumeInformation(volumeID)
+	if err != nil {
+		return false, "", fmt.Errorf("error checking if volume is formatted: %v", err)
+	}
+	return info.fileSystem != "", info.fileSystem, nil
+}
+
+// MountVolume - mounts a volume to a path. This is done using SetVolumeMountPoint or Add-PartitionAccessPath
+// for presenting the volume via a path. The read-only attribute of the partition is set before the access
+// path is added so that the volume is never writable through it, and it's cleared if the volume is mounted
+// read-write. The attribute is changed with Set-Partition, a volume that doesn't need it changed is
+// mounted with SetVolumeMountPoint. Mounting a volume at a path where it's already mounted (e.g. a retried
+// call) succeeds, a MountConflictError is returned if another volume is mounted at the path.
+func (VolumeAPI) MountVolume(volumeID, path string, readOnly bool) error {
+	// GetVolumeNameForVolumeMountPoint fails if nothing is mounted at the path
+	if mountedVolumeID, err := getVolumeNameForMountPoint(path); err == nil {
+		if !sameVolumeID(mountedVolumeID, volumeID) {
+			return &MountConflictError{VolumeID: volumeID, Path: path, MountedVolumeID: mountedVolumeID}
+		}
+		if isReadOnly, err := isVolumeReadOnly(volumeID); err == nil && isReadOnly != readOnly {
+			return fmt.Errorf("volume %s is already mounted at %s with readOnly=%v", volumeID, path, isReadOnly)
+		}
+		klog.V(4).Infof("volume %s is already mounted at %s", volumeID, path)
+		return nil
+	}
+
+	if !readOnly {
+		// the GPT attributes can't be queried for volumes of MBR disks, they're
+		// mounted with the cmdlets
+		if isReadOnly, err := isVolumeReadOnly(volumeID); err == nil && !isReadOnly {
+			return setVolumeMountPoint(volumeID, path)
+		}
+	}
+
+	var cmd string
+	if readOnly {
+		cmd = fmt.Sprintf("$ErrorActionPreference = 'Stop'; $p = Get-Volume -UniqueId \"%s\" | Get-Partition; $p | Set-Partition -IsReadOnly $true; $p | Add-PartitionAccessPath -AccessPath %s", volumeID, path)
+	} else {
+		cmd = fmt.Sprintf("$ErrorActionPreference = 'Stop'; $p = Get-Volume -UniqueId \"%s\" | Get-Partition; if ($p.IsReadOnly) { $p | Set-Partition -IsReadOnly $false }; $p | Add-PartitionAccessPath -AccessPath %s", volumeID, path)
 	}
-	cmd := fmt.Sprintf("Get-Volume -UniqueId \"%s\" | Get-Partition | Remove-PartitionAccessPath -AccessPath %s", volumeID, path)
 	out, err := runExec(cmd)
 	if err != nil {
-		return fmt.Errorf("error getting driver letter to mount volume. cmd: %s, output: %s,error: %v", cmd, string(out), err)
+		return fmt.Errorf("error mount volume to path. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
+	return nil
+}
+
+// mountMarkerStream is the alternate data stream of the root directory of a volume
+// where its mount marker is kept, it's not listed with the files of the volume.
+const mountMarkerStream = ":csi-proxy-mount-marker"
+
+func mountMarkerPath(volumeID string) string {
+	return strings.TrimSuffix(volumeID, `\`) + `\` + mountMarkerStream
+}
+
+// GetMountMarker - reads the mount marker of the volume.
+func (VolumeAPI) GetMountMarker(volumeID string) (*MountMarker, error) {
+	data, err := os.ReadFile(mountMarkerPath(volumeID))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	marker := &MountMarker{}
+	if err := json.Unmarshal(data, marker); err != nil {
+		return nil, fmt.Errorf("error parsing the mount marker %s of volume %s: %v", string(data), volumeID, err)
+	}
+	return marker, nil
+}
+
+// SetMountMarker - writes the mount marker of the volume, a nil marker removes it.
+func (VolumeAPI) SetMountMarker(volumeID string, marker *MountMarker) error {
+	path := mountMarkerPath(volumeID)
+	if marker == nil {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.Marshal(marker)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// CreateDirectories - creates the missing directories of the path e.g. of the target path of a mount.
+func (VolumeAPI) CreateDirectories(path string, sddl string) ([]string, error) {
+	return createDirectories(path, sddl)
+}
+
+// RemoveDirectories - removes the directories created by CreateDirectories from the deepest one, it stops
+// at the first directory that isn't empty anymore.
+func (VolumeAPI) RemoveDirectories(dirs []string) error {
+	return removeDirectories(dirs)
+}
+
+func removeDirectories(dirs []string) error {
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if err := os.Remove(dirs[i]); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("error removing directory %s: %w", dirs[i], err)
+		}
 	}
 	return nil
 }
 
-// ResizeVolume - resizes a volume with the given size, if size == 0 then max supported size is used
-func (VolumeAPI) ResizeVolume(volumeID string, size int64) error {
+// UnmountVolume - unmounts the volume path by deleting the volume mount point
+func (VolumeAPI) UnmountVolume(volumeID, path string) error {
+	if err := syncVolume(volumeID); err != nil {
+		return err
+	}
+	return deleteVolumeMountPoint(path)
+}
+
+// ListOpenHandles - lists the handles of the processes to the files and the directories of the
+// volume, i.e. the handles that keep it busy.
+func (VolumeAPI) ListOpenHandles(volumeID string) ([]OpenHandle, error) {
+	return listOpenHandles(volumeID)
+}
+
+// DismountVolume - dismounts the file system of the volume forcibly, the open handles of its
+// files become invalid.
+func (VolumeAPI) DismountVolume(volumeID string) error {
+	return dismountVolume(volumeID)
+}
+
+// ResizeVolume - resizes a volume with the given size, if size == 0 then max supported size is used.
+// A volume is shrunk only if allowShrink is set and never below the minimum size supported by its
+// partition, i.e. the space used by its data.
+func (VolumeAPI) ResizeVolume(volumeID string, size int64, allowShrink bool) ([]string, error) {
 	// If size is 0 then we will resize to the maximum size possible, otherwise just resize to size
 	var cmd string
 	var out []byte
 	var err error
 	var finalSize int64
-	var outString string
+	var supportedSize *cim.PartitionSupportedSize
+	partition, err := getVolumePartition(volumeID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the partition of volume %s: %w", volumeID, err)
+	}
 	if size == 0 {
-		cmd = fmt.Sprintf("Get-Volume -UniqueId \"%s\" | Get-partition | Get-PartitionSupportedSize | Select SizeMax | ConvertTo-Json", volumeID)
-		out, err = runExec(cmd)
-
-		if err != nil || len(out) == 0 {
-			return fmt.Errorf("error getting sizemin,sizemax from mount. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+		supportedSize, err = getPartitionSupportedSize(partition)
+		if err != nil {
+			return nil, err
 		}
+		finalSize = supportedSize.SizeMax
+	} else {
+		finalSize = size
+	}
 
-		var getVolumeSizing map[string]int64
-		outString = string(out)
-		err = json.Unmarshal([]byte(outString), &getVolumeSizing)
+	currentSize := partition.Size
+	//if the partition's size is already the size we want this is a noop, just return
+	if currentSize == finalSize {
+		return nil, nil
+	}
+	if currentSize > finalSize && !allowShrink {
+		klog.V(2).Infof("Attempted to resize volume %s to a lower size, from currentBytes=%d wantedBytes=%d", volumeID, currentSize, finalSize)
+		return []string{fmt.Sprintf("volume %s wasn't shrunk from %d to %d bytes, shrinking isn't allowed", volumeID, currentSize, finalSize)}, nil
+	}
+
+	if currentSize > finalSize {
+		// the supported sizes change as data is written, query them right before shrinking
+		supportedSize, err = getPartitionSupportedSize(partition)
 		if err != nil {
-			return fmt.Errorf("out %v outstring %v err %v", out, outString, err)
+			return nil, err
+		}
+		if finalSize < supportedSize.SizeMin {
+			return nil, fmt.Errorf("cannot shrink volume %s to %d bytes, the minimum size supported by its partition is %d bytes", volumeID, finalSize, supportedSize.SizeMin)
 		}
+		klog.V(2).Infof("Shrinking volume %s from currentBytes=%d to wantedBytes=%d", volumeID, currentSize, finalSize)
+	}
 
-		sizeMax := getVolumeSizing["SizeMax"]
+	cmd = fmt.Sprintf("Resize-Partition -DiskNumber %d -PartitionNumber %d -Size %d", partition.DiskNumber, partition.PartitionNumber, finalSize)
+	out, err = runExec(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("error resizing volume. cmd: %s, output: %s size:%v, finalSize %v, error: %v", utils.CommandText(cmd), string(out), size, finalSize, err)
+	}
+	return nil, nil
+}
 
-		finalSize = sizeMax
-	} else {
-		finalSize = size
+// RepairVolume - repairs the file system of a volume with Repair-Volume, e.g. "NoErrorsFound" is returned
+// if a scan didn't find any corruption.
+func (VolumeAPI) RepairVolume(volumeID string, mode RepairMode) (string, error) {
+	cmd := fmt.Sprintf("[string](Get-Volume -UniqueId \"%s\" | Repair-Volume -%s)", volumeID, mode)
+	out, err := runExec(cmd)
+	if err != nil {
+		return "", fmt.Errorf("error repairing volume. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// IsVolumeDirty - checks the dirty bit of a volume, it's set when the volume wasn't dismounted cleanly
+// or NTFS detected corruption, and it's cleared by chkdsk.
+func (VolumeAPI) IsVolumeDirty(volumeID string) (bool, error) {
+	return isVolumeDirty(volumeID)
+}
+
+// OptimizeVolume - optimizes a volume with Optimize-Volume, e.g. a retrim sends TRIM requests for the free
+// space of the volume so that thin provisioned storage reclaims the space of deleted files.
+func (VolumeAPI) OptimizeVolume(volumeID string, options OptimizeOptions) error {
+	cmd := fmt.Sprintf("Get-Volume -UniqueId \"%s\" | Optimize-Volume", volumeID)
+	if options.ReTrim {
+		cmd += " -ReTrim"
+	}
+	if options.Defrag {
+		cmd += " -Defrag"
+	}
+	if options.SlabConsolidate {
+		cmd += " -SlabConsolidate"
 	}
+	out, err := runExec(cmd)
+	if err != nil {
+		return fmt.Errorf("error optimizing volume. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
+	return nil
+}
 
-	currentSize, err := getVolumeSize(volumeID)
+// GetVolumeLabel - returns the file system label of a volume, empty if it doesn't have a label.
+func (VolumeAPI) GetVolumeLabel(volumeID string) (string, error) {
+	if !storageModule() {
+		info, err := getVolumeInformation(volumeID)
+		if err != nil {
+			return "", err
+		}
+		return info.label, nil
+	}
+	cmd := fmt.Sprintf("(Get-Volume -UniqueId \"%s\").FileSystemLabel", volumeID)
+	out, err := runExec(cmd)
 	if err != nil {
-		return fmt.Errorf("error getting the current size of volume (%s) with error (%v)", volumeID, err)
+		return "", fmt.Errorf("error getting the label of volume. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
 	}
+	return strings.TrimSpace(string(out)), nil
+}
 
-	//if the partition's size is already the size we want this is a noop, just return
-	if currentSize >= finalSize {
-		klog.V(2).Infof("Attempted to resize volume %s to a lower size, from currentBytes=%d wantedBytes=%d", volumeID, currentSize, finalSize)
-		return nil
+// GetVolumeSerialNumber - gets the serial number of the file system of the volume.
+func (VolumeAPI) GetVolumeSerialNumber(volumeID string) (string, error) {
+	info, err := getVolumeInformation(volumeID)
+	if err != nil {
+		return "", err
 	}
+	return info.serialNumberString(), nil
+}
 
-	cmd = fmt.Sprintf("Get-Volume -UniqueId \"%s\" | Get-Partition | Resize-Partition -Size %d", volumeID, finalSize)
-	out, err = runExec(cmd)
+// SetVolumeLabel - changes the file system label of a volume without formatting it.
+func (VolumeAPI) SetVolumeLabel(volumeID string, label string) error {
+	if !storageModule() {
+		return setVolumeLabel(volumeID, label)
+	}
+	// single quotes are escaped by doubling them in PowerShell single quoted strings
+	cmd := fmt.Sprintf("Get-Volume -UniqueId \"%s\" | Set-Volume -NewFileSystemLabel '%s'", volumeID, strings.ReplaceAll(label, "'", "''"))
+	out, err := runExec(cmd)
 	if err != nil {
-		return fmt.Errorf("error resizing volume. cmd: %s, output: %s size:%v, finalSize %v, error: %v", cmd, string(out), size, finalSize, err)
+		return fmt.Errorf("error setting the label of volume. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
 	}
 	return nil
 }
 
-// GetVolumeStats - retrieves the volume stats for a given volume
-func (VolumeAPI) GetVolumeStats(volumeID string) (int64, int64, error) {
-	// get the size and sizeRemaining for the volume
-	cmd := fmt.Sprintf("(Get-Volume -UniqueId \"%s\" | Select SizeRemaining,Size) | ConvertTo-Json", volumeID)
+// getPartitionSupportedSize returns the minimum and maximum sizes supported by the partition
+// `partition`.
+func getPartitionSupportedSize(partition *cim.Partition) (*cim.PartitionSupportedSize, error) {
+	cmd := fmt.Sprintf("Get-PartitionSupportedSize -DiskNumber %d -PartitionNumber %d | Select SizeMin, SizeMax | ConvertTo-Json", partition.DiskNumber, partition.PartitionNumber)
 	out, err := runExec(cmd)
+	if err != nil || len(out) == 0 {
+		return nil, fmt.Errorf("error getting sizemin,sizemax from mount. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
 
+	var supportedSize cim.PartitionSupportedSize
+	err = cim.Unmarshal(out, "MSFT_Partition.GetSupportedSize", &supportedSize, "SizeMin", "SizeMax")
 	if err != nil {
-		return -1, -1, fmt.Errorf("error getting capacity and used size of volume. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+		return nil, err
 	}
+	return &supportedSize, nil
+}
 
-	var getVolume map[string]int64
-	outString := string(out)
-	err = json.Unmarshal([]byte(outString), &getVolume)
+// GetVolumeStats - retrieves the volume stats for a given volume with GetDiskFreeSpaceEx and
+// GetVolumeInformation, the stats of a volume that isn't formatted are empty.
+func (VolumeAPI) GetVolumeStats(volumeID string) (*VolumeStats, error) {
+	info, err := getVolumeInformation(volumeID)
 	if err != nil {
-		return -1, -1, fmt.Errorf("out %v outstring %v err %v", out, outString, err)
+		return nil, err
+	}
+	stats := &VolumeStats{
+		FileSystemType:  info.fileSystem,
+		FileSystemLabel: info.label,
+		SerialNumber:    info.serialNumberString(),
+	}
+	if info.fileSystem == "" {
+		return stats, nil
 	}
-	var volumeSizeRemaining int64
-	var volumeSize int64
 
-	volumeSize = getVolume["Size"]
-	volumeSizeRemaining = getVolume["SizeRemaining"]
+	totalBytes, freeBytes, err := getVolumeSpace(volumeID)
+	if err != nil {
+		return nil, err
+	}
+	stats.TotalBytes = totalBytes
+	stats.UsedBytes = totalBytes - freeBytes
+	return stats, nil
+}
 
-	volumeUsedSize := volumeSize - volumeSizeRemaining
-	return volumeSize, volumeUsedSize, nil
+// HasVolumeSpaceChanged - checks the records of the USN change journal of a volume after `since`
+// with FSCTL_READ_USN_JOURNAL, only the records of the changes that allocate or free space count.
+func (VolumeAPI) HasVolumeSpaceChanged(volumeID string, since *JournalPosition) (bool, *JournalPosition, error) {
+	return readVolumeJournal(volumeID, since)
 }
 
-// GetDiskNumberFromVolumeID - gets the disk number where the volume is.
-func (VolumeAPI) GetDiskNumberFromVolumeID(volumeID string) (uint32, error) {
-	// get the size and sizeRemaining for the volume
-	cmd := fmt.Sprintf("(Get-Volume -UniqueId \"%s\" | Get-Partition).DiskNumber", volumeID)
+// GetVolumeHealth - retrieves the health and the operational status of a given volume, the
+// enums are converted to their names.
+func (VolumeAPI) GetVolumeHealth(volumeID string) (string, string, error) {
+	cmd := fmt.Sprintf("(%s | Select %s,%s) | ConvertTo-Json",
+		getVolumeCmd(volumeID), cim.StringProperty("HealthStatus"), cim.StringProperty("OperationalStatus"))
 	out, err := runExec(cmd)
+	if err != nil {
+		return "", "", fmt.Errorf("error getting the health of volume. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
 
-	if err != nil || len(out) == 0 {
-		return 0, fmt.Errorf("error getting disk number. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+	var getVolume cim.Volume
+	err = cim.Unmarshal(out, "MSFT_Volume", &getVolume, "HealthStatus", "OperationalStatus")
+	if err != nil {
+		return "", "", err
+	}
+	operationalStatus := ""
+	if getVolume.OperationalStatus != nil {
+		operationalStatus = cim.EnumName("OperationalStatus", *getVolume.OperationalStatus)
 	}
+	return cim.EnumName("HealthStatus", getVolume.HealthStatus), operationalStatus, nil
+}
 
-	reg, err := regexp.Compile("[^0-9]+")
+// watchVolumesScript subscribes to the creation, deletion and modification events of
+// MSFT_Volume and writes them as JSON, one per line. The modifications that don't change
+// the properties of VolumeEvent e.g. the free space aren't written.
+var watchVolumesScript = fmt.Sprintf(`$ErrorActionPreference = 'Stop'
+Register-CimIndicationEvent -Namespace %s -SourceIdentifier csi-proxy-volumes `+
+	`-Query "SELECT * FROM __InstanceOperationEvent WITHIN 2 WHERE TargetInstance ISA 'MSFT_Volume'"
+while ($true) {
+	$e = Wait-Event -SourceIdentifier csi-proxy-volumes
+	Remove-Event -EventIdentifier $e.EventIdentifier
+	$n = $e.SourceEventArgs.NewEvent
+	$t = $n.TargetInstance
+	$class = $n.CimClass.CimClassName
+	if ($class -eq '__InstanceModificationEvent') {
+		$p = $n.PreviousInstance
+		if ("$($p.Size) $($p.DriveLetter) $($p.FileSystemType) $($p.HealthStatus) $($p.OperationalStatus)" -eq `+
+	`"$($t.Size) $($t.DriveLetter) $($t.FileSystemType) $($t.HealthStatus) $($t.OperationalStatus)") { continue }
+	}
+	$v = $t | Select UniqueId,Size,@{n='DriveLetter';e={if ($_.DriveLetter) {[string]$_.DriveLetter}}},%s,%s,%s
+	$v | Add-Member EventClass $class
+	[Console]::Out.WriteLine(($v | ConvertTo-Json -Compress))
+}`, cim.StorageNamespace, cim.StringProperty("FileSystemType"), cim.StringProperty("HealthStatus"),
+	cim.StringProperty("OperationalStatus"))
+
+// volumeEventTypes are the volume event types of the classes of the WMI events.
+var volumeEventTypes = map[string]VolumeEventType{
+	"__InstanceCreationEvent":     VolumeAdded,
+	"__InstanceDeletionEvent":     VolumeRemoved,
+	"__InstanceModificationEvent": VolumeChanged,
+}
+
+// WatchVolumes - runs a PowerShell script subscribed to the WMI events of MSFT_Volume,
+// which are polled every 2 seconds, until `ctx` is done.
+func (VolumeAPI) WatchVolumes(ctx context.Context, handler func(VolumeEvent) error) error {
+	cmd, err := utils.PowerShellCommand(watchVolumesScript)
+	if err != nil {
+		return err
+	}
+	stdout, err := cmd.StdoutPipe()
 	if err != nil {
-		return 0, fmt.Errorf("error compiling regex. err: %v", err)
+		return err
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	klog.V(metrics.CommandTextLogLevel).Infof("Executing command: %q", utils.Redact(watchVolumesScript))
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("error starting the volume watcher: %v", err)
 	}
-	diskNumberOutput := reg.ReplaceAllString(string(out), "")
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = cmd.Process.Kill()
+		case <-done:
+		}
+	}()
 
-	diskNumber, err := strconv.ParseUint(diskNumberOutput, 10, 32)
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var out struct {
+			EventClass        string
+			UniqueID          string `json:"UniqueId"`
+			Size              int64
+			DriveLetter       string
+			FileSystemType    string
+			HealthStatus      string
+			OperationalStatus string
+		}
+		if err := json.Unmarshal(line, &out); err != nil {
+			klog.Warningf("ignoring the volume event %s: %v", string(line), err)
+			continue
+		}
+		event := VolumeEvent{
+			Type:              volumeEventTypes[out.EventClass],
+			VolumeID:          out.UniqueID,
+			SizeBytes:         out.Size,
+			DriveLetter:       out.DriveLetter,
+			FileSystemType:    cim.EnumName("FileSystemType", out.FileSystemType),
+			HealthStatus:      cim.EnumName("HealthStatus", out.HealthStatus),
+			OperationalStatus: cim.EnumName("OperationalStatus", out.OperationalStatus),
+		}
+		if err := handler(event); err != nil {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+			return err
+		}
+	}
+	err = cmd.Wait()
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return fmt.Errorf("the volume watcher exited. output: %s, error: %v", stderr.String(), err)
+}
 
+// GetDiskNumberFromVolumeID - gets the disk number where the volume is.
+func (VolumeAPI) GetDiskNumberFromVolumeID(volumeID string) (uint32, error) {
+	diskNumber, _, err := getVolumeDeviceNumber(volumeID)
 	if err != nil {
-		return 0, fmt.Errorf("error parsing disk number. cmd: %s, output: %s, error: %v", cmd, diskNumberOutput, err)
+		return 0, fmt.Errorf("error getting disk number: %v", err)
 	}
+	return diskNumber, nil
+}
 
-	return uint32(diskNumber), nil
+// GetVolumeDisk returns the number, bus type, size and partition style of the
+// disk of the volume `volumeID` and whether it's an OS disk.
+func (VolumeAPI) GetVolumeDisk(volumeID string) (*shared.DiskInfo, error) {
+	cmd := fmt.Sprintf("Get-Volume -UniqueId \"%s\" | Get-Partition | Get-Disk | Select Number, %s, Size, %s, IsBoot, IsSystem | ConvertTo-Json",
+		volumeID, cim.StringProperty("BusType"), cim.StringProperty("PartitionStyle"))
+	if !storageModule() {
+		// the partitions of a volume are on a single disk, its number is queried with a system call
+		diskNumber, _, err := getVolumeDeviceNumber(volumeID)
+		if err != nil {
+			return nil, err
+		}
+		cmd = fmt.Sprintf("%s | Select Number, %s, Size, %s, IsBoot, IsSystem | ConvertTo-Json",
+			cim.StorageQuery("MSFT_Disk", fmt.Sprintf("Number=%d", diskNumber)), cim.StringProperty("BusType"), cim.StringProperty("PartitionStyle"))
+	}
+	out, err := runExec(cmd)
+	if err != nil || len(out) == 0 {
+		return nil, fmt.Errorf("error getting the disk of the volume. cmd: %s, output: %s, error: %v", utils.CommandText(cmd), string(out), err)
+	}
+
+	var d cim.Disk
+	err = cim.Unmarshal(out, "MSFT_Disk", &d, "Number", "BusType", "Size", "PartitionStyle", "IsBoot", "IsSystem")
+	if err != nil {
+		return nil, err
+	}
+	return &shared.DiskInfo{
+		Number:         d.Number,
+		BusType:        cim.EnumName("BusType", d.BusType),
+		Size:           d.Size,
+		PartitionStyle: cim.EnumName("PartitionStyle", d.PartitionStyle),
+		IsOSDisk:       d.IsBoot || d.IsSystem,
+	}, nil
 }
 
-// GetVolumeIDFromTargetPath - gets the volume ID given a mount point, the function is recursive until it find a volume or errors out
+// GetVolumeIDFromTargetPath - gets the volume ID given a mount point, the symlinks to the mount point are followed
 func (VolumeAPI) GetVolumeIDFromTargetPath(mount string) (string, error) {
-	volumeString, err := getTarget(mount)
+	volumeString, err := getMountedVolume(mount)
 
 	if err != nil {
 		return "", fmt.Errorf("error getting the volume for the mount %s, internal error %v", mount, err)
@@ -268,18 +1005,56 @@ func (VolumeAPI) GetVolumeIDFromTargetPath(mount string) (string, error) {
 	return volumeString, nil
 }
 
-func getTarget(mount string) (string, error) {
-	cmd := fmt.Sprintf("(Get-Item -Path %s).Target", mount)
-	out, err := runExec(cmd)
-	if err != nil || len(out) == 0 {
-		return "", fmt.Errorf("error getting volume from mount. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+// GetVolumeDriveLetter returns the drive letter of the volume `volumeID`, empty if it doesn't have one.
+func (VolumeAPI) GetVolumeDriveLetter(volumeID string) (string, error) {
+	paths, err := getVolumePathNames(volumeID)
+	if err != nil {
+		return "", fmt.Errorf("error getting the drive letter of the volume: %v", err)
 	}
-	volumeString := strings.TrimSpace(string(out))
-	if !strings.HasPrefix(volumeString, "Volume") {
-		return getTarget(volumeString)
+	for _, path := range paths {
+		if isDriveLetterPath(path) {
+			return path[:1], nil
+		}
 	}
+	return "", nil
+}
 
-	return ensureVolumePrefix(volumeString), nil
+// GetVolumeAccessPaths returns the paths where the volume `volumeID` is accessible and its drive
+// letters, they're queried with GetVolumePathNamesForVolumeName.
+func (VolumeAPI) GetVolumeAccessPaths(volumeID string) ([]string, []string, error) {
+	accessPaths, err := getVolumeAccessPaths(volumeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	driveLetters := []string{}
+	for _, path := range accessPaths {
+		if isDriveLetterPath(path) {
+			driveLetters = append(driveLetters, path[:1])
+		}
+	}
+	return accessPaths, driveLetters, nil
+}
+
+// getVolumeAccessPaths returns the drive letters, the mount points and the volume device ID
+// path of the volume `volumeID`, the volume device ID path is an access path of the partition
+// like for Get-Partition.
+func getVolumeAccessPaths(volumeID string) ([]string, error) {
+	paths, err := getVolumePathNames(volumeID)
+	if err != nil {
+		return nil, err
+	}
+	return append(paths, volumeID), nil
+}
+
+// isDriveLetterPath returns true for the drive letter paths like D:\
+func isDriveLetterPath(path string) bool {
+	return len(path) == 3 && strings.HasSuffix(path, `:\`)
+}
+
+// ListDriveLetters returns the drive letters in use in the host, including
+// the letters of network drives and removable drives.
+func (VolumeAPI) ListDriveLetters() ([]string, error) {
+	return listDriveLetters()
 }
 
 // GetVolumeIDFromTargetPath returns the volume id of a given target path.
@@ -317,7 +1092,7 @@ func findClosestVolume(path string) (string, error) {
 		isSymlink := fi.Mode()&os.ModeSymlink != 0
 
 		if isSymlink {
-			target, err := dereferenceSymlink(candidatePath)
+			target, err := symlinks.Target(candidatePath, fi.ModTime())
 			if err != nil {
 				return "", err
 			}
@@ -360,8 +1135,12 @@ func ensureVolumePrefix(volume string) string {
 
 // dereferenceSymlink dereferences the symlink `path` and returns the stdout.
 func dereferenceSymlink(path string) (string, error) {
-	cmd := exec.Command("powershell", "/c", fmt.Sprintf(`(Get-Item -Path %s).Target`, path))
-	klog.V(8).Infof("About to execute: %q", cmd.String())
+	script := fmt.Sprintf(`(Get-Item -Path %s).Target`, path)
+	cmd, err := utils.PowerShellCommand(script)
+	if err != nil {
+		return "", err
+	}
+	klog.V(metrics.CommandTextLogLevel).Infof("Executing command: %q", utils.Redact(script))
 	var outbuf, errbuf bytes.Buffer
 	cmd.Stderr = &errbuf
 	cmd.Stdout = &outbuf
@@ -369,7 +1148,7 @@ func dereferenceSymlink(path string) (string, error) {
 		return "", err
 	}
 	if len(errbuf.String()) != 0 {
-		return "", fmt.Errorf("Unexpected stderr output in command=%v stdeerr=%v", cmd.String(), errbuf.String())
+		return "", fmt.Errorf("Unexpected stderr output in command=%v stdeerr=%v", utils.CommandText(script), errbuf.String())
 	}
 	output := strings.TrimSpace(outbuf.String())
 	klog.V(8).Infof("Stdout: %s", output)
@@ -382,22 +1161,70 @@ func getVolumeForDriveLetter(path string) (string, error) {
 		return "", fmt.Errorf("The path=%s is not a valid DriverLetter", path)
 	}
 
-	cmd := exec.Command("powershell", "/c", fmt.Sprintf(`(Get-Partition -DriveLetter %s | Get-Volume).UniqueId`, path))
-	klog.V(8).Infof("About to execute: %q", cmd.String())
-	targetb, err := cmd.Output()
+	return getVolumeNameForMountPoint(path + `:\`)
+}
+
+// sameVolumeID compares volume IDs ignoring the case and the trailing separator,
+// e.g. \\?\Volume{...}\ and \\?\volume{...}
+func sameVolumeID(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, `\`), strings.TrimSuffix(b, `\`))
+}
+
+// CreateBoundedVolume - creates and attaches the dynamic VHDX of a bounded volume, then initializes,
+// partitions and formats its disk if they aren't yet, so that an interrupted creation is resumed.
+func (v VolumeAPI) CreateBoundedVolume(vhdPath string, sizeBytes int64) (string, error) {
+	diskNumber, err := attachVirtualDisk(vhdPath, sizeBytes)
 	if err != nil {
 		return "", err
 	}
-	output := strings.TrimSpace(string(targetb))
-	klog.V(8).Infof("Stdout: %s", output)
-	return output, nil
+	volumes, err := v.ListVolumesOnDisk(diskNumber, 0)
+	if err != nil {
+		return "", err
+	}
+	if len(volumes) == 0 {
+		if err := partitionBoundedDisk(diskNumber); err != nil {
+			return "", err
+		}
+		if volumes, err = v.ListVolumesOnDisk(diskNumber, 0); err != nil {
+			return "", err
+		}
+		if len(volumes) == 0 {
+			return "", fmt.Errorf("no volume found on disk %d of %s after partitioning it", diskNumber, vhdPath)
+		}
+	}
+	volumeID := volumes[0].VolumeID
+	formatted, _, err := v.IsVolumeFormatted(volumeID)
+	if err != nil {
+		return "", err
+	}
+	if !formatted {
+		if err := v.FormatVolume(volumeID, FormatOptions{}); err != nil {
+			return "", err
+		}
+	}
+	return volumeID, nil
 }
 
-func writeCache(volumeID string) error {
-	cmd := fmt.Sprintf("Get-Volume -UniqueId \"%s\" | Write-Volumecache", volumeID)
+// partitionBoundedDisk initializes the disk `diskNumber` of a bounded volume as GPT if it's raw and
+// creates a partition on all its space.
+func partitionBoundedDisk(diskNumber uint32) error {
+	cmd := fmt.Sprintf("$ErrorActionPreference = 'Stop'; if ((Get-Disk -Number %d).PartitionStyle -eq 'RAW') { Initialize-Disk -Number %d -PartitionStyle GPT }; "+
+		"New-Partition -DiskNumber %d -UseMaximumSize | Out-Null", diskNumber, diskNumber, diskNumber)
+	if !storageModule() {
+		cmd = fmt.Sprintf("$ErrorActionPreference = 'Stop'; $d = %s; if ($d.PartitionStyle -eq 0) { $d | %s }; $d | %s",
+			cim.StorageQuery("MSFT_Disk", fmt.Sprintf("Number=%d", diskNumber)),
+			cim.StorageMethod("Initialize", "@{PartitionStyle=[uint16]2}"),
+			cim.StorageMethod("CreatePartition", "@{UseMaximumSize=$true}"))
+	}
 	out, err := runExec(cmd)
 	if err != nil {
-		return fmt.Errorf("error writing volume cache. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+		return fmt.Errorf("error partitioning disk %d: %v, %v", diskNumber, string(out), err)
 	}
 	return nil
 }
+
+// DeleteBoundedVolume - detaches the VHDX of a bounded volume if it's attached and deletes it, it's a
+// no-op if the VHDX doesn't exist.
+func (VolumeAPI) DeleteBoundedVolume(vhdPath string) error {
+	return deleteVirtualDisk(vhdPath)
+}
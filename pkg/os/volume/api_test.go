@@ -0,0 +1,87 @@
+package volume
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/cim"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+)
+
+func TestSelectVolumePartition(t *testing.T) {
+	const (
+		volume1 = `\\?\Volume{11111111-0000-0000-0000-000000000000}\`
+		volume2 = `\\?\Volume{22222222-0000-0000-0000-000000000000}\`
+		spanned = `\\?\Volume{33333333-0000-0000-0000-000000000000}\`
+	)
+	partitions := []cim.Partition{
+		// reserved partition without access paths
+		{DiskNumber: 1, PartitionNumber: 1, Size: 16 * 1024 * 1024},
+		{DiskNumber: 1, PartitionNumber: 2, Size: 1024, AccessPaths: []string{`C:\mnt\volume1\`, volume1}},
+		// a volume GUID path with another case and without trailing separator
+		{DiskNumber: 1, PartitionNumber: 3, Size: 2048, AccessPaths: []string{`D:\`, `\\?\volume{22222222-0000-0000-0000-000000000000}`}},
+		{DiskNumber: 2, PartitionNumber: 1, Size: 4096, AccessPaths: []string{volume1}},
+	}
+
+	testCases := []struct {
+		name            string
+		volumeID        string
+		partitions      []cim.Partition
+		expectPartition uint32
+		expectNoPart    bool
+		expectError     bool
+	}{
+		{
+			name:            "single partition disk",
+			volumeID:        volume1,
+			partitions:      partitions[1:2],
+			expectPartition: 2,
+		},
+		{
+			name:            "multiple partitions disk",
+			volumeID:        volume2,
+			partitions:      partitions[:3],
+			expectPartition: 3,
+		},
+		{
+			name:         "volume without partition",
+			volumeID:     spanned,
+			partitions:   partitions,
+			expectNoPart: true,
+		},
+		{
+			name:         "no partitions",
+			volumeID:     volume1,
+			expectNoPart: true,
+		},
+		{
+			name:        "volume on several partitions",
+			volumeID:    volume1,
+			partitions:  partitions,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			partition, err := selectVolumePartition(tc.volumeID, tc.partitions)
+			var noPartition *NoPartitionError
+			switch {
+			case tc.expectNoPart:
+				if !errors.As(err, &noPartition) || noPartition.VolumeID != tc.volumeID {
+					t.Fatalf("expected a NoPartitionError, got %v", err)
+				}
+				if code, _ := utils.StableErrorCode(err); code != utils.ErrorCodeNotSupported {
+					t.Errorf("expected code %s, got %s", utils.ErrorCodeNotSupported, code)
+				}
+			case tc.expectError:
+				if err == nil || errors.As(err, &noPartition) {
+					t.Fatalf("expected an error other than NoPartitionError, got %v", err)
+				}
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case partition.PartitionNumber != tc.expectPartition:
+				t.Errorf("expected partition %d, got %+v", tc.expectPartition, partition)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package volume
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+	"k8s.io/klog/v2"
+)
+
+// Open handles of the files of a volume, they're found in the handle table of
+// the system returned by NtQuerySystemInformation. The file handles are
+// duplicated into csi-proxy to get their paths, it requires administrator rights.
+
+const (
+	// systemExtendedHandleInformation is the SYSTEM_INFORMATION_CLASS of the handle table
+	systemExtendedHandleInformation = 64
+	// statusInfoLengthMismatch is the NTSTATUS returned when the buffer is too small
+	statusInfoLengthMismatch = 0xC0000004
+
+	// volumeNameGUID is the flag of GetFinalPathNameByHandle for paths that start
+	// with the volume GUID path e.g. \\?\Volume{452e318a-5cde-421e-9831-b9853c521012}\pods\log
+	volumeNameGUID = 0x1
+
+	// FSCTLs of the forced dismount, see winioctl.h
+	fsctlLockVolume     = 0x90018
+	fsctlDismountVolume = 0x90020
+)
+
+var (
+	modntdll    = windows.NewLazySystemDLL("ntdll.dll")
+	modkernel32 = windows.NewLazySystemDLL("kernel32.dll")
+
+	procNtQuerySystemInformation   = modntdll.NewProc("NtQuerySystemInformation")
+	procGetFinalPathNameByHandleW  = modkernel32.NewProc("GetFinalPathNameByHandleW")
+	procQueryFullProcessImageNameW = modkernel32.NewProc("QueryFullProcessImageNameW")
+)
+
+// systemHandleTableEntryInfoEx is SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX, an entry of
+// the handle table.
+type systemHandleTableEntryInfoEx struct {
+	Object                uintptr
+	UniqueProcessID       uintptr
+	HandleValue           uintptr
+	GrantedAccess         uint32
+	CreatorBackTraceIndex uint16
+	ObjectTypeIndex       uint16
+	HandleAttributes      uint32
+	Reserved              uint32
+}
+
+// listHandles returns the handle table of the system.
+func listHandles() ([]systemHandleTableEntryInfoEx, error) {
+	buf := make([]byte, 1024*1024)
+	for {
+		var size uint32
+		status, _, _ := procNtQuerySystemInformation.Call(systemExtendedHandleInformation,
+			uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)), uintptr(unsafe.Pointer(&size)))
+		if status == statusInfoLengthMismatch {
+			// the table grows between the calls
+			buf = make([]byte, len(buf)*2)
+			continue
+		}
+		if status != 0 {
+			return nil, fmt.Errorf("error listing the handles of the system: NTSTATUS 0x%X", status)
+		}
+		break
+	}
+	// SYSTEM_HANDLE_INFORMATION_EX is the number of handles, a reserved field and the entries
+	count := *(*uintptr)(unsafe.Pointer(&buf[0]))
+	first := unsafe.Pointer(&buf[2*unsafe.Sizeof(uintptr(0))])
+	entries := make([]systemHandleTableEntryInfoEx, count)
+	for i := range entries {
+		entries[i] = *(*systemHandleTableEntryInfoEx)(unsafe.Pointer(uintptr(first) + uintptr(i)*unsafe.Sizeof(entries[0])))
+	}
+	return entries, nil
+}
+
+// fileObjectTypeIndex returns the index of the File object type in the handle
+// table, it changes between Windows builds so it's looked up with a handle of a
+// file opened by csi-proxy.
+func fileObjectTypeIndex(entries []systemHandleTableEntryInfoEx, h windows.Handle) (uint16, bool) {
+	pid := uintptr(windows.GetCurrentProcessId())
+	for _, e := range entries {
+		if e.UniqueProcessID == pid && e.HandleValue == uintptr(h) {
+			return e.ObjectTypeIndex, true
+		}
+	}
+	return 0, false
+}
+
+// getFinalPathNameByHandle returns the path of the file `h` starting with its
+// volume GUID path.
+func getFinalPathNameByHandle(h windows.Handle) (string, error) {
+	buf := make([]uint16, windows.MAX_PATH)
+	for {
+		n, _, err := procGetFinalPathNameByHandleW.Call(uintptr(h), uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)), volumeNameGUID)
+		if n == 0 {
+			return "", err
+		}
+		if int(n) < len(buf) {
+			return windows.UTF16ToString(buf[:n]), nil
+		}
+		buf = make([]uint16, n)
+	}
+}
+
+// processName returns the executable name of the process `pid`, empty if it
+// can't be queried.
+func processName(pid uint32) string {
+	h, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, pid)
+	if err != nil {
+		return ""
+	}
+	defer windows.CloseHandle(h)
+	buf := make([]uint16, windows.MAX_PATH)
+	size := uint32(len(buf))
+	if r, _, _ := procQueryFullProcessImageNameW.Call(uintptr(h), 0, uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&size))); r == 0 {
+		return ""
+	}
+	return filepath.Base(windows.UTF16ToString(buf[:size]))
+}
+
+// listOpenHandles returns the handles of the processes to the files and the
+// directories of the volume `volumeID`. The handles of the processes that can't
+// be opened (e.g. protected processes) aren't listed.
+func listOpenHandles(volumeID string) ([]OpenHandle, error) {
+	// a handle of a file of csi-proxy to find the type index of the file handles
+	nul, err := windows.UTF16PtrFromString("NUL")
+	if err != nil {
+		return nil, err
+	}
+	ownHandle, err := windows.CreateFile(nul, windows.GENERIC_READ, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error opening NUL: %w", err)
+	}
+	defer windows.CloseHandle(ownHandle)
+
+	entries, err := listHandles()
+	if err != nil {
+		return nil, err
+	}
+	fileType, ok := fileObjectTypeIndex(entries, ownHandle)
+	if !ok {
+		return nil, fmt.Errorf("error finding the type of the file handles")
+	}
+
+	prefix := strings.ToLower(withTrailingSeparator(volumeID))
+	self := uintptr(windows.GetCurrentProcessId())
+	processes := make(map[uintptr]windows.Handle)
+	defer func() {
+		for _, h := range processes {
+			if h != 0 {
+				windows.CloseHandle(h)
+			}
+		}
+	}()
+
+	handles := []OpenHandle{}
+	for _, e := range entries {
+		if e.ObjectTypeIndex != fileType || e.UniqueProcessID == self {
+			continue
+		}
+		process, ok := processes[e.UniqueProcessID]
+		if !ok {
+			process, err = windows.OpenProcess(windows.PROCESS_DUP_HANDLE, false, uint32(e.UniqueProcessID))
+			if err != nil {
+				klog.V(6).Infof("Skipping the handles of process %d: %v", e.UniqueProcessID, err)
+				process = 0
+			}
+			processes[e.UniqueProcessID] = process
+		}
+		if process == 0 {
+			continue
+		}
+
+		var h windows.Handle
+		if err := windows.DuplicateHandle(process, windows.Handle(e.HandleValue), windows.CurrentProcess(), &h, 0, false, windows.DUPLICATE_SAME_ACCESS); err != nil {
+			continue
+		}
+		// the path of the handles of pipes and of other devices can't be queried,
+		// it may block forever for synchronous pipes
+		var path string
+		if t, err := windows.GetFileType(h); err == nil && t == windows.FILE_TYPE_DISK {
+			path, _ = getFinalPathNameByHandle(h)
+		}
+		windows.CloseHandle(h)
+		if path == "" || !strings.HasPrefix(strings.ToLower(withTrailingSeparator(path)), prefix) {
+			continue
+		}
+		handles = append(handles, OpenHandle{
+			ProcessID:   uint32(e.UniqueProcessID),
+			ProcessName: processName(uint32(e.UniqueProcessID)),
+			Path:        path,
+		})
+	}
+	return handles, nil
+}
+
+// dismountVolume dismounts the file system of the volume `volumeID` even if it
+// has open handles, the handles become invalid and the file system is mounted
+// again on the next access.
+func dismountVolume(volumeID string) error {
+	path, err := windows.UTF16PtrFromString(strings.TrimSuffix(volumeID, `\`))
+	if err != nil {
+		return err
+	}
+	h, err := windows.CreateFile(path, windows.GENERIC_READ|windows.GENERIC_WRITE, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		return fmt.Errorf("error opening volume %s: %w", volumeID, err)
+	}
+	defer windows.CloseHandle(h)
+
+	var size uint32
+	// the lock fails if the volume has open handles, the file system is
+	// dismounted anyway but it's dismounted cleanly if the lock is taken
+	if err := windows.DeviceIoControl(h, fsctlLockVolume, nil, 0, nil, 0, &size, nil); err != nil {
+		klog.V(4).Infof("Dismounting volume %s without a lock: %v", volumeID, err)
+	}
+	if err := windows.DeviceIoControl(h, fsctlDismountVolume, nil, 0, nil, 0, &size, nil); err != nil {
+		return fmt.Errorf("error dismounting volume %s: %w", volumeID, err)
+	}
+	return nil
+}
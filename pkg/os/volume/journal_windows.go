@@ -0,0 +1,144 @@
+package volume
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// The USN change journal of a volume records the changes to its files, the
+// records between two positions tell whether the used space may have changed
+// without querying the file system.
+
+const (
+	// FSCTLs of the USN change journal, see winioctl.h
+	fsctlQueryUsnJournal  = 0x900F4
+	fsctlReadUsnJournal   = 0x900BB
+	fsctlCreateUsnJournal = 0x900E7
+
+	// usnJournalMaximumSize and usnJournalAllocationDelta are the sizes of the
+	// journals created by csi-proxy, the defaults of fsutil usn createjournal
+	usnJournalMaximumSize     = 32 * 1024 * 1024
+	usnJournalAllocationDelta = 4 * 1024 * 1024
+
+	// usnSpaceReasons are the reasons of the USN records of the changes that
+	// allocate or free space: data overwrites, extensions and truncations of
+	// the default and the named streams, file creations and deletions, changes
+	// of the extended attributes, of the compression and of the streams
+	usnSpaceReasons = 0x1 | 0x2 | 0x4 | 0x10 | 0x20 | 0x40 | 0x100 | 0x200 | 0x400 | 0x20000 | 0x200000
+
+	// usnReadBufferSize is the size of the buffer of FSCTL_READ_USN_JOURNAL
+	usnReadBufferSize = 64 * 1024
+
+	// errors of the USN journal operations
+	errorJournalDeleteInProgress windows.Errno = 1178
+	errorJournalNotActive        windows.Errno = 1179
+	errorJournalEntryDeleted     windows.Errno = 1181
+)
+
+// usnJournalData is USN_JOURNAL_DATA_V0, the output of FSCTL_QUERY_USN_JOURNAL.
+type usnJournalData struct {
+	UsnJournalID    uint64
+	FirstUsn        int64
+	NextUsn         int64
+	LowestValidUsn  int64
+	MaxUsn          int64
+	MaximumSize     uint64
+	AllocationDelta uint64
+}
+
+// readUsnJournalData is READ_USN_JOURNAL_DATA_V0, the input of FSCTL_READ_USN_JOURNAL.
+type readUsnJournalData struct {
+	StartUsn          int64
+	ReasonMask        uint32
+	ReturnOnlyOnClose uint32
+	Timeout           uint64
+	BytesToWaitFor    uint64
+	UsnJournalID      uint64
+}
+
+// createUsnJournalData is CREATE_USN_JOURNAL_DATA, the input of FSCTL_CREATE_USN_JOURNAL.
+type createUsnJournalData struct {
+	MaximumSize     uint64
+	AllocationDelta uint64
+}
+
+// queryUsnJournal returns the journal of the volume opened as `h`, the journal
+// is created if the volume doesn't have one yet.
+func queryUsnJournal(h windows.Handle, volumeID string) (*usnJournalData, error) {
+	var data usnJournalData
+	var size uint32
+	err := windows.DeviceIoControl(h, fsctlQueryUsnJournal, nil, 0,
+		(*byte)(unsafe.Pointer(&data)), uint32(unsafe.Sizeof(data)), &size, nil)
+	if errors.Is(err, errorJournalNotActive) {
+		create := createUsnJournalData{MaximumSize: usnJournalMaximumSize, AllocationDelta: usnJournalAllocationDelta}
+		err = windows.DeviceIoControl(h, fsctlCreateUsnJournal,
+			(*byte)(unsafe.Pointer(&create)), uint32(unsafe.Sizeof(create)), nil, 0, &size, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating the USN journal of volume %s: %w", volumeID, err)
+		}
+		err = windows.DeviceIoControl(h, fsctlQueryUsnJournal, nil, 0,
+			(*byte)(unsafe.Pointer(&data)), uint32(unsafe.Sizeof(data)), &size, nil)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error querying the USN journal of volume %s: %w", volumeID, err)
+	}
+	return &data, nil
+}
+
+// readVolumeJournal returns true if the USN journal of the volume `volumeID` has
+// records of changes of the used space after `since`, or if they can't be known
+// because `since` is nil, the journal was recreated or its records were purged.
+// It also returns the current position of the journal.
+func readVolumeJournal(volumeID string, since *JournalPosition) (bool, *JournalPosition, error) {
+	h, err := openVolume(volumeID)
+	if err != nil {
+		return false, nil, err
+	}
+	defer windows.CloseHandle(h)
+
+	journal, err := queryUsnJournal(h, volumeID)
+	if err != nil {
+		return false, nil, err
+	}
+	position := &JournalPosition{JournalID: journal.UsnJournalID, USN: journal.NextUsn}
+	if since == nil || since.JournalID != journal.UsnJournalID || since.USN < journal.LowestValidUsn {
+		return true, position, nil
+	}
+	if since.USN >= journal.NextUsn {
+		return false, position, nil
+	}
+
+	// only the records with the reasons in the mask are returned, the first
+	// record before the current position is enough
+	input := readUsnJournalData{
+		StartUsn:     since.USN,
+		ReasonMask:   usnSpaceReasons,
+		UsnJournalID: journal.UsnJournalID,
+	}
+	buf := make([]byte, usnReadBufferSize)
+	for input.StartUsn < journal.NextUsn {
+		var size uint32
+		err := windows.DeviceIoControl(h, fsctlReadUsnJournal,
+			(*byte)(unsafe.Pointer(&input)), uint32(unsafe.Sizeof(input)), &buf[0], uint32(len(buf)), &size, nil)
+		if errors.Is(err, errorJournalEntryDeleted) || errors.Is(err, errorJournalDeleteInProgress) {
+			return true, position, nil
+		}
+		if err != nil {
+			return false, nil, fmt.Errorf("error reading the USN journal of volume %s: %w", volumeID, err)
+		}
+		// the output is the USN after the records followed by the records
+		if size > 8 {
+			return true, position, nil
+		}
+		next := int64(binary.LittleEndian.Uint64(buf[:8]))
+		if next <= input.StartUsn {
+			break
+		}
+		input.StartUsn = next
+	}
+	return false, position, nil
+}
@@ -0,0 +1,120 @@
+//go:build !windows
+// +build !windows
+
+package volume
+
+import "errors"
+
+// The Win32 volume operations are only available on Windows, these stubs let the
+// packages that depend on the volume API build on other platforms e.g. to run
+// their unit tests with a fake API.
+
+var errNotSupported = errors.New("volume operations are only supported on Windows")
+
+func listVolumeIDs() ([]string, error) {
+	return nil, errNotSupported
+}
+
+func getVolumeDeviceNumber(volumeID string) (uint32, uint32, error) {
+	return 0, 0, errNotSupported
+}
+
+func getVolumeLength(volumeID string) (int64, error) {
+	return 0, errNotSupported
+}
+
+func readVolumeStart(volumeID string, size int) ([]byte, error) {
+	return nil, errNotSupported
+}
+
+func isVolumeReadOnly(volumeID string) (bool, error) {
+	return false, errNotSupported
+}
+
+func isVolumeDirty(volumeID string) (bool, error) {
+	return false, errNotSupported
+}
+
+func getVolumeGptName(volumeID string) (string, error) {
+	return "", errNotSupported
+}
+
+func flushVolumeCache(volumeID string) error {
+	return errNotSupported
+}
+
+func getMountedVolume(path string) (string, error) {
+	return "", errNotSupported
+}
+
+func listDriveLetters() ([]string, error) {
+	return nil, errNotSupported
+}
+
+func setContentIndexing(volumeID string, enabled bool) error {
+	return errNotSupported
+}
+
+func isVolumeWriteCacheEnabled(volumeID string) (bool, error) {
+	return false, errNotSupported
+}
+
+func setVolumeWriteCache(volumeID string, enabled bool) error {
+	return errNotSupported
+}
+
+func getVolumeInformation(volumeID string) (*volumeInformation, error) {
+	return nil, errNotSupported
+}
+
+func setVolumeLabel(volumeID, label string) error {
+	return errNotSupported
+}
+
+func getVolumeSpace(volumeID string) (int64, int64, error) {
+	return 0, 0, errNotSupported
+}
+
+func setVolumeMountPoint(volumeID, path string) error {
+	return errNotSupported
+}
+
+func deleteVolumeMountPoint(path string) error {
+	return errNotSupported
+}
+
+func getVolumeNameForMountPoint(path string) (string, error) {
+	return "", errNotSupported
+}
+
+func getVolumePathNames(volumeID string) ([]string, error) {
+	return nil, errNotSupported
+}
+
+func listOpenHandles(volumeID string) ([]OpenHandle, error) {
+	return nil, errNotSupported
+}
+
+func dismountVolume(volumeID string) error {
+	return errNotSupported
+}
+
+func setCompression(volumeID string, enabled bool) error {
+	return errNotSupported
+}
+
+func createDirectories(path, sddl string) ([]string, error) {
+	return nil, errNotSupported
+}
+
+func readVolumeJournal(volumeID string, since *JournalPosition) (bool, *JournalPosition, error) {
+	return false, nil, errNotSupported
+}
+
+func attachVirtualDisk(path string, sizeBytes int64) (uint32, error) {
+	return 0, errNotSupported
+}
+
+func deleteVirtualDisk(path string) error {
+	return errNotSupported
+}
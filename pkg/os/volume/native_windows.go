@@ -0,0 +1,713 @@
+package volume
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
+
+	"github.com/Microsoft/go-winio/vhd"
+	"golang.org/x/sys/windows"
+)
+
+// Volume operations implemented with Win32 calls instead of cmdlets, spawning
+// powershell.exe takes seconds under load. The volume IDs are the volume GUID
+// paths e.g. \\?\Volume{452e318a-5cde-421e-9831-b9853c521012}\
+//
+// This isn't a complete backend, the operations of the Storage module without a
+// Win32 equivalent still run cmdlets: formatting, resizing and repairing volumes,
+// the read-only mounts and the mounts of MBR volumes (Add-PartitionAccessPath),
+// and the queries of the disk of a volume for the disk policy.
+
+const (
+	// IOCTLs of the volume operations, see winioctl.h
+	ioctlStorageGetDeviceNumber = 0x2D1080
+	ioctlDiskGetLengthInfo      = 0x7405C
+	fsctlSetCompression         = 0x9C040
+	ioctlVolumeGetGptAttributes = 0x560038
+	ioctlDiskGetPartitionInfoEx = 0x70048
+	ioctlDiskGetCacheInfo       = 0x740D4
+	ioctlDiskSetCacheInfo       = 0x7C0D8
+	fsctlIsVolumeDirty          = 0x90078
+
+	// compression formats of FSCTL_SET_COMPRESSION
+	compressionFormatNone    uint16 = 0
+	compressionFormatDefault uint16 = 1
+
+	// partitionStyleGpt is the PartitionStyle of the partitions of GPT disks
+	partitionStyleGpt = 1
+
+	// gptBasicDataAttributeReadOnly is the GPT attribute of the read-only partitions
+	gptBasicDataAttributeReadOnly = 0x1000000000000000
+
+	// volumeIsDirty is the flag of the output of FSCTL_IS_VOLUME_DIRTY set when
+	// the dirty bit of the file system is set
+	volumeIsDirty = 0x1
+
+	// maxVolumeNameLength is the length of a volume GUID path including the NUL character
+	maxVolumeNameLength = 50
+
+	// errorUnrecognizedVolume is returned by GetVolumeInformation for unformatted volumes
+	errorUnrecognizedVolume windows.Errno = 1005
+
+	// flushFlagsNoSync is FLUSH_FLAGS_NO_SYNC of NtFlushBuffersFileEx, the file system
+	// cache is written to the storage without sending it a flush command
+	flushFlagsNoSync = 0x2
+)
+
+var procNtFlushBuffersFileEx = modntdll.NewProc("NtFlushBuffersFileEx")
+
+// storageDeviceNumber is STORAGE_DEVICE_NUMBER, the output of IOCTL_STORAGE_GET_DEVICE_NUMBER.
+type storageDeviceNumber struct {
+	DeviceType      uint32
+	DeviceNumber    uint32
+	PartitionNumber uint32
+}
+
+// partitionInformationEx is PARTITION_INFORMATION_EX, the output of
+// IOCTL_DISK_GET_PARTITION_INFO_EX, with the GPT member of its union.
+type partitionInformationEx struct {
+	PartitionStyle     uint32
+	_                  uint32
+	StartingOffset     int64
+	PartitionLength    int64
+	PartitionNumber    uint32
+	RewritePartition   bool
+	IsServicePartition bool
+	_                  [2]byte
+	PartitionType      windows.GUID
+	PartitionID        windows.GUID
+	Attributes         uint64
+	Name               [36]uint16
+}
+
+// diskCacheInformation is DISK_CACHE_INFORMATION, the input of
+// IOCTL_DISK_SET_CACHE_INFORMATION and the output of IOCTL_DISK_GET_CACHE_INFORMATION.
+type diskCacheInformation struct {
+	ParametersSavable             bool
+	ReadCacheEnabled              bool
+	WriteCacheEnabled             bool
+	_                             byte
+	ReadRetentionPriority         int32
+	WriteRetentionPriority        int32
+	DisablePrefetchTransferLength uint16
+	PrefetchScalar                bool
+	_                             byte
+	// ScalarPrefetch or BlockPrefetch depending on PrefetchScalar
+	Prefetch [3]uint16
+	_        uint16
+}
+
+// ioStatusBlock is IO_STATUS_BLOCK, the status of the NtXxxFile calls.
+type ioStatusBlock struct {
+	Status      uintptr
+	Information uintptr
+}
+
+// withTrailingSeparator returns `path` ending with a path separator, the volume
+// mount point functions require it.
+func withTrailingSeparator(path string) string {
+	if strings.HasSuffix(path, `\`) {
+		return path
+	}
+	return path + `\`
+}
+
+// openVolume opens the device of the volume `volumeID` for queries.
+func openVolume(volumeID string) (windows.Handle, error) {
+	// the volume device is opened with its ID without the trailing separator, the
+	// ID with the separator is the root directory of the file system
+	path, err := windows.UTF16PtrFromString(strings.TrimSuffix(volumeID, `\`))
+	if err != nil {
+		return windows.InvalidHandle, err
+	}
+	h, err := windows.CreateFile(path, 0, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		return windows.InvalidHandle, fmt.Errorf("error opening volume %s: %w", volumeID, err)
+	}
+	return h, nil
+}
+
+// listVolumeIDs returns the IDs of all the volumes of the host.
+func listVolumeIDs() ([]string, error) {
+	buf := make([]uint16, maxVolumeNameLength)
+	h, err := windows.FindFirstVolume(&buf[0], uint32(len(buf)))
+	if err != nil {
+		return nil, fmt.Errorf("error listing volumes: %w", err)
+	}
+	defer windows.FindVolumeClose(h)
+
+	volumeIDs := []string{windows.UTF16ToString(buf)}
+	for {
+		if err := windows.FindNextVolume(h, &buf[0], uint32(len(buf))); err != nil {
+			if errors.Is(err, windows.ERROR_NO_MORE_FILES) {
+				return volumeIDs, nil
+			}
+			return nil, fmt.Errorf("error listing volumes: %w", err)
+		}
+		volumeIDs = append(volumeIDs, windows.UTF16ToString(buf))
+	}
+}
+
+// getVolumeDeviceNumber returns the disk number and the partition number of the
+// volume `volumeID`, it fails for volumes that span multiple disks.
+func getVolumeDeviceNumber(volumeID string) (uint32, uint32, error) {
+	h, err := openVolume(volumeID)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer windows.CloseHandle(h)
+
+	var number storageDeviceNumber
+	var size uint32
+	err = windows.DeviceIoControl(h, ioctlStorageGetDeviceNumber, nil, 0,
+		(*byte)(unsafe.Pointer(&number)), uint32(unsafe.Sizeof(number)), &size, nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error getting the device number of volume %s: %w", volumeID, err)
+	}
+	return number.DeviceNumber, number.PartitionNumber, nil
+}
+
+// getVolumeLength returns the size in bytes of the volume `volumeID`, formatted or
+// not.
+func getVolumeLength(volumeID string) (int64, error) {
+	path, err := windows.UTF16PtrFromString(strings.TrimSuffix(volumeID, `\`))
+	if err != nil {
+		return 0, err
+	}
+	// IOCTL_DISK_GET_LENGTH_INFO requires read access
+	h, err := windows.CreateFile(path, windows.GENERIC_READ, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		return 0, fmt.Errorf("error opening volume %s: %w", volumeID, err)
+	}
+	defer windows.CloseHandle(h)
+
+	var length int64
+	var size uint32
+	err = windows.DeviceIoControl(h, ioctlDiskGetLengthInfo, nil, 0,
+		(*byte)(unsafe.Pointer(&length)), uint32(unsafe.Sizeof(length)), &size, nil)
+	if err != nil {
+		return 0, fmt.Errorf("error getting the size of volume %s: %w", volumeID, err)
+	}
+	return length, nil
+}
+
+// readVolumeStart reads the first `size` bytes of the volume `volumeID`, less if the
+// volume is smaller. `size` must be a multiple of the sector size of the disk.
+func readVolumeStart(volumeID string, size int) ([]byte, error) {
+	path, err := windows.UTF16PtrFromString(strings.TrimSuffix(volumeID, `\`))
+	if err != nil {
+		return nil, err
+	}
+	h, err := windows.CreateFile(path, windows.GENERIC_READ, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error opening volume %s: %w", volumeID, err)
+	}
+	defer windows.CloseHandle(h)
+
+	buf := make([]byte, size)
+	var n uint32
+	if err := windows.ReadFile(h, buf, &n, nil); err != nil {
+		return nil, fmt.Errorf("error reading volume %s: %w", volumeID, err)
+	}
+	return buf[:n], nil
+}
+
+// isVolumeReadOnly returns true if the partition of the volume `volumeID` has the
+// read-only GPT attribute, it fails for volumes of MBR disks.
+func isVolumeReadOnly(volumeID string) (bool, error) {
+	h, err := openVolume(volumeID)
+	if err != nil {
+		return false, err
+	}
+	defer windows.CloseHandle(h)
+
+	var attributes uint64
+	var size uint32
+	err = windows.DeviceIoControl(h, ioctlVolumeGetGptAttributes, nil, 0,
+		(*byte)(unsafe.Pointer(&attributes)), uint32(unsafe.Sizeof(attributes)), &size, nil)
+	if err != nil {
+		return false, fmt.Errorf("error getting the GPT attributes of volume %s: %w", volumeID, err)
+	}
+	return attributes&gptBasicDataAttributeReadOnly != 0, nil
+}
+
+// isVolumeDirty returns true if the dirty bit of the file system of the volume
+// `volumeID` is set.
+func isVolumeDirty(volumeID string) (bool, error) {
+	h, err := openVolume(volumeID)
+	if err != nil {
+		return false, err
+	}
+	defer windows.CloseHandle(h)
+
+	var flags uint32
+	var size uint32
+	err = windows.DeviceIoControl(h, fsctlIsVolumeDirty, nil, 0, (*byte)(unsafe.Pointer(&flags)), uint32(unsafe.Sizeof(flags)), &size, nil)
+	if err != nil {
+		return false, fmt.Errorf("error checking the dirty bit of volume %s: %w", volumeID, err)
+	}
+	return flags&volumeIsDirty != 0, nil
+}
+
+// getVolumeGptName returns the name of the GPT partition of the volume `volumeID`,
+// it's empty for the volumes of MBR disks.
+func getVolumeGptName(volumeID string) (string, error) {
+	h, err := openVolume(volumeID)
+	if err != nil {
+		return "", err
+	}
+	defer windows.CloseHandle(h)
+
+	var info partitionInformationEx
+	var size uint32
+	err = windows.DeviceIoControl(h, ioctlDiskGetPartitionInfoEx, nil, 0,
+		(*byte)(unsafe.Pointer(&info)), uint32(unsafe.Sizeof(info)), &size, nil)
+	if err != nil {
+		return "", fmt.Errorf("error getting the partition of volume %s: %w", volumeID, err)
+	}
+	if info.PartitionStyle != partitionStyleGpt {
+		return "", nil
+	}
+	return windows.UTF16ToString(info.Name[:]), nil
+}
+
+// openVolumeDisk opens the disk of the volume `volumeID` with the access `access`.
+func openVolumeDisk(volumeID string, access uint32) (windows.Handle, error) {
+	diskNumber, _, err := getVolumeDeviceNumber(volumeID)
+	if err != nil {
+		return windows.InvalidHandle, err
+	}
+	path, err := windows.UTF16PtrFromString(fmt.Sprintf(`\\.\PhysicalDrive%d`, diskNumber))
+	if err != nil {
+		return windows.InvalidHandle, err
+	}
+	h, err := windows.CreateFile(path, access, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE, nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		return windows.InvalidHandle, fmt.Errorf("error opening disk %d of volume %s: %w", diskNumber, volumeID, err)
+	}
+	return h, nil
+}
+
+// getDiskCacheInformation returns the cache settings of the disk opened with the handle `h`.
+func getDiskCacheInformation(h windows.Handle) (*diskCacheInformation, error) {
+	var info diskCacheInformation
+	var size uint32
+	err := windows.DeviceIoControl(h, ioctlDiskGetCacheInfo, nil, 0,
+		(*byte)(unsafe.Pointer(&info)), uint32(unsafe.Sizeof(info)), &size, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// isVolumeWriteCacheEnabled returns true if the write cache of the disk of the volume
+// `volumeID` is enabled.
+func isVolumeWriteCacheEnabled(volumeID string) (bool, error) {
+	h, err := openVolumeDisk(volumeID, windows.GENERIC_READ)
+	if err != nil {
+		return false, err
+	}
+	defer windows.CloseHandle(h)
+
+	info, err := getDiskCacheInformation(h)
+	if err != nil {
+		return false, fmt.Errorf("error getting the cache settings of the disk of volume %s: %w", volumeID, err)
+	}
+	return info.WriteCacheEnabled, nil
+}
+
+// setVolumeWriteCache enables or disables the write cache of the disk of the volume
+// `volumeID`, the setting is saved by the disk if it supports it.
+func setVolumeWriteCache(volumeID string, enabled bool) error {
+	h, err := openVolumeDisk(volumeID, windows.GENERIC_READ|windows.GENERIC_WRITE)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(h)
+
+	// the other settings are written back as they are
+	info, err := getDiskCacheInformation(h)
+	if err != nil {
+		return fmt.Errorf("error getting the cache settings of the disk of volume %s: %w", volumeID, err)
+	}
+	if info.WriteCacheEnabled == enabled {
+		return nil
+	}
+	info.WriteCacheEnabled = enabled
+	var size uint32
+	err = windows.DeviceIoControl(h, ioctlDiskSetCacheInfo,
+		(*byte)(unsafe.Pointer(info)), uint32(unsafe.Sizeof(*info)), nil, 0, &size, nil)
+	if err != nil {
+		return fmt.Errorf("error setting the cache settings of the disk of volume %s: %w", volumeID, err)
+	}
+	return nil
+}
+
+// getVolumeInformation returns the file system (e.g. NTFS), the label and the serial
+// number of the volume `volumeID`, they're empty if the volume isn't formatted.
+func getVolumeInformation(volumeID string) (*volumeInformation, error) {
+	root, err := windows.UTF16PtrFromString(withTrailingSeparator(volumeID))
+	if err != nil {
+		return nil, err
+	}
+	label := make([]uint16, windows.MAX_PATH+1)
+	fileSystem := make([]uint16, windows.MAX_PATH+1)
+	var serialNumber uint32
+	err = windows.GetVolumeInformation(root, &label[0], uint32(len(label)), &serialNumber, nil, nil, &fileSystem[0], uint32(len(fileSystem)))
+	if errors.Is(err, errorUnrecognizedVolume) {
+		return &volumeInformation{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting the file system of volume %s: %w", volumeID, err)
+	}
+	return &volumeInformation{
+		fileSystem:   windows.UTF16ToString(fileSystem),
+		label:        windows.UTF16ToString(label),
+		serialNumber: serialNumber,
+	}, nil
+}
+
+// setVolumeLabel changes the label of the file system of the volume `volumeID`, an
+// empty `label` removes it.
+func setVolumeLabel(volumeID, label string) error {
+	root, err := windows.UTF16PtrFromString(withTrailingSeparator(volumeID))
+	if err != nil {
+		return err
+	}
+	var name *uint16
+	if label != "" {
+		if name, err = windows.UTF16PtrFromString(label); err != nil {
+			return err
+		}
+	}
+	if err := windows.SetVolumeLabel(root, name); err != nil {
+		return fmt.Errorf("error setting the label of volume %s: %w", volumeID, err)
+	}
+	return nil
+}
+
+// getVolumeSpace returns the size and the free space in bytes of the file system
+// of the volume `volumeID`.
+func getVolumeSpace(volumeID string) (int64, int64, error) {
+	root, err := windows.UTF16PtrFromString(withTrailingSeparator(volumeID))
+	if err != nil {
+		return 0, 0, err
+	}
+	var freeBytesAvailable, totalBytes, totalFreeBytes uint64
+	if err := windows.GetDiskFreeSpaceEx(root, &freeBytesAvailable, &totalBytes, &totalFreeBytes); err != nil {
+		return 0, 0, fmt.Errorf("error getting the free space of volume %s: %w", volumeID, err)
+	}
+	return int64(totalBytes), int64(totalFreeBytes), nil
+}
+
+// setVolumeMountPoint mounts the volume `volumeID` at the empty directory `path`.
+func setVolumeMountPoint(volumeID, path string) error {
+	mountPoint, err := windows.UTF16PtrFromString(withTrailingSeparator(path))
+	if err != nil {
+		return err
+	}
+	volumeName, err := windows.UTF16PtrFromString(withTrailingSeparator(volumeID))
+	if err != nil {
+		return err
+	}
+	if err := windows.SetVolumeMountPoint(mountPoint, volumeName); err != nil {
+		return fmt.Errorf("error mounting volume %s at %s: %w", volumeID, path, err)
+	}
+	return nil
+}
+
+// deleteVolumeMountPoint unmounts the volume mounted at `path`.
+func deleteVolumeMountPoint(path string) error {
+	mountPoint, err := windows.UTF16PtrFromString(withTrailingSeparator(path))
+	if err != nil {
+		return err
+	}
+	if err := windows.DeleteVolumeMountPoint(mountPoint); err != nil {
+		return fmt.Errorf("error unmounting the volume at %s: %w", path, err)
+	}
+	return nil
+}
+
+// getVolumeNameForMountPoint returns the ID of the volume mounted at `path`, a
+// mount point or a drive root e.g. C:\
+func getVolumeNameForMountPoint(path string) (string, error) {
+	mountPoint, err := windows.UTF16PtrFromString(withTrailingSeparator(path))
+	if err != nil {
+		return "", err
+	}
+	buf := make([]uint16, maxVolumeNameLength)
+	if err := windows.GetVolumeNameForVolumeMountPoint(mountPoint, &buf[0], uint32(len(buf))); err != nil {
+		return "", fmt.Errorf("error getting the volume mounted at %s: %w", path, err)
+	}
+	return windows.UTF16ToString(buf), nil
+}
+
+// getMountedVolume returns the ID of the volume mounted at `path`, the symlinks to
+// the mount point are followed. It fails if `path` isn't the root of a volume.
+func getMountedVolume(path string) (string, error) {
+	name, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return "", err
+	}
+	// directories are opened with FILE_FLAG_BACKUP_SEMANTICS, the reparse points of
+	// the path are followed
+	h, err := windows.CreateFile(name, 0, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE|windows.FILE_SHARE_DELETE,
+		nil, windows.OPEN_EXISTING, windows.FILE_FLAG_BACKUP_SEMANTICS, 0)
+	if err != nil {
+		return "", fmt.Errorf("error opening %s: %w", path, err)
+	}
+	defer windows.CloseHandle(h)
+
+	finalPath, err := getFinalPathNameByHandle(h)
+	if err != nil {
+		return "", fmt.Errorf("error getting the final path of %s: %w", path, err)
+	}
+	// the final path of the root of a volume is its volume GUID path
+	if loc := VolumeRegexp.FindStringIndex(finalPath); loc == nil || finalPath[loc[1]:] != `\` {
+		return "", fmt.Errorf("%s is not a volume mount point, its final path is %s", path, finalPath)
+	}
+	return finalPath, nil
+}
+
+// listDriveLetters returns the drive letters in use, including the letters of
+// the network drives and of the removable drives.
+func listDriveLetters() ([]string, error) {
+	drives, err := windows.GetLogicalDrives()
+	if err != nil {
+		return nil, fmt.Errorf("error listing the drive letters: %w", err)
+	}
+	letters := []string{}
+	for i := 0; i < 26; i++ {
+		if drives&(1<<uint(i)) != 0 {
+			letters = append(letters, string(rune('A'+i)))
+		}
+	}
+	return letters, nil
+}
+
+// getVolumePathNames returns the drive letters and the mount points of the volume
+// `volumeID`, e.g. D:\ and C:\mnt\data\
+func getVolumePathNames(volumeID string) ([]string, error) {
+	volumeName, err := windows.UTF16PtrFromString(withTrailingSeparator(volumeID))
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]uint16, windows.MAX_PATH)
+	for {
+		var size uint32
+		err := windows.GetVolumePathNamesForVolumeName(volumeName, &buf[0], uint32(len(buf)), &size)
+		if errors.Is(err, windows.ERROR_MORE_DATA) {
+			buf = make([]uint16, size)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting the paths of volume %s: %w", volumeID, err)
+		}
+		break
+	}
+	// the paths are a list of NUL terminated strings terminated by an empty string
+	var paths []string
+	start := 0
+	for i, c := range buf {
+		if c != 0 {
+			continue
+		}
+		if i == start {
+			break
+		}
+		paths = append(paths, windows.UTF16ToString(buf[start:i]))
+		start = i + 1
+	}
+	return paths, nil
+}
+
+// setCompression sets the NTFS compression state of the root directory of the
+// volume `volumeID`.
+func setCompression(volumeID string, enabled bool) error {
+	root, err := windows.UTF16PtrFromString(withTrailingSeparator(volumeID))
+	if err != nil {
+		return err
+	}
+	// directories are opened with FILE_FLAG_BACKUP_SEMANTICS
+	h, err := windows.CreateFile(root, windows.GENERIC_READ|windows.GENERIC_WRITE, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE,
+		nil, windows.OPEN_EXISTING, windows.FILE_FLAG_BACKUP_SEMANTICS, 0)
+	if err != nil {
+		return fmt.Errorf("error opening the root directory of volume %s: %w", volumeID, err)
+	}
+	defer windows.CloseHandle(h)
+
+	format := compressionFormatNone
+	if enabled {
+		format = compressionFormatDefault
+	}
+	var size uint32
+	err = windows.DeviceIoControl(h, fsctlSetCompression, (*byte)(unsafe.Pointer(&format)), uint32(unsafe.Sizeof(format)), nil, 0, &size, nil)
+	if err != nil {
+		return fmt.Errorf("error setting the compression of volume %s: %w", volumeID, err)
+	}
+	return nil
+}
+
+// flushVolumeCache writes the file system cache of the volume `volumeID` to the
+// storage. Unlike FlushFileBuffers it doesn't wait for the storage to flush its
+// write cache.
+func flushVolumeCache(volumeID string) error {
+	// the volume device is opened with its ID without the trailing separator, the
+	// ID with the separator is the root directory of the file system
+	path, err := windows.UTF16PtrFromString(strings.TrimSuffix(volumeID, `\`))
+	if err != nil {
+		return err
+	}
+	h, err := windows.CreateFile(path, windows.GENERIC_READ|windows.GENERIC_WRITE, windows.FILE_SHARE_READ|windows.FILE_SHARE_WRITE,
+		nil, windows.OPEN_EXISTING, 0, 0)
+	if err != nil {
+		return fmt.Errorf("error opening volume %s: %w", volumeID, err)
+	}
+	defer windows.CloseHandle(h)
+
+	var iosb ioStatusBlock
+	status, _, _ := procNtFlushBuffersFileEx.Call(uintptr(h), flushFlagsNoSync, 0, 0, uintptr(unsafe.Pointer(&iosb)))
+	if status != 0 {
+		return fmt.Errorf("error flushing volume %s: NTSTATUS 0x%X", volumeID, status)
+	}
+	return nil
+}
+
+// setContentIndexing clears or sets the FILE_ATTRIBUTE_NOT_CONTENT_INDEXED attribute
+// of the root directory of the volume `volumeID`.
+func setContentIndexing(volumeID string, enabled bool) error {
+	root, err := windows.UTF16PtrFromString(withTrailingSeparator(volumeID))
+	if err != nil {
+		return err
+	}
+	attributes, err := windows.GetFileAttributes(root)
+	if err != nil {
+		return fmt.Errorf("error getting the attributes of the root directory of volume %s: %w", volumeID, err)
+	}
+	if enabled {
+		attributes &^= windows.FILE_ATTRIBUTE_NOT_CONTENT_INDEXED
+	} else {
+		attributes |= windows.FILE_ATTRIBUTE_NOT_CONTENT_INDEXED
+	}
+	if err := windows.SetFileAttributes(root, attributes); err != nil {
+		return fmt.Errorf("error setting the attributes of the root directory of volume %s: %w", volumeID, err)
+	}
+	return nil
+}
+
+// createDirectories creates the missing directories of `path` from the top most
+// one with the security descriptor `sddl`, they inherit the permissions of their
+// parent if it's empty. The directories created are removed if it fails.
+func createDirectories(path, sddl string) ([]string, error) {
+	var sa *windows.SecurityAttributes
+	if sddl != "" {
+		sd, err := windows.SecurityDescriptorFromString(sddl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid security descriptor %q: %w", sddl, err)
+		}
+		sa = &windows.SecurityAttributes{SecurityDescriptor: sd}
+		sa.Length = uint32(unsafe.Sizeof(*sa))
+	}
+
+	missing := []string{}
+	for dir := filepath.Clean(path); ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(dir); err == nil {
+			break
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+		missing = append(missing, dir)
+		if filepath.Dir(dir) == dir {
+			break
+		}
+	}
+
+	created := []string{}
+	for i := len(missing) - 1; i >= 0; i-- {
+		p, err := windows.UTF16PtrFromString(missing[i])
+		if err == nil {
+			err = windows.CreateDirectory(p, sa)
+		}
+		if err == windows.ERROR_ALREADY_EXISTS {
+			// created concurrently, it's not ours to remove
+			continue
+		}
+		if err != nil {
+			removeDirectories(created)
+			return nil, fmt.Errorf("error creating directory %s: %w", missing[i], err)
+		}
+		created = append(created, missing[i])
+	}
+	return created, nil
+}
+
+// attachVirtualDisk creates the dynamic VHDX `path` of `sizeBytes` bytes if it
+// doesn't exist and attaches it without a drive letter until it's detached or
+// the host restarts, it returns the number of its disk.
+func attachVirtualDisk(path string, sizeBytes int64) (uint32, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		params := vhd.CreateVirtualDiskParameters{
+			Version:  2,
+			Version2: vhd.CreateVersion2{MaximumSize: uint64(sizeBytes)},
+		}
+		handle, err := vhd.CreateVirtualDisk(path, vhd.VirtualDiskAccessNone, vhd.CreateVirtualDiskFlagNone, &params)
+		if err != nil {
+			return 0, fmt.Errorf("error creating VHDX %s: %w", path, err)
+		}
+		syscall.CloseHandle(handle)
+	} else if err != nil {
+		return 0, err
+	}
+
+	handle, err := vhd.OpenVirtualDisk(path, vhd.VirtualDiskAccessNone, vhd.OpenVirtualDiskFlagNone)
+	if err != nil {
+		return 0, fmt.Errorf("error opening VHDX %s: %w", path, err)
+	}
+	defer syscall.CloseHandle(handle)
+	// the physical path of a virtual disk is only available while it's attached
+	physicalPath, err := vhd.GetVirtualDiskPhysicalPath(handle)
+	if err != nil {
+		// the disk is detached when the handle is closed without a permanent lifetime
+		params := vhd.AttachVirtualDiskParameters{Version: 2}
+		flags := vhd.AttachVirtualDiskFlagNoDriveLetter | vhd.AttachVirtualDiskFlagPermanentLifetime
+		if err := vhd.AttachVirtualDisk(handle, flags, &params); err != nil {
+			return 0, fmt.Errorf("error attaching VHDX %s: %w", path, err)
+		}
+		if physicalPath, err = vhd.GetVirtualDiskPhysicalPath(handle); err != nil {
+			return 0, fmt.Errorf("error getting the disk of VHDX %s: %w", path, err)
+		}
+	}
+	// e.g. \\.\PhysicalDrive3
+	n, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(physicalPath), `\\.\physicaldrive`), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("unexpected physical path %q of VHDX %s", physicalPath, path)
+	}
+	return uint32(n), nil
+}
+
+// deleteVirtualDisk detaches the VHDX `path` if it's attached and deletes it.
+func deleteVirtualDisk(path string) error {
+	handle, err := vhd.OpenVirtualDisk(path, vhd.VirtualDiskAccessNone, vhd.OpenVirtualDiskFlagNone)
+	if err != nil {
+		if _, statErr := os.Stat(path); os.IsNotExist(statErr) {
+			return nil
+		}
+		return fmt.Errorf("error opening VHDX %s: %w", path, err)
+	}
+	if _, err := vhd.GetVirtualDiskPhysicalPath(handle); err == nil {
+		if err := vhd.DetachVirtualDisk(handle); err != nil {
+			syscall.CloseHandle(handle)
+			return fmt.Errorf("error detaching VHDX %s: %w", path, err)
+		}
+	}
+	syscall.CloseHandle(handle)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error deleting VHDX %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package volume
+
+import (
+	"container/list"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/cache"
+)
+
+const (
+	maxSymlinkCacheEntries = 1024
+
+	// symlinkCachePriority is the priority of the symlink cache in the cache
+	// memory budget, dereferencing a symlink again is cheap.
+	symlinkCachePriority = 0
+
+	// symlinkCacheEntryOverhead is the estimated size of an entry besides its
+	// strings i.e. the list element, the map entry and the entry itself.
+	symlinkCacheEntryOverhead = 160
+)
+
+// symlinkCacheMetrics are the stats of the symlink cache, published as
+// `symlink_cache` in the metrics server.
+var symlinkCacheMetrics = expvar.NewMap("symlink_cache")
+
+// symlinks caches the symlinks dereferenced while looking for the volume of a path.
+var symlinks = newSymlinkCache(maxSymlinkCacheEntries, dereferenceSymlink, symlinkCacheMetrics, cache.Memory)
+
+// symlinkCache caches the targets of symlinks by (path, mtime), a symlink that's
+// recreated pointing to a different target gets a new mtime so it's
+// dereferenced again. The least recently used entries are evicted when the
+// cache is full or the caches exceed their memory budget.
+type symlinkCache struct {
+	mu         sync.Mutex
+	entries    map[string]*list.Element
+	lru        *list.List
+	maxEntries int
+
+	// dereference resolves the target of a symlink on a cache miss.
+	dereference func(path string) (string, error)
+	metrics     *expvar.Map
+	memory      *cache.Account
+}
+
+type symlinkCacheEntry struct {
+	path    string
+	modTime time.Time
+	target  string
+}
+
+func (e *symlinkCacheEntry) size() int64 {
+	return int64(len(e.path)+len(e.target)) + symlinkCacheEntryOverhead
+}
+
+func newSymlinkCache(maxEntries int, dereference func(path string) (string, error), metrics *expvar.Map, budget *cache.Budget) *symlinkCache {
+	c := &symlinkCache{
+		entries:     make(map[string]*list.Element),
+		lru:         list.New(),
+		maxEntries:  maxEntries,
+		dereference: dereference,
+		metrics:     metrics,
+	}
+	metrics.Set("entries", expvar.Func(func() interface{} {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.lru.Len()
+	}))
+	c.memory = budget.Register("symlinks", symlinkCachePriority, c)
+	return c
+}
+
+// Target returns the target of the symlink `path` whose mtime is `modTime`.
+func (c *symlinkCache) Target(path string, modTime time.Time) (string, error) {
+	c.mu.Lock()
+	if e, ok := c.entries[path]; ok {
+		entry := e.Value.(*symlinkCacheEntry)
+		if entry.modTime.Equal(modTime) {
+			c.lru.MoveToFront(e)
+			c.mu.Unlock()
+			c.metrics.Add("hits", 1)
+			return entry.target, nil
+		}
+		// the symlink changed since it was cached
+		c.remove(e)
+	}
+	c.mu.Unlock()
+	c.metrics.Add("misses", 1)
+
+	target, err := c.dereference(path)
+	if err != nil {
+		return "", err
+	}
+
+	entry := &symlinkCacheEntry{path: path, modTime: modTime, target: target}
+	c.mu.Lock()
+	if e, ok := c.entries[path]; ok {
+		// cached by a concurrent call
+		c.remove(e)
+	}
+	c.entries[path] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+		c.metrics.Add("evictions", 1)
+	}
+	c.mu.Unlock()
+
+	// charged without the lock held as the budget may evict entries of this cache
+	c.memory.Charge(entry.size())
+	return target, nil
+}
+
+// EvictOldest evicts the least recently used entry, it's called by the memory
+// budget of the caches.
+func (c *symlinkCache) EvictOldest() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	oldest := c.lru.Back()
+	if oldest == nil {
+		return 0
+	}
+	entry := oldest.Value.(*symlinkCacheEntry)
+	c.lru.Remove(oldest)
+	delete(c.entries, entry.path)
+	c.metrics.Add("evictions", 1)
+	return entry.size()
+}
+
+// remove removes the element `e` and releases its memory, it must be called with
+// the lock held.
+func (c *symlinkCache) remove(e *list.Element) {
+	entry := e.Value.(*symlinkCacheEntry)
+	c.lru.Remove(e)
+	delete(c.entries, entry.path)
+	c.memory.Release(entry.size())
+}
@@ -0,0 +1,94 @@
+package volume
+
+import (
+	"expvar"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/cache"
+)
+
+func TestSymlinkCache(t *testing.T) {
+	targets := map[string]string{
+		"a": "Volume{a}",
+		"b": "Volume{b}",
+		"c": "Volume{c}",
+	}
+	dereferences := 0
+	dereference := func(path string) (string, error) {
+		dereferences++
+		return targets[path], nil
+	}
+	metrics := new(expvar.Map).Init()
+	budget := cache.NewBudget(cache.DefaultMemoryLimit, new(expvar.Map).Init())
+	cache := newSymlinkCache(2, dereference, metrics, budget)
+
+	mtime := time.Unix(1, 0)
+	testCases := []struct {
+		name               string
+		path               string
+		modTime            time.Time
+		expectDereferences int
+	}{
+		{name: "miss", path: "a", modTime: mtime, expectDereferences: 1},
+		{name: "hit", path: "a", modTime: mtime, expectDereferences: 1},
+		{name: "miss other path", path: "b", modTime: mtime, expectDereferences: 2},
+		{name: "miss after the symlink changed", path: "a", modTime: mtime.Add(time.Second), expectDereferences: 3},
+		{name: "miss evicts the least recently used entry", path: "c", modTime: mtime, expectDereferences: 4},
+		{name: "hit recently used entry", path: "a", modTime: mtime.Add(time.Second), expectDereferences: 4},
+		{name: "miss evicted entry", path: "b", modTime: mtime, expectDereferences: 5},
+	}
+	for _, tc := range testCases {
+		target, err := cache.Target(tc.path, tc.modTime)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.name, err)
+		}
+		if target != targets[tc.path] {
+			t.Errorf("%s: expected target %q, got %q", tc.name, targets[tc.path], target)
+		}
+		if dereferences != tc.expectDereferences {
+			t.Errorf("%s: expected %d dereferences, got %d", tc.name, tc.expectDereferences, dereferences)
+		}
+	}
+
+	expectMetrics := map[string]string{"hits": "2", "misses": "5", "evictions": "2", "entries": "2"}
+	for name, expect := range expectMetrics {
+		if got := metrics.Get(name).String(); got != expect {
+			t.Errorf("expected metric %s=%s, got %s", name, expect, got)
+		}
+	}
+}
+
+func TestSymlinkCacheMemoryBudget(t *testing.T) {
+	dereference := func(path string) (string, error) {
+		return "Volume{" + path + "}", nil
+	}
+	entrySize := int64(len("a")+len("Volume{a}")) + symlinkCacheEntryOverhead
+	budgetMetrics := new(expvar.Map).Init()
+	// the budget fits two entries
+	budget := cache.NewBudget(2*entrySize, budgetMetrics)
+	metrics := new(expvar.Map).Init()
+	symlinkCache := newSymlinkCache(maxSymlinkCacheEntries, dereference, metrics, budget)
+
+	mtime := time.Unix(1, 0)
+	for _, path := range []string{"a", "b", "c"} {
+		if _, err := symlinkCache.Target(path, mtime); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, ok := symlinkCache.entries["a"]; ok {
+		t.Errorf("expected the least recently used entry to be evicted")
+	}
+	if got := metrics.Get("evictions").String(); got != "1" {
+		t.Errorf("expected 1 eviction, got %s", got)
+	}
+	if got := budgetMetrics.Get("used").String(); got != fmt.Sprint(2*entrySize) {
+		t.Errorf("expected %d bytes used, got %s", 2*entrySize, got)
+	}
+
+	budget.SetLimit(entrySize)
+	if got := metrics.Get("entries").String(); got != "1" {
+		t.Errorf("expected 1 entry after lowering the limit, got %s", got)
+	}
+}
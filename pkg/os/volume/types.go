@@ -0,0 +1,249 @@
+package volume
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+)
+
+// VolumeStats are the stats of a volume.
+type VolumeStats struct {
+	// Size of the volume in bytes
+	TotalBytes int64
+
+	// Bytes used in the volume
+	UsedBytes int64
+
+	// File system of the volume e.g. NTFS, empty if the volume isn't formatted
+	FileSystemType string
+
+	// Label of the file system
+	FileSystemLabel string
+
+	// Health of the volume i.e. Healthy, Warning or Unhealthy
+	HealthStatus string
+
+	// Operational status of the volume e.g. OK, Full Repair Needed
+	OperationalStatus string
+
+	// Serial number of the file system e.g. 1A2B-3C4D, empty if the volume isn't formatted
+	SerialNumber string
+}
+
+// JournalPosition is a position in the USN change journal of a volume.
+type JournalPosition struct {
+	// JournalID identifies the journal, it changes when the journal is recreated
+	JournalID uint64
+
+	// USN is the update sequence number of the next record of the journal
+	USN int64
+}
+
+// VolumeInfo describes a volume of the host.
+type VolumeInfo struct {
+	// VolumeID is the volume device ID of the volume
+	VolumeID string `json:"UniqueId"`
+
+	// Size of the volume in bytes
+	SizeBytes int64 `json:"Size"`
+
+	// Disk and partition of the volume, nil if the volume isn't on a partition
+	// of a disk e.g. optical drives
+	DiskNumber      *uint32
+	PartitionNumber *uint32
+
+	// Paths where the volume is accessible e.g. drive letters and mount points
+	AccessPaths []string
+
+	// Serial number of the file system e.g. 1A2B-3C4D, empty if the volume isn't formatted
+	SerialNumber string `json:"-"`
+
+	// Serial number of the disk of the volume, nil if the volume isn't on a
+	// partition of a disk or if the disk doesn't have a serial number
+	DiskSerialNumber *string
+
+	// File system of the volume e.g. NTFS, empty if the volume isn't formatted
+	FileSystem string `json:"-"`
+
+	// Label of the file system, empty if the volume isn't formatted
+	Label string `json:"-"`
+
+	// Name of the GPT partition of the volume, empty if the volume isn't on a
+	// partition of a GPT disk
+	GptName string `json:"-"`
+}
+
+// OpenHandle is a handle of a process to a file or a directory of a volume.
+type OpenHandle struct {
+	ProcessID uint32
+	// ProcessName is the executable name of the process e.g. pause.exe, empty if
+	// it can't be queried
+	ProcessName string
+	// Path of the file or directory starting with the volume device ID
+	Path string
+}
+
+// volumeInformation is the file system information of a volume returned by
+// GetVolumeInformation, it's empty if the volume isn't formatted.
+type volumeInformation struct {
+	fileSystem   string
+	label        string
+	serialNumber uint32
+}
+
+// serialNumberString returns the serial number of the file system the way the
+// vol command displays it e.g. 1A2B-3C4D, empty if the volume isn't formatted.
+func (i *volumeInformation) serialNumberString() string {
+	if i.fileSystem == "" {
+		return ""
+	}
+	return fmt.Sprintf("%04X-%04X", i.serialNumber>>16, i.serialNumber&0xFFFF)
+}
+
+// MountConflictError is returned when a volume is mounted at a path where another
+// volume is already mounted.
+type MountConflictError struct {
+	VolumeID        string
+	Path            string
+	MountedVolumeID string
+}
+
+func (e *MountConflictError) Error() string {
+	return fmt.Sprintf("cannot mount volume %s at %s, volume %s is mounted at it", e.VolumeID, e.Path, e.MountedVolumeID)
+}
+
+// ErrorCode returns the stable code of the error.
+func (e *MountConflictError) ErrorCode() string {
+	return utils.ErrorCodeMountConflict
+}
+
+// NoPartitionError is returned when a volume isn't backed by a partition e.g. a
+// volume that spans multiple dynamic disks, so its size can't be queried or
+// changed through its partition.
+type NoPartitionError struct {
+	VolumeID string
+}
+
+func (e *NoPartitionError) Error() string {
+	return fmt.Sprintf("volume %s has no partition, it may span multiple disks", e.VolumeID)
+}
+
+// ErrorCode returns the stable code of the error.
+func (e *NoPartitionError) ErrorCode() string {
+	return utils.ErrorCodeNotSupported
+}
+
+// RepairMode is the switch of Repair-Volume that selects how a volume is repaired.
+type RepairMode string
+
+const (
+	RepairModeScan              RepairMode = "Scan"
+	RepairModeSpotFix           RepairMode = "SpotFix"
+	RepairModeOfflineScanAndFix RepairMode = "OfflineScanAndFix"
+)
+
+// MountMarker records the node where a volume is mounted read-write, it's kept in
+// the volume so that it moves with the disk to the next node.
+type MountMarker struct {
+	Node      string    `json:"node"`
+	MountedAt time.Time `json:"mountedAt"`
+}
+
+// DedupUsageType is the -UsageType of Enable-DedupVolume, the workload the Data
+// Deduplication of a volume is tuned for.
+type DedupUsageType string
+
+const (
+	// DedupUsageTypeDefault is for general purpose file servers
+	DedupUsageTypeDefault DedupUsageType = "Default"
+	// DedupUsageTypeHyperV is for the virtual disks of VDI servers
+	DedupUsageTypeHyperV DedupUsageType = "HyperV"
+	// DedupUsageTypeBackup is for the virtualized backup applications
+	DedupUsageTypeBackup DedupUsageType = "Backup"
+)
+
+// DedupStatus is the Data Deduplication status of a volume.
+// JSON field names are the names of the properties of Get-DedupVolume and Get-DedupStatus.
+type DedupStatus struct {
+	Enabled   bool   `json:"Enabled"`
+	UsageType string `json:"UsageType"`
+	// SavedSpace is the number of bytes saved by the deduplication
+	SavedSpace int64 `json:"SavedSpace"`
+	// SavingsRate is the percentage of the size of the files saved by the deduplication
+	SavingsRate         uint32 `json:"SavingsRate"`
+	OptimizedFilesCount uint64 `json:"OptimizedFilesCount"`
+	InPolicyFilesCount  uint64 `json:"InPolicyFilesCount"`
+	// LastOptimizationTime is zero if the volume was never optimized
+	LastOptimizationTime          time.Time `json:"LastOptimizationTime"`
+	LastOptimizationResultMessage string    `json:"LastOptimizationResultMessage"`
+}
+
+// FormatOptions are the options of the NTFS format of a volume.
+type FormatOptions struct {
+	// AllocationUnitSize is the cluster size in bytes, 0 uses the default cluster size
+	AllocationUnitSize uint32
+
+	// Label is the file system label, empty for no label
+	Label string
+
+	// Full requests a full format instead of a quick format
+	Full bool
+
+	// Compress enables the NTFS compression of the files of the volume
+	Compress bool
+
+	// UseLargeFRS formats the volume with large file record segments, for volumes
+	// with large or heavily fragmented files e.g. compressed files
+	UseLargeFRS bool
+}
+
+// CleanupOptions are the temporary files of a volume deleted by a cleanup.
+type CleanupOptions struct {
+	// RecycleBin empties the recycle bin of the volume
+	RecycleBin bool
+
+	// TempDirs are directories relative to the root of the volume whose content is deleted
+	TempDirs []string
+}
+
+// OptimizeOptions are the optimizations of a volume run by Optimize-Volume, the
+// default optimization of the media type of the volume is run if none is set.
+type OptimizeOptions struct {
+	// ReTrim sends TRIM requests for the free space of the volume
+	ReTrim bool
+
+	// Defrag defragments the volume
+	Defrag bool
+
+	// SlabConsolidate consolidates the slabs of a thin provisioned volume
+	SlabConsolidate bool
+}
+
+// VolumeEventType is the type of the change of a volume reported by WatchVolumes.
+type VolumeEventType string
+
+const (
+	// VolumeAdded is reported when a volume appears e.g. a disk is attached
+	VolumeAdded VolumeEventType = "Added"
+	// VolumeRemoved is reported when a volume disappears e.g. a disk is detached
+	VolumeRemoved VolumeEventType = "Removed"
+	// VolumeChanged is reported when the size, the drive letter, the file system or
+	// the health of a volume changes
+	VolumeChanged VolumeEventType = "Changed"
+)
+
+// VolumeEvent is a change of a volume of the host.
+type VolumeEvent struct {
+	Type VolumeEventType
+
+	// VolumeID is the volume device ID of the volume
+	VolumeID string
+
+	// The properties of the volume after the change, before it for VolumeRemoved
+	SizeBytes         int64
+	DriveLetter       string
+	FileSystemType    string
+	HealthStatus      string
+	OperationalStatus string
+}
@@ -0,0 +1,121 @@
+package vss
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/cim"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+)
+
+// Implements the Volume Shadow Copy OS API calls with the WMI class
+// Win32_ShadowCopy. All code here should be very simple pass-through to the OS
+// APIs, any logic around the APIs goes in pkg/server/vss/server.go.
+
+type API interface {
+	// CreateShadowCopy creates a shadow copy of a volume.
+	CreateShadowCopy(volumeID string) (*ShadowCopy, error)
+	// ListShadowCopies lists the shadow copies of a volume sorted by creation time.
+	ListShadowCopies(volumeID string) ([]ShadowCopy, error)
+	// DeleteShadowCopy deletes a shadow copy, it doesn't fail if it doesn't exist.
+	DeleteShadowCopy(shadowCopyID string) error
+	// RevertToShadowCopy reverts the volume of a shadow copy to its content, the volume is
+	// dismounted even if it has open handles if `forceDismount` is set.
+	RevertToShadowCopy(shadowCopyID string, forceDismount bool) error
+}
+
+type VssAPI struct{}
+
+var _ API = &VssAPI{}
+
+func New() VssAPI {
+	return VssAPI{}
+}
+
+// shadowCopyProperties are the properties of Win32_ShadowCopy selected by
+// listShadowCopies, InstallDate is converted to RFC 3339.
+var shadowCopyProperties = []string{"ID", "VolumeName", "DeviceObject", "InstallDate"}
+
+// volumeName returns the name of a volume in Win32_ShadowCopy i.e. its volume
+// device ID with the trailing separator.
+func volumeName(volumeID string) string {
+	if !strings.HasSuffix(volumeID, `\`) {
+		return volumeID + `\`
+	}
+	return volumeID
+}
+
+func (VssAPI) CreateShadowCopy(volumeID string) (*ShadowCopy, error) {
+	// the client accessible context creates a persistent shadow copy without writers, i.e. a
+	// crash consistent copy that's kept until it's deleted
+	cmdLine := `$result = Invoke-CimMethod -ClassName Win32_ShadowCopy -MethodName Create ` +
+		`-Arguments @{Volume = $Env:vssvolume; Context = 'ClientAccessible'} -ErrorAction Stop; ` +
+		`if ($result.ReturnValue -ne 0) { throw "Win32_ShadowCopy.Create failed with return value $($result.ReturnValue)" }; ` +
+		`$result.ShadowID`
+	out, err := utils.RunPowershellCmd(cmdLine, fmt.Sprintf("vssvolume=%s", volumeName(volumeID)))
+	if err != nil {
+		return nil, fmt.Errorf("error creating a shadow copy of volume %s. cmd: %s, output: %s, error: %v", volumeID, utils.CommandText(cmdLine), string(out), err)
+	}
+
+	shadowCopyID := strings.TrimSpace(string(out))
+	shadowCopies, err := listShadowCopies(`$_.ID -eq $Env:vssid`, fmt.Sprintf("vssid=%s", shadowCopyID))
+	if err != nil {
+		return nil, err
+	}
+	if len(shadowCopies) != 1 {
+		return nil, fmt.Errorf("shadow copy %s of volume %s not found after it was created", shadowCopyID, volumeID)
+	}
+	return &shadowCopies[0], nil
+}
+
+func (VssAPI) ListShadowCopies(volumeID string) ([]ShadowCopy, error) {
+	return listShadowCopies(`$_.VolumeName -eq $Env:vssvolume`, fmt.Sprintf("vssvolume=%s", volumeName(volumeID)))
+}
+
+func (VssAPI) DeleteShadowCopy(shadowCopyID string) error {
+	cmdLine := `Get-CimInstance -ClassName Win32_ShadowCopy -ErrorAction Stop | Where-Object { $_.ID -eq $Env:vssid } | Remove-CimInstance -ErrorAction Stop`
+	out, err := utils.RunPowershellCmd(cmdLine, fmt.Sprintf("vssid=%s", shadowCopyID))
+	if err != nil {
+		return fmt.Errorf("error deleting shadow copy %s. cmd: %s, output: %s, error: %v", shadowCopyID, utils.CommandText(cmdLine), string(out), err)
+	}
+	return nil
+}
+
+func (VssAPI) RevertToShadowCopy(shadowCopyID string, forceDismount bool) error {
+	// Win32_ShadowCopy.Revert returns 4 (volume not supported) or 5 (unsupported shadow copy context)
+	// when the provider can't revert the volume
+	cmdLine := `$shadowCopy = Get-CimInstance -ClassName Win32_ShadowCopy -ErrorAction Stop | Where-Object { $_.ID -eq $Env:vssid }; ` +
+		`if (-not $shadowCopy) { throw "shadow copy $Env:vssid not found" }; ` +
+		`$result = Invoke-CimMethod -InputObject $shadowCopy -MethodName Revert ` +
+		`-Arguments @{ForceDismount = ($Env:vssforce -eq 'true')} -ErrorAction Stop; ` +
+		`if ($result.ReturnValue -ne 0) { throw "Win32_ShadowCopy.Revert failed with return value $($result.ReturnValue)" }`
+	out, err := utils.RunPowershellCmd(cmdLine, fmt.Sprintf("vssid=%s", shadowCopyID), fmt.Sprintf("vssforce=%t", forceDismount))
+	if err != nil {
+		return fmt.Errorf("error reverting to shadow copy %s. cmd: %s, output: %s, error: %v", shadowCopyID, utils.CommandText(cmdLine), string(out), err)
+	}
+	return nil
+}
+
+// listShadowCopies lists the shadow copies that match the PowerShell condition
+// `filter`, the values it compares are passed in the environment variables `envs`.
+func listShadowCopies(filter string, envs ...string) ([]ShadowCopy, error) {
+	// ConvertTo-Json is not part of the pipeline because powershell converts an
+	// array with one element to a single element
+	cmdLine := fmt.Sprintf(`ConvertTo-Json @(Get-CimInstance -ClassName Win32_ShadowCopy -ErrorAction Stop | `+
+		`Where-Object { %s } | `+
+		`Select-Object ID, VolumeName, DeviceObject, @{n='InstallDate';e={$_.InstallDate.ToUniversalTime().ToString('o')}})`, filter)
+	out, err := utils.RunPowershellCmd(cmdLine, envs...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing shadow copies. cmd: %s, output: %s, error: %v", utils.CommandText(cmdLine), string(out), err)
+	}
+
+	var shadowCopies []ShadowCopy
+	if err := cim.Unmarshal(out, "Win32_ShadowCopy", &shadowCopies, shadowCopyProperties...); err != nil {
+		return nil, fmt.Errorf("failed parsing shadow copies. cmd: %s, output: %s, error: %v", utils.CommandText(cmdLine), string(out), err)
+	}
+	sort.Slice(shadowCopies, func(i, j int) bool {
+		return shadowCopies[i].CreationTime.Before(shadowCopies[j].CreationTime)
+	})
+	return shadowCopies, nil
+}
@@ -0,0 +1,12 @@
+package vss
+
+import "time"
+
+// ShadowCopy is a shadow copy of a volume.
+// JSON field names are the WMI Win32_ShadowCopy field names.
+type ShadowCopy struct {
+	ID           string    `json:"ID"`
+	VolumeID     string    `json:"VolumeName"`
+	DeviceObject string    `json:"DeviceObject"`
+	CreationTime time.Time `json:"InstallDate"`
+}
@@ -0,0 +1,57 @@
+package impl
+
+type KeyProtectorType uint32
+
+const (
+	KEY_PROTECTOR_TYPE_PASSWORD          = 0
+	KEY_PROTECTOR_TYPE_RECOVERY_PASSWORD = 1
+)
+
+type KeyProtector struct {
+	Type   KeyProtectorType
+	Secret string
+}
+
+type EncryptionMethod uint32
+
+const (
+	ENCRYPTION_METHOD_XTS_AES_128 = 0
+	ENCRYPTION_METHOD_XTS_AES_256 = 1
+	ENCRYPTION_METHOD_AES_128     = 2
+	ENCRYPTION_METHOD_AES_256     = 3
+)
+
+type EnableBitLockerRequest struct {
+	VolumeId         string
+	KeyProtector     *KeyProtector
+	EncryptionMethod EncryptionMethod
+	UsedSpaceOnly    bool
+}
+
+type EnableBitLockerResponse struct {
+	KeyProtectorId string
+	// Recovery password of the key protector if it was generated
+	RecoveryPassword string
+}
+
+type UnlockVolumeRequest struct {
+	VolumeId     string
+	KeyProtector *KeyProtector
+}
+
+type UnlockVolumeResponse struct {
+	// Intentionally empty.
+}
+
+type GetBitLockerStatusRequest struct {
+	VolumeId string
+}
+
+type GetBitLockerStatusResponse struct {
+	VolumeStatus         string
+	ProtectionStatus     string
+	LockStatus           string
+	EncryptionPercentage uint32
+	EncryptionMethod     string
+	KeyProtectorTypes    []string
+}
@@ -0,0 +1,21 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package impl
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+type VersionedAPI interface {
+	Register(grpcServer *grpc.Server)
+}
+
+// All the functions this group's server needs to define.
+type ServerInterface interface {
+	EnableBitLocker(context.Context, *EnableBitLockerRequest, apiversion.Version) (*EnableBitLockerResponse, error)
+	GetBitLockerStatus(context.Context, *GetBitLockerStatusRequest, apiversion.Version) (*GetBitLockerStatusResponse, error)
+	UnlockVolume(context.Context, *UnlockVolumeRequest, apiversion.Version) (*UnlockVolumeResponse, error)
+}
@@ -0,0 +1,3 @@
+package v1alpha1
+
+// Add manual conversion functions here to override automatic conversion functions
@@ -0,0 +1,202 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	v1alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/bitlocker/v1alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/bitlocker/impl"
+)
+
+func autoConvert_v1alpha1_EnableBitLockerRequest_To_impl_EnableBitLockerRequest(in *v1alpha1.EnableBitLockerRequest, out *impl.EnableBitLockerRequest) error {
+	out.VolumeId = in.VolumeId
+	if in.KeyProtector != nil {
+		in, out := &in.KeyProtector, &out.KeyProtector
+		*out = new(impl.KeyProtector)
+		if err := Convert_v1alpha1_KeyProtector_To_impl_KeyProtector(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.KeyProtector = nil
+	}
+	out.EncryptionMethod = impl.EncryptionMethod(in.EncryptionMethod)
+	out.UsedSpaceOnly = in.UsedSpaceOnly
+	return nil
+}
+
+// Convert_v1alpha1_EnableBitLockerRequest_To_impl_EnableBitLockerRequest is an autogenerated conversion function.
+func Convert_v1alpha1_EnableBitLockerRequest_To_impl_EnableBitLockerRequest(in *v1alpha1.EnableBitLockerRequest, out *impl.EnableBitLockerRequest) error {
+	return autoConvert_v1alpha1_EnableBitLockerRequest_To_impl_EnableBitLockerRequest(in, out)
+}
+
+func autoConvert_impl_EnableBitLockerRequest_To_v1alpha1_EnableBitLockerRequest(in *impl.EnableBitLockerRequest, out *v1alpha1.EnableBitLockerRequest) error {
+	out.VolumeId = in.VolumeId
+	if in.KeyProtector != nil {
+		in, out := &in.KeyProtector, &out.KeyProtector
+		*out = new(v1alpha1.KeyProtector)
+		if err := Convert_impl_KeyProtector_To_v1alpha1_KeyProtector(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.KeyProtector = nil
+	}
+	out.EncryptionMethod = v1alpha1.EncryptionMethod(in.EncryptionMethod)
+	out.UsedSpaceOnly = in.UsedSpaceOnly
+	return nil
+}
+
+// Convert_impl_EnableBitLockerRequest_To_v1alpha1_EnableBitLockerRequest is an autogenerated conversion function.
+func Convert_impl_EnableBitLockerRequest_To_v1alpha1_EnableBitLockerRequest(in *impl.EnableBitLockerRequest, out *v1alpha1.EnableBitLockerRequest) error {
+	return autoConvert_impl_EnableBitLockerRequest_To_v1alpha1_EnableBitLockerRequest(in, out)
+}
+
+func autoConvert_v1alpha1_EnableBitLockerResponse_To_impl_EnableBitLockerResponse(in *v1alpha1.EnableBitLockerResponse, out *impl.EnableBitLockerResponse) error {
+	out.KeyProtectorId = in.KeyProtectorId
+	out.RecoveryPassword = in.RecoveryPassword
+	return nil
+}
+
+// Convert_v1alpha1_EnableBitLockerResponse_To_impl_EnableBitLockerResponse is an autogenerated conversion function.
+func Convert_v1alpha1_EnableBitLockerResponse_To_impl_EnableBitLockerResponse(in *v1alpha1.EnableBitLockerResponse, out *impl.EnableBitLockerResponse) error {
+	return autoConvert_v1alpha1_EnableBitLockerResponse_To_impl_EnableBitLockerResponse(in, out)
+}
+
+func autoConvert_impl_EnableBitLockerResponse_To_v1alpha1_EnableBitLockerResponse(in *impl.EnableBitLockerResponse, out *v1alpha1.EnableBitLockerResponse) error {
+	out.KeyProtectorId = in.KeyProtectorId
+	out.RecoveryPassword = in.RecoveryPassword
+	return nil
+}
+
+// Convert_impl_EnableBitLockerResponse_To_v1alpha1_EnableBitLockerResponse is an autogenerated conversion function.
+func Convert_impl_EnableBitLockerResponse_To_v1alpha1_EnableBitLockerResponse(in *impl.EnableBitLockerResponse, out *v1alpha1.EnableBitLockerResponse) error {
+	return autoConvert_impl_EnableBitLockerResponse_To_v1alpha1_EnableBitLockerResponse(in, out)
+}
+
+func autoConvert_v1alpha1_GetBitLockerStatusRequest_To_impl_GetBitLockerStatusRequest(in *v1alpha1.GetBitLockerStatusRequest, out *impl.GetBitLockerStatusRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v1alpha1_GetBitLockerStatusRequest_To_impl_GetBitLockerStatusRequest is an autogenerated conversion function.
+func Convert_v1alpha1_GetBitLockerStatusRequest_To_impl_GetBitLockerStatusRequest(in *v1alpha1.GetBitLockerStatusRequest, out *impl.GetBitLockerStatusRequest) error {
+	return autoConvert_v1alpha1_GetBitLockerStatusRequest_To_impl_GetBitLockerStatusRequest(in, out)
+}
+
+func autoConvert_impl_GetBitLockerStatusRequest_To_v1alpha1_GetBitLockerStatusRequest(in *impl.GetBitLockerStatusRequest, out *v1alpha1.GetBitLockerStatusRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_GetBitLockerStatusRequest_To_v1alpha1_GetBitLockerStatusRequest is an autogenerated conversion function.
+func Convert_impl_GetBitLockerStatusRequest_To_v1alpha1_GetBitLockerStatusRequest(in *impl.GetBitLockerStatusRequest, out *v1alpha1.GetBitLockerStatusRequest) error {
+	return autoConvert_impl_GetBitLockerStatusRequest_To_v1alpha1_GetBitLockerStatusRequest(in, out)
+}
+
+func autoConvert_v1alpha1_GetBitLockerStatusResponse_To_impl_GetBitLockerStatusResponse(in *v1alpha1.GetBitLockerStatusResponse, out *impl.GetBitLockerStatusResponse) error {
+	out.VolumeStatus = in.VolumeStatus
+	out.ProtectionStatus = in.ProtectionStatus
+	out.LockStatus = in.LockStatus
+	out.EncryptionPercentage = in.EncryptionPercentage
+	out.EncryptionMethod = in.EncryptionMethod
+	out.KeyProtectorTypes = *(*[]string)(unsafe.Pointer(&in.KeyProtectorTypes))
+	return nil
+}
+
+// Convert_v1alpha1_GetBitLockerStatusResponse_To_impl_GetBitLockerStatusResponse is an autogenerated conversion function.
+func Convert_v1alpha1_GetBitLockerStatusResponse_To_impl_GetBitLockerStatusResponse(in *v1alpha1.GetBitLockerStatusResponse, out *impl.GetBitLockerStatusResponse) error {
+	return autoConvert_v1alpha1_GetBitLockerStatusResponse_To_impl_GetBitLockerStatusResponse(in, out)
+}
+
+func autoConvert_impl_GetBitLockerStatusResponse_To_v1alpha1_GetBitLockerStatusResponse(in *impl.GetBitLockerStatusResponse, out *v1alpha1.GetBitLockerStatusResponse) error {
+	out.VolumeStatus = in.VolumeStatus
+	out.ProtectionStatus = in.ProtectionStatus
+	out.LockStatus = in.LockStatus
+	out.EncryptionPercentage = in.EncryptionPercentage
+	out.EncryptionMethod = in.EncryptionMethod
+	out.KeyProtectorTypes = *(*[]string)(unsafe.Pointer(&in.KeyProtectorTypes))
+	return nil
+}
+
+// Convert_impl_GetBitLockerStatusResponse_To_v1alpha1_GetBitLockerStatusResponse is an autogenerated conversion function.
+func Convert_impl_GetBitLockerStatusResponse_To_v1alpha1_GetBitLockerStatusResponse(in *impl.GetBitLockerStatusResponse, out *v1alpha1.GetBitLockerStatusResponse) error {
+	return autoConvert_impl_GetBitLockerStatusResponse_To_v1alpha1_GetBitLockerStatusResponse(in, out)
+}
+
+func autoConvert_v1alpha1_KeyProtector_To_impl_KeyProtector(in *v1alpha1.KeyProtector, out *impl.KeyProtector) error {
+	out.Type = impl.KeyProtectorType(in.Type)
+	out.Secret = in.Secret
+	return nil
+}
+
+// Convert_v1alpha1_KeyProtector_To_impl_KeyProtector is an autogenerated conversion function.
+func Convert_v1alpha1_KeyProtector_To_impl_KeyProtector(in *v1alpha1.KeyProtector, out *impl.KeyProtector) error {
+	return autoConvert_v1alpha1_KeyProtector_To_impl_KeyProtector(in, out)
+}
+
+func autoConvert_impl_KeyProtector_To_v1alpha1_KeyProtector(in *impl.KeyProtector, out *v1alpha1.KeyProtector) error {
+	out.Type = v1alpha1.KeyProtectorType(in.Type)
+	out.Secret = in.Secret
+	return nil
+}
+
+// Convert_impl_KeyProtector_To_v1alpha1_KeyProtector is an autogenerated conversion function.
+func Convert_impl_KeyProtector_To_v1alpha1_KeyProtector(in *impl.KeyProtector, out *v1alpha1.KeyProtector) error {
+	return autoConvert_impl_KeyProtector_To_v1alpha1_KeyProtector(in, out)
+}
+
+func autoConvert_v1alpha1_UnlockVolumeRequest_To_impl_UnlockVolumeRequest(in *v1alpha1.UnlockVolumeRequest, out *impl.UnlockVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	if in.KeyProtector != nil {
+		in, out := &in.KeyProtector, &out.KeyProtector
+		*out = new(impl.KeyProtector)
+		if err := Convert_v1alpha1_KeyProtector_To_impl_KeyProtector(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.KeyProtector = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_UnlockVolumeRequest_To_impl_UnlockVolumeRequest is an autogenerated conversion function.
+func Convert_v1alpha1_UnlockVolumeRequest_To_impl_UnlockVolumeRequest(in *v1alpha1.UnlockVolumeRequest, out *impl.UnlockVolumeRequest) error {
+	return autoConvert_v1alpha1_UnlockVolumeRequest_To_impl_UnlockVolumeRequest(in, out)
+}
+
+func autoConvert_impl_UnlockVolumeRequest_To_v1alpha1_UnlockVolumeRequest(in *impl.UnlockVolumeRequest, out *v1alpha1.UnlockVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	if in.KeyProtector != nil {
+		in, out := &in.KeyProtector, &out.KeyProtector
+		*out = new(v1alpha1.KeyProtector)
+		if err := Convert_impl_KeyProtector_To_v1alpha1_KeyProtector(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.KeyProtector = nil
+	}
+	return nil
+}
+
+// Convert_impl_UnlockVolumeRequest_To_v1alpha1_UnlockVolumeRequest is an autogenerated conversion function.
+func Convert_impl_UnlockVolumeRequest_To_v1alpha1_UnlockVolumeRequest(in *impl.UnlockVolumeRequest, out *v1alpha1.UnlockVolumeRequest) error {
+	return autoConvert_impl_UnlockVolumeRequest_To_v1alpha1_UnlockVolumeRequest(in, out)
+}
+
+func autoConvert_v1alpha1_UnlockVolumeResponse_To_impl_UnlockVolumeResponse(in *v1alpha1.UnlockVolumeResponse, out *impl.UnlockVolumeResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_UnlockVolumeResponse_To_impl_UnlockVolumeResponse is an autogenerated conversion function.
+func Convert_v1alpha1_UnlockVolumeResponse_To_impl_UnlockVolumeResponse(in *v1alpha1.UnlockVolumeResponse, out *impl.UnlockVolumeResponse) error {
+	return autoConvert_v1alpha1_UnlockVolumeResponse_To_impl_UnlockVolumeResponse(in, out)
+}
+
+func autoConvert_impl_UnlockVolumeResponse_To_v1alpha1_UnlockVolumeResponse(in *impl.UnlockVolumeResponse, out *v1alpha1.UnlockVolumeResponse) error {
+	return nil
+}
+
+// Convert_impl_UnlockVolumeResponse_To_v1alpha1_UnlockVolumeResponse is an autogenerated conversion function.
+func Convert_impl_UnlockVolumeResponse_To_v1alpha1_UnlockVolumeResponse(in *impl.UnlockVolumeResponse, out *v1alpha1.UnlockVolumeResponse) error {
+	return autoConvert_impl_UnlockVolumeResponse_To_v1alpha1_UnlockVolumeResponse(in, out)
+}
@@ -0,0 +1,85 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/bitlocker/v1alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/bitlocker/impl"
+	"google.golang.org/grpc"
+)
+
+var version = apiversion.NewVersionOrPanic("v1alpha1")
+
+type versionedAPI struct {
+	apiGroupServer impl.ServerInterface
+}
+
+func NewVersionedServer(apiGroupServer impl.ServerInterface) impl.VersionedAPI {
+	return &versionedAPI{
+		apiGroupServer: apiGroupServer,
+	}
+}
+
+func (s *versionedAPI) Register(grpcServer *grpc.Server) {
+	v1alpha1.RegisterBitlockerServer(grpcServer, s)
+}
+
+func (s *versionedAPI) EnableBitLocker(context context.Context, versionedRequest *v1alpha1.EnableBitLockerRequest) (*v1alpha1.EnableBitLockerResponse, error) {
+	request := &impl.EnableBitLockerRequest{}
+	if err := Convert_v1alpha1_EnableBitLockerRequest_To_impl_EnableBitLockerRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.EnableBitLocker(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.EnableBitLockerResponse{}
+	if err := Convert_impl_EnableBitLockerResponse_To_v1alpha1_EnableBitLockerResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetBitLockerStatus(context context.Context, versionedRequest *v1alpha1.GetBitLockerStatusRequest) (*v1alpha1.GetBitLockerStatusResponse, error) {
+	request := &impl.GetBitLockerStatusRequest{}
+	if err := Convert_v1alpha1_GetBitLockerStatusRequest_To_impl_GetBitLockerStatusRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetBitLockerStatus(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.GetBitLockerStatusResponse{}
+	if err := Convert_impl_GetBitLockerStatusResponse_To_v1alpha1_GetBitLockerStatusResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) UnlockVolume(context context.Context, versionedRequest *v1alpha1.UnlockVolumeRequest) (*v1alpha1.UnlockVolumeResponse, error) {
+	request := &impl.UnlockVolumeRequest{}
+	if err := Convert_v1alpha1_UnlockVolumeRequest_To_impl_UnlockVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.UnlockVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.UnlockVolumeResponse{}
+	if err := Convert_impl_UnlockVolumeResponse_To_v1alpha1_UnlockVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
@@ -0,0 +1,134 @@
+package bitlocker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/bitlocker"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/bitlocker/impl"
+	"k8s.io/klog/v2"
+)
+
+type Server struct {
+	hostAPI bitlocker.API
+}
+
+// check that Server implements the ServerInterface
+var _ internal.ServerInterface = &Server{}
+
+func NewServer(hostAPI bitlocker.API) (*Server, error) {
+	return &Server{
+		hostAPI: hostAPI,
+	}, nil
+}
+
+var keyProtectorTypes = map[internal.KeyProtectorType]bitlocker.KeyProtectorType{
+	internal.KEY_PROTECTOR_TYPE_PASSWORD:          bitlocker.KeyProtectorTypePassword,
+	internal.KEY_PROTECTOR_TYPE_RECOVERY_PASSWORD: bitlocker.KeyProtectorTypeRecoveryPassword,
+}
+
+var encryptionMethods = map[internal.EncryptionMethod]bitlocker.EncryptionMethod{
+	internal.ENCRYPTION_METHOD_XTS_AES_128: bitlocker.EncryptionMethodXtsAes128,
+	internal.ENCRYPTION_METHOD_XTS_AES_256: bitlocker.EncryptionMethodXtsAes256,
+	internal.ENCRYPTION_METHOD_AES_128:     bitlocker.EncryptionMethodAes128,
+	internal.ENCRYPTION_METHOD_AES_256:     bitlocker.EncryptionMethodAes256,
+}
+
+// keyProtector validates the key protector of a request, `generate` allows a
+// recovery password without a secret that's generated by BitLocker.
+func keyProtector(protector *internal.KeyProtector, generate bool) (bitlocker.KeyProtector, error) {
+	if protector == nil {
+		return bitlocker.KeyProtector{}, fmt.Errorf("key protector empty")
+	}
+	protectorType, ok := keyProtectorTypes[protector.Type]
+	if !ok {
+		return bitlocker.KeyProtector{}, fmt.Errorf("invalid key protector type %d", protector.Type)
+	}
+	if protector.Secret == "" && !(generate && protectorType == bitlocker.KeyProtectorTypeRecoveryPassword) {
+		return bitlocker.KeyProtector{}, fmt.Errorf("secret of the %s key protector empty", protectorType)
+	}
+	return bitlocker.KeyProtector{Type: protectorType, Secret: protector.Secret}, nil
+}
+
+// The requests have secrets, only their volume IDs are logged.
+
+func (s *Server) EnableBitLocker(context context.Context, request *internal.EnableBitLockerRequest, version apiversion.Version) (*internal.EnableBitLockerResponse, error) {
+	klog.V(2).Infof("EnableBitLocker: volume %q", request.VolumeId)
+	response := &internal.EnableBitLockerResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+	protector, err := keyProtector(request.KeyProtector, true)
+	if err != nil {
+		klog.Errorf("invalid key protector of volume %s: %v", volumeID, err)
+		return response, err
+	}
+	method, ok := encryptionMethods[request.EncryptionMethod]
+	if !ok {
+		klog.Errorf("invalid encryption method %d", request.EncryptionMethod)
+		return response, fmt.Errorf("invalid encryption method %d", request.EncryptionMethod)
+	}
+
+	added, err := s.hostAPI.EnableBitLocker(volumeID, protector, method, request.UsedSpaceOnly)
+	if err != nil {
+		klog.Errorf("failed EnableBitLocker %v", err)
+		return response, err
+	}
+	klog.V(2).Infof("EnableBitLocker: volume %s encrypted with %s, key protector %s", volumeID, method, added.KeyProtectorId)
+	response.KeyProtectorId = added.KeyProtectorId
+	// return the recovery password only if the caller doesn't know it already
+	if protector.Type == bitlocker.KeyProtectorTypeRecoveryPassword && protector.Secret == "" {
+		response.RecoveryPassword = added.RecoveryPassword
+	}
+	return response, nil
+}
+
+func (s *Server) UnlockVolume(context context.Context, request *internal.UnlockVolumeRequest, version apiversion.Version) (*internal.UnlockVolumeResponse, error) {
+	klog.V(2).Infof("UnlockVolume: volume %q", request.VolumeId)
+	response := &internal.UnlockVolumeResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+	protector, err := keyProtector(request.KeyProtector, false)
+	if err != nil {
+		klog.Errorf("invalid key protector of volume %s: %v", volumeID, err)
+		return response, err
+	}
+
+	if err := s.hostAPI.UnlockVolume(volumeID, protector); err != nil {
+		klog.Errorf("failed UnlockVolume %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) GetBitLockerStatus(context context.Context, request *internal.GetBitLockerStatusRequest, version apiversion.Version) (*internal.GetBitLockerStatusResponse, error) {
+	klog.V(2).Infof("GetBitLockerStatus: Request: %+v", request)
+	response := &internal.GetBitLockerStatusResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	status, err := s.hostAPI.GetBitLockerStatus(volumeID)
+	if err != nil {
+		klog.Errorf("failed GetBitLockerStatus %v", err)
+		return response, err
+	}
+	response.VolumeStatus = status.VolumeStatus
+	response.ProtectionStatus = status.ProtectionStatus
+	response.LockStatus = status.LockStatus
+	response.EncryptionPercentage = uint32(status.EncryptionPercentage)
+	response.EncryptionMethod = status.EncryptionMethod
+	response.KeyProtectorTypes = status.KeyProtectorTypes
+	return response, nil
+}
@@ -0,0 +1,161 @@
+package bitlocker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/bitlocker"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/bitlocker/impl"
+)
+
+type fakeBitLockerAPI struct {
+	// protector and method are the arguments of the last call
+	protector *bitlocker.KeyProtector
+	method    bitlocker.EncryptionMethod
+}
+
+var _ bitlocker.API = &fakeBitLockerAPI{}
+
+func (bitlockerAPI *fakeBitLockerAPI) EnableBitLocker(volumeID string, protector bitlocker.KeyProtector, method bitlocker.EncryptionMethod, usedSpaceOnly bool) (*bitlocker.AddedKeyProtector, error) {
+	bitlockerAPI.protector = &protector
+	bitlockerAPI.method = method
+	added := &bitlocker.AddedKeyProtector{KeyProtectorId: "{protector}"}
+	if protector.Type == bitlocker.KeyProtectorTypeRecoveryPassword {
+		added.RecoveryPassword = protector.Secret
+		if added.RecoveryPassword == "" {
+			added.RecoveryPassword = "111111-222222-333333-444444-555555-666666-777777-888888"
+		}
+	}
+	return added, nil
+}
+
+func (bitlockerAPI *fakeBitLockerAPI) UnlockVolume(volumeID string, protector bitlocker.KeyProtector) error {
+	bitlockerAPI.protector = &protector
+	return nil
+}
+
+func (bitlockerAPI *fakeBitLockerAPI) GetBitLockerStatus(volumeID string) (*bitlocker.Status, error) {
+	return &bitlocker.Status{
+		VolumeStatus:         "EncryptionInProgress",
+		ProtectionStatus:     "Off",
+		LockStatus:           "Unlocked",
+		EncryptionPercentage: 42.5,
+		EncryptionMethod:     "XtsAes128",
+		KeyProtectorTypes:    []string{"Password"},
+	}, nil
+}
+
+func TestEnableBitLocker(t *testing.T) {
+	v1alpha1, err := apiversion.NewVersion("v1alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		name                   string
+		request                *internal.EnableBitLockerRequest
+		expectProtector        bitlocker.KeyProtector
+		expectMethod           bitlocker.EncryptionMethod
+		expectRecoveryPassword bool
+		isErrorExpected        bool
+	}{
+		{
+			name: "password",
+			request: &internal.EnableBitLockerRequest{
+				VolumeId:     "volumeID1",
+				KeyProtector: &internal.KeyProtector{Type: internal.KEY_PROTECTOR_TYPE_PASSWORD, Secret: "secret"},
+			},
+			expectProtector: bitlocker.KeyProtector{Type: bitlocker.KeyProtectorTypePassword, Secret: "secret"},
+			expectMethod:    bitlocker.EncryptionMethodXtsAes128,
+		},
+		{
+			name: "generated recovery password",
+			request: &internal.EnableBitLockerRequest{
+				VolumeId:         "volumeID1",
+				KeyProtector:     &internal.KeyProtector{Type: internal.KEY_PROTECTOR_TYPE_RECOVERY_PASSWORD},
+				EncryptionMethod: internal.ENCRYPTION_METHOD_XTS_AES_256,
+			},
+			expectProtector:        bitlocker.KeyProtector{Type: bitlocker.KeyProtectorTypeRecoveryPassword},
+			expectMethod:           bitlocker.EncryptionMethodXtsAes256,
+			expectRecoveryPassword: true,
+		},
+		{
+			name: "password without secret",
+			request: &internal.EnableBitLockerRequest{
+				VolumeId:     "volumeID1",
+				KeyProtector: &internal.KeyProtector{Type: internal.KEY_PROTECTOR_TYPE_PASSWORD},
+			},
+			isErrorExpected: true,
+		},
+		{
+			name:            "no key protector",
+			request:         &internal.EnableBitLockerRequest{VolumeId: "volumeID1"},
+			isErrorExpected: true,
+		},
+		{
+			name: "invalid encryption method",
+			request: &internal.EnableBitLockerRequest{
+				VolumeId:         "volumeID1",
+				KeyProtector:     &internal.KeyProtector{Type: internal.KEY_PROTECTOR_TYPE_PASSWORD, Secret: "secret"},
+				EncryptionMethod: internal.EncryptionMethod(10),
+			},
+			isErrorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		bitlockerAPI := &fakeBitLockerAPI{}
+		srv, err := NewServer(bitlockerAPI)
+		if err != nil {
+			t.Fatalf("BitLocker server could not be initialized: %v", err)
+		}
+
+		response, err := srv.EnableBitLocker(context.TODO(), tc.request, v1alpha1)
+		if tc.isErrorExpected {
+			if err == nil {
+				t.Errorf("Expected error")
+			}
+			if bitlockerAPI.protector != nil {
+				t.Errorf("Expected BitLocker not to be enabled")
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Error %v not expected", err)
+			continue
+		}
+		if bitlockerAPI.protector == nil || *bitlockerAPI.protector != tc.expectProtector {
+			t.Errorf("Expected key protector %+v, got %+v", tc.expectProtector, bitlockerAPI.protector)
+		}
+		if bitlockerAPI.method != tc.expectMethod {
+			t.Errorf("Expected encryption method %s, got %s", tc.expectMethod, bitlockerAPI.method)
+		}
+		if (response.RecoveryPassword != "") != tc.expectRecoveryPassword {
+			t.Errorf("Unexpected recovery password %q", response.RecoveryPassword)
+		}
+	}
+}
+
+func TestGetBitLockerStatus(t *testing.T) {
+	v1alpha1, err := apiversion.NewVersion("v1alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	srv, err := NewServer(&fakeBitLockerAPI{})
+	if err != nil {
+		t.Fatalf("BitLocker server could not be initialized: %v", err)
+	}
+
+	response, err := srv.GetBitLockerStatus(context.TODO(), &internal.GetBitLockerStatusRequest{VolumeId: "volumeID1"}, v1alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if response.VolumeStatus != "EncryptionInProgress" || response.EncryptionPercentage != 42 {
+		t.Errorf("Unexpected status %+v", response)
+	}
+	if len(response.KeyProtectorTypes) != 1 || response.KeyProtectorTypes[0] != "Password" {
+		t.Errorf("Unexpected key protector types %v", response.KeyProtectorTypes)
+	}
+}
@@ -5,24 +5,115 @@ type DiskLocation struct {
 	Bus     string
 	Target  string
 	LUNID   string
+	// Serial number of the disk
+	SerialNumber string
+}
+
+type DiskFilter struct {
+	// Bus type the disk is attached through, empty matches any bus type
+	BusType string
+
+	// Size range of the disk in bytes, 0 means no bound
+	MinSizeBytes int64
+	MaxSizeBytes int64
+
+	// Only match disks that haven't been initialized
+	UninitializedOnly bool
 }
 
 type ListDiskLocationsRequest struct {
+	// Optional filter of the disks to list
+	Filter *DiskFilter
+
+	// Maximum number of disks to return, 0 means no limit
+	MaxResults uint32
+
+	// Token to continue a previous listing
+	PageToken string
 }
 
 type ListDiskLocationsResponse struct {
 	// Map of disk device IDs and <adapter, bus, target, lun ID> associated with each disk device
 	DiskLocations map[uint32]*DiskLocation
+
+	// Token to get the next page of disks, empty if there are no more disks
+	NextPageToken string
 }
 
+type GetFreeDiskLocationsRequest struct {
+	// Number of LUNs of each target, 0 means the default
+	MaxLuns uint32
+}
+
+type FreeDiskLocation struct {
+	Adapter    string
+	Target     string
+	FreeLunIds []uint32
+}
+
+type GetFreeDiskLocationsResponse struct {
+	// Free LUNs of every adapter and target with disks attached
+	Locations []*FreeDiskLocation
+}
+
+type PartitionStyle uint32
+
+const (
+	PARTITION_STYLE_GPT = 0
+	PARTITION_STYLE_MBR = 1
+)
+
 type PartitionDiskRequest struct {
 	// Disk device ID of the disk to partition
 	DiskNumber uint32
+	// Partition style the disk is initialized with
+	PartitionStyle PartitionStyle
+	// Size in bytes of the partition, the largest free space if 0
+	SizeBytes int64
+	// Offset in bytes of the partition, picked by Windows if 0
+	OffsetBytes int64
+	// Bring the disk online and make it writable before it's initialized
+	BringOnline bool
+	// GPT partition type GUID of the partition, a basic data partition if empty
+	GptType string
 }
 
 type PartitionDiskResponse struct {
 }
 
+type SetPartitionTypeRequest struct {
+	DiskNumber      uint32
+	PartitionNumber uint32
+	GptType         string
+}
+
+type SetPartitionTypeResponse struct {
+}
+
+type DeletePartitionRequest struct {
+	DiskNumber      uint32
+	PartitionNumber uint32
+}
+
+type DeletePartitionResponse struct {
+}
+
+type WipeDiskRequest struct {
+	DiskNumber uint32
+	RemoveData bool
+	ZeroFill   bool
+}
+
+type WipeDiskResponse struct {
+}
+
+type ConvertDiskToGPTRequest struct {
+	DiskNumber uint32
+}
+
+type ConvertDiskToGPTResponse struct {
+}
+
 type RescanRequest struct {
 }
 
@@ -39,6 +130,14 @@ type GetDiskNumberByNameResponse struct {
 }
 
 type ListDiskIDsRequest struct {
+	// Optional filter of the disks to list
+	Filter *DiskFilter
+
+	// Maximum number of disks to return, 0 means no limit
+	MaxResults uint32
+
+	// Token to continue a previous listing
+	PageToken string
 }
 
 type DiskIDs struct {
@@ -50,6 +149,54 @@ type DiskIDs struct {
 type ListDiskIDsResponse struct {
 	// Map of disk device numbers and IDs associated with each disk device
 	DiskIDs map[uint32]*DiskIDs
+
+	// Token to get the next page of disks, empty if there are no more disks
+	NextPageToken string
+}
+
+type GetDiskNumberByLocationRequest struct {
+	// Location of the disk, the empty fields other than LunId match any value
+	Adapter string
+	Bus     string
+	Target  string
+	LunId   string
+}
+
+type GetDiskNumberByLocationResponse struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+}
+
+type ListDisksRequest struct {
+	// Optional filter of the disks to list
+	Filter *DiskFilter
+
+	// Maximum number of disks to return, 0 means no limit
+	MaxResults uint32
+
+	// Token to continue a previous listing
+	PageToken string
+}
+
+type DiskInfo struct {
+	DiskNumber     uint32
+	FriendlyName   string
+	SerialNumber   string
+	SizeBytes      int64
+	BusType        string
+	PartitionStyle string
+	IsOffline      bool
+	IsReadOnly     bool
+	// The disk has the boot or the system partition
+	IsOsDisk bool
+}
+
+type ListDisksResponse struct {
+	// Disks sorted by disk number
+	Disks []*DiskInfo
+
+	// Token to get the next page of disks, empty if there are no more disks
+	NextPageToken string
 }
 
 type GetDiskStatsRequest struct {
@@ -57,7 +204,32 @@ type GetDiskStatsRequest struct {
 }
 
 type GetDiskStatsResponse struct {
-	TotalBytes int64
+	TotalBytes   int64
+	SerialNumber string
+	// Sector sizes in bytes, 0 if the disk doesn't report them
+	LogicalSectorSize  uint32
+	PhysicalSectorSize uint32
+}
+
+type GetDiskReliabilityCountersRequest struct {
+	DiskNumber uint32
+}
+
+// GetDiskReliabilityCountersResponse has the counters of the disk, -1 if it doesn't report them
+type GetDiskReliabilityCountersResponse struct {
+	TemperatureCelsius     int64
+	MaxTemperatureCelsius  int64
+	WearPercentage         int64
+	ReadErrorsTotal        int64
+	ReadErrorsCorrected    int64
+	ReadErrorsUncorrected  int64
+	WriteErrorsTotal       int64
+	WriteErrorsCorrected   int64
+	WriteErrorsUncorrected int64
+	PowerOnHours           int64
+	ReadLatencyMaxMs       int64
+	WriteLatencyMaxMs      int64
+	FlushLatencyMaxMs      int64
 }
 
 type SetDiskStateRequest struct {
@@ -66,6 +238,10 @@ type SetDiskStateRequest struct {
 
 	// Online state to set for the disk. true for online, false for offline
 	IsOnline bool
+
+	// Token that identifies the owner of a shared disk, required to change
+	// the state of shared (clustered) disks
+	ClaimToken string
 }
 
 type SetDiskStateResponse struct {
@@ -111,3 +287,146 @@ type GetAttachStateResponse struct {
 	// Online state of the disk. true for online, false for offline
 	IsOnline bool
 }
+
+type WaitForDiskSizeChangeRequest struct {
+	DiskNumber        uint32
+	ExpectedSizeBytes int64
+	TimeoutNanos      int64
+}
+
+type WaitForDiskSizeChangeResponse struct {
+	TotalBytes int64
+}
+
+type SanPolicy uint32
+
+const (
+	SAN_POLICY_ONLINE_ALL       = 0
+	SAN_POLICY_OFFLINE_SHARED   = 1
+	SAN_POLICY_OFFLINE_ALL      = 2
+	SAN_POLICY_OFFLINE_INTERNAL = 3
+)
+
+type GetSanPolicyRequest struct {
+}
+
+type GetSanPolicyResponse struct {
+	// SAN policy of the node
+	SanPolicy SanPolicy
+}
+
+type SetSanPolicyRequest struct {
+	// SAN policy to set
+	SanPolicy SanPolicy
+}
+
+type SetSanPolicyResponse struct {
+}
+
+type SetDiskReadOnlyRequest struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+	// Make the disk read-only if true, writable if false
+	ReadOnly bool
+}
+
+type SetDiskReadOnlyResponse struct {
+}
+
+type GetDiskReadOnlyRequest struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+}
+
+type GetDiskReadOnlyResponse struct {
+	// The disk is read-only
+	ReadOnly bool
+}
+
+type GetDiskNumberBySerialNumberRequest struct {
+	// Serial number of the disk
+	SerialNumber string
+}
+
+type GetDiskNumberBySerialNumberResponse struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+}
+
+type ListDiskPage83IDsRequest struct {
+	// Optional filter of the disks to list
+	Filter *DiskFilter
+
+	// Maximum number of disks to return, 0 means no limit
+	MaxResults uint32
+
+	// Token to continue a previous listing
+	PageToken string
+}
+
+type Page83ID struct {
+	Type        string
+	Association string
+	Value       string
+}
+
+type DiskPage83IDs struct {
+	Ids []*Page83ID
+}
+
+type ListDiskPage83IDsResponse struct {
+	// Map of disk device numbers and identifiers of each disk
+	DiskPage83IDs map[uint32]*DiskPage83IDs
+
+	// Token to get the next page of disks, empty if there are no more disks
+	NextPageToken string
+}
+
+type GetDiskNumberByPage83IDRequest struct {
+	Page83Id string
+}
+
+type GetDiskNumberByPage83IDResponse struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+}
+
+type WaitForDiskRequest struct {
+	SerialNumber string
+	Page83Id     string
+	Adapter      string
+	Bus          string
+	Target       string
+	LunId        string
+	TimeoutNanos int64
+}
+
+type WaitForDiskResponse struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+}
+
+type DiskIdentity struct {
+	SerialNumber string
+	Page83Id     string
+	Signature    uint32
+	Guid         string
+}
+
+type GetDiskIdentityRequest struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+}
+
+type GetDiskIdentityResponse struct {
+	Identity *DiskIdentity
+}
+
+type ResolveDiskIdentityRequest struct {
+	Identity *DiskIdentity
+}
+
+type ResolveDiskIdentityResponse struct {
+	// Disk device number of the disk
+	DiskNumber uint32
+}
@@ -15,15 +15,34 @@ type VersionedAPI interface {
 
 // All the functions this group's server needs to define.
 type ServerInterface interface {
+	ConvertDiskToGPT(context.Context, *ConvertDiskToGPTRequest, apiversion.Version) (*ConvertDiskToGPTResponse, error)
+	DeletePartition(context.Context, *DeletePartitionRequest, apiversion.Version) (*DeletePartitionResponse, error)
 	DiskStats(context.Context, *DiskStatsRequest, apiversion.Version) (*DiskStatsResponse, error)
 	GetAttachState(context.Context, *GetAttachStateRequest, apiversion.Version) (*GetAttachStateResponse, error)
+	GetDiskIdentity(context.Context, *GetDiskIdentityRequest, apiversion.Version) (*GetDiskIdentityResponse, error)
+	GetDiskNumberByLocation(context.Context, *GetDiskNumberByLocationRequest, apiversion.Version) (*GetDiskNumberByLocationResponse, error)
 	GetDiskNumberByName(context.Context, *GetDiskNumberByNameRequest, apiversion.Version) (*GetDiskNumberByNameResponse, error)
+	GetDiskNumberByPage83ID(context.Context, *GetDiskNumberByPage83IDRequest, apiversion.Version) (*GetDiskNumberByPage83IDResponse, error)
+	GetDiskNumberBySerialNumber(context.Context, *GetDiskNumberBySerialNumberRequest, apiversion.Version) (*GetDiskNumberBySerialNumberResponse, error)
+	GetDiskReadOnly(context.Context, *GetDiskReadOnlyRequest, apiversion.Version) (*GetDiskReadOnlyResponse, error)
+	GetDiskReliabilityCounters(context.Context, *GetDiskReliabilityCountersRequest, apiversion.Version) (*GetDiskReliabilityCountersResponse, error)
 	GetDiskState(context.Context, *GetDiskStateRequest, apiversion.Version) (*GetDiskStateResponse, error)
 	GetDiskStats(context.Context, *GetDiskStatsRequest, apiversion.Version) (*GetDiskStatsResponse, error)
+	GetFreeDiskLocations(context.Context, *GetFreeDiskLocationsRequest, apiversion.Version) (*GetFreeDiskLocationsResponse, error)
+	GetSanPolicy(context.Context, *GetSanPolicyRequest, apiversion.Version) (*GetSanPolicyResponse, error)
 	ListDiskIDs(context.Context, *ListDiskIDsRequest, apiversion.Version) (*ListDiskIDsResponse, error)
 	ListDiskLocations(context.Context, *ListDiskLocationsRequest, apiversion.Version) (*ListDiskLocationsResponse, error)
+	ListDiskPage83IDs(context.Context, *ListDiskPage83IDsRequest, apiversion.Version) (*ListDiskPage83IDsResponse, error)
+	ListDisks(context.Context, *ListDisksRequest, apiversion.Version) (*ListDisksResponse, error)
 	PartitionDisk(context.Context, *PartitionDiskRequest, apiversion.Version) (*PartitionDiskResponse, error)
 	Rescan(context.Context, *RescanRequest, apiversion.Version) (*RescanResponse, error)
+	ResolveDiskIdentity(context.Context, *ResolveDiskIdentityRequest, apiversion.Version) (*ResolveDiskIdentityResponse, error)
 	SetAttachState(context.Context, *SetAttachStateRequest, apiversion.Version) (*SetAttachStateResponse, error)
+	SetDiskReadOnly(context.Context, *SetDiskReadOnlyRequest, apiversion.Version) (*SetDiskReadOnlyResponse, error)
 	SetDiskState(context.Context, *SetDiskStateRequest, apiversion.Version) (*SetDiskStateResponse, error)
+	SetPartitionType(context.Context, *SetPartitionTypeRequest, apiversion.Version) (*SetPartitionTypeResponse, error)
+	SetSanPolicy(context.Context, *SetSanPolicyRequest, apiversion.Version) (*SetSanPolicyResponse, error)
+	WaitForDisk(context.Context, *WaitForDiskRequest, apiversion.Version) (*WaitForDiskResponse, error)
+	WaitForDiskSizeChange(context.Context, *WaitForDiskSizeChangeRequest, apiversion.Version) (*WaitForDiskSizeChangeResponse, error)
+	WipeDisk(context.Context, *WipeDiskRequest, apiversion.Version) (*WipeDiskResponse, error)
 }
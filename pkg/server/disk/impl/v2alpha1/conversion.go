@@ -0,0 +1,285 @@
+package v2alpha1
+
+import (
+	"github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/disk/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+)
+
+// Add manual conversion functions here to override automatic conversion functions
+
+func Convert_impl_ListDiskIDsResponse_To_v2alpha1_ListDiskIDsResponse(in *impl.ListDiskIDsResponse, out *v2alpha1.ListDiskIDsResponse) error {
+	if in.DiskIDs != nil {
+		in, out := &in.DiskIDs, &out.DiskIDs
+		*out = make(map[uint32]*v2alpha1.DiskIDs, len(*in))
+		for key, val := range *in {
+
+			// This function is almost generated correctly, it has an issue in the type of arguments sent
+			// e.g.  if err := Convert_impl_DiskIDs_To_v2alpha1_DiskIDs(*&val, *newVal); err != nil {
+			newVal := new(v2alpha1.DiskIDs)
+			if err := Convert_impl_DiskIDs_To_v2alpha1_DiskIDs(val, newVal); err != nil {
+				return err
+			}
+			(*out)[key] = newVal
+
+		}
+	} else {
+		out.DiskIDs = nil
+	}
+	out.NextPageToken = in.NextPageToken
+	return nil
+}
+
+func Convert_impl_ListDiskPage83IDsResponse_To_v2alpha1_ListDiskPage83IDsResponse(in *impl.ListDiskPage83IDsResponse, out *v2alpha1.ListDiskPage83IDsResponse) error {
+	if in.DiskPage83IDs != nil {
+		in, out := &in.DiskPage83IDs, &out.DiskPage83IDs
+		*out = make(map[uint32]*v2alpha1.DiskPage83IDs, len(*in))
+		for key, val := range *in {
+			// same issue as the conversion of ListDiskIDsResponse
+			newVal := new(v2alpha1.DiskPage83IDs)
+			if err := Convert_impl_DiskPage83IDs_To_v2alpha1_DiskPage83IDs(val, newVal); err != nil {
+				return err
+			}
+			(*out)[key] = newVal
+		}
+	} else {
+		out.DiskPage83IDs = nil
+	}
+	out.NextPageToken = in.NextPageToken
+	return nil
+}
+
+func Convert_impl_ListDiskLocationsResponse_To_v2alpha1_ListDiskLocationsResponse(in *impl.ListDiskLocationsResponse, out *v2alpha1.ListDiskLocationsResponse) error {
+	if in.DiskLocations != nil {
+		in, out := &in.DiskLocations, &out.DiskLocations
+		*out = make(map[uint32]*v2alpha1.DiskLocation, len(*in))
+		for key, val := range *in {
+
+			// This function is almost generated correctly, it has an issue in the type of arguments sent
+			// e.g.  if err := Convert_impl_DiskLocation_To_v2alpha1_DiskLocation(*&val, *newVal); err != nil {
+			newVal := new(v2alpha1.DiskLocation)
+			if err := Convert_impl_DiskLocation_To_v2alpha1_DiskLocation(val, newVal); err != nil {
+				return err
+			}
+			(*out)[key] = newVal
+		}
+	} else {
+		out.DiskLocations = nil
+	}
+	out.NextPageToken = in.NextPageToken
+	return nil
+}
+
+func Convert_impl_GetFreeDiskLocationsResponse_To_v2alpha1_GetFreeDiskLocationsResponse(in *impl.GetFreeDiskLocationsResponse, out *v2alpha1.GetFreeDiskLocationsResponse) error {
+	if in.Locations != nil {
+		out.Locations = make([]*v2alpha1.FreeDiskLocation, len(in.Locations))
+		for i, val := range in.Locations {
+			newVal := new(v2alpha1.FreeDiskLocation)
+			if err := Convert_impl_FreeDiskLocation_To_v2alpha1_FreeDiskLocation(val, newVal); err != nil {
+				return err
+			}
+			out.Locations[i] = newVal
+		}
+	} else {
+		out.Locations = nil
+	}
+	return nil
+}
+
+func Convert_v2alpha1_GetFreeDiskLocationsResponse_To_impl_GetFreeDiskLocationsResponse(in *v2alpha1.GetFreeDiskLocationsResponse, out *impl.GetFreeDiskLocationsResponse) error {
+	if in.Locations != nil {
+		out.Locations = make([]*impl.FreeDiskLocation, len(in.Locations))
+		for i, val := range in.Locations {
+			newVal := new(impl.FreeDiskLocation)
+			if err := Convert_v2alpha1_FreeDiskLocation_To_impl_FreeDiskLocation(val, newVal); err != nil {
+				return err
+			}
+			out.Locations[i] = newVal
+		}
+	} else {
+		out.Locations = nil
+	}
+	return nil
+}
+
+func Convert_impl_ListDisksResponse_To_v2alpha1_ListDisksResponse(in *impl.ListDisksResponse, out *v2alpha1.ListDisksResponse) error {
+	if in.Disks != nil {
+		out.Disks = make([]*v2alpha1.DiskInfo, len(in.Disks))
+		for i, val := range in.Disks {
+			newVal := new(v2alpha1.DiskInfo)
+			if err := Convert_impl_DiskInfo_To_v2alpha1_DiskInfo(val, newVal); err != nil {
+				return err
+			}
+			out.Disks[i] = newVal
+		}
+	} else {
+		out.Disks = nil
+	}
+	out.NextPageToken = in.NextPageToken
+	return nil
+}
+
+func Convert_v2alpha1_ListDisksResponse_To_impl_ListDisksResponse(in *v2alpha1.ListDisksResponse, out *impl.ListDisksResponse) error {
+	if in.Disks != nil {
+		out.Disks = make([]*impl.DiskInfo, len(in.Disks))
+		for i, val := range in.Disks {
+			newVal := new(impl.DiskInfo)
+			if err := Convert_v2alpha1_DiskInfo_To_impl_DiskInfo(val, newVal); err != nil {
+				return err
+			}
+			out.Disks[i] = newVal
+		}
+	} else {
+		out.Disks = nil
+	}
+	out.NextPageToken = in.NextPageToken
+	return nil
+}
+
+// The sizes in bytes of v2alpha1 are unsigned, the conversions below reject the
+// sizes that don't fit in the int64 sizes of the internal types instead of
+// wrapping them around.
+
+func Convert_v2alpha1_DiskFilter_To_impl_DiskFilter(in *v2alpha1.DiskFilter, out *impl.DiskFilter) error {
+	var err error
+	out.BusType = in.BusType
+	if out.MinSizeBytes, err = utils.ByteSizeToInt64("DiskFilter.MinSizeBytes", in.MinSizeBytes); err != nil {
+		return err
+	}
+	if out.MaxSizeBytes, err = utils.ByteSizeToInt64("DiskFilter.MaxSizeBytes", in.MaxSizeBytes); err != nil {
+		return err
+	}
+	out.UninitializedOnly = in.UninitializedOnly
+	return nil
+}
+
+func Convert_impl_DiskFilter_To_v2alpha1_DiskFilter(in *impl.DiskFilter, out *v2alpha1.DiskFilter) error {
+	var err error
+	out.BusType = in.BusType
+	if out.MinSizeBytes, err = utils.ByteSizeFromInt64("DiskFilter.MinSizeBytes", in.MinSizeBytes); err != nil {
+		return err
+	}
+	if out.MaxSizeBytes, err = utils.ByteSizeFromInt64("DiskFilter.MaxSizeBytes", in.MaxSizeBytes); err != nil {
+		return err
+	}
+	out.UninitializedOnly = in.UninitializedOnly
+	return nil
+}
+
+func Convert_v2alpha1_PartitionDiskRequest_To_impl_PartitionDiskRequest(in *v2alpha1.PartitionDiskRequest, out *impl.PartitionDiskRequest) error {
+	var err error
+	out.DiskNumber = in.DiskNumber
+	out.PartitionStyle = impl.PartitionStyle(in.PartitionStyle)
+	if out.SizeBytes, err = utils.ByteSizeToInt64("PartitionDiskRequest.SizeBytes", in.SizeBytes); err != nil {
+		return err
+	}
+	if out.OffsetBytes, err = utils.ByteSizeToInt64("PartitionDiskRequest.OffsetBytes", in.OffsetBytes); err != nil {
+		return err
+	}
+	out.BringOnline = in.BringOnline
+	return nil
+}
+
+func Convert_impl_PartitionDiskRequest_To_v2alpha1_PartitionDiskRequest(in *impl.PartitionDiskRequest, out *v2alpha1.PartitionDiskRequest) error {
+	var err error
+	out.DiskNumber = in.DiskNumber
+	out.PartitionStyle = v2alpha1.PartitionStyle(in.PartitionStyle)
+	if out.SizeBytes, err = utils.ByteSizeFromInt64("PartitionDiskRequest.SizeBytes", in.SizeBytes); err != nil {
+		return err
+	}
+	if out.OffsetBytes, err = utils.ByteSizeFromInt64("PartitionDiskRequest.OffsetBytes", in.OffsetBytes); err != nil {
+		return err
+	}
+	out.BringOnline = in.BringOnline
+	return nil
+}
+
+func Convert_v2alpha1_GetDiskStatsResponse_To_impl_GetDiskStatsResponse(in *v2alpha1.GetDiskStatsResponse, out *impl.GetDiskStatsResponse) error {
+	var err error
+	if out.TotalBytes, err = utils.ByteSizeToInt64("GetDiskStatsResponse.TotalBytes", in.TotalBytes); err != nil {
+		return err
+	}
+	out.SerialNumber = in.SerialNumber
+	out.LogicalSectorSize = in.LogicalSectorSize
+	out.PhysicalSectorSize = in.PhysicalSectorSize
+	return nil
+}
+
+func Convert_impl_GetDiskStatsResponse_To_v2alpha1_GetDiskStatsResponse(in *impl.GetDiskStatsResponse, out *v2alpha1.GetDiskStatsResponse) error {
+	var err error
+	if out.TotalBytes, err = utils.ByteSizeFromInt64("GetDiskStatsResponse.TotalBytes", in.TotalBytes); err != nil {
+		return err
+	}
+	out.SerialNumber = in.SerialNumber
+	out.LogicalSectorSize = in.LogicalSectorSize
+	out.PhysicalSectorSize = in.PhysicalSectorSize
+	return nil
+}
+
+func Convert_v2alpha1_WaitForDiskSizeChangeRequest_To_impl_WaitForDiskSizeChangeRequest(in *v2alpha1.WaitForDiskSizeChangeRequest, out *impl.WaitForDiskSizeChangeRequest) error {
+	var err error
+	out.DiskNumber = in.DiskNumber
+	if out.ExpectedSizeBytes, err = utils.ByteSizeToInt64("WaitForDiskSizeChangeRequest.ExpectedSizeBytes", in.ExpectedSizeBytes); err != nil {
+		return err
+	}
+	out.TimeoutNanos = in.TimeoutNanos
+	return nil
+}
+
+func Convert_impl_WaitForDiskSizeChangeRequest_To_v2alpha1_WaitForDiskSizeChangeRequest(in *impl.WaitForDiskSizeChangeRequest, out *v2alpha1.WaitForDiskSizeChangeRequest) error {
+	var err error
+	out.DiskNumber = in.DiskNumber
+	if out.ExpectedSizeBytes, err = utils.ByteSizeFromInt64("WaitForDiskSizeChangeRequest.ExpectedSizeBytes", in.ExpectedSizeBytes); err != nil {
+		return err
+	}
+	out.TimeoutNanos = in.TimeoutNanos
+	return nil
+}
+
+func Convert_v2alpha1_WaitForDiskSizeChangeResponse_To_impl_WaitForDiskSizeChangeResponse(in *v2alpha1.WaitForDiskSizeChangeResponse, out *impl.WaitForDiskSizeChangeResponse) error {
+	var err error
+	if out.TotalBytes, err = utils.ByteSizeToInt64("WaitForDiskSizeChangeResponse.TotalBytes", in.TotalBytes); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_impl_WaitForDiskSizeChangeResponse_To_v2alpha1_WaitForDiskSizeChangeResponse(in *impl.WaitForDiskSizeChangeResponse, out *v2alpha1.WaitForDiskSizeChangeResponse) error {
+	var err error
+	if out.TotalBytes, err = utils.ByteSizeFromInt64("WaitForDiskSizeChangeResponse.TotalBytes", in.TotalBytes); err != nil {
+		return err
+	}
+	return nil
+}
+
+func Convert_v2alpha1_DiskInfo_To_impl_DiskInfo(in *v2alpha1.DiskInfo, out *impl.DiskInfo) error {
+	var err error
+	out.DiskNumber = in.DiskNumber
+	out.FriendlyName = in.FriendlyName
+	out.SerialNumber = in.SerialNumber
+	if out.SizeBytes, err = utils.ByteSizeToInt64("DiskInfo.SizeBytes", in.SizeBytes); err != nil {
+		return err
+	}
+	out.BusType = in.BusType
+	out.PartitionStyle = in.PartitionStyle
+	out.IsOffline = in.IsOffline
+	out.IsReadOnly = in.IsReadOnly
+	out.IsOsDisk = in.IsOsDisk
+	return nil
+}
+
+func Convert_impl_DiskInfo_To_v2alpha1_DiskInfo(in *impl.DiskInfo, out *v2alpha1.DiskInfo) error {
+	var err error
+	out.DiskNumber = in.DiskNumber
+	out.FriendlyName = in.FriendlyName
+	out.SerialNumber = in.SerialNumber
+	if out.SizeBytes, err = utils.ByteSizeFromInt64("DiskInfo.SizeBytes", in.SizeBytes); err != nil {
+		return err
+	}
+	out.BusType = in.BusType
+	out.PartitionStyle = in.PartitionStyle
+	out.IsOffline = in.IsOffline
+	out.IsReadOnly = in.IsReadOnly
+	out.IsOsDisk = in.IsOsDisk
+	return nil
+}
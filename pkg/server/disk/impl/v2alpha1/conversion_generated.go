@@ -0,0 +1,1327 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v2alpha1
+
+import (
+	unsafe "unsafe"
+
+	v2alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/disk/impl"
+)
+
+func autoConvert_v2alpha1_ConvertDiskToGPTRequest_To_impl_ConvertDiskToGPTRequest(in *v2alpha1.ConvertDiskToGPTRequest, out *impl.ConvertDiskToGPTRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_ConvertDiskToGPTRequest_To_impl_ConvertDiskToGPTRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ConvertDiskToGPTRequest_To_impl_ConvertDiskToGPTRequest(in *v2alpha1.ConvertDiskToGPTRequest, out *impl.ConvertDiskToGPTRequest) error {
+	return autoConvert_v2alpha1_ConvertDiskToGPTRequest_To_impl_ConvertDiskToGPTRequest(in, out)
+}
+
+func autoConvert_impl_ConvertDiskToGPTRequest_To_v2alpha1_ConvertDiskToGPTRequest(in *impl.ConvertDiskToGPTRequest, out *v2alpha1.ConvertDiskToGPTRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_ConvertDiskToGPTRequest_To_v2alpha1_ConvertDiskToGPTRequest is an autogenerated conversion function.
+func Convert_impl_ConvertDiskToGPTRequest_To_v2alpha1_ConvertDiskToGPTRequest(in *impl.ConvertDiskToGPTRequest, out *v2alpha1.ConvertDiskToGPTRequest) error {
+	return autoConvert_impl_ConvertDiskToGPTRequest_To_v2alpha1_ConvertDiskToGPTRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ConvertDiskToGPTResponse_To_impl_ConvertDiskToGPTResponse(in *v2alpha1.ConvertDiskToGPTResponse, out *impl.ConvertDiskToGPTResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_ConvertDiskToGPTResponse_To_impl_ConvertDiskToGPTResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ConvertDiskToGPTResponse_To_impl_ConvertDiskToGPTResponse(in *v2alpha1.ConvertDiskToGPTResponse, out *impl.ConvertDiskToGPTResponse) error {
+	return autoConvert_v2alpha1_ConvertDiskToGPTResponse_To_impl_ConvertDiskToGPTResponse(in, out)
+}
+
+func autoConvert_impl_ConvertDiskToGPTResponse_To_v2alpha1_ConvertDiskToGPTResponse(in *impl.ConvertDiskToGPTResponse, out *v2alpha1.ConvertDiskToGPTResponse) error {
+	return nil
+}
+
+// Convert_impl_ConvertDiskToGPTResponse_To_v2alpha1_ConvertDiskToGPTResponse is an autogenerated conversion function.
+func Convert_impl_ConvertDiskToGPTResponse_To_v2alpha1_ConvertDiskToGPTResponse(in *impl.ConvertDiskToGPTResponse, out *v2alpha1.ConvertDiskToGPTResponse) error {
+	return autoConvert_impl_ConvertDiskToGPTResponse_To_v2alpha1_ConvertDiskToGPTResponse(in, out)
+}
+
+func autoConvert_v2alpha1_DeletePartitionRequest_To_impl_DeletePartitionRequest(in *v2alpha1.DeletePartitionRequest, out *impl.DeletePartitionRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	return nil
+}
+
+// Convert_v2alpha1_DeletePartitionRequest_To_impl_DeletePartitionRequest is an autogenerated conversion function.
+func Convert_v2alpha1_DeletePartitionRequest_To_impl_DeletePartitionRequest(in *v2alpha1.DeletePartitionRequest, out *impl.DeletePartitionRequest) error {
+	return autoConvert_v2alpha1_DeletePartitionRequest_To_impl_DeletePartitionRequest(in, out)
+}
+
+func autoConvert_impl_DeletePartitionRequest_To_v2alpha1_DeletePartitionRequest(in *impl.DeletePartitionRequest, out *v2alpha1.DeletePartitionRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	return nil
+}
+
+// Convert_impl_DeletePartitionRequest_To_v2alpha1_DeletePartitionRequest is an autogenerated conversion function.
+func Convert_impl_DeletePartitionRequest_To_v2alpha1_DeletePartitionRequest(in *impl.DeletePartitionRequest, out *v2alpha1.DeletePartitionRequest) error {
+	return autoConvert_impl_DeletePartitionRequest_To_v2alpha1_DeletePartitionRequest(in, out)
+}
+
+func autoConvert_v2alpha1_DeletePartitionResponse_To_impl_DeletePartitionResponse(in *v2alpha1.DeletePartitionResponse, out *impl.DeletePartitionResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_DeletePartitionResponse_To_impl_DeletePartitionResponse is an autogenerated conversion function.
+func Convert_v2alpha1_DeletePartitionResponse_To_impl_DeletePartitionResponse(in *v2alpha1.DeletePartitionResponse, out *impl.DeletePartitionResponse) error {
+	return autoConvert_v2alpha1_DeletePartitionResponse_To_impl_DeletePartitionResponse(in, out)
+}
+
+func autoConvert_impl_DeletePartitionResponse_To_v2alpha1_DeletePartitionResponse(in *impl.DeletePartitionResponse, out *v2alpha1.DeletePartitionResponse) error {
+	return nil
+}
+
+// Convert_impl_DeletePartitionResponse_To_v2alpha1_DeletePartitionResponse is an autogenerated conversion function.
+func Convert_impl_DeletePartitionResponse_To_v2alpha1_DeletePartitionResponse(in *impl.DeletePartitionResponse, out *v2alpha1.DeletePartitionResponse) error {
+	return autoConvert_impl_DeletePartitionResponse_To_v2alpha1_DeletePartitionResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_DiskFilter_To_impl_DiskFilter(in *v2alpha1.DiskFilter, out *impl.DiskFilter) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_DiskFilter_To_v2alpha1_DiskFilter(in *impl.DiskFilter, out *v2alpha1.DiskFilter) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_DiskIDs_To_impl_DiskIDs(in *v2alpha1.DiskIDs, out *impl.DiskIDs) error {
+	out.Page83 = in.Page83
+	out.SerialNumber = in.SerialNumber
+	return nil
+}
+
+// Convert_v2alpha1_DiskIDs_To_impl_DiskIDs is an autogenerated conversion function.
+func Convert_v2alpha1_DiskIDs_To_impl_DiskIDs(in *v2alpha1.DiskIDs, out *impl.DiskIDs) error {
+	return autoConvert_v2alpha1_DiskIDs_To_impl_DiskIDs(in, out)
+}
+
+func autoConvert_impl_DiskIDs_To_v2alpha1_DiskIDs(in *impl.DiskIDs, out *v2alpha1.DiskIDs) error {
+	out.Page83 = in.Page83
+	out.SerialNumber = in.SerialNumber
+	return nil
+}
+
+// Convert_impl_DiskIDs_To_v2alpha1_DiskIDs is an autogenerated conversion function.
+func Convert_impl_DiskIDs_To_v2alpha1_DiskIDs(in *impl.DiskIDs, out *v2alpha1.DiskIDs) error {
+	return autoConvert_impl_DiskIDs_To_v2alpha1_DiskIDs(in, out)
+}
+
+func autoConvert_v2alpha1_DiskIdentity_To_impl_DiskIdentity(in *v2alpha1.DiskIdentity, out *impl.DiskIdentity) error {
+	out.SerialNumber = in.SerialNumber
+	out.Page83Id = in.Page83Id
+	out.Signature = in.Signature
+	out.Guid = in.Guid
+	return nil
+}
+
+// Convert_v2alpha1_DiskIdentity_To_impl_DiskIdentity is an autogenerated conversion function.
+func Convert_v2alpha1_DiskIdentity_To_impl_DiskIdentity(in *v2alpha1.DiskIdentity, out *impl.DiskIdentity) error {
+	return autoConvert_v2alpha1_DiskIdentity_To_impl_DiskIdentity(in, out)
+}
+
+func autoConvert_impl_DiskIdentity_To_v2alpha1_DiskIdentity(in *impl.DiskIdentity, out *v2alpha1.DiskIdentity) error {
+	out.SerialNumber = in.SerialNumber
+	out.Page83Id = in.Page83Id
+	out.Signature = in.Signature
+	out.Guid = in.Guid
+	return nil
+}
+
+// Convert_impl_DiskIdentity_To_v2alpha1_DiskIdentity is an autogenerated conversion function.
+func Convert_impl_DiskIdentity_To_v2alpha1_DiskIdentity(in *impl.DiskIdentity, out *v2alpha1.DiskIdentity) error {
+	return autoConvert_impl_DiskIdentity_To_v2alpha1_DiskIdentity(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_DiskInfo_To_impl_DiskInfo(in *v2alpha1.DiskInfo, out *impl.DiskInfo) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_DiskInfo_To_v2alpha1_DiskInfo(in *impl.DiskInfo, out *v2alpha1.DiskInfo) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_DiskLocation_To_impl_DiskLocation(in *v2alpha1.DiskLocation, out *impl.DiskLocation) error {
+	out.Adapter = in.Adapter
+	out.Bus = in.Bus
+	out.Target = in.Target
+	out.LUNID = in.LUNID
+	out.SerialNumber = in.SerialNumber
+	return nil
+}
+
+// Convert_v2alpha1_DiskLocation_To_impl_DiskLocation is an autogenerated conversion function.
+func Convert_v2alpha1_DiskLocation_To_impl_DiskLocation(in *v2alpha1.DiskLocation, out *impl.DiskLocation) error {
+	return autoConvert_v2alpha1_DiskLocation_To_impl_DiskLocation(in, out)
+}
+
+func autoConvert_impl_DiskLocation_To_v2alpha1_DiskLocation(in *impl.DiskLocation, out *v2alpha1.DiskLocation) error {
+	out.Adapter = in.Adapter
+	out.Bus = in.Bus
+	out.Target = in.Target
+	out.LUNID = in.LUNID
+	out.SerialNumber = in.SerialNumber
+	return nil
+}
+
+// Convert_impl_DiskLocation_To_v2alpha1_DiskLocation is an autogenerated conversion function.
+func Convert_impl_DiskLocation_To_v2alpha1_DiskLocation(in *impl.DiskLocation, out *v2alpha1.DiskLocation) error {
+	return autoConvert_impl_DiskLocation_To_v2alpha1_DiskLocation(in, out)
+}
+
+func autoConvert_v2alpha1_DiskPage83IDs_To_impl_DiskPage83IDs(in *v2alpha1.DiskPage83IDs, out *impl.DiskPage83IDs) error {
+	if in.Ids != nil {
+		in, out := &in.Ids, &out.Ids
+		*out = make([]*impl.Page83ID, len(*in))
+		for i := range *in {
+			if err := Convert_v2alpha1_Page83ID_To_impl_Page83ID(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ids = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_DiskPage83IDs_To_impl_DiskPage83IDs is an autogenerated conversion function.
+func Convert_v2alpha1_DiskPage83IDs_To_impl_DiskPage83IDs(in *v2alpha1.DiskPage83IDs, out *impl.DiskPage83IDs) error {
+	return autoConvert_v2alpha1_DiskPage83IDs_To_impl_DiskPage83IDs(in, out)
+}
+
+func autoConvert_impl_DiskPage83IDs_To_v2alpha1_DiskPage83IDs(in *impl.DiskPage83IDs, out *v2alpha1.DiskPage83IDs) error {
+	if in.Ids != nil {
+		in, out := &in.Ids, &out.Ids
+		*out = make([]*v2alpha1.Page83ID, len(*in))
+		for i := range *in {
+			if err := Convert_impl_Page83ID_To_v2alpha1_Page83ID(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Ids = nil
+	}
+	return nil
+}
+
+// Convert_impl_DiskPage83IDs_To_v2alpha1_DiskPage83IDs is an autogenerated conversion function.
+func Convert_impl_DiskPage83IDs_To_v2alpha1_DiskPage83IDs(in *impl.DiskPage83IDs, out *v2alpha1.DiskPage83IDs) error {
+	return autoConvert_impl_DiskPage83IDs_To_v2alpha1_DiskPage83IDs(in, out)
+}
+
+func autoConvert_v2alpha1_FreeDiskLocation_To_impl_FreeDiskLocation(in *v2alpha1.FreeDiskLocation, out *impl.FreeDiskLocation) error {
+	out.Adapter = in.Adapter
+	out.Target = in.Target
+	out.FreeLunIds = *(*[]uint32)(unsafe.Pointer(&in.FreeLunIds))
+	return nil
+}
+
+// Convert_v2alpha1_FreeDiskLocation_To_impl_FreeDiskLocation is an autogenerated conversion function.
+func Convert_v2alpha1_FreeDiskLocation_To_impl_FreeDiskLocation(in *v2alpha1.FreeDiskLocation, out *impl.FreeDiskLocation) error {
+	return autoConvert_v2alpha1_FreeDiskLocation_To_impl_FreeDiskLocation(in, out)
+}
+
+func autoConvert_impl_FreeDiskLocation_To_v2alpha1_FreeDiskLocation(in *impl.FreeDiskLocation, out *v2alpha1.FreeDiskLocation) error {
+	out.Adapter = in.Adapter
+	out.Target = in.Target
+	out.FreeLunIds = *(*[]uint32)(unsafe.Pointer(&in.FreeLunIds))
+	return nil
+}
+
+// Convert_impl_FreeDiskLocation_To_v2alpha1_FreeDiskLocation is an autogenerated conversion function.
+func Convert_impl_FreeDiskLocation_To_v2alpha1_FreeDiskLocation(in *impl.FreeDiskLocation, out *v2alpha1.FreeDiskLocation) error {
+	return autoConvert_impl_FreeDiskLocation_To_v2alpha1_FreeDiskLocation(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskIdentityRequest_To_impl_GetDiskIdentityRequest(in *v2alpha1.GetDiskIdentityRequest, out *impl.GetDiskIdentityRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskIdentityRequest_To_impl_GetDiskIdentityRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskIdentityRequest_To_impl_GetDiskIdentityRequest(in *v2alpha1.GetDiskIdentityRequest, out *impl.GetDiskIdentityRequest) error {
+	return autoConvert_v2alpha1_GetDiskIdentityRequest_To_impl_GetDiskIdentityRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskIdentityRequest_To_v2alpha1_GetDiskIdentityRequest(in *impl.GetDiskIdentityRequest, out *v2alpha1.GetDiskIdentityRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskIdentityRequest_To_v2alpha1_GetDiskIdentityRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskIdentityRequest_To_v2alpha1_GetDiskIdentityRequest(in *impl.GetDiskIdentityRequest, out *v2alpha1.GetDiskIdentityRequest) error {
+	return autoConvert_impl_GetDiskIdentityRequest_To_v2alpha1_GetDiskIdentityRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskIdentityResponse_To_impl_GetDiskIdentityResponse(in *v2alpha1.GetDiskIdentityResponse, out *impl.GetDiskIdentityResponse) error {
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(impl.DiskIdentity)
+		if err := Convert_v2alpha1_DiskIdentity_To_impl_DiskIdentity(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Identity = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskIdentityResponse_To_impl_GetDiskIdentityResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskIdentityResponse_To_impl_GetDiskIdentityResponse(in *v2alpha1.GetDiskIdentityResponse, out *impl.GetDiskIdentityResponse) error {
+	return autoConvert_v2alpha1_GetDiskIdentityResponse_To_impl_GetDiskIdentityResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskIdentityResponse_To_v2alpha1_GetDiskIdentityResponse(in *impl.GetDiskIdentityResponse, out *v2alpha1.GetDiskIdentityResponse) error {
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(v2alpha1.DiskIdentity)
+		if err := Convert_impl_DiskIdentity_To_v2alpha1_DiskIdentity(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Identity = nil
+	}
+	return nil
+}
+
+// Convert_impl_GetDiskIdentityResponse_To_v2alpha1_GetDiskIdentityResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskIdentityResponse_To_v2alpha1_GetDiskIdentityResponse(in *impl.GetDiskIdentityResponse, out *v2alpha1.GetDiskIdentityResponse) error {
+	return autoConvert_impl_GetDiskIdentityResponse_To_v2alpha1_GetDiskIdentityResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskNumberByLocationRequest_To_impl_GetDiskNumberByLocationRequest(in *v2alpha1.GetDiskNumberByLocationRequest, out *impl.GetDiskNumberByLocationRequest) error {
+	out.Adapter = in.Adapter
+	out.Bus = in.Bus
+	out.Target = in.Target
+	out.LunId = in.LunId
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskNumberByLocationRequest_To_impl_GetDiskNumberByLocationRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskNumberByLocationRequest_To_impl_GetDiskNumberByLocationRequest(in *v2alpha1.GetDiskNumberByLocationRequest, out *impl.GetDiskNumberByLocationRequest) error {
+	return autoConvert_v2alpha1_GetDiskNumberByLocationRequest_To_impl_GetDiskNumberByLocationRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskNumberByLocationRequest_To_v2alpha1_GetDiskNumberByLocationRequest(in *impl.GetDiskNumberByLocationRequest, out *v2alpha1.GetDiskNumberByLocationRequest) error {
+	out.Adapter = in.Adapter
+	out.Bus = in.Bus
+	out.Target = in.Target
+	out.LunId = in.LunId
+	return nil
+}
+
+// Convert_impl_GetDiskNumberByLocationRequest_To_v2alpha1_GetDiskNumberByLocationRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskNumberByLocationRequest_To_v2alpha1_GetDiskNumberByLocationRequest(in *impl.GetDiskNumberByLocationRequest, out *v2alpha1.GetDiskNumberByLocationRequest) error {
+	return autoConvert_impl_GetDiskNumberByLocationRequest_To_v2alpha1_GetDiskNumberByLocationRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskNumberByLocationResponse_To_impl_GetDiskNumberByLocationResponse(in *v2alpha1.GetDiskNumberByLocationResponse, out *impl.GetDiskNumberByLocationResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskNumberByLocationResponse_To_impl_GetDiskNumberByLocationResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskNumberByLocationResponse_To_impl_GetDiskNumberByLocationResponse(in *v2alpha1.GetDiskNumberByLocationResponse, out *impl.GetDiskNumberByLocationResponse) error {
+	return autoConvert_v2alpha1_GetDiskNumberByLocationResponse_To_impl_GetDiskNumberByLocationResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskNumberByLocationResponse_To_v2alpha1_GetDiskNumberByLocationResponse(in *impl.GetDiskNumberByLocationResponse, out *v2alpha1.GetDiskNumberByLocationResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskNumberByLocationResponse_To_v2alpha1_GetDiskNumberByLocationResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskNumberByLocationResponse_To_v2alpha1_GetDiskNumberByLocationResponse(in *impl.GetDiskNumberByLocationResponse, out *v2alpha1.GetDiskNumberByLocationResponse) error {
+	return autoConvert_impl_GetDiskNumberByLocationResponse_To_v2alpha1_GetDiskNumberByLocationResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskNumberByPage83IDRequest_To_impl_GetDiskNumberByPage83IDRequest(in *v2alpha1.GetDiskNumberByPage83IDRequest, out *impl.GetDiskNumberByPage83IDRequest) error {
+	out.Page83Id = in.Page83Id
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskNumberByPage83IDRequest_To_impl_GetDiskNumberByPage83IDRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskNumberByPage83IDRequest_To_impl_GetDiskNumberByPage83IDRequest(in *v2alpha1.GetDiskNumberByPage83IDRequest, out *impl.GetDiskNumberByPage83IDRequest) error {
+	return autoConvert_v2alpha1_GetDiskNumberByPage83IDRequest_To_impl_GetDiskNumberByPage83IDRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskNumberByPage83IDRequest_To_v2alpha1_GetDiskNumberByPage83IDRequest(in *impl.GetDiskNumberByPage83IDRequest, out *v2alpha1.GetDiskNumberByPage83IDRequest) error {
+	out.Page83Id = in.Page83Id
+	return nil
+}
+
+// Convert_impl_GetDiskNumberByPage83IDRequest_To_v2alpha1_GetDiskNumberByPage83IDRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskNumberByPage83IDRequest_To_v2alpha1_GetDiskNumberByPage83IDRequest(in *impl.GetDiskNumberByPage83IDRequest, out *v2alpha1.GetDiskNumberByPage83IDRequest) error {
+	return autoConvert_impl_GetDiskNumberByPage83IDRequest_To_v2alpha1_GetDiskNumberByPage83IDRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskNumberByPage83IDResponse_To_impl_GetDiskNumberByPage83IDResponse(in *v2alpha1.GetDiskNumberByPage83IDResponse, out *impl.GetDiskNumberByPage83IDResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskNumberByPage83IDResponse_To_impl_GetDiskNumberByPage83IDResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskNumberByPage83IDResponse_To_impl_GetDiskNumberByPage83IDResponse(in *v2alpha1.GetDiskNumberByPage83IDResponse, out *impl.GetDiskNumberByPage83IDResponse) error {
+	return autoConvert_v2alpha1_GetDiskNumberByPage83IDResponse_To_impl_GetDiskNumberByPage83IDResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskNumberByPage83IDResponse_To_v2alpha1_GetDiskNumberByPage83IDResponse(in *impl.GetDiskNumberByPage83IDResponse, out *v2alpha1.GetDiskNumberByPage83IDResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskNumberByPage83IDResponse_To_v2alpha1_GetDiskNumberByPage83IDResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskNumberByPage83IDResponse_To_v2alpha1_GetDiskNumberByPage83IDResponse(in *impl.GetDiskNumberByPage83IDResponse, out *v2alpha1.GetDiskNumberByPage83IDResponse) error {
+	return autoConvert_impl_GetDiskNumberByPage83IDResponse_To_v2alpha1_GetDiskNumberByPage83IDResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskNumberBySerialNumberRequest_To_impl_GetDiskNumberBySerialNumberRequest(in *v2alpha1.GetDiskNumberBySerialNumberRequest, out *impl.GetDiskNumberBySerialNumberRequest) error {
+	out.SerialNumber = in.SerialNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskNumberBySerialNumberRequest_To_impl_GetDiskNumberBySerialNumberRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskNumberBySerialNumberRequest_To_impl_GetDiskNumberBySerialNumberRequest(in *v2alpha1.GetDiskNumberBySerialNumberRequest, out *impl.GetDiskNumberBySerialNumberRequest) error {
+	return autoConvert_v2alpha1_GetDiskNumberBySerialNumberRequest_To_impl_GetDiskNumberBySerialNumberRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskNumberBySerialNumberRequest_To_v2alpha1_GetDiskNumberBySerialNumberRequest(in *impl.GetDiskNumberBySerialNumberRequest, out *v2alpha1.GetDiskNumberBySerialNumberRequest) error {
+	out.SerialNumber = in.SerialNumber
+	return nil
+}
+
+// Convert_impl_GetDiskNumberBySerialNumberRequest_To_v2alpha1_GetDiskNumberBySerialNumberRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskNumberBySerialNumberRequest_To_v2alpha1_GetDiskNumberBySerialNumberRequest(in *impl.GetDiskNumberBySerialNumberRequest, out *v2alpha1.GetDiskNumberBySerialNumberRequest) error {
+	return autoConvert_impl_GetDiskNumberBySerialNumberRequest_To_v2alpha1_GetDiskNumberBySerialNumberRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskNumberBySerialNumberResponse_To_impl_GetDiskNumberBySerialNumberResponse(in *v2alpha1.GetDiskNumberBySerialNumberResponse, out *impl.GetDiskNumberBySerialNumberResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskNumberBySerialNumberResponse_To_impl_GetDiskNumberBySerialNumberResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskNumberBySerialNumberResponse_To_impl_GetDiskNumberBySerialNumberResponse(in *v2alpha1.GetDiskNumberBySerialNumberResponse, out *impl.GetDiskNumberBySerialNumberResponse) error {
+	return autoConvert_v2alpha1_GetDiskNumberBySerialNumberResponse_To_impl_GetDiskNumberBySerialNumberResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskNumberBySerialNumberResponse_To_v2alpha1_GetDiskNumberBySerialNumberResponse(in *impl.GetDiskNumberBySerialNumberResponse, out *v2alpha1.GetDiskNumberBySerialNumberResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskNumberBySerialNumberResponse_To_v2alpha1_GetDiskNumberBySerialNumberResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskNumberBySerialNumberResponse_To_v2alpha1_GetDiskNumberBySerialNumberResponse(in *impl.GetDiskNumberBySerialNumberResponse, out *v2alpha1.GetDiskNumberBySerialNumberResponse) error {
+	return autoConvert_impl_GetDiskNumberBySerialNumberResponse_To_v2alpha1_GetDiskNumberBySerialNumberResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskReadOnlyRequest_To_impl_GetDiskReadOnlyRequest(in *v2alpha1.GetDiskReadOnlyRequest, out *impl.GetDiskReadOnlyRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskReadOnlyRequest_To_impl_GetDiskReadOnlyRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskReadOnlyRequest_To_impl_GetDiskReadOnlyRequest(in *v2alpha1.GetDiskReadOnlyRequest, out *impl.GetDiskReadOnlyRequest) error {
+	return autoConvert_v2alpha1_GetDiskReadOnlyRequest_To_impl_GetDiskReadOnlyRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskReadOnlyRequest_To_v2alpha1_GetDiskReadOnlyRequest(in *impl.GetDiskReadOnlyRequest, out *v2alpha1.GetDiskReadOnlyRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskReadOnlyRequest_To_v2alpha1_GetDiskReadOnlyRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskReadOnlyRequest_To_v2alpha1_GetDiskReadOnlyRequest(in *impl.GetDiskReadOnlyRequest, out *v2alpha1.GetDiskReadOnlyRequest) error {
+	return autoConvert_impl_GetDiskReadOnlyRequest_To_v2alpha1_GetDiskReadOnlyRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskReadOnlyResponse_To_impl_GetDiskReadOnlyResponse(in *v2alpha1.GetDiskReadOnlyResponse, out *impl.GetDiskReadOnlyResponse) error {
+	out.ReadOnly = in.ReadOnly
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskReadOnlyResponse_To_impl_GetDiskReadOnlyResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskReadOnlyResponse_To_impl_GetDiskReadOnlyResponse(in *v2alpha1.GetDiskReadOnlyResponse, out *impl.GetDiskReadOnlyResponse) error {
+	return autoConvert_v2alpha1_GetDiskReadOnlyResponse_To_impl_GetDiskReadOnlyResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskReadOnlyResponse_To_v2alpha1_GetDiskReadOnlyResponse(in *impl.GetDiskReadOnlyResponse, out *v2alpha1.GetDiskReadOnlyResponse) error {
+	out.ReadOnly = in.ReadOnly
+	return nil
+}
+
+// Convert_impl_GetDiskReadOnlyResponse_To_v2alpha1_GetDiskReadOnlyResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskReadOnlyResponse_To_v2alpha1_GetDiskReadOnlyResponse(in *impl.GetDiskReadOnlyResponse, out *v2alpha1.GetDiskReadOnlyResponse) error {
+	return autoConvert_impl_GetDiskReadOnlyResponse_To_v2alpha1_GetDiskReadOnlyResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskReliabilityCountersRequest_To_impl_GetDiskReliabilityCountersRequest(in *v2alpha1.GetDiskReliabilityCountersRequest, out *impl.GetDiskReliabilityCountersRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskReliabilityCountersRequest_To_impl_GetDiskReliabilityCountersRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskReliabilityCountersRequest_To_impl_GetDiskReliabilityCountersRequest(in *v2alpha1.GetDiskReliabilityCountersRequest, out *impl.GetDiskReliabilityCountersRequest) error {
+	return autoConvert_v2alpha1_GetDiskReliabilityCountersRequest_To_impl_GetDiskReliabilityCountersRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskReliabilityCountersRequest_To_v2alpha1_GetDiskReliabilityCountersRequest(in *impl.GetDiskReliabilityCountersRequest, out *v2alpha1.GetDiskReliabilityCountersRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskReliabilityCountersRequest_To_v2alpha1_GetDiskReliabilityCountersRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskReliabilityCountersRequest_To_v2alpha1_GetDiskReliabilityCountersRequest(in *impl.GetDiskReliabilityCountersRequest, out *v2alpha1.GetDiskReliabilityCountersRequest) error {
+	return autoConvert_impl_GetDiskReliabilityCountersRequest_To_v2alpha1_GetDiskReliabilityCountersRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskReliabilityCountersResponse_To_impl_GetDiskReliabilityCountersResponse(in *v2alpha1.GetDiskReliabilityCountersResponse, out *impl.GetDiskReliabilityCountersResponse) error {
+	out.TemperatureCelsius = in.TemperatureCelsius
+	out.MaxTemperatureCelsius = in.MaxTemperatureCelsius
+	out.WearPercentage = in.WearPercentage
+	out.ReadErrorsTotal = in.ReadErrorsTotal
+	out.ReadErrorsCorrected = in.ReadErrorsCorrected
+	out.ReadErrorsUncorrected = in.ReadErrorsUncorrected
+	out.WriteErrorsTotal = in.WriteErrorsTotal
+	out.WriteErrorsCorrected = in.WriteErrorsCorrected
+	out.WriteErrorsUncorrected = in.WriteErrorsUncorrected
+	out.PowerOnHours = in.PowerOnHours
+	out.ReadLatencyMaxMs = in.ReadLatencyMaxMs
+	out.WriteLatencyMaxMs = in.WriteLatencyMaxMs
+	out.FlushLatencyMaxMs = in.FlushLatencyMaxMs
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskReliabilityCountersResponse_To_impl_GetDiskReliabilityCountersResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskReliabilityCountersResponse_To_impl_GetDiskReliabilityCountersResponse(in *v2alpha1.GetDiskReliabilityCountersResponse, out *impl.GetDiskReliabilityCountersResponse) error {
+	return autoConvert_v2alpha1_GetDiskReliabilityCountersResponse_To_impl_GetDiskReliabilityCountersResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskReliabilityCountersResponse_To_v2alpha1_GetDiskReliabilityCountersResponse(in *impl.GetDiskReliabilityCountersResponse, out *v2alpha1.GetDiskReliabilityCountersResponse) error {
+	out.TemperatureCelsius = in.TemperatureCelsius
+	out.MaxTemperatureCelsius = in.MaxTemperatureCelsius
+	out.WearPercentage = in.WearPercentage
+	out.ReadErrorsTotal = in.ReadErrorsTotal
+	out.ReadErrorsCorrected = in.ReadErrorsCorrected
+	out.ReadErrorsUncorrected = in.ReadErrorsUncorrected
+	out.WriteErrorsTotal = in.WriteErrorsTotal
+	out.WriteErrorsCorrected = in.WriteErrorsCorrected
+	out.WriteErrorsUncorrected = in.WriteErrorsUncorrected
+	out.PowerOnHours = in.PowerOnHours
+	out.ReadLatencyMaxMs = in.ReadLatencyMaxMs
+	out.WriteLatencyMaxMs = in.WriteLatencyMaxMs
+	out.FlushLatencyMaxMs = in.FlushLatencyMaxMs
+	return nil
+}
+
+// Convert_impl_GetDiskReliabilityCountersResponse_To_v2alpha1_GetDiskReliabilityCountersResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskReliabilityCountersResponse_To_v2alpha1_GetDiskReliabilityCountersResponse(in *impl.GetDiskReliabilityCountersResponse, out *v2alpha1.GetDiskReliabilityCountersResponse) error {
+	return autoConvert_impl_GetDiskReliabilityCountersResponse_To_v2alpha1_GetDiskReliabilityCountersResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskStateRequest_To_impl_GetDiskStateRequest(in *v2alpha1.GetDiskStateRequest, out *impl.GetDiskStateRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskStateRequest_To_impl_GetDiskStateRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskStateRequest_To_impl_GetDiskStateRequest(in *v2alpha1.GetDiskStateRequest, out *impl.GetDiskStateRequest) error {
+	return autoConvert_v2alpha1_GetDiskStateRequest_To_impl_GetDiskStateRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskStateRequest_To_v2alpha1_GetDiskStateRequest(in *impl.GetDiskStateRequest, out *v2alpha1.GetDiskStateRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskStateRequest_To_v2alpha1_GetDiskStateRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskStateRequest_To_v2alpha1_GetDiskStateRequest(in *impl.GetDiskStateRequest, out *v2alpha1.GetDiskStateRequest) error {
+	return autoConvert_impl_GetDiskStateRequest_To_v2alpha1_GetDiskStateRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskStateResponse_To_impl_GetDiskStateResponse(in *v2alpha1.GetDiskStateResponse, out *impl.GetDiskStateResponse) error {
+	out.IsOnline = in.IsOnline
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskStateResponse_To_impl_GetDiskStateResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskStateResponse_To_impl_GetDiskStateResponse(in *v2alpha1.GetDiskStateResponse, out *impl.GetDiskStateResponse) error {
+	return autoConvert_v2alpha1_GetDiskStateResponse_To_impl_GetDiskStateResponse(in, out)
+}
+
+func autoConvert_impl_GetDiskStateResponse_To_v2alpha1_GetDiskStateResponse(in *impl.GetDiskStateResponse, out *v2alpha1.GetDiskStateResponse) error {
+	out.IsOnline = in.IsOnline
+	return nil
+}
+
+// Convert_impl_GetDiskStateResponse_To_v2alpha1_GetDiskStateResponse is an autogenerated conversion function.
+func Convert_impl_GetDiskStateResponse_To_v2alpha1_GetDiskStateResponse(in *impl.GetDiskStateResponse, out *v2alpha1.GetDiskStateResponse) error {
+	return autoConvert_impl_GetDiskStateResponse_To_v2alpha1_GetDiskStateResponse(in, out)
+}
+
+func autoConvert_v2alpha1_GetDiskStatsRequest_To_impl_GetDiskStatsRequest(in *v2alpha1.GetDiskStatsRequest, out *impl.GetDiskStatsRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_GetDiskStatsRequest_To_impl_GetDiskStatsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDiskStatsRequest_To_impl_GetDiskStatsRequest(in *v2alpha1.GetDiskStatsRequest, out *impl.GetDiskStatsRequest) error {
+	return autoConvert_v2alpha1_GetDiskStatsRequest_To_impl_GetDiskStatsRequest(in, out)
+}
+
+func autoConvert_impl_GetDiskStatsRequest_To_v2alpha1_GetDiskStatsRequest(in *impl.GetDiskStatsRequest, out *v2alpha1.GetDiskStatsRequest) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_GetDiskStatsRequest_To_v2alpha1_GetDiskStatsRequest is an autogenerated conversion function.
+func Convert_impl_GetDiskStatsRequest_To_v2alpha1_GetDiskStatsRequest(in *impl.GetDiskStatsRequest, out *v2alpha1.GetDiskStatsRequest) error {
+	return autoConvert_impl_GetDiskStatsRequest_To_v2alpha1_GetDiskStatsRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_GetDiskStatsResponse_To_impl_GetDiskStatsResponse(in *v2alpha1.GetDiskStatsResponse, out *impl.GetDiskStatsResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_GetDiskStatsResponse_To_v2alpha1_GetDiskStatsResponse(in *impl.GetDiskStatsResponse, out *v2alpha1.GetDiskStatsResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_GetFreeDiskLocationsRequest_To_impl_GetFreeDiskLocationsRequest(in *v2alpha1.GetFreeDiskLocationsRequest, out *impl.GetFreeDiskLocationsRequest) error {
+	out.MaxLuns = in.MaxLuns
+	return nil
+}
+
+// Convert_v2alpha1_GetFreeDiskLocationsRequest_To_impl_GetFreeDiskLocationsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetFreeDiskLocationsRequest_To_impl_GetFreeDiskLocationsRequest(in *v2alpha1.GetFreeDiskLocationsRequest, out *impl.GetFreeDiskLocationsRequest) error {
+	return autoConvert_v2alpha1_GetFreeDiskLocationsRequest_To_impl_GetFreeDiskLocationsRequest(in, out)
+}
+
+func autoConvert_impl_GetFreeDiskLocationsRequest_To_v2alpha1_GetFreeDiskLocationsRequest(in *impl.GetFreeDiskLocationsRequest, out *v2alpha1.GetFreeDiskLocationsRequest) error {
+	out.MaxLuns = in.MaxLuns
+	return nil
+}
+
+// Convert_impl_GetFreeDiskLocationsRequest_To_v2alpha1_GetFreeDiskLocationsRequest is an autogenerated conversion function.
+func Convert_impl_GetFreeDiskLocationsRequest_To_v2alpha1_GetFreeDiskLocationsRequest(in *impl.GetFreeDiskLocationsRequest, out *v2alpha1.GetFreeDiskLocationsRequest) error {
+	return autoConvert_impl_GetFreeDiskLocationsRequest_To_v2alpha1_GetFreeDiskLocationsRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_GetFreeDiskLocationsResponse_To_impl_GetFreeDiskLocationsResponse(in *v2alpha1.GetFreeDiskLocationsResponse, out *impl.GetFreeDiskLocationsResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_GetFreeDiskLocationsResponse_To_v2alpha1_GetFreeDiskLocationsResponse(in *impl.GetFreeDiskLocationsResponse, out *v2alpha1.GetFreeDiskLocationsResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_GetSanPolicyRequest_To_impl_GetSanPolicyRequest(in *v2alpha1.GetSanPolicyRequest, out *impl.GetSanPolicyRequest) error {
+	return nil
+}
+
+// Convert_v2alpha1_GetSanPolicyRequest_To_impl_GetSanPolicyRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetSanPolicyRequest_To_impl_GetSanPolicyRequest(in *v2alpha1.GetSanPolicyRequest, out *impl.GetSanPolicyRequest) error {
+	return autoConvert_v2alpha1_GetSanPolicyRequest_To_impl_GetSanPolicyRequest(in, out)
+}
+
+func autoConvert_impl_GetSanPolicyRequest_To_v2alpha1_GetSanPolicyRequest(in *impl.GetSanPolicyRequest, out *v2alpha1.GetSanPolicyRequest) error {
+	return nil
+}
+
+// Convert_impl_GetSanPolicyRequest_To_v2alpha1_GetSanPolicyRequest is an autogenerated conversion function.
+func Convert_impl_GetSanPolicyRequest_To_v2alpha1_GetSanPolicyRequest(in *impl.GetSanPolicyRequest, out *v2alpha1.GetSanPolicyRequest) error {
+	return autoConvert_impl_GetSanPolicyRequest_To_v2alpha1_GetSanPolicyRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetSanPolicyResponse_To_impl_GetSanPolicyResponse(in *v2alpha1.GetSanPolicyResponse, out *impl.GetSanPolicyResponse) error {
+	out.SanPolicy = impl.SanPolicy(in.SanPolicy)
+	return nil
+}
+
+// Convert_v2alpha1_GetSanPolicyResponse_To_impl_GetSanPolicyResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetSanPolicyResponse_To_impl_GetSanPolicyResponse(in *v2alpha1.GetSanPolicyResponse, out *impl.GetSanPolicyResponse) error {
+	return autoConvert_v2alpha1_GetSanPolicyResponse_To_impl_GetSanPolicyResponse(in, out)
+}
+
+func autoConvert_impl_GetSanPolicyResponse_To_v2alpha1_GetSanPolicyResponse(in *impl.GetSanPolicyResponse, out *v2alpha1.GetSanPolicyResponse) error {
+	out.SanPolicy = v2alpha1.SanPolicy(in.SanPolicy)
+	return nil
+}
+
+// Convert_impl_GetSanPolicyResponse_To_v2alpha1_GetSanPolicyResponse is an autogenerated conversion function.
+func Convert_impl_GetSanPolicyResponse_To_v2alpha1_GetSanPolicyResponse(in *impl.GetSanPolicyResponse, out *v2alpha1.GetSanPolicyResponse) error {
+	return autoConvert_impl_GetSanPolicyResponse_To_v2alpha1_GetSanPolicyResponse(in, out)
+}
+
+func autoConvert_v2alpha1_ListDiskIDsRequest_To_impl_ListDiskIDsRequest(in *v2alpha1.ListDiskIDsRequest, out *impl.ListDiskIDsRequest) error {
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(impl.DiskFilter)
+		if err := Convert_v2alpha1_DiskFilter_To_impl_DiskFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	out.MaxResults = in.MaxResults
+	out.PageToken = in.PageToken
+	return nil
+}
+
+// Convert_v2alpha1_ListDiskIDsRequest_To_impl_ListDiskIDsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ListDiskIDsRequest_To_impl_ListDiskIDsRequest(in *v2alpha1.ListDiskIDsRequest, out *impl.ListDiskIDsRequest) error {
+	return autoConvert_v2alpha1_ListDiskIDsRequest_To_impl_ListDiskIDsRequest(in, out)
+}
+
+func autoConvert_impl_ListDiskIDsRequest_To_v2alpha1_ListDiskIDsRequest(in *impl.ListDiskIDsRequest, out *v2alpha1.ListDiskIDsRequest) error {
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(v2alpha1.DiskFilter)
+		if err := Convert_impl_DiskFilter_To_v2alpha1_DiskFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	out.MaxResults = in.MaxResults
+	out.PageToken = in.PageToken
+	return nil
+}
+
+// Convert_impl_ListDiskIDsRequest_To_v2alpha1_ListDiskIDsRequest is an autogenerated conversion function.
+func Convert_impl_ListDiskIDsRequest_To_v2alpha1_ListDiskIDsRequest(in *impl.ListDiskIDsRequest, out *v2alpha1.ListDiskIDsRequest) error {
+	return autoConvert_impl_ListDiskIDsRequest_To_v2alpha1_ListDiskIDsRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ListDiskIDsResponse_To_impl_ListDiskIDsResponse(in *v2alpha1.ListDiskIDsResponse, out *impl.ListDiskIDsResponse) error {
+	if in.DiskIDs != nil {
+		in, out := &in.DiskIDs, &out.DiskIDs
+		*out = make(map[uint32]*impl.DiskIDs, len(*in))
+		for key, val := range *in {
+			newVal := new(*impl.DiskIDs)
+			if err := Convert_v2alpha1_DiskIDs_To_impl_DiskIDs(*&val, *newVal); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.DiskIDs = nil
+	}
+	out.NextPageToken = in.NextPageToken
+	return nil
+}
+
+// Convert_v2alpha1_ListDiskIDsResponse_To_impl_ListDiskIDsResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ListDiskIDsResponse_To_impl_ListDiskIDsResponse(in *v2alpha1.ListDiskIDsResponse, out *impl.ListDiskIDsResponse) error {
+	return autoConvert_v2alpha1_ListDiskIDsResponse_To_impl_ListDiskIDsResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_impl_ListDiskIDsResponse_To_v2alpha1_ListDiskIDsResponse(in *impl.ListDiskIDsResponse, out *v2alpha1.ListDiskIDsResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_ListDiskLocationsRequest_To_impl_ListDiskLocationsRequest(in *v2alpha1.ListDiskLocationsRequest, out *impl.ListDiskLocationsRequest) error {
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(impl.DiskFilter)
+		if err := Convert_v2alpha1_DiskFilter_To_impl_DiskFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	out.MaxResults = in.MaxResults
+	out.PageToken = in.PageToken
+	return nil
+}
+
+// Convert_v2alpha1_ListDiskLocationsRequest_To_impl_ListDiskLocationsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ListDiskLocationsRequest_To_impl_ListDiskLocationsRequest(in *v2alpha1.ListDiskLocationsRequest, out *impl.ListDiskLocationsRequest) error {
+	return autoConvert_v2alpha1_ListDiskLocationsRequest_To_impl_ListDiskLocationsRequest(in, out)
+}
+
+func autoConvert_impl_ListDiskLocationsRequest_To_v2alpha1_ListDiskLocationsRequest(in *impl.ListDiskLocationsRequest, out *v2alpha1.ListDiskLocationsRequest) error {
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(v2alpha1.DiskFilter)
+		if err := Convert_impl_DiskFilter_To_v2alpha1_DiskFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	out.MaxResults = in.MaxResults
+	out.PageToken = in.PageToken
+	return nil
+}
+
+// Convert_impl_ListDiskLocationsRequest_To_v2alpha1_ListDiskLocationsRequest is an autogenerated conversion function.
+func Convert_impl_ListDiskLocationsRequest_To_v2alpha1_ListDiskLocationsRequest(in *impl.ListDiskLocationsRequest, out *v2alpha1.ListDiskLocationsRequest) error {
+	return autoConvert_impl_ListDiskLocationsRequest_To_v2alpha1_ListDiskLocationsRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ListDiskLocationsResponse_To_impl_ListDiskLocationsResponse(in *v2alpha1.ListDiskLocationsResponse, out *impl.ListDiskLocationsResponse) error {
+	if in.DiskLocations != nil {
+		in, out := &in.DiskLocations, &out.DiskLocations
+		*out = make(map[uint32]*impl.DiskLocation, len(*in))
+		for key, val := range *in {
+			newVal := new(*impl.DiskLocation)
+			if err := Convert_v2alpha1_DiskLocation_To_impl_DiskLocation(*&val, *newVal); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.DiskLocations = nil
+	}
+	out.NextPageToken = in.NextPageToken
+	return nil
+}
+
+// Convert_v2alpha1_ListDiskLocationsResponse_To_impl_ListDiskLocationsResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ListDiskLocationsResponse_To_impl_ListDiskLocationsResponse(in *v2alpha1.ListDiskLocationsResponse, out *impl.ListDiskLocationsResponse) error {
+	return autoConvert_v2alpha1_ListDiskLocationsResponse_To_impl_ListDiskLocationsResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_impl_ListDiskLocationsResponse_To_v2alpha1_ListDiskLocationsResponse(in *impl.ListDiskLocationsResponse, out *v2alpha1.ListDiskLocationsResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_ListDiskPage83IDsRequest_To_impl_ListDiskPage83IDsRequest(in *v2alpha1.ListDiskPage83IDsRequest, out *impl.ListDiskPage83IDsRequest) error {
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(impl.DiskFilter)
+		if err := Convert_v2alpha1_DiskFilter_To_impl_DiskFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	out.MaxResults = in.MaxResults
+	out.PageToken = in.PageToken
+	return nil
+}
+
+// Convert_v2alpha1_ListDiskPage83IDsRequest_To_impl_ListDiskPage83IDsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ListDiskPage83IDsRequest_To_impl_ListDiskPage83IDsRequest(in *v2alpha1.ListDiskPage83IDsRequest, out *impl.ListDiskPage83IDsRequest) error {
+	return autoConvert_v2alpha1_ListDiskPage83IDsRequest_To_impl_ListDiskPage83IDsRequest(in, out)
+}
+
+func autoConvert_impl_ListDiskPage83IDsRequest_To_v2alpha1_ListDiskPage83IDsRequest(in *impl.ListDiskPage83IDsRequest, out *v2alpha1.ListDiskPage83IDsRequest) error {
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(v2alpha1.DiskFilter)
+		if err := Convert_impl_DiskFilter_To_v2alpha1_DiskFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	out.MaxResults = in.MaxResults
+	out.PageToken = in.PageToken
+	return nil
+}
+
+// Convert_impl_ListDiskPage83IDsRequest_To_v2alpha1_ListDiskPage83IDsRequest is an autogenerated conversion function.
+func Convert_impl_ListDiskPage83IDsRequest_To_v2alpha1_ListDiskPage83IDsRequest(in *impl.ListDiskPage83IDsRequest, out *v2alpha1.ListDiskPage83IDsRequest) error {
+	return autoConvert_impl_ListDiskPage83IDsRequest_To_v2alpha1_ListDiskPage83IDsRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ListDiskPage83IDsResponse_To_impl_ListDiskPage83IDsResponse(in *v2alpha1.ListDiskPage83IDsResponse, out *impl.ListDiskPage83IDsResponse) error {
+	if in.DiskPage83IDs != nil {
+		in, out := &in.DiskPage83IDs, &out.DiskPage83IDs
+		*out = make(map[uint32]*impl.DiskPage83IDs, len(*in))
+		for key, val := range *in {
+			newVal := new(*impl.DiskPage83IDs)
+			if err := Convert_v2alpha1_DiskPage83IDs_To_impl_DiskPage83IDs(*&val, *newVal); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.DiskPage83IDs = nil
+	}
+	out.NextPageToken = in.NextPageToken
+	return nil
+}
+
+// Convert_v2alpha1_ListDiskPage83IDsResponse_To_impl_ListDiskPage83IDsResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ListDiskPage83IDsResponse_To_impl_ListDiskPage83IDsResponse(in *v2alpha1.ListDiskPage83IDsResponse, out *impl.ListDiskPage83IDsResponse) error {
+	return autoConvert_v2alpha1_ListDiskPage83IDsResponse_To_impl_ListDiskPage83IDsResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_impl_ListDiskPage83IDsResponse_To_v2alpha1_ListDiskPage83IDsResponse(in *impl.ListDiskPage83IDsResponse, out *v2alpha1.ListDiskPage83IDsResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_ListDisksRequest_To_impl_ListDisksRequest(in *v2alpha1.ListDisksRequest, out *impl.ListDisksRequest) error {
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(impl.DiskFilter)
+		if err := Convert_v2alpha1_DiskFilter_To_impl_DiskFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	out.MaxResults = in.MaxResults
+	out.PageToken = in.PageToken
+	return nil
+}
+
+// Convert_v2alpha1_ListDisksRequest_To_impl_ListDisksRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ListDisksRequest_To_impl_ListDisksRequest(in *v2alpha1.ListDisksRequest, out *impl.ListDisksRequest) error {
+	return autoConvert_v2alpha1_ListDisksRequest_To_impl_ListDisksRequest(in, out)
+}
+
+func autoConvert_impl_ListDisksRequest_To_v2alpha1_ListDisksRequest(in *impl.ListDisksRequest, out *v2alpha1.ListDisksRequest) error {
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(v2alpha1.DiskFilter)
+		if err := Convert_impl_DiskFilter_To_v2alpha1_DiskFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	out.MaxResults = in.MaxResults
+	out.PageToken = in.PageToken
+	return nil
+}
+
+// Convert_impl_ListDisksRequest_To_v2alpha1_ListDisksRequest is an autogenerated conversion function.
+func Convert_impl_ListDisksRequest_To_v2alpha1_ListDisksRequest(in *impl.ListDisksRequest, out *v2alpha1.ListDisksRequest) error {
+	return autoConvert_impl_ListDisksRequest_To_v2alpha1_ListDisksRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_ListDisksResponse_To_impl_ListDisksResponse(in *v2alpha1.ListDisksResponse, out *impl.ListDisksResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_ListDisksResponse_To_v2alpha1_ListDisksResponse(in *impl.ListDisksResponse, out *v2alpha1.ListDisksResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_Page83ID_To_impl_Page83ID(in *v2alpha1.Page83ID, out *impl.Page83ID) error {
+	out.Type = in.Type
+	out.Association = in.Association
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v2alpha1_Page83ID_To_impl_Page83ID is an autogenerated conversion function.
+func Convert_v2alpha1_Page83ID_To_impl_Page83ID(in *v2alpha1.Page83ID, out *impl.Page83ID) error {
+	return autoConvert_v2alpha1_Page83ID_To_impl_Page83ID(in, out)
+}
+
+func autoConvert_impl_Page83ID_To_v2alpha1_Page83ID(in *impl.Page83ID, out *v2alpha1.Page83ID) error {
+	out.Type = in.Type
+	out.Association = in.Association
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_impl_Page83ID_To_v2alpha1_Page83ID is an autogenerated conversion function.
+func Convert_impl_Page83ID_To_v2alpha1_Page83ID(in *impl.Page83ID, out *v2alpha1.Page83ID) error {
+	return autoConvert_impl_Page83ID_To_v2alpha1_Page83ID(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_PartitionDiskRequest_To_impl_PartitionDiskRequest(in *v2alpha1.PartitionDiskRequest, out *impl.PartitionDiskRequest) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_PartitionDiskRequest_To_v2alpha1_PartitionDiskRequest(in *impl.PartitionDiskRequest, out *v2alpha1.PartitionDiskRequest) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_PartitionDiskResponse_To_impl_PartitionDiskResponse(in *v2alpha1.PartitionDiskResponse, out *impl.PartitionDiskResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_PartitionDiskResponse_To_impl_PartitionDiskResponse is an autogenerated conversion function.
+func Convert_v2alpha1_PartitionDiskResponse_To_impl_PartitionDiskResponse(in *v2alpha1.PartitionDiskResponse, out *impl.PartitionDiskResponse) error {
+	return autoConvert_v2alpha1_PartitionDiskResponse_To_impl_PartitionDiskResponse(in, out)
+}
+
+func autoConvert_impl_PartitionDiskResponse_To_v2alpha1_PartitionDiskResponse(in *impl.PartitionDiskResponse, out *v2alpha1.PartitionDiskResponse) error {
+	return nil
+}
+
+// Convert_impl_PartitionDiskResponse_To_v2alpha1_PartitionDiskResponse is an autogenerated conversion function.
+func Convert_impl_PartitionDiskResponse_To_v2alpha1_PartitionDiskResponse(in *impl.PartitionDiskResponse, out *v2alpha1.PartitionDiskResponse) error {
+	return autoConvert_impl_PartitionDiskResponse_To_v2alpha1_PartitionDiskResponse(in, out)
+}
+
+func autoConvert_v2alpha1_RescanRequest_To_impl_RescanRequest(in *v2alpha1.RescanRequest, out *impl.RescanRequest) error {
+	return nil
+}
+
+// Convert_v2alpha1_RescanRequest_To_impl_RescanRequest is an autogenerated conversion function.
+func Convert_v2alpha1_RescanRequest_To_impl_RescanRequest(in *v2alpha1.RescanRequest, out *impl.RescanRequest) error {
+	return autoConvert_v2alpha1_RescanRequest_To_impl_RescanRequest(in, out)
+}
+
+func autoConvert_impl_RescanRequest_To_v2alpha1_RescanRequest(in *impl.RescanRequest, out *v2alpha1.RescanRequest) error {
+	return nil
+}
+
+// Convert_impl_RescanRequest_To_v2alpha1_RescanRequest is an autogenerated conversion function.
+func Convert_impl_RescanRequest_To_v2alpha1_RescanRequest(in *impl.RescanRequest, out *v2alpha1.RescanRequest) error {
+	return autoConvert_impl_RescanRequest_To_v2alpha1_RescanRequest(in, out)
+}
+
+func autoConvert_v2alpha1_RescanResponse_To_impl_RescanResponse(in *v2alpha1.RescanResponse, out *impl.RescanResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_RescanResponse_To_impl_RescanResponse is an autogenerated conversion function.
+func Convert_v2alpha1_RescanResponse_To_impl_RescanResponse(in *v2alpha1.RescanResponse, out *impl.RescanResponse) error {
+	return autoConvert_v2alpha1_RescanResponse_To_impl_RescanResponse(in, out)
+}
+
+func autoConvert_impl_RescanResponse_To_v2alpha1_RescanResponse(in *impl.RescanResponse, out *v2alpha1.RescanResponse) error {
+	return nil
+}
+
+// Convert_impl_RescanResponse_To_v2alpha1_RescanResponse is an autogenerated conversion function.
+func Convert_impl_RescanResponse_To_v2alpha1_RescanResponse(in *impl.RescanResponse, out *v2alpha1.RescanResponse) error {
+	return autoConvert_impl_RescanResponse_To_v2alpha1_RescanResponse(in, out)
+}
+
+func autoConvert_v2alpha1_ResolveDiskIdentityRequest_To_impl_ResolveDiskIdentityRequest(in *v2alpha1.ResolveDiskIdentityRequest, out *impl.ResolveDiskIdentityRequest) error {
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(impl.DiskIdentity)
+		if err := Convert_v2alpha1_DiskIdentity_To_impl_DiskIdentity(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Identity = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_ResolveDiskIdentityRequest_To_impl_ResolveDiskIdentityRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ResolveDiskIdentityRequest_To_impl_ResolveDiskIdentityRequest(in *v2alpha1.ResolveDiskIdentityRequest, out *impl.ResolveDiskIdentityRequest) error {
+	return autoConvert_v2alpha1_ResolveDiskIdentityRequest_To_impl_ResolveDiskIdentityRequest(in, out)
+}
+
+func autoConvert_impl_ResolveDiskIdentityRequest_To_v2alpha1_ResolveDiskIdentityRequest(in *impl.ResolveDiskIdentityRequest, out *v2alpha1.ResolveDiskIdentityRequest) error {
+	if in.Identity != nil {
+		in, out := &in.Identity, &out.Identity
+		*out = new(v2alpha1.DiskIdentity)
+		if err := Convert_impl_DiskIdentity_To_v2alpha1_DiskIdentity(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Identity = nil
+	}
+	return nil
+}
+
+// Convert_impl_ResolveDiskIdentityRequest_To_v2alpha1_ResolveDiskIdentityRequest is an autogenerated conversion function.
+func Convert_impl_ResolveDiskIdentityRequest_To_v2alpha1_ResolveDiskIdentityRequest(in *impl.ResolveDiskIdentityRequest, out *v2alpha1.ResolveDiskIdentityRequest) error {
+	return autoConvert_impl_ResolveDiskIdentityRequest_To_v2alpha1_ResolveDiskIdentityRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ResolveDiskIdentityResponse_To_impl_ResolveDiskIdentityResponse(in *v2alpha1.ResolveDiskIdentityResponse, out *impl.ResolveDiskIdentityResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_ResolveDiskIdentityResponse_To_impl_ResolveDiskIdentityResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ResolveDiskIdentityResponse_To_impl_ResolveDiskIdentityResponse(in *v2alpha1.ResolveDiskIdentityResponse, out *impl.ResolveDiskIdentityResponse) error {
+	return autoConvert_v2alpha1_ResolveDiskIdentityResponse_To_impl_ResolveDiskIdentityResponse(in, out)
+}
+
+func autoConvert_impl_ResolveDiskIdentityResponse_To_v2alpha1_ResolveDiskIdentityResponse(in *impl.ResolveDiskIdentityResponse, out *v2alpha1.ResolveDiskIdentityResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_ResolveDiskIdentityResponse_To_v2alpha1_ResolveDiskIdentityResponse is an autogenerated conversion function.
+func Convert_impl_ResolveDiskIdentityResponse_To_v2alpha1_ResolveDiskIdentityResponse(in *impl.ResolveDiskIdentityResponse, out *v2alpha1.ResolveDiskIdentityResponse) error {
+	return autoConvert_impl_ResolveDiskIdentityResponse_To_v2alpha1_ResolveDiskIdentityResponse(in, out)
+}
+
+func autoConvert_v2alpha1_SetDiskReadOnlyRequest_To_impl_SetDiskReadOnlyRequest(in *v2alpha1.SetDiskReadOnlyRequest, out *impl.SetDiskReadOnlyRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.ReadOnly = in.ReadOnly
+	return nil
+}
+
+// Convert_v2alpha1_SetDiskReadOnlyRequest_To_impl_SetDiskReadOnlyRequest is an autogenerated conversion function.
+func Convert_v2alpha1_SetDiskReadOnlyRequest_To_impl_SetDiskReadOnlyRequest(in *v2alpha1.SetDiskReadOnlyRequest, out *impl.SetDiskReadOnlyRequest) error {
+	return autoConvert_v2alpha1_SetDiskReadOnlyRequest_To_impl_SetDiskReadOnlyRequest(in, out)
+}
+
+func autoConvert_impl_SetDiskReadOnlyRequest_To_v2alpha1_SetDiskReadOnlyRequest(in *impl.SetDiskReadOnlyRequest, out *v2alpha1.SetDiskReadOnlyRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.ReadOnly = in.ReadOnly
+	return nil
+}
+
+// Convert_impl_SetDiskReadOnlyRequest_To_v2alpha1_SetDiskReadOnlyRequest is an autogenerated conversion function.
+func Convert_impl_SetDiskReadOnlyRequest_To_v2alpha1_SetDiskReadOnlyRequest(in *impl.SetDiskReadOnlyRequest, out *v2alpha1.SetDiskReadOnlyRequest) error {
+	return autoConvert_impl_SetDiskReadOnlyRequest_To_v2alpha1_SetDiskReadOnlyRequest(in, out)
+}
+
+func autoConvert_v2alpha1_SetDiskReadOnlyResponse_To_impl_SetDiskReadOnlyResponse(in *v2alpha1.SetDiskReadOnlyResponse, out *impl.SetDiskReadOnlyResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_SetDiskReadOnlyResponse_To_impl_SetDiskReadOnlyResponse is an autogenerated conversion function.
+func Convert_v2alpha1_SetDiskReadOnlyResponse_To_impl_SetDiskReadOnlyResponse(in *v2alpha1.SetDiskReadOnlyResponse, out *impl.SetDiskReadOnlyResponse) error {
+	return autoConvert_v2alpha1_SetDiskReadOnlyResponse_To_impl_SetDiskReadOnlyResponse(in, out)
+}
+
+func autoConvert_impl_SetDiskReadOnlyResponse_To_v2alpha1_SetDiskReadOnlyResponse(in *impl.SetDiskReadOnlyResponse, out *v2alpha1.SetDiskReadOnlyResponse) error {
+	return nil
+}
+
+// Convert_impl_SetDiskReadOnlyResponse_To_v2alpha1_SetDiskReadOnlyResponse is an autogenerated conversion function.
+func Convert_impl_SetDiskReadOnlyResponse_To_v2alpha1_SetDiskReadOnlyResponse(in *impl.SetDiskReadOnlyResponse, out *v2alpha1.SetDiskReadOnlyResponse) error {
+	return autoConvert_impl_SetDiskReadOnlyResponse_To_v2alpha1_SetDiskReadOnlyResponse(in, out)
+}
+
+func autoConvert_v2alpha1_SetDiskStateRequest_To_impl_SetDiskStateRequest(in *v2alpha1.SetDiskStateRequest, out *impl.SetDiskStateRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.IsOnline = in.IsOnline
+	out.ClaimToken = in.ClaimToken
+	return nil
+}
+
+// Convert_v2alpha1_SetDiskStateRequest_To_impl_SetDiskStateRequest is an autogenerated conversion function.
+func Convert_v2alpha1_SetDiskStateRequest_To_impl_SetDiskStateRequest(in *v2alpha1.SetDiskStateRequest, out *impl.SetDiskStateRequest) error {
+	return autoConvert_v2alpha1_SetDiskStateRequest_To_impl_SetDiskStateRequest(in, out)
+}
+
+func autoConvert_impl_SetDiskStateRequest_To_v2alpha1_SetDiskStateRequest(in *impl.SetDiskStateRequest, out *v2alpha1.SetDiskStateRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.IsOnline = in.IsOnline
+	out.ClaimToken = in.ClaimToken
+	return nil
+}
+
+// Convert_impl_SetDiskStateRequest_To_v2alpha1_SetDiskStateRequest is an autogenerated conversion function.
+func Convert_impl_SetDiskStateRequest_To_v2alpha1_SetDiskStateRequest(in *impl.SetDiskStateRequest, out *v2alpha1.SetDiskStateRequest) error {
+	return autoConvert_impl_SetDiskStateRequest_To_v2alpha1_SetDiskStateRequest(in, out)
+}
+
+func autoConvert_v2alpha1_SetDiskStateResponse_To_impl_SetDiskStateResponse(in *v2alpha1.SetDiskStateResponse, out *impl.SetDiskStateResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_SetDiskStateResponse_To_impl_SetDiskStateResponse is an autogenerated conversion function.
+func Convert_v2alpha1_SetDiskStateResponse_To_impl_SetDiskStateResponse(in *v2alpha1.SetDiskStateResponse, out *impl.SetDiskStateResponse) error {
+	return autoConvert_v2alpha1_SetDiskStateResponse_To_impl_SetDiskStateResponse(in, out)
+}
+
+func autoConvert_impl_SetDiskStateResponse_To_v2alpha1_SetDiskStateResponse(in *impl.SetDiskStateResponse, out *v2alpha1.SetDiskStateResponse) error {
+	return nil
+}
+
+// Convert_impl_SetDiskStateResponse_To_v2alpha1_SetDiskStateResponse is an autogenerated conversion function.
+func Convert_impl_SetDiskStateResponse_To_v2alpha1_SetDiskStateResponse(in *impl.SetDiskStateResponse, out *v2alpha1.SetDiskStateResponse) error {
+	return autoConvert_impl_SetDiskStateResponse_To_v2alpha1_SetDiskStateResponse(in, out)
+}
+
+func autoConvert_v2alpha1_SetPartitionTypeRequest_To_impl_SetPartitionTypeRequest(in *v2alpha1.SetPartitionTypeRequest, out *impl.SetPartitionTypeRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	out.GptType = in.GptType
+	return nil
+}
+
+// Convert_v2alpha1_SetPartitionTypeRequest_To_impl_SetPartitionTypeRequest is an autogenerated conversion function.
+func Convert_v2alpha1_SetPartitionTypeRequest_To_impl_SetPartitionTypeRequest(in *v2alpha1.SetPartitionTypeRequest, out *impl.SetPartitionTypeRequest) error {
+	return autoConvert_v2alpha1_SetPartitionTypeRequest_To_impl_SetPartitionTypeRequest(in, out)
+}
+
+func autoConvert_impl_SetPartitionTypeRequest_To_v2alpha1_SetPartitionTypeRequest(in *impl.SetPartitionTypeRequest, out *v2alpha1.SetPartitionTypeRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	out.GptType = in.GptType
+	return nil
+}
+
+// Convert_impl_SetPartitionTypeRequest_To_v2alpha1_SetPartitionTypeRequest is an autogenerated conversion function.
+func Convert_impl_SetPartitionTypeRequest_To_v2alpha1_SetPartitionTypeRequest(in *impl.SetPartitionTypeRequest, out *v2alpha1.SetPartitionTypeRequest) error {
+	return autoConvert_impl_SetPartitionTypeRequest_To_v2alpha1_SetPartitionTypeRequest(in, out)
+}
+
+func autoConvert_v2alpha1_SetPartitionTypeResponse_To_impl_SetPartitionTypeResponse(in *v2alpha1.SetPartitionTypeResponse, out *impl.SetPartitionTypeResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_SetPartitionTypeResponse_To_impl_SetPartitionTypeResponse is an autogenerated conversion function.
+func Convert_v2alpha1_SetPartitionTypeResponse_To_impl_SetPartitionTypeResponse(in *v2alpha1.SetPartitionTypeResponse, out *impl.SetPartitionTypeResponse) error {
+	return autoConvert_v2alpha1_SetPartitionTypeResponse_To_impl_SetPartitionTypeResponse(in, out)
+}
+
+func autoConvert_impl_SetPartitionTypeResponse_To_v2alpha1_SetPartitionTypeResponse(in *impl.SetPartitionTypeResponse, out *v2alpha1.SetPartitionTypeResponse) error {
+	return nil
+}
+
+// Convert_impl_SetPartitionTypeResponse_To_v2alpha1_SetPartitionTypeResponse is an autogenerated conversion function.
+func Convert_impl_SetPartitionTypeResponse_To_v2alpha1_SetPartitionTypeResponse(in *impl.SetPartitionTypeResponse, out *v2alpha1.SetPartitionTypeResponse) error {
+	return autoConvert_impl_SetPartitionTypeResponse_To_v2alpha1_SetPartitionTypeResponse(in, out)
+}
+
+func autoConvert_v2alpha1_SetSanPolicyRequest_To_impl_SetSanPolicyRequest(in *v2alpha1.SetSanPolicyRequest, out *impl.SetSanPolicyRequest) error {
+	out.SanPolicy = impl.SanPolicy(in.SanPolicy)
+	return nil
+}
+
+// Convert_v2alpha1_SetSanPolicyRequest_To_impl_SetSanPolicyRequest is an autogenerated conversion function.
+func Convert_v2alpha1_SetSanPolicyRequest_To_impl_SetSanPolicyRequest(in *v2alpha1.SetSanPolicyRequest, out *impl.SetSanPolicyRequest) error {
+	return autoConvert_v2alpha1_SetSanPolicyRequest_To_impl_SetSanPolicyRequest(in, out)
+}
+
+func autoConvert_impl_SetSanPolicyRequest_To_v2alpha1_SetSanPolicyRequest(in *impl.SetSanPolicyRequest, out *v2alpha1.SetSanPolicyRequest) error {
+	out.SanPolicy = v2alpha1.SanPolicy(in.SanPolicy)
+	return nil
+}
+
+// Convert_impl_SetSanPolicyRequest_To_v2alpha1_SetSanPolicyRequest is an autogenerated conversion function.
+func Convert_impl_SetSanPolicyRequest_To_v2alpha1_SetSanPolicyRequest(in *impl.SetSanPolicyRequest, out *v2alpha1.SetSanPolicyRequest) error {
+	return autoConvert_impl_SetSanPolicyRequest_To_v2alpha1_SetSanPolicyRequest(in, out)
+}
+
+func autoConvert_v2alpha1_SetSanPolicyResponse_To_impl_SetSanPolicyResponse(in *v2alpha1.SetSanPolicyResponse, out *impl.SetSanPolicyResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_SetSanPolicyResponse_To_impl_SetSanPolicyResponse is an autogenerated conversion function.
+func Convert_v2alpha1_SetSanPolicyResponse_To_impl_SetSanPolicyResponse(in *v2alpha1.SetSanPolicyResponse, out *impl.SetSanPolicyResponse) error {
+	return autoConvert_v2alpha1_SetSanPolicyResponse_To_impl_SetSanPolicyResponse(in, out)
+}
+
+func autoConvert_impl_SetSanPolicyResponse_To_v2alpha1_SetSanPolicyResponse(in *impl.SetSanPolicyResponse, out *v2alpha1.SetSanPolicyResponse) error {
+	return nil
+}
+
+// Convert_impl_SetSanPolicyResponse_To_v2alpha1_SetSanPolicyResponse is an autogenerated conversion function.
+func Convert_impl_SetSanPolicyResponse_To_v2alpha1_SetSanPolicyResponse(in *impl.SetSanPolicyResponse, out *v2alpha1.SetSanPolicyResponse) error {
+	return autoConvert_impl_SetSanPolicyResponse_To_v2alpha1_SetSanPolicyResponse(in, out)
+}
+
+func autoConvert_v2alpha1_WaitForDiskRequest_To_impl_WaitForDiskRequest(in *v2alpha1.WaitForDiskRequest, out *impl.WaitForDiskRequest) error {
+	out.SerialNumber = in.SerialNumber
+	out.Page83Id = in.Page83Id
+	out.Adapter = in.Adapter
+	out.Bus = in.Bus
+	out.Target = in.Target
+	out.LunId = in.LunId
+	out.TimeoutNanos = in.TimeoutNanos
+	return nil
+}
+
+// Convert_v2alpha1_WaitForDiskRequest_To_impl_WaitForDiskRequest is an autogenerated conversion function.
+func Convert_v2alpha1_WaitForDiskRequest_To_impl_WaitForDiskRequest(in *v2alpha1.WaitForDiskRequest, out *impl.WaitForDiskRequest) error {
+	return autoConvert_v2alpha1_WaitForDiskRequest_To_impl_WaitForDiskRequest(in, out)
+}
+
+func autoConvert_impl_WaitForDiskRequest_To_v2alpha1_WaitForDiskRequest(in *impl.WaitForDiskRequest, out *v2alpha1.WaitForDiskRequest) error {
+	out.SerialNumber = in.SerialNumber
+	out.Page83Id = in.Page83Id
+	out.Adapter = in.Adapter
+	out.Bus = in.Bus
+	out.Target = in.Target
+	out.LunId = in.LunId
+	out.TimeoutNanos = in.TimeoutNanos
+	return nil
+}
+
+// Convert_impl_WaitForDiskRequest_To_v2alpha1_WaitForDiskRequest is an autogenerated conversion function.
+func Convert_impl_WaitForDiskRequest_To_v2alpha1_WaitForDiskRequest(in *impl.WaitForDiskRequest, out *v2alpha1.WaitForDiskRequest) error {
+	return autoConvert_impl_WaitForDiskRequest_To_v2alpha1_WaitForDiskRequest(in, out)
+}
+
+func autoConvert_v2alpha1_WaitForDiskResponse_To_impl_WaitForDiskResponse(in *v2alpha1.WaitForDiskResponse, out *impl.WaitForDiskResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v2alpha1_WaitForDiskResponse_To_impl_WaitForDiskResponse is an autogenerated conversion function.
+func Convert_v2alpha1_WaitForDiskResponse_To_impl_WaitForDiskResponse(in *v2alpha1.WaitForDiskResponse, out *impl.WaitForDiskResponse) error {
+	return autoConvert_v2alpha1_WaitForDiskResponse_To_impl_WaitForDiskResponse(in, out)
+}
+
+func autoConvert_impl_WaitForDiskResponse_To_v2alpha1_WaitForDiskResponse(in *impl.WaitForDiskResponse, out *v2alpha1.WaitForDiskResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_WaitForDiskResponse_To_v2alpha1_WaitForDiskResponse is an autogenerated conversion function.
+func Convert_impl_WaitForDiskResponse_To_v2alpha1_WaitForDiskResponse(in *impl.WaitForDiskResponse, out *v2alpha1.WaitForDiskResponse) error {
+	return autoConvert_impl_WaitForDiskResponse_To_v2alpha1_WaitForDiskResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_WaitForDiskSizeChangeRequest_To_impl_WaitForDiskSizeChangeRequest(in *v2alpha1.WaitForDiskSizeChangeRequest, out *impl.WaitForDiskSizeChangeRequest) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_WaitForDiskSizeChangeRequest_To_v2alpha1_WaitForDiskSizeChangeRequest(in *impl.WaitForDiskSizeChangeRequest, out *v2alpha1.WaitForDiskSizeChangeRequest) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_v2alpha1_WaitForDiskSizeChangeResponse_To_impl_WaitForDiskSizeChangeResponse(in *v2alpha1.WaitForDiskSizeChangeResponse, out *impl.WaitForDiskSizeChangeResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_WaitForDiskSizeChangeResponse_To_v2alpha1_WaitForDiskSizeChangeResponse(in *impl.WaitForDiskSizeChangeResponse, out *v2alpha1.WaitForDiskSizeChangeResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_WipeDiskRequest_To_impl_WipeDiskRequest(in *v2alpha1.WipeDiskRequest, out *impl.WipeDiskRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.RemoveData = in.RemoveData
+	out.ZeroFill = in.ZeroFill
+	return nil
+}
+
+// Convert_v2alpha1_WipeDiskRequest_To_impl_WipeDiskRequest is an autogenerated conversion function.
+func Convert_v2alpha1_WipeDiskRequest_To_impl_WipeDiskRequest(in *v2alpha1.WipeDiskRequest, out *impl.WipeDiskRequest) error {
+	return autoConvert_v2alpha1_WipeDiskRequest_To_impl_WipeDiskRequest(in, out)
+}
+
+func autoConvert_impl_WipeDiskRequest_To_v2alpha1_WipeDiskRequest(in *impl.WipeDiskRequest, out *v2alpha1.WipeDiskRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.RemoveData = in.RemoveData
+	out.ZeroFill = in.ZeroFill
+	return nil
+}
+
+// Convert_impl_WipeDiskRequest_To_v2alpha1_WipeDiskRequest is an autogenerated conversion function.
+func Convert_impl_WipeDiskRequest_To_v2alpha1_WipeDiskRequest(in *impl.WipeDiskRequest, out *v2alpha1.WipeDiskRequest) error {
+	return autoConvert_impl_WipeDiskRequest_To_v2alpha1_WipeDiskRequest(in, out)
+}
+
+func autoConvert_v2alpha1_WipeDiskResponse_To_impl_WipeDiskResponse(in *v2alpha1.WipeDiskResponse, out *impl.WipeDiskResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_WipeDiskResponse_To_impl_WipeDiskResponse is an autogenerated conversion function.
+func Convert_v2alpha1_WipeDiskResponse_To_impl_WipeDiskResponse(in *v2alpha1.WipeDiskResponse, out *impl.WipeDiskResponse) error {
+	return autoConvert_v2alpha1_WipeDiskResponse_To_impl_WipeDiskResponse(in, out)
+}
+
+func autoConvert_impl_WipeDiskResponse_To_v2alpha1_WipeDiskResponse(in *impl.WipeDiskResponse, out *v2alpha1.WipeDiskResponse) error {
+	return nil
+}
+
+// Convert_impl_WipeDiskResponse_To_v2alpha1_WipeDiskResponse is an autogenerated conversion function.
+func Convert_impl_WipeDiskResponse_To_v2alpha1_WipeDiskResponse(in *impl.WipeDiskResponse, out *v2alpha1.WipeDiskResponse) error {
+	return autoConvert_impl_WipeDiskResponse_To_v2alpha1_WipeDiskResponse(in, out)
+}
@@ -0,0 +1,522 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v2alpha1
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/disk/impl"
+	"google.golang.org/grpc"
+)
+
+var version = apiversion.NewVersionOrPanic("v2alpha1")
+
+type versionedAPI struct {
+	apiGroupServer impl.ServerInterface
+}
+
+func NewVersionedServer(apiGroupServer impl.ServerInterface) impl.VersionedAPI {
+	return &versionedAPI{
+		apiGroupServer: apiGroupServer,
+	}
+}
+
+func (s *versionedAPI) Register(grpcServer *grpc.Server) {
+	v2alpha1.RegisterDiskServer(grpcServer, s)
+}
+
+func (s *versionedAPI) ConvertDiskToGPT(context context.Context, versionedRequest *v2alpha1.ConvertDiskToGPTRequest) (*v2alpha1.ConvertDiskToGPTResponse, error) {
+	request := &impl.ConvertDiskToGPTRequest{}
+	if err := Convert_v2alpha1_ConvertDiskToGPTRequest_To_impl_ConvertDiskToGPTRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ConvertDiskToGPT(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ConvertDiskToGPTResponse{}
+	if err := Convert_impl_ConvertDiskToGPTResponse_To_v2alpha1_ConvertDiskToGPTResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) DeletePartition(context context.Context, versionedRequest *v2alpha1.DeletePartitionRequest) (*v2alpha1.DeletePartitionResponse, error) {
+	request := &impl.DeletePartitionRequest{}
+	if err := Convert_v2alpha1_DeletePartitionRequest_To_impl_DeletePartitionRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.DeletePartition(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.DeletePartitionResponse{}
+	if err := Convert_impl_DeletePartitionResponse_To_v2alpha1_DeletePartitionResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskIdentity(context context.Context, versionedRequest *v2alpha1.GetDiskIdentityRequest) (*v2alpha1.GetDiskIdentityResponse, error) {
+	request := &impl.GetDiskIdentityRequest{}
+	if err := Convert_v2alpha1_GetDiskIdentityRequest_To_impl_GetDiskIdentityRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskIdentity(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskIdentityResponse{}
+	if err := Convert_impl_GetDiskIdentityResponse_To_v2alpha1_GetDiskIdentityResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskNumberByLocation(context context.Context, versionedRequest *v2alpha1.GetDiskNumberByLocationRequest) (*v2alpha1.GetDiskNumberByLocationResponse, error) {
+	request := &impl.GetDiskNumberByLocationRequest{}
+	if err := Convert_v2alpha1_GetDiskNumberByLocationRequest_To_impl_GetDiskNumberByLocationRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskNumberByLocation(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskNumberByLocationResponse{}
+	if err := Convert_impl_GetDiskNumberByLocationResponse_To_v2alpha1_GetDiskNumberByLocationResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskNumberByPage83ID(context context.Context, versionedRequest *v2alpha1.GetDiskNumberByPage83IDRequest) (*v2alpha1.GetDiskNumberByPage83IDResponse, error) {
+	request := &impl.GetDiskNumberByPage83IDRequest{}
+	if err := Convert_v2alpha1_GetDiskNumberByPage83IDRequest_To_impl_GetDiskNumberByPage83IDRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskNumberByPage83ID(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskNumberByPage83IDResponse{}
+	if err := Convert_impl_GetDiskNumberByPage83IDResponse_To_v2alpha1_GetDiskNumberByPage83IDResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskNumberBySerialNumber(context context.Context, versionedRequest *v2alpha1.GetDiskNumberBySerialNumberRequest) (*v2alpha1.GetDiskNumberBySerialNumberResponse, error) {
+	request := &impl.GetDiskNumberBySerialNumberRequest{}
+	if err := Convert_v2alpha1_GetDiskNumberBySerialNumberRequest_To_impl_GetDiskNumberBySerialNumberRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskNumberBySerialNumber(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskNumberBySerialNumberResponse{}
+	if err := Convert_impl_GetDiskNumberBySerialNumberResponse_To_v2alpha1_GetDiskNumberBySerialNumberResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskReadOnly(context context.Context, versionedRequest *v2alpha1.GetDiskReadOnlyRequest) (*v2alpha1.GetDiskReadOnlyResponse, error) {
+	request := &impl.GetDiskReadOnlyRequest{}
+	if err := Convert_v2alpha1_GetDiskReadOnlyRequest_To_impl_GetDiskReadOnlyRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskReadOnly(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskReadOnlyResponse{}
+	if err := Convert_impl_GetDiskReadOnlyResponse_To_v2alpha1_GetDiskReadOnlyResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskReliabilityCounters(context context.Context, versionedRequest *v2alpha1.GetDiskReliabilityCountersRequest) (*v2alpha1.GetDiskReliabilityCountersResponse, error) {
+	request := &impl.GetDiskReliabilityCountersRequest{}
+	if err := Convert_v2alpha1_GetDiskReliabilityCountersRequest_To_impl_GetDiskReliabilityCountersRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskReliabilityCounters(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskReliabilityCountersResponse{}
+	if err := Convert_impl_GetDiskReliabilityCountersResponse_To_v2alpha1_GetDiskReliabilityCountersResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskState(context context.Context, versionedRequest *v2alpha1.GetDiskStateRequest) (*v2alpha1.GetDiskStateResponse, error) {
+	request := &impl.GetDiskStateRequest{}
+	if err := Convert_v2alpha1_GetDiskStateRequest_To_impl_GetDiskStateRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskState(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskStateResponse{}
+	if err := Convert_impl_GetDiskStateResponse_To_v2alpha1_GetDiskStateResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetDiskStats(context context.Context, versionedRequest *v2alpha1.GetDiskStatsRequest) (*v2alpha1.GetDiskStatsResponse, error) {
+	request := &impl.GetDiskStatsRequest{}
+	if err := Convert_v2alpha1_GetDiskStatsRequest_To_impl_GetDiskStatsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDiskStats(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDiskStatsResponse{}
+	if err := Convert_impl_GetDiskStatsResponse_To_v2alpha1_GetDiskStatsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetFreeDiskLocations(context context.Context, versionedRequest *v2alpha1.GetFreeDiskLocationsRequest) (*v2alpha1.GetFreeDiskLocationsResponse, error) {
+	request := &impl.GetFreeDiskLocationsRequest{}
+	if err := Convert_v2alpha1_GetFreeDiskLocationsRequest_To_impl_GetFreeDiskLocationsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetFreeDiskLocations(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetFreeDiskLocationsResponse{}
+	if err := Convert_impl_GetFreeDiskLocationsResponse_To_v2alpha1_GetFreeDiskLocationsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetSanPolicy(context context.Context, versionedRequest *v2alpha1.GetSanPolicyRequest) (*v2alpha1.GetSanPolicyResponse, error) {
+	request := &impl.GetSanPolicyRequest{}
+	if err := Convert_v2alpha1_GetSanPolicyRequest_To_impl_GetSanPolicyRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetSanPolicy(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetSanPolicyResponse{}
+	if err := Convert_impl_GetSanPolicyResponse_To_v2alpha1_GetSanPolicyResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListDiskIDs(context context.Context, versionedRequest *v2alpha1.ListDiskIDsRequest) (*v2alpha1.ListDiskIDsResponse, error) {
+	request := &impl.ListDiskIDsRequest{}
+	if err := Convert_v2alpha1_ListDiskIDsRequest_To_impl_ListDiskIDsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListDiskIDs(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ListDiskIDsResponse{}
+	if err := Convert_impl_ListDiskIDsResponse_To_v2alpha1_ListDiskIDsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListDiskLocations(context context.Context, versionedRequest *v2alpha1.ListDiskLocationsRequest) (*v2alpha1.ListDiskLocationsResponse, error) {
+	request := &impl.ListDiskLocationsRequest{}
+	if err := Convert_v2alpha1_ListDiskLocationsRequest_To_impl_ListDiskLocationsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListDiskLocations(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ListDiskLocationsResponse{}
+	if err := Convert_impl_ListDiskLocationsResponse_To_v2alpha1_ListDiskLocationsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListDiskPage83IDs(context context.Context, versionedRequest *v2alpha1.ListDiskPage83IDsRequest) (*v2alpha1.ListDiskPage83IDsResponse, error) {
+	request := &impl.ListDiskPage83IDsRequest{}
+	if err := Convert_v2alpha1_ListDiskPage83IDsRequest_To_impl_ListDiskPage83IDsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListDiskPage83IDs(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ListDiskPage83IDsResponse{}
+	if err := Convert_impl_ListDiskPage83IDsResponse_To_v2alpha1_ListDiskPage83IDsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListDisks(context context.Context, versionedRequest *v2alpha1.ListDisksRequest) (*v2alpha1.ListDisksResponse, error) {
+	request := &impl.ListDisksRequest{}
+	if err := Convert_v2alpha1_ListDisksRequest_To_impl_ListDisksRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListDisks(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ListDisksResponse{}
+	if err := Convert_impl_ListDisksResponse_To_v2alpha1_ListDisksResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) PartitionDisk(context context.Context, versionedRequest *v2alpha1.PartitionDiskRequest) (*v2alpha1.PartitionDiskResponse, error) {
+	request := &impl.PartitionDiskRequest{}
+	if err := Convert_v2alpha1_PartitionDiskRequest_To_impl_PartitionDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.PartitionDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.PartitionDiskResponse{}
+	if err := Convert_impl_PartitionDiskResponse_To_v2alpha1_PartitionDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) Rescan(context context.Context, versionedRequest *v2alpha1.RescanRequest) (*v2alpha1.RescanResponse, error) {
+	request := &impl.RescanRequest{}
+	if err := Convert_v2alpha1_RescanRequest_To_impl_RescanRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.Rescan(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.RescanResponse{}
+	if err := Convert_impl_RescanResponse_To_v2alpha1_RescanResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ResolveDiskIdentity(context context.Context, versionedRequest *v2alpha1.ResolveDiskIdentityRequest) (*v2alpha1.ResolveDiskIdentityResponse, error) {
+	request := &impl.ResolveDiskIdentityRequest{}
+	if err := Convert_v2alpha1_ResolveDiskIdentityRequest_To_impl_ResolveDiskIdentityRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ResolveDiskIdentity(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ResolveDiskIdentityResponse{}
+	if err := Convert_impl_ResolveDiskIdentityResponse_To_v2alpha1_ResolveDiskIdentityResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) SetDiskReadOnly(context context.Context, versionedRequest *v2alpha1.SetDiskReadOnlyRequest) (*v2alpha1.SetDiskReadOnlyResponse, error) {
+	request := &impl.SetDiskReadOnlyRequest{}
+	if err := Convert_v2alpha1_SetDiskReadOnlyRequest_To_impl_SetDiskReadOnlyRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.SetDiskReadOnly(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.SetDiskReadOnlyResponse{}
+	if err := Convert_impl_SetDiskReadOnlyResponse_To_v2alpha1_SetDiskReadOnlyResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) SetDiskState(context context.Context, versionedRequest *v2alpha1.SetDiskStateRequest) (*v2alpha1.SetDiskStateResponse, error) {
+	request := &impl.SetDiskStateRequest{}
+	if err := Convert_v2alpha1_SetDiskStateRequest_To_impl_SetDiskStateRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.SetDiskState(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.SetDiskStateResponse{}
+	if err := Convert_impl_SetDiskStateResponse_To_v2alpha1_SetDiskStateResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) SetPartitionType(context context.Context, versionedRequest *v2alpha1.SetPartitionTypeRequest) (*v2alpha1.SetPartitionTypeResponse, error) {
+	request := &impl.SetPartitionTypeRequest{}
+	if err := Convert_v2alpha1_SetPartitionTypeRequest_To_impl_SetPartitionTypeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.SetPartitionType(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.SetPartitionTypeResponse{}
+	if err := Convert_impl_SetPartitionTypeResponse_To_v2alpha1_SetPartitionTypeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) SetSanPolicy(context context.Context, versionedRequest *v2alpha1.SetSanPolicyRequest) (*v2alpha1.SetSanPolicyResponse, error) {
+	request := &impl.SetSanPolicyRequest{}
+	if err := Convert_v2alpha1_SetSanPolicyRequest_To_impl_SetSanPolicyRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.SetSanPolicy(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.SetSanPolicyResponse{}
+	if err := Convert_impl_SetSanPolicyResponse_To_v2alpha1_SetSanPolicyResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) WaitForDisk(context context.Context, versionedRequest *v2alpha1.WaitForDiskRequest) (*v2alpha1.WaitForDiskResponse, error) {
+	request := &impl.WaitForDiskRequest{}
+	if err := Convert_v2alpha1_WaitForDiskRequest_To_impl_WaitForDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.WaitForDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.WaitForDiskResponse{}
+	if err := Convert_impl_WaitForDiskResponse_To_v2alpha1_WaitForDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) WaitForDiskSizeChange(context context.Context, versionedRequest *v2alpha1.WaitForDiskSizeChangeRequest) (*v2alpha1.WaitForDiskSizeChangeResponse, error) {
+	request := &impl.WaitForDiskSizeChangeRequest{}
+	if err := Convert_v2alpha1_WaitForDiskSizeChangeRequest_To_impl_WaitForDiskSizeChangeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.WaitForDiskSizeChange(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.WaitForDiskSizeChangeResponse{}
+	if err := Convert_impl_WaitForDiskSizeChangeResponse_To_v2alpha1_WaitForDiskSizeChangeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) WipeDisk(context context.Context, versionedRequest *v2alpha1.WipeDiskRequest) (*v2alpha1.WipeDiskResponse, error) {
+	request := &impl.WipeDiskRequest{}
+	if err := Convert_v2alpha1_WipeDiskRequest_To_impl_WipeDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.WipeDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.WipeDiskResponse{}
+	if err := Convert_impl_WipeDiskResponse_To_v2alpha1_WipeDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
@@ -2,62 +2,335 @@ package disk
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/disk"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/disk/impl"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/klog/v2"
 )
 
 type Server struct {
 	hostAPI disk.API
+
+	// diskPolicy restricts the disks that can be initialized and partitioned.
+	diskPolicy shared.DiskPolicy
 }
 
 // check that Server implements internal.ServerInterface
 var _ internal.ServerInterface = &Server{}
 
-func NewServer(hostAPI disk.API) (*Server, error) {
+// NewServer creates a disk server, only the disks allowed by `diskPolicy` are
+// initialized and partitioned.
+func NewServer(diskPolicy shared.DiskPolicy, hostAPI disk.API) (*Server, error) {
 	return &Server{
-		hostAPI: hostAPI,
+		hostAPI:    hostAPI,
+		diskPolicy: diskPolicy,
 	}, nil
 }
 
+// WarmUp lists the disks, it loads the Storage module and the storage WMI provider.
+func (s *Server) WarmUp() error {
+	_, err := s.hostAPI.ListDisks()
+	return err
+}
+
 func (s *Server) ListDiskLocations(context context.Context, request *internal.ListDiskLocationsRequest, version apiversion.Version) (*internal.ListDiskLocationsResponse, error) {
 	klog.V(2).Infof("Request: ListDiskLocations: %+v", request)
 	response := &internal.ListDiskLocationsResponse{}
-	m, err := s.hostAPI.ListDiskLocations()
+	diskNumbers, nextPageToken, err := s.selectDisks(request.Filter, request.MaxResults, request.PageToken)
 	if err != nil {
 		klog.Errorf("ListDiskLocations failed: %v", err)
 		return response, err
 	}
+	response.NextPageToken = nextPageToken
 
 	response.DiskLocations = make(map[uint32]*internal.DiskLocation)
+	if diskNumbers != nil && len(diskNumbers) == 0 {
+		return response, nil
+	}
+	m, err := s.hostAPI.ListDiskLocations(diskNumbers)
+	if err != nil {
+		klog.Errorf("ListDiskLocations failed: %v", err)
+		return response, err
+	}
+
 	for k, v := range m {
 		d := &internal.DiskLocation{}
 		d.Adapter = v.Adapter
 		d.Bus = v.Bus
 		d.Target = v.Target
 		d.LUNID = v.LUNID
+		d.SerialNumber = v.SerialNumber
+		klog.V(4).Infof("ListDiskLocations: disk %d serial number %q location %+v", k, v.SerialNumber, v)
 		response.DiskLocations[k] = d
 	}
 	return response, nil
 }
 
+// matchesLocationField returns true if the field `value` of a disk location is
+// `expected`, every value matches an empty `expected`.
+func matchesLocationField(value, expected string) bool {
+	return expected == "" || strings.TrimSpace(value) == strings.TrimSpace(expected)
+}
+
+func (s *Server) GetDiskNumberByLocation(context context.Context, request *internal.GetDiskNumberByLocationRequest, version apiversion.Version) (*internal.GetDiskNumberByLocationResponse, error) {
+	klog.V(2).Infof("Request: GetDiskNumberByLocation: %+v", request)
+	if request.LunId == "" {
+		klog.Errorf("lun id empty")
+		return nil, fmt.Errorf("GetDiskNumberByLocationRequest.LunId is empty")
+	}
+
+	m, err := s.hostAPI.ListDiskLocations(nil)
+	if err != nil {
+		klog.Errorf("GetDiskNumberByLocation failed: %v", err)
+		return nil, err
+	}
+	matches := []uint32{}
+	for diskNumber, l := range m {
+		if matchesLocationField(l.Adapter, request.Adapter) && matchesLocationField(l.Bus, request.Bus) &&
+			matchesLocationField(l.Target, request.Target) && matchesLocationField(l.LUNID, request.LunId) {
+			matches = append(matches, diskNumber)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, status.Errorf(codes.NotFound, "no disk at adapter %q bus %q target %q LUN %q", request.Adapter, request.Bus, request.Target, request.LunId)
+	case 1:
+		return &internal.GetDiskNumberByLocationResponse{DiskNumber: matches[0]}, nil
+	default:
+		// e.g. only the LUN was set and several targets have disks at that LUN
+		sort.Slice(matches, func(i, j int) bool { return matches[i] < matches[j] })
+		klog.Errorf("disks %v are at adapter %q bus %q target %q LUN %q", matches, request.Adapter, request.Bus, request.Target, request.LunId)
+		return nil, fmt.Errorf("disks %v are at adapter %q bus %q target %q LUN %q, the location must match a single disk", matches, request.Adapter, request.Bus, request.Target, request.LunId)
+	}
+}
+
+const (
+	// defaultMaxLuns is the number of LUNs of Hyper-V SCSI controllers.
+	defaultMaxLuns = 64
+	// maxLuns is the maximum number of LUNs of a SCSI target in Windows.
+	maxLuns = 255
+)
+
+func (s *Server) GetFreeDiskLocations(context context.Context, request *internal.GetFreeDiskLocationsRequest, version apiversion.Version) (*internal.GetFreeDiskLocationsResponse, error) {
+	klog.V(2).Infof("Request: GetFreeDiskLocations: %+v", request)
+	response := &internal.GetFreeDiskLocationsResponse{}
+	luns := request.MaxLuns
+	if luns == 0 {
+		luns = defaultMaxLuns
+	}
+	if luns > maxLuns {
+		return response, fmt.Errorf("max LUNs %d is greater than the maximum number of LUNs of a target %d", luns, maxLuns)
+	}
+
+	m, err := s.hostAPI.ListDiskLocations(nil)
+	if err != nil {
+		klog.Errorf("GetFreeDiskLocations failed: %v", err)
+		return response, err
+	}
+
+	// LUNs used by the disks of each adapter and target
+	type adapterTarget struct {
+		adapter, target string
+	}
+	used := make(map[adapterTarget]map[uint64]bool)
+	for diskNumber, location := range m {
+		key := adapterTarget{adapter: location.Adapter, target: location.Target}
+		if used[key] == nil {
+			used[key] = make(map[uint64]bool)
+		}
+		lun, err := strconv.ParseUint(location.LUNID, 10, 32)
+		if err != nil {
+			klog.Warningf("Ignoring disk %d with invalid LUN %q", diskNumber, location.LUNID)
+			continue
+		}
+		used[key][lun] = true
+	}
+
+	for key, usedLuns := range used {
+		location := &internal.FreeDiskLocation{
+			Adapter:    key.adapter,
+			Target:     key.target,
+			FreeLunIds: []uint32{},
+		}
+		for lun := uint32(0); lun < luns; lun++ {
+			if !usedLuns[uint64(lun)] {
+				location.FreeLunIds = append(location.FreeLunIds, lun)
+			}
+		}
+		response.Locations = append(response.Locations, location)
+	}
+	sort.Slice(response.Locations, func(i, j int) bool {
+		a, b := response.Locations[i], response.Locations[j]
+		if a.Adapter != b.Adapter {
+			return lessNumeric(a.Adapter, b.Adapter)
+		}
+		return lessNumeric(a.Target, b.Target)
+	})
+	return response, nil
+}
+
+// lessNumeric compares the numbers `a` and `b`, falling back to comparing them
+// as strings if they aren't numbers.
+func lessNumeric(a, b string) bool {
+	x, errA := strconv.ParseUint(a, 10, 64)
+	y, errB := strconv.ParseUint(b, 10, 64)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return x < y
+}
+
+// selectDisks returns the numbers of the disks that match `filter` in the page
+// that starts at `pageToken` (with at most `maxResults` disks) and the token of
+// the next page. A nil slice is returned when the request doesn't filter or page
+// the disks i.e. all the disks are listed.
+func (s *Server) selectDisks(filter *internal.DiskFilter, maxResults uint32, pageToken string) ([]uint32, string, error) {
+	if filter == nil && maxResults == 0 && pageToken == "" {
+		return nil, "", nil
+	}
+	disks, nextPageToken, err := s.listDisks(filter, maxResults, pageToken)
+	if err != nil {
+		return nil, "", err
+	}
+	diskNumbers := []uint32{}
+	for _, d := range disks {
+		diskNumbers = append(diskNumbers, d.Number)
+	}
+	return diskNumbers, nextPageToken, nil
+}
+
+// listDisks returns the disks that match `filter` in the page that starts at
+// `pageToken` (with at most `maxResults` disks) and the token of the next page.
+// The disks are paged in disk number order, the page token is the number of the
+// first disk of the page.
+func (s *Server) listDisks(filter *internal.DiskFilter, maxResults uint32, pageToken string) ([]shared.DiskInfo, string, error) {
+	var start uint64
+	if pageToken != "" {
+		var err error
+		start, err = strconv.ParseUint(pageToken, 10, 32)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid page token %q: %w", pageToken, err)
+		}
+	}
+	if filter != nil {
+		if err := utils.ValidateByteSize("DiskFilter.MinSizeBytes", filter.MinSizeBytes); err != nil {
+			return nil, "", err
+		}
+		if err := utils.ValidateByteSize("DiskFilter.MaxSizeBytes", filter.MaxSizeBytes); err != nil {
+			return nil, "", err
+		}
+	}
+
+	disks, err := s.hostAPI.ListDisks()
+	if err != nil {
+		return nil, "", err
+	}
+	sort.Slice(disks, func(i, j int) bool {
+		return disks[i].Number < disks[j].Number
+	})
+
+	selected := []shared.DiskInfo{}
+	for _, d := range disks {
+		if uint64(d.Number) < start || !matchesDiskFilter(d, filter) {
+			continue
+		}
+		if maxResults > 0 && len(selected) == int(maxResults) {
+			return selected, strconv.FormatUint(uint64(d.Number), 10), nil
+		}
+		selected = append(selected, d)
+	}
+	return selected, "", nil
+}
+
+func matchesDiskFilter(d shared.DiskInfo, filter *internal.DiskFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.BusType != "" && !strings.EqualFold(filter.BusType, d.BusType) {
+		return false
+	}
+	if filter.MinSizeBytes > 0 && d.Size < filter.MinSizeBytes {
+		return false
+	}
+	if filter.MaxSizeBytes > 0 && d.Size > filter.MaxSizeBytes {
+		return false
+	}
+	if filter.UninitializedOnly && !strings.EqualFold(d.PartitionStyle, "RAW") {
+		return false
+	}
+	return true
+}
+
+// partitionStyles maps the partition styles of the API to the partition styles of Initialize-Disk.
+var partitionStyles = map[internal.PartitionStyle]disk.PartitionStyle{
+	internal.PARTITION_STYLE_GPT: disk.PartitionStyleGPT,
+	internal.PARTITION_STYLE_MBR: disk.PartitionStyleMBR,
+}
+
+// gptTypeRegexp matches the GPT partition types e.g. {ebd0a0a2-b9e5-4433-87c0-68b6b72699c7}
+var gptTypeRegexp = regexp.MustCompile(`^\{[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}\}$`)
+
 func (s *Server) PartitionDisk(context context.Context, request *internal.PartitionDiskRequest, version apiversion.Version) (*internal.PartitionDiskResponse, error) {
 	klog.V(2).Infof("Request: PartitionDisk with diskNumber=%d", request.DiskNumber)
 	response := &internal.PartitionDiskResponse{}
 	diskNumber := request.DiskNumber
 
+	style, ok := partitionStyles[request.PartitionStyle]
+	if !ok {
+		klog.Errorf("invalid partition style %d", request.PartitionStyle)
+		return response, fmt.Errorf("invalid partition style %d", request.PartitionStyle)
+	}
+	if err := utils.ValidateByteSize("PartitionDiskRequest.SizeBytes", request.SizeBytes); err != nil {
+		klog.Errorf("failed PartitionDisk %v", err)
+		return response, err
+	}
+	if err := utils.ValidateByteSize("PartitionDiskRequest.OffsetBytes", request.OffsetBytes); err != nil {
+		klog.Errorf("failed PartitionDisk %v", err)
+		return response, err
+	}
+	if request.GptType != "" {
+		if !gptTypeRegexp.MatchString(request.GptType) {
+			klog.Errorf("invalid GPT type %q", request.GptType)
+			return response, fmt.Errorf("invalid GPT type %q", request.GptType)
+		}
+		if style != disk.PartitionStyleGPT {
+			klog.Errorf("GPT type %s set for the %s partition style", request.GptType, style)
+			return response, fmt.Errorf("GPT type %s set for the %s partition style", request.GptType, style)
+		}
+	}
+
+	if request.BringOnline {
+		if err := s.bringDiskOnline(diskNumber); err != nil {
+			klog.Errorf("failed BringDiskOnline %v", err)
+			return response, err
+		}
+	}
+
 	initialized, err := s.hostAPI.IsDiskInitialized(diskNumber)
 	if err != nil {
 		klog.Errorf("IsDiskInitialized failed: %v", err)
 		return response, err
 	}
 	if !initialized {
-		klog.V(4).Infof("Initializing disk %d", diskNumber)
-		err = s.hostAPI.InitializeDisk(diskNumber)
+		if err := s.checkDiskPolicy(diskNumber); err != nil {
+			klog.Errorf("failed InitializeDisk %v", err)
+			return response, err
+		}
+		klog.V(4).Infof("Initializing disk %d with the %s partition style", diskNumber, style)
+		err = s.hostAPI.InitializeDisk(diskNumber, style)
 		if err != nil {
 			klog.Errorf("failed InitializeDisk %v", err)
 			return response, err
@@ -73,8 +346,12 @@ func (s *Server) PartitionDisk(context context.Context, request *internal.Partit
 		return response, err
 	}
 	if !partitioned {
+		if err := s.checkDiskPolicy(diskNumber); err != nil {
+			klog.Errorf("failed CreateBasicPartition %v", err)
+			return response, err
+		}
 		klog.V(4).Infof("Creating basic partition on disk %d", diskNumber)
-		err = s.hostAPI.CreateBasicPartition(diskNumber)
+		err = s.hostAPI.CreateBasicPartition(diskNumber, request.SizeBytes, request.OffsetBytes, request.GptType)
 		if err != nil {
 			klog.Errorf("failed CreateBasicPartition %v", err)
 			return response, err
@@ -85,6 +362,181 @@ func (s *Server) PartitionDisk(context context.Context, request *internal.Partit
 	return response, nil
 }
 
+func (s *Server) SetPartitionType(context context.Context, request *internal.SetPartitionTypeRequest, version apiversion.Version) (*internal.SetPartitionTypeResponse, error) {
+	klog.V(2).Infof("Request: SetPartitionType with diskNumber=%d partitionNumber=%d gptType=%s", request.DiskNumber, request.PartitionNumber, request.GptType)
+	response := &internal.SetPartitionTypeResponse{}
+	diskNumber := request.DiskNumber
+
+	if request.PartitionNumber == 0 {
+		klog.Errorf("partition number empty")
+		return response, fmt.Errorf("partition number empty")
+	}
+	if !gptTypeRegexp.MatchString(request.GptType) {
+		klog.Errorf("invalid GPT type %q", request.GptType)
+		return response, fmt.Errorf("invalid GPT type %q", request.GptType)
+	}
+	if err := s.checkDiskPolicy(diskNumber); err != nil {
+		klog.Errorf("failed SetPartitionType %v", err)
+		return response, err
+	}
+	if err := s.hostAPI.SetPartitionType(diskNumber, request.PartitionNumber, request.GptType); err != nil {
+		klog.Errorf("failed SetPartitionType %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) DeletePartition(context context.Context, request *internal.DeletePartitionRequest, version apiversion.Version) (*internal.DeletePartitionResponse, error) {
+	klog.V(2).Infof("Request: DeletePartition with diskNumber=%d partitionNumber=%d", request.DiskNumber, request.PartitionNumber)
+	response := &internal.DeletePartitionResponse{}
+	diskNumber := request.DiskNumber
+
+	if err := s.checkDiskPolicy(diskNumber); err != nil {
+		klog.Errorf("failed DeletePartition %v", err)
+		return response, err
+	}
+	if request.PartitionNumber == 0 {
+		klog.V(4).Infof("Deleting all the partitions of disk %d", diskNumber)
+	} else {
+		klog.V(4).Infof("Deleting partition %d of disk %d", request.PartitionNumber, diskNumber)
+	}
+	if err := s.hostAPI.DeletePartition(diskNumber, request.PartitionNumber); err != nil {
+		klog.Errorf("failed DeletePartition %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) WipeDisk(context context.Context, request *internal.WipeDiskRequest, version apiversion.Version) (*internal.WipeDiskResponse, error) {
+	klog.V(2).Infof("Request: WipeDisk with diskNumber=%d removeData=%t zeroFill=%t", request.DiskNumber, request.RemoveData, request.ZeroFill)
+	response := &internal.WipeDiskResponse{}
+	diskNumber := request.DiskNumber
+
+	if err := s.checkDiskPolicy(diskNumber); err != nil {
+		klog.Errorf("failed WipeDisk %v", err)
+		return response, err
+	}
+	initialized, err := s.hostAPI.IsDiskInitialized(diskNumber)
+	if err != nil {
+		klog.Errorf("IsDiskInitialized failed: %v", err)
+		return response, err
+	}
+	if !initialized {
+		if !request.ZeroFill {
+			klog.V(4).Infof("Disk %d isn't initialized, it's already clear", diskNumber)
+			return response, nil
+		}
+		// Clear-Disk only clears initialized disks, initialize it to zero fill it
+		klog.V(4).Infof("Initializing disk %d to zero fill it", diskNumber)
+		if err := s.hostAPI.InitializeDisk(diskNumber, disk.PartitionStyleGPT); err != nil {
+			klog.Errorf("failed InitializeDisk %v", err)
+			return response, err
+		}
+	}
+	klog.V(4).Infof("Wiping disk %d", diskNumber)
+	if err := s.hostAPI.WipeDisk(diskNumber, request.RemoveData, request.ZeroFill); err != nil {
+		klog.Errorf("failed WipeDisk %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) ConvertDiskToGPT(context context.Context, request *internal.ConvertDiskToGPTRequest, version apiversion.Version) (*internal.ConvertDiskToGPTResponse, error) {
+	klog.V(2).Infof("Request: ConvertDiskToGPT with diskNumber=%d", request.DiskNumber)
+	response := &internal.ConvertDiskToGPTResponse{}
+	diskNumber := request.DiskNumber
+
+	disks, err := s.hostAPI.ListDisks()
+	if err != nil {
+		klog.Errorf("failed ListDisks %v", err)
+		return response, err
+	}
+	var info *shared.DiskInfo
+	for i := range disks {
+		if disks[i].Number == diskNumber {
+			info = &disks[i]
+			break
+		}
+	}
+	if info == nil {
+		klog.Errorf("disk %d not found", diskNumber)
+		return response, status.Errorf(codes.NotFound, "disk %d not found", diskNumber)
+	}
+	if err := s.checkDiskInfoPolicy(*info); err != nil {
+		klog.Errorf("failed ConvertDiskToGPT %v", err)
+		return response, err
+	}
+	switch {
+	case strings.EqualFold(info.PartitionStyle, string(disk.PartitionStyleGPT)):
+		klog.V(4).Infof("Disk %d is already GPT", diskNumber)
+		return response, nil
+	case !strings.EqualFold(info.PartitionStyle, string(disk.PartitionStyleMBR)):
+		klog.Errorf("disk %d isn't initialized", diskNumber)
+		return response, status.Errorf(codes.FailedPrecondition, "disk %d isn't initialized (partition style %s), it can be initialized as GPT with PartitionDisk", diskNumber, info.PartitionStyle)
+	}
+
+	// converting the partition style of a disk with partitions would lose their data
+	partitioned, err := s.hostAPI.BasicPartitionsExist(diskNumber)
+	if err != nil {
+		klog.Errorf("failed check BasicPartitionsExist %v", err)
+		return response, err
+	}
+	if partitioned {
+		klog.Errorf("disk %d has partitions", diskNumber)
+		return response, status.Errorf(codes.FailedPrecondition, "disk %d has partitions, only empty MBR disks can be converted to GPT", diskNumber)
+	}
+	klog.V(4).Infof("Converting disk %d to GPT", diskNumber)
+	if err := s.hostAPI.ConvertPartitionStyle(diskNumber, disk.PartitionStyleGPT); err != nil {
+		klog.Errorf("failed ConvertDiskToGPT %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+// bringDiskOnline brings the disk `diskNumber` left offline by the SAN policy
+// online, shared disks must be brought online with SetDiskState to reserve them.
+func (s *Server) bringDiskOnline(diskNumber uint32) error {
+	isClustered, err := s.hostAPI.IsDiskClustered(diskNumber)
+	if err != nil {
+		return err
+	}
+	if isClustered {
+		return fmt.Errorf("disk %d is a shared disk, it must be brought online with SetDiskState", diskNumber)
+	}
+	if err := s.checkDiskPolicy(diskNumber); err != nil {
+		return err
+	}
+	klog.V(4).Infof("Bringing disk %d online", diskNumber)
+	return s.hostAPI.BringDiskOnline(diskNumber)
+}
+
+// checkDiskPolicy returns an error if the disk policy doesn't allow writing to
+// the disk `diskNumber`.
+func (s *Server) checkDiskPolicy(diskNumber uint32) error {
+	if !s.diskPolicy.Restricted() {
+		return nil
+	}
+	disks, err := s.hostAPI.ListDisks()
+	if err != nil {
+		return err
+	}
+	for _, d := range disks {
+		if d.Number == diskNumber {
+			return s.checkDiskInfoPolicy(d)
+		}
+	}
+	return fmt.Errorf("disk %d not found", diskNumber)
+}
+
+// checkDiskInfoPolicy returns an error if the disk policy doesn't allow writing
+// to the listed disk `d`.
+func (s *Server) checkDiskInfoPolicy(d shared.DiskInfo) error {
+	if err := s.diskPolicy.Check(d); err != nil {
+		return fmt.Errorf("the disk policy doesn't allow writing to disk %d: %v", d.Number, err)
+	}
+	return nil
+}
+
 func (s *Server) Rescan(context context.Context, request *internal.RescanRequest, version apiversion.Version) (*internal.RescanResponse, error) {
 	klog.V(2).Infof("Request: Rescan")
 	response := &internal.RescanResponse{}
@@ -116,12 +568,21 @@ func (s *Server) ListDiskIDs(context context.Context, request *internal.ListDisk
 		return nil, fmt.Errorf("ListDiskIDs requires CSI-Proxy API version v1beta1 or greater")
 	}
 
-	diskIDs, err := s.hostAPI.ListDiskIDs()
+	diskNumbers, nextPageToken, err := s.selectDisks(request.Filter, request.MaxResults, request.PageToken)
 	if err != nil {
 		klog.Errorf("ListDiskIDs failed: %v", err)
 		return nil, err
 	}
 
+	diskIDs := map[uint32]shared.DiskIDs{}
+	if diskNumbers == nil || len(diskNumbers) > 0 {
+		diskIDs, err = s.hostAPI.ListDiskIDs(diskNumbers)
+		if err != nil {
+			klog.Errorf("ListDiskIDs failed: %v", err)
+			return nil, err
+		}
+	}
+
 	// Convert from shared to internal type
 	responseDiskIDs := make(map[uint32]*internal.DiskIDs)
 	for k, v := range diskIDs {
@@ -130,7 +591,62 @@ func (s *Server) ListDiskIDs(context context.Context, request *internal.ListDisk
 			SerialNumber: v.SerialNumber,
 		}
 	}
-	response := &internal.ListDiskIDsResponse{DiskIDs: responseDiskIDs}
+	response := &internal.ListDiskIDsResponse{DiskIDs: responseDiskIDs, NextPageToken: nextPageToken}
+	klog.V(5).Infof("Response=%v", response)
+	return response, nil
+}
+
+func (s *Server) ListDiskPage83IDs(context context.Context, request *internal.ListDiskPage83IDsRequest, version apiversion.Version) (*internal.ListDiskPage83IDsResponse, error) {
+	klog.V(4).Infof("Request: ListDiskPage83IDs")
+	diskNumbers, nextPageToken, err := s.selectDisks(request.Filter, request.MaxResults, request.PageToken)
+	if err != nil {
+		klog.Errorf("ListDiskPage83IDs failed: %v", err)
+		return nil, err
+	}
+
+	diskIDs := map[uint32][]shared.Page83ID{}
+	if diskNumbers == nil || len(diskNumbers) > 0 {
+		diskIDs, err = s.hostAPI.ListDiskPage83IDs(diskNumbers)
+		if err != nil {
+			klog.Errorf("ListDiskPage83IDs failed: %v", err)
+			return nil, err
+		}
+	}
+
+	response := &internal.ListDiskPage83IDsResponse{DiskPage83IDs: map[uint32]*internal.DiskPage83IDs{}, NextPageToken: nextPageToken}
+	for diskNumber, ids := range diskIDs {
+		page83IDs := &internal.DiskPage83IDs{Ids: []*internal.Page83ID{}}
+		for _, id := range ids {
+			page83IDs.Ids = append(page83IDs.Ids, &internal.Page83ID{Type: id.Type, Association: id.Association, Value: id.Value})
+		}
+		response.DiskPage83IDs[diskNumber] = page83IDs
+	}
+	klog.V(5).Infof("Response=%v", response)
+	return response, nil
+}
+
+func (s *Server) ListDisks(context context.Context, request *internal.ListDisksRequest, version apiversion.Version) (*internal.ListDisksResponse, error) {
+	klog.V(4).Infof("Request: ListDisks: %+v", request)
+	disks, nextPageToken, err := s.listDisks(request.Filter, request.MaxResults, request.PageToken)
+	if err != nil {
+		klog.Errorf("ListDisks failed: %v", err)
+		return nil, err
+	}
+
+	response := &internal.ListDisksResponse{Disks: []*internal.DiskInfo{}, NextPageToken: nextPageToken}
+	for _, d := range disks {
+		response.Disks = append(response.Disks, &internal.DiskInfo{
+			DiskNumber:     d.Number,
+			FriendlyName:   d.FriendlyName,
+			SerialNumber:   d.SerialNumber,
+			SizeBytes:      d.Size,
+			BusType:        d.BusType,
+			PartitionStyle: d.PartitionStyle,
+			IsOffline:      d.IsOffline,
+			IsReadOnly:     d.IsReadOnly,
+			IsOsDisk:       d.IsOSDisk,
+		})
+	}
 	klog.V(5).Infof("Response=%v", response)
 	return response, nil
 }
@@ -162,13 +678,48 @@ func (s *Server) DiskStats(context context.Context, request *internal.DiskStatsR
 func (s *Server) GetDiskStats(context context.Context, request *internal.GetDiskStatsRequest, version apiversion.Version) (*internal.GetDiskStatsResponse, error) {
 	klog.V(2).Infof("Request: GetDiskStats: diskNumber=%d", request.DiskNumber)
 	diskNumber := request.DiskNumber
-	totalBytes, err := s.hostAPI.GetDiskStats(diskNumber)
+	totalBytes, serialNumber, err := s.hostAPI.GetDiskStats(diskNumber)
 	if err != nil {
 		klog.Errorf("GetDiskStats failed: %v", err)
 		return nil, err
 	}
-	return &internal.GetDiskStatsResponse{
-		TotalBytes: totalBytes,
+	klog.V(2).Infof("GetDiskStats: disk %d serial number %q size %d", diskNumber, serialNumber, totalBytes)
+	response := &internal.GetDiskStatsResponse{
+		TotalBytes:   totalBytes,
+		SerialNumber: serialNumber,
+	}
+	// some virtual disks don't report their sector sizes, the size is enough for most callers
+	logical, physical, err := s.hostAPI.GetDiskSectorSizes(diskNumber)
+	if err != nil {
+		klog.Warningf("GetDiskStats: failed to get the sector sizes of disk %d: %v", diskNumber, err)
+		return response, nil
+	}
+	response.LogicalSectorSize = logical
+	response.PhysicalSectorSize = physical
+	return response, nil
+}
+
+func (s *Server) GetDiskReliabilityCounters(context context.Context, request *internal.GetDiskReliabilityCountersRequest, version apiversion.Version) (*internal.GetDiskReliabilityCountersResponse, error) {
+	klog.V(2).Infof("Request: GetDiskReliabilityCounters: diskNumber=%d", request.DiskNumber)
+	counters, err := s.hostAPI.GetReliabilityCounters(request.DiskNumber)
+	if err != nil {
+		klog.Errorf("GetDiskReliabilityCounters failed: %v", err)
+		return nil, err
+	}
+	return &internal.GetDiskReliabilityCountersResponse{
+		TemperatureCelsius:     counters.TemperatureCelsius,
+		MaxTemperatureCelsius:  counters.MaxTemperatureCelsius,
+		WearPercentage:         counters.WearPercentage,
+		ReadErrorsTotal:        counters.ReadErrorsTotal,
+		ReadErrorsCorrected:    counters.ReadErrorsCorrected,
+		ReadErrorsUncorrected:  counters.ReadErrorsUncorrected,
+		WriteErrorsTotal:       counters.WriteErrorsTotal,
+		WriteErrorsCorrected:   counters.WriteErrorsCorrected,
+		WriteErrorsUncorrected: counters.WriteErrorsUncorrected,
+		PowerOnHours:           counters.PowerOnHours,
+		ReadLatencyMaxMs:       counters.ReadLatencyMaxMs,
+		WriteLatencyMaxMs:      counters.WriteLatencyMaxMs,
+		FlushLatencyMaxMs:      counters.FlushLatencyMaxMs,
 	}, nil
 }
 
@@ -199,14 +750,68 @@ func (s *Server) SetAttachState(context context.Context, request *internal.SetAt
 
 func (s *Server) SetDiskState(context context.Context, request *internal.SetDiskStateRequest, version apiversion.Version) (*internal.SetDiskStateResponse, error) {
 	klog.V(2).Infof("Request: SetDiskState with diskNumber=%d and isOnline=%v", request.DiskNumber, request.IsOnline)
-	err := s.hostAPI.SetDiskState(request.DiskNumber, request.IsOnline)
+	diskNumber := request.DiskNumber
+
+	isClustered, err := s.hostAPI.IsDiskClustered(diskNumber)
+	if err != nil {
+		klog.Errorf("IsDiskClustered failed: %v", err)
+		return nil, err
+	}
+	var key uint64
+	if isClustered {
+		if request.ClaimToken == "" {
+			return nil, fmt.Errorf("disk %d is a shared disk, SetDiskStateRequest.ClaimToken is required", diskNumber)
+		}
+		key = claimKey(request.ClaimToken)
+		if request.IsOnline {
+			if err := s.reserveSharedDisk(diskNumber, key); err != nil {
+				klog.Errorf("reserveSharedDisk failed: %v", err)
+				return nil, err
+			}
+		}
+	}
+
+	err = s.hostAPI.SetDiskState(diskNumber, request.IsOnline)
 	if err != nil {
 		klog.Errorf("SetDiskState failed: %v", err)
 		return nil, err
 	}
+
+	if isClustered && !request.IsOnline {
+		klog.V(4).Infof("Releasing the reservation of shared disk %d", diskNumber)
+		if err := s.hostAPI.ReleaseDisk(diskNumber, key); err != nil {
+			klog.Errorf("ReleaseDisk failed: %v", err)
+			return nil, err
+		}
+	}
 	return &internal.SetDiskStateResponse{}, nil
 }
 
+// reserveSharedDisk makes sure that the shared disk `diskNumber` is reserved with `key`,
+// it fails if the disk is reserved with a different key e.g. because it was
+// brought online in a different node with a different claim token.
+func (s *Server) reserveSharedDisk(diskNumber uint32, key uint64) error {
+	holder, reserved, err := s.hostAPI.GetDiskReservation(diskNumber)
+	if err != nil {
+		return err
+	}
+	if reserved {
+		if holder != key {
+			return fmt.Errorf("shared disk %d is reserved by a different claim token", diskNumber)
+		}
+		klog.V(4).Infof("Shared disk %d is already reserved with the claim token", diskNumber)
+		return nil
+	}
+	klog.V(4).Infof("Reserving shared disk %d", diskNumber)
+	return s.hostAPI.ReserveDisk(diskNumber, key)
+}
+
+// claimKey derives the persistent reservation key of a claim token.
+func claimKey(claimToken string) uint64 {
+	sum := sha256.Sum256([]byte(claimToken))
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
 func (s *Server) GetAttachState(context context.Context, request *internal.GetAttachStateRequest, version apiversion.Version) (*internal.GetAttachStateResponse, error) {
 	klog.V(2).Infof("Request: GetAttachState: %+v", request)
 
@@ -242,3 +847,390 @@ func (s *Server) GetDiskState(context context.Context, request *internal.GetDisk
 	}
 	return &internal.GetDiskStateResponse{IsOnline: isOnline}, nil
 }
+
+// diskSizePollInterval is how often WaitForDiskSizeChange polls the size of a
+// disk and diskArrivalPollInterval how often WaitForDisk looks for a disk, the
+// disks are rescanned every diskRescanInterval because the storage cache is only
+// updated by a rescan.
+var (
+	diskSizePollInterval    = time.Second
+	diskArrivalPollInterval = time.Second
+	diskRescanInterval      = 10 * time.Second
+)
+
+// defaultDiskSizeChangeTimeout is how long WaitForDiskSizeChange waits if the
+// request doesn't set a timeout.
+const defaultDiskSizeChangeTimeout = 2 * time.Minute
+
+func (s *Server) WaitForDiskSizeChange(context context.Context, request *internal.WaitForDiskSizeChangeRequest, version apiversion.Version) (*internal.WaitForDiskSizeChangeResponse, error) {
+	klog.V(2).Infof("Request: WaitForDiskSizeChange: %+v", request)
+	if request.ExpectedSizeBytes <= 0 {
+		return nil, fmt.Errorf("WaitForDiskSizeChangeRequest.ExpectedSizeBytes must be positive")
+	}
+	timeout := time.Duration(request.TimeoutNanos)
+	if timeout <= 0 {
+		timeout = defaultDiskSizeChangeTimeout
+	}
+
+	start := time.Now()
+	var lastRescan time.Time
+	for {
+		if time.Since(lastRescan) >= diskRescanInterval {
+			if err := s.hostAPI.Rescan(); err != nil {
+				klog.Errorf("Rescan failed %v", err)
+				return nil, err
+			}
+			lastRescan = time.Now()
+		}
+		totalBytes, _, err := s.hostAPI.GetDiskStats(request.DiskNumber)
+		if err != nil {
+			klog.Errorf("GetDiskStats failed: %v", err)
+			return nil, err
+		}
+		if totalBytes >= request.ExpectedSizeBytes {
+			klog.V(2).Infof("WaitForDiskSizeChange: disk %d size is %d bytes after %v", request.DiskNumber, totalBytes, time.Since(start))
+			return &internal.WaitForDiskSizeChangeResponse{TotalBytes: totalBytes}, nil
+		}
+		if time.Since(start) >= timeout {
+			klog.Errorf("WaitForDiskSizeChange: disk %d size is %d bytes after %v, expected %d bytes", request.DiskNumber, totalBytes, timeout, request.ExpectedSizeBytes)
+			return nil, status.Errorf(codes.DeadlineExceeded, "disk %d size is still %d bytes after %v, expected at least %d bytes", request.DiskNumber, totalBytes, timeout, request.ExpectedSizeBytes)
+		}
+		select {
+		case <-context.Done():
+			return nil, status.FromContextError(context.Err()).Err()
+		case <-time.After(diskSizePollInterval):
+		}
+	}
+}
+
+// sanPolicies maps the SAN policies of the API to the SAN policies of Set-StorageSetting.
+var sanPolicies = map[internal.SanPolicy]disk.SanPolicy{
+	internal.SAN_POLICY_ONLINE_ALL:       disk.SanPolicyOnlineAll,
+	internal.SAN_POLICY_OFFLINE_SHARED:   disk.SanPolicyOfflineShared,
+	internal.SAN_POLICY_OFFLINE_ALL:      disk.SanPolicyOfflineAll,
+	internal.SAN_POLICY_OFFLINE_INTERNAL: disk.SanPolicyOfflineInternal,
+}
+
+func (s *Server) GetSanPolicy(context context.Context, request *internal.GetSanPolicyRequest, version apiversion.Version) (*internal.GetSanPolicyResponse, error) {
+	klog.V(2).Infof("Request: GetSanPolicy")
+	policy, err := s.hostAPI.GetSanPolicy()
+	if err != nil {
+		klog.Errorf("failed GetSanPolicy %v", err)
+		return nil, err
+	}
+	for sanPolicy, p := range sanPolicies {
+		if p == policy {
+			return &internal.GetSanPolicyResponse{SanPolicy: sanPolicy}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown SAN policy %q", policy)
+}
+
+func (s *Server) SetSanPolicy(context context.Context, request *internal.SetSanPolicyRequest, version apiversion.Version) (*internal.SetSanPolicyResponse, error) {
+	klog.V(2).Infof("Request: SetSanPolicy with sanPolicy=%d", request.SanPolicy)
+	policy, ok := sanPolicies[request.SanPolicy]
+	if !ok {
+		klog.Errorf("invalid SAN policy %d", request.SanPolicy)
+		return nil, fmt.Errorf("invalid SAN policy %d", request.SanPolicy)
+	}
+	if err := s.hostAPI.SetSanPolicy(policy); err != nil {
+		klog.Errorf("failed SetSanPolicy %v", err)
+		return nil, err
+	}
+	return &internal.SetSanPolicyResponse{}, nil
+}
+
+func (s *Server) SetDiskReadOnly(context context.Context, request *internal.SetDiskReadOnlyRequest, version apiversion.Version) (*internal.SetDiskReadOnlyResponse, error) {
+	klog.V(2).Infof("Request: SetDiskReadOnly with diskNumber=%d and readOnly=%v", request.DiskNumber, request.ReadOnly)
+	if err := s.checkDiskPolicy(request.DiskNumber); err != nil {
+		klog.Errorf("failed SetDiskReadOnly %v", err)
+		return nil, err
+	}
+	if err := s.hostAPI.SetDiskReadOnly(request.DiskNumber, request.ReadOnly); err != nil {
+		klog.Errorf("failed SetDiskReadOnly %v", err)
+		return nil, err
+	}
+	return &internal.SetDiskReadOnlyResponse{}, nil
+}
+
+func (s *Server) GetDiskReadOnly(context context.Context, request *internal.GetDiskReadOnlyRequest, version apiversion.Version) (*internal.GetDiskReadOnlyResponse, error) {
+	klog.V(4).Infof("Request: GetDiskReadOnly with diskNumber=%d", request.DiskNumber)
+	readOnly, err := s.hostAPI.GetDiskReadOnly(request.DiskNumber)
+	if err != nil {
+		klog.Errorf("failed GetDiskReadOnly %v", err)
+		return nil, err
+	}
+	return &internal.GetDiskReadOnlyResponse{ReadOnly: readOnly}, nil
+}
+
+func (s *Server) GetDiskNumberBySerialNumber(context context.Context, request *internal.GetDiskNumberBySerialNumberRequest, version apiversion.Version) (*internal.GetDiskNumberBySerialNumberResponse, error) {
+	klog.V(4).Infof("Request: GetDiskNumberBySerialNumber with serialNumber %q", request.SerialNumber)
+	serialNumber := strings.TrimSpace(request.SerialNumber)
+	if serialNumber == "" {
+		klog.Errorf("serial number empty")
+		return nil, fmt.Errorf("GetDiskNumberBySerialNumberRequest.SerialNumber is empty")
+	}
+	disks, err := s.hostAPI.ListDisks()
+	if err != nil {
+		klog.Errorf("failed GetDiskNumberBySerialNumber %v", err)
+		return nil, err
+	}
+	matches := []uint32{}
+	for _, d := range disks {
+		// the serial numbers of some disks are padded with spaces
+		if strings.EqualFold(strings.TrimSpace(d.SerialNumber), serialNumber) {
+			matches = append(matches, d.Number)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, status.Errorf(codes.NotFound, "no disk has the serial number %q", serialNumber)
+	case 1:
+		return &internal.GetDiskNumberBySerialNumberResponse{DiskNumber: matches[0]}, nil
+	default:
+		// e.g. the paths of a multipath disk that isn't claimed by MPIO
+		klog.Errorf("disks %v have the serial number %q", matches, serialNumber)
+		return nil, fmt.Errorf("disks %v have the serial number %q", matches, serialNumber)
+	}
+}
+
+// normalizePage83ID returns the identifier `id` without the prefix of its type
+// (e.g. naa.600a0b80...) in lowercase.
+func normalizePage83ID(id string) string {
+	id = strings.ToLower(strings.TrimSpace(id))
+	for _, prefix := range []string{"naa.", "eui.", "0x"} {
+		id = strings.TrimPrefix(id, prefix)
+	}
+	return id
+}
+
+func (s *Server) GetDiskNumberByPage83ID(context context.Context, request *internal.GetDiskNumberByPage83IDRequest, version apiversion.Version) (*internal.GetDiskNumberByPage83IDResponse, error) {
+	klog.V(4).Infof("Request: GetDiskNumberByPage83ID with page83Id %q", request.Page83Id)
+	page83ID := normalizePage83ID(request.Page83Id)
+	if page83ID == "" {
+		klog.Errorf("page83 id empty")
+		return nil, fmt.Errorf("GetDiskNumberByPage83IDRequest.Page83Id is empty")
+	}
+	diskIDs, err := s.hostAPI.ListDiskPage83IDs(nil)
+	if err != nil {
+		klog.Errorf("failed GetDiskNumberByPage83ID %v", err)
+		return nil, err
+	}
+	matches := []uint32{}
+	for diskNumber, ids := range diskIDs {
+		for _, id := range ids {
+			// the identifiers of the ports and of the targets are shared by the disks behind them
+			if id.Association == "Device" && normalizePage83ID(id.Value) == page83ID {
+				matches = append(matches, diskNumber)
+				break
+			}
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, status.Errorf(codes.NotFound, "no disk has the page83 id %q", request.Page83Id)
+	case 1:
+		return &internal.GetDiskNumberByPage83IDResponse{DiskNumber: matches[0]}, nil
+	default:
+		sort.Slice(matches, func(i, j int) bool { return matches[i] < matches[j] })
+		klog.Errorf("disks %v have the page83 id %q", matches, request.Page83Id)
+		return nil, fmt.Errorf("disks %v have the page83 id %q", matches, request.Page83Id)
+	}
+}
+
+// defaultWaitForDiskTimeout is how long WaitForDisk waits if the request doesn't
+// set a timeout.
+const defaultWaitForDiskTimeout = 2 * time.Minute
+
+// WaitForDisk looks for the disk with GetDiskNumberBySerialNumber,
+// GetDiskNumberByPage83ID or GetDiskNumberByLocation until it doesn't fail with
+// NotFound, the disks are rescanned between the attempts.
+func (s *Server) WaitForDisk(context context.Context, request *internal.WaitForDiskRequest, version apiversion.Version) (*internal.WaitForDiskResponse, error) {
+	klog.V(2).Infof("Request: WaitForDisk: %+v", request)
+	find, err := s.diskFinder(request, version)
+	if err != nil {
+		klog.Errorf("failed WaitForDisk %v", err)
+		return nil, err
+	}
+	timeout := time.Duration(request.TimeoutNanos)
+	if timeout <= 0 {
+		timeout = defaultWaitForDiskTimeout
+	}
+
+	start := time.Now()
+	var lastRescan time.Time
+	for {
+		diskNumber, err := find(context)
+		if err == nil {
+			klog.V(2).Infof("WaitForDisk: disk %d found after %v", diskNumber, time.Since(start))
+			return &internal.WaitForDiskResponse{DiskNumber: diskNumber}, nil
+		}
+		if status.Code(err) != codes.NotFound {
+			klog.Errorf("failed WaitForDisk %v", err)
+			return nil, err
+		}
+		if time.Since(start) >= timeout {
+			klog.Errorf("WaitForDisk: disk not found after %v: %v", timeout, err)
+			return nil, status.Errorf(codes.DeadlineExceeded, "disk not found after %v: %s", timeout, status.Convert(err).Message())
+		}
+		if time.Since(lastRescan) >= diskRescanInterval {
+			if err := s.hostAPI.Rescan(); err != nil {
+				klog.Errorf("Rescan failed %v", err)
+				return nil, err
+			}
+			lastRescan = time.Now()
+		}
+		select {
+		case <-context.Done():
+			return nil, status.FromContextError(context.Err()).Err()
+		case <-time.After(diskArrivalPollInterval):
+		}
+	}
+}
+
+// diskFinder returns the function that looks for the disk of `request` by its
+// serial number, its page83 id or its location, whichever is set.
+func (s *Server) diskFinder(request *internal.WaitForDiskRequest, version apiversion.Version) (func(context.Context) (uint32, error), error) {
+	matchers := 0
+	for _, value := range []string{request.SerialNumber, request.Page83Id, request.LunId} {
+		if value != "" {
+			matchers++
+		}
+	}
+	if matchers != 1 {
+		return nil, fmt.Errorf("exactly one of WaitForDiskRequest.SerialNumber, Page83Id and LunId must be set")
+	}
+	switch {
+	case request.SerialNumber != "":
+		return func(ctx context.Context) (uint32, error) {
+			response, err := s.GetDiskNumberBySerialNumber(ctx, &internal.GetDiskNumberBySerialNumberRequest{SerialNumber: request.SerialNumber}, version)
+			if err != nil {
+				return 0, err
+			}
+			return response.DiskNumber, nil
+		}, nil
+	case request.Page83Id != "":
+		return func(ctx context.Context) (uint32, error) {
+			response, err := s.GetDiskNumberByPage83ID(ctx, &internal.GetDiskNumberByPage83IDRequest{Page83Id: request.Page83Id}, version)
+			if err != nil {
+				return 0, err
+			}
+			return response.DiskNumber, nil
+		}, nil
+	default:
+		locationRequest := &internal.GetDiskNumberByLocationRequest{
+			Adapter: request.Adapter,
+			Bus:     request.Bus,
+			Target:  request.Target,
+			LunId:   request.LunId,
+		}
+		return func(ctx context.Context) (uint32, error) {
+			response, err := s.GetDiskNumberByLocation(ctx, locationRequest, version)
+			if err != nil {
+				return 0, err
+			}
+			return response.DiskNumber, nil
+		}, nil
+	}
+}
+
+// normalizeDiskGUID returns the GUID `guid` without braces in lowercase.
+func normalizeDiskGUID(guid string) string {
+	return strings.ToLower(strings.Trim(strings.TrimSpace(guid), "{}"))
+}
+
+func (s *Server) GetDiskIdentity(context context.Context, request *internal.GetDiskIdentityRequest, version apiversion.Version) (*internal.GetDiskIdentityResponse, error) {
+	klog.V(4).Infof("Request: GetDiskIdentity with diskNumber=%d", request.DiskNumber)
+	disks, err := s.hostAPI.ListDisks()
+	if err != nil {
+		klog.Errorf("failed GetDiskIdentity %v", err)
+		return nil, err
+	}
+	var disk *shared.DiskInfo
+	for i := range disks {
+		if disks[i].Number == request.DiskNumber {
+			disk = &disks[i]
+		}
+	}
+	if disk == nil {
+		return nil, status.Errorf(codes.NotFound, "disk %d not found", request.DiskNumber)
+	}
+	diskIDs, err := s.hostAPI.ListDiskPage83IDs([]uint32{request.DiskNumber})
+	if err != nil {
+		klog.Errorf("failed GetDiskIdentity %v", err)
+		return nil, err
+	}
+
+	identity := &internal.DiskIdentity{
+		SerialNumber: strings.TrimSpace(disk.SerialNumber),
+		Signature:    disk.Signature,
+		Guid:         normalizeDiskGUID(disk.GUID),
+	}
+	for _, id := range diskIDs[request.DiskNumber] {
+		// the identifiers of the ports and of the targets are shared by the disks behind them
+		if id.Association == "Device" {
+			identity.Page83Id = normalizePage83ID(id.Value)
+			break
+		}
+	}
+	if identity.SerialNumber == "" && identity.Page83Id == "" && identity.Signature == 0 && identity.Guid == "" {
+		klog.Errorf("disk %d has no stable identifier", request.DiskNumber)
+		return nil, status.Errorf(codes.FailedPrecondition, "disk %d has no serial number, page83 id, signature or GUID", request.DiskNumber)
+	}
+	return &internal.GetDiskIdentityResponse{Identity: identity}, nil
+}
+
+func (s *Server) ResolveDiskIdentity(context context.Context, request *internal.ResolveDiskIdentityRequest, version apiversion.Version) (*internal.ResolveDiskIdentityResponse, error) {
+	klog.V(4).Infof("Request: ResolveDiskIdentity: %+v", request.Identity)
+	identity := request.Identity
+	if identity == nil || (identity.SerialNumber == "" && identity.Page83Id == "" && identity.Signature == 0 && identity.Guid == "") {
+		klog.Errorf("disk identity empty")
+		return nil, fmt.Errorf("ResolveDiskIdentityRequest.Identity is empty")
+	}
+	disks, err := s.hostAPI.ListDisks()
+	if err != nil {
+		klog.Errorf("failed ResolveDiskIdentity %v", err)
+		return nil, err
+	}
+	var diskIDs map[uint32][]shared.Page83ID
+	if identity.Page83Id != "" {
+		diskIDs, err = s.hostAPI.ListDiskPage83IDs(nil)
+		if err != nil {
+			klog.Errorf("failed ResolveDiskIdentity %v", err)
+			return nil, err
+		}
+	}
+
+	matches := []uint32{}
+	for _, d := range disks {
+		if identity.SerialNumber != "" && !strings.EqualFold(strings.TrimSpace(d.SerialNumber), strings.TrimSpace(identity.SerialNumber)) {
+			continue
+		}
+		if identity.Signature != 0 && d.Signature != identity.Signature {
+			continue
+		}
+		if identity.Guid != "" && normalizeDiskGUID(d.GUID) != normalizeDiskGUID(identity.Guid) {
+			continue
+		}
+		if identity.Page83Id != "" {
+			found := false
+			for _, id := range diskIDs[d.Number] {
+				found = found || (id.Association == "Device" && normalizePage83ID(id.Value) == normalizePage83ID(identity.Page83Id))
+			}
+			if !found {
+				continue
+			}
+		}
+		matches = append(matches, d.Number)
+	}
+	switch len(matches) {
+	case 0:
+		return nil, status.Errorf(codes.NotFound, "no disk has the identity %+v", *identity)
+	case 1:
+		return &internal.ResolveDiskIdentityResponse{DiskNumber: matches[0]}, nil
+	default:
+		// e.g. the paths of a multipath disk that isn't claimed by MPIO or a cloned disk
+		klog.Errorf("disks %v have the identity %+v", matches, *identity)
+		return nil, fmt.Errorf("disks %v have the identity %+v", matches, *identity)
+	}
+}
@@ -0,0 +1,1483 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/disk"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/disk/impl"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeDiskAPI struct {
+	// locations are the locations of all the disks
+	locations    map[uint32]shared.DiskLocation
+	disks        []shared.DiskInfo
+	clustered    bool
+	online       bool
+	reservedKey  uint64
+	isReserved   bool
+	releaseCalls int
+
+	// uninitialized is set for disks that aren't initialized until InitializeDisk is called
+	uninitialized bool
+	// unpartitioned is set for disks without partitions until CreateBasicPartition is called
+	unpartitioned bool
+	// partitionStyle is the partition style of the last InitializeDisk, partitionSize,
+	// partitionOffset and partitionGptType are the size, the offset and the GPT type of
+	// the last CreateBasicPartition
+	partitionStyle   disk.PartitionStyle
+	partitionSize    int64
+	partitionOffset  int64
+	partitionGptType string
+	// partitionTypes are the GPT types set with SetPartitionType as "<disk number>/<partition number>=<type>"
+	partitionTypes []string
+	// deletedPartitions are the partition numbers passed to DeletePartition
+	deletedPartitions []uint32
+	// wipes are the wipes of the disks as "<disk number> removeData=<bool> zeroFill=<bool>"
+	wipes []string
+	// conversions are the conversions of the disks as "<disk number> <partition style>"
+	conversions []string
+	// sanPolicy is the SAN policy of the node, OfflineShared if it's empty
+	sanPolicy disk.SanPolicy
+	// readOnly maps the disk numbers to their read-only attribute
+	readOnly map[uint32]bool
+	// page83IDs maps the disk numbers to their page 83 identifiers
+	page83IDs map[uint32][]shared.Page83ID
+	// reliabilityCounters maps the disk numbers to their reliability counters, the
+	// other disks don't report any
+	reliabilityCounters map[uint32]shared.ReliabilityCounters
+
+	// sizes are the sizes of the disks returned by the successive calls of
+	// GetDiskStats after the first rescan, the last one is repeated
+	sizes       []int64
+	rescanCalls int
+	// arrivalRescans is the number of rescans before the disks are listed
+	arrivalRescans int
+}
+
+var _ disk.API = &fakeDiskAPI{}
+
+func (diskAPI *fakeDiskAPI) ListDiskLocations(diskNumbers []uint32) (map[uint32]shared.DiskLocation, error) {
+	if diskNumbers == nil && diskAPI.locations != nil {
+		if diskAPI.rescanCalls < diskAPI.arrivalRescans {
+			return map[uint32]shared.DiskLocation{}, nil
+		}
+		return diskAPI.locations, nil
+	}
+	m := make(map[uint32]shared.DiskLocation)
+	for _, n := range diskNumbers {
+		m[n] = shared.DiskLocation{LUNID: strconv.FormatUint(uint64(n), 10), SerialNumber: diskSerialNumber(n)}
+	}
+	return m, nil
+}
+
+func (diskAPI *fakeDiskAPI) IsDiskInitialized(diskNumber uint32) (bool, error) {
+	return !diskAPI.uninitialized, nil
+}
+
+func (diskAPI *fakeDiskAPI) InitializeDisk(diskNumber uint32, style disk.PartitionStyle) error {
+	diskAPI.uninitialized = false
+	diskAPI.partitionStyle = style
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) BringDiskOnline(diskNumber uint32) error {
+	diskAPI.online = true
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) GetSanPolicy() (disk.SanPolicy, error) {
+	if diskAPI.sanPolicy == "" {
+		return disk.SanPolicyOfflineShared, nil
+	}
+	return diskAPI.sanPolicy, nil
+}
+
+func (diskAPI *fakeDiskAPI) SetSanPolicy(policy disk.SanPolicy) error {
+	diskAPI.sanPolicy = policy
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) SetDiskReadOnly(diskNumber uint32, readOnly bool) error {
+	if diskAPI.readOnly == nil {
+		diskAPI.readOnly = make(map[uint32]bool)
+	}
+	diskAPI.readOnly[diskNumber] = readOnly
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) GetDiskReadOnly(diskNumber uint32) (bool, error) {
+	return diskAPI.readOnly[diskNumber], nil
+}
+
+func (diskAPI *fakeDiskAPI) BasicPartitionsExist(diskNumber uint32) (bool, error) {
+	return !diskAPI.unpartitioned, nil
+}
+
+func (diskAPI *fakeDiskAPI) CreateBasicPartition(diskNumber uint32, sizeBytes int64, offsetBytes int64, gptType string) error {
+	diskAPI.unpartitioned = false
+	diskAPI.partitionSize = sizeBytes
+	diskAPI.partitionOffset = offsetBytes
+	diskAPI.partitionGptType = gptType
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) SetPartitionType(diskNumber uint32, partitionNumber uint32, gptType string) error {
+	diskAPI.partitionTypes = append(diskAPI.partitionTypes, fmt.Sprintf("%d/%d=%s", diskNumber, partitionNumber, gptType))
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) DeletePartition(diskNumber uint32, partitionNumber uint32) error {
+	diskAPI.deletedPartitions = append(diskAPI.deletedPartitions, partitionNumber)
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) ConvertPartitionStyle(diskNumber uint32, style disk.PartitionStyle) error {
+	diskAPI.conversions = append(diskAPI.conversions, fmt.Sprintf("%d %s", diskNumber, style))
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) WipeDisk(diskNumber uint32, removeData bool, zeroFill bool) error {
+	diskAPI.wipes = append(diskAPI.wipes, fmt.Sprintf("%d removeData=%t zeroFill=%t", diskNumber, removeData, zeroFill))
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) Rescan() error {
+	diskAPI.rescanCalls++
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) GetDiskNumberByName(page83ID string) (uint32, error) {
+	return 0, nil
+}
+
+func (diskAPI *fakeDiskAPI) ListDiskIDs(diskNumbers []uint32) (map[uint32]shared.DiskIDs, error) {
+	m := make(map[uint32]shared.DiskIDs)
+	for _, n := range diskNumbers {
+		m[n] = shared.DiskIDs{Page83: strconv.FormatUint(uint64(n), 10)}
+	}
+	return m, nil
+}
+
+func (diskAPI *fakeDiskAPI) ListDiskPage83IDs(diskNumbers []uint32) (map[uint32][]shared.Page83ID, error) {
+	if diskNumbers == nil {
+		if diskAPI.rescanCalls < diskAPI.arrivalRescans {
+			return map[uint32][]shared.Page83ID{}, nil
+		}
+		return diskAPI.page83IDs, nil
+	}
+	m := make(map[uint32][]shared.Page83ID)
+	for _, n := range diskNumbers {
+		if ids, ok := diskAPI.page83IDs[n]; ok {
+			m[n] = ids
+		}
+	}
+	return m, nil
+}
+
+func (diskAPI *fakeDiskAPI) ListDisks() ([]shared.DiskInfo, error) {
+	if diskAPI.rescanCalls < diskAPI.arrivalRescans {
+		return nil, nil
+	}
+	return diskAPI.disks, nil
+}
+
+func (diskAPI *fakeDiskAPI) GetDiskStats(diskNumber uint32) (int64, string, error) {
+	if len(diskAPI.sizes) == 0 {
+		return 1024, diskSerialNumber(diskNumber), nil
+	}
+	size := diskAPI.sizes[0]
+	if len(diskAPI.sizes) > 1 {
+		diskAPI.sizes = diskAPI.sizes[1:]
+	}
+	return size, diskSerialNumber(diskNumber), nil
+}
+
+func (diskAPI *fakeDiskAPI) GetDiskSectorSizes(diskNumber uint32) (uint32, uint32, error) {
+	return 512, 4096, nil
+}
+
+func (diskAPI *fakeDiskAPI) GetReliabilityCounters(diskNumber uint32) (shared.ReliabilityCounters, error) {
+	counters, ok := diskAPI.reliabilityCounters[diskNumber]
+	if !ok {
+		return shared.ReliabilityCounters{}, &disk.ReliabilityCountersNotSupportedError{DiskNumber: diskNumber}
+	}
+	return counters, nil
+}
+
+// diskSerialNumber is the serial number of the fake disk `diskNumber`.
+func diskSerialNumber(diskNumber uint32) string {
+	return fmt.Sprintf("serial-%d", diskNumber)
+}
+
+func (diskAPI *fakeDiskAPI) SetDiskState(diskNumber uint32, isOnline bool) error {
+	diskAPI.online = isOnline
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) GetDiskState(diskNumber uint32) (bool, error) {
+	return diskAPI.online, nil
+}
+
+func (diskAPI *fakeDiskAPI) IsDiskClustered(diskNumber uint32) (bool, error) {
+	return diskAPI.clustered, nil
+}
+
+func (diskAPI *fakeDiskAPI) GetDiskReservation(diskNumber uint32) (uint64, bool, error) {
+	return diskAPI.reservedKey, diskAPI.isReserved, nil
+}
+
+func (diskAPI *fakeDiskAPI) ReserveDisk(diskNumber uint32, key uint64) error {
+	diskAPI.reservedKey = key
+	diskAPI.isReserved = true
+	return nil
+}
+
+func (diskAPI *fakeDiskAPI) ReleaseDisk(diskNumber uint32, key uint64) error {
+	diskAPI.releaseCalls++
+	if diskAPI.reservedKey == key {
+		diskAPI.reservedKey = 0
+		diskAPI.isReserved = false
+	}
+	return nil
+}
+
+func TestSetDiskStateSharedDisk(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	testCases := []struct {
+		name          string
+		clustered     bool
+		reservedBy    string
+		claimToken    string
+		isOnline      bool
+		expectError   bool
+		expectOnline  bool
+		expectRelease bool
+	}{
+		{
+			name:         "non shared disk doesn't need a claim token",
+			isOnline:     true,
+			expectOnline: true,
+		},
+		{
+			name:        "shared disk without a claim token",
+			clustered:   true,
+			isOnline:    true,
+			expectError: true,
+		},
+		{
+			name:         "shared disk without reservation is reserved",
+			clustered:    true,
+			claimToken:   "node-a",
+			isOnline:     true,
+			expectOnline: true,
+		},
+		{
+			name:         "shared disk reserved with the same claim token",
+			clustered:    true,
+			reservedBy:   "node-a",
+			claimToken:   "node-a",
+			isOnline:     true,
+			expectOnline: true,
+		},
+		{
+			name:        "shared disk reserved with a different claim token",
+			clustered:   true,
+			reservedBy:  "node-b",
+			claimToken:  "node-a",
+			isOnline:    true,
+			expectError: true,
+		},
+		{
+			name:          "shared disk is released when brought offline",
+			clustered:     true,
+			reservedBy:    "node-a",
+			claimToken:    "node-a",
+			isOnline:      false,
+			expectOnline:  false,
+			expectRelease: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diskAPI := &fakeDiskAPI{clustered: tc.clustered}
+			if tc.reservedBy != "" {
+				diskAPI.ReserveDisk(0, claimKey(tc.reservedBy))
+			}
+			srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+			if err != nil {
+				t.Fatalf("Disk Server could not be initialized: %v", err)
+			}
+			request := &internal.SetDiskStateRequest{
+				DiskNumber: 0,
+				IsOnline:   tc.isOnline,
+				ClaimToken: tc.claimToken,
+			}
+			_, err = srv.SetDiskState(context.TODO(), request, v2alpha1)
+			if tc.expectError && err == nil {
+				t.Errorf("Expected error but SetDiskState returned a nil error")
+			}
+			if !tc.expectError && err != nil {
+				t.Errorf("Expected no errors but SetDiskState returned error: %v", err)
+			}
+			if diskAPI.online != tc.expectOnline {
+				t.Errorf("Expected disk online=%v, got online=%v", tc.expectOnline, diskAPI.online)
+			}
+			if tc.expectOnline && tc.clustered && diskAPI.reservedKey != claimKey(tc.claimToken) {
+				t.Errorf("Expected shared disk to be reserved with the claim token %q", tc.claimToken)
+			}
+			if tc.expectRelease && (diskAPI.releaseCalls != 1 || diskAPI.isReserved) {
+				t.Errorf("Expected shared disk to be released, releaseCalls=%d", diskAPI.releaseCalls)
+			}
+		})
+	}
+}
+
+func TestDiskSerialNumbers(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	diskAPI := &fakeDiskAPI{
+		disks: []shared.DiskInfo{{Number: 1}, {Number: 2}},
+	}
+	srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk Server could not be initialized: %v", err)
+	}
+
+	locations, err := srv.ListDiskLocations(context.TODO(), &internal.ListDiskLocationsRequest{MaxResults: 2}, v2alpha1)
+	if err != nil {
+		t.Fatalf("ListDiskLocations: unexpected error %v", err)
+	}
+	if len(locations.DiskLocations) != 2 {
+		t.Errorf("ListDiskLocations: expected 2 disks, got %d", len(locations.DiskLocations))
+	}
+	for diskNumber, location := range locations.DiskLocations {
+		if location.SerialNumber != diskSerialNumber(diskNumber) {
+			t.Errorf("ListDiskLocations: expected serial number %q for disk %d, got %q", diskSerialNumber(diskNumber), diskNumber, location.SerialNumber)
+		}
+	}
+
+	stats, err := srv.GetDiskStats(context.TODO(), &internal.GetDiskStatsRequest{DiskNumber: 2}, v2alpha1)
+	if err != nil {
+		t.Fatalf("GetDiskStats: unexpected error %v", err)
+	}
+	if stats.TotalBytes != 1024 || stats.SerialNumber != diskSerialNumber(2) || stats.LogicalSectorSize != 512 || stats.PhysicalSectorSize != 4096 {
+		t.Errorf("GetDiskStats: unexpected stats %+v", stats)
+	}
+}
+
+func TestListDiskIDsPaging(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	diskAPI := &fakeDiskAPI{
+		disks: []shared.DiskInfo{
+			{Number: 3, BusType: "SAS", Size: 100, PartitionStyle: "RAW"},
+			{Number: 0, BusType: "NVMe", Size: 50, PartitionStyle: "GPT"},
+			{Number: 1, BusType: "SAS", Size: 200, PartitionStyle: "GPT"},
+			{Number: 2, BusType: "SAS", Size: 300, PartitionStyle: "RAW"},
+			{Number: 4, BusType: "iSCSI", Size: 100, PartitionStyle: "RAW"},
+		},
+	}
+	srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk Server could not be initialized: %v", err)
+	}
+
+	testCases := []struct {
+		name            string
+		filter          *internal.DiskFilter
+		maxResults      uint32
+		expectDiskPages [][]uint32
+	}{
+		{
+			name:            "all disks in pages",
+			maxResults:      2,
+			expectDiskPages: [][]uint32{{0, 1}, {2, 3}, {4}},
+		},
+		{
+			name:            "bus type filter",
+			filter:          &internal.DiskFilter{BusType: "sas"},
+			maxResults:      2,
+			expectDiskPages: [][]uint32{{1, 2}, {3}},
+		},
+		{
+			name:            "size range filter",
+			filter:          &internal.DiskFilter{MinSizeBytes: 100, MaxSizeBytes: 200},
+			expectDiskPages: [][]uint32{{1, 3, 4}},
+		},
+		{
+			name:            "uninitialized only filter",
+			filter:          &internal.DiskFilter{UninitializedOnly: true, BusType: "SAS"},
+			maxResults:      1,
+			expectDiskPages: [][]uint32{{2}, {3}},
+		},
+		{
+			name:            "no disks match",
+			filter:          &internal.DiskFilter{BusType: "SATA"},
+			expectDiskPages: [][]uint32{{}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pageToken := ""
+			for i, expectDisks := range tc.expectDiskPages {
+				request := &internal.ListDiskIDsRequest{
+					Filter:     tc.filter,
+					MaxResults: tc.maxResults,
+					PageToken:  pageToken,
+				}
+				response, err := srv.ListDiskIDs(context.TODO(), request, v2alpha1)
+				if err != nil {
+					t.Fatalf("ListDiskIDs returned error: %v", err)
+				}
+				disks := []uint32{}
+				for n := range response.DiskIDs {
+					disks = append(disks, n)
+				}
+				sort.Slice(disks, func(i, j int) bool { return disks[i] < disks[j] })
+				if !reflect.DeepEqual(disks, expectDisks) {
+					t.Errorf("Page %d: expected disks %v, got %v", i, expectDisks, disks)
+				}
+				pageToken = response.NextPageToken
+				if (i == len(tc.expectDiskPages)-1) != (pageToken == "") {
+					t.Fatalf("Page %d: unexpected next page token %q", i, pageToken)
+				}
+			}
+		})
+	}
+}
+
+func TestListDisks(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	diskAPI := &fakeDiskAPI{
+		disks: []shared.DiskInfo{
+			{Number: 1, BusType: "SAS", Size: 200, PartitionStyle: "RAW", FriendlyName: "Msft Virtual Disk", SerialNumber: "serial-1", IsOffline: true},
+			{Number: 0, BusType: "NVMe", Size: 50, PartitionStyle: "GPT", IsOSDisk: true},
+			{Number: 2, BusType: "SAS", Size: 300, PartitionStyle: "GPT", IsReadOnly: true},
+		},
+	}
+	srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk Server could not be initialized: %v", err)
+	}
+
+	response, err := srv.ListDisks(context.TODO(), &internal.ListDisksRequest{}, v2alpha1)
+	if err != nil {
+		t.Fatalf("ListDisks returned error: %v", err)
+	}
+	expected := []*internal.DiskInfo{
+		{DiskNumber: 0, BusType: "NVMe", SizeBytes: 50, PartitionStyle: "GPT", IsOsDisk: true},
+		{DiskNumber: 1, BusType: "SAS", SizeBytes: 200, PartitionStyle: "RAW", FriendlyName: "Msft Virtual Disk", SerialNumber: "serial-1", IsOffline: true},
+		{DiskNumber: 2, BusType: "SAS", SizeBytes: 300, PartitionStyle: "GPT", IsReadOnly: true},
+	}
+	if !reflect.DeepEqual(response.Disks, expected) || response.NextPageToken != "" {
+		t.Errorf("Expected disks %+v, got %+v", expected, response.Disks)
+	}
+
+	// the disks are filtered and paged like ListDiskIDs
+	request := &internal.ListDisksRequest{Filter: &internal.DiskFilter{BusType: "SAS"}, MaxResults: 1}
+	response, err = srv.ListDisks(context.TODO(), request, v2alpha1)
+	if err != nil {
+		t.Fatalf("ListDisks returned error: %v", err)
+	}
+	if len(response.Disks) != 1 || response.Disks[0].DiskNumber != 1 || response.NextPageToken != "2" {
+		t.Errorf("Expected the first page with disk 1, got %+v, next page token %q", response.Disks, response.NextPageToken)
+	}
+
+	request = &internal.ListDisksRequest{Filter: &internal.DiskFilter{MaxSizeBytes: -1}}
+	if _, err := srv.ListDisks(context.TODO(), request, v2alpha1); err == nil {
+		t.Errorf("Expected error for a negative maximum size")
+	}
+}
+
+func TestGetFreeDiskLocations(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	diskAPI := &fakeDiskAPI{
+		locations: map[uint32]shared.DiskLocation{
+			0: {Adapter: "0", Target: "0", LUNID: "0"},
+			1: {Adapter: "1", Target: "0", LUNID: "0"},
+			2: {Adapter: "1", Target: "0", LUNID: "2"},
+			3: {Adapter: "10", Target: "1", LUNID: "1"},
+		},
+	}
+	srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+
+	response, err := srv.GetFreeDiskLocations(context.TODO(), &internal.GetFreeDiskLocationsRequest{MaxLuns: 4}, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	expected := []*internal.FreeDiskLocation{
+		{Adapter: "0", Target: "0", FreeLunIds: []uint32{1, 2, 3}},
+		{Adapter: "1", Target: "0", FreeLunIds: []uint32{1, 3}},
+		{Adapter: "10", Target: "1", FreeLunIds: []uint32{0, 2, 3}},
+	}
+	if !reflect.DeepEqual(response.Locations, expected) {
+		t.Errorf("Expected locations %+v, got %+v", expected, response.Locations)
+	}
+
+	response, err = srv.GetFreeDiskLocations(context.TODO(), &internal.GetFreeDiskLocationsRequest{}, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if n := len(response.Locations[0].FreeLunIds); n != defaultMaxLuns-1 {
+		t.Errorf("Expected %d free LUNs by default, got %d", defaultMaxLuns-1, n)
+	}
+
+	if _, err := srv.GetFreeDiskLocations(context.TODO(), &internal.GetFreeDiskLocationsRequest{MaxLuns: 1000}, v2alpha1); err == nil {
+		t.Errorf("Expected error for too many LUNs")
+	}
+}
+
+func TestGetDiskNumberByLocation(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	diskAPI := &fakeDiskAPI{
+		locations: map[uint32]shared.DiskLocation{
+			0: {Adapter: "0", Bus: "0", Target: "0", LUNID: "0"},
+			1: {Adapter: "1", Bus: "0", Target: "0", LUNID: "0"},
+			2: {Adapter: "1", Bus: "0", Target: "0", LUNID: "2"},
+		},
+	}
+	srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+
+	testCases := []struct {
+		name               string
+		request            *internal.GetDiskNumberByLocationRequest
+		expectedDiskNumber uint32
+		expectCode         codes.Code
+	}{
+		{
+			name:               "full location",
+			request:            &internal.GetDiskNumberByLocationRequest{Adapter: "1", Bus: "0", Target: "0", LunId: "2"},
+			expectedDiskNumber: 2,
+			expectCode:         codes.OK,
+		},
+		{
+			name:               "adapter and LUN",
+			request:            &internal.GetDiskNumberByLocationRequest{Adapter: "1", LunId: "0"},
+			expectedDiskNumber: 1,
+			expectCode:         codes.OK,
+		},
+		{
+			name:       "free LUN",
+			request:    &internal.GetDiskNumberByLocationRequest{Adapter: "1", LunId: "1"},
+			expectCode: codes.NotFound,
+		},
+		{
+			name:       "several disks",
+			request:    &internal.GetDiskNumberByLocationRequest{LunId: "0"},
+			expectCode: codes.Unknown,
+		},
+		{
+			name:       "no LUN",
+			request:    &internal.GetDiskNumberByLocationRequest{Adapter: "1"},
+			expectCode: codes.Unknown,
+		},
+	}
+	for _, tc := range testCases {
+		response, err := srv.GetDiskNumberByLocation(context.TODO(), tc.request, v2alpha1)
+		if code := status.Code(err); code != tc.expectCode {
+			t.Errorf("%s: expected code %v, got %v", tc.name, tc.expectCode, err)
+			continue
+		}
+		if err == nil && response.DiskNumber != tc.expectedDiskNumber {
+			t.Errorf("%s: expected disk %d, got %d", tc.name, tc.expectedDiskNumber, response.DiskNumber)
+		}
+	}
+}
+
+func TestConvertDiskToGPT(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	disks := []shared.DiskInfo{
+		{Number: 0, BusType: "SAS", Size: 100, PartitionStyle: "MBR", IsOSDisk: true},
+		{Number: 1, BusType: "SAS", Size: 200, PartitionStyle: "MBR"},
+		{Number: 2, BusType: "SAS", Size: 200, PartitionStyle: "GPT"},
+		{Number: 3, BusType: "SAS", Size: 200, PartitionStyle: "RAW"},
+	}
+
+	testCases := []struct {
+		name               string
+		diskNumber         uint32
+		partitioned        bool
+		expectCode         codes.Code
+		expectedConversion bool
+	}{
+		{
+			name:               "empty MBR disk",
+			diskNumber:         1,
+			expectCode:         codes.OK,
+			expectedConversion: true,
+		},
+		{
+			name:        "MBR disk with partitions",
+			diskNumber:  1,
+			partitioned: true,
+			expectCode:  codes.FailedPrecondition,
+		},
+		{
+			name:       "GPT disk",
+			diskNumber: 2,
+			expectCode: codes.OK,
+		},
+		{
+			name:       "uninitialized disk",
+			diskNumber: 3,
+			expectCode: codes.FailedPrecondition,
+		},
+		{
+			name:       "OS disk",
+			diskNumber: 0,
+			expectCode: codes.Unknown,
+		},
+		{
+			name:       "missing disk",
+			diskNumber: 4,
+			expectCode: codes.NotFound,
+		},
+	}
+	for _, tc := range testCases {
+		diskAPI := &fakeDiskAPI{disks: disks, unpartitioned: !tc.partitioned}
+		srv, err := NewServer(shared.DiskPolicy{ProtectOSDisks: true}, diskAPI)
+		if err != nil {
+			t.Fatalf("Disk server could not be initialized: %v", err)
+		}
+		request := &internal.ConvertDiskToGPTRequest{DiskNumber: tc.diskNumber}
+		_, err = srv.ConvertDiskToGPT(context.TODO(), request, v2alpha1)
+		if code := status.Code(err); code != tc.expectCode {
+			t.Errorf("%s: expected code %v, got %v", tc.name, tc.expectCode, err)
+		}
+		var expected []string
+		if tc.expectedConversion {
+			expected = []string{fmt.Sprintf("%d GPT", tc.diskNumber)}
+		}
+		if !reflect.DeepEqual(diskAPI.conversions, expected) {
+			t.Errorf("%s: expected conversions %v, got %v", tc.name, expected, diskAPI.conversions)
+		}
+	}
+}
+
+func TestPartitionDiskPolicy(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	disks := []shared.DiskInfo{
+		{Number: 0, BusType: "SAS", Size: 100, PartitionStyle: "GPT", IsOSDisk: true},
+		{Number: 1, BusType: "SAS", Size: 200, PartitionStyle: "RAW"},
+		{Number: 2, BusType: "NVMe", Size: 200, PartitionStyle: "RAW"},
+		{Number: 3, BusType: "SAS", Size: 10, PartitionStyle: "RAW"},
+	}
+	policy := shared.DiskPolicy{
+		AllowedBusTypes: []string{"sas", "iSCSI"},
+		MinSizeBytes:    50,
+		ProtectOSDisks:  true,
+	}
+
+	testCases := []struct {
+		name        string
+		diskNumber  uint32
+		policy      shared.DiskPolicy
+		expectError bool
+	}{
+		{
+			name:       "allowed disk",
+			diskNumber: 1,
+			policy:     policy,
+		},
+		{
+			name:        "OS disk",
+			diskNumber:  0,
+			policy:      policy,
+			expectError: true,
+		},
+		{
+			name:        "bus type not allowed",
+			diskNumber:  2,
+			policy:      policy,
+			expectError: true,
+		},
+		{
+			name:        "disk too small",
+			diskNumber:  3,
+			policy:      policy,
+			expectError: true,
+		},
+		{
+			name:        "unknown disk",
+			diskNumber:  4,
+			policy:      policy,
+			expectError: true,
+		},
+		{
+			name:       "no policy",
+			diskNumber: 0,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diskAPI := &fakeDiskAPI{disks: disks, uninitialized: true}
+			srv, err := NewServer(tc.policy, diskAPI)
+			if err != nil {
+				t.Fatalf("Disk server could not be initialized: %v", err)
+			}
+			_, err = srv.PartitionDisk(context.TODO(), &internal.PartitionDiskRequest{DiskNumber: tc.diskNumber}, v2alpha1)
+			if tc.expectError != (err != nil) {
+				t.Errorf("Expected error=%v, got %v", tc.expectError, err)
+			}
+			if diskAPI.uninitialized != tc.expectError {
+				t.Errorf("Expected the disk to be initialized=%v", !tc.expectError)
+			}
+		})
+	}
+}
+
+func TestDeletePartition(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	disks := []shared.DiskInfo{
+		{Number: 0, BusType: "SAS", Size: 100, PartitionStyle: "GPT", IsOSDisk: true},
+		{Number: 1, BusType: "SAS", Size: 200, PartitionStyle: "GPT"},
+	}
+	diskAPI := &fakeDiskAPI{disks: disks}
+	srv, err := NewServer(shared.DiskPolicy{ProtectOSDisks: true}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+
+	for _, partitionNumber := range []uint32{2, 0} {
+		request := &internal.DeletePartitionRequest{DiskNumber: 1, PartitionNumber: partitionNumber}
+		if _, err := srv.DeletePartition(context.TODO(), request, v2alpha1); err != nil {
+			t.Errorf("DeletePartition of partition %d failed: %v", partitionNumber, err)
+		}
+	}
+	// the partitions of the OS disk are never deleted
+	request := &internal.DeletePartitionRequest{DiskNumber: 0, PartitionNumber: 2}
+	if _, err := srv.DeletePartition(context.TODO(), request, v2alpha1); err == nil {
+		t.Errorf("Expected DeletePartition on the OS disk to fail")
+	}
+	if !reflect.DeepEqual(diskAPI.deletedPartitions, []uint32{2, 0}) {
+		t.Errorf("Expected partitions [2 0] to be deleted, got %v", diskAPI.deletedPartitions)
+	}
+}
+
+func TestWipeDisk(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	disks := []shared.DiskInfo{
+		{Number: 0, BusType: "SAS", Size: 100, PartitionStyle: "GPT", IsOSDisk: true},
+		{Number: 1, BusType: "SAS", Size: 200, PartitionStyle: "GPT"},
+	}
+
+	testCases := []struct {
+		name          string
+		request       *internal.WipeDiskRequest
+		uninitialized bool
+		expectWipes   []string
+		expectError   bool
+	}{
+		{
+			name:        "remove data",
+			request:     &internal.WipeDiskRequest{DiskNumber: 1, RemoveData: true},
+			expectWipes: []string{"1 removeData=true zeroFill=false"},
+		},
+		{
+			name:          "uninitialized disk",
+			request:       &internal.WipeDiskRequest{DiskNumber: 1, RemoveData: true},
+			uninitialized: true,
+		},
+		{
+			name:          "zero fill of an uninitialized disk",
+			request:       &internal.WipeDiskRequest{DiskNumber: 1, ZeroFill: true},
+			uninitialized: true,
+			expectWipes:   []string{"1 removeData=false zeroFill=true"},
+		},
+		{
+			name:        "OS disk",
+			request:     &internal.WipeDiskRequest{DiskNumber: 0, RemoveData: true},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diskAPI := &fakeDiskAPI{disks: disks, uninitialized: tc.uninitialized}
+			srv, err := NewServer(shared.DiskPolicy{ProtectOSDisks: true}, diskAPI)
+			if err != nil {
+				t.Fatalf("Disk server could not be initialized: %v", err)
+			}
+			_, err = srv.WipeDisk(context.TODO(), tc.request, v2alpha1)
+			if tc.expectError != (err != nil) {
+				t.Errorf("Expected error=%v, got %v", tc.expectError, err)
+			}
+			if fmt.Sprint(diskAPI.wipes) != fmt.Sprint(tc.expectWipes) {
+				t.Errorf("Expected the wipes %v, got %v", tc.expectWipes, diskAPI.wipes)
+			}
+		})
+	}
+}
+
+func TestSetPartitionType(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	disks := []shared.DiskInfo{
+		{Number: 0, BusType: "SAS", Size: 100, PartitionStyle: "GPT", IsOSDisk: true},
+		{Number: 1, BusType: "SAS", Size: 200, PartitionStyle: "GPT"},
+	}
+	diskAPI := &fakeDiskAPI{disks: disks}
+	srv, err := NewServer(shared.DiskPolicy{ProtectOSDisks: true}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+
+	const basicData = "{ebd0a0a2-b9e5-4433-87c0-68b6b72699c7}"
+	request := &internal.SetPartitionTypeRequest{DiskNumber: 1, PartitionNumber: 2, GptType: basicData}
+	if _, err := srv.SetPartitionType(context.TODO(), request, v2alpha1); err != nil {
+		t.Errorf("SetPartitionType failed: %v", err)
+	}
+	invalidRequests := []*internal.SetPartitionTypeRequest{
+		// the partitions of the OS disk are never changed
+		{DiskNumber: 0, PartitionNumber: 2, GptType: basicData},
+		{DiskNumber: 1, GptType: basicData},
+		{DiskNumber: 1, PartitionNumber: 2, GptType: "basic"},
+	}
+	for _, request := range invalidRequests {
+		if _, err := srv.SetPartitionType(context.TODO(), request, v2alpha1); err == nil {
+			t.Errorf("Expected SetPartitionType %+v to fail", request)
+		}
+	}
+	expected := []string{"1/2=" + basicData}
+	if !reflect.DeepEqual(diskAPI.partitionTypes, expected) {
+		t.Errorf("Expected GPT types %v, got %v", expected, diskAPI.partitionTypes)
+	}
+}
+
+func TestPartitionDiskStyleAndSize(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	testCases := []struct {
+		name          string
+		request       *internal.PartitionDiskRequest
+		expectedStyle disk.PartitionStyle
+		expectError   bool
+	}{
+		{
+			name:          "default GPT",
+			request:       &internal.PartitionDiskRequest{DiskNumber: 1},
+			expectedStyle: disk.PartitionStyleGPT,
+		},
+		{
+			name:          "MBR with size and offset",
+			request:       &internal.PartitionDiskRequest{DiskNumber: 1, PartitionStyle: internal.PARTITION_STYLE_MBR, SizeBytes: 1 << 30, OffsetBytes: 1 << 20},
+			expectedStyle: disk.PartitionStyleMBR,
+		},
+		{
+			name:        "invalid partition style",
+			request:     &internal.PartitionDiskRequest{DiskNumber: 1, PartitionStyle: 7},
+			expectError: true,
+		},
+		{
+			name:        "negative size",
+			request:     &internal.PartitionDiskRequest{DiskNumber: 1, SizeBytes: -1},
+			expectError: true,
+		},
+		{
+			name:          "GPT type",
+			request:       &internal.PartitionDiskRequest{DiskNumber: 1, GptType: "{e3c9e316-0b5c-4db8-817d-f92df00215ae}"},
+			expectedStyle: disk.PartitionStyleGPT,
+		},
+		{
+			name:        "invalid GPT type",
+			request:     &internal.PartitionDiskRequest{DiskNumber: 1, GptType: "e3c9e316-0b5c-4db8-817d-f92df00215ae'; Clear-Disk"},
+			expectError: true,
+		},
+		{
+			name:        "GPT type on MBR",
+			request:     &internal.PartitionDiskRequest{DiskNumber: 1, PartitionStyle: internal.PARTITION_STYLE_MBR, GptType: "{ebd0a0a2-b9e5-4433-87c0-68b6b72699c7}"},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diskAPI := &fakeDiskAPI{uninitialized: true, unpartitioned: true}
+			srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+			if err != nil {
+				t.Fatalf("Disk server could not be initialized: %v", err)
+			}
+			_, err = srv.PartitionDisk(context.TODO(), tc.request, v2alpha1)
+			if tc.expectError {
+				if err == nil || !diskAPI.uninitialized || !diskAPI.unpartitioned {
+					t.Errorf("Expected an error without touching the disk, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Error %v not expected", err)
+			}
+			if diskAPI.partitionStyle != tc.expectedStyle {
+				t.Errorf("Expected partition style %s, got %s", tc.expectedStyle, diskAPI.partitionStyle)
+			}
+			if diskAPI.partitionSize != tc.request.SizeBytes || diskAPI.partitionOffset != tc.request.OffsetBytes {
+				t.Errorf("Expected a partition of %d bytes at %d, got %d bytes at %d",
+					tc.request.SizeBytes, tc.request.OffsetBytes, diskAPI.partitionSize, diskAPI.partitionOffset)
+			}
+			if diskAPI.partitionGptType != tc.request.GptType {
+				t.Errorf("Expected GPT type %q, got %q", tc.request.GptType, diskAPI.partitionGptType)
+			}
+		})
+	}
+}
+
+func TestPartitionDiskBringOnline(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	for _, clustered := range []bool{false, true} {
+		diskAPI := &fakeDiskAPI{uninitialized: true, unpartitioned: true, clustered: clustered}
+		srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+		if err != nil {
+			t.Fatalf("Disk server could not be initialized: %v", err)
+		}
+		request := &internal.PartitionDiskRequest{DiskNumber: 1, BringOnline: true}
+		_, err = srv.PartitionDisk(context.TODO(), request, v2alpha1)
+		// shared disks are only brought online with a reservation
+		if clustered != (err != nil) {
+			t.Errorf("Expected error=%v for clustered=%v, got %v", clustered, clustered, err)
+		}
+		if diskAPI.online == clustered || diskAPI.uninitialized != clustered {
+			t.Errorf("Expected online=%v and initialized=%v for clustered=%v", !clustered, !clustered, clustered)
+		}
+	}
+}
+
+func TestSanPolicy(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	diskAPI := &fakeDiskAPI{}
+	srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+
+	response, err := srv.GetSanPolicy(context.TODO(), &internal.GetSanPolicyRequest{}, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if response.SanPolicy != internal.SAN_POLICY_OFFLINE_SHARED {
+		t.Errorf("Expected SAN policy OfflineShared, got %d", response.SanPolicy)
+	}
+
+	_, err = srv.SetSanPolicy(context.TODO(), &internal.SetSanPolicyRequest{SanPolicy: internal.SAN_POLICY_ONLINE_ALL}, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if diskAPI.sanPolicy != disk.SanPolicyOnlineAll {
+		t.Errorf("Expected SAN policy OnlineAll, got %s", diskAPI.sanPolicy)
+	}
+	response, err = srv.GetSanPolicy(context.TODO(), &internal.GetSanPolicyRequest{}, v2alpha1)
+	if err != nil || response.SanPolicy != internal.SAN_POLICY_ONLINE_ALL {
+		t.Errorf("Expected SAN policy OnlineAll, got %+v, %v", response, err)
+	}
+
+	_, err = srv.SetSanPolicy(context.TODO(), &internal.SetSanPolicyRequest{SanPolicy: 9}, v2alpha1)
+	if err == nil {
+		t.Errorf("Expected an error for an invalid SAN policy")
+	}
+}
+
+func TestWaitForDiskSizeChange(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	pollInterval, rescanInterval := diskSizePollInterval, diskRescanInterval
+	defer func() {
+		diskSizePollInterval, diskRescanInterval = pollInterval, rescanInterval
+	}()
+	diskSizePollInterval, diskRescanInterval = time.Millisecond, 2*time.Millisecond
+
+	testCases := []struct {
+		name            string
+		sizes           []int64
+		expectedSize    int64
+		timeout         time.Duration
+		expectCode      codes.Code
+		expectSize      int64
+		expectRescanned bool
+	}{
+		{
+			name:         "disk already expanded",
+			sizes:        []int64{2048},
+			expectedSize: 2048,
+			expectCode:   codes.OK,
+			expectSize:   2048,
+		},
+		{
+			name:            "disk expanded after a few polls",
+			sizes:           []int64{1024, 1024, 1024, 1024, 4096},
+			expectedSize:    2048,
+			expectCode:      codes.OK,
+			expectSize:      4096,
+			expectRescanned: true,
+		},
+		{
+			name:         "disk never expanded",
+			sizes:        []int64{1024},
+			expectedSize: 2048,
+			timeout:      20 * time.Millisecond,
+			expectCode:   codes.DeadlineExceeded,
+		},
+		{
+			name:         "invalid expected size",
+			expectedSize: 0,
+			expectCode:   codes.Unknown,
+		},
+	}
+	for _, tc := range testCases {
+		diskAPI := &fakeDiskAPI{sizes: tc.sizes}
+		srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+		if err != nil {
+			t.Fatalf("Disk server could not be initialized: %v", err)
+		}
+		response, err := srv.WaitForDiskSizeChange(context.TODO(), &internal.WaitForDiskSizeChangeRequest{
+			DiskNumber:        1,
+			ExpectedSizeBytes: tc.expectedSize,
+			TimeoutNanos:      tc.timeout.Nanoseconds(),
+		}, v2alpha1)
+		if code := status.Code(err); code != tc.expectCode {
+			t.Errorf("%s: expected code %v, got %v", tc.name, tc.expectCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if response.TotalBytes != tc.expectSize {
+			t.Errorf("%s: expected size %d, got %d", tc.name, tc.expectSize, response.TotalBytes)
+		}
+		if diskAPI.rescanCalls == 0 || (tc.expectRescanned && diskAPI.rescanCalls < 2) {
+			t.Errorf("%s: unexpected number of rescans %d", tc.name, diskAPI.rescanCalls)
+		}
+	}
+}
+
+func TestWaitForDisk(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	pollInterval, rescanInterval := diskArrivalPollInterval, diskRescanInterval
+	defer func() {
+		diskArrivalPollInterval, diskRescanInterval = pollInterval, rescanInterval
+	}()
+	diskArrivalPollInterval, diskRescanInterval = time.Millisecond, 2*time.Millisecond
+
+	testCases := []struct {
+		name           string
+		request        *internal.WaitForDiskRequest
+		arrivalRescans int
+		expectCode     codes.Code
+		expectDisk     uint32
+	}{
+		{
+			name:       "disk already attached",
+			request:    &internal.WaitForDiskRequest{SerialNumber: "serial-2"},
+			expectCode: codes.OK,
+			expectDisk: 2,
+		},
+		{
+			name:           "disk attached after a few rescans",
+			request:        &internal.WaitForDiskRequest{Page83Id: "naa.600A0B80"},
+			arrivalRescans: 3,
+			expectCode:     codes.OK,
+			expectDisk:     1,
+		},
+		{
+			name:           "disk attached at a location",
+			request:        &internal.WaitForDiskRequest{Target: "1", LunId: "2"},
+			arrivalRescans: 1,
+			expectCode:     codes.OK,
+			expectDisk:     2,
+		},
+		{
+			name:       "disk never attached",
+			request:    &internal.WaitForDiskRequest{SerialNumber: "serial-3", TimeoutNanos: (20 * time.Millisecond).Nanoseconds()},
+			expectCode: codes.DeadlineExceeded,
+		},
+		{
+			name:       "several disks match",
+			request:    &internal.WaitForDiskRequest{LunId: "2"},
+			expectCode: codes.Unknown,
+		},
+		{
+			name:       "no matcher",
+			request:    &internal.WaitForDiskRequest{Adapter: "0"},
+			expectCode: codes.Unknown,
+		},
+		{
+			name:       "several matchers",
+			request:    &internal.WaitForDiskRequest{SerialNumber: "serial-2", LunId: "2"},
+			expectCode: codes.Unknown,
+		},
+	}
+	for _, tc := range testCases {
+		diskAPI := &fakeDiskAPI{
+			disks: []shared.DiskInfo{
+				{Number: 1, SerialNumber: "serial-1"},
+				{Number: 2, SerialNumber: "serial-2"},
+			},
+			locations: map[uint32]shared.DiskLocation{
+				1: {Adapter: "0", Bus: "0", Target: "0", LUNID: "2"},
+				2: {Adapter: "0", Bus: "0", Target: "1", LUNID: "2"},
+			},
+			page83IDs: map[uint32][]shared.Page83ID{
+				1: {{Type: "NAA", Association: "Device", Value: "600a0b80"}},
+			},
+			arrivalRescans: tc.arrivalRescans,
+		}
+		srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+		if err != nil {
+			t.Fatalf("Disk server could not be initialized: %v", err)
+		}
+		response, err := srv.WaitForDisk(context.TODO(), tc.request, v2alpha1)
+		if code := status.Code(err); code != tc.expectCode {
+			t.Errorf("%s: expected code %v, got %v", tc.name, tc.expectCode, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if response.DiskNumber != tc.expectDisk {
+			t.Errorf("%s: expected disk %d, got %d", tc.name, tc.expectDisk, response.DiskNumber)
+		}
+		if diskAPI.rescanCalls < tc.arrivalRescans {
+			t.Errorf("%s: expected at least %d rescans, got %d", tc.name, tc.arrivalRescans, diskAPI.rescanCalls)
+		}
+	}
+}
+
+func TestDiskReadOnly(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	diskAPI := &fakeDiskAPI{
+		disks: []shared.DiskInfo{
+			{Number: 0, BusType: "SAS", Size: 100, PartitionStyle: "GPT", IsOSDisk: true},
+			{Number: 1, BusType: "SAS", Size: 200, PartitionStyle: "RAW", IsReadOnly: true},
+		},
+		readOnly: map[uint32]bool{1: true},
+	}
+	srv, err := NewServer(shared.DiskPolicy{ProtectOSDisks: true}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+
+	response, err := srv.GetDiskReadOnly(context.TODO(), &internal.GetDiskReadOnlyRequest{DiskNumber: 1}, v2alpha1)
+	if err != nil || !response.ReadOnly {
+		t.Errorf("Expected disk 1 to be read-only, got %+v, %v", response, err)
+	}
+	// clear the read-only attribute set by the SAN
+	if _, err := srv.SetDiskReadOnly(context.TODO(), &internal.SetDiskReadOnlyRequest{DiskNumber: 1}, v2alpha1); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	response, err = srv.GetDiskReadOnly(context.TODO(), &internal.GetDiskReadOnlyRequest{DiskNumber: 1}, v2alpha1)
+	if err != nil || response.ReadOnly {
+		t.Errorf("Expected disk 1 to be writable, got %+v, %v", response, err)
+	}
+
+	// the OS disks are protected by the disk policy
+	if _, err := srv.SetDiskReadOnly(context.TODO(), &internal.SetDiskReadOnlyRequest{DiskNumber: 0, ReadOnly: true}, v2alpha1); err == nil {
+		t.Errorf("Expected error for the OS disk")
+	}
+	if diskAPI.readOnly[0] {
+		t.Errorf("Expected the OS disk to be left writable")
+	}
+}
+
+func TestGetDiskReliabilityCounters(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	counters := shared.ReliabilityCounters{
+		TemperatureCelsius:     41,
+		MaxTemperatureCelsius:  70,
+		WearPercentage:         3,
+		ReadErrorsTotal:        2,
+		ReadErrorsCorrected:    2,
+		ReadErrorsUncorrected:  0,
+		WriteErrorsTotal:       shared.CounterNotReported,
+		WriteErrorsCorrected:   shared.CounterNotReported,
+		WriteErrorsUncorrected: shared.CounterNotReported,
+		PowerOnHours:           1234,
+		ReadLatencyMaxMs:       97,
+		WriteLatencyMaxMs:      211,
+		FlushLatencyMaxMs:      shared.CounterNotReported,
+	}
+	diskAPI := &fakeDiskAPI{reliabilityCounters: map[uint32]shared.ReliabilityCounters{1: counters}}
+	srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+
+	response, err := srv.GetDiskReliabilityCounters(context.TODO(), &internal.GetDiskReliabilityCountersRequest{DiskNumber: 1}, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	expected := &internal.GetDiskReliabilityCountersResponse{
+		TemperatureCelsius:     41,
+		MaxTemperatureCelsius:  70,
+		WearPercentage:         3,
+		ReadErrorsTotal:        2,
+		ReadErrorsCorrected:    2,
+		ReadErrorsUncorrected:  0,
+		WriteErrorsTotal:       -1,
+		WriteErrorsCorrected:   -1,
+		WriteErrorsUncorrected: -1,
+		PowerOnHours:           1234,
+		ReadLatencyMaxMs:       97,
+		WriteLatencyMaxMs:      211,
+		FlushLatencyMaxMs:      -1,
+	}
+	if !reflect.DeepEqual(response, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, response)
+	}
+
+	// virtual disks don't report reliability counters
+	_, err = srv.GetDiskReliabilityCounters(context.TODO(), &internal.GetDiskReliabilityCountersRequest{DiskNumber: 2}, v2alpha1)
+	if code, _ := utils.StableErrorCode(err); code != utils.ErrorCodeNotSupported {
+		t.Errorf("Expected a %s error, got %v", utils.ErrorCodeNotSupported, err)
+	}
+}
+
+func TestGetDiskNumberBySerialNumber(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	diskAPI := &fakeDiskAPI{
+		disks: []shared.DiskInfo{
+			{Number: 0, BusType: "NVMe", SerialNumber: "os-disk"},
+			{Number: 1, BusType: "SAS", SerialNumber: "  6000C29ABCDEF  "},
+			{Number: 2, BusType: "SAS", SerialNumber: "shared"},
+			{Number: 3, BusType: "SAS", SerialNumber: "shared"},
+		},
+	}
+	srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+
+	testCases := []struct {
+		serialNumber       string
+		expectedDiskNumber uint32
+		expectCode         codes.Code
+	}{
+		{serialNumber: "6000c29abcdef", expectedDiskNumber: 1, expectCode: codes.OK},
+		{serialNumber: "os-disk", expectedDiskNumber: 0, expectCode: codes.OK},
+		{serialNumber: "missing", expectCode: codes.NotFound},
+		{serialNumber: "shared", expectCode: codes.Unknown},
+		{serialNumber: " ", expectCode: codes.Unknown},
+	}
+	for _, tc := range testCases {
+		response, err := srv.GetDiskNumberBySerialNumber(context.TODO(), &internal.GetDiskNumberBySerialNumberRequest{SerialNumber: tc.serialNumber}, v2alpha1)
+		if code := status.Code(err); code != tc.expectCode {
+			t.Errorf("%q: expected code %v, got %v", tc.serialNumber, tc.expectCode, err)
+			continue
+		}
+		if err == nil && response.DiskNumber != tc.expectedDiskNumber {
+			t.Errorf("%q: expected disk %d, got %d", tc.serialNumber, tc.expectedDiskNumber, response.DiskNumber)
+		}
+	}
+}
+
+func TestGetDiskNumberByPage83ID(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	targetPort := shared.Page83ID{Type: "RelativeTargetPort", Association: "Port", Value: "00000001"}
+	diskAPI := &fakeDiskAPI{
+		page83IDs: map[uint32][]shared.Page83ID{
+			1: {{Type: "NAA", Association: "Device", Value: "600a0b800026e1d20000c2ff4b6f9ec3"}, targetPort},
+			2: {{Type: "EUI64", Association: "Device", Value: "0025385b71b0ab5d"}, targetPort},
+			3: {{Type: "T10", Association: "Device", Value: "MSFT    4FC7D7A3F6E1B34DA6CE6FFEBB8A6D73"}},
+			4: {{Type: "T10", Association: "Device", Value: "MSFT    4FC7D7A3F6E1B34DA6CE6FFEBB8A6D73"}},
+		},
+	}
+	srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+
+	testCases := []struct {
+		page83ID           string
+		expectedDiskNumber uint32
+		expectCode         codes.Code
+	}{
+		{page83ID: "naa.600A0B800026E1D20000C2FF4B6F9EC3", expectedDiskNumber: 1, expectCode: codes.OK},
+		{page83ID: "0025385b71b0ab5d", expectedDiskNumber: 2, expectCode: codes.OK},
+		{page83ID: "eui.0025385B71B0AB5D", expectedDiskNumber: 2, expectCode: codes.OK},
+		// the identifiers of the ports aren't identifiers of the disks
+		{page83ID: "00000001", expectCode: codes.NotFound},
+		{page83ID: "msft    4fc7d7a3f6e1b34da6ce6ffebb8a6d73", expectCode: codes.Unknown},
+		{page83ID: "", expectCode: codes.Unknown},
+	}
+	for _, tc := range testCases {
+		response, err := srv.GetDiskNumberByPage83ID(context.TODO(), &internal.GetDiskNumberByPage83IDRequest{Page83Id: tc.page83ID}, v2alpha1)
+		if code := status.Code(err); code != tc.expectCode {
+			t.Errorf("%q: expected code %v, got %v", tc.page83ID, tc.expectCode, err)
+			continue
+		}
+		if err == nil && response.DiskNumber != tc.expectedDiskNumber {
+			t.Errorf("%q: expected disk %d, got %d", tc.page83ID, tc.expectedDiskNumber, response.DiskNumber)
+		}
+	}
+
+	listResponse, err := srv.ListDiskPage83IDs(context.TODO(), &internal.ListDiskPage83IDsRequest{}, v2alpha1)
+	if err != nil {
+		t.Fatalf("ListDiskPage83IDs failed: %v", err)
+	}
+	if len(listResponse.DiskPage83IDs) != 4 || len(listResponse.DiskPage83IDs[1].Ids) != 2 || listResponse.DiskPage83IDs[1].Ids[0].Type != "NAA" {
+		t.Errorf("Unexpected page83 ids %v", listResponse.DiskPage83IDs)
+	}
+}
+
+func TestDiskIdentity(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	diskAPI := &fakeDiskAPI{
+		disks: []shared.DiskInfo{
+			{Number: 0, SerialNumber: "os-disk", GUID: "{0D6A3E9C-0A5B-4C9E-8E3F-4B1F2A7C9D10}"},
+			{Number: 1, SerialNumber: " shared "},
+			{Number: 2, SerialNumber: "shared", Signature: 0x5eed},
+			{Number: 3},
+		},
+		page83IDs: map[uint32][]shared.Page83ID{
+			1: {{Type: "RelativeTargetPort", Association: "Port", Value: "00000001"}, {Type: "NAA", Association: "Device", Value: "600A0B800026E1D2"}},
+			2: {{Type: "NAA", Association: "Device", Value: "600a0b800026e1d3"}},
+		},
+	}
+	srv, err := NewServer(shared.DiskPolicy{}, diskAPI)
+	if err != nil {
+		t.Fatalf("Disk server could not be initialized: %v", err)
+	}
+
+	expected := map[uint32]internal.DiskIdentity{
+		0: {SerialNumber: "os-disk", Guid: "0d6a3e9c-0a5b-4c9e-8e3f-4b1f2a7c9d10"},
+		1: {SerialNumber: "shared", Page83Id: "600a0b800026e1d2"},
+		2: {SerialNumber: "shared", Page83Id: "600a0b800026e1d3", Signature: 0x5eed},
+	}
+	for diskNumber, identity := range expected {
+		response, err := srv.GetDiskIdentity(context.TODO(), &internal.GetDiskIdentityRequest{DiskNumber: diskNumber}, v2alpha1)
+		if err != nil {
+			t.Fatalf("GetDiskIdentity of disk %d failed: %v", diskNumber, err)
+		}
+		if *response.Identity != identity {
+			t.Errorf("expected the identity %+v of disk %d, got %+v", identity, diskNumber, *response.Identity)
+		}
+		// the disks are numbered in another order after a reboot
+		resolveResponse, err := srv.ResolveDiskIdentity(context.TODO(), &internal.ResolveDiskIdentityRequest{Identity: response.Identity}, v2alpha1)
+		if err != nil {
+			t.Fatalf("ResolveDiskIdentity of disk %d failed: %v", diskNumber, err)
+		}
+		if resolveResponse.DiskNumber != diskNumber {
+			t.Errorf("expected disk %d, got %d", diskNumber, resolveResponse.DiskNumber)
+		}
+	}
+
+	if _, err := srv.GetDiskIdentity(context.TODO(), &internal.GetDiskIdentityRequest{DiskNumber: 3}, v2alpha1); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition for a disk without identifiers, got %v", err)
+	}
+	if _, err := srv.GetDiskIdentity(context.TODO(), &internal.GetDiskIdentityRequest{DiskNumber: 9}, v2alpha1); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for a missing disk, got %v", err)
+	}
+
+	testCases := []struct {
+		name               string
+		identity           *internal.DiskIdentity
+		expectedDiskNumber uint32
+		expectCode         codes.Code
+	}{
+		{
+			name:               "identity taken before the disk was initialized",
+			identity:           &internal.DiskIdentity{SerialNumber: "SHARED", Page83Id: "naa.600a0b800026e1d3"},
+			expectedDiskNumber: 2,
+			expectCode:         codes.OK,
+		},
+		{
+			name:       "disk reinitialized",
+			identity:   &internal.DiskIdentity{SerialNumber: "shared", Signature: 0x1234},
+			expectCode: codes.NotFound,
+		},
+		{
+			name:       "ambiguous",
+			identity:   &internal.DiskIdentity{SerialNumber: "shared"},
+			expectCode: codes.Unknown,
+		},
+		{
+			name:       "empty",
+			identity:   &internal.DiskIdentity{},
+			expectCode: codes.Unknown,
+		},
+	}
+	for _, tc := range testCases {
+		response, err := srv.ResolveDiskIdentity(context.TODO(), &internal.ResolveDiskIdentityRequest{Identity: tc.identity}, v2alpha1)
+		if code := status.Code(err); code != tc.expectCode {
+			t.Errorf("%s: expected code %v, got %v", tc.name, tc.expectCode, err)
+			continue
+		}
+		if err == nil && response.DiskNumber != tc.expectedDiskNumber {
+			t.Errorf("%s: expected disk %d, got %d", tc.name, tc.expectedDiskNumber, response.DiskNumber)
+		}
+	}
+}
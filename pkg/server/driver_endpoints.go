@@ -0,0 +1,137 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// driverNameRegexp matches the names of the drivers, they're the suffix of the
+// named pipes of their endpoints.
+var driverNameRegexp = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]{0,62}$`)
+
+// DriverEndpoint is a set of named pipes dedicated to a CSI driver (e.g.
+// \\.\pipe\csi-proxy-smb-v1-driverA), the operations of the driver are served
+// with its own policy and quota and are counted under its name in the metrics.
+type DriverEndpoint struct {
+	// Name of the driver, it's the suffix of the named pipes and the label of
+	// the metrics of the driver.
+	Name string `json:"name"`
+
+	// AllowedMethods are the methods the driver can call by service and method
+	// name e.g. "Volume/MountVolume", or "Volume/*" for all the methods of an
+	// API group. The pipes are only created for the API groups with allowed
+	// methods, all the methods are allowed if empty.
+	AllowedMethods []string `json:"allowedMethods,omitempty"`
+
+	// MaxConcurrentOperations is the number of operations of the driver that
+	// run at the same time, the others fail with codes.ResourceExhausted. There's
+	// no limit if 0.
+	MaxConcurrentOperations int `json:"maxConcurrentOperations,omitempty"`
+}
+
+// ParseDriverEndpoints parses and validates the JSON list of driver endpoints
+// `data` e.g.
+// [{"name": "driverA", "allowedMethods": ["Smb/*"], "maxConcurrentOperations": 4}]
+func ParseDriverEndpoints(data []byte) ([]DriverEndpoint, error) {
+	var endpoints []DriverEndpoint
+	if err := json.Unmarshal(data, &endpoints); err != nil {
+		return nil, fmt.Errorf("error parsing the driver endpoints: %v", err)
+	}
+	names := make(map[string]bool)
+	for _, endpoint := range endpoints {
+		if !driverNameRegexp.MatchString(endpoint.Name) {
+			return nil, fmt.Errorf("invalid driver name %q, it must be at most 63 letters, digits, '_', '.' or '-'", endpoint.Name)
+		}
+		name := strings.ToLower(endpoint.Name)
+		if names[name] {
+			// pipe names are case insensitive
+			return nil, fmt.Errorf("duplicate driver %q", endpoint.Name)
+		}
+		names[name] = true
+		for _, method := range endpoint.AllowedMethods {
+			parts := strings.Split(method, "/")
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return nil, fmt.Errorf("invalid method %q of driver %s, expected <service>/<method> or <service>/*", method, endpoint.Name)
+			}
+		}
+		if endpoint.MaxConcurrentOperations < 0 {
+			return nil, fmt.Errorf("invalid maxConcurrentOperations %d of driver %s", endpoint.MaxConcurrentOperations, endpoint.Name)
+		}
+	}
+	return endpoints, nil
+}
+
+// driverEndpoint enforces the policy and the quota of a driver endpoint.
+type driverEndpoint struct {
+	DriverEndpoint
+	// slots has an element per operation of the driver in progress, it's nil if
+	// the driver has no quota.
+	slots chan struct{}
+}
+
+func newDriverEndpoint(endpoint DriverEndpoint) *driverEndpoint {
+	d := &driverEndpoint{DriverEndpoint: endpoint}
+	if endpoint.MaxConcurrentOperations > 0 {
+		d.slots = make(chan struct{}, endpoint.MaxConcurrentOperations)
+	}
+	return d
+}
+
+// allowsGroup returns true if the driver can call methods of the API group
+// `group`.
+func (d *driverEndpoint) allowsGroup(group string) bool {
+	if len(d.AllowedMethods) == 0 {
+		return true
+	}
+	for _, allowed := range d.AllowedMethods {
+		if strings.EqualFold(strings.SplitN(allowed, "/", 2)[0], group) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsMethod returns true if the driver can call the method `method` e.g.
+// "Volume/MountVolume".
+func (d *driverEndpoint) allowsMethod(method string) bool {
+	if len(d.AllowedMethods) == 0 {
+		return true
+	}
+	service := strings.SplitN(method, "/", 2)[0]
+	for _, allowed := range d.AllowedMethods {
+		if strings.EqualFold(allowed, method) || strings.EqualFold(allowed, service+"/*") {
+			return true
+		}
+	}
+	return false
+}
+
+// intercept is the first interceptor of the GRPC servers of the driver, it
+// rejects the methods the driver isn't allowed to call and the operations above
+// its quota, and counts the operations of the driver.
+func (d *driverEndpoint) intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !d.allowsMethod(serviceMethod(info.FullMethod)) {
+		metrics.Drivers.Reject(d.Name, info.FullMethod)
+		return nil, status.Errorf(codes.PermissionDenied, "driver %s isn't allowed to call %s", d.Name, info.FullMethod)
+	}
+	if d.slots != nil {
+		select {
+		case d.slots <- struct{}{}:
+			defer func() { <-d.slots }()
+		default:
+			metrics.Drivers.Reject(d.Name, info.FullMethod)
+			return nil, status.Errorf(codes.ResourceExhausted, "driver %s has %d operations in progress, its limit", d.Name, d.MaxConcurrentOperations)
+		}
+	}
+	resp, err := handler(ctx, req)
+	metrics.Drivers.Record(d.Name, info.FullMethod, err)
+	return resp, err
+}
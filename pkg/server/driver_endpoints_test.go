@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseDriverEndpoints(t *testing.T) {
+	testCases := []struct {
+		data        string
+		expectError bool
+	}{
+		{data: `[]`},
+		{data: `[{"name": "driverA", "allowedMethods": ["Smb/*", "Filesystem/Mkdir"], "maxConcurrentOperations": 4}, {"name": "driver-b.v2"}]`},
+		{data: `[{"name": ""}]`, expectError: true},
+		{data: `[{"name": "driver\\a"}]`, expectError: true},
+		{data: `[{"name": "driverA"}, {"name": "DRIVERA"}]`, expectError: true},
+		{data: `[{"name": "driverA", "allowedMethods": ["MountVolume"]}]`, expectError: true},
+		{data: `[{"name": "driverA", "maxConcurrentOperations": -1}]`, expectError: true},
+		{data: `{"name": "driverA"}`, expectError: true},
+	}
+	for _, tc := range testCases {
+		_, err := ParseDriverEndpoints([]byte(tc.data))
+		if tc.expectError && err == nil {
+			t.Errorf("%s: expected an error", tc.data)
+		}
+		if !tc.expectError && err != nil {
+			t.Errorf("%s: unexpected error %v", tc.data, err)
+		}
+	}
+}
+
+func TestDriverEndpointPolicy(t *testing.T) {
+	driver := newDriverEndpoint(DriverEndpoint{
+		Name:           "driverA",
+		AllowedMethods: []string{"Smb/*", "Volume/MountVolume"},
+	})
+
+	for group, expected := range map[string]bool{"smb": true, "volume": true, "disk": false} {
+		if allowed := driver.allowsGroup(group); allowed != expected {
+			t.Errorf("allowsGroup(%s): expected %t, got %t", group, expected, allowed)
+		}
+	}
+
+	testCases := []struct {
+		fullMethod string
+		expectCode codes.Code
+	}{
+		{fullMethod: "/v1.Smb/NewSmbGlobalMapping", expectCode: codes.OK},
+		{fullMethod: "/v2alpha1.Volume/MountVolume", expectCode: codes.OK},
+		{fullMethod: "/v2alpha1.Volume/FormatVolume", expectCode: codes.PermissionDenied},
+		{fullMethod: "/v1.Disk/PartitionDisk", expectCode: codes.PermissionDenied},
+	}
+	for _, tc := range testCases {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: tc.fullMethod}
+		_, err := driver.intercept(context.TODO(), nil, info, handler)
+		if code := status.Code(err); code != tc.expectCode {
+			t.Errorf("%s: expected code %v, got %v", tc.fullMethod, tc.expectCode, code)
+		}
+	}
+}
+
+func TestDriverEndpointQuota(t *testing.T) {
+	driver := newDriverEndpoint(DriverEndpoint{
+		Name:                    "driverA",
+		MaxConcurrentOperations: 1,
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: "/v1.Volume/MountVolume"}
+
+	var nestedErr error
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		// the driver has an operation in progress, it's at its quota
+		_, nestedErr = driver.intercept(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return nil, nil
+	}
+	if _, err := driver.intercept(context.TODO(), nil, info, handler); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if code := status.Code(nestedErr); code != codes.ResourceExhausted {
+		t.Errorf("expected code %v above the quota, got %v", codes.ResourceExhausted, code)
+	}
+	// the operation is done, its slot is released
+	if _, err := driver.intercept(context.TODO(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}); err != nil {
+		t.Errorf("unexpected error %v after the operation in progress is done", err)
+	}
+}
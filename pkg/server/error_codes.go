@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorCodeStatusCodes maps the stable error codes to the gRPC status codes of
+// the errors.
+var errorCodeStatusCodes = map[string]codes.Code{
+	utils.ErrorCodeNotFound:             codes.NotFound,
+	utils.ErrorCodeAccessDenied:         codes.PermissionDenied,
+	utils.ErrorCodeAlreadyExists:        codes.AlreadyExists,
+	utils.ErrorCodeInUse:                codes.Unavailable,
+	utils.ErrorCodeInvalidParameter:     codes.InvalidArgument,
+	utils.ErrorCodeNotSupported:         codes.FailedPrecondition,
+	utils.ErrorCodeDeviceNotReady:       codes.Unavailable,
+	utils.ErrorCodeWriteProtected:       codes.FailedPrecondition,
+	utils.ErrorCodeDiskFull:             codes.ResourceExhausted,
+	utils.ErrorCodeTimeout:              codes.DeadlineExceeded,
+	utils.ErrorCodeNetworkPathNotFound:  codes.NotFound,
+	utils.ErrorCodeAuthenticationFailed: codes.Unauthenticated,
+	utils.ErrorCodeCredentialConflict:   codes.FailedPrecondition,
+	utils.ErrorCodeMountConflict:        codes.AlreadyExists,
+}
+
+// attachErrorInfo attaches an api.ErrorInfo with the stable code of the Windows
+// error to the status of failed calls, so that clients can handle errors the same
+// way whatever the display language of Windows. The error records of PowerShell
+// are attached too and replaced by their message in the status message. Errors
+// that already have a status code are left untouched.
+func attachErrorInfo(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err == nil {
+		return resp, nil
+	}
+	if s, ok := status.FromError(err); ok && s.Code() != codes.Unknown {
+		return resp, err
+	}
+	code, windowsCode := utils.StableErrorCode(err)
+	records, msg := utils.PowerShellErrors(err)
+	if code == "" && len(records) == 0 {
+		return resp, err
+	}
+	statusCode, ok := errorCodeStatusCodes[code]
+	if !ok {
+		statusCode = codes.Unknown
+	}
+	powerShellErrors := make([]*api.PowerShellErrorRecord, 0, len(records))
+	for _, r := range records {
+		powerShellErrors = append(powerShellErrors, &api.PowerShellErrorRecord{
+			Message:       r.Message,
+			Category:      r.Category,
+			ExceptionType: r.ExceptionType,
+			TargetObject:  r.TargetObject,
+			Activity:      r.Activity,
+			ErrorId:       r.ErrorID,
+		})
+	}
+	s, detailsErr := status.New(statusCode, msg).WithDetails(&api.ErrorInfo{
+		Code:             code,
+		WindowsErrorCode: windowsCode,
+		PowershellErrors: powerShellErrors,
+	})
+	if detailsErr != nil {
+		return resp, err
+	}
+	return resp, s.Err()
+}
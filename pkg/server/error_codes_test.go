@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/volume"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAttachErrorInfo(t *testing.T) {
+	testCases := []struct {
+		name             string
+		err              error
+		expectStatusCode codes.Code
+		expectErrorCode  string
+	}{
+		{
+			name:             "no error",
+			err:              nil,
+			expectStatusCode: codes.OK,
+		},
+		{
+			name:             "known HRESULT",
+			err:              errors.New("Format-Volume : Accès refusé (Exception de HRESULT : 0x80070005)"),
+			expectStatusCode: codes.PermissionDenied,
+			expectErrorCode:  "ACCESS_DENIED",
+		},
+		{
+			name:             "mount conflict",
+			err:              &volume.MountConflictError{VolumeID: `\\?\Volume{1}\`, Path: `C:\mnt`, MountedVolumeID: `\\?\Volume{2}\`},
+			expectStatusCode: codes.AlreadyExists,
+			expectErrorCode:  "MOUNT_CONFLICT",
+		},
+		{
+			name:             "unsupported feature",
+			err:              &utils.UnsupportedFeatureError{Feature: utils.FeatureReFSDeduplication, Build: utils.BuildWindowsServer2022},
+			expectStatusCode: codes.FailedPrecondition,
+			expectErrorCode:  "NOT_SUPPORTED",
+		},
+		{
+			name:             "unknown error",
+			err:              errors.New("exit status 1"),
+			expectStatusCode: codes.Unknown,
+		},
+		{
+			name:             "error with a status code",
+			err:              status.Error(codes.InvalidArgument, "volume id empty (0x80070005)"),
+			expectStatusCode: codes.InvalidArgument,
+		},
+	}
+	for _, tc := range testCases {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, tc.err
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: "/v2alpha1.Volume/FormatVolume"}
+		_, err := attachErrorInfo(context.TODO(), nil, info, handler)
+		s := status.Convert(err)
+		if s.Code() != tc.expectStatusCode {
+			t.Errorf("%s: expected code %v, got %v", tc.name, tc.expectStatusCode, s.Code())
+		}
+		errorCode := ""
+		for _, detail := range s.Details() {
+			if errorInfo, ok := detail.(*api.ErrorInfo); ok {
+				errorCode = errorInfo.Code
+			}
+		}
+		if errorCode != tc.expectErrorCode {
+			t.Errorf("%s: expected error code %q, got %q", tc.name, tc.expectErrorCode, errorCode)
+		}
+		if expectMessage := status.Convert(tc.err).Message(); s.Message() != expectMessage {
+			t.Errorf("%s: expected message %q, got %q", tc.name, expectMessage, s.Message())
+		}
+	}
+}
+
+func TestAttachPowerShellErrors(t *testing.T) {
+	output := "Resize-Partition : Size Not Supported\r\n" +
+		"At line:1 char:1\r\n" +
+		"+ Resize-Partition -Size 1\r\n" +
+		"+ ~~~~~~~~~~~~~~~~~~~~~~~~\r\n" +
+		"    + CategoryInfo          : NotSpecified: (StorageWMI:ROOT/Microsoft/...ALLCIMCLASSNAME) [Resize-Partition], CimException\r\n" +
+		"    + FullyQualifiedErrorId : StorageWMI 4097,Resize-Partition\r\n"
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("error resizing volume. output: %s, error: %v", output, errors.New("exit status 1"))
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/v2alpha1.Volume/ResizeVolume"}
+	_, err := attachErrorInfo(context.TODO(), nil, info, handler)
+	s := status.Convert(err)
+	if s.Code() != codes.Unknown {
+		t.Errorf("expected code %v, got %v", codes.Unknown, s.Code())
+	}
+	if expectMessage := "error resizing volume. output: Size Not Supported\n, error: exit status 1"; s.Message() != expectMessage {
+		t.Errorf("expected message %q, got %q", expectMessage, s.Message())
+	}
+	var records []*api.PowerShellErrorRecord
+	for _, detail := range s.Details() {
+		if errorInfo, ok := detail.(*api.ErrorInfo); ok {
+			records = errorInfo.PowershellErrors
+		}
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 PowerShell error record, got %d", len(records))
+	}
+	if r := records[0]; r.Message != "Size Not Supported" || r.Activity != "Resize-Partition" || r.ExceptionType != "CimException" || r.ErrorId != "StorageWMI 4097,Resize-Partition" {
+		t.Errorf("unexpected PowerShell error record %+v", r)
+	}
+}
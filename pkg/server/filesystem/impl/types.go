@@ -128,6 +128,26 @@ type IsSymlinkResponse struct {
 	IsSymlink bool
 }
 
+type CreateJunctionRequest struct {
+	// The path of the existing directory the junction points to.
+	SourcePath string
+	// The path of the junction point created in the host's filesystem.
+	TargetPath string
+}
+
+type CreateJunctionResponse struct {
+}
+
+type ResolveJunctionRequest struct {
+	// The path of the junction point in the host's filesystem.
+	Path string
+}
+
+type ResolveJunctionResponse struct {
+	// The absolute path of the directory the junction points to.
+	SourcePath string
+}
+
 // Compatibility for pre v1beta2 APIs
 
 type LinkPathRequest struct {
@@ -169,4 +189,77 @@ type IsMountPointRequest struct {
 
 type IsMountPointResponse struct {
 	IsMountPoint bool
+	// What the path is, only returned from v2alpha1
+	Type MountPointType
+}
+
+// MountPointType is what a path checked by IsMountPoint is.
+type MountPointType uint32
+
+const (
+	MOUNT_POINT_TYPE_DIRECTORY          MountPointType = 0
+	MOUNT_POINT_TYPE_VOLUME_MOUNT_POINT MountPointType = 1
+	MOUNT_POINT_TYPE_SYMLINK_TO_VOLUME  MountPointType = 2
+	MOUNT_POINT_TYPE_SYMLINK            MountPointType = 3
+)
+
+type AccessRight uint32
+
+const (
+	ACCESS_RIGHT_READ       = 0
+	ACCESS_RIGHT_WRITE      = 1
+	ACCESS_RIGHT_READ_WRITE = 2
+	ACCESS_RIGHT_MODIFY     = 3
+)
+
+// CheckAccessRequest is the internal representation of requests to the CheckAccess endpoint.
+type CheckAccessRequest struct {
+	// The path whose access is checked
+	Path string
+	// Security identifier of the identity
+	Sid string
+	// Access to check
+	DesiredAccess AccessRight
+}
+
+// CheckAccessResponse is the internal representation of responses from the CheckAccess endpoint.
+type CheckAccessResponse struct {
+	// Indicates whether the identity has the requested access to the path
+	Allowed bool
+	// Windows access mask of the rights of the identity to the path
+	GrantedAccess uint32
+}
+
+// GetACLRequest is the internal representation of requests to the GetACL endpoint.
+type GetACLRequest struct {
+	// The path whose ACL is read
+	Path string
+}
+
+// GetACLResponse is the internal representation of responses from the GetACL endpoint.
+type GetACLResponse struct {
+	// The owner, the group and the DACL of the path in SDDL
+	Sddl string
+}
+
+// AccessGrant is access granted to an identity by SetACL.
+type AccessGrant struct {
+	// Security identifier of the identity
+	Sid string
+	// Access granted to the identity
+	Access AccessRight
+}
+
+// SetACLRequest is the internal representation of requests to the SetACL endpoint.
+type SetACLRequest struct {
+	// The path whose ACL is set
+	Path string
+	// SDDL string whose DACL replaces the DACL of the path
+	Sddl string
+	// Access added to the DACL of the path
+	Grants []*AccessGrant
+}
+
+// SetACLResponse is the internal representation of responses from the SetACL endpoint.
+type SetACLResponse struct {
 }
@@ -15,12 +15,17 @@ type VersionedAPI interface {
 
 // All the functions this group's server needs to define.
 type ServerInterface interface {
+	CheckAccess(context.Context, *CheckAccessRequest, apiversion.Version) (*CheckAccessResponse, error)
+	CreateJunction(context.Context, *CreateJunctionRequest, apiversion.Version) (*CreateJunctionResponse, error)
 	CreateSymlink(context.Context, *CreateSymlinkRequest, apiversion.Version) (*CreateSymlinkResponse, error)
+	GetACL(context.Context, *GetACLRequest, apiversion.Version) (*GetACLResponse, error)
 	IsMountPoint(context.Context, *IsMountPointRequest, apiversion.Version) (*IsMountPointResponse, error)
 	IsSymlink(context.Context, *IsSymlinkRequest, apiversion.Version) (*IsSymlinkResponse, error)
 	LinkPath(context.Context, *LinkPathRequest, apiversion.Version) (*LinkPathResponse, error)
 	Mkdir(context.Context, *MkdirRequest, apiversion.Version) (*MkdirResponse, error)
 	PathExists(context.Context, *PathExistsRequest, apiversion.Version) (*PathExistsResponse, error)
+	ResolveJunction(context.Context, *ResolveJunctionRequest, apiversion.Version) (*ResolveJunctionResponse, error)
 	Rmdir(context.Context, *RmdirRequest, apiversion.Version) (*RmdirResponse, error)
 	RmdirContents(context.Context, *RmdirContentsRequest, apiversion.Version) (*RmdirContentsResponse, error)
+	SetACL(context.Context, *SetACLRequest, apiversion.Version) (*SetACLResponse, error)
 }
@@ -7,6 +7,114 @@ import (
 	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem/impl"
 )
 
+func autoConvert_v2alpha1_AccessGrant_To_impl_AccessGrant(in *v2alpha1.AccessGrant, out *impl.AccessGrant) error {
+	out.Sid = in.Sid
+	out.Access = impl.AccessRight(in.Access)
+	return nil
+}
+
+// Convert_v2alpha1_AccessGrant_To_impl_AccessGrant is an autogenerated conversion function.
+func Convert_v2alpha1_AccessGrant_To_impl_AccessGrant(in *v2alpha1.AccessGrant, out *impl.AccessGrant) error {
+	return autoConvert_v2alpha1_AccessGrant_To_impl_AccessGrant(in, out)
+}
+
+func autoConvert_impl_AccessGrant_To_v2alpha1_AccessGrant(in *impl.AccessGrant, out *v2alpha1.AccessGrant) error {
+	out.Sid = in.Sid
+	out.Access = v2alpha1.AccessRight(in.Access)
+	return nil
+}
+
+// Convert_impl_AccessGrant_To_v2alpha1_AccessGrant is an autogenerated conversion function.
+func Convert_impl_AccessGrant_To_v2alpha1_AccessGrant(in *impl.AccessGrant, out *v2alpha1.AccessGrant) error {
+	return autoConvert_impl_AccessGrant_To_v2alpha1_AccessGrant(in, out)
+}
+
+func autoConvert_v2alpha1_CheckAccessRequest_To_impl_CheckAccessRequest(in *v2alpha1.CheckAccessRequest, out *impl.CheckAccessRequest) error {
+	out.Path = in.Path
+	out.Sid = in.Sid
+	out.DesiredAccess = impl.AccessRight(in.DesiredAccess)
+	return nil
+}
+
+// Convert_v2alpha1_CheckAccessRequest_To_impl_CheckAccessRequest is an autogenerated conversion function.
+func Convert_v2alpha1_CheckAccessRequest_To_impl_CheckAccessRequest(in *v2alpha1.CheckAccessRequest, out *impl.CheckAccessRequest) error {
+	return autoConvert_v2alpha1_CheckAccessRequest_To_impl_CheckAccessRequest(in, out)
+}
+
+func autoConvert_impl_CheckAccessRequest_To_v2alpha1_CheckAccessRequest(in *impl.CheckAccessRequest, out *v2alpha1.CheckAccessRequest) error {
+	out.Path = in.Path
+	out.Sid = in.Sid
+	out.DesiredAccess = v2alpha1.AccessRight(in.DesiredAccess)
+	return nil
+}
+
+// Convert_impl_CheckAccessRequest_To_v2alpha1_CheckAccessRequest is an autogenerated conversion function.
+func Convert_impl_CheckAccessRequest_To_v2alpha1_CheckAccessRequest(in *impl.CheckAccessRequest, out *v2alpha1.CheckAccessRequest) error {
+	return autoConvert_impl_CheckAccessRequest_To_v2alpha1_CheckAccessRequest(in, out)
+}
+
+func autoConvert_v2alpha1_CheckAccessResponse_To_impl_CheckAccessResponse(in *v2alpha1.CheckAccessResponse, out *impl.CheckAccessResponse) error {
+	out.Allowed = in.Allowed
+	out.GrantedAccess = in.GrantedAccess
+	return nil
+}
+
+// Convert_v2alpha1_CheckAccessResponse_To_impl_CheckAccessResponse is an autogenerated conversion function.
+func Convert_v2alpha1_CheckAccessResponse_To_impl_CheckAccessResponse(in *v2alpha1.CheckAccessResponse, out *impl.CheckAccessResponse) error {
+	return autoConvert_v2alpha1_CheckAccessResponse_To_impl_CheckAccessResponse(in, out)
+}
+
+func autoConvert_impl_CheckAccessResponse_To_v2alpha1_CheckAccessResponse(in *impl.CheckAccessResponse, out *v2alpha1.CheckAccessResponse) error {
+	out.Allowed = in.Allowed
+	out.GrantedAccess = in.GrantedAccess
+	return nil
+}
+
+// Convert_impl_CheckAccessResponse_To_v2alpha1_CheckAccessResponse is an autogenerated conversion function.
+func Convert_impl_CheckAccessResponse_To_v2alpha1_CheckAccessResponse(in *impl.CheckAccessResponse, out *v2alpha1.CheckAccessResponse) error {
+	return autoConvert_impl_CheckAccessResponse_To_v2alpha1_CheckAccessResponse(in, out)
+}
+
+func autoConvert_v2alpha1_CreateJunctionRequest_To_impl_CreateJunctionRequest(in *v2alpha1.CreateJunctionRequest, out *impl.CreateJunctionRequest) error {
+	out.SourcePath = in.SourcePath
+	out.TargetPath = in.TargetPath
+	return nil
+}
+
+// Convert_v2alpha1_CreateJunctionRequest_To_impl_CreateJunctionRequest is an autogenerated conversion function.
+func Convert_v2alpha1_CreateJunctionRequest_To_impl_CreateJunctionRequest(in *v2alpha1.CreateJunctionRequest, out *impl.CreateJunctionRequest) error {
+	return autoConvert_v2alpha1_CreateJunctionRequest_To_impl_CreateJunctionRequest(in, out)
+}
+
+func autoConvert_impl_CreateJunctionRequest_To_v2alpha1_CreateJunctionRequest(in *impl.CreateJunctionRequest, out *v2alpha1.CreateJunctionRequest) error {
+	out.SourcePath = in.SourcePath
+	out.TargetPath = in.TargetPath
+	return nil
+}
+
+// Convert_impl_CreateJunctionRequest_To_v2alpha1_CreateJunctionRequest is an autogenerated conversion function.
+func Convert_impl_CreateJunctionRequest_To_v2alpha1_CreateJunctionRequest(in *impl.CreateJunctionRequest, out *v2alpha1.CreateJunctionRequest) error {
+	return autoConvert_impl_CreateJunctionRequest_To_v2alpha1_CreateJunctionRequest(in, out)
+}
+
+func autoConvert_v2alpha1_CreateJunctionResponse_To_impl_CreateJunctionResponse(in *v2alpha1.CreateJunctionResponse, out *impl.CreateJunctionResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_CreateJunctionResponse_To_impl_CreateJunctionResponse is an autogenerated conversion function.
+func Convert_v2alpha1_CreateJunctionResponse_To_impl_CreateJunctionResponse(in *v2alpha1.CreateJunctionResponse, out *impl.CreateJunctionResponse) error {
+	return autoConvert_v2alpha1_CreateJunctionResponse_To_impl_CreateJunctionResponse(in, out)
+}
+
+func autoConvert_impl_CreateJunctionResponse_To_v2alpha1_CreateJunctionResponse(in *impl.CreateJunctionResponse, out *v2alpha1.CreateJunctionResponse) error {
+	return nil
+}
+
+// Convert_impl_CreateJunctionResponse_To_v2alpha1_CreateJunctionResponse is an autogenerated conversion function.
+func Convert_impl_CreateJunctionResponse_To_v2alpha1_CreateJunctionResponse(in *impl.CreateJunctionResponse, out *v2alpha1.CreateJunctionResponse) error {
+	return autoConvert_impl_CreateJunctionResponse_To_v2alpha1_CreateJunctionResponse(in, out)
+}
+
 func autoConvert_v2alpha1_CreateSymlinkRequest_To_impl_CreateSymlinkRequest(in *v2alpha1.CreateSymlinkRequest, out *impl.CreateSymlinkRequest) error {
 	out.SourcePath = in.SourcePath
 	out.TargetPath = in.TargetPath
@@ -47,6 +155,88 @@ func Convert_impl_CreateSymlinkResponse_To_v2alpha1_CreateSymlinkResponse(in *im
 	return autoConvert_impl_CreateSymlinkResponse_To_v2alpha1_CreateSymlinkResponse(in, out)
 }
 
+func autoConvert_v2alpha1_GetACLRequest_To_impl_GetACLRequest(in *v2alpha1.GetACLRequest, out *impl.GetACLRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v2alpha1_GetACLRequest_To_impl_GetACLRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetACLRequest_To_impl_GetACLRequest(in *v2alpha1.GetACLRequest, out *impl.GetACLRequest) error {
+	return autoConvert_v2alpha1_GetACLRequest_To_impl_GetACLRequest(in, out)
+}
+
+func autoConvert_impl_GetACLRequest_To_v2alpha1_GetACLRequest(in *impl.GetACLRequest, out *v2alpha1.GetACLRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_impl_GetACLRequest_To_v2alpha1_GetACLRequest is an autogenerated conversion function.
+func Convert_impl_GetACLRequest_To_v2alpha1_GetACLRequest(in *impl.GetACLRequest, out *v2alpha1.GetACLRequest) error {
+	return autoConvert_impl_GetACLRequest_To_v2alpha1_GetACLRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetACLResponse_To_impl_GetACLResponse(in *v2alpha1.GetACLResponse, out *impl.GetACLResponse) error {
+	out.Sddl = in.Sddl
+	return nil
+}
+
+// Convert_v2alpha1_GetACLResponse_To_impl_GetACLResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetACLResponse_To_impl_GetACLResponse(in *v2alpha1.GetACLResponse, out *impl.GetACLResponse) error {
+	return autoConvert_v2alpha1_GetACLResponse_To_impl_GetACLResponse(in, out)
+}
+
+func autoConvert_impl_GetACLResponse_To_v2alpha1_GetACLResponse(in *impl.GetACLResponse, out *v2alpha1.GetACLResponse) error {
+	out.Sddl = in.Sddl
+	return nil
+}
+
+// Convert_impl_GetACLResponse_To_v2alpha1_GetACLResponse is an autogenerated conversion function.
+func Convert_impl_GetACLResponse_To_v2alpha1_GetACLResponse(in *impl.GetACLResponse, out *v2alpha1.GetACLResponse) error {
+	return autoConvert_impl_GetACLResponse_To_v2alpha1_GetACLResponse(in, out)
+}
+
+func autoConvert_v2alpha1_IsMountPointRequest_To_impl_IsMountPointRequest(in *v2alpha1.IsMountPointRequest, out *impl.IsMountPointRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v2alpha1_IsMountPointRequest_To_impl_IsMountPointRequest is an autogenerated conversion function.
+func Convert_v2alpha1_IsMountPointRequest_To_impl_IsMountPointRequest(in *v2alpha1.IsMountPointRequest, out *impl.IsMountPointRequest) error {
+	return autoConvert_v2alpha1_IsMountPointRequest_To_impl_IsMountPointRequest(in, out)
+}
+
+func autoConvert_impl_IsMountPointRequest_To_v2alpha1_IsMountPointRequest(in *impl.IsMountPointRequest, out *v2alpha1.IsMountPointRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_impl_IsMountPointRequest_To_v2alpha1_IsMountPointRequest is an autogenerated conversion function.
+func Convert_impl_IsMountPointRequest_To_v2alpha1_IsMountPointRequest(in *impl.IsMountPointRequest, out *v2alpha1.IsMountPointRequest) error {
+	return autoConvert_impl_IsMountPointRequest_To_v2alpha1_IsMountPointRequest(in, out)
+}
+
+func autoConvert_v2alpha1_IsMountPointResponse_To_impl_IsMountPointResponse(in *v2alpha1.IsMountPointResponse, out *impl.IsMountPointResponse) error {
+	out.IsMountPoint = in.IsMountPoint
+	out.Type = impl.MountPointType(in.Type)
+	return nil
+}
+
+// Convert_v2alpha1_IsMountPointResponse_To_impl_IsMountPointResponse is an autogenerated conversion function.
+func Convert_v2alpha1_IsMountPointResponse_To_impl_IsMountPointResponse(in *v2alpha1.IsMountPointResponse, out *impl.IsMountPointResponse) error {
+	return autoConvert_v2alpha1_IsMountPointResponse_To_impl_IsMountPointResponse(in, out)
+}
+
+func autoConvert_impl_IsMountPointResponse_To_v2alpha1_IsMountPointResponse(in *impl.IsMountPointResponse, out *v2alpha1.IsMountPointResponse) error {
+	out.IsMountPoint = in.IsMountPoint
+	out.Type = v2alpha1.MountPointType(in.Type)
+	return nil
+}
+
+// Convert_impl_IsMountPointResponse_To_v2alpha1_IsMountPointResponse is an autogenerated conversion function.
+func Convert_impl_IsMountPointResponse_To_v2alpha1_IsMountPointResponse(in *impl.IsMountPointResponse, out *v2alpha1.IsMountPointResponse) error {
+	return autoConvert_impl_IsMountPointResponse_To_v2alpha1_IsMountPointResponse(in, out)
+}
+
 func autoConvert_v2alpha1_IsSymlinkRequest_To_impl_IsSymlinkRequest(in *v2alpha1.IsSymlinkRequest, out *impl.IsSymlinkRequest) error {
 	out.Path = in.Path
 	return nil
@@ -165,6 +355,46 @@ func Convert_impl_PathExistsResponse_To_v2alpha1_PathExistsResponse(in *impl.Pat
 	return autoConvert_impl_PathExistsResponse_To_v2alpha1_PathExistsResponse(in, out)
 }
 
+func autoConvert_v2alpha1_ResolveJunctionRequest_To_impl_ResolveJunctionRequest(in *v2alpha1.ResolveJunctionRequest, out *impl.ResolveJunctionRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v2alpha1_ResolveJunctionRequest_To_impl_ResolveJunctionRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ResolveJunctionRequest_To_impl_ResolveJunctionRequest(in *v2alpha1.ResolveJunctionRequest, out *impl.ResolveJunctionRequest) error {
+	return autoConvert_v2alpha1_ResolveJunctionRequest_To_impl_ResolveJunctionRequest(in, out)
+}
+
+func autoConvert_impl_ResolveJunctionRequest_To_v2alpha1_ResolveJunctionRequest(in *impl.ResolveJunctionRequest, out *v2alpha1.ResolveJunctionRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_impl_ResolveJunctionRequest_To_v2alpha1_ResolveJunctionRequest is an autogenerated conversion function.
+func Convert_impl_ResolveJunctionRequest_To_v2alpha1_ResolveJunctionRequest(in *impl.ResolveJunctionRequest, out *v2alpha1.ResolveJunctionRequest) error {
+	return autoConvert_impl_ResolveJunctionRequest_To_v2alpha1_ResolveJunctionRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ResolveJunctionResponse_To_impl_ResolveJunctionResponse(in *v2alpha1.ResolveJunctionResponse, out *impl.ResolveJunctionResponse) error {
+	out.SourcePath = in.SourcePath
+	return nil
+}
+
+// Convert_v2alpha1_ResolveJunctionResponse_To_impl_ResolveJunctionResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ResolveJunctionResponse_To_impl_ResolveJunctionResponse(in *v2alpha1.ResolveJunctionResponse, out *impl.ResolveJunctionResponse) error {
+	return autoConvert_v2alpha1_ResolveJunctionResponse_To_impl_ResolveJunctionResponse(in, out)
+}
+
+func autoConvert_impl_ResolveJunctionResponse_To_v2alpha1_ResolveJunctionResponse(in *impl.ResolveJunctionResponse, out *v2alpha1.ResolveJunctionResponse) error {
+	out.SourcePath = in.SourcePath
+	return nil
+}
+
+// Convert_impl_ResolveJunctionResponse_To_v2alpha1_ResolveJunctionResponse is an autogenerated conversion function.
+func Convert_impl_ResolveJunctionResponse_To_v2alpha1_ResolveJunctionResponse(in *impl.ResolveJunctionResponse, out *v2alpha1.ResolveJunctionResponse) error {
+	return autoConvert_impl_ResolveJunctionResponse_To_v2alpha1_ResolveJunctionResponse(in, out)
+}
+
 func autoConvert_v2alpha1_RmdirContentsRequest_To_impl_RmdirContentsRequest(in *v2alpha1.RmdirContentsRequest, out *impl.RmdirContentsRequest) error {
 	out.Path = in.Path
 	return nil
@@ -242,3 +472,65 @@ func autoConvert_impl_RmdirResponse_To_v2alpha1_RmdirResponse(in *impl.RmdirResp
 func Convert_impl_RmdirResponse_To_v2alpha1_RmdirResponse(in *impl.RmdirResponse, out *v2alpha1.RmdirResponse) error {
 	return autoConvert_impl_RmdirResponse_To_v2alpha1_RmdirResponse(in, out)
 }
+
+func autoConvert_v2alpha1_SetACLRequest_To_impl_SetACLRequest(in *v2alpha1.SetACLRequest, out *impl.SetACLRequest) error {
+	out.Path = in.Path
+	out.Sddl = in.Sddl
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]*impl.AccessGrant, len(*in))
+		for i := range *in {
+			if err := Convert_v2alpha1_AccessGrant_To_impl_AccessGrant(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Grants = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_SetACLRequest_To_impl_SetACLRequest is an autogenerated conversion function.
+func Convert_v2alpha1_SetACLRequest_To_impl_SetACLRequest(in *v2alpha1.SetACLRequest, out *impl.SetACLRequest) error {
+	return autoConvert_v2alpha1_SetACLRequest_To_impl_SetACLRequest(in, out)
+}
+
+func autoConvert_impl_SetACLRequest_To_v2alpha1_SetACLRequest(in *impl.SetACLRequest, out *v2alpha1.SetACLRequest) error {
+	out.Path = in.Path
+	out.Sddl = in.Sddl
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]*v2alpha1.AccessGrant, len(*in))
+		for i := range *in {
+			if err := Convert_impl_AccessGrant_To_v2alpha1_AccessGrant(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Grants = nil
+	}
+	return nil
+}
+
+// Convert_impl_SetACLRequest_To_v2alpha1_SetACLRequest is an autogenerated conversion function.
+func Convert_impl_SetACLRequest_To_v2alpha1_SetACLRequest(in *impl.SetACLRequest, out *v2alpha1.SetACLRequest) error {
+	return autoConvert_impl_SetACLRequest_To_v2alpha1_SetACLRequest(in, out)
+}
+
+func autoConvert_v2alpha1_SetACLResponse_To_impl_SetACLResponse(in *v2alpha1.SetACLResponse, out *impl.SetACLResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_SetACLResponse_To_impl_SetACLResponse is an autogenerated conversion function.
+func Convert_v2alpha1_SetACLResponse_To_impl_SetACLResponse(in *v2alpha1.SetACLResponse, out *impl.SetACLResponse) error {
+	return autoConvert_v2alpha1_SetACLResponse_To_impl_SetACLResponse(in, out)
+}
+
+func autoConvert_impl_SetACLResponse_To_v2alpha1_SetACLResponse(in *impl.SetACLResponse, out *v2alpha1.SetACLResponse) error {
+	return nil
+}
+
+// Convert_impl_SetACLResponse_To_v2alpha1_SetACLResponse is an autogenerated conversion function.
+func Convert_impl_SetACLResponse_To_v2alpha1_SetACLResponse(in *impl.SetACLResponse, out *v2alpha1.SetACLResponse) error {
+	return autoConvert_impl_SetACLResponse_To_v2alpha1_SetACLResponse(in, out)
+}
@@ -27,6 +27,44 @@ func (s *versionedAPI) Register(grpcServer *grpc.Server) {
 	v2alpha1.RegisterFilesystemServer(grpcServer, s)
 }
 
+func (s *versionedAPI) CheckAccess(context context.Context, versionedRequest *v2alpha1.CheckAccessRequest) (*v2alpha1.CheckAccessResponse, error) {
+	request := &impl.CheckAccessRequest{}
+	if err := Convert_v2alpha1_CheckAccessRequest_To_impl_CheckAccessRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.CheckAccess(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.CheckAccessResponse{}
+	if err := Convert_impl_CheckAccessResponse_To_v2alpha1_CheckAccessResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) CreateJunction(context context.Context, versionedRequest *v2alpha1.CreateJunctionRequest) (*v2alpha1.CreateJunctionResponse, error) {
+	request := &impl.CreateJunctionRequest{}
+	if err := Convert_v2alpha1_CreateJunctionRequest_To_impl_CreateJunctionRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.CreateJunction(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.CreateJunctionResponse{}
+	if err := Convert_impl_CreateJunctionResponse_To_v2alpha1_CreateJunctionResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) CreateSymlink(context context.Context, versionedRequest *v2alpha1.CreateSymlinkRequest) (*v2alpha1.CreateSymlinkResponse, error) {
 	request := &impl.CreateSymlinkRequest{}
 	if err := Convert_v2alpha1_CreateSymlinkRequest_To_impl_CreateSymlinkRequest(versionedRequest, request); err != nil {
@@ -46,6 +84,44 @@ func (s *versionedAPI) CreateSymlink(context context.Context, versionedRequest *
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) GetACL(context context.Context, versionedRequest *v2alpha1.GetACLRequest) (*v2alpha1.GetACLResponse, error) {
+	request := &impl.GetACLRequest{}
+	if err := Convert_v2alpha1_GetACLRequest_To_impl_GetACLRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetACL(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetACLResponse{}
+	if err := Convert_impl_GetACLResponse_To_v2alpha1_GetACLResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) IsMountPoint(context context.Context, versionedRequest *v2alpha1.IsMountPointRequest) (*v2alpha1.IsMountPointResponse, error) {
+	request := &impl.IsMountPointRequest{}
+	if err := Convert_v2alpha1_IsMountPointRequest_To_impl_IsMountPointRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.IsMountPoint(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.IsMountPointResponse{}
+	if err := Convert_impl_IsMountPointResponse_To_v2alpha1_IsMountPointResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) IsSymlink(context context.Context, versionedRequest *v2alpha1.IsSymlinkRequest) (*v2alpha1.IsSymlinkResponse, error) {
 	request := &impl.IsSymlinkRequest{}
 	if err := Convert_v2alpha1_IsSymlinkRequest_To_impl_IsSymlinkRequest(versionedRequest, request); err != nil {
@@ -103,6 +179,25 @@ func (s *versionedAPI) PathExists(context context.Context, versionedRequest *v2a
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) ResolveJunction(context context.Context, versionedRequest *v2alpha1.ResolveJunctionRequest) (*v2alpha1.ResolveJunctionResponse, error) {
+	request := &impl.ResolveJunctionRequest{}
+	if err := Convert_v2alpha1_ResolveJunctionRequest_To_impl_ResolveJunctionRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ResolveJunction(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ResolveJunctionResponse{}
+	if err := Convert_impl_ResolveJunctionResponse_To_v2alpha1_ResolveJunctionResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) Rmdir(context context.Context, versionedRequest *v2alpha1.RmdirRequest) (*v2alpha1.RmdirResponse, error) {
 	request := &impl.RmdirRequest{}
 	if err := Convert_v2alpha1_RmdirRequest_To_impl_RmdirRequest(versionedRequest, request); err != nil {
@@ -140,3 +235,22 @@ func (s *versionedAPI) RmdirContents(context context.Context, versionedRequest *
 
 	return versionedResponse, err
 }
+
+func (s *versionedAPI) SetACL(context context.Context, versionedRequest *v2alpha1.SetACLRequest) (*v2alpha1.SetACLResponse, error) {
+	request := &impl.SetACLRequest{}
+	if err := Convert_v2alpha1_SetACLRequest_To_impl_SetACLRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.SetACL(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.SetACLResponse{}
+	if err := Convert_impl_SetACLResponse_To_v2alpha1_SetACLResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
@@ -95,20 +95,27 @@ func (s *Server) validatePathWindows(path string) error {
 		return fmt.Errorf("not an absolute Windows path: %s", path)
 	}
 
-	valid := false
-	for _, workingDir := range s.workingDirs {
-		if strings.HasPrefix(strings.ToLower(path), strings.ToLower(workingDir)) {
-			valid = true
-		}
-	}
-
-	if !valid {
+	if !s.isWithinWorkingDirs(path) {
 		return fmt.Errorf("path: %s is not within the working directories: %v", path, s.workingDirs)
 	}
 
 	return nil
 }
 
+// isWithinWorkingDirs returns true if `path` is one of the working directories or
+// is under one of them, a sibling that only shares a prefix e.g. C:\var\lib\kubelet2
+// isn't within C:\var\lib\kubelet.
+func (s *Server) isWithinWorkingDirs(path string) bool {
+	path = strings.ToLower(path)
+	for _, workingDir := range s.workingDirs {
+		workingDir = strings.TrimSuffix(strings.ToLower(workingDir), `\`)
+		if path == workingDir || strings.HasPrefix(path, workingDir+`\`) {
+			return true
+		}
+	}
+	return false
+}
+
 // PathExists checks if the given path exists on the host.
 func (s *Server) PathExists(ctx context.Context, request *internal.PathExistsRequest, version apiversion.Version) (*internal.PathExistsResponse, error) {
 	klog.V(2).Infof("Request: PathExists with path=%q", request.Path)
@@ -212,23 +219,59 @@ func (s *Server) CreateSymlink(ctx context.Context, request *internal.CreateSyml
 	return &internal.CreateSymlinkResponse{}, nil
 }
 
+// mountPointTypes are the internal values of the filesystem.MountPointType values.
+var mountPointTypes = map[filesystem.MountPointType]internal.MountPointType{
+	filesystem.Directory:        internal.MOUNT_POINT_TYPE_DIRECTORY,
+	filesystem.VolumeMountPoint: internal.MOUNT_POINT_TYPE_VOLUME_MOUNT_POINT,
+	filesystem.SymlinkToVolume:  internal.MOUNT_POINT_TYPE_SYMLINK_TO_VOLUME,
+	filesystem.Symlink:          internal.MOUNT_POINT_TYPE_SYMLINK,
+}
+
+// IsMountPoint checks if a path is a volume mount point or a link to a volume, the
+// versions before v2alpha1 only check if the path is a symlink whose target exists.
 func (s *Server) IsMountPoint(ctx context.Context, request *internal.IsMountPointRequest, version apiversion.Version) (*internal.IsMountPointResponse, error) {
 	klog.V(2).Infof("Request: IsMountPoint with path=%q", request.Path)
-	isSymlinkRequest := &internal.IsSymlinkRequest{
-		Path: request.Path,
+	if version.Compare(apiversion.NewVersionOrPanic("v2alpha1")) < 0 {
+		isSymlinkRequest := &internal.IsSymlinkRequest{
+			Path: request.Path,
+		}
+		isSymlinkResponse, err := s.IsSymlink(ctx, isSymlinkRequest, version)
+		if err != nil {
+			klog.Errorf("Failed to forward to IsSymlink: %v", err)
+			return nil, err
+		}
+		return &internal.IsMountPointResponse{
+			IsMountPoint: isSymlinkResponse.IsSymlink,
+		}, nil
+	}
+
+	err := s.validatePathWindows(request.Path)
+	if err != nil {
+		klog.Errorf("failed validatePathWindows %v", err)
+		return nil, err
 	}
-	isSymlinkResponse, err := s.IsSymlink(ctx, isSymlinkRequest, version)
+	mountPointType, err := s.hostAPI.GetMountPointType(request.Path)
 	if err != nil {
-		klog.Errorf("Failed to forward to IsSymlink: %v", err)
+		klog.Errorf("failed GetMountPointType %v", err)
 		return nil, err
 	}
 	return &internal.IsMountPointResponse{
-		IsMountPoint: isSymlinkResponse.IsSymlink,
+		IsMountPoint: mountPointType == filesystem.VolumeMountPoint || mountPointType == filesystem.SymlinkToVolume,
+		Type:         mountPointTypes[mountPointType],
 	}, nil
 }
 
 func (s *Server) IsSymlink(ctx context.Context, request *internal.IsSymlinkRequest, version apiversion.Version) (*internal.IsSymlinkResponse, error) {
 	klog.V(2).Infof("Request: IsSymlink with path=%q", request.Path)
+	// the paths are restricted to the working directories from v2alpha1, the
+	// earlier versions check any host path as they always did
+	minimumVersion := apiversion.NewVersionOrPanic("v2alpha1")
+	if version.Compare(minimumVersion) >= 0 {
+		if err := s.validatePathWindows(request.Path); err != nil {
+			klog.Errorf("failed validatePathWindows %v", err)
+			return nil, err
+		}
+	}
 	isSymlink, err := s.hostAPI.IsSymlink(request.Path)
 	if err != nil {
 		klog.Errorf("failed IsSymlink %v", err)
@@ -238,3 +281,147 @@ func (s *Server) IsSymlink(ctx context.Context, request *internal.IsSymlinkReque
 		IsSymlink: isSymlink,
 	}, nil
 }
+
+// CreateJunction creates a junction point at the target path to the source
+// directory, both paths are within the working directories.
+func (s *Server) CreateJunction(ctx context.Context, request *internal.CreateJunctionRequest, version apiversion.Version) (*internal.CreateJunctionResponse, error) {
+	klog.V(2).Infof("Request: CreateJunction with targetPath=%q sourcePath=%q", request.TargetPath, request.SourcePath)
+	err := s.validatePathWindows(request.TargetPath)
+	if err != nil {
+		klog.Errorf("failed validatePathWindows for target path %v", err)
+		return nil, err
+	}
+	err = s.validatePathWindows(request.SourcePath)
+	if err != nil {
+		klog.Errorf("failed validatePathWindows for source path %v", err)
+		return nil, err
+	}
+	err = s.hostAPI.CreateJunction(request.SourcePath, request.TargetPath)
+	if err != nil {
+		klog.Errorf("failed CreateJunction: %v", err)
+		return nil, err
+	}
+	return &internal.CreateJunctionResponse{}, nil
+}
+
+// ResolveJunction returns the directory a junction point points to.
+func (s *Server) ResolveJunction(ctx context.Context, request *internal.ResolveJunctionRequest, version apiversion.Version) (*internal.ResolveJunctionResponse, error) {
+	klog.V(2).Infof("Request: ResolveJunction with path=%q", request.Path)
+	err := s.validatePathWindows(request.Path)
+	if err != nil {
+		klog.Errorf("failed validatePathWindows %v", err)
+		return nil, err
+	}
+	sourcePath, err := s.hostAPI.ResolveJunction(request.Path)
+	if err != nil {
+		klog.Errorf("failed ResolveJunction %v", err)
+		return nil, err
+	}
+	return &internal.ResolveJunctionResponse{
+		SourcePath: sourcePath,
+	}, nil
+}
+
+// accessMasks are the Windows access masks of the access rights of CheckAccess.
+var accessMasks = map[internal.AccessRight]uint32{
+	internal.ACCESS_RIGHT_READ:       filesystem.FileGenericRead,
+	internal.ACCESS_RIGHT_WRITE:      filesystem.FileGenericWrite,
+	internal.ACCESS_RIGHT_READ_WRITE: filesystem.FileGenericRead | filesystem.FileGenericWrite,
+	internal.ACCESS_RIGHT_MODIFY:     filesystem.FileGenericRead | filesystem.FileGenericWrite | filesystem.FileGenericExecute | filesystem.Delete,
+}
+
+// CheckAccess checks if an identity has the requested access to the given path.
+func (s *Server) CheckAccess(ctx context.Context, request *internal.CheckAccessRequest, version apiversion.Version) (*internal.CheckAccessResponse, error) {
+	klog.V(2).Infof("Request: CheckAccess with path=%q sid=%q desiredAccess=%d", request.Path, request.Sid, request.DesiredAccess)
+	err := s.validatePathWindows(request.Path)
+	if err != nil {
+		klog.Errorf("failed validatePathWindows %v", err)
+		return nil, err
+	}
+	if request.Sid == "" {
+		klog.Errorf("sid empty")
+		return nil, fmt.Errorf("sid empty")
+	}
+	mask, ok := accessMasks[request.DesiredAccess]
+	if !ok {
+		klog.Errorf("invalid desired access %d", request.DesiredAccess)
+		return nil, fmt.Errorf("invalid desired access %d", request.DesiredAccess)
+	}
+
+	grantedAccess, err := s.hostAPI.GetEffectiveAccess(request.Path, request.Sid)
+	if err != nil {
+		klog.Errorf("failed CheckAccess %v", err)
+		return nil, err
+	}
+	allowed := grantedAccess&mask == mask
+	if !allowed {
+		klog.V(2).Infof("CheckAccess: %s is granted 0x%X to %s, requested 0x%X", request.Sid, grantedAccess, request.Path, mask)
+	}
+	return &internal.CheckAccessResponse{
+		Allowed:       allowed,
+		GrantedAccess: grantedAccess,
+	}, nil
+}
+
+// GetACL returns the owner, the group and the DACL of a path in SDDL.
+func (s *Server) GetACL(ctx context.Context, request *internal.GetACLRequest, version apiversion.Version) (*internal.GetACLResponse, error) {
+	klog.V(2).Infof("Request: GetACL with path=%q", request.Path)
+	err := s.validatePathWindows(request.Path)
+	if err != nil {
+		klog.Errorf("failed validatePathWindows %v", err)
+		return nil, err
+	}
+	sddl, err := s.hostAPI.GetSecurityDescriptor(request.Path)
+	if err != nil {
+		klog.Errorf("failed GetACL %v", err)
+		return nil, err
+	}
+	return &internal.GetACLResponse{
+		Sddl: sddl,
+	}, nil
+}
+
+// SetACL replaces the DACL of a path with the DACL of an SDDL string or grants
+// access to the path to identities.
+func (s *Server) SetACL(ctx context.Context, request *internal.SetACLRequest, version apiversion.Version) (*internal.SetACLResponse, error) {
+	klog.V(2).Infof("Request: SetACL with path=%q sddl=%q grants=%d", request.Path, request.Sddl, len(request.Grants))
+	err := s.validatePathWindows(request.Path)
+	if err != nil {
+		klog.Errorf("failed validatePathWindows %v", err)
+		return nil, err
+	}
+	if (request.Sddl == "") == (len(request.Grants) == 0) {
+		klog.Errorf("exactly one of sddl and grants must be set")
+		return nil, fmt.Errorf("exactly one of sddl and grants must be set")
+	}
+
+	if request.Sddl != "" {
+		err = s.hostAPI.SetDACL(request.Path, request.Sddl)
+		if err != nil {
+			klog.Errorf("failed SetACL %v", err)
+			return nil, err
+		}
+		return &internal.SetACLResponse{}, nil
+	}
+
+	// the access granted to the same identity by several grants is merged
+	grants := map[string]uint32{}
+	for _, grant := range request.Grants {
+		if grant.Sid == "" {
+			klog.Errorf("sid empty")
+			return nil, fmt.Errorf("sid empty")
+		}
+		mask, ok := accessMasks[grant.Access]
+		if !ok {
+			klog.Errorf("invalid access %d", grant.Access)
+			return nil, fmt.Errorf("invalid access %d", grant.Access)
+		}
+		grants[grant.Sid] |= mask
+	}
+	err = s.hostAPI.GrantAccess(request.Path, grants)
+	if err != nil {
+		klog.Errorf("failed SetACL %v", err)
+		return nil, err
+	}
+	return &internal.SetACLResponse{}, nil
+}
@@ -2,6 +2,7 @@ package filesystem
 
 import (
 	"context"
+	"reflect"
 	"testing"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
@@ -9,7 +10,10 @@ import (
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem/impl"
 )
 
-type fakeFileSystemAPI struct{}
+type fakeFileSystemAPI struct {
+	// grants are the grants of the last GrantAccess
+	grants map[string]uint32
+}
 
 var _ filesystem.API = &fakeFileSystemAPI{}
 
@@ -36,6 +40,37 @@ func (fakeFileSystemAPI) IsSymlink(path string) (bool, error) {
 	return true, nil
 }
 
+func (fakeFileSystemAPI) GetMountPointType(path string) (filesystem.MountPointType, error) {
+	return filesystem.SymlinkToVolume, nil
+}
+
+func (fakeFileSystemAPI) CreateJunction(target string, junction string) error {
+	return nil
+}
+
+func (fakeFileSystemAPI) ResolveJunction(path string) (string, error) {
+	return `C:\var\lib\kubelet\plugins\pv1`, nil
+}
+
+// GetEffectiveAccess grants Read & execute to every identity.
+func (fakeFileSystemAPI) GetEffectiveAccess(path string, sid string) (uint32, error) {
+	return filesystem.FileGenericRead | filesystem.FileGenericExecute, nil
+}
+
+func (fakeFileSystemAPI) GetSecurityDescriptor(path string) (string, error) {
+	return "O:SYG:SYD:PAI(A;OICI;FA;;;SY)", nil
+}
+
+func (fakeFileSystemAPI) SetDACL(path string, sddl string) error {
+	return nil
+}
+
+// GrantAccess records the last grants.
+func (f *fakeFileSystemAPI) GrantAccess(path string, grants map[string]uint32) error {
+	f.grants = grants
+	return nil
+}
+
 func TestMkdirWindows(t *testing.T) {
 	v1, err := apiversion.NewVersion("v1")
 	if err != nil {
@@ -65,6 +100,12 @@ func TestMkdirWindows(t *testing.T) {
 			version:     v1,
 			expectError: true,
 		},
+		{
+			name:        "path sharing a prefix with the working directory",
+			path:        `C:\var\lib\kubelet2\pods\pv1`,
+			version:     v1,
+			expectError: true,
+		},
 		{
 			name:        "path inside plugin context with plugin context set",
 			path:        `C:\var\lib\kubelet\plugins\pv1`,
@@ -169,6 +210,12 @@ func TestRmdirWindows(t *testing.T) {
 			version:     v1,
 			expectError: true,
 		},
+		{
+			name:        "path sharing a prefix with the working directory",
+			path:        `C:\var\lib\kubelet2\pods\pv1`,
+			version:     v1,
+			expectError: true,
+		},
 		{
 			name:        "path inside plugin context with plugin context set",
 			path:        `C:\var\lib\kubelet\plugins\pv1`,
@@ -243,3 +290,267 @@ func TestRmdirWindows(t *testing.T) {
 		}
 	}
 }
+
+func TestSymlinkWindows(t *testing.T) {
+	v1, err := apiversion.NewVersion("v1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+	testCases := []struct {
+		name        string
+		sourcePath  string
+		targetPath  string
+		expectError bool
+		// expectIsSymlinkError is set when IsSymlink and ResolveJunction of the target path fail
+		expectIsSymlinkError bool
+	}{
+		{
+			name:       "paths inside the working directory",
+			sourcePath: `C:\var\lib\kubelet\plugins\pv1`,
+			targetPath: `C:\var\lib\kubelet\pods\pod1\volumes\pv1`,
+		},
+		{
+			name:        "source path outside of the working directory",
+			sourcePath:  `C:\foo\bar`,
+			targetPath:  `C:\var\lib\kubelet\pods\pod1\volumes\pv1`,
+			expectError: true,
+		},
+		{
+			name:                 "target path outside of the working directory",
+			sourcePath:           `C:\var\lib\kubelet\plugins\pv1`,
+			targetPath:           `C:\Windows\System32\pv1`,
+			expectError:          true,
+			expectIsSymlinkError: true,
+		},
+		{
+			name:                 "target path with invalid characters `..`",
+			sourcePath:           `C:\var\lib\kubelet\plugins\pv1`,
+			targetPath:           `C:\var\lib\kubelet\pods\..\..\..\system32`,
+			expectError:          true,
+			expectIsSymlinkError: true,
+		},
+	}
+	srv, err := NewServer([]string{`C:\var\lib\kubelet`}, &fakeFileSystemAPI{})
+	if err != nil {
+		t.Fatalf("FileSystem Server could not be initialized for testing: %v", err)
+	}
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		_, err := srv.CreateSymlink(context.TODO(), &internal.CreateSymlinkRequest{SourcePath: tc.sourcePath, TargetPath: tc.targetPath}, v1)
+		if tc.expectError && err == nil {
+			t.Errorf("Expected error but CreateSymlink returned a nil error")
+		}
+		if !tc.expectError && err != nil {
+			t.Errorf("Expected no errors but CreateSymlink returned error: %v", err)
+		}
+
+		_, err = srv.CreateJunction(context.TODO(), &internal.CreateJunctionRequest{SourcePath: tc.sourcePath, TargetPath: tc.targetPath}, v1)
+		if tc.expectError && err == nil {
+			t.Errorf("Expected error but CreateJunction returned a nil error")
+		}
+		if !tc.expectError && err != nil {
+			t.Errorf("Expected no errors but CreateJunction returned error: %v", err)
+		}
+
+		_, err = srv.ResolveJunction(context.TODO(), &internal.ResolveJunctionRequest{Path: tc.targetPath}, v1)
+		if tc.expectIsSymlinkError && err == nil {
+			t.Errorf("Expected error but ResolveJunction returned a nil error")
+		}
+		if !tc.expectIsSymlinkError && err != nil {
+			t.Errorf("Expected no errors but ResolveJunction returned error: %v", err)
+		}
+
+		_, err = srv.IsSymlink(context.TODO(), &internal.IsSymlinkRequest{Path: tc.targetPath}, v2alpha1)
+		if tc.expectIsSymlinkError && err == nil {
+			t.Errorf("Expected error but IsSymlink returned a nil error")
+		}
+		if !tc.expectIsSymlinkError && err != nil {
+			t.Errorf("Expected no errors but IsSymlink returned error: %v", err)
+		}
+		// the paths of the versions before v2alpha1 aren't restricted
+		if _, err = srv.IsSymlink(context.TODO(), &internal.IsSymlinkRequest{Path: tc.targetPath}, v1); err != nil {
+			t.Errorf("Expected no errors but IsSymlink v1 returned error: %v", err)
+		}
+	}
+}
+
+func TestIsMountPoint(t *testing.T) {
+	srv, err := NewServer([]string{`C:\var\lib\kubelet`}, &fakeFileSystemAPI{})
+	if err != nil {
+		t.Fatalf("FileSystem Server could not be initialized for testing: %v", err)
+	}
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+	request := &internal.IsMountPointRequest{Path: `C:\var\lib\kubelet\pods\pod1\volumes\pv1`}
+	response, err := srv.IsMountPoint(context.TODO(), request, v2alpha1)
+	if err != nil {
+		t.Fatalf("Expected no errors but IsMountPoint returned error: %v", err)
+	}
+	expected := internal.IsMountPointResponse{IsMountPoint: true, Type: internal.MOUNT_POINT_TYPE_SYMLINK_TO_VOLUME}
+	if *response != expected {
+		t.Errorf("expected %+v, got %+v", expected, *response)
+	}
+
+	// the versions before v2alpha1 check if the path is a symlink
+	response, err = srv.IsMountPoint(context.TODO(), request, apiversion.NewVersionOrPanic("v1beta1"))
+	if err != nil {
+		t.Fatalf("Expected no errors but IsMountPoint returned error: %v", err)
+	}
+	expected = internal.IsMountPointResponse{IsMountPoint: true}
+	if *response != expected {
+		t.Errorf("expected %+v, got %+v", expected, *response)
+	}
+
+	request.Path = `C:\Windows\System32`
+	if _, err := srv.IsMountPoint(context.TODO(), request, v2alpha1); err == nil {
+		t.Errorf("Expected error for a path outside of the working directories but IsMountPoint returned a nil error")
+	}
+}
+
+func TestCheckAccess(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	testCases := []struct {
+		name          string
+		path          string
+		sid           string
+		desiredAccess internal.AccessRight
+		expectAllowed bool
+		expectError   bool
+	}{
+		{
+			name:          "read access granted",
+			path:          `C:\var\lib\kubelet\pods\pv1`,
+			sid:           "S-1-5-32-545",
+			desiredAccess: internal.ACCESS_RIGHT_READ,
+			expectAllowed: true,
+		},
+		{
+			name:          "write access not granted",
+			path:          `C:\var\lib\kubelet\pods\pv1`,
+			sid:           "S-1-5-32-545",
+			desiredAccess: internal.ACCESS_RIGHT_READ_WRITE,
+			expectAllowed: false,
+		},
+		{
+			name:          "path outside of the working directories",
+			path:          `C:\foo\bar`,
+			sid:           "S-1-5-32-545",
+			desiredAccess: internal.ACCESS_RIGHT_READ,
+			expectError:   true,
+		},
+		{
+			name:          "sid empty",
+			path:          `C:\var\lib\kubelet\pods\pv1`,
+			desiredAccess: internal.ACCESS_RIGHT_READ,
+			expectError:   true,
+		},
+		{
+			name:          "unknown access right",
+			path:          `C:\var\lib\kubelet\pods\pv1`,
+			sid:           "S-1-5-32-545",
+			desiredAccess: 42,
+			expectError:   true,
+		},
+	}
+	srv, err := NewServer([]string{`C:\var\lib\kubelet`}, &fakeFileSystemAPI{})
+	if err != nil {
+		t.Fatalf("FileSystem Server could not be initialized for testing: %v", err)
+	}
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		req := &internal.CheckAccessRequest{
+			Path:          tc.path,
+			Sid:           tc.sid,
+			DesiredAccess: tc.desiredAccess,
+		}
+		response, err := srv.CheckAccess(context.TODO(), req, v2alpha1)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("Expected error but CheckAccess returned a nil error")
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Expected no errors but CheckAccess returned error: %v", err)
+			continue
+		}
+		if response.Allowed != tc.expectAllowed {
+			t.Errorf("Expected allowed=%v, got %v", tc.expectAllowed, response.Allowed)
+		}
+	}
+}
+
+func TestSetACL(t *testing.T) {
+	v2alpha1 := apiversion.NewVersionOrPanic("v2alpha1")
+	path := `C:\var\lib\kubelet\pods\pod1\volumes\pv1`
+	testCases := []struct {
+		name         string
+		request      *internal.SetACLRequest
+		expectGrants map[string]uint32
+		expectError  bool
+	}{
+		{
+			name:    "sddl",
+			request: &internal.SetACLRequest{Path: path, Sddl: "D:PAI(A;OICI;FA;;;SY)"},
+		},
+		{
+			name: "grants",
+			request: &internal.SetACLRequest{Path: path, Grants: []*internal.AccessGrant{
+				{Sid: "S-1-5-32-545", Access: internal.ACCESS_RIGHT_READ},
+				{Sid: "S-1-5-93-2-1", Access: internal.ACCESS_RIGHT_MODIFY},
+				{Sid: "S-1-5-32-545", Access: internal.ACCESS_RIGHT_WRITE},
+			}},
+			expectGrants: map[string]uint32{
+				"S-1-5-32-545": filesystem.FileGenericRead | filesystem.FileGenericWrite,
+				"S-1-5-93-2-1": filesystem.FileGenericRead | filesystem.FileGenericWrite | filesystem.FileGenericExecute | filesystem.Delete,
+			},
+		},
+		{
+			name:        "sddl and grants",
+			request:     &internal.SetACLRequest{Path: path, Sddl: "D:PAI(A;OICI;FA;;;SY)", Grants: []*internal.AccessGrant{{Sid: "S-1-5-32-545"}}},
+			expectError: true,
+		},
+		{
+			name:        "neither sddl nor grants",
+			request:     &internal.SetACLRequest{Path: path},
+			expectError: true,
+		},
+		{
+			name:        "sid empty",
+			request:     &internal.SetACLRequest{Path: path, Grants: []*internal.AccessGrant{{Access: internal.ACCESS_RIGHT_READ}}},
+			expectError: true,
+		},
+		{
+			name:        "unknown access right",
+			request:     &internal.SetACLRequest{Path: path, Grants: []*internal.AccessGrant{{Sid: "S-1-5-32-545", Access: 42}}},
+			expectError: true,
+		},
+		{
+			name:        "path outside of the working directories",
+			request:     &internal.SetACLRequest{Path: `C:\Windows`, Sddl: "D:PAI(A;OICI;FA;;;SY)"},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hostAPI := &fakeFileSystemAPI{}
+			srv, err := NewServer([]string{`C:\var\lib\kubelet`}, hostAPI)
+			if err != nil {
+				t.Fatalf("FileSystem Server could not be initialized for testing: %v", err)
+			}
+			_, err = srv.SetACL(context.TODO(), tc.request, v2alpha1)
+			if tc.expectError && err == nil {
+				t.Fatalf("Expected error but SetACL returned a nil error")
+			}
+			if !tc.expectError && err != nil {
+				t.Fatalf("Expected no errors but SetACL returned error: %v", err)
+			}
+			if !reflect.DeepEqual(hostAPI.grants, tc.expectGrants) {
+				t.Errorf("expected grants %v, got %v", tc.expectGrants, hostAPI.grants)
+			}
+		})
+	}
+}
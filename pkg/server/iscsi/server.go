@@ -3,6 +3,9 @@ package iscsi
 import (
 	"context"
 	"fmt"
+	"net"
+	"strconv"
+	"strings"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/iscsi"
@@ -34,18 +37,62 @@ func NewServer(hostAPI API) (*Server, error) {
 	}, nil
 }
 
-func (s *Server) requestTPtoAPITP(portal *internal.TargetPortal) *iscsi.TargetPortal {
+// WarmUp lists the target portals, it loads the iSCSI module and the iSCSI WMI provider.
+func (s *Server) WarmUp() error {
+	_, err := s.hostAPI.ListTargetPortals()
+	return err
+}
+
+// requestTPtoAPITP converts the target portal of a request. The address can be
+// an IPv4 address, a host name or an IPv6 address with or without brackets,
+// followed by the port e.g. [fd00::1]:3261 if the port of the portal isn't set.
+func (s *Server) requestTPtoAPITP(portal *internal.TargetPortal) (*iscsi.TargetPortal, error) {
+	address := portal.TargetAddress
 	port := portal.TargetPort
+	switch {
+	case strings.HasPrefix(address, "[") && strings.HasSuffix(address, "]"):
+		address = address[1 : len(address)-1]
+	case strings.HasPrefix(address, "["), strings.Count(address, ":") == 1:
+		host, addressPort, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid target portal address %q: %v", portal.TargetAddress, err)
+		}
+		n, err := strconv.ParseUint(addressPort, 10, 16)
+		if err != nil || n == 0 {
+			return nil, fmt.Errorf("invalid port in target portal address %q", portal.TargetAddress)
+		}
+		if port != 0 && port != uint32(n) {
+			return nil, fmt.Errorf("the port of target portal address %q doesn't match the target port %d", portal.TargetAddress, port)
+		}
+		address, port = host, uint32(n)
+	}
+	if address == "" {
+		return nil, fmt.Errorf("target portal address is empty")
+	}
+	// the portals of IPv6 addresses are looked up by their canonical form
+	if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+		address = ip.String()
+	}
 	if port == 0 {
 		port = defaultIscsiPort
 	}
-	return &iscsi.TargetPortal{Address: portal.TargetAddress, Port: port}
+	return &iscsi.TargetPortal{Address: address, Port: port}, nil
+}
+
+// portalString formats the target portal of a request for the logs.
+func portalString(portal *internal.TargetPortal) string {
+	return net.JoinHostPort(strings.Trim(portal.TargetAddress, "[]"), strconv.FormatUint(uint64(portal.TargetPort), 10))
 }
 
 func (s *Server) AddTargetPortal(context context.Context, request *internal.AddTargetPortalRequest, version apiversion.Version) (*internal.AddTargetPortalResponse, error) {
-	klog.V(4).Infof("calling AddTargetPortal with portal %s:%d", request.TargetPortal.TargetAddress, request.TargetPortal.TargetPort)
+	klog.V(4).Infof("calling AddTargetPortal with portal %s", portalString(request.TargetPortal))
 	response := &internal.AddTargetPortalResponse{}
-	err := s.hostAPI.AddTargetPortal(s.requestTPtoAPITP(request.TargetPortal))
+	portal, err := s.requestTPtoAPITP(request.TargetPortal)
+	if err != nil {
+		klog.Errorf("failed AddTargetPortal %v", err)
+		return response, err
+	}
+	err = s.hostAPI.AddTargetPortal(portal)
 	if err != nil {
 		klog.Errorf("failed AddTargetPortal %v", err)
 		return response, err
@@ -68,9 +115,8 @@ func AuthTypeToString(authType internal.AuthenticationType) (string, error) {
 }
 
 func (s *Server) ConnectTarget(context context.Context, req *internal.ConnectTargetRequest, version apiversion.Version) (*internal.ConnectTargetResponse, error) {
-	klog.V(4).Infof("calling ConnectTarget with portal %s:%d and iqn %s"+
-		" auth=%v chapuser=%v", req.TargetPortal.TargetAddress,
-		req.TargetPortal.TargetPort, req.Iqn, req.AuthType, req.ChapUsername)
+	klog.V(4).Infof("calling ConnectTarget with portal %s and iqn %s"+
+		" auth=%v chapuser=%v", portalString(req.TargetPortal), req.Iqn, req.AuthType, req.ChapUsername)
 
 	response := &internal.ConnectTargetResponse{}
 	authType, err := AuthTypeToString(req.AuthType)
@@ -79,7 +125,12 @@ func (s *Server) ConnectTarget(context context.Context, req *internal.ConnectTar
 		return response, err
 	}
 
-	err = s.hostAPI.ConnectTarget(s.requestTPtoAPITP(req.TargetPortal), req.Iqn,
+	portal, err := s.requestTPtoAPITP(req.TargetPortal)
+	if err != nil {
+		klog.Errorf("failed ConnectTarget %v", err)
+		return response, err
+	}
+	err = s.hostAPI.ConnectTarget(portal, req.Iqn,
 		authType, req.ChapUsername, req.ChapSecret)
 	if err != nil {
 		klog.Errorf("failed ConnectTarget %v", err)
@@ -90,11 +141,16 @@ func (s *Server) ConnectTarget(context context.Context, req *internal.ConnectTar
 }
 
 func (s *Server) DisconnectTarget(context context.Context, request *internal.DisconnectTargetRequest, version apiversion.Version) (*internal.DisconnectTargetResponse, error) {
-	klog.V(4).Infof("calling DisconnectTarget with portal %s:%d and iqn %s",
-		request.TargetPortal.TargetAddress, request.TargetPortal.TargetPort, request.Iqn)
+	klog.V(4).Infof("calling DisconnectTarget with portal %s and iqn %s",
+		portalString(request.TargetPortal), request.Iqn)
 
 	response := &internal.DisconnectTargetResponse{}
-	err := s.hostAPI.DisconnectTarget(s.requestTPtoAPITP(request.TargetPortal), request.Iqn)
+	portal, err := s.requestTPtoAPITP(request.TargetPortal)
+	if err != nil {
+		klog.Errorf("failed DisconnectTarget %v", err)
+		return response, err
+	}
+	err = s.hostAPI.DisconnectTarget(portal, request.Iqn)
 	if err != nil {
 		klog.Errorf("failed DisconnectTarget %v", err)
 		return response, err
@@ -104,9 +160,14 @@ func (s *Server) DisconnectTarget(context context.Context, request *internal.Dis
 }
 
 func (s *Server) DiscoverTargetPortal(context context.Context, request *internal.DiscoverTargetPortalRequest, version apiversion.Version) (*internal.DiscoverTargetPortalResponse, error) {
-	klog.V(4).Infof("calling DiscoverTargetPortal with portal %s:%d", request.TargetPortal.TargetAddress, request.TargetPortal.TargetPort)
+	klog.V(4).Infof("calling DiscoverTargetPortal with portal %s", portalString(request.TargetPortal))
 	response := &internal.DiscoverTargetPortalResponse{}
-	iqns, err := s.hostAPI.DiscoverTargetPortal(s.requestTPtoAPITP(request.TargetPortal))
+	portal, err := s.requestTPtoAPITP(request.TargetPortal)
+	if err != nil {
+		klog.Errorf("failed DiscoverTargetPortal %v", err)
+		return response, err
+	}
+	iqns, err := s.hostAPI.DiscoverTargetPortal(portal)
 	if err != nil {
 		klog.Errorf("failed DiscoverTargetPortal %v", err)
 		return response, err
@@ -117,10 +178,15 @@ func (s *Server) DiscoverTargetPortal(context context.Context, request *internal
 }
 
 func (s *Server) GetTargetDisks(context context.Context, request *internal.GetTargetDisksRequest, version apiversion.Version) (*internal.GetTargetDisksResponse, error) {
-	klog.V(4).Infof("calling GetTargetDisks with portal %s:%d and iqn %s",
-		request.TargetPortal.TargetAddress, request.TargetPortal.TargetPort, request.Iqn)
+	klog.V(4).Infof("calling GetTargetDisks with portal %s and iqn %s",
+		portalString(request.TargetPortal), request.Iqn)
 	response := &internal.GetTargetDisksResponse{}
-	disks, err := s.hostAPI.GetTargetDisks(s.requestTPtoAPITP(request.TargetPortal), request.Iqn)
+	portal, err := s.requestTPtoAPITP(request.TargetPortal)
+	if err != nil {
+		klog.Errorf("failed GetTargetDisks %v", err)
+		return response, err
+	}
+	disks, err := s.hostAPI.GetTargetDisks(portal, request.Iqn)
 	if err != nil {
 		klog.Errorf("failed GetTargetDisks %v", err)
 		return response, err
@@ -159,9 +225,14 @@ func (s *Server) ListTargetPortals(context context.Context, request *internal.Li
 }
 
 func (s *Server) RemoveTargetPortal(context context.Context, request *internal.RemoveTargetPortalRequest, version apiversion.Version) (*internal.RemoveTargetPortalResponse, error) {
-	klog.V(4).Infof("calling RemoveTargetPortal with portal %s:%d", request.TargetPortal.TargetAddress, request.TargetPortal.TargetPort)
+	klog.V(4).Infof("calling RemoveTargetPortal with portal %s", portalString(request.TargetPortal))
 	response := &internal.RemoveTargetPortalResponse{}
-	err := s.hostAPI.RemoveTargetPortal(s.requestTPtoAPITP(request.TargetPortal))
+	portal, err := s.requestTPtoAPITP(request.TargetPortal)
+	if err != nil {
+		klog.Errorf("failed RemoveTargetPortal %v", err)
+		return response, err
+	}
+	err = s.hostAPI.RemoveTargetPortal(portal)
 	if err != nil {
 		klog.Errorf("failed RemoveTargetPortal %v", err)
 		return response, err
@@ -0,0 +1,78 @@
+package iscsi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/iscsi"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/iscsi/impl"
+)
+
+func TestRequestTPtoAPITP(t *testing.T) {
+	testCases := []struct {
+		portal       internal.TargetPortal
+		expectPortal *iscsi.TargetPortal
+		expectError  bool
+	}{
+		{
+			portal:       internal.TargetPortal{TargetAddress: "10.0.0.1"},
+			expectPortal: &iscsi.TargetPortal{Address: "10.0.0.1", Port: 3260},
+		},
+		{
+			portal:       internal.TargetPortal{TargetAddress: "target.example.com", TargetPort: 3261},
+			expectPortal: &iscsi.TargetPortal{Address: "target.example.com", Port: 3261},
+		},
+		{
+			portal:       internal.TargetPortal{TargetAddress: "10.0.0.1:3261"},
+			expectPortal: &iscsi.TargetPortal{Address: "10.0.0.1", Port: 3261},
+		},
+		{
+			portal:       internal.TargetPortal{TargetAddress: "FD00:0::1"},
+			expectPortal: &iscsi.TargetPortal{Address: "fd00::1", Port: 3260},
+		},
+		{
+			portal:       internal.TargetPortal{TargetAddress: "[fd00::1]", TargetPort: 3261},
+			expectPortal: &iscsi.TargetPortal{Address: "fd00::1", Port: 3261},
+		},
+		{
+			portal:       internal.TargetPortal{TargetAddress: "[fd00::1]:3261", TargetPort: 3261},
+			expectPortal: &iscsi.TargetPortal{Address: "fd00::1", Port: 3261},
+		},
+		{
+			portal:      internal.TargetPortal{TargetAddress: "[fd00::1]:3261", TargetPort: 3262},
+			expectError: true,
+		},
+		{
+			portal:      internal.TargetPortal{TargetAddress: "10.0.0.1:iscsi"},
+			expectError: true,
+		},
+		{
+			portal:      internal.TargetPortal{TargetAddress: "[fd00::1"},
+			expectError: true,
+		},
+		{
+			portal:      internal.TargetPortal{TargetAddress: ""},
+			expectError: true,
+		},
+	}
+	srv, err := NewServer(nil)
+	if err != nil {
+		t.Fatalf("iSCSI server could not be initialized for testing: %v", err)
+	}
+	for _, tc := range testCases {
+		portal, err := srv.requestTPtoAPITP(&tc.portal)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("%+v: expected an error", tc.portal)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", tc.portal, err)
+			continue
+		}
+		if !reflect.DeepEqual(portal, tc.expectPortal) {
+			t.Errorf("%+v: expected %+v, got %+v", tc.portal, tc.expectPortal, portal)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// serializedMethods are the methods that change a volume, a disk, a virtual
+// disk or a storage pool, the calls of these methods against the same volume
+// (or the same disk) are run one at a time so that they don't race in Windows and fail with
+// "resource busy" errors.
+// They're listed by service and method name without the API version.
+var serializedMethods = map[string]bool{
+	"Volume/FormatVolume":             true,
+	"Volume/ResizeVolume":             true,
+	"Volume/MountVolume":              true,
+	"Volume/UnmountVolume":            true,
+	"Volume/DismountVolume":           true,
+	"Volume/RepairVolume":             true,
+	"Volume/OptimizeVolume":           true,
+	"Volume/SetVolumeLabel":           true,
+	"Volume/SetVolumeCompression":     true,
+	"Volume/CleanupVolume":            true,
+	"Volume/EnableDeduplication":      true,
+	"Vss/RevertToShadowCopy":          true,
+	"Disk/PartitionDisk":              true,
+	"Disk/DeletePartition":            true,
+	"Disk/SetPartitionType":           true,
+	"Disk/WipeDisk":                   true,
+	"Disk/ConvertDiskToGPT":           true,
+	"Disk/SetDiskState":               true,
+	"Disk/SetAttachState":             true,
+	"Disk/SetDiskReadOnly":            true,
+	"Vhd/CreateVirtualDisk":           true,
+	"Vhd/AttachVirtualDisk":           true,
+	"Vhd/DetachVirtualDisk":           true,
+	"Vhd/ResizeVirtualDisk":           true,
+	"Storagespaces/CreateStoragePool": true,
+	"Storagespaces/DeleteStoragePool": true,
+	"Storagespaces/CreateVirtualDisk": true,
+	"Storagespaces/DeleteVirtualDisk": true,
+}
+
+// operationLocks are the locks of the volumes and the disks with an operation
+// in progress.
+var operationLocks = newKeyedLocks()
+
+// keyedLocks is a set of locks created on demand by key (e.g. a volume ID), a
+// lock is removed once no operation holds it or waits for it.
+type keyedLocks struct {
+	lock  sync.Mutex
+	locks map[string]*keyedLock
+}
+
+// keyedLock is a lock that can be waited for with a context, it's held while
+// its channel has an element.
+type keyedLock struct {
+	held chan struct{}
+	// users is the number of operations holding or waiting for the lock.
+	users int
+}
+
+func newKeyedLocks() *keyedLocks {
+	return &keyedLocks{
+		locks: make(map[string]*keyedLock),
+	}
+}
+
+// Lock waits until the lock of `key` is acquired or `ctx` is done, it returns the
+// function that releases the lock.
+func (k *keyedLocks) Lock(ctx context.Context, key string) (func(), error) {
+	k.lock.Lock()
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyedLock{held: make(chan struct{}, 1)}
+		k.locks[key] = l
+	}
+	l.users++
+	k.lock.Unlock()
+
+	select {
+	case l.held <- struct{}{}:
+	default:
+		klog.V(4).Infof("waiting for the operation in progress on %s", key)
+		select {
+		case l.held <- struct{}{}:
+		case <-ctx.Done():
+			k.release(key, l)
+			return nil, ctx.Err()
+		}
+	}
+	return func() {
+		<-l.held
+		k.release(key, l)
+	}, nil
+}
+
+// release removes the lock `l` of `key` when the last operation using it is done.
+func (k *keyedLocks) release(key string, l *keyedLock) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	l.users--
+	if l.users == 0 {
+		delete(k.locks, key)
+	}
+}
+
+// operationKey returns the key of the volume or the disk the request `req` is
+// about, "" if it has neither.
+func operationKey(req interface{}) string {
+	switch r := req.(type) {
+	case interface{ GetVolumeId() string }:
+		if id := r.GetVolumeId(); id != "" {
+			return volumeKey(id)
+		}
+	case interface{ GetDiskNumber() uint32 }:
+		return fmt.Sprintf("disk %d", r.GetDiskNumber())
+	case interface{ GetDiskID() string }:
+		if id := r.GetDiskID(); id != "" {
+			return "disk " + id
+		}
+	case interface{ GetPoolName() string }:
+		// the virtual disks of a storage pool are serialized with the pool, the
+		// names of the pools are case insensitive
+		if name := r.GetPoolName(); name != "" {
+			return "pool " + strings.ToLower(name)
+		}
+	case interface{ GetPath() string }:
+		// paths of virtual disk files are case insensitive
+		if path := r.GetPath(); path != "" {
+			return "path " + strings.ToLower(path)
+		}
+	}
+	return ""
+}
+
+// volumeKey returns the key of the volume `volumeID`.
+func volumeKey(volumeID string) string {
+	// volume IDs are GUID paths, which are case insensitive
+	return "volume " + strings.ToLower(volumeID)
+}
+
+// lockVolume waits until the lock of the volume `volumeID` is acquired or `ctx`
+// is done, it's used by the operations that run in the background.
+func lockVolume(ctx context.Context, volumeID string) (func(), error) {
+	return operationLocks.Lock(ctx, volumeKey(volumeID))
+}
+
+// serializeOperations runs the calls of the serialized methods against the same
+// volume or disk one at a time. Operations on a volume aren't serialized with
+// operations on its disk.
+func serializeOperations(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !serializedMethods[serviceMethod(info.FullMethod)] {
+		return handler(ctx, req)
+	}
+	key := operationKey(req)
+	if key == "" {
+		return handler(ctx, req)
+	}
+	unlock, err := operationLocks.Lock(ctx, key)
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	defer unlock()
+	return handler(ctx, req)
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	diskapi "github.com/kubernetes-csi/csi-proxy/client/api/disk/v2alpha1"
+	storagespacesapi "github.com/kubernetes-csi/csi-proxy/client/api/storagespaces/v1alpha1"
+	vhdapi "github.com/kubernetes-csi/csi-proxy/client/api/vhd/v1alpha1"
+	volumeapi "github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestOperationKey(t *testing.T) {
+	testCases := []struct {
+		req       interface{}
+		expectKey string
+	}{
+		{req: &volumeapi.FormatVolumeRequest{VolumeId: `\\?\Volume{ABC}\`}, expectKey: `volume \\?\volume{abc}\`},
+		{req: &volumeapi.FormatVolumeRequest{}, expectKey: ""},
+		{req: &diskapi.PartitionDiskRequest{DiskNumber: 2}, expectKey: "disk 2"},
+		{req: &diskapi.RescanRequest{}, expectKey: ""},
+		{req: &vhdapi.ResizeVirtualDiskRequest{Path: `C:\var\lib\kubelet\Disk.vhdx`}, expectKey: `path c:\var\lib\kubelet\disk.vhdx`},
+		{req: &storagespacesapi.DeleteVirtualDiskRequest{PoolName: "Pool", Name: "disk"}, expectKey: "pool pool"},
+	}
+	for _, tc := range testCases {
+		if key := operationKey(tc.req); key != tc.expectKey {
+			t.Errorf("%+v: expected key %q, got %q", tc.req, tc.expectKey, key)
+		}
+	}
+}
+
+func TestSerializeOperations(t *testing.T) {
+	var lock sync.Mutex
+	running := map[string]int{}
+	maxRunning := map[string]int{}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		key := operationKey(req)
+		lock.Lock()
+		running[key]++
+		if running[key] > maxRunning[key] {
+			maxRunning[key] = running[key]
+		}
+		lock.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		lock.Lock()
+		running[key]--
+		lock.Unlock()
+		return nil, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		for _, method := range []string{"/v2alpha1.Volume/FormatVolume", "/v2alpha1.Volume/MountVolume"} {
+			for _, volumeID := range []string{"vol1", "vol2"} {
+				wg.Add(1)
+				go func(method, volumeID string) {
+					defer wg.Done()
+					info := &grpc.UnaryServerInfo{FullMethod: method}
+					req := &volumeapi.FormatVolumeRequest{VolumeId: volumeID}
+					if _, err := serializeOperations(context.TODO(), req, info, handler); err != nil {
+						t.Errorf("%s %s: unexpected error: %v", method, volumeID, err)
+					}
+				}(method, volumeID)
+			}
+		}
+	}
+	wg.Wait()
+	for key, max := range maxRunning {
+		if max != 1 {
+			t.Errorf("%s: expected the operations to run one at a time, got %d at a time", key, max)
+		}
+	}
+	if len(operationLocks.locks) != 0 {
+		t.Errorf("expected the locks to be removed, got %d", len(operationLocks.locks))
+	}
+}
+
+func TestSerializeOperationsCanceled(t *testing.T) {
+	unlock, err := operationLocks.Lock(context.TODO(), operationKey(&volumeapi.MountVolumeRequest{VolumeId: "vol1"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer unlock()
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	info := &grpc.UnaryServerInfo{FullMethod: "/v2alpha1.Volume/MountVolume"}
+	_, err = serializeOperations(ctx, &volumeapi.MountVolumeRequest{VolumeId: "vol1"}, info, handler)
+	if code := status.Code(err); code != codes.DeadlineExceeded {
+		t.Errorf("expected code %v, got %v", codes.DeadlineExceeded, code)
+	}
+
+	// the methods that don't change the volume aren't serialized
+	info = &grpc.UnaryServerInfo{FullMethod: "/v2alpha1.Volume/GetVolumeStats"}
+	if _, err := serializeOperations(ctx, &volumeapi.GetVolumeStatsRequest{VolumeId: "vol1"}, info, handler); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
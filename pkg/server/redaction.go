@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// redactSecrets registers the secrets of the requests and of the responses (e.g.
+// SMB passwords, CHAP secrets and BitLocker recovery passwords) so that they're
+// redacted from the logs, the command log and the errors of every operation,
+// and redacts the errors returned to the clients.
+func redactSecrets(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	registerSecrets(req)
+	resp, err := handler(ctx, req)
+	registerSecrets(resp)
+	if err == nil {
+		return resp, nil
+	}
+	if s, ok := status.FromError(err); ok {
+		p := s.Proto()
+		p.Message = utils.Redact(p.Message)
+		return resp, status.ErrorProto(p)
+	}
+	return resp, utils.RedactError(err)
+}
+
+// registerSecrets registers the string fields of the message `m` and of its
+// nested messages whose names contain "password" or "secret".
+func registerSecrets(m interface{}) {
+	if msg, ok := m.(proto.Message); ok && msg != nil {
+		registerMessageSecrets(msg.ProtoReflect())
+	}
+}
+
+func registerMessageSecrets(m protoreflect.Message) {
+	if !m.IsValid() {
+		return
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+			for i := 0; i < v.List().Len(); i++ {
+				registerMessageSecrets(v.List().Get(i).Message())
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					registerMessageSecrets(mv.Message())
+					return true
+				})
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			registerMessageSecrets(v.Message())
+		case fd.Kind() == protoreflect.StringKind && !fd.IsList() && isSecretField(fd):
+			utils.RegisterSecret(v.String())
+		}
+		return true
+	})
+}
+
+// isSecretField returns true if the name of the field `fd` (e.g. chap_secret,
+// recovery_password) says it holds a credential.
+func isSecretField(fd protoreflect.FieldDescriptor) bool {
+	name := strings.ToLower(string(fd.Name()))
+	return strings.Contains(name, "password") || strings.Contains(name, "secret")
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	bitlockerv1alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/bitlocker/v1alpha1"
+	smbv1 "github.com/kubernetes-csi/csi-proxy/client/api/smb/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           interface{}
+		resp          interface{}
+		err           error
+		expectMessage string
+	}{
+		{
+			name:          "password of the request",
+			req:           &smbv1.NewSmbGlobalMappingRequest{RemotePath: `\\server\share`, Password: "request-password"},
+			err:           errors.New("logon failure for request-password"),
+			expectMessage: "logon failure for [REDACTED]",
+		},
+		{
+			name:          "error with a status code",
+			req:           &smbv1.NewSmbGlobalMappingRequest{RemotePath: `\\server\share`, Password: "status-password"},
+			err:           status.Error(codes.Unauthenticated, "logon failure for status-password"),
+			expectMessage: "logon failure for [REDACTED]",
+		},
+		{
+			name:          "recovery password of an earlier response",
+			req:           &smbv1.NewSmbGlobalMappingRequest{RemotePath: `\\server\share`},
+			err:           errors.New("unexpected 111111-222222"),
+			expectMessage: "unexpected [REDACTED]",
+		},
+	}
+	// the recovery password generated by an earlier operation
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &bitlockerv1alpha1.EnableBitLockerResponse{RecoveryPassword: "111111-222222"}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/v1alpha1.Bitlocker/EnableBitLocker"}
+	if _, err := redactSecrets(context.TODO(), &bitlockerv1alpha1.EnableBitLockerRequest{}, info, handler); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+
+	for _, tc := range testCases {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, tc.err
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: "/v1.Smb/NewSmbGlobalMapping"}
+		_, err := redactSecrets(context.TODO(), tc.req, info, handler)
+		if s := status.Convert(err); s.Message() != tc.expectMessage || s.Code() != status.Convert(tc.err).Code() {
+			t.Errorf("%s: expected message %q, got %v", tc.name, tc.expectMessage, err)
+		}
+	}
+}
@@ -1,24 +1,38 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/Microsoft/go-winio"
 	"github.com/kubernetes-csi/csi-proxy/client"
+	"github.com/kubernetes-csi/csi-proxy/pkg/metrics"
 	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"k8s.io/klog/v2"
 )
 
 // Server aggregates a number of API groups and versions,
 // and serves requests for all of them.
 type Server struct {
-	versionedAPIs []*srvtypes.VersionedAPI
-	started       bool
-	mutex         *sync.Mutex
-	grpcServers   []*grpc.Server
+	versionedAPIs   []*srvtypes.VersionedAPI
+	driverEndpoints []*driverEndpoint
+	pipes           []*pipe
+	started         bool
+	mutex           *sync.Mutex
+	grpcServers     []*grpc.Server
+}
+
+// pipe is a named pipe served by a GRPC server, the pipes of the driver
+// endpoints have a driver.
+type pipe struct {
+	versionedAPI *srvtypes.VersionedAPI
+	path         string
+	driver       *driverEndpoint
 }
 
 // NewServer creates a new Server for the given API groups.
@@ -26,6 +40,9 @@ func NewServer(apiGroups ...srvtypes.APIGroup) *Server {
 	versionedAPIs := make([]*srvtypes.VersionedAPI, 0, len(apiGroups))
 	for _, apiGroup := range apiGroups {
 		versionedAPIs = append(versionedAPIs, apiGroup.VersionedAPIs()...)
+		if locker, ok := apiGroup.(srvtypes.VolumeLocker); ok {
+			locker.SetVolumeLock(lockVolume)
+		}
 	}
 
 	return &Server{
@@ -34,7 +51,23 @@ func NewServer(apiGroups ...srvtypes.APIGroup) *Server {
 	}
 }
 
-// Start starts one GRPC server per API version; it is a blocking call, that returns
+// SetDriverEndpoints makes the server serve the API versions on the named pipes
+// of the driver endpoints too, it must be called before Start.
+func (s *Server) SetDriverEndpoints(endpoints []DriverEndpoint) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.started {
+		return fmt.Errorf("server already started")
+	}
+	s.driverEndpoints = nil
+	for _, endpoint := range endpoints {
+		s.driverEndpoints = append(s.driverEndpoints, newDriverEndpoint(endpoint))
+	}
+	return nil
+}
+
+// Start starts one GRPC server per API version and driver endpoint; it is a blocking call, that returns
 // as soon as any of those servers shuts down (at which point it also shuts down all the
 // others).
 // If passed a listeningChan, it will close it when it's started listening.
@@ -45,6 +78,8 @@ func (s *Server) Start(listeningChan chan interface{}) []error {
 	}
 	defer close(doneChan)
 
+	ready.Set(1)
+	klog.Info("CSI-Proxy is ready")
 	if listeningChan != nil {
 		close(listeningChan)
 	}
@@ -61,6 +96,7 @@ func (s *Server) startListening() (chan *versionedAPIDone, []error) {
 		return nil, []error{fmt.Errorf("server already started")}
 	}
 	s.started = true
+	s.pipes = s.createPipes()
 
 	listeners, ListenErr := s.createListeners()
 	if len(ListenErr) != 0 {
@@ -70,14 +106,38 @@ func (s *Server) startListening() (chan *versionedAPIDone, []error) {
 	return s.createAndStartGRPCServers(listeners), nil
 }
 
+// createPipes returns the named pipes of the API versions, and of the API
+// versions of each driver endpoint whose driver can call methods of their API
+// group.
+func (s *Server) createPipes() []*pipe {
+	pipes := []*pipe{}
+	for _, versionedAPI := range s.versionedAPIs {
+		pipes = append(pipes, &pipe{
+			versionedAPI: versionedAPI,
+			path:         client.PipePath(versionedAPI.Group, versionedAPI.Version),
+		})
+	}
+	for _, driver := range s.driverEndpoints {
+		for _, versionedAPI := range s.versionedAPIs {
+			if !driver.allowsGroup(versionedAPI.Group) {
+				continue
+			}
+			pipes = append(pipes, &pipe{
+				versionedAPI: versionedAPI,
+				path:         client.DriverPipePath(versionedAPI.Group, versionedAPI.Version, driver.Name),
+				driver:       driver,
+			})
+		}
+	}
+	return pipes
+}
+
 // createListeners creates the named pipes.
 func (s *Server) createListeners() (listeners []net.Listener, errors []error) {
-	listeners = make([]net.Listener, len(s.versionedAPIs))
-
-	for i, versionedAPI := range s.versionedAPIs {
-		pipePath := client.PipePath(versionedAPI.Group, versionedAPI.Version)
+	listeners = make([]net.Listener, len(s.pipes))
 
-		listener, err := winio.ListenPipe(pipePath, nil)
+	for i, pipe := range s.pipes {
+		listener, err := winio.ListenPipe(pipe.path, nil)
 		if err == nil {
 			listeners[i] = listener
 		} else {
@@ -104,14 +164,18 @@ type versionedAPIDone struct {
 
 // createAndStartGRPCServers creates the GRPC servers, but doesn't start them just yet.
 func (s *Server) createAndStartGRPCServers(listeners []net.Listener) chan *versionedAPIDone {
-	doneChan := make(chan *versionedAPIDone, len(s.versionedAPIs))
-	s.grpcServers = make([]*grpc.Server, len(s.versionedAPIs))
+	doneChan := make(chan *versionedAPIDone, len(s.pipes))
+	s.grpcServers = make([]*grpc.Server, len(s.pipes))
 
-	for i, versionedAPI := range s.versionedAPIs {
-		grpcServer := grpc.NewServer()
+	for i, pipe := range s.pipes {
+		interceptors := []grpc.UnaryServerInterceptor{recordOperation, redactSecrets, rejectPowerShellMethods, attachErrorInfo, rejectUnsupportedMethods, serializeOperations}
+		if pipe.driver != nil {
+			interceptors = append([]grpc.UnaryServerInterceptor{pipe.driver.intercept}, interceptors...)
+		}
+		grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
 		s.grpcServers[i] = grpcServer
 
-		versionedAPI.Registrant(grpcServer)
+		pipe.versionedAPI.Registrant(grpcServer)
 		// this next line is not a tautology, because of how go treats closures...
 		index := i
 
@@ -131,8 +195,8 @@ func (s *Server) createAndStartGRPCServers(listeners []net.Listener) chan *versi
 func (s *Server) waitForGRPCServersToStop(doneChan chan *versionedAPIDone) (errs []error) {
 	processServerDoneEvent := func(event *versionedAPIDone) {
 		if event.err != nil {
-			versionedAPI := s.versionedAPIs[event.index]
-			err := errors.Wrapf(event.err, "GRPC server for API group %s version %s failed", versionedAPI.Group, versionedAPI.Version)
+			pipe := s.pipes[event.index]
+			err := errors.Wrapf(event.err, "GRPC server for API group %s version %s at %s failed", pipe.versionedAPI.Group, pipe.versionedAPI.Version, pipe.path)
 			errs = append(errs, err)
 		}
 	}
@@ -148,7 +212,7 @@ func (s *Server) waitForGRPCServersToStop(doneChan chan *versionedAPIDone) (errs
 
 	// and wait for them to stop
 	// TODO: do we want a timeout here?
-	for doneCount := 1; doneCount < len(s.versionedAPIs); doneCount++ {
+	for doneCount := 1; doneCount < len(s.pipes); doneCount++ {
 		processServerDoneEvent(<-doneChan)
 	}
 
@@ -172,3 +236,12 @@ func (s *Server) Stop() error {
 
 	return nil
 }
+
+// recordOperation records the duration of every operation in the operation log
+// and checks it against the SLO of the operation.
+func recordOperation(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	metrics.Latency.Observe(metrics.Operations.Record(info.FullMethod, start, err))
+	return resp, err
+}
@@ -3,12 +3,15 @@ package smb
 import (
 	"context"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/smb"
 	fsserver "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/smb/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
 	"k8s.io/klog/v2"
 )
 
@@ -25,6 +28,54 @@ func normalizeWindowsPath(path string) string {
 	return normalizedPath
 }
 
+// defaultSmbPort is the TCP port of SMB.
+const defaultSmbPort = 445
+
+// parseRemotePath parses the remote path of an SMB share e.g. \\server\share,
+// \\[fd00::1]\share or \\server:8445\share. IPv6 literals, which UNC paths can't
+// contain, are replaced by their ipv6-literal.net name. It returns the UNC path
+// and the TCP port, 0 for the default port.
+func parseRemotePath(path string) (string, uint32, error) {
+	path = normalizeWindowsPath(path)
+	if !strings.HasPrefix(path, `\\`) {
+		return path, 0, nil
+	}
+	host, share := path[2:], ""
+	if i := strings.Index(host, `\`); i >= 0 {
+		host, share = host[:i], host[i:]
+	}
+
+	port := ""
+	switch {
+	case strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]"):
+		host = host[1 : len(host)-1]
+	case strings.HasPrefix(host, "["), strings.Count(host, ":") == 1:
+		var err error
+		if host, port, err = net.SplitHostPort(host); err != nil {
+			return "", 0, fmt.Errorf("invalid server %q in remote path %s: %v", host, path, err)
+		}
+	}
+	if address := strings.SplitN(host, "%", 2)[0]; strings.Contains(address, ":") {
+		if ip := net.ParseIP(address); ip == nil {
+			return "", 0, fmt.Errorf("invalid IPv6 address %q in remote path %s", host, path)
+		}
+		// e.g. fe80--1s4.ipv6-literal.net for fe80::1%4
+		host = strings.NewReplacer(":", "-", "%", "s").Replace(host) + ".ipv6-literal.net"
+	}
+
+	if port == "" {
+		return `\\` + host + share, 0, nil
+	}
+	n, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || n == 0 {
+		return "", 0, fmt.Errorf("invalid port %q in remote path %s", port, path)
+	}
+	if n == defaultSmbPort {
+		n = 0
+	}
+	return `\\` + host + share, uint32(n), nil
+}
+
 func NewServer(hostAPI smb.API, fsServer *fsserver.Server) (*Server, error) {
 	return &Server{
 		hostAPI:  hostAPI,
@@ -35,13 +86,23 @@ func NewServer(hostAPI smb.API, fsServer *fsserver.Server) (*Server, error) {
 func (s *Server) NewSmbGlobalMapping(context context.Context, request *internal.NewSmbGlobalMappingRequest, version apiversion.Version) (*internal.NewSmbGlobalMappingResponse, error) {
 	klog.V(2).Infof("calling NewSmbGlobalMapping with remote path %q", request.RemotePath)
 	response := &internal.NewSmbGlobalMappingResponse{}
-	remotePath := normalizeWindowsPath(request.RemotePath)
 	localPath := request.LocalPath
 
-	if remotePath == "" {
+	if request.RemotePath == "" {
 		klog.Errorf("remote path is empty")
 		return response, fmt.Errorf("remote path is empty")
 	}
+	remotePath, port, err := parseRemotePath(request.RemotePath)
+	if err != nil {
+		klog.Errorf("failed parsing remote path %v", err)
+		return response, err
+	}
+	if port != 0 {
+		if err := utils.CheckFeature(utils.FeatureSmbAlternativePort); err != nil {
+			klog.Errorf("failed NewSmbGlobalMapping %v", err)
+			return response, err
+		}
+	}
 
 	isMapped, err := s.hostAPI.IsSmbMapped(remotePath)
 	if err != nil {
@@ -67,7 +128,7 @@ func (s *Server) NewSmbGlobalMapping(context context.Context, request *internal.
 
 	if !isMapped {
 		klog.V(4).Infof("Remote %s not mapped. Mapping now!", remotePath)
-		err := s.hostAPI.NewSmbGlobalMapping(remotePath, request.Username, request.Password)
+		err := s.hostAPI.NewSmbGlobalMapping(remotePath, port, request.Username, request.Password)
 		if err != nil {
 			klog.Errorf("failed NewSmbGlobalMapping %v", err)
 			return response, err
@@ -93,14 +154,18 @@ func (s *Server) NewSmbGlobalMapping(context context.Context, request *internal.
 func (s *Server) RemoveSmbGlobalMapping(context context.Context, request *internal.RemoveSmbGlobalMappingRequest, version apiversion.Version) (*internal.RemoveSmbGlobalMappingResponse, error) {
 	klog.V(2).Infof("calling RemoveSmbGlobalMapping with remote path %q", request.RemotePath)
 	response := &internal.RemoveSmbGlobalMappingResponse{}
-	remotePath := normalizeWindowsPath(request.RemotePath)
 
-	if remotePath == "" {
+	if request.RemotePath == "" {
 		klog.Errorf("remote path is empty")
 		return response, fmt.Errorf("remote path is empty")
 	}
+	remotePath, _, err := parseRemotePath(request.RemotePath)
+	if err != nil {
+		klog.Errorf("failed parsing remote path %v", err)
+		return response, err
+	}
 
-	err := s.hostAPI.RemoveSmbGlobalMapping(remotePath)
+	err = s.hostAPI.RemoveSmbGlobalMapping(remotePath)
 	if err != nil {
 		klog.Errorf("failed RemoveSmbGlobalMapping %v", err)
 		return response, err
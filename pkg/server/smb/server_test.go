@@ -15,7 +15,7 @@ type fakeSmbAPI struct{}
 
 var _ smb.API = &fakeSmbAPI{}
 
-func (fakeSmbAPI) NewSmbGlobalMapping(remotePath, username, password string) error {
+func (fakeSmbAPI) NewSmbGlobalMapping(remotePath string, port uint32, username, password string) error {
 	return nil
 }
 
@@ -58,6 +58,34 @@ func (fakeFileSystemAPI) IsSymlink(path string) (bool, error) {
 	return true, nil
 }
 
+func (fakeFileSystemAPI) GetMountPointType(path string) (filesystem.MountPointType, error) {
+	return filesystem.Directory, nil
+}
+
+func (fakeFileSystemAPI) CreateJunction(target string, junction string) error {
+	return nil
+}
+
+func (fakeFileSystemAPI) ResolveJunction(path string) (string, error) {
+	return "", nil
+}
+
+func (fakeFileSystemAPI) GetEffectiveAccess(path string, sid string) (uint32, error) {
+	return 0, nil
+}
+
+func (fakeFileSystemAPI) GetSecurityDescriptor(path string) (string, error) {
+	return "", nil
+}
+
+func (fakeFileSystemAPI) SetDACL(path string, sddl string) error {
+	return nil
+}
+
+func (fakeFileSystemAPI) GrantAccess(path string, grants map[string]uint32) error {
+	return nil
+}
+
 func TestNewSmbGlobalMapping(t *testing.T) {
 	v1, err := apiversion.NewVersion("v1")
 	if err != nil {
@@ -111,3 +139,41 @@ func TestNewSmbGlobalMapping(t *testing.T) {
 		}
 	}
 }
+
+func TestParseRemotePath(t *testing.T) {
+	testCases := []struct {
+		path             string
+		expectRemotePath string
+		expectPort       uint32
+		expectError      bool
+	}{
+		{path: `\\server\share`, expectRemotePath: `\\server\share`},
+		{path: "//10.0.0.1/share/dir", expectRemotePath: `\\10.0.0.1\share\dir`},
+		{path: `\\server:8445\share`, expectRemotePath: `\\server\share`, expectPort: 8445},
+		{path: `\\server:445\share`, expectRemotePath: `\\server\share`},
+		{path: `\\fd00::1\share`, expectRemotePath: `\\fd00--1.ipv6-literal.net\share`},
+		{path: `\\[fd00::1]\share`, expectRemotePath: `\\fd00--1.ipv6-literal.net\share`},
+		{path: `\\[fe80::1%4]:8445\share`, expectRemotePath: `\\fe80--1s4.ipv6-literal.net\share`, expectPort: 8445},
+		{path: `\\fd00--1.ipv6-literal.net\share`, expectRemotePath: `\\fd00--1.ipv6-literal.net\share`},
+		{path: `\\server:0\share`, expectError: true},
+		{path: `\\server:smb\share`, expectError: true},
+		{path: `\\[fd00::1\share`, expectError: true},
+		{path: `\\fd00::xyz\share`, expectError: true},
+	}
+	for _, tc := range testCases {
+		remotePath, port, err := parseRemotePath(tc.path)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("%s: expected an error", tc.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.path, err)
+			continue
+		}
+		if remotePath != tc.expectRemotePath || port != tc.expectPort {
+			t.Errorf("%s: expected %s port %d, got %s port %d", tc.path, tc.expectRemotePath, tc.expectPort, remotePath, port)
+		}
+	}
+}
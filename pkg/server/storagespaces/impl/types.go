@@ -0,0 +1,96 @@
+package impl
+
+type Resiliency uint32
+
+const (
+	RESILIENCY_SIMPLE Resiliency = 0
+	RESILIENCY_MIRROR Resiliency = 1
+	RESILIENCY_PARITY Resiliency = 2
+)
+
+type PhysicalDisk struct {
+	DiskNumber   uint32
+	SerialNumber string
+	BusType      string
+	MediaType    string
+	SizeBytes    uint64
+}
+
+type ListPoolablePhysicalDisksRequest struct {
+}
+
+type ListPoolablePhysicalDisksResponse struct {
+	PhysicalDisks []*PhysicalDisk
+}
+
+type StoragePool struct {
+	Name               string
+	UniqueId           string
+	HealthStatus       string
+	SizeBytes          uint64
+	AllocatedSizeBytes uint64
+	DiskNumbers        []uint32
+}
+
+type CreateStoragePoolRequest struct {
+	PoolName    string
+	DiskNumbers []uint32
+}
+
+type CreateStoragePoolResponse struct {
+	StoragePool *StoragePool
+}
+
+type DeleteStoragePoolRequest struct {
+	PoolName string
+}
+
+type DeleteStoragePoolResponse struct {
+}
+
+type ListStoragePoolsRequest struct {
+}
+
+type ListStoragePoolsResponse struct {
+	StoragePools []*StoragePool
+}
+
+type VirtualDisk struct {
+	Name             string
+	PoolName         string
+	UniqueId         string
+	SizeBytes        uint64
+	Resiliency       Resiliency
+	ThinProvisioning bool
+	HealthStatus     string
+	Attached         bool
+	DiskNumber       uint32
+}
+
+type CreateVirtualDiskRequest struct {
+	PoolName         string
+	Name             string
+	SizeBytes        uint64
+	Resiliency       Resiliency
+	ThinProvisioning bool
+}
+
+type CreateVirtualDiskResponse struct {
+	VirtualDisk *VirtualDisk
+}
+
+type DeleteVirtualDiskRequest struct {
+	PoolName string
+	Name     string
+}
+
+type DeleteVirtualDiskResponse struct {
+}
+
+type ListVirtualDisksRequest struct {
+	PoolName string
+}
+
+type ListVirtualDisksResponse struct {
+	VirtualDisks []*VirtualDisk
+}
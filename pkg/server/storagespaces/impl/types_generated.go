@@ -0,0 +1,25 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package impl
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+type VersionedAPI interface {
+	Register(grpcServer *grpc.Server)
+}
+
+// All the functions this group's server needs to define.
+type ServerInterface interface {
+	CreateStoragePool(context.Context, *CreateStoragePoolRequest, apiversion.Version) (*CreateStoragePoolResponse, error)
+	CreateVirtualDisk(context.Context, *CreateVirtualDiskRequest, apiversion.Version) (*CreateVirtualDiskResponse, error)
+	DeleteStoragePool(context.Context, *DeleteStoragePoolRequest, apiversion.Version) (*DeleteStoragePoolResponse, error)
+	DeleteVirtualDisk(context.Context, *DeleteVirtualDiskRequest, apiversion.Version) (*DeleteVirtualDiskResponse, error)
+	ListPoolablePhysicalDisks(context.Context, *ListPoolablePhysicalDisksRequest, apiversion.Version) (*ListPoolablePhysicalDisksResponse, error)
+	ListStoragePools(context.Context, *ListStoragePoolsRequest, apiversion.Version) (*ListStoragePoolsResponse, error)
+	ListVirtualDisks(context.Context, *ListVirtualDisksRequest, apiversion.Version) (*ListVirtualDisksResponse, error)
+}
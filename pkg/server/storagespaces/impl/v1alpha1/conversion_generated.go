@@ -0,0 +1,480 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	v1alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/storagespaces/v1alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/storagespaces/impl"
+)
+
+func autoConvert_v1alpha1_CreateStoragePoolRequest_To_impl_CreateStoragePoolRequest(in *v1alpha1.CreateStoragePoolRequest, out *impl.CreateStoragePoolRequest) error {
+	out.PoolName = in.PoolName
+	out.DiskNumbers = *(*[]uint32)(unsafe.Pointer(&in.DiskNumbers))
+	return nil
+}
+
+// Convert_v1alpha1_CreateStoragePoolRequest_To_impl_CreateStoragePoolRequest is an autogenerated conversion function.
+func Convert_v1alpha1_CreateStoragePoolRequest_To_impl_CreateStoragePoolRequest(in *v1alpha1.CreateStoragePoolRequest, out *impl.CreateStoragePoolRequest) error {
+	return autoConvert_v1alpha1_CreateStoragePoolRequest_To_impl_CreateStoragePoolRequest(in, out)
+}
+
+func autoConvert_impl_CreateStoragePoolRequest_To_v1alpha1_CreateStoragePoolRequest(in *impl.CreateStoragePoolRequest, out *v1alpha1.CreateStoragePoolRequest) error {
+	out.PoolName = in.PoolName
+	out.DiskNumbers = *(*[]uint32)(unsafe.Pointer(&in.DiskNumbers))
+	return nil
+}
+
+// Convert_impl_CreateStoragePoolRequest_To_v1alpha1_CreateStoragePoolRequest is an autogenerated conversion function.
+func Convert_impl_CreateStoragePoolRequest_To_v1alpha1_CreateStoragePoolRequest(in *impl.CreateStoragePoolRequest, out *v1alpha1.CreateStoragePoolRequest) error {
+	return autoConvert_impl_CreateStoragePoolRequest_To_v1alpha1_CreateStoragePoolRequest(in, out)
+}
+
+func autoConvert_v1alpha1_CreateStoragePoolResponse_To_impl_CreateStoragePoolResponse(in *v1alpha1.CreateStoragePoolResponse, out *impl.CreateStoragePoolResponse) error {
+	if in.StoragePool != nil {
+		in, out := &in.StoragePool, &out.StoragePool
+		*out = new(impl.StoragePool)
+		if err := Convert_v1alpha1_StoragePool_To_impl_StoragePool(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.StoragePool = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CreateStoragePoolResponse_To_impl_CreateStoragePoolResponse is an autogenerated conversion function.
+func Convert_v1alpha1_CreateStoragePoolResponse_To_impl_CreateStoragePoolResponse(in *v1alpha1.CreateStoragePoolResponse, out *impl.CreateStoragePoolResponse) error {
+	return autoConvert_v1alpha1_CreateStoragePoolResponse_To_impl_CreateStoragePoolResponse(in, out)
+}
+
+func autoConvert_impl_CreateStoragePoolResponse_To_v1alpha1_CreateStoragePoolResponse(in *impl.CreateStoragePoolResponse, out *v1alpha1.CreateStoragePoolResponse) error {
+	if in.StoragePool != nil {
+		in, out := &in.StoragePool, &out.StoragePool
+		*out = new(v1alpha1.StoragePool)
+		if err := Convert_impl_StoragePool_To_v1alpha1_StoragePool(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.StoragePool = nil
+	}
+	return nil
+}
+
+// Convert_impl_CreateStoragePoolResponse_To_v1alpha1_CreateStoragePoolResponse is an autogenerated conversion function.
+func Convert_impl_CreateStoragePoolResponse_To_v1alpha1_CreateStoragePoolResponse(in *impl.CreateStoragePoolResponse, out *v1alpha1.CreateStoragePoolResponse) error {
+	return autoConvert_impl_CreateStoragePoolResponse_To_v1alpha1_CreateStoragePoolResponse(in, out)
+}
+
+func autoConvert_v1alpha1_CreateVirtualDiskRequest_To_impl_CreateVirtualDiskRequest(in *v1alpha1.CreateVirtualDiskRequest, out *impl.CreateVirtualDiskRequest) error {
+	out.PoolName = in.PoolName
+	out.Name = in.Name
+	out.SizeBytes = in.SizeBytes
+	out.Resiliency = impl.Resiliency(in.Resiliency)
+	out.ThinProvisioning = in.ThinProvisioning
+	return nil
+}
+
+// Convert_v1alpha1_CreateVirtualDiskRequest_To_impl_CreateVirtualDiskRequest is an autogenerated conversion function.
+func Convert_v1alpha1_CreateVirtualDiskRequest_To_impl_CreateVirtualDiskRequest(in *v1alpha1.CreateVirtualDiskRequest, out *impl.CreateVirtualDiskRequest) error {
+	return autoConvert_v1alpha1_CreateVirtualDiskRequest_To_impl_CreateVirtualDiskRequest(in, out)
+}
+
+func autoConvert_impl_CreateVirtualDiskRequest_To_v1alpha1_CreateVirtualDiskRequest(in *impl.CreateVirtualDiskRequest, out *v1alpha1.CreateVirtualDiskRequest) error {
+	out.PoolName = in.PoolName
+	out.Name = in.Name
+	out.SizeBytes = in.SizeBytes
+	out.Resiliency = v1alpha1.Resiliency(in.Resiliency)
+	out.ThinProvisioning = in.ThinProvisioning
+	return nil
+}
+
+// Convert_impl_CreateVirtualDiskRequest_To_v1alpha1_CreateVirtualDiskRequest is an autogenerated conversion function.
+func Convert_impl_CreateVirtualDiskRequest_To_v1alpha1_CreateVirtualDiskRequest(in *impl.CreateVirtualDiskRequest, out *v1alpha1.CreateVirtualDiskRequest) error {
+	return autoConvert_impl_CreateVirtualDiskRequest_To_v1alpha1_CreateVirtualDiskRequest(in, out)
+}
+
+func autoConvert_v1alpha1_CreateVirtualDiskResponse_To_impl_CreateVirtualDiskResponse(in *v1alpha1.CreateVirtualDiskResponse, out *impl.CreateVirtualDiskResponse) error {
+	if in.VirtualDisk != nil {
+		in, out := &in.VirtualDisk, &out.VirtualDisk
+		*out = new(impl.VirtualDisk)
+		if err := Convert_v1alpha1_VirtualDisk_To_impl_VirtualDisk(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.VirtualDisk = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CreateVirtualDiskResponse_To_impl_CreateVirtualDiskResponse is an autogenerated conversion function.
+func Convert_v1alpha1_CreateVirtualDiskResponse_To_impl_CreateVirtualDiskResponse(in *v1alpha1.CreateVirtualDiskResponse, out *impl.CreateVirtualDiskResponse) error {
+	return autoConvert_v1alpha1_CreateVirtualDiskResponse_To_impl_CreateVirtualDiskResponse(in, out)
+}
+
+func autoConvert_impl_CreateVirtualDiskResponse_To_v1alpha1_CreateVirtualDiskResponse(in *impl.CreateVirtualDiskResponse, out *v1alpha1.CreateVirtualDiskResponse) error {
+	if in.VirtualDisk != nil {
+		in, out := &in.VirtualDisk, &out.VirtualDisk
+		*out = new(v1alpha1.VirtualDisk)
+		if err := Convert_impl_VirtualDisk_To_v1alpha1_VirtualDisk(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.VirtualDisk = nil
+	}
+	return nil
+}
+
+// Convert_impl_CreateVirtualDiskResponse_To_v1alpha1_CreateVirtualDiskResponse is an autogenerated conversion function.
+func Convert_impl_CreateVirtualDiskResponse_To_v1alpha1_CreateVirtualDiskResponse(in *impl.CreateVirtualDiskResponse, out *v1alpha1.CreateVirtualDiskResponse) error {
+	return autoConvert_impl_CreateVirtualDiskResponse_To_v1alpha1_CreateVirtualDiskResponse(in, out)
+}
+
+func autoConvert_v1alpha1_DeleteStoragePoolRequest_To_impl_DeleteStoragePoolRequest(in *v1alpha1.DeleteStoragePoolRequest, out *impl.DeleteStoragePoolRequest) error {
+	out.PoolName = in.PoolName
+	return nil
+}
+
+// Convert_v1alpha1_DeleteStoragePoolRequest_To_impl_DeleteStoragePoolRequest is an autogenerated conversion function.
+func Convert_v1alpha1_DeleteStoragePoolRequest_To_impl_DeleteStoragePoolRequest(in *v1alpha1.DeleteStoragePoolRequest, out *impl.DeleteStoragePoolRequest) error {
+	return autoConvert_v1alpha1_DeleteStoragePoolRequest_To_impl_DeleteStoragePoolRequest(in, out)
+}
+
+func autoConvert_impl_DeleteStoragePoolRequest_To_v1alpha1_DeleteStoragePoolRequest(in *impl.DeleteStoragePoolRequest, out *v1alpha1.DeleteStoragePoolRequest) error {
+	out.PoolName = in.PoolName
+	return nil
+}
+
+// Convert_impl_DeleteStoragePoolRequest_To_v1alpha1_DeleteStoragePoolRequest is an autogenerated conversion function.
+func Convert_impl_DeleteStoragePoolRequest_To_v1alpha1_DeleteStoragePoolRequest(in *impl.DeleteStoragePoolRequest, out *v1alpha1.DeleteStoragePoolRequest) error {
+	return autoConvert_impl_DeleteStoragePoolRequest_To_v1alpha1_DeleteStoragePoolRequest(in, out)
+}
+
+func autoConvert_v1alpha1_DeleteStoragePoolResponse_To_impl_DeleteStoragePoolResponse(in *v1alpha1.DeleteStoragePoolResponse, out *impl.DeleteStoragePoolResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_DeleteStoragePoolResponse_To_impl_DeleteStoragePoolResponse is an autogenerated conversion function.
+func Convert_v1alpha1_DeleteStoragePoolResponse_To_impl_DeleteStoragePoolResponse(in *v1alpha1.DeleteStoragePoolResponse, out *impl.DeleteStoragePoolResponse) error {
+	return autoConvert_v1alpha1_DeleteStoragePoolResponse_To_impl_DeleteStoragePoolResponse(in, out)
+}
+
+func autoConvert_impl_DeleteStoragePoolResponse_To_v1alpha1_DeleteStoragePoolResponse(in *impl.DeleteStoragePoolResponse, out *v1alpha1.DeleteStoragePoolResponse) error {
+	return nil
+}
+
+// Convert_impl_DeleteStoragePoolResponse_To_v1alpha1_DeleteStoragePoolResponse is an autogenerated conversion function.
+func Convert_impl_DeleteStoragePoolResponse_To_v1alpha1_DeleteStoragePoolResponse(in *impl.DeleteStoragePoolResponse, out *v1alpha1.DeleteStoragePoolResponse) error {
+	return autoConvert_impl_DeleteStoragePoolResponse_To_v1alpha1_DeleteStoragePoolResponse(in, out)
+}
+
+func autoConvert_v1alpha1_DeleteVirtualDiskRequest_To_impl_DeleteVirtualDiskRequest(in *v1alpha1.DeleteVirtualDiskRequest, out *impl.DeleteVirtualDiskRequest) error {
+	out.PoolName = in.PoolName
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_DeleteVirtualDiskRequest_To_impl_DeleteVirtualDiskRequest is an autogenerated conversion function.
+func Convert_v1alpha1_DeleteVirtualDiskRequest_To_impl_DeleteVirtualDiskRequest(in *v1alpha1.DeleteVirtualDiskRequest, out *impl.DeleteVirtualDiskRequest) error {
+	return autoConvert_v1alpha1_DeleteVirtualDiskRequest_To_impl_DeleteVirtualDiskRequest(in, out)
+}
+
+func autoConvert_impl_DeleteVirtualDiskRequest_To_v1alpha1_DeleteVirtualDiskRequest(in *impl.DeleteVirtualDiskRequest, out *v1alpha1.DeleteVirtualDiskRequest) error {
+	out.PoolName = in.PoolName
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_impl_DeleteVirtualDiskRequest_To_v1alpha1_DeleteVirtualDiskRequest is an autogenerated conversion function.
+func Convert_impl_DeleteVirtualDiskRequest_To_v1alpha1_DeleteVirtualDiskRequest(in *impl.DeleteVirtualDiskRequest, out *v1alpha1.DeleteVirtualDiskRequest) error {
+	return autoConvert_impl_DeleteVirtualDiskRequest_To_v1alpha1_DeleteVirtualDiskRequest(in, out)
+}
+
+func autoConvert_v1alpha1_DeleteVirtualDiskResponse_To_impl_DeleteVirtualDiskResponse(in *v1alpha1.DeleteVirtualDiskResponse, out *impl.DeleteVirtualDiskResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_DeleteVirtualDiskResponse_To_impl_DeleteVirtualDiskResponse is an autogenerated conversion function.
+func Convert_v1alpha1_DeleteVirtualDiskResponse_To_impl_DeleteVirtualDiskResponse(in *v1alpha1.DeleteVirtualDiskResponse, out *impl.DeleteVirtualDiskResponse) error {
+	return autoConvert_v1alpha1_DeleteVirtualDiskResponse_To_impl_DeleteVirtualDiskResponse(in, out)
+}
+
+func autoConvert_impl_DeleteVirtualDiskResponse_To_v1alpha1_DeleteVirtualDiskResponse(in *impl.DeleteVirtualDiskResponse, out *v1alpha1.DeleteVirtualDiskResponse) error {
+	return nil
+}
+
+// Convert_impl_DeleteVirtualDiskResponse_To_v1alpha1_DeleteVirtualDiskResponse is an autogenerated conversion function.
+func Convert_impl_DeleteVirtualDiskResponse_To_v1alpha1_DeleteVirtualDiskResponse(in *impl.DeleteVirtualDiskResponse, out *v1alpha1.DeleteVirtualDiskResponse) error {
+	return autoConvert_impl_DeleteVirtualDiskResponse_To_v1alpha1_DeleteVirtualDiskResponse(in, out)
+}
+
+func autoConvert_v1alpha1_ListPoolablePhysicalDisksRequest_To_impl_ListPoolablePhysicalDisksRequest(in *v1alpha1.ListPoolablePhysicalDisksRequest, out *impl.ListPoolablePhysicalDisksRequest) error {
+	return nil
+}
+
+// Convert_v1alpha1_ListPoolablePhysicalDisksRequest_To_impl_ListPoolablePhysicalDisksRequest is an autogenerated conversion function.
+func Convert_v1alpha1_ListPoolablePhysicalDisksRequest_To_impl_ListPoolablePhysicalDisksRequest(in *v1alpha1.ListPoolablePhysicalDisksRequest, out *impl.ListPoolablePhysicalDisksRequest) error {
+	return autoConvert_v1alpha1_ListPoolablePhysicalDisksRequest_To_impl_ListPoolablePhysicalDisksRequest(in, out)
+}
+
+func autoConvert_impl_ListPoolablePhysicalDisksRequest_To_v1alpha1_ListPoolablePhysicalDisksRequest(in *impl.ListPoolablePhysicalDisksRequest, out *v1alpha1.ListPoolablePhysicalDisksRequest) error {
+	return nil
+}
+
+// Convert_impl_ListPoolablePhysicalDisksRequest_To_v1alpha1_ListPoolablePhysicalDisksRequest is an autogenerated conversion function.
+func Convert_impl_ListPoolablePhysicalDisksRequest_To_v1alpha1_ListPoolablePhysicalDisksRequest(in *impl.ListPoolablePhysicalDisksRequest, out *v1alpha1.ListPoolablePhysicalDisksRequest) error {
+	return autoConvert_impl_ListPoolablePhysicalDisksRequest_To_v1alpha1_ListPoolablePhysicalDisksRequest(in, out)
+}
+
+func autoConvert_v1alpha1_ListPoolablePhysicalDisksResponse_To_impl_ListPoolablePhysicalDisksResponse(in *v1alpha1.ListPoolablePhysicalDisksResponse, out *impl.ListPoolablePhysicalDisksResponse) error {
+	if in.PhysicalDisks != nil {
+		in, out := &in.PhysicalDisks, &out.PhysicalDisks
+		*out = make([]*impl.PhysicalDisk, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_PhysicalDisk_To_impl_PhysicalDisk(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PhysicalDisks = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ListPoolablePhysicalDisksResponse_To_impl_ListPoolablePhysicalDisksResponse is an autogenerated conversion function.
+func Convert_v1alpha1_ListPoolablePhysicalDisksResponse_To_impl_ListPoolablePhysicalDisksResponse(in *v1alpha1.ListPoolablePhysicalDisksResponse, out *impl.ListPoolablePhysicalDisksResponse) error {
+	return autoConvert_v1alpha1_ListPoolablePhysicalDisksResponse_To_impl_ListPoolablePhysicalDisksResponse(in, out)
+}
+
+func autoConvert_impl_ListPoolablePhysicalDisksResponse_To_v1alpha1_ListPoolablePhysicalDisksResponse(in *impl.ListPoolablePhysicalDisksResponse, out *v1alpha1.ListPoolablePhysicalDisksResponse) error {
+	if in.PhysicalDisks != nil {
+		in, out := &in.PhysicalDisks, &out.PhysicalDisks
+		*out = make([]*v1alpha1.PhysicalDisk, len(*in))
+		for i := range *in {
+			if err := Convert_impl_PhysicalDisk_To_v1alpha1_PhysicalDisk(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.PhysicalDisks = nil
+	}
+	return nil
+}
+
+// Convert_impl_ListPoolablePhysicalDisksResponse_To_v1alpha1_ListPoolablePhysicalDisksResponse is an autogenerated conversion function.
+func Convert_impl_ListPoolablePhysicalDisksResponse_To_v1alpha1_ListPoolablePhysicalDisksResponse(in *impl.ListPoolablePhysicalDisksResponse, out *v1alpha1.ListPoolablePhysicalDisksResponse) error {
+	return autoConvert_impl_ListPoolablePhysicalDisksResponse_To_v1alpha1_ListPoolablePhysicalDisksResponse(in, out)
+}
+
+func autoConvert_v1alpha1_ListStoragePoolsRequest_To_impl_ListStoragePoolsRequest(in *v1alpha1.ListStoragePoolsRequest, out *impl.ListStoragePoolsRequest) error {
+	return nil
+}
+
+// Convert_v1alpha1_ListStoragePoolsRequest_To_impl_ListStoragePoolsRequest is an autogenerated conversion function.
+func Convert_v1alpha1_ListStoragePoolsRequest_To_impl_ListStoragePoolsRequest(in *v1alpha1.ListStoragePoolsRequest, out *impl.ListStoragePoolsRequest) error {
+	return autoConvert_v1alpha1_ListStoragePoolsRequest_To_impl_ListStoragePoolsRequest(in, out)
+}
+
+func autoConvert_impl_ListStoragePoolsRequest_To_v1alpha1_ListStoragePoolsRequest(in *impl.ListStoragePoolsRequest, out *v1alpha1.ListStoragePoolsRequest) error {
+	return nil
+}
+
+// Convert_impl_ListStoragePoolsRequest_To_v1alpha1_ListStoragePoolsRequest is an autogenerated conversion function.
+func Convert_impl_ListStoragePoolsRequest_To_v1alpha1_ListStoragePoolsRequest(in *impl.ListStoragePoolsRequest, out *v1alpha1.ListStoragePoolsRequest) error {
+	return autoConvert_impl_ListStoragePoolsRequest_To_v1alpha1_ListStoragePoolsRequest(in, out)
+}
+
+func autoConvert_v1alpha1_ListStoragePoolsResponse_To_impl_ListStoragePoolsResponse(in *v1alpha1.ListStoragePoolsResponse, out *impl.ListStoragePoolsResponse) error {
+	if in.StoragePools != nil {
+		in, out := &in.StoragePools, &out.StoragePools
+		*out = make([]*impl.StoragePool, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_StoragePool_To_impl_StoragePool(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.StoragePools = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ListStoragePoolsResponse_To_impl_ListStoragePoolsResponse is an autogenerated conversion function.
+func Convert_v1alpha1_ListStoragePoolsResponse_To_impl_ListStoragePoolsResponse(in *v1alpha1.ListStoragePoolsResponse, out *impl.ListStoragePoolsResponse) error {
+	return autoConvert_v1alpha1_ListStoragePoolsResponse_To_impl_ListStoragePoolsResponse(in, out)
+}
+
+func autoConvert_impl_ListStoragePoolsResponse_To_v1alpha1_ListStoragePoolsResponse(in *impl.ListStoragePoolsResponse, out *v1alpha1.ListStoragePoolsResponse) error {
+	if in.StoragePools != nil {
+		in, out := &in.StoragePools, &out.StoragePools
+		*out = make([]*v1alpha1.StoragePool, len(*in))
+		for i := range *in {
+			if err := Convert_impl_StoragePool_To_v1alpha1_StoragePool(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.StoragePools = nil
+	}
+	return nil
+}
+
+// Convert_impl_ListStoragePoolsResponse_To_v1alpha1_ListStoragePoolsResponse is an autogenerated conversion function.
+func Convert_impl_ListStoragePoolsResponse_To_v1alpha1_ListStoragePoolsResponse(in *impl.ListStoragePoolsResponse, out *v1alpha1.ListStoragePoolsResponse) error {
+	return autoConvert_impl_ListStoragePoolsResponse_To_v1alpha1_ListStoragePoolsResponse(in, out)
+}
+
+func autoConvert_v1alpha1_ListVirtualDisksRequest_To_impl_ListVirtualDisksRequest(in *v1alpha1.ListVirtualDisksRequest, out *impl.ListVirtualDisksRequest) error {
+	out.PoolName = in.PoolName
+	return nil
+}
+
+// Convert_v1alpha1_ListVirtualDisksRequest_To_impl_ListVirtualDisksRequest is an autogenerated conversion function.
+func Convert_v1alpha1_ListVirtualDisksRequest_To_impl_ListVirtualDisksRequest(in *v1alpha1.ListVirtualDisksRequest, out *impl.ListVirtualDisksRequest) error {
+	return autoConvert_v1alpha1_ListVirtualDisksRequest_To_impl_ListVirtualDisksRequest(in, out)
+}
+
+func autoConvert_impl_ListVirtualDisksRequest_To_v1alpha1_ListVirtualDisksRequest(in *impl.ListVirtualDisksRequest, out *v1alpha1.ListVirtualDisksRequest) error {
+	out.PoolName = in.PoolName
+	return nil
+}
+
+// Convert_impl_ListVirtualDisksRequest_To_v1alpha1_ListVirtualDisksRequest is an autogenerated conversion function.
+func Convert_impl_ListVirtualDisksRequest_To_v1alpha1_ListVirtualDisksRequest(in *impl.ListVirtualDisksRequest, out *v1alpha1.ListVirtualDisksRequest) error {
+	return autoConvert_impl_ListVirtualDisksRequest_To_v1alpha1_ListVirtualDisksRequest(in, out)
+}
+
+func autoConvert_v1alpha1_ListVirtualDisksResponse_To_impl_ListVirtualDisksResponse(in *v1alpha1.ListVirtualDisksResponse, out *impl.ListVirtualDisksResponse) error {
+	if in.VirtualDisks != nil {
+		in, out := &in.VirtualDisks, &out.VirtualDisks
+		*out = make([]*impl.VirtualDisk, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VirtualDisk_To_impl_VirtualDisk(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.VirtualDisks = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ListVirtualDisksResponse_To_impl_ListVirtualDisksResponse is an autogenerated conversion function.
+func Convert_v1alpha1_ListVirtualDisksResponse_To_impl_ListVirtualDisksResponse(in *v1alpha1.ListVirtualDisksResponse, out *impl.ListVirtualDisksResponse) error {
+	return autoConvert_v1alpha1_ListVirtualDisksResponse_To_impl_ListVirtualDisksResponse(in, out)
+}
+
+func autoConvert_impl_ListVirtualDisksResponse_To_v1alpha1_ListVirtualDisksResponse(in *impl.ListVirtualDisksResponse, out *v1alpha1.ListVirtualDisksResponse) error {
+	if in.VirtualDisks != nil {
+		in, out := &in.VirtualDisks, &out.VirtualDisks
+		*out = make([]*v1alpha1.VirtualDisk, len(*in))
+		for i := range *in {
+			if err := Convert_impl_VirtualDisk_To_v1alpha1_VirtualDisk(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.VirtualDisks = nil
+	}
+	return nil
+}
+
+// Convert_impl_ListVirtualDisksResponse_To_v1alpha1_ListVirtualDisksResponse is an autogenerated conversion function.
+func Convert_impl_ListVirtualDisksResponse_To_v1alpha1_ListVirtualDisksResponse(in *impl.ListVirtualDisksResponse, out *v1alpha1.ListVirtualDisksResponse) error {
+	return autoConvert_impl_ListVirtualDisksResponse_To_v1alpha1_ListVirtualDisksResponse(in, out)
+}
+
+func autoConvert_v1alpha1_PhysicalDisk_To_impl_PhysicalDisk(in *v1alpha1.PhysicalDisk, out *impl.PhysicalDisk) error {
+	out.DiskNumber = in.DiskNumber
+	out.SerialNumber = in.SerialNumber
+	out.BusType = in.BusType
+	out.MediaType = in.MediaType
+	out.SizeBytes = in.SizeBytes
+	return nil
+}
+
+// Convert_v1alpha1_PhysicalDisk_To_impl_PhysicalDisk is an autogenerated conversion function.
+func Convert_v1alpha1_PhysicalDisk_To_impl_PhysicalDisk(in *v1alpha1.PhysicalDisk, out *impl.PhysicalDisk) error {
+	return autoConvert_v1alpha1_PhysicalDisk_To_impl_PhysicalDisk(in, out)
+}
+
+func autoConvert_impl_PhysicalDisk_To_v1alpha1_PhysicalDisk(in *impl.PhysicalDisk, out *v1alpha1.PhysicalDisk) error {
+	out.DiskNumber = in.DiskNumber
+	out.SerialNumber = in.SerialNumber
+	out.BusType = in.BusType
+	out.MediaType = in.MediaType
+	out.SizeBytes = in.SizeBytes
+	return nil
+}
+
+// Convert_impl_PhysicalDisk_To_v1alpha1_PhysicalDisk is an autogenerated conversion function.
+func Convert_impl_PhysicalDisk_To_v1alpha1_PhysicalDisk(in *impl.PhysicalDisk, out *v1alpha1.PhysicalDisk) error {
+	return autoConvert_impl_PhysicalDisk_To_v1alpha1_PhysicalDisk(in, out)
+}
+
+func autoConvert_v1alpha1_StoragePool_To_impl_StoragePool(in *v1alpha1.StoragePool, out *impl.StoragePool) error {
+	out.Name = in.Name
+	out.UniqueId = in.UniqueId
+	out.HealthStatus = in.HealthStatus
+	out.SizeBytes = in.SizeBytes
+	out.AllocatedSizeBytes = in.AllocatedSizeBytes
+	out.DiskNumbers = *(*[]uint32)(unsafe.Pointer(&in.DiskNumbers))
+	return nil
+}
+
+// Convert_v1alpha1_StoragePool_To_impl_StoragePool is an autogenerated conversion function.
+func Convert_v1alpha1_StoragePool_To_impl_StoragePool(in *v1alpha1.StoragePool, out *impl.StoragePool) error {
+	return autoConvert_v1alpha1_StoragePool_To_impl_StoragePool(in, out)
+}
+
+func autoConvert_impl_StoragePool_To_v1alpha1_StoragePool(in *impl.StoragePool, out *v1alpha1.StoragePool) error {
+	out.Name = in.Name
+	out.UniqueId = in.UniqueId
+	out.HealthStatus = in.HealthStatus
+	out.SizeBytes = in.SizeBytes
+	out.AllocatedSizeBytes = in.AllocatedSizeBytes
+	out.DiskNumbers = *(*[]uint32)(unsafe.Pointer(&in.DiskNumbers))
+	return nil
+}
+
+// Convert_impl_StoragePool_To_v1alpha1_StoragePool is an autogenerated conversion function.
+func Convert_impl_StoragePool_To_v1alpha1_StoragePool(in *impl.StoragePool, out *v1alpha1.StoragePool) error {
+	return autoConvert_impl_StoragePool_To_v1alpha1_StoragePool(in, out)
+}
+
+func autoConvert_v1alpha1_VirtualDisk_To_impl_VirtualDisk(in *v1alpha1.VirtualDisk, out *impl.VirtualDisk) error {
+	out.Name = in.Name
+	out.PoolName = in.PoolName
+	out.UniqueId = in.UniqueId
+	out.SizeBytes = in.SizeBytes
+	out.Resiliency = impl.Resiliency(in.Resiliency)
+	out.ThinProvisioning = in.ThinProvisioning
+	out.HealthStatus = in.HealthStatus
+	out.Attached = in.Attached
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v1alpha1_VirtualDisk_To_impl_VirtualDisk is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualDisk_To_impl_VirtualDisk(in *v1alpha1.VirtualDisk, out *impl.VirtualDisk) error {
+	return autoConvert_v1alpha1_VirtualDisk_To_impl_VirtualDisk(in, out)
+}
+
+func autoConvert_impl_VirtualDisk_To_v1alpha1_VirtualDisk(in *impl.VirtualDisk, out *v1alpha1.VirtualDisk) error {
+	out.Name = in.Name
+	out.PoolName = in.PoolName
+	out.UniqueId = in.UniqueId
+	out.SizeBytes = in.SizeBytes
+	out.Resiliency = v1alpha1.Resiliency(in.Resiliency)
+	out.ThinProvisioning = in.ThinProvisioning
+	out.HealthStatus = in.HealthStatus
+	out.Attached = in.Attached
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_VirtualDisk_To_v1alpha1_VirtualDisk is an autogenerated conversion function.
+func Convert_impl_VirtualDisk_To_v1alpha1_VirtualDisk(in *impl.VirtualDisk, out *v1alpha1.VirtualDisk) error {
+	return autoConvert_impl_VirtualDisk_To_v1alpha1_VirtualDisk(in, out)
+}
@@ -0,0 +1,161 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/storagespaces/v1alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/storagespaces/impl"
+	"google.golang.org/grpc"
+)
+
+var version = apiversion.NewVersionOrPanic("v1alpha1")
+
+type versionedAPI struct {
+	apiGroupServer impl.ServerInterface
+}
+
+func NewVersionedServer(apiGroupServer impl.ServerInterface) impl.VersionedAPI {
+	return &versionedAPI{
+		apiGroupServer: apiGroupServer,
+	}
+}
+
+func (s *versionedAPI) Register(grpcServer *grpc.Server) {
+	v1alpha1.RegisterStoragespacesServer(grpcServer, s)
+}
+
+func (s *versionedAPI) CreateStoragePool(context context.Context, versionedRequest *v1alpha1.CreateStoragePoolRequest) (*v1alpha1.CreateStoragePoolResponse, error) {
+	request := &impl.CreateStoragePoolRequest{}
+	if err := Convert_v1alpha1_CreateStoragePoolRequest_To_impl_CreateStoragePoolRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.CreateStoragePool(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.CreateStoragePoolResponse{}
+	if err := Convert_impl_CreateStoragePoolResponse_To_v1alpha1_CreateStoragePoolResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) CreateVirtualDisk(context context.Context, versionedRequest *v1alpha1.CreateVirtualDiskRequest) (*v1alpha1.CreateVirtualDiskResponse, error) {
+	request := &impl.CreateVirtualDiskRequest{}
+	if err := Convert_v1alpha1_CreateVirtualDiskRequest_To_impl_CreateVirtualDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.CreateVirtualDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.CreateVirtualDiskResponse{}
+	if err := Convert_impl_CreateVirtualDiskResponse_To_v1alpha1_CreateVirtualDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) DeleteStoragePool(context context.Context, versionedRequest *v1alpha1.DeleteStoragePoolRequest) (*v1alpha1.DeleteStoragePoolResponse, error) {
+	request := &impl.DeleteStoragePoolRequest{}
+	if err := Convert_v1alpha1_DeleteStoragePoolRequest_To_impl_DeleteStoragePoolRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.DeleteStoragePool(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.DeleteStoragePoolResponse{}
+	if err := Convert_impl_DeleteStoragePoolResponse_To_v1alpha1_DeleteStoragePoolResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) DeleteVirtualDisk(context context.Context, versionedRequest *v1alpha1.DeleteVirtualDiskRequest) (*v1alpha1.DeleteVirtualDiskResponse, error) {
+	request := &impl.DeleteVirtualDiskRequest{}
+	if err := Convert_v1alpha1_DeleteVirtualDiskRequest_To_impl_DeleteVirtualDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.DeleteVirtualDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.DeleteVirtualDiskResponse{}
+	if err := Convert_impl_DeleteVirtualDiskResponse_To_v1alpha1_DeleteVirtualDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListPoolablePhysicalDisks(context context.Context, versionedRequest *v1alpha1.ListPoolablePhysicalDisksRequest) (*v1alpha1.ListPoolablePhysicalDisksResponse, error) {
+	request := &impl.ListPoolablePhysicalDisksRequest{}
+	if err := Convert_v1alpha1_ListPoolablePhysicalDisksRequest_To_impl_ListPoolablePhysicalDisksRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListPoolablePhysicalDisks(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.ListPoolablePhysicalDisksResponse{}
+	if err := Convert_impl_ListPoolablePhysicalDisksResponse_To_v1alpha1_ListPoolablePhysicalDisksResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListStoragePools(context context.Context, versionedRequest *v1alpha1.ListStoragePoolsRequest) (*v1alpha1.ListStoragePoolsResponse, error) {
+	request := &impl.ListStoragePoolsRequest{}
+	if err := Convert_v1alpha1_ListStoragePoolsRequest_To_impl_ListStoragePoolsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListStoragePools(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.ListStoragePoolsResponse{}
+	if err := Convert_impl_ListStoragePoolsResponse_To_v1alpha1_ListStoragePoolsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListVirtualDisks(context context.Context, versionedRequest *v1alpha1.ListVirtualDisksRequest) (*v1alpha1.ListVirtualDisksResponse, error) {
+	request := &impl.ListVirtualDisksRequest{}
+	if err := Convert_v1alpha1_ListVirtualDisksRequest_To_impl_ListVirtualDisksRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListVirtualDisks(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.ListVirtualDisksResponse{}
+	if err := Convert_impl_ListVirtualDisksResponse_To_v1alpha1_ListVirtualDisksResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
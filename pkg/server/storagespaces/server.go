@@ -0,0 +1,325 @@
+package storagespaces
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/storagespaces"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/storagespaces/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+type Server struct {
+	hostAPI storagespaces.API
+}
+
+// check that Server implements the ServerInterface
+var _ internal.ServerInterface = &Server{}
+
+// resiliencies are the resiliency settings of the internal resiliencies.
+var resiliencies = map[internal.Resiliency]storagespaces.Resiliency{
+	internal.RESILIENCY_SIMPLE: storagespaces.ResiliencySimple,
+	internal.RESILIENCY_MIRROR: storagespaces.ResiliencyMirror,
+	internal.RESILIENCY_PARITY: storagespaces.ResiliencyParity,
+}
+
+func NewServer(hostAPI storagespaces.API) (*Server, error) {
+	return &Server{
+		hostAPI: hostAPI,
+	}, nil
+}
+
+func toInternalStoragePool(pool *storagespaces.StoragePool) *internal.StoragePool {
+	return &internal.StoragePool{
+		Name:               pool.Name,
+		UniqueId:           pool.UniqueID,
+		HealthStatus:       pool.HealthStatus,
+		SizeBytes:          uint64(pool.Size),
+		AllocatedSizeBytes: uint64(pool.AllocatedSize),
+		DiskNumbers:        pool.DiskNumbers,
+	}
+}
+
+func toInternalVirtualDisk(disk *storagespaces.VirtualDisk) *internal.VirtualDisk {
+	out := &internal.VirtualDisk{
+		Name:             disk.Name,
+		PoolName:         disk.PoolName,
+		UniqueId:         disk.UniqueID,
+		SizeBytes:        uint64(disk.Size),
+		ThinProvisioning: disk.ThinProvisioning,
+		HealthStatus:     disk.HealthStatus,
+	}
+	for r, resiliency := range resiliencies {
+		if resiliency == disk.Resiliency {
+			out.Resiliency = r
+		}
+	}
+	if disk.DiskNumber != nil {
+		out.Attached = true
+		out.DiskNumber = *disk.DiskNumber
+	}
+	return out
+}
+
+// getStoragePool returns the storage pool `name`, nil if it doesn't exist.
+func (s *Server) getStoragePool(name string) (*storagespaces.StoragePool, error) {
+	pools, err := s.hostAPI.ListStoragePools()
+	if err != nil {
+		return nil, err
+	}
+	for i := range pools {
+		// the names are compared case insensitively like PowerShell does
+		if strings.EqualFold(pools[i].Name, name) {
+			return &pools[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// sameDiskNumbers returns true if `a` and `b` have the same disk numbers in any order.
+func sameDiskNumbers(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sorted := func(numbers []uint32) []uint32 {
+		s := append([]uint32{}, numbers...)
+		sort.Slice(s, func(i, j int) bool { return s[i] < s[j] })
+		return s
+	}
+	sa, sb := sorted(a), sorted(b)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) ListPoolablePhysicalDisks(context context.Context, request *internal.ListPoolablePhysicalDisksRequest, version apiversion.Version) (*internal.ListPoolablePhysicalDisksResponse, error) {
+	klog.V(4).Infof("ListPoolablePhysicalDisks: Request: %+v", request)
+	response := &internal.ListPoolablePhysicalDisksResponse{}
+
+	disks, err := s.hostAPI.ListPoolablePhysicalDisks()
+	if err != nil {
+		klog.Errorf("failed ListPoolablePhysicalDisks %v", err)
+		return response, err
+	}
+	response.PhysicalDisks = make([]*internal.PhysicalDisk, 0, len(disks))
+	for _, disk := range disks {
+		response.PhysicalDisks = append(response.PhysicalDisks, &internal.PhysicalDisk{
+			DiskNumber:   disk.DiskNumber,
+			SerialNumber: disk.SerialNumber,
+			BusType:      disk.BusType,
+			MediaType:    disk.MediaType,
+			SizeBytes:    uint64(disk.Size),
+		})
+	}
+	return response, nil
+}
+
+func (s *Server) CreateStoragePool(context context.Context, request *internal.CreateStoragePoolRequest, version apiversion.Version) (*internal.CreateStoragePoolResponse, error) {
+	klog.V(2).Infof("CreateStoragePool: Request: %+v", request)
+	response := &internal.CreateStoragePoolResponse{}
+
+	name := request.PoolName
+	if name == "" {
+		klog.Errorf("pool name empty")
+		return response, fmt.Errorf("pool name empty")
+	}
+	if len(request.DiskNumbers) == 0 {
+		klog.Errorf("disk numbers empty")
+		return response, fmt.Errorf("disk numbers of storage pool %s empty", name)
+	}
+	seen := make(map[uint32]bool)
+	for _, n := range request.DiskNumbers {
+		if seen[n] {
+			klog.Errorf("disk %d listed twice", n)
+			return response, fmt.Errorf("disk %d is listed twice in the disk numbers of storage pool %s", n, name)
+		}
+		seen[n] = true
+	}
+
+	// a retry succeeds once the storage pool is created
+	pool, err := s.getStoragePool(name)
+	if err != nil {
+		klog.Errorf("failed CreateStoragePool %v", err)
+		return response, err
+	}
+	if pool != nil {
+		if !sameDiskNumbers(pool.DiskNumbers, request.DiskNumbers) {
+			klog.Errorf("storage pool %s exists with disks %v", name, pool.DiskNumbers)
+			return response, status.Errorf(codes.AlreadyExists, "storage pool %s exists with disks %v instead of %v", name, pool.DiskNumbers, request.DiskNumbers)
+		}
+		response.StoragePool = toInternalStoragePool(pool)
+		return response, nil
+	}
+
+	pool, err = s.hostAPI.CreateStoragePool(name, request.DiskNumbers)
+	if err != nil {
+		klog.Errorf("failed CreateStoragePool %v", err)
+		return response, err
+	}
+	klog.V(2).Infof("CreateStoragePool: created storage pool %s with disks %v", name, request.DiskNumbers)
+	response.StoragePool = toInternalStoragePool(pool)
+	return response, nil
+}
+
+func (s *Server) DeleteStoragePool(context context.Context, request *internal.DeleteStoragePoolRequest, version apiversion.Version) (*internal.DeleteStoragePoolResponse, error) {
+	klog.V(2).Infof("DeleteStoragePool: Request: %+v", request)
+	response := &internal.DeleteStoragePoolResponse{}
+
+	name := request.PoolName
+	if name == "" {
+		klog.Errorf("pool name empty")
+		return response, fmt.Errorf("pool name empty")
+	}
+
+	disks, err := s.hostAPI.ListVirtualDisks(name)
+	if err != nil {
+		klog.Errorf("failed DeleteStoragePool %v", err)
+		return response, err
+	}
+	if len(disks) > 0 {
+		klog.Errorf("storage pool %s has %d virtual disks", name, len(disks))
+		return response, status.Errorf(codes.FailedPrecondition, "storage pool %s has %d virtual disks, they must be deleted first", name, len(disks))
+	}
+
+	if err := s.hostAPI.DeleteStoragePool(name); err != nil {
+		klog.Errorf("failed DeleteStoragePool %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) ListStoragePools(context context.Context, request *internal.ListStoragePoolsRequest, version apiversion.Version) (*internal.ListStoragePoolsResponse, error) {
+	klog.V(4).Infof("ListStoragePools: Request: %+v", request)
+	response := &internal.ListStoragePoolsResponse{}
+
+	pools, err := s.hostAPI.ListStoragePools()
+	if err != nil {
+		klog.Errorf("failed ListStoragePools %v", err)
+		return response, err
+	}
+	response.StoragePools = make([]*internal.StoragePool, 0, len(pools))
+	for i := range pools {
+		response.StoragePools = append(response.StoragePools, toInternalStoragePool(&pools[i]))
+	}
+	return response, nil
+}
+
+func (s *Server) CreateVirtualDisk(context context.Context, request *internal.CreateVirtualDiskRequest, version apiversion.Version) (*internal.CreateVirtualDiskResponse, error) {
+	klog.V(2).Infof("CreateVirtualDisk: Request: %+v", request)
+	response := &internal.CreateVirtualDiskResponse{}
+
+	poolName, name := request.PoolName, request.Name
+	if poolName == "" {
+		klog.Errorf("pool name empty")
+		return response, fmt.Errorf("pool name empty")
+	}
+	if name == "" {
+		klog.Errorf("virtual disk name empty")
+		return response, fmt.Errorf("virtual disk name empty")
+	}
+	size, err := utils.ByteSizeToInt64("CreateVirtualDiskRequest.SizeBytes", request.SizeBytes)
+	if err != nil {
+		klog.Errorf("failed CreateVirtualDisk %v", err)
+		return response, err
+	}
+	if size == 0 {
+		klog.Errorf("virtual disk size empty")
+		return response, fmt.Errorf("size of virtual disk %s empty", name)
+	}
+	resiliency, ok := resiliencies[request.Resiliency]
+	if !ok {
+		klog.Errorf("unknown resiliency %d", request.Resiliency)
+		return response, fmt.Errorf("unknown resiliency %d", request.Resiliency)
+	}
+
+	pool, err := s.getStoragePool(poolName)
+	if err != nil {
+		klog.Errorf("failed CreateVirtualDisk %v", err)
+		return response, err
+	}
+	if pool == nil {
+		klog.Errorf("storage pool %s not found", poolName)
+		return response, status.Errorf(codes.NotFound, "storage pool %s not found", poolName)
+	}
+
+	// a retry succeeds once the virtual disk is created, its size is rounded up by Windows
+	disks, err := s.hostAPI.ListVirtualDisks(poolName)
+	if err != nil {
+		klog.Errorf("failed CreateVirtualDisk %v", err)
+		return response, err
+	}
+	for i := range disks {
+		disk := &disks[i]
+		if !strings.EqualFold(disk.Name, name) {
+			continue
+		}
+		if disk.Size < size || disk.Resiliency != resiliency || disk.ThinProvisioning != request.ThinProvisioning {
+			klog.Errorf("virtual disk %s exists in storage pool %s with %d bytes, resiliency %s", name, poolName, disk.Size, disk.Resiliency)
+			return response, status.Errorf(codes.AlreadyExists, "virtual disk %s exists in storage pool %s with %d bytes, resiliency %s and thin provisioning %t",
+				name, poolName, disk.Size, disk.Resiliency, disk.ThinProvisioning)
+		}
+		response.VirtualDisk = toInternalVirtualDisk(disk)
+		return response, nil
+	}
+
+	disk, err := s.hostAPI.CreateVirtualDisk(poolName, name, size, resiliency, request.ThinProvisioning)
+	if err != nil {
+		klog.Errorf("failed CreateVirtualDisk %v", err)
+		return response, err
+	}
+	klog.V(2).Infof("CreateVirtualDisk: created virtual disk %s of %d bytes in storage pool %s", name, disk.Size, poolName)
+	response.VirtualDisk = toInternalVirtualDisk(disk)
+	return response, nil
+}
+
+func (s *Server) DeleteVirtualDisk(context context.Context, request *internal.DeleteVirtualDiskRequest, version apiversion.Version) (*internal.DeleteVirtualDiskResponse, error) {
+	klog.V(2).Infof("DeleteVirtualDisk: Request: %+v", request)
+	response := &internal.DeleteVirtualDiskResponse{}
+
+	poolName, name := request.PoolName, request.Name
+	if poolName == "" {
+		klog.Errorf("pool name empty")
+		return response, fmt.Errorf("pool name empty")
+	}
+	if name == "" {
+		klog.Errorf("virtual disk name empty")
+		return response, fmt.Errorf("virtual disk name empty")
+	}
+
+	if err := s.hostAPI.DeleteVirtualDisk(poolName, name); err != nil {
+		klog.Errorf("failed DeleteVirtualDisk %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) ListVirtualDisks(context context.Context, request *internal.ListVirtualDisksRequest, version apiversion.Version) (*internal.ListVirtualDisksResponse, error) {
+	klog.V(4).Infof("ListVirtualDisks: Request: %+v", request)
+	response := &internal.ListVirtualDisksResponse{}
+
+	poolName := request.PoolName
+	if poolName == "" {
+		klog.Errorf("pool name empty")
+		return response, fmt.Errorf("pool name empty")
+	}
+
+	disks, err := s.hostAPI.ListVirtualDisks(poolName)
+	if err != nil {
+		klog.Errorf("failed ListVirtualDisks %v", err)
+		return response, err
+	}
+	response.VirtualDisks = make([]*internal.VirtualDisk, 0, len(disks))
+	for i := range disks {
+		response.VirtualDisks = append(response.VirtualDisks, toInternalVirtualDisk(&disks[i]))
+	}
+	return response, nil
+}
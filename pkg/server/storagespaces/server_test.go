@@ -0,0 +1,204 @@
+package storagespaces
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/storagespaces"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/storagespaces/impl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeStorageSpacesAPI struct {
+	pools []storagespaces.StoragePool
+	disks []storagespaces.VirtualDisk
+	// created counts the calls of CreateStoragePool and CreateVirtualDisk
+	created int
+}
+
+var _ storagespaces.API = &fakeStorageSpacesAPI{}
+
+func (f *fakeStorageSpacesAPI) ListPoolablePhysicalDisks() ([]storagespaces.PhysicalDisk, error) {
+	return []storagespaces.PhysicalDisk{{DiskNumber: 1, BusType: "NVMe", MediaType: "SSD", Size: 1 << 40}}, nil
+}
+
+func (f *fakeStorageSpacesAPI) CreateStoragePool(name string, diskNumbers []uint32) (*storagespaces.StoragePool, error) {
+	f.created++
+	f.pools = append(f.pools, storagespaces.StoragePool{Name: name, HealthStatus: "Healthy", DiskNumbers: diskNumbers})
+	return &f.pools[len(f.pools)-1], nil
+}
+
+func (f *fakeStorageSpacesAPI) DeleteStoragePool(name string) error {
+	pools := []storagespaces.StoragePool{}
+	for _, pool := range f.pools {
+		if pool.Name != name {
+			pools = append(pools, pool)
+		}
+	}
+	f.pools = pools
+	return nil
+}
+
+func (f *fakeStorageSpacesAPI) ListStoragePools() ([]storagespaces.StoragePool, error) {
+	return f.pools, nil
+}
+
+func (f *fakeStorageSpacesAPI) CreateVirtualDisk(poolName, name string, sizeBytes int64, resiliency storagespaces.Resiliency, thinProvisioning bool) (*storagespaces.VirtualDisk, error) {
+	f.created++
+	diskNumber := uint32(5)
+	// Windows rounds the size up to the allocation unit of the pool
+	size := (sizeBytes + 1<<28 - 1) / (1 << 28) * (1 << 28)
+	f.disks = append(f.disks, storagespaces.VirtualDisk{
+		Name:             name,
+		PoolName:         poolName,
+		Size:             size,
+		Resiliency:       resiliency,
+		ThinProvisioning: thinProvisioning,
+		DiskNumber:       &diskNumber,
+	})
+	return &f.disks[len(f.disks)-1], nil
+}
+
+func (f *fakeStorageSpacesAPI) DeleteVirtualDisk(poolName, name string) error {
+	disks := []storagespaces.VirtualDisk{}
+	for _, disk := range f.disks {
+		if disk.PoolName != poolName || disk.Name != name {
+			disks = append(disks, disk)
+		}
+	}
+	f.disks = disks
+	return nil
+}
+
+func (f *fakeStorageSpacesAPI) ListVirtualDisks(poolName string) ([]storagespaces.VirtualDisk, error) {
+	disks := []storagespaces.VirtualDisk{}
+	for _, disk := range f.disks {
+		if disk.PoolName == poolName {
+			disks = append(disks, disk)
+		}
+	}
+	return disks, nil
+}
+
+func TestStoragePools(t *testing.T) {
+	v1alpha1 := apiversion.NewVersionOrPanic("v1alpha1")
+	hostAPI := &fakeStorageSpacesAPI{}
+	srv, err := NewServer(hostAPI)
+	if err != nil {
+		t.Fatalf("StorageSpaces Server could not be initialized for testing: %v", err)
+	}
+
+	testCases := []struct {
+		name        string
+		request     *internal.CreateStoragePoolRequest
+		expectCode  codes.Code
+		expectError bool
+	}{
+		{
+			name:        "empty name",
+			request:     &internal.CreateStoragePoolRequest{DiskNumbers: []uint32{1, 2}},
+			expectError: true,
+		},
+		{
+			name:        "no disks",
+			request:     &internal.CreateStoragePoolRequest{PoolName: "pool"},
+			expectError: true,
+		},
+		{
+			name:        "duplicate disks",
+			request:     &internal.CreateStoragePoolRequest{PoolName: "pool", DiskNumbers: []uint32{1, 1}},
+			expectError: true,
+		},
+		{
+			name:    "create",
+			request: &internal.CreateStoragePoolRequest{PoolName: "pool", DiskNumbers: []uint32{1, 2}},
+		},
+		{
+			name:    "retry",
+			request: &internal.CreateStoragePoolRequest{PoolName: "pool", DiskNumbers: []uint32{2, 1}},
+		},
+		{
+			name:        "exists with other disks",
+			request:     &internal.CreateStoragePoolRequest{PoolName: "pool", DiskNumbers: []uint32{1, 3}},
+			expectCode:  codes.AlreadyExists,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.CreateStoragePool(context.TODO(), tc.request, v1alpha1)
+			if tc.expectError && err == nil {
+				t.Fatalf("Expected error but CreateStoragePool returned a nil error")
+			}
+			if !tc.expectError && err != nil {
+				t.Fatalf("Expected no errors but CreateStoragePool returned error: %v", err)
+			}
+			if tc.expectCode != codes.OK && status.Code(err) != tc.expectCode {
+				t.Errorf("expected code %s, got %v", tc.expectCode, err)
+			}
+		})
+	}
+	if hostAPI.created != 1 {
+		t.Errorf("expected the storage pool to be created once, got %d", hostAPI.created)
+	}
+}
+
+func TestVirtualDisks(t *testing.T) {
+	v1alpha1 := apiversion.NewVersionOrPanic("v1alpha1")
+	hostAPI := &fakeStorageSpacesAPI{pools: []storagespaces.StoragePool{{Name: "pool", DiskNumbers: []uint32{1, 2}}}}
+	srv, err := NewServer(hostAPI)
+	if err != nil {
+		t.Fatalf("StorageSpaces Server could not be initialized for testing: %v", err)
+	}
+
+	request := &internal.CreateVirtualDiskRequest{PoolName: "missing", Name: "disk", SizeBytes: 1 << 30, Resiliency: internal.RESILIENCY_MIRROR}
+	if _, err := srv.CreateVirtualDisk(context.TODO(), request, v1alpha1); status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound for a missing pool, got %v", err)
+	}
+
+	request.PoolName = "pool"
+	request.SizeBytes = 1<<30 + 1
+	response, err := srv.CreateVirtualDisk(context.TODO(), request, v1alpha1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := internal.VirtualDisk{
+		Name:       "disk",
+		PoolName:   "pool",
+		SizeBytes:  1<<30 + 1<<28,
+		Resiliency: internal.RESILIENCY_MIRROR,
+		Attached:   true,
+		DiskNumber: 5,
+	}
+	if *response.VirtualDisk != expected {
+		t.Errorf("expected %+v, got %+v", expected, *response.VirtualDisk)
+	}
+
+	// the size of the virtual disk was rounded up, a retry succeeds
+	if _, err := srv.CreateVirtualDisk(context.TODO(), request, v1alpha1); err != nil {
+		t.Errorf("unexpected error on retry: %v", err)
+	}
+	request.Resiliency = internal.RESILIENCY_SIMPLE
+	if _, err := srv.CreateVirtualDisk(context.TODO(), request, v1alpha1); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected AlreadyExists for another resiliency, got %v", err)
+	}
+	if hostAPI.created != 1 {
+		t.Errorf("expected the virtual disk to be created once, got %d", hostAPI.created)
+	}
+
+	_, err = srv.DeleteStoragePool(context.TODO(), &internal.DeleteStoragePoolRequest{PoolName: "pool"}, v1alpha1)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected FailedPrecondition when deleting a pool with virtual disks, got %v", err)
+	}
+	if _, err := srv.DeleteVirtualDisk(context.TODO(), &internal.DeleteVirtualDiskRequest{PoolName: "pool", Name: "disk"}, v1alpha1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := srv.DeleteStoragePool(context.TODO(), &internal.DeleteStoragePoolRequest{PoolName: "pool"}, v1alpha1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(hostAPI.pools) != 0 {
+		t.Errorf("expected the storage pool to be deleted, got %+v", hostAPI.pools)
+	}
+}
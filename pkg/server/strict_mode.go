@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// strictModeMethods are the methods that don't run PowerShell, i.e. the only
+// methods served in strict mode, by service and method name without the API
+// version.
+var strictModeMethods = map[string]bool{
+	"Filesystem/PathExists":            true,
+	"Filesystem/Mkdir":                 true,
+	"Filesystem/Rmdir":                 true,
+	"Filesystem/RmdirContents":         true,
+	"Filesystem/CreateSymlink":         true,
+	"Filesystem/LinkPath":              true,
+	"Filesystem/IsSymlink":             true,
+	"Filesystem/IsMountPoint":          true,
+	"Filesystem/CheckAccess":           true,
+	"Filesystem/CreateJunction":        true,
+	"Filesystem/ResolveJunction":       true,
+	"Filesystem/GetACL":                true,
+	"Filesystem/SetACL":                true,
+	"System/GetBIOSSerialNumber":       true,
+	"System/ListSlowestOperations":     true,
+	"System/ExportState":               true,
+	"System/ImportState":               true,
+	"System/GetCapabilities":           true,
+	"Disk/Rescan":                      true,
+	"Disk/GetDiskState":                true,
+	"Disk/SetDiskState":                true,
+	"Disk/GetDiskReadOnly":             true,
+	"Volume/ListVolumesOnDisk":         true,
+	"Volume/UnmountVolume":             true,
+	"Volume/IsVolumeFormatted":         true,
+	"Volume/IsVolumeDirty":             true,
+	"Volume/GetDiskNumberFromVolumeID": true,
+	"Volume/GetVolumeIDFromTargetPath": true,
+	"Volume/GetVolumeIDFromMount":      true,
+	"Volume/WriteVolumeCache":          true,
+	"Volume/WriteVolumeCaches":         true,
+	"Volume/SyncVolume":                true,
+	"Volume/GetVolumeWriteCache":       true,
+	"Volume/GetVolumeAccessPaths":      true,
+	"Volume/SetVolumeCompression":      true,
+	"Volume/CleanupVolume":             true,
+	"Volume/GetVolumeSerialNumber":     true,
+}
+
+// rejectPowerShellMethods fails the methods that require PowerShell with
+// codes.Unimplemented in strict mode before they run, so that clients handle
+// them as unsupported operations.
+func rejectPowerShellMethods(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if utils.PowerShellDisabled() && !strictModeMethods[serviceMethod(info.FullMethod)] {
+		return nil, status.Errorf(codes.Unimplemented, "%s requires PowerShell which is disabled in strict mode", info.FullMethod)
+	}
+	return handler(ctx, req)
+}
+
+// serviceMethod returns the service and method name of the full gRPC method
+// `fullMethod` without the API version e.g. "Volume/FormatVolume" for
+// "/v2alpha1.Volume/FormatVolume".
+func serviceMethod(fullMethod string) string {
+	name := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRejectPowerShellMethods(t *testing.T) {
+	utils.DisablePowerShell()
+
+	testCases := []struct {
+		fullMethod string
+		expectCode codes.Code
+	}{
+		{fullMethod: "/v1.Filesystem/Mkdir", expectCode: codes.OK},
+		{fullMethod: "/v1beta1.Filesystem/LinkPath", expectCode: codes.OK},
+		{fullMethod: "/v2alpha1.Filesystem/CreateJunction", expectCode: codes.OK},
+		{fullMethod: "/v2alpha1.Filesystem/SetACL", expectCode: codes.OK},
+		{fullMethod: "/v1alpha2.System/ExportState", expectCode: codes.OK},
+		{fullMethod: "/v1alpha2.System/GetCapabilities", expectCode: codes.OK},
+		{fullMethod: "/v2alpha1.Volume/FormatVolume", expectCode: codes.Unimplemented},
+		{fullMethod: "/v2alpha1.Volume/IsVolumeFormatted", expectCode: codes.OK},
+		{fullMethod: "/v2alpha1.Volume/IsVolumeDirty", expectCode: codes.OK},
+		{fullMethod: "/v2alpha1.Volume/GetVolumeWriteCache", expectCode: codes.OK},
+		{fullMethod: "/v1.Disk/ListDiskIDs", expectCode: codes.Unimplemented},
+		{fullMethod: "/v2alpha1.Disk/Rescan", expectCode: codes.OK},
+		{fullMethod: "/v1.Disk/GetDiskState", expectCode: codes.OK},
+		{fullMethod: "/v1.Disk/SetDiskState", expectCode: codes.OK},
+		{fullMethod: "/v2alpha1.Disk/GetDiskReadOnly", expectCode: codes.OK},
+		{fullMethod: "/v2alpha1.Disk/SetDiskReadOnly", expectCode: codes.Unimplemented},
+		{fullMethod: "/v1alpha1.System/GetService", expectCode: codes.Unimplemented},
+	}
+	for _, tc := range testCases {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: tc.fullMethod}
+		_, err := rejectPowerShellMethods(context.TODO(), nil, info, handler)
+		if code := status.Code(err); code != tc.expectCode {
+			t.Errorf("%s: expected code %v, got %v", tc.fullMethod, tc.expectCode, code)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/server/system/impl"
 	"github.com/kubernetes-csi/csi-proxy/pkg/server/system/impl/v1alpha1"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/system/impl/v1alpha2"
 	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
 )
 
@@ -16,6 +17,7 @@ var _ impl.ServerInterface = &Server{}
 
 func (s *Server) VersionedAPIs() []*srvtypes.VersionedAPI {
 	v1alpha1Server := v1alpha1.NewVersionedServer(s)
+	v1alpha2Server := v1alpha2.NewVersionedServer(s)
 
 	return []*srvtypes.VersionedAPI{
 		{
@@ -23,5 +25,10 @@ func (s *Server) VersionedAPIs() []*srvtypes.VersionedAPI {
 			Version:    apiversion.NewVersionOrPanic("v1alpha1"),
 			Registrant: v1alpha1Server.Register,
 		},
+		{
+			Group:      name,
+			Version:    apiversion.NewVersionOrPanic("v1alpha2"),
+			Registrant: v1alpha2Server.Register,
+		},
 	}
 }
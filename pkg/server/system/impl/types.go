@@ -68,3 +68,50 @@ type GetServiceResponse struct {
 	// Service status, e.g. stopped, running, paused
 	Status ServiceStatus
 }
+
+type ListSlowestOperationsRequest struct {
+	// Maximum number of operations to return, 0 returns all the recent operations
+	MaxResults uint32
+}
+
+type Operation struct {
+	Method          string
+	DurationNanos   int64
+	StartedAgoNanos int64
+	// Error of the operation, empty if it succeeded
+	Error string
+}
+
+type ListSlowestOperationsResponse struct {
+	// Recent operations, slowest first
+	Operations []*Operation
+}
+
+type ExportStateRequest struct {
+}
+
+type ExportStateResponse struct {
+	State []byte
+}
+
+type ImportStateRequest struct {
+	State []byte
+}
+
+type ImportStateResponse struct {
+}
+
+type GetCapabilitiesRequest struct {
+}
+
+type GetCapabilitiesResponse struct {
+	// Installation type of Windows e.g. Server Core
+	InstallationType    string
+	PowershellAvailable bool
+	AvailableModules    []string
+	MissingModules      []string
+	// Build number of Windows, 0 if it couldn't be read
+	WindowsBuild uint32
+	// Operations that the build of Windows doesn't support
+	UnsupportedOperations []string
+}
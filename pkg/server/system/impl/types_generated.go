@@ -15,8 +15,12 @@ type VersionedAPI interface {
 
 // All the functions this group's server needs to define.
 type ServerInterface interface {
+	ExportState(context.Context, *ExportStateRequest, apiversion.Version) (*ExportStateResponse, error)
 	GetBIOSSerialNumber(context.Context, *GetBIOSSerialNumberRequest, apiversion.Version) (*GetBIOSSerialNumberResponse, error)
+	GetCapabilities(context.Context, *GetCapabilitiesRequest, apiversion.Version) (*GetCapabilitiesResponse, error)
 	GetService(context.Context, *GetServiceRequest, apiversion.Version) (*GetServiceResponse, error)
+	ImportState(context.Context, *ImportStateRequest, apiversion.Version) (*ImportStateResponse, error)
+	ListSlowestOperations(context.Context, *ListSlowestOperationsRequest, apiversion.Version) (*ListSlowestOperationsResponse, error)
 	StartService(context.Context, *StartServiceRequest, apiversion.Version) (*StartServiceResponse, error)
 	StopService(context.Context, *StopServiceRequest, apiversion.Version) (*StopServiceResponse, error)
 }
@@ -0,0 +1,40 @@
+package v1alpha2
+
+import (
+	"github.com/kubernetes-csi/csi-proxy/client/api/system/v1alpha2"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/system/impl"
+)
+
+// Add manual conversion functions here to override automatic conversion functions
+
+func Convert_impl_ListSlowestOperationsResponse_To_v1alpha2_ListSlowestOperationsResponse(in *impl.ListSlowestOperationsResponse, out *v1alpha2.ListSlowestOperationsResponse) error {
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]*v1alpha2.Operation, len(*in))
+		for i := range *in {
+			(*out)[i] = new(v1alpha2.Operation)
+			if err := Convert_impl_Operation_To_v1alpha2_Operation(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Operations = nil
+	}
+	return nil
+}
+
+func Convert_v1alpha2_ListSlowestOperationsResponse_To_impl_ListSlowestOperationsResponse(in *v1alpha2.ListSlowestOperationsResponse, out *impl.ListSlowestOperationsResponse) error {
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]*impl.Operation, len(*in))
+		for i := range *in {
+			(*out)[i] = new(impl.Operation)
+			if err := Convert_v1alpha2_Operation_To_impl_Operation(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Operations = nil
+	}
+	return nil
+}
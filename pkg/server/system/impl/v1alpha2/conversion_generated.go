@@ -0,0 +1,348 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	unsafe "unsafe"
+
+	v1alpha2 "github.com/kubernetes-csi/csi-proxy/client/api/system/v1alpha2"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/system/impl"
+)
+
+func autoConvert_v1alpha2_ExportStateRequest_To_impl_ExportStateRequest(in *v1alpha2.ExportStateRequest, out *impl.ExportStateRequest) error {
+	return nil
+}
+
+// Convert_v1alpha2_ExportStateRequest_To_impl_ExportStateRequest is an autogenerated conversion function.
+func Convert_v1alpha2_ExportStateRequest_To_impl_ExportStateRequest(in *v1alpha2.ExportStateRequest, out *impl.ExportStateRequest) error {
+	return autoConvert_v1alpha2_ExportStateRequest_To_impl_ExportStateRequest(in, out)
+}
+
+func autoConvert_impl_ExportStateRequest_To_v1alpha2_ExportStateRequest(in *impl.ExportStateRequest, out *v1alpha2.ExportStateRequest) error {
+	return nil
+}
+
+// Convert_impl_ExportStateRequest_To_v1alpha2_ExportStateRequest is an autogenerated conversion function.
+func Convert_impl_ExportStateRequest_To_v1alpha2_ExportStateRequest(in *impl.ExportStateRequest, out *v1alpha2.ExportStateRequest) error {
+	return autoConvert_impl_ExportStateRequest_To_v1alpha2_ExportStateRequest(in, out)
+}
+
+func autoConvert_v1alpha2_ExportStateResponse_To_impl_ExportStateResponse(in *v1alpha2.ExportStateResponse, out *impl.ExportStateResponse) error {
+	out.State = *(*[]byte)(unsafe.Pointer(&in.State))
+	return nil
+}
+
+// Convert_v1alpha2_ExportStateResponse_To_impl_ExportStateResponse is an autogenerated conversion function.
+func Convert_v1alpha2_ExportStateResponse_To_impl_ExportStateResponse(in *v1alpha2.ExportStateResponse, out *impl.ExportStateResponse) error {
+	return autoConvert_v1alpha2_ExportStateResponse_To_impl_ExportStateResponse(in, out)
+}
+
+func autoConvert_impl_ExportStateResponse_To_v1alpha2_ExportStateResponse(in *impl.ExportStateResponse, out *v1alpha2.ExportStateResponse) error {
+	out.State = *(*[]byte)(unsafe.Pointer(&in.State))
+	return nil
+}
+
+// Convert_impl_ExportStateResponse_To_v1alpha2_ExportStateResponse is an autogenerated conversion function.
+func Convert_impl_ExportStateResponse_To_v1alpha2_ExportStateResponse(in *impl.ExportStateResponse, out *v1alpha2.ExportStateResponse) error {
+	return autoConvert_impl_ExportStateResponse_To_v1alpha2_ExportStateResponse(in, out)
+}
+
+func autoConvert_v1alpha2_GetBIOSSerialNumberRequest_To_impl_GetBIOSSerialNumberRequest(in *v1alpha2.GetBIOSSerialNumberRequest, out *impl.GetBIOSSerialNumberRequest) error {
+	return nil
+}
+
+// Convert_v1alpha2_GetBIOSSerialNumberRequest_To_impl_GetBIOSSerialNumberRequest is an autogenerated conversion function.
+func Convert_v1alpha2_GetBIOSSerialNumberRequest_To_impl_GetBIOSSerialNumberRequest(in *v1alpha2.GetBIOSSerialNumberRequest, out *impl.GetBIOSSerialNumberRequest) error {
+	return autoConvert_v1alpha2_GetBIOSSerialNumberRequest_To_impl_GetBIOSSerialNumberRequest(in, out)
+}
+
+func autoConvert_impl_GetBIOSSerialNumberRequest_To_v1alpha2_GetBIOSSerialNumberRequest(in *impl.GetBIOSSerialNumberRequest, out *v1alpha2.GetBIOSSerialNumberRequest) error {
+	return nil
+}
+
+// Convert_impl_GetBIOSSerialNumberRequest_To_v1alpha2_GetBIOSSerialNumberRequest is an autogenerated conversion function.
+func Convert_impl_GetBIOSSerialNumberRequest_To_v1alpha2_GetBIOSSerialNumberRequest(in *impl.GetBIOSSerialNumberRequest, out *v1alpha2.GetBIOSSerialNumberRequest) error {
+	return autoConvert_impl_GetBIOSSerialNumberRequest_To_v1alpha2_GetBIOSSerialNumberRequest(in, out)
+}
+
+func autoConvert_v1alpha2_GetBIOSSerialNumberResponse_To_impl_GetBIOSSerialNumberResponse(in *v1alpha2.GetBIOSSerialNumberResponse, out *impl.GetBIOSSerialNumberResponse) error {
+	out.SerialNumber = in.SerialNumber
+	return nil
+}
+
+// Convert_v1alpha2_GetBIOSSerialNumberResponse_To_impl_GetBIOSSerialNumberResponse is an autogenerated conversion function.
+func Convert_v1alpha2_GetBIOSSerialNumberResponse_To_impl_GetBIOSSerialNumberResponse(in *v1alpha2.GetBIOSSerialNumberResponse, out *impl.GetBIOSSerialNumberResponse) error {
+	return autoConvert_v1alpha2_GetBIOSSerialNumberResponse_To_impl_GetBIOSSerialNumberResponse(in, out)
+}
+
+func autoConvert_impl_GetBIOSSerialNumberResponse_To_v1alpha2_GetBIOSSerialNumberResponse(in *impl.GetBIOSSerialNumberResponse, out *v1alpha2.GetBIOSSerialNumberResponse) error {
+	out.SerialNumber = in.SerialNumber
+	return nil
+}
+
+// Convert_impl_GetBIOSSerialNumberResponse_To_v1alpha2_GetBIOSSerialNumberResponse is an autogenerated conversion function.
+func Convert_impl_GetBIOSSerialNumberResponse_To_v1alpha2_GetBIOSSerialNumberResponse(in *impl.GetBIOSSerialNumberResponse, out *v1alpha2.GetBIOSSerialNumberResponse) error {
+	return autoConvert_impl_GetBIOSSerialNumberResponse_To_v1alpha2_GetBIOSSerialNumberResponse(in, out)
+}
+
+func autoConvert_v1alpha2_GetCapabilitiesRequest_To_impl_GetCapabilitiesRequest(in *v1alpha2.GetCapabilitiesRequest, out *impl.GetCapabilitiesRequest) error {
+	return nil
+}
+
+// Convert_v1alpha2_GetCapabilitiesRequest_To_impl_GetCapabilitiesRequest is an autogenerated conversion function.
+func Convert_v1alpha2_GetCapabilitiesRequest_To_impl_GetCapabilitiesRequest(in *v1alpha2.GetCapabilitiesRequest, out *impl.GetCapabilitiesRequest) error {
+	return autoConvert_v1alpha2_GetCapabilitiesRequest_To_impl_GetCapabilitiesRequest(in, out)
+}
+
+func autoConvert_impl_GetCapabilitiesRequest_To_v1alpha2_GetCapabilitiesRequest(in *impl.GetCapabilitiesRequest, out *v1alpha2.GetCapabilitiesRequest) error {
+	return nil
+}
+
+// Convert_impl_GetCapabilitiesRequest_To_v1alpha2_GetCapabilitiesRequest is an autogenerated conversion function.
+func Convert_impl_GetCapabilitiesRequest_To_v1alpha2_GetCapabilitiesRequest(in *impl.GetCapabilitiesRequest, out *v1alpha2.GetCapabilitiesRequest) error {
+	return autoConvert_impl_GetCapabilitiesRequest_To_v1alpha2_GetCapabilitiesRequest(in, out)
+}
+
+func autoConvert_v1alpha2_GetCapabilitiesResponse_To_impl_GetCapabilitiesResponse(in *v1alpha2.GetCapabilitiesResponse, out *impl.GetCapabilitiesResponse) error {
+	out.InstallationType = in.InstallationType
+	out.PowershellAvailable = in.PowershellAvailable
+	out.AvailableModules = *(*[]string)(unsafe.Pointer(&in.AvailableModules))
+	out.MissingModules = *(*[]string)(unsafe.Pointer(&in.MissingModules))
+	out.WindowsBuild = in.WindowsBuild
+	out.UnsupportedOperations = *(*[]string)(unsafe.Pointer(&in.UnsupportedOperations))
+	return nil
+}
+
+// Convert_v1alpha2_GetCapabilitiesResponse_To_impl_GetCapabilitiesResponse is an autogenerated conversion function.
+func Convert_v1alpha2_GetCapabilitiesResponse_To_impl_GetCapabilitiesResponse(in *v1alpha2.GetCapabilitiesResponse, out *impl.GetCapabilitiesResponse) error {
+	return autoConvert_v1alpha2_GetCapabilitiesResponse_To_impl_GetCapabilitiesResponse(in, out)
+}
+
+func autoConvert_impl_GetCapabilitiesResponse_To_v1alpha2_GetCapabilitiesResponse(in *impl.GetCapabilitiesResponse, out *v1alpha2.GetCapabilitiesResponse) error {
+	out.InstallationType = in.InstallationType
+	out.PowershellAvailable = in.PowershellAvailable
+	out.AvailableModules = *(*[]string)(unsafe.Pointer(&in.AvailableModules))
+	out.MissingModules = *(*[]string)(unsafe.Pointer(&in.MissingModules))
+	out.WindowsBuild = in.WindowsBuild
+	out.UnsupportedOperations = *(*[]string)(unsafe.Pointer(&in.UnsupportedOperations))
+	return nil
+}
+
+// Convert_impl_GetCapabilitiesResponse_To_v1alpha2_GetCapabilitiesResponse is an autogenerated conversion function.
+func Convert_impl_GetCapabilitiesResponse_To_v1alpha2_GetCapabilitiesResponse(in *impl.GetCapabilitiesResponse, out *v1alpha2.GetCapabilitiesResponse) error {
+	return autoConvert_impl_GetCapabilitiesResponse_To_v1alpha2_GetCapabilitiesResponse(in, out)
+}
+
+func autoConvert_v1alpha2_GetServiceRequest_To_impl_GetServiceRequest(in *v1alpha2.GetServiceRequest, out *impl.GetServiceRequest) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha2_GetServiceRequest_To_impl_GetServiceRequest is an autogenerated conversion function.
+func Convert_v1alpha2_GetServiceRequest_To_impl_GetServiceRequest(in *v1alpha2.GetServiceRequest, out *impl.GetServiceRequest) error {
+	return autoConvert_v1alpha2_GetServiceRequest_To_impl_GetServiceRequest(in, out)
+}
+
+func autoConvert_impl_GetServiceRequest_To_v1alpha2_GetServiceRequest(in *impl.GetServiceRequest, out *v1alpha2.GetServiceRequest) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_impl_GetServiceRequest_To_v1alpha2_GetServiceRequest is an autogenerated conversion function.
+func Convert_impl_GetServiceRequest_To_v1alpha2_GetServiceRequest(in *impl.GetServiceRequest, out *v1alpha2.GetServiceRequest) error {
+	return autoConvert_impl_GetServiceRequest_To_v1alpha2_GetServiceRequest(in, out)
+}
+
+func autoConvert_v1alpha2_GetServiceResponse_To_impl_GetServiceResponse(in *v1alpha2.GetServiceResponse, out *impl.GetServiceResponse) error {
+	out.DisplayName = in.DisplayName
+	out.StartType = impl.Startype(in.StartType)
+	out.Status = impl.ServiceStatus(in.Status)
+	return nil
+}
+
+// Convert_v1alpha2_GetServiceResponse_To_impl_GetServiceResponse is an autogenerated conversion function.
+func Convert_v1alpha2_GetServiceResponse_To_impl_GetServiceResponse(in *v1alpha2.GetServiceResponse, out *impl.GetServiceResponse) error {
+	return autoConvert_v1alpha2_GetServiceResponse_To_impl_GetServiceResponse(in, out)
+}
+
+func autoConvert_impl_GetServiceResponse_To_v1alpha2_GetServiceResponse(in *impl.GetServiceResponse, out *v1alpha2.GetServiceResponse) error {
+	out.DisplayName = in.DisplayName
+	out.StartType = v1alpha2.StartType(in.StartType)
+	out.Status = v1alpha2.ServiceStatus(in.Status)
+	return nil
+}
+
+// Convert_impl_GetServiceResponse_To_v1alpha2_GetServiceResponse is an autogenerated conversion function.
+func Convert_impl_GetServiceResponse_To_v1alpha2_GetServiceResponse(in *impl.GetServiceResponse, out *v1alpha2.GetServiceResponse) error {
+	return autoConvert_impl_GetServiceResponse_To_v1alpha2_GetServiceResponse(in, out)
+}
+
+func autoConvert_v1alpha2_ImportStateRequest_To_impl_ImportStateRequest(in *v1alpha2.ImportStateRequest, out *impl.ImportStateRequest) error {
+	out.State = *(*[]byte)(unsafe.Pointer(&in.State))
+	return nil
+}
+
+// Convert_v1alpha2_ImportStateRequest_To_impl_ImportStateRequest is an autogenerated conversion function.
+func Convert_v1alpha2_ImportStateRequest_To_impl_ImportStateRequest(in *v1alpha2.ImportStateRequest, out *impl.ImportStateRequest) error {
+	return autoConvert_v1alpha2_ImportStateRequest_To_impl_ImportStateRequest(in, out)
+}
+
+func autoConvert_impl_ImportStateRequest_To_v1alpha2_ImportStateRequest(in *impl.ImportStateRequest, out *v1alpha2.ImportStateRequest) error {
+	out.State = *(*[]byte)(unsafe.Pointer(&in.State))
+	return nil
+}
+
+// Convert_impl_ImportStateRequest_To_v1alpha2_ImportStateRequest is an autogenerated conversion function.
+func Convert_impl_ImportStateRequest_To_v1alpha2_ImportStateRequest(in *impl.ImportStateRequest, out *v1alpha2.ImportStateRequest) error {
+	return autoConvert_impl_ImportStateRequest_To_v1alpha2_ImportStateRequest(in, out)
+}
+
+func autoConvert_v1alpha2_ImportStateResponse_To_impl_ImportStateResponse(in *v1alpha2.ImportStateResponse, out *impl.ImportStateResponse) error {
+	return nil
+}
+
+// Convert_v1alpha2_ImportStateResponse_To_impl_ImportStateResponse is an autogenerated conversion function.
+func Convert_v1alpha2_ImportStateResponse_To_impl_ImportStateResponse(in *v1alpha2.ImportStateResponse, out *impl.ImportStateResponse) error {
+	return autoConvert_v1alpha2_ImportStateResponse_To_impl_ImportStateResponse(in, out)
+}
+
+func autoConvert_impl_ImportStateResponse_To_v1alpha2_ImportStateResponse(in *impl.ImportStateResponse, out *v1alpha2.ImportStateResponse) error {
+	return nil
+}
+
+// Convert_impl_ImportStateResponse_To_v1alpha2_ImportStateResponse is an autogenerated conversion function.
+func Convert_impl_ImportStateResponse_To_v1alpha2_ImportStateResponse(in *impl.ImportStateResponse, out *v1alpha2.ImportStateResponse) error {
+	return autoConvert_impl_ImportStateResponse_To_v1alpha2_ImportStateResponse(in, out)
+}
+
+func autoConvert_v1alpha2_ListSlowestOperationsRequest_To_impl_ListSlowestOperationsRequest(in *v1alpha2.ListSlowestOperationsRequest, out *impl.ListSlowestOperationsRequest) error {
+	out.MaxResults = in.MaxResults
+	return nil
+}
+
+// Convert_v1alpha2_ListSlowestOperationsRequest_To_impl_ListSlowestOperationsRequest is an autogenerated conversion function.
+func Convert_v1alpha2_ListSlowestOperationsRequest_To_impl_ListSlowestOperationsRequest(in *v1alpha2.ListSlowestOperationsRequest, out *impl.ListSlowestOperationsRequest) error {
+	return autoConvert_v1alpha2_ListSlowestOperationsRequest_To_impl_ListSlowestOperationsRequest(in, out)
+}
+
+func autoConvert_impl_ListSlowestOperationsRequest_To_v1alpha2_ListSlowestOperationsRequest(in *impl.ListSlowestOperationsRequest, out *v1alpha2.ListSlowestOperationsRequest) error {
+	out.MaxResults = in.MaxResults
+	return nil
+}
+
+// Convert_impl_ListSlowestOperationsRequest_To_v1alpha2_ListSlowestOperationsRequest is an autogenerated conversion function.
+func Convert_impl_ListSlowestOperationsRequest_To_v1alpha2_ListSlowestOperationsRequest(in *impl.ListSlowestOperationsRequest, out *v1alpha2.ListSlowestOperationsRequest) error {
+	return autoConvert_impl_ListSlowestOperationsRequest_To_v1alpha2_ListSlowestOperationsRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v1alpha2_ListSlowestOperationsResponse_To_impl_ListSlowestOperationsResponse(in *v1alpha2.ListSlowestOperationsResponse, out *impl.ListSlowestOperationsResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_ListSlowestOperationsResponse_To_v1alpha2_ListSlowestOperationsResponse(in *impl.ListSlowestOperationsResponse, out *v1alpha2.ListSlowestOperationsResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v1alpha2_Operation_To_impl_Operation(in *v1alpha2.Operation, out *impl.Operation) error {
+	out.Method = in.Method
+	out.DurationNanos = in.DurationNanos
+	out.StartedAgoNanos = in.StartedAgoNanos
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_v1alpha2_Operation_To_impl_Operation is an autogenerated conversion function.
+func Convert_v1alpha2_Operation_To_impl_Operation(in *v1alpha2.Operation, out *impl.Operation) error {
+	return autoConvert_v1alpha2_Operation_To_impl_Operation(in, out)
+}
+
+func autoConvert_impl_Operation_To_v1alpha2_Operation(in *impl.Operation, out *v1alpha2.Operation) error {
+	out.Method = in.Method
+	out.DurationNanos = in.DurationNanos
+	out.StartedAgoNanos = in.StartedAgoNanos
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_impl_Operation_To_v1alpha2_Operation is an autogenerated conversion function.
+func Convert_impl_Operation_To_v1alpha2_Operation(in *impl.Operation, out *v1alpha2.Operation) error {
+	return autoConvert_impl_Operation_To_v1alpha2_Operation(in, out)
+}
+
+func autoConvert_v1alpha2_StartServiceRequest_To_impl_StartServiceRequest(in *v1alpha2.StartServiceRequest, out *impl.StartServiceRequest) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha2_StartServiceRequest_To_impl_StartServiceRequest is an autogenerated conversion function.
+func Convert_v1alpha2_StartServiceRequest_To_impl_StartServiceRequest(in *v1alpha2.StartServiceRequest, out *impl.StartServiceRequest) error {
+	return autoConvert_v1alpha2_StartServiceRequest_To_impl_StartServiceRequest(in, out)
+}
+
+func autoConvert_impl_StartServiceRequest_To_v1alpha2_StartServiceRequest(in *impl.StartServiceRequest, out *v1alpha2.StartServiceRequest) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_impl_StartServiceRequest_To_v1alpha2_StartServiceRequest is an autogenerated conversion function.
+func Convert_impl_StartServiceRequest_To_v1alpha2_StartServiceRequest(in *impl.StartServiceRequest, out *v1alpha2.StartServiceRequest) error {
+	return autoConvert_impl_StartServiceRequest_To_v1alpha2_StartServiceRequest(in, out)
+}
+
+func autoConvert_v1alpha2_StartServiceResponse_To_impl_StartServiceResponse(in *v1alpha2.StartServiceResponse, out *impl.StartServiceResponse) error {
+	return nil
+}
+
+// Convert_v1alpha2_StartServiceResponse_To_impl_StartServiceResponse is an autogenerated conversion function.
+func Convert_v1alpha2_StartServiceResponse_To_impl_StartServiceResponse(in *v1alpha2.StartServiceResponse, out *impl.StartServiceResponse) error {
+	return autoConvert_v1alpha2_StartServiceResponse_To_impl_StartServiceResponse(in, out)
+}
+
+func autoConvert_impl_StartServiceResponse_To_v1alpha2_StartServiceResponse(in *impl.StartServiceResponse, out *v1alpha2.StartServiceResponse) error {
+	return nil
+}
+
+// Convert_impl_StartServiceResponse_To_v1alpha2_StartServiceResponse is an autogenerated conversion function.
+func Convert_impl_StartServiceResponse_To_v1alpha2_StartServiceResponse(in *impl.StartServiceResponse, out *v1alpha2.StartServiceResponse) error {
+	return autoConvert_impl_StartServiceResponse_To_v1alpha2_StartServiceResponse(in, out)
+}
+
+func autoConvert_v1alpha2_StopServiceRequest_To_impl_StopServiceRequest(in *v1alpha2.StopServiceRequest, out *impl.StopServiceRequest) error {
+	out.Name = in.Name
+	out.Force = in.Force
+	return nil
+}
+
+// Convert_v1alpha2_StopServiceRequest_To_impl_StopServiceRequest is an autogenerated conversion function.
+func Convert_v1alpha2_StopServiceRequest_To_impl_StopServiceRequest(in *v1alpha2.StopServiceRequest, out *impl.StopServiceRequest) error {
+	return autoConvert_v1alpha2_StopServiceRequest_To_impl_StopServiceRequest(in, out)
+}
+
+func autoConvert_impl_StopServiceRequest_To_v1alpha2_StopServiceRequest(in *impl.StopServiceRequest, out *v1alpha2.StopServiceRequest) error {
+	out.Name = in.Name
+	out.Force = in.Force
+	return nil
+}
+
+// Convert_impl_StopServiceRequest_To_v1alpha2_StopServiceRequest is an autogenerated conversion function.
+func Convert_impl_StopServiceRequest_To_v1alpha2_StopServiceRequest(in *impl.StopServiceRequest, out *v1alpha2.StopServiceRequest) error {
+	return autoConvert_impl_StopServiceRequest_To_v1alpha2_StopServiceRequest(in, out)
+}
+
+func autoConvert_v1alpha2_StopServiceResponse_To_impl_StopServiceResponse(in *v1alpha2.StopServiceResponse, out *impl.StopServiceResponse) error {
+	return nil
+}
+
+// Convert_v1alpha2_StopServiceResponse_To_impl_StopServiceResponse is an autogenerated conversion function.
+func Convert_v1alpha2_StopServiceResponse_To_impl_StopServiceResponse(in *v1alpha2.StopServiceResponse, out *impl.StopServiceResponse) error {
+	return autoConvert_v1alpha2_StopServiceResponse_To_impl_StopServiceResponse(in, out)
+}
+
+func autoConvert_impl_StopServiceResponse_To_v1alpha2_StopServiceResponse(in *impl.StopServiceResponse, out *v1alpha2.StopServiceResponse) error {
+	return nil
+}
+
+// Convert_impl_StopServiceResponse_To_v1alpha2_StopServiceResponse is an autogenerated conversion function.
+func Convert_impl_StopServiceResponse_To_v1alpha2_StopServiceResponse(in *impl.StopServiceResponse, out *v1alpha2.StopServiceResponse) error {
+	return autoConvert_impl_StopServiceResponse_To_v1alpha2_StopServiceResponse(in, out)
+}
@@ -0,0 +1,180 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/system/v1alpha2"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/system/impl"
+	"google.golang.org/grpc"
+)
+
+var version = apiversion.NewVersionOrPanic("v1alpha2")
+
+type versionedAPI struct {
+	apiGroupServer impl.ServerInterface
+}
+
+func NewVersionedServer(apiGroupServer impl.ServerInterface) impl.VersionedAPI {
+	return &versionedAPI{
+		apiGroupServer: apiGroupServer,
+	}
+}
+
+func (s *versionedAPI) Register(grpcServer *grpc.Server) {
+	v1alpha2.RegisterSystemServer(grpcServer, s)
+}
+
+func (s *versionedAPI) ExportState(context context.Context, versionedRequest *v1alpha2.ExportStateRequest) (*v1alpha2.ExportStateResponse, error) {
+	request := &impl.ExportStateRequest{}
+	if err := Convert_v1alpha2_ExportStateRequest_To_impl_ExportStateRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ExportState(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha2.ExportStateResponse{}
+	if err := Convert_impl_ExportStateResponse_To_v1alpha2_ExportStateResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetBIOSSerialNumber(context context.Context, versionedRequest *v1alpha2.GetBIOSSerialNumberRequest) (*v1alpha2.GetBIOSSerialNumberResponse, error) {
+	request := &impl.GetBIOSSerialNumberRequest{}
+	if err := Convert_v1alpha2_GetBIOSSerialNumberRequest_To_impl_GetBIOSSerialNumberRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetBIOSSerialNumber(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha2.GetBIOSSerialNumberResponse{}
+	if err := Convert_impl_GetBIOSSerialNumberResponse_To_v1alpha2_GetBIOSSerialNumberResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetCapabilities(context context.Context, versionedRequest *v1alpha2.GetCapabilitiesRequest) (*v1alpha2.GetCapabilitiesResponse, error) {
+	request := &impl.GetCapabilitiesRequest{}
+	if err := Convert_v1alpha2_GetCapabilitiesRequest_To_impl_GetCapabilitiesRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetCapabilities(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha2.GetCapabilitiesResponse{}
+	if err := Convert_impl_GetCapabilitiesResponse_To_v1alpha2_GetCapabilitiesResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetService(context context.Context, versionedRequest *v1alpha2.GetServiceRequest) (*v1alpha2.GetServiceResponse, error) {
+	request := &impl.GetServiceRequest{}
+	if err := Convert_v1alpha2_GetServiceRequest_To_impl_GetServiceRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetService(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha2.GetServiceResponse{}
+	if err := Convert_impl_GetServiceResponse_To_v1alpha2_GetServiceResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ImportState(context context.Context, versionedRequest *v1alpha2.ImportStateRequest) (*v1alpha2.ImportStateResponse, error) {
+	request := &impl.ImportStateRequest{}
+	if err := Convert_v1alpha2_ImportStateRequest_To_impl_ImportStateRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ImportState(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha2.ImportStateResponse{}
+	if err := Convert_impl_ImportStateResponse_To_v1alpha2_ImportStateResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ListSlowestOperations(context context.Context, versionedRequest *v1alpha2.ListSlowestOperationsRequest) (*v1alpha2.ListSlowestOperationsResponse, error) {
+	request := &impl.ListSlowestOperationsRequest{}
+	if err := Convert_v1alpha2_ListSlowestOperationsRequest_To_impl_ListSlowestOperationsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListSlowestOperations(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha2.ListSlowestOperationsResponse{}
+	if err := Convert_impl_ListSlowestOperationsResponse_To_v1alpha2_ListSlowestOperationsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) StartService(context context.Context, versionedRequest *v1alpha2.StartServiceRequest) (*v1alpha2.StartServiceResponse, error) {
+	request := &impl.StartServiceRequest{}
+	if err := Convert_v1alpha2_StartServiceRequest_To_impl_StartServiceRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.StartService(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha2.StartServiceResponse{}
+	if err := Convert_impl_StartServiceResponse_To_v1alpha2_StartServiceResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) StopService(context context.Context, versionedRequest *v1alpha2.StopServiceRequest) (*v1alpha2.StopServiceResponse, error) {
+	request := &impl.StopServiceRequest{}
+	if err := Convert_v1alpha2_StopServiceRequest_To_impl_StopServiceRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.StopService(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha2.StopServiceResponse{}
+	if err := Convert_impl_StopServiceResponse_To_v1alpha2_StopServiceResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
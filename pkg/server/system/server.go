@@ -2,15 +2,22 @@ package system
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/metrics"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/system"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/system/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
 	"k8s.io/klog/v2"
 )
 
 type Server struct {
-	hostAPI API
+	hostAPI        API
+	stateProviders map[string]StateProvider
 }
 
 type API interface {
@@ -20,12 +27,36 @@ type API interface {
 	StopService(name string, force bool) error
 }
 
-func NewServer(hostAPI API) (*Server, error) {
+// StateProvider is a part of the state of csi-proxy, e.g. its configuration,
+// exported by ExportState and imported by ImportState.
+type StateProvider interface {
+	ExportState() (json.RawMessage, error)
+	ImportState(state json.RawMessage) error
+}
+
+// stateVersion is the version of the format of the exported state.
+const stateVersion = 1
+
+// exportedState is the state of csi-proxy, with a section for each state provider.
+type exportedState struct {
+	Version  int                        `json:"version"`
+	Sections map[string]json.RawMessage `json:"sections"`
+}
+
+func NewServer(hostAPI API, stateProviders map[string]StateProvider) (*Server, error) {
 	return &Server{
-		hostAPI: hostAPI,
+		hostAPI:        hostAPI,
+		stateProviders: stateProviders,
 	}, nil
 }
 
+// WarmUp gets the BIOS serial number, it loads the CIM cmdlets and the WMI provider of the
+// Win32 classes.
+func (s *Server) WarmUp() error {
+	_, err := s.hostAPI.GetBIOSSerialNumber()
+	return err
+}
+
 func (s *Server) GetBIOSSerialNumber(context context.Context, request *internal.GetBIOSSerialNumberRequest, version apiversion.Version) (*internal.GetBIOSSerialNumberResponse, error) {
 	klog.V(4).Infof("calling GetBIOSSerialNumber")
 	response := &internal.GetBIOSSerialNumberResponse{}
@@ -77,3 +108,106 @@ func (s *Server) StopService(context context.Context, request *internal.StopServ
 
 	return response, nil
 }
+
+func (s *Server) ListSlowestOperations(context context.Context, request *internal.ListSlowestOperationsRequest, version apiversion.Version) (*internal.ListSlowestOperationsResponse, error) {
+	klog.V(4).Infof("calling ListSlowestOperations maxResults=%d", request.MaxResults)
+	response := &internal.ListSlowestOperationsResponse{}
+	for _, op := range metrics.Operations.Slowest(int(request.MaxResults)) {
+		operation := &internal.Operation{
+			Method:          op.Method,
+			DurationNanos:   op.Duration.Nanoseconds(),
+			StartedAgoNanos: time.Since(op.Start).Nanoseconds(),
+		}
+		if op.Err != nil {
+			operation.Error = op.Err.Error()
+		}
+		response.Operations = append(response.Operations, operation)
+	}
+	return response, nil
+}
+
+func (s *Server) ExportState(context context.Context, request *internal.ExportStateRequest, version apiversion.Version) (*internal.ExportStateResponse, error) {
+	klog.V(4).Infof("calling ExportState")
+	response := &internal.ExportStateResponse{}
+	state := exportedState{
+		Version:  stateVersion,
+		Sections: make(map[string]json.RawMessage),
+	}
+	for name, provider := range s.stateProviders {
+		section, err := provider.ExportState()
+		if err != nil {
+			klog.Errorf("failed ExportState of %s: %v", name, err)
+			return response, fmt.Errorf("error exporting the state of %s: %v", name, err)
+		}
+		state.Sections[name] = section
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		klog.Errorf("failed ExportState: %v", err)
+		return response, err
+	}
+	response.State = data
+	return response, nil
+}
+
+func (s *Server) ImportState(context context.Context, request *internal.ImportStateRequest, version apiversion.Version) (*internal.ImportStateResponse, error) {
+	klog.V(4).Infof("calling ImportState")
+	response := &internal.ImportStateResponse{}
+	var state exportedState
+	if err := json.Unmarshal(request.State, &state); err != nil {
+		klog.Errorf("failed ImportState: %v", err)
+		return response, fmt.Errorf("error parsing the state: %v", err)
+	}
+	if state.Version != stateVersion {
+		return response, fmt.Errorf("unsupported state version %d, the supported version is %d", state.Version, stateVersion)
+	}
+
+	// check all the sections before importing any of them
+	names := make([]string, 0, len(state.Sections))
+	for name := range state.Sections {
+		if _, ok := s.stateProviders[name]; !ok {
+			return response, fmt.Errorf("unknown state section %q", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := s.stateProviders[name].ImportState(state.Sections[name]); err != nil {
+			klog.Errorf("failed ImportState of %s: %v", name, err)
+			return response, fmt.Errorf("error importing the state of %s: %w", name, err)
+		}
+	}
+	return response, nil
+}
+
+func (s *Server) GetCapabilities(context context.Context, request *internal.GetCapabilitiesRequest, version apiversion.Version) (*internal.GetCapabilitiesResponse, error) {
+	klog.V(4).Infof("calling GetCapabilities")
+	capabilities, ok := utils.GetCapabilities()
+	if !ok {
+		return nil, fmt.Errorf("the capabilities of the host weren't detected")
+	}
+	response := &internal.GetCapabilitiesResponse{
+		InstallationType:    capabilities.InstallationType,
+		PowershellAvailable: capabilities.PowerShell,
+		AvailableModules:    []string{},
+		MissingModules:      []string{},
+	}
+	for _, module := range utils.PowerShellModules {
+		if capabilities.PowerShell && utils.HasPowerShellModule(module) {
+			response.AvailableModules = append(response.AvailableModules, module)
+		} else {
+			response.MissingModules = append(response.MissingModules, module)
+		}
+	}
+	response.WindowsBuild = capabilities.Build
+	response.UnsupportedOperations = []string{}
+	for method := range utils.OperationFeatures {
+		if err := utils.CheckOperation(method); err != nil {
+			response.UnsupportedOperations = append(response.UnsupportedOperations, method)
+		}
+	}
+	sort.Strings(response.UnsupportedOperations)
+	return response, nil
+}
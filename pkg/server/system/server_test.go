@@ -0,0 +1,157 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/system"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/system/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+)
+
+type fakeSystemAPI struct{}
+
+var _ API = &fakeSystemAPI{}
+
+func (fakeSystemAPI) GetBIOSSerialNumber() (string, error) {
+	return "", nil
+}
+
+func (fakeSystemAPI) GetService(name string) (*system.ServiceInfo, error) {
+	return &system.ServiceInfo{}, nil
+}
+
+func (fakeSystemAPI) StartService(name string) error {
+	return nil
+}
+
+func (fakeSystemAPI) StopService(name string, force bool) error {
+	return nil
+}
+
+// fakeStateProvider keeps its state in memory.
+type fakeStateProvider struct {
+	state json.RawMessage
+}
+
+func (p *fakeStateProvider) ExportState() (json.RawMessage, error) {
+	return p.state, nil
+}
+
+func (p *fakeStateProvider) ImportState(state json.RawMessage) error {
+	p.state = state
+	return nil
+}
+
+func TestExportImportState(t *testing.T) {
+	v1alpha2, err := apiversion.NewVersion("v1alpha2")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	source := map[string]StateProvider{
+		"config": &fakeStateProvider{state: json.RawMessage(`{"drive-letters":["STUV"]}`)},
+		"mounts": &fakeStateProvider{state: json.RawMessage(`{"volumeID1":{"targetPaths":["C:\\mnt\\a"],"readOnly":false}}`)},
+	}
+	sourceSrv, err := NewServer(&fakeSystemAPI{}, source)
+	if err != nil {
+		t.Fatalf("System server could not be initialized: %v", err)
+	}
+	exported, err := sourceSrv.ExportState(context.TODO(), &internal.ExportStateRequest{}, v1alpha2)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+
+	target := map[string]StateProvider{
+		"config": &fakeStateProvider{},
+		"mounts": &fakeStateProvider{},
+	}
+	targetSrv, err := NewServer(&fakeSystemAPI{}, target)
+	if err != nil {
+		t.Fatalf("System server could not be initialized: %v", err)
+	}
+	_, err = targetSrv.ImportState(context.TODO(), &internal.ImportStateRequest{State: exported.State}, v1alpha2)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	for name, provider := range source {
+		expected := string(provider.(*fakeStateProvider).state)
+		if got := string(target[name].(*fakeStateProvider).state); got != expected {
+			t.Errorf("Expected state of %s to be %s, got %s", name, expected, got)
+		}
+	}
+
+	testCases := []struct {
+		name  string
+		state string
+	}{
+		{
+			name:  "invalid state",
+			state: `not json`,
+		},
+		{
+			name:  "unsupported version",
+			state: `{"version":2,"sections":{}}`,
+		},
+		{
+			name:  "unknown section",
+			state: `{"version":1,"sections":{"mounts":{},"unknown":{}}}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		mounts := &fakeStateProvider{}
+		srv, err := NewServer(&fakeSystemAPI{}, map[string]StateProvider{"mounts": mounts})
+		if err != nil {
+			t.Fatalf("System server could not be initialized: %v", err)
+		}
+		_, err = srv.ImportState(context.TODO(), &internal.ImportStateRequest{State: []byte(tc.state)}, v1alpha2)
+		if err == nil {
+			t.Errorf("Expected error importing %s", tc.state)
+		}
+		if mounts.state != nil {
+			t.Errorf("Expected no section to be imported, got mounts %s", mounts.state)
+		}
+	}
+}
+
+func TestGetCapabilities(t *testing.T) {
+	v1alpha2, err := apiversion.NewVersion("v1alpha2")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	srv, err := NewServer(&fakeSystemAPI{}, nil)
+	if err != nil {
+		t.Fatalf("System server could not be initialized: %v", err)
+	}
+
+	utils.SetCapabilities(utils.Capabilities{
+		InstallationType: "Server Core",
+		Build:            utils.BuildWindowsServer2016,
+		PowerShell:       true,
+		Modules:          []string{utils.ModuleCimCmdlets, utils.ModuleSmbShare},
+	})
+	response, err := srv.GetCapabilities(context.TODO(), &internal.GetCapabilitiesRequest{}, v1alpha2)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if response.InstallationType != "Server Core" || !response.PowershellAvailable {
+		t.Errorf("Unexpected capabilities %+v", response)
+	}
+	expectedMissing := []string{utils.ModuleStorage, utils.ModuleISCSI, utils.ModuleBitLocker, utils.ModuleDeduplication}
+	if !reflect.DeepEqual(response.MissingModules, expectedMissing) {
+		t.Errorf("Expected missing modules %v, got %v", expectedMissing, response.MissingModules)
+	}
+	expectedAvailable := []string{utils.ModuleSmbShare, utils.ModuleCimCmdlets}
+	if !reflect.DeepEqual(response.AvailableModules, expectedAvailable) {
+		t.Errorf("Expected available modules %v, got %v", expectedAvailable, response.AvailableModules)
+	}
+	expectedUnsupported := []string{"Smb/NewSmbGlobalMapping", "Smb/RemoveSmbGlobalMapping"}
+	if response.WindowsBuild != utils.BuildWindowsServer2016 || !reflect.DeepEqual(response.UnsupportedOperations, expectedUnsupported) {
+		t.Errorf("Expected build %d without the operations %v, got %d without %v",
+			utils.BuildWindowsServer2016, expectedUnsupported, response.WindowsBuild, response.UnsupportedOperations)
+	}
+}
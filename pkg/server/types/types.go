@@ -1,6 +1,8 @@
 package types
 
 import (
+	"context"
+
 	"google.golang.org/grpc"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
@@ -17,3 +19,18 @@ type VersionedAPI struct {
 type APIGroup interface {
 	VersionedAPIs() []*VersionedAPI
 }
+
+// WarmUpper is implemented by the API groups that run a cheap query at startup,
+// so that the first operation after a restart doesn't pay for loading the WMI
+// providers and the PowerShell modules that the API group depends on.
+type WarmUpper interface {
+	WarmUp() error
+}
+
+// VolumeLocker is implemented by the API groups that run operations on volumes
+// in the background, e.g. the asynchronous variants of the long-running methods.
+// The server sets the function that locks a volume so that those operations are
+// serialized with the calls of the serialized methods against the same volume.
+type VolumeLocker interface {
+	SetVolumeLock(lock func(ctx context.Context, volumeID string) (unlock func(), err error))
+}
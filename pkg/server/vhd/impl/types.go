@@ -0,0 +1,58 @@
+package impl
+
+type VirtualDiskFormat uint32
+
+const (
+	VIRTUAL_DISK_FORMAT_VHDX VirtualDiskFormat = 0
+	VIRTUAL_DISK_FORMAT_VHD  VirtualDiskFormat = 1
+)
+
+type VirtualDisk struct {
+	Path          string
+	Format        VirtualDiskFormat
+	SizeBytes     uint64
+	FileSizeBytes uint64
+	Attached      bool
+	DiskNumber    uint32
+}
+
+type CreateVirtualDiskRequest struct {
+	Path      string
+	SizeBytes uint64
+	Fixed     bool
+}
+
+type CreateVirtualDiskResponse struct {
+}
+
+type AttachVirtualDiskRequest struct {
+	Path     string
+	ReadOnly bool
+}
+
+type AttachVirtualDiskResponse struct {
+	DiskNumber uint32
+}
+
+type DetachVirtualDiskRequest struct {
+	Path string
+}
+
+type DetachVirtualDiskResponse struct {
+}
+
+type ResizeVirtualDiskRequest struct {
+	Path      string
+	SizeBytes uint64
+}
+
+type ResizeVirtualDiskResponse struct {
+}
+
+type GetVirtualDiskRequest struct {
+	Path string
+}
+
+type GetVirtualDiskResponse struct {
+	VirtualDisk *VirtualDisk
+}
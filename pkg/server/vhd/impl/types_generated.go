@@ -0,0 +1,23 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package impl
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"google.golang.org/grpc"
+)
+
+type VersionedAPI interface {
+	Register(grpcServer *grpc.Server)
+}
+
+// All the functions this group's server needs to define.
+type ServerInterface interface {
+	AttachVirtualDisk(context.Context, *AttachVirtualDiskRequest, apiversion.Version) (*AttachVirtualDiskResponse, error)
+	CreateVirtualDisk(context.Context, *CreateVirtualDiskRequest, apiversion.Version) (*CreateVirtualDiskResponse, error)
+	DetachVirtualDisk(context.Context, *DetachVirtualDiskRequest, apiversion.Version) (*DetachVirtualDiskResponse, error)
+	GetVirtualDisk(context.Context, *GetVirtualDiskRequest, apiversion.Version) (*GetVirtualDiskResponse, error)
+	ResizeVirtualDisk(context.Context, *ResizeVirtualDiskRequest, apiversion.Version) (*ResizeVirtualDiskResponse, error)
+}
@@ -0,0 +1,256 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/kubernetes-csi/csi-proxy/client/api/vhd/v1alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/vhd/impl"
+)
+
+func autoConvert_v1alpha1_AttachVirtualDiskRequest_To_impl_AttachVirtualDiskRequest(in *v1alpha1.AttachVirtualDiskRequest, out *impl.AttachVirtualDiskRequest) error {
+	out.Path = in.Path
+	out.ReadOnly = in.ReadOnly
+	return nil
+}
+
+// Convert_v1alpha1_AttachVirtualDiskRequest_To_impl_AttachVirtualDiskRequest is an autogenerated conversion function.
+func Convert_v1alpha1_AttachVirtualDiskRequest_To_impl_AttachVirtualDiskRequest(in *v1alpha1.AttachVirtualDiskRequest, out *impl.AttachVirtualDiskRequest) error {
+	return autoConvert_v1alpha1_AttachVirtualDiskRequest_To_impl_AttachVirtualDiskRequest(in, out)
+}
+
+func autoConvert_impl_AttachVirtualDiskRequest_To_v1alpha1_AttachVirtualDiskRequest(in *impl.AttachVirtualDiskRequest, out *v1alpha1.AttachVirtualDiskRequest) error {
+	out.Path = in.Path
+	out.ReadOnly = in.ReadOnly
+	return nil
+}
+
+// Convert_impl_AttachVirtualDiskRequest_To_v1alpha1_AttachVirtualDiskRequest is an autogenerated conversion function.
+func Convert_impl_AttachVirtualDiskRequest_To_v1alpha1_AttachVirtualDiskRequest(in *impl.AttachVirtualDiskRequest, out *v1alpha1.AttachVirtualDiskRequest) error {
+	return autoConvert_impl_AttachVirtualDiskRequest_To_v1alpha1_AttachVirtualDiskRequest(in, out)
+}
+
+func autoConvert_v1alpha1_AttachVirtualDiskResponse_To_impl_AttachVirtualDiskResponse(in *v1alpha1.AttachVirtualDiskResponse, out *impl.AttachVirtualDiskResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v1alpha1_AttachVirtualDiskResponse_To_impl_AttachVirtualDiskResponse is an autogenerated conversion function.
+func Convert_v1alpha1_AttachVirtualDiskResponse_To_impl_AttachVirtualDiskResponse(in *v1alpha1.AttachVirtualDiskResponse, out *impl.AttachVirtualDiskResponse) error {
+	return autoConvert_v1alpha1_AttachVirtualDiskResponse_To_impl_AttachVirtualDiskResponse(in, out)
+}
+
+func autoConvert_impl_AttachVirtualDiskResponse_To_v1alpha1_AttachVirtualDiskResponse(in *impl.AttachVirtualDiskResponse, out *v1alpha1.AttachVirtualDiskResponse) error {
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_AttachVirtualDiskResponse_To_v1alpha1_AttachVirtualDiskResponse is an autogenerated conversion function.
+func Convert_impl_AttachVirtualDiskResponse_To_v1alpha1_AttachVirtualDiskResponse(in *impl.AttachVirtualDiskResponse, out *v1alpha1.AttachVirtualDiskResponse) error {
+	return autoConvert_impl_AttachVirtualDiskResponse_To_v1alpha1_AttachVirtualDiskResponse(in, out)
+}
+
+func autoConvert_v1alpha1_CreateVirtualDiskRequest_To_impl_CreateVirtualDiskRequest(in *v1alpha1.CreateVirtualDiskRequest, out *impl.CreateVirtualDiskRequest) error {
+	out.Path = in.Path
+	out.SizeBytes = in.SizeBytes
+	out.Fixed = in.Fixed
+	return nil
+}
+
+// Convert_v1alpha1_CreateVirtualDiskRequest_To_impl_CreateVirtualDiskRequest is an autogenerated conversion function.
+func Convert_v1alpha1_CreateVirtualDiskRequest_To_impl_CreateVirtualDiskRequest(in *v1alpha1.CreateVirtualDiskRequest, out *impl.CreateVirtualDiskRequest) error {
+	return autoConvert_v1alpha1_CreateVirtualDiskRequest_To_impl_CreateVirtualDiskRequest(in, out)
+}
+
+func autoConvert_impl_CreateVirtualDiskRequest_To_v1alpha1_CreateVirtualDiskRequest(in *impl.CreateVirtualDiskRequest, out *v1alpha1.CreateVirtualDiskRequest) error {
+	out.Path = in.Path
+	out.SizeBytes = in.SizeBytes
+	out.Fixed = in.Fixed
+	return nil
+}
+
+// Convert_impl_CreateVirtualDiskRequest_To_v1alpha1_CreateVirtualDiskRequest is an autogenerated conversion function.
+func Convert_impl_CreateVirtualDiskRequest_To_v1alpha1_CreateVirtualDiskRequest(in *impl.CreateVirtualDiskRequest, out *v1alpha1.CreateVirtualDiskRequest) error {
+	return autoConvert_impl_CreateVirtualDiskRequest_To_v1alpha1_CreateVirtualDiskRequest(in, out)
+}
+
+func autoConvert_v1alpha1_CreateVirtualDiskResponse_To_impl_CreateVirtualDiskResponse(in *v1alpha1.CreateVirtualDiskResponse, out *impl.CreateVirtualDiskResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_CreateVirtualDiskResponse_To_impl_CreateVirtualDiskResponse is an autogenerated conversion function.
+func Convert_v1alpha1_CreateVirtualDiskResponse_To_impl_CreateVirtualDiskResponse(in *v1alpha1.CreateVirtualDiskResponse, out *impl.CreateVirtualDiskResponse) error {
+	return autoConvert_v1alpha1_CreateVirtualDiskResponse_To_impl_CreateVirtualDiskResponse(in, out)
+}
+
+func autoConvert_impl_CreateVirtualDiskResponse_To_v1alpha1_CreateVirtualDiskResponse(in *impl.CreateVirtualDiskResponse, out *v1alpha1.CreateVirtualDiskResponse) error {
+	return nil
+}
+
+// Convert_impl_CreateVirtualDiskResponse_To_v1alpha1_CreateVirtualDiskResponse is an autogenerated conversion function.
+func Convert_impl_CreateVirtualDiskResponse_To_v1alpha1_CreateVirtualDiskResponse(in *impl.CreateVirtualDiskResponse, out *v1alpha1.CreateVirtualDiskResponse) error {
+	return autoConvert_impl_CreateVirtualDiskResponse_To_v1alpha1_CreateVirtualDiskResponse(in, out)
+}
+
+func autoConvert_v1alpha1_DetachVirtualDiskRequest_To_impl_DetachVirtualDiskRequest(in *v1alpha1.DetachVirtualDiskRequest, out *impl.DetachVirtualDiskRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1alpha1_DetachVirtualDiskRequest_To_impl_DetachVirtualDiskRequest is an autogenerated conversion function.
+func Convert_v1alpha1_DetachVirtualDiskRequest_To_impl_DetachVirtualDiskRequest(in *v1alpha1.DetachVirtualDiskRequest, out *impl.DetachVirtualDiskRequest) error {
+	return autoConvert_v1alpha1_DetachVirtualDiskRequest_To_impl_DetachVirtualDiskRequest(in, out)
+}
+
+func autoConvert_impl_DetachVirtualDiskRequest_To_v1alpha1_DetachVirtualDiskRequest(in *impl.DetachVirtualDiskRequest, out *v1alpha1.DetachVirtualDiskRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_impl_DetachVirtualDiskRequest_To_v1alpha1_DetachVirtualDiskRequest is an autogenerated conversion function.
+func Convert_impl_DetachVirtualDiskRequest_To_v1alpha1_DetachVirtualDiskRequest(in *impl.DetachVirtualDiskRequest, out *v1alpha1.DetachVirtualDiskRequest) error {
+	return autoConvert_impl_DetachVirtualDiskRequest_To_v1alpha1_DetachVirtualDiskRequest(in, out)
+}
+
+func autoConvert_v1alpha1_DetachVirtualDiskResponse_To_impl_DetachVirtualDiskResponse(in *v1alpha1.DetachVirtualDiskResponse, out *impl.DetachVirtualDiskResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_DetachVirtualDiskResponse_To_impl_DetachVirtualDiskResponse is an autogenerated conversion function.
+func Convert_v1alpha1_DetachVirtualDiskResponse_To_impl_DetachVirtualDiskResponse(in *v1alpha1.DetachVirtualDiskResponse, out *impl.DetachVirtualDiskResponse) error {
+	return autoConvert_v1alpha1_DetachVirtualDiskResponse_To_impl_DetachVirtualDiskResponse(in, out)
+}
+
+func autoConvert_impl_DetachVirtualDiskResponse_To_v1alpha1_DetachVirtualDiskResponse(in *impl.DetachVirtualDiskResponse, out *v1alpha1.DetachVirtualDiskResponse) error {
+	return nil
+}
+
+// Convert_impl_DetachVirtualDiskResponse_To_v1alpha1_DetachVirtualDiskResponse is an autogenerated conversion function.
+func Convert_impl_DetachVirtualDiskResponse_To_v1alpha1_DetachVirtualDiskResponse(in *impl.DetachVirtualDiskResponse, out *v1alpha1.DetachVirtualDiskResponse) error {
+	return autoConvert_impl_DetachVirtualDiskResponse_To_v1alpha1_DetachVirtualDiskResponse(in, out)
+}
+
+func autoConvert_v1alpha1_GetVirtualDiskRequest_To_impl_GetVirtualDiskRequest(in *v1alpha1.GetVirtualDiskRequest, out *impl.GetVirtualDiskRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1alpha1_GetVirtualDiskRequest_To_impl_GetVirtualDiskRequest is an autogenerated conversion function.
+func Convert_v1alpha1_GetVirtualDiskRequest_To_impl_GetVirtualDiskRequest(in *v1alpha1.GetVirtualDiskRequest, out *impl.GetVirtualDiskRequest) error {
+	return autoConvert_v1alpha1_GetVirtualDiskRequest_To_impl_GetVirtualDiskRequest(in, out)
+}
+
+func autoConvert_impl_GetVirtualDiskRequest_To_v1alpha1_GetVirtualDiskRequest(in *impl.GetVirtualDiskRequest, out *v1alpha1.GetVirtualDiskRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_impl_GetVirtualDiskRequest_To_v1alpha1_GetVirtualDiskRequest is an autogenerated conversion function.
+func Convert_impl_GetVirtualDiskRequest_To_v1alpha1_GetVirtualDiskRequest(in *impl.GetVirtualDiskRequest, out *v1alpha1.GetVirtualDiskRequest) error {
+	return autoConvert_impl_GetVirtualDiskRequest_To_v1alpha1_GetVirtualDiskRequest(in, out)
+}
+
+func autoConvert_v1alpha1_GetVirtualDiskResponse_To_impl_GetVirtualDiskResponse(in *v1alpha1.GetVirtualDiskResponse, out *impl.GetVirtualDiskResponse) error {
+	if in.VirtualDisk != nil {
+		in, out := &in.VirtualDisk, &out.VirtualDisk
+		*out = new(impl.VirtualDisk)
+		if err := Convert_v1alpha1_VirtualDisk_To_impl_VirtualDisk(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.VirtualDisk = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_GetVirtualDiskResponse_To_impl_GetVirtualDiskResponse is an autogenerated conversion function.
+func Convert_v1alpha1_GetVirtualDiskResponse_To_impl_GetVirtualDiskResponse(in *v1alpha1.GetVirtualDiskResponse, out *impl.GetVirtualDiskResponse) error {
+	return autoConvert_v1alpha1_GetVirtualDiskResponse_To_impl_GetVirtualDiskResponse(in, out)
+}
+
+func autoConvert_impl_GetVirtualDiskResponse_To_v1alpha1_GetVirtualDiskResponse(in *impl.GetVirtualDiskResponse, out *v1alpha1.GetVirtualDiskResponse) error {
+	if in.VirtualDisk != nil {
+		in, out := &in.VirtualDisk, &out.VirtualDisk
+		*out = new(v1alpha1.VirtualDisk)
+		if err := Convert_impl_VirtualDisk_To_v1alpha1_VirtualDisk(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.VirtualDisk = nil
+	}
+	return nil
+}
+
+// Convert_impl_GetVirtualDiskResponse_To_v1alpha1_GetVirtualDiskResponse is an autogenerated conversion function.
+func Convert_impl_GetVirtualDiskResponse_To_v1alpha1_GetVirtualDiskResponse(in *impl.GetVirtualDiskResponse, out *v1alpha1.GetVirtualDiskResponse) error {
+	return autoConvert_impl_GetVirtualDiskResponse_To_v1alpha1_GetVirtualDiskResponse(in, out)
+}
+
+func autoConvert_v1alpha1_ResizeVirtualDiskRequest_To_impl_ResizeVirtualDiskRequest(in *v1alpha1.ResizeVirtualDiskRequest, out *impl.ResizeVirtualDiskRequest) error {
+	out.Path = in.Path
+	out.SizeBytes = in.SizeBytes
+	return nil
+}
+
+// Convert_v1alpha1_ResizeVirtualDiskRequest_To_impl_ResizeVirtualDiskRequest is an autogenerated conversion function.
+func Convert_v1alpha1_ResizeVirtualDiskRequest_To_impl_ResizeVirtualDiskRequest(in *v1alpha1.ResizeVirtualDiskRequest, out *impl.ResizeVirtualDiskRequest) error {
+	return autoConvert_v1alpha1_ResizeVirtualDiskRequest_To_impl_ResizeVirtualDiskRequest(in, out)
+}
+
+func autoConvert_impl_ResizeVirtualDiskRequest_To_v1alpha1_ResizeVirtualDiskRequest(in *impl.ResizeVirtualDiskRequest, out *v1alpha1.ResizeVirtualDiskRequest) error {
+	out.Path = in.Path
+	out.SizeBytes = in.SizeBytes
+	return nil
+}
+
+// Convert_impl_ResizeVirtualDiskRequest_To_v1alpha1_ResizeVirtualDiskRequest is an autogenerated conversion function.
+func Convert_impl_ResizeVirtualDiskRequest_To_v1alpha1_ResizeVirtualDiskRequest(in *impl.ResizeVirtualDiskRequest, out *v1alpha1.ResizeVirtualDiskRequest) error {
+	return autoConvert_impl_ResizeVirtualDiskRequest_To_v1alpha1_ResizeVirtualDiskRequest(in, out)
+}
+
+func autoConvert_v1alpha1_ResizeVirtualDiskResponse_To_impl_ResizeVirtualDiskResponse(in *v1alpha1.ResizeVirtualDiskResponse, out *impl.ResizeVirtualDiskResponse) error {
+	return nil
+}
+
+// Convert_v1alpha1_ResizeVirtualDiskResponse_To_impl_ResizeVirtualDiskResponse is an autogenerated conversion function.
+func Convert_v1alpha1_ResizeVirtualDiskResponse_To_impl_ResizeVirtualDiskResponse(in *v1alpha1.ResizeVirtualDiskResponse, out *impl.ResizeVirtualDiskResponse) error {
+	return autoConvert_v1alpha1_ResizeVirtualDiskResponse_To_impl_ResizeVirtualDiskResponse(in, out)
+}
+
+func autoConvert_impl_ResizeVirtualDiskResponse_To_v1alpha1_ResizeVirtualDiskResponse(in *impl.ResizeVirtualDiskResponse, out *v1alpha1.ResizeVirtualDiskResponse) error {
+	return nil
+}
+
+// Convert_impl_ResizeVirtualDiskResponse_To_v1alpha1_ResizeVirtualDiskResponse is an autogenerated conversion function.
+func Convert_impl_ResizeVirtualDiskResponse_To_v1alpha1_ResizeVirtualDiskResponse(in *impl.ResizeVirtualDiskResponse, out *v1alpha1.ResizeVirtualDiskResponse) error {
+	return autoConvert_impl_ResizeVirtualDiskResponse_To_v1alpha1_ResizeVirtualDiskResponse(in, out)
+}
+
+func autoConvert_v1alpha1_VirtualDisk_To_impl_VirtualDisk(in *v1alpha1.VirtualDisk, out *impl.VirtualDisk) error {
+	out.Path = in.Path
+	out.Format = impl.VirtualDiskFormat(in.Format)
+	out.SizeBytes = in.SizeBytes
+	out.FileSizeBytes = in.FileSizeBytes
+	out.Attached = in.Attached
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_v1alpha1_VirtualDisk_To_impl_VirtualDisk is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualDisk_To_impl_VirtualDisk(in *v1alpha1.VirtualDisk, out *impl.VirtualDisk) error {
+	return autoConvert_v1alpha1_VirtualDisk_To_impl_VirtualDisk(in, out)
+}
+
+func autoConvert_impl_VirtualDisk_To_v1alpha1_VirtualDisk(in *impl.VirtualDisk, out *v1alpha1.VirtualDisk) error {
+	out.Path = in.Path
+	out.Format = v1alpha1.VirtualDiskFormat(in.Format)
+	out.SizeBytes = in.SizeBytes
+	out.FileSizeBytes = in.FileSizeBytes
+	out.Attached = in.Attached
+	out.DiskNumber = in.DiskNumber
+	return nil
+}
+
+// Convert_impl_VirtualDisk_To_v1alpha1_VirtualDisk is an autogenerated conversion function.
+func Convert_impl_VirtualDisk_To_v1alpha1_VirtualDisk(in *impl.VirtualDisk, out *v1alpha1.VirtualDisk) error {
+	return autoConvert_impl_VirtualDisk_To_v1alpha1_VirtualDisk(in, out)
+}
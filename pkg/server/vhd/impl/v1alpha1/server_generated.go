@@ -0,0 +1,123 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/client/api/vhd/v1alpha1"
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/vhd/impl"
+	"google.golang.org/grpc"
+)
+
+var version = apiversion.NewVersionOrPanic("v1alpha1")
+
+type versionedAPI struct {
+	apiGroupServer impl.ServerInterface
+}
+
+func NewVersionedServer(apiGroupServer impl.ServerInterface) impl.VersionedAPI {
+	return &versionedAPI{
+		apiGroupServer: apiGroupServer,
+	}
+}
+
+func (s *versionedAPI) Register(grpcServer *grpc.Server) {
+	v1alpha1.RegisterVhdServer(grpcServer, s)
+}
+
+func (s *versionedAPI) AttachVirtualDisk(context context.Context, versionedRequest *v1alpha1.AttachVirtualDiskRequest) (*v1alpha1.AttachVirtualDiskResponse, error) {
+	request := &impl.AttachVirtualDiskRequest{}
+	if err := Convert_v1alpha1_AttachVirtualDiskRequest_To_impl_AttachVirtualDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.AttachVirtualDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.AttachVirtualDiskResponse{}
+	if err := Convert_impl_AttachVirtualDiskResponse_To_v1alpha1_AttachVirtualDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) CreateVirtualDisk(context context.Context, versionedRequest *v1alpha1.CreateVirtualDiskRequest) (*v1alpha1.CreateVirtualDiskResponse, error) {
+	request := &impl.CreateVirtualDiskRequest{}
+	if err := Convert_v1alpha1_CreateVirtualDiskRequest_To_impl_CreateVirtualDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.CreateVirtualDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.CreateVirtualDiskResponse{}
+	if err := Convert_impl_CreateVirtualDiskResponse_To_v1alpha1_CreateVirtualDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) DetachVirtualDisk(context context.Context, versionedRequest *v1alpha1.DetachVirtualDiskRequest) (*v1alpha1.DetachVirtualDiskResponse, error) {
+	request := &impl.DetachVirtualDiskRequest{}
+	if err := Convert_v1alpha1_DetachVirtualDiskRequest_To_impl_DetachVirtualDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.DetachVirtualDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.DetachVirtualDiskResponse{}
+	if err := Convert_impl_DetachVirtualDiskResponse_To_v1alpha1_DetachVirtualDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetVirtualDisk(context context.Context, versionedRequest *v1alpha1.GetVirtualDiskRequest) (*v1alpha1.GetVirtualDiskResponse, error) {
+	request := &impl.GetVirtualDiskRequest{}
+	if err := Convert_v1alpha1_GetVirtualDiskRequest_To_impl_GetVirtualDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetVirtualDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.GetVirtualDiskResponse{}
+	if err := Convert_impl_GetVirtualDiskResponse_To_v1alpha1_GetVirtualDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) ResizeVirtualDisk(context context.Context, versionedRequest *v1alpha1.ResizeVirtualDiskRequest) (*v1alpha1.ResizeVirtualDiskResponse, error) {
+	request := &impl.ResizeVirtualDiskRequest{}
+	if err := Convert_v1alpha1_ResizeVirtualDiskRequest_To_impl_ResizeVirtualDiskRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ResizeVirtualDisk(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v1alpha1.ResizeVirtualDiskResponse{}
+	if err := Convert_impl_ResizeVirtualDiskResponse_To_v1alpha1_ResizeVirtualDiskResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
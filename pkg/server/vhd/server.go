@@ -0,0 +1,225 @@
+package vhd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/vhd"
+	fsserver "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/vhd/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// virtualDiskSizeUnit is the unit the sizes of the virtual disks are rounded
+	// up to, VHDX requires a multiple of the sector size.
+	virtualDiskSizeUnit = 1024 * 1024
+	// minVirtualDiskSize is the minimum size of a VHDX.
+	minVirtualDiskSize = 3 * 1024 * 1024
+)
+
+type Server struct {
+	hostAPI  vhd.API
+	fsServer *fsserver.Server
+}
+
+// check that Server implements the ServerInterface
+var _ internal.ServerInterface = &Server{}
+
+// NewServer creates the server of the VHD API group, the paths of the virtual
+// disks are checked against the working directories of `fsServer`.
+func NewServer(hostAPI vhd.API, fsServer *fsserver.Server) (*Server, error) {
+	return &Server{
+		hostAPI:  hostAPI,
+		fsServer: fsServer,
+	}, nil
+}
+
+// validatePath returns an error if `path` isn't a VHD or VHDX file within the
+// working directories.
+func (s *Server) validatePath(path string) error {
+	if path == "" {
+		return fmt.Errorf("path empty")
+	}
+	if _, ok := vhd.FormatOf(path); !ok {
+		return fmt.Errorf("path %s isn't a VHD or VHDX file", path)
+	}
+	return s.fsServer.ValidatePluginPath(path)
+}
+
+// roundUpSize validates the size `sizeBytes` of the field `field` and rounds it
+// up to virtualDiskSizeUnit.
+func roundUpSize(field string, sizeBytes uint64) (int64, error) {
+	size, err := utils.ByteSizeToInt64(field, sizeBytes)
+	if err != nil {
+		return 0, err
+	}
+	if size < minVirtualDiskSize {
+		return 0, fmt.Errorf("%s is %d bytes, a virtual disk must be at least %d bytes", field, size, minVirtualDiskSize)
+	}
+	if rem := size % virtualDiskSizeUnit; rem != 0 {
+		if size > math.MaxInt64-virtualDiskSizeUnit {
+			return 0, &utils.InvalidByteSizeError{Field: field, Value: fmt.Sprint(sizeBytes)}
+		}
+		size += virtualDiskSizeUnit - rem
+	}
+	return size, nil
+}
+
+// getVirtualDisk gets the virtual disk `path`, it fails with NotFound if it doesn't exist.
+func (s *Server) getVirtualDisk(path string) (*vhd.VirtualDisk, error) {
+	d, err := s.hostAPI.GetVirtualDisk(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, status.Errorf(codes.NotFound, "virtual disk %s not found", path)
+	}
+	return d, err
+}
+
+func (s *Server) CreateVirtualDisk(context context.Context, request *internal.CreateVirtualDiskRequest, version apiversion.Version) (*internal.CreateVirtualDiskResponse, error) {
+	klog.V(2).Infof("CreateVirtualDisk: Request: %+v", request)
+	response := &internal.CreateVirtualDiskResponse{}
+
+	path := request.Path
+	if err := s.validatePath(path); err != nil {
+		klog.Errorf("failed CreateVirtualDisk %v", err)
+		return response, err
+	}
+	size, err := roundUpSize("CreateVirtualDiskRequest.SizeBytes", request.SizeBytes)
+	if err != nil {
+		klog.Errorf("failed CreateVirtualDisk %v", err)
+		return response, err
+	}
+
+	// a retry succeeds once the virtual disk is created
+	if d, err := s.hostAPI.GetVirtualDisk(path); err == nil {
+		if d.SizeBytes != size {
+			klog.Errorf("virtual disk %s exists with %d bytes", path, d.SizeBytes)
+			return response, status.Errorf(codes.AlreadyExists, "virtual disk %s exists with a size of %d bytes instead of %d bytes", path, d.SizeBytes, size)
+		}
+		return response, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		klog.Errorf("failed CreateVirtualDisk %v", err)
+		return response, err
+	}
+
+	if err := s.hostAPI.CreateVirtualDisk(path, size, request.Fixed); err != nil {
+		klog.Errorf("failed CreateVirtualDisk %v", err)
+		return response, err
+	}
+	klog.V(2).Infof("CreateVirtualDisk: created virtual disk %s of %d bytes", path, size)
+	return response, nil
+}
+
+func (s *Server) AttachVirtualDisk(context context.Context, request *internal.AttachVirtualDiskRequest, version apiversion.Version) (*internal.AttachVirtualDiskResponse, error) {
+	klog.V(2).Infof("AttachVirtualDisk: Request: %+v", request)
+	response := &internal.AttachVirtualDiskResponse{}
+
+	path := request.Path
+	if err := s.validatePath(path); err != nil {
+		klog.Errorf("failed AttachVirtualDisk %v", err)
+		return response, err
+	}
+	if _, err := s.getVirtualDisk(path); err != nil {
+		klog.Errorf("failed AttachVirtualDisk %v", err)
+		return response, err
+	}
+
+	diskNumber, err := s.hostAPI.AttachVirtualDisk(path, request.ReadOnly)
+	if err != nil {
+		klog.Errorf("failed AttachVirtualDisk %v", err)
+		return response, err
+	}
+	klog.V(2).Infof("AttachVirtualDisk: virtual disk %s attached as disk %d", path, diskNumber)
+	response.DiskNumber = diskNumber
+	return response, nil
+}
+
+func (s *Server) DetachVirtualDisk(context context.Context, request *internal.DetachVirtualDiskRequest, version apiversion.Version) (*internal.DetachVirtualDiskResponse, error) {
+	klog.V(2).Infof("DetachVirtualDisk: Request: %+v", request)
+	response := &internal.DetachVirtualDiskResponse{}
+
+	path := request.Path
+	if err := s.validatePath(path); err != nil {
+		klog.Errorf("failed DetachVirtualDisk %v", err)
+		return response, err
+	}
+
+	if err := s.hostAPI.DetachVirtualDisk(path); err != nil {
+		klog.Errorf("failed DetachVirtualDisk %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) ResizeVirtualDisk(context context.Context, request *internal.ResizeVirtualDiskRequest, version apiversion.Version) (*internal.ResizeVirtualDiskResponse, error) {
+	klog.V(2).Infof("ResizeVirtualDisk: Request: %+v", request)
+	response := &internal.ResizeVirtualDiskResponse{}
+
+	path := request.Path
+	if err := s.validatePath(path); err != nil {
+		klog.Errorf("failed ResizeVirtualDisk %v", err)
+		return response, err
+	}
+	size, err := roundUpSize("ResizeVirtualDiskRequest.SizeBytes", request.SizeBytes)
+	if err != nil {
+		klog.Errorf("failed ResizeVirtualDisk %v", err)
+		return response, err
+	}
+
+	d, err := s.getVirtualDisk(path)
+	if err != nil {
+		klog.Errorf("failed ResizeVirtualDisk %v", err)
+		return response, err
+	}
+	if size == d.SizeBytes {
+		return response, nil
+	}
+	if size < d.SizeBytes {
+		klog.Errorf("virtual disk %s of %d bytes can't be shrunk to %d bytes", path, d.SizeBytes, size)
+		return response, status.Errorf(codes.InvalidArgument, "virtual disk %s of %d bytes can't be shrunk to %d bytes", path, d.SizeBytes, size)
+	}
+
+	if err := s.hostAPI.ResizeVirtualDisk(path, size); err != nil {
+		klog.Errorf("failed ResizeVirtualDisk %v", err)
+		return response, err
+	}
+	klog.V(2).Infof("ResizeVirtualDisk: virtual disk %s resized from %d to %d bytes", path, d.SizeBytes, size)
+	return response, nil
+}
+
+func (s *Server) GetVirtualDisk(context context.Context, request *internal.GetVirtualDiskRequest, version apiversion.Version) (*internal.GetVirtualDiskResponse, error) {
+	klog.V(4).Infof("GetVirtualDisk: Request: %+v", request)
+	response := &internal.GetVirtualDiskResponse{}
+
+	path := request.Path
+	if err := s.validatePath(path); err != nil {
+		klog.Errorf("failed GetVirtualDisk %v", err)
+		return response, err
+	}
+
+	d, err := s.getVirtualDisk(path)
+	if err != nil {
+		klog.Errorf("failed GetVirtualDisk %v", err)
+		return response, err
+	}
+	format := internal.VIRTUAL_DISK_FORMAT_VHDX
+	if d.Format == vhd.FormatVHD {
+		format = internal.VIRTUAL_DISK_FORMAT_VHD
+	}
+	response.VirtualDisk = &internal.VirtualDisk{
+		Path:          d.Path,
+		Format:        format,
+		SizeBytes:     uint64(d.SizeBytes),
+		FileSizeBytes: uint64(d.FileSizeBytes),
+		Attached:      d.Attached,
+		DiskNumber:    d.DiskNumber,
+	}
+	return response, nil
+}
@@ -0,0 +1,174 @@
+package vhd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/vhd"
+	fsserver "github.com/kubernetes-csi/csi-proxy/pkg/server/filesystem"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/vhd/impl"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeVhdAPI struct {
+	disks map[string]*vhd.VirtualDisk
+}
+
+var _ vhd.API = &fakeVhdAPI{}
+
+func (f *fakeVhdAPI) CreateVirtualDisk(path string, sizeBytes int64, fixed bool) error {
+	format, _ := vhd.FormatOf(path)
+	f.disks[path] = &vhd.VirtualDisk{Path: path, Format: format, SizeBytes: sizeBytes}
+	return nil
+}
+
+func (f *fakeVhdAPI) AttachVirtualDisk(path string, readOnly bool) (uint32, error) {
+	f.disks[path].Attached = true
+	f.disks[path].DiskNumber = 3
+	return 3, nil
+}
+
+func (f *fakeVhdAPI) DetachVirtualDisk(path string) error {
+	if d, ok := f.disks[path]; ok {
+		d.Attached = false
+	}
+	return nil
+}
+
+func (f *fakeVhdAPI) ResizeVirtualDisk(path string, sizeBytes int64) error {
+	f.disks[path].SizeBytes = sizeBytes
+	return nil
+}
+
+func (f *fakeVhdAPI) GetVirtualDisk(path string) (*vhd.VirtualDisk, error) {
+	d, ok := f.disks[path]
+	if !ok {
+		return nil, fmt.Errorf("virtual disk %s: %w", path, os.ErrNotExist)
+	}
+	return d, nil
+}
+
+func newTestServer(t *testing.T) (*Server, *fakeVhdAPI) {
+	fsSrv, err := fsserver.NewServer([]string{`C:\var\lib\kubelet`}, nil)
+	if err != nil {
+		t.Fatalf("FileSystem Server could not be initialized for testing: %v", err)
+	}
+	hostAPI := &fakeVhdAPI{disks: map[string]*vhd.VirtualDisk{}}
+	srv, err := NewServer(hostAPI, fsSrv)
+	if err != nil {
+		t.Fatalf("Vhd Server could not be initialized for testing: %v", err)
+	}
+	return srv, hostAPI
+}
+
+func TestCreateVirtualDisk(t *testing.T) {
+	v1alpha1 := apiversion.NewVersionOrPanic("v1alpha1")
+	const path = `C:\var\lib\kubelet\plugins\disk.vhdx`
+	testCases := []struct {
+		name         string
+		path         string
+		sizeBytes    uint64
+		expectedSize int64
+		expectError  bool
+	}{
+		{
+			name:        "outside the working directories",
+			path:        `C:\disk.vhdx`,
+			sizeBytes:   10 * 1024 * 1024,
+			expectError: true,
+		},
+		{
+			name:        "not a virtual disk",
+			path:        `C:\var\lib\kubelet\plugins\disk.img`,
+			sizeBytes:   10 * 1024 * 1024,
+			expectError: true,
+		},
+		{
+			name:        "too small",
+			path:        path,
+			sizeBytes:   1024 * 1024,
+			expectError: true,
+		},
+		{
+			name:         "rounded up",
+			path:         path,
+			sizeBytes:    10*1024*1024 + 1,
+			expectedSize: 11 * 1024 * 1024,
+		},
+		{
+			name:         "retry",
+			path:         path,
+			sizeBytes:    11 * 1024 * 1024,
+			expectedSize: 11 * 1024 * 1024,
+		},
+		{
+			name:        "exists with another size",
+			path:        path,
+			sizeBytes:   20 * 1024 * 1024,
+			expectError: true,
+		},
+	}
+	srv, hostAPI := newTestServer(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &internal.CreateVirtualDiskRequest{Path: tc.path, SizeBytes: tc.sizeBytes}
+			_, err := srv.CreateVirtualDisk(context.TODO(), req, v1alpha1)
+			if tc.expectError && err == nil {
+				t.Fatalf("Expected error but CreateVirtualDisk returned a nil error")
+			}
+			if !tc.expectError && err != nil {
+				t.Fatalf("Expected no errors but CreateVirtualDisk returned error: %v", err)
+			}
+			if !tc.expectError && hostAPI.disks[tc.path].SizeBytes != tc.expectedSize {
+				t.Errorf("expected a size of %d bytes, got %d bytes", tc.expectedSize, hostAPI.disks[tc.path].SizeBytes)
+			}
+		})
+	}
+}
+
+func TestResizeVirtualDisk(t *testing.T) {
+	v1alpha1 := apiversion.NewVersionOrPanic("v1alpha1")
+	const path = `C:\var\lib\kubelet\plugins\disk.vhd`
+	srv, hostAPI := newTestServer(t)
+
+	_, err := srv.ResizeVirtualDisk(context.TODO(), &internal.ResizeVirtualDiskRequest{Path: path, SizeBytes: 20 * 1024 * 1024}, v1alpha1)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound, got %v", err)
+	}
+
+	if _, err := srv.CreateVirtualDisk(context.TODO(), &internal.CreateVirtualDiskRequest{Path: path, SizeBytes: 10 * 1024 * 1024}, v1alpha1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = srv.ResizeVirtualDisk(context.TODO(), &internal.ResizeVirtualDiskRequest{Path: path, SizeBytes: 5 * 1024 * 1024}, v1alpha1)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument when shrinking, got %v", err)
+	}
+	if _, err := srv.ResizeVirtualDisk(context.TODO(), &internal.ResizeVirtualDiskRequest{Path: path, SizeBytes: 20 * 1024 * 1024}, v1alpha1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if size := hostAPI.disks[path].SizeBytes; size != 20*1024*1024 {
+		t.Errorf("expected a size of %d bytes, got %d bytes", 20*1024*1024, size)
+	}
+
+	if _, err := srv.AttachVirtualDisk(context.TODO(), &internal.AttachVirtualDiskRequest{Path: path}, v1alpha1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	response, err := srv.GetVirtualDisk(context.TODO(), &internal.GetVirtualDiskRequest{Path: path}, v1alpha1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := internal.VirtualDisk{
+		Path:       path,
+		Format:     internal.VIRTUAL_DISK_FORMAT_VHD,
+		SizeBytes:  20 * 1024 * 1024,
+		Attached:   true,
+		DiskNumber: 3,
+	}
+	if *response.VirtualDisk != expected {
+		t.Errorf("expected %+v, got %+v", expected, *response.VirtualDisk)
+	}
+}
@@ -0,0 +1,96 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/volume"
+	"k8s.io/klog/v2"
+)
+
+// FencingHook fences a volume before it's mounted on this node when its mount
+// marker shows that it's mounted on another node, e.g. by checking the attach
+// state of the disk in the cloud or by taking a SCSI reservation, so that two
+// nodes never write to the volume at the same time.
+type FencingHook interface {
+	// Fence returns nil once the node of `marker` can't write to the volume
+	// `volumeID` anymore, the volume isn't mounted if it returns an error.
+	Fence(volumeID string, marker volume.MountMarker) error
+}
+
+// fencingCommandTimeout is how long a fencing command can run.
+const fencingCommandTimeout = 2 * time.Minute
+
+// CommandFencingHook is a fencing hook that runs an external command with the
+// volume ID and the node of the mount marker as arguments, the volume is fenced
+// if the command exits with 0.
+type CommandFencingHook struct {
+	Command string
+}
+
+func (h CommandFencingHook) Fence(volumeID string, marker volume.MountMarker) error {
+	ctx, cancel := context.WithTimeout(context.Background(), fencingCommandTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, h.Command, volumeID, marker.Node).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("fencing command %s failed, output: %s, error: %v", h.Command, string(out), err)
+	}
+	return nil
+}
+
+// SetFencingHook enables the fencing of the volumes mounted on other nodes, the
+// volumes mounted read-write get a mount marker with the name `nodeName` of this
+// node and `hook` is called before mounting a volume whose marker has the name of
+// another node.
+func (s *Server) SetFencingHook(nodeName string, hook FencingHook) {
+	s.nodeName = nodeName
+	s.fencingHook = hook
+}
+
+// fence calls the fencing hook if the volume `volumeID` was mounted on another
+// node and wasn't unmounted since.
+func (s *Server) fence(volumeID string) error {
+	if s.fencingHook == nil {
+		return nil
+	}
+	marker, err := s.hostAPI.GetMountMarker(volumeID)
+	if err != nil {
+		return fmt.Errorf("error reading the mount marker of volume %s: %v", volumeID, err)
+	}
+	if marker == nil || strings.EqualFold(marker.Node, s.nodeName) {
+		return nil
+	}
+	klog.Warningf("Volume %s was mounted on node %s at %s, fencing it", volumeID, marker.Node, marker.MountedAt)
+	if err := s.fencingHook.Fence(volumeID, *marker); err != nil {
+		return fmt.Errorf("fencing of volume %s mounted on node %s failed: %v", volumeID, marker.Node, err)
+	}
+	klog.V(2).Infof("Volume %s mounted on node %s was fenced", volumeID, marker.Node)
+	return nil
+}
+
+// markMounted sets the mount marker of the volume `volumeID` to this node.
+// Failures are logged, the volume is mounted anyway.
+func (s *Server) markMounted(volumeID string) {
+	if s.fencingHook == nil {
+		return
+	}
+	marker := &volume.MountMarker{Node: s.nodeName, MountedAt: time.Now().UTC()}
+	if err := s.hostAPI.SetMountMarker(volumeID, marker); err != nil {
+		klog.Warningf("failed to set the mount marker of volume %s: %v", volumeID, err)
+	}
+}
+
+// markUnmounted removes the mount marker of the volume `volumeID` after it was
+// unmounted from its last path, the marker is kept while the volume is still
+// mounted on this node. Failures are logged, the volume is unmounted anyway.
+func (s *Server) markUnmounted(volumeID string) {
+	if s.fencingHook == nil {
+		return
+	}
+	if err := s.hostAPI.SetMountMarker(volumeID, nil); err != nil {
+		klog.Warningf("failed to remove the mount marker of volume %s: %v", volumeID, err)
+	}
+}
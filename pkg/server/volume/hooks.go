@@ -0,0 +1,124 @@
+package volume
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// HookOperation is a volume operation that runs the volume hooks.
+type HookOperation string
+
+const (
+	HookMount   HookOperation = "mount"
+	HookFormat  HookOperation = "format"
+	HookUnmount HookOperation = "unmount"
+)
+
+// HookContext is the context of a volume operation passed to the volume hooks.
+type HookContext struct {
+	Operation HookOperation
+	VolumeID  string
+	// Path is the target path of mount and unmount, empty for format
+	Path string
+}
+
+// VolumeHook runs vendor steps, e.g. warming a cache or registering the volume
+// with an agent, before and after the volumes are mounted, formatted and unmounted.
+type VolumeHook interface {
+	// Before is called before the operation, the operation fails without
+	// running if it returns an error.
+	Before(op HookContext) error
+	// After is called once the operation succeeded, the operation is done
+	// anyway if it returns an error.
+	After(op HookContext) error
+}
+
+// hookCommandTimeout is how long a hook command can run.
+const hookCommandTimeout = 2 * time.Minute
+
+// CommandVolumeHook is a volume hook that runs an external command with the phase
+// ("before" or "after"), the operation, the volume ID and the path as arguments,
+// the hook succeeds if the command exits with 0.
+type CommandVolumeHook struct {
+	Command string
+}
+
+func (h CommandVolumeHook) Before(op HookContext) error {
+	return h.run("before", op)
+}
+
+func (h CommandVolumeHook) After(op HookContext) error {
+	return h.run("after", op)
+}
+
+func (h CommandVolumeHook) run(phase string, op HookContext) error {
+	ctx, cancel := context.WithTimeout(context.Background(), hookCommandTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, h.Command, phase, string(op.Operation), op.VolumeID, op.Path).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("hook command %s failed, output: %s, error: %v", h.Command, string(out), err)
+	}
+	return nil
+}
+
+var (
+	// hookPlugins are the compiled-in volume hooks by name, see RegisterVolumeHook.
+	hookPlugins     = map[string]VolumeHook{}
+	hookPluginsLock sync.Mutex
+)
+
+// RegisterVolumeHook registers the compiled-in volume hook `hook` as `name`, it's
+// usually called in the init function of a vendor package imported by the build.
+// The registered hooks run only once they're enabled with AddVolumeHook.
+func RegisterVolumeHook(name string, hook VolumeHook) {
+	hookPluginsLock.Lock()
+	defer hookPluginsLock.Unlock()
+	if _, ok := hookPlugins[name]; ok {
+		panic(fmt.Sprintf("volume hook %s registered twice", name))
+	}
+	hookPlugins[name] = hook
+}
+
+// VolumeHookPlugin returns the compiled-in volume hook registered as `name`.
+func VolumeHookPlugin(name string) (VolumeHook, bool) {
+	hookPluginsLock.Lock()
+	defer hookPluginsLock.Unlock()
+	hook, ok := hookPlugins[name]
+	return hook, ok
+}
+
+// AddVolumeHook enables the volume hook `hook`, the hooks run in the order they're
+// added.
+func (s *Server) AddVolumeHook(hook VolumeHook) {
+	s.hooks = append(s.hooks, hook)
+}
+
+// runBeforeHooks calls the Before method of the volume hooks, it stops at the
+// first hook that fails.
+func (s *Server) runBeforeHooks(op HookContext) error {
+	for _, hook := range s.hooks {
+		if err := hook.Before(op); err != nil {
+			return fmt.Errorf("%s hook of volume %s failed: %v", op.Operation, op.VolumeID, err)
+		}
+	}
+	return nil
+}
+
+// runAfterHooks calls the After method of the volume hooks and returns the
+// failures as warnings, the operation is done already.
+func (s *Server) runAfterHooks(op HookContext) []string {
+	var warnings []string
+	for _, hook := range s.hooks {
+		if err := hook.After(op); err != nil {
+			warning := fmt.Sprintf("after %s hook of volume %s failed: %v", op.Operation, op.VolumeID, err)
+			klog.Warning(warning)
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings
+}
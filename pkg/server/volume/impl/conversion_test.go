@@ -0,0 +1,75 @@
+package impl_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha1"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl"
+	v2alpha1_impl "github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl/v2alpha1"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestResizeVolume_Conversion_v2alpha1(t *testing.T) {
+	testCases := []struct {
+		in      *v2alpha1.ResizeVolumeRequest
+		wantOut *impl.ResizeVolumeRequest
+		wantErr bool
+	}{
+		{
+			in:      &v2alpha1.ResizeVolumeRequest{VolumeId: "volumeID1", SizeBytes: 1 << 40, AllowShrink: true},
+			wantOut: &impl.ResizeVolumeRequest{VolumeId: "volumeID1", SizeBytes: 1 << 40, AllowShrink: true},
+		},
+		{
+			in:      &v2alpha1.ResizeVolumeRequest{VolumeId: "volumeID1", SizeBytes: math.MaxInt64},
+			wantOut: &impl.ResizeVolumeRequest{VolumeId: "volumeID1", SizeBytes: math.MaxInt64},
+		},
+		{
+			// sentinel values above 8EiB don't wrap around to negative sizes
+			in:      &v2alpha1.ResizeVolumeRequest{VolumeId: "volumeID1", SizeBytes: math.MaxUint64},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		gotOut := &impl.ResizeVolumeRequest{}
+		err := v2alpha1_impl.Convert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(tc.in, gotOut)
+		if tc.wantErr != (err != nil) {
+			t.Errorf("Convert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(%+v): wantErr=%v, got %v", tc.in, tc.wantErr, err)
+			continue
+		}
+		if !tc.wantErr && !cmp.Equal(gotOut, tc.wantOut) {
+			t.Errorf("Convert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(%+v): got %+v, want %+v", tc.in, gotOut, tc.wantOut)
+		}
+	}
+}
+
+func TestGetVolumeStats_Conversion_v2alpha1(t *testing.T) {
+	testCases := []struct {
+		in      *impl.GetVolumeStatsResponse
+		wantOut *v2alpha1.GetVolumeStatsResponse
+		wantErr bool
+	}{
+		{
+			in:      &impl.GetVolumeStatsResponse{TotalBytes: 1 << 30, UsedBytes: 1 << 20, FileSystemType: "NTFS"},
+			wantOut: &v2alpha1.GetVolumeStatsResponse{TotalBytes: 1 << 30, UsedBytes: 1 << 20, FileSystemType: "NTFS"},
+		},
+		{
+			in:      &impl.GetVolumeStatsResponse{TotalBytes: 1 << 30, UsedBytes: -1},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		gotOut := &v2alpha1.GetVolumeStatsResponse{}
+		err := v2alpha1_impl.Convert_impl_GetVolumeStatsResponse_To_v2alpha1_GetVolumeStatsResponse(tc.in, gotOut)
+		if tc.wantErr != (err != nil) {
+			t.Errorf("Convert_impl_GetVolumeStatsResponse_To_v2alpha1_GetVolumeStatsResponse(%+v): wantErr=%v, got %v", tc.in, tc.wantErr, err)
+			continue
+		}
+		if !tc.wantErr && !cmp.Equal(gotOut, tc.wantOut, protocmp.Transform()) {
+			t.Errorf("Convert_impl_GetVolumeStatsResponse_To_v2alpha1_GetVolumeStatsResponse(%+v): got %+v, want %+v", tc.in, gotOut, tc.wantOut)
+		}
+	}
+}
@@ -8,18 +8,87 @@ package impl
 type ListVolumesOnDiskRequest struct {
 	DiskNumber      uint32
 	PartitionNumber uint32
+	// Only the volumes that match the filter, all the volumes if nil
+	Filter *VolumeFilter
 }
 
 type ListVolumesOnDiskResponse struct {
 	VolumeIds []string
+	Volumes   []*VolumeInfo
+}
+
+type ListVolumesRequest struct {
+	// Only the volumes that match the filter, all the volumes if nil
+	Filter *VolumeFilter
+}
+
+type VolumeFilter struct {
+	// Only the formatted volumes
+	FormattedOnly bool
+	// File system of the volumes e.g. NTFS, any file system if empty
+	FileSystem string
+	// Minimum size of the volumes in bytes, no lower bound if 0
+	MinSizeBytes int64
+	// Only the volumes with a drive letter or a mount point
+	WithAccessPathOnly bool
+}
+
+type VolumeInfo struct {
+	VolumeId string
+	// 0 if the volume isn't on a partition of a disk
+	DiskNumber      uint32
+	PartitionNumber uint32
+	SizeBytes       int64
+	AccessPaths     []string
+	// Serial numbers of the file system and of the disk
+	SerialNumber     string
+	DiskSerialNumber string
+	FileSystem       string
+	Label            string
+	// Empty if the volume isn't on a partition of a GPT disk
+	GptName string
+}
+
+type ListVolumesResponse struct {
+	Volumes []*VolumeInfo
+}
+
+type VolumeDiscoveryRule struct {
+	Name         string
+	PathPrefixes []string
+	GptNames     []string
+	Labels       []string
+}
+
+type DiscoverLocalVolumesRequest struct {
+	Rules []*VolumeDiscoveryRule
+}
+
+type DiscoverLocalVolumesResponse struct {
+	Volumes []*VolumeInfo
+	// Names of the rules matched by Volumes, in the same order
+	RuleNames []string
 }
 
 type MountVolumeRequest struct {
-	VolumeId   string
-	TargetPath string
+	VolumeId          string
+	TargetPath        string
+	ReadOnly          bool
+	AssignDriveLetter bool
+	// Create the missing directories of TargetPath with the security descriptor TargetPathSddl
+	CreateTargetPath bool
+	TargetPathSddl   string
+	// Disable the write cache of the disk of the volume
+	WriteThrough bool
+	// Exclude the files of the volume from the Windows Search indexing
+	DisableIndexing bool
 }
 
 type MountVolumeResponse struct {
+	// Drive letter assigned to the volume if AssignDriveLetter was set
+	DriveLetter string
+	// Caveats of the mount that didn't fail it
+	Warnings []string
 }
 
 type IsVolumeFormattedRequest struct {
@@ -28,15 +97,49 @@ type IsVolumeFormattedRequest struct {
 
 type IsVolumeFormattedResponse struct {
 	Formatted bool
+	// File system of the volume, empty if it isn't formatted
+	FileSystemType string
+	// The volume isn't formatted but its first sectors aren't zero
+	Unrecognized bool
 }
 
 type FormatVolumeRequest struct {
 	VolumeId string
+
+	// Allocation unit size in bytes, 0 uses the file system default
+	AllocationUnitSize uint32
+
+	// Label of the file system, empty for no label
+	Label string
+
+	// Full format in the background instead of a quick format
+	FullFormat bool
+
+	// Enable the NTFS compression of the files of the volume
+	Compress bool
+
+	// Format with large file record segments
+	UseLargeFrs bool
+
+	// Disable the write cache of the disk of the volume
+	WriteThrough bool
 }
 
 type FormatVolumeResponse struct {
 }
 
+type GetFormatVolumeStatusRequest struct {
+	VolumeId string
+}
+
+type GetFormatVolumeStatusResponse struct {
+	// True while the full format is running
+	InProgress bool
+
+	// Error of the full format if it failed
+	Error string
+}
+
 type WriteVolumeCacheRequest struct {
 	VolumeId string
 }
@@ -44,29 +147,248 @@ type WriteVolumeCacheRequest struct {
 type WriteVolumeCacheResponse struct {
 }
 
+type WriteVolumeCachesRequest struct {
+	// Volumes to flush, the mounted volumes if empty
+	VolumeIds []string
+}
+
+type VolumeCacheError struct {
+	VolumeId string
+	Error    string
+}
+
+type WriteVolumeCachesResponse struct {
+	// Volumes that failed to flush
+	Errors []*VolumeCacheError
+}
+
+type SyncVolumeRequest struct {
+	VolumeId string
+}
+
+type SyncVolumeResponse struct {
+	// Intentionally empty.
+}
+
+type GetVolumeWriteCacheRequest struct {
+	VolumeId string
+}
+
+type GetVolumeWriteCacheResponse struct {
+	WriteCacheEnabled bool
+}
+
+type PrepareStagingPathRequest struct {
+	StagingPath string
+	Sddl        string
+}
+
+type PrepareStagingPathResponse struct {
+	Created bool
+}
+
+type CreateBoundedDirectoryRequest struct {
+	Path      string
+	SizeBytes uint64
+}
+
+type CreateBoundedDirectoryResponse struct {
+	VolumeId string
+}
+
+type RemoveBoundedDirectoryRequest struct {
+	Path string
+}
+
+type RemoveBoundedDirectoryResponse struct {
+	// Intentionally empty.
+}
+
 type UnmountVolumeRequest struct {
 	VolumeId   string
 	TargetPath string
+	// Dismount the file system forcibly if it has open handles
+	Force bool
+}
+
+type OpenHandle struct {
+	ProcessId   uint32
+	ProcessName string
+	Path        string
 }
 
 type UnmountVolumeResponse struct {
+	OpenHandles []*OpenHandle
 }
 
 type ResizeVolumeRequest struct {
-	VolumeId  string
-	SizeBytes int64
+	VolumeId    string
+	SizeBytes   int64
+	AllowShrink bool
 }
 
 type ResizeVolumeResponse struct {
+	// Caveats of the resize that didn't fail it
+	Warnings []string
 }
 
-type GetVolumeStatsRequest struct {
+type RepairMode uint32
+
+const (
+	REPAIR_MODE_SCAN                 = 0
+	REPAIR_MODE_SPOT_FIX             = 1
+	REPAIR_MODE_OFFLINE_SCAN_AND_FIX = 2
+)
+
+type RepairVolumeRequest struct {
 	VolumeId string
+	Mode     RepairMode
+}
+
+type RepairVolumeResponse struct {
+	// Result reported by Repair-Volume e.g. NoErrorsFound
+	Result string
+}
+
+type OptimizeVolumeRequest struct {
+	VolumeId        string
+	Retrim          bool
+	Defrag          bool
+	SlabConsolidate bool
+}
+
+type OptimizeVolumeResponse struct {
+}
+
+type FormatVolumeAsyncRequest struct {
+	Request *FormatVolumeRequest
+}
+
+type FormatVolumeAsyncResponse struct {
+	OperationId string
+}
+
+type ResizeVolumeAsyncRequest struct {
+	Request *ResizeVolumeRequest
+}
+
+type ResizeVolumeAsyncResponse struct {
+	OperationId string
+}
+
+type RepairVolumeAsyncRequest struct {
+	Request *RepairVolumeRequest
+}
+
+type RepairVolumeAsyncResponse struct {
+	OperationId string
+}
+
+type OptimizeVolumeAsyncRequest struct {
+	Request *OptimizeVolumeRequest
+}
+
+type OptimizeVolumeAsyncResponse struct {
+	OperationId string
+}
+
+type GetOperationRequest struct {
+	OperationId string
+}
+
+type GetOperationResponse struct {
+	Method    string
+	VolumeId  string
+	Done      bool
+	Error     string
+	ErrorCode string
+	// Results of the operations that have one, set once they succeeded
+	ResizeVolumeResult *ResizeVolumeResponse
+	RepairVolumeResult *RepairVolumeResponse
+}
+
+type GetVolumeLabelRequest struct {
+	VolumeId string
+}
+
+type GetVolumeLabelResponse struct {
+	Label string
+}
+
+type GetVolumeHealthRequest struct {
+	VolumeId string
+}
+
+type GetVolumeHealthResponse struct {
+	HealthStatus      string
+	OperationalStatus string
+	// The volume isn't healthy or its operational status isn't OK
+	Abnormal bool
+	// Description of the condition of the volume
+	Message string
+}
+
+type WatchVolumesRequest struct {
+	// Volumes to watch, all the volumes if empty
+	VolumeIds []string
+}
+
+type VolumeEventType uint32
+
+const (
+	VOLUME_EVENT_TYPE_ADDED   = 0
+	VOLUME_EVENT_TYPE_REMOVED = 1
+	VOLUME_EVENT_TYPE_CHANGED = 2
+)
+
+type VolumeEvent struct {
+	Type              VolumeEventType
+	VolumeId          string
+	SizeBytes         int64
+	DriveLetter       string
+	FileSystemType    string
+	HealthStatus      string
+	OperationalStatus string
+}
+
+type GetVolumeSerialNumberRequest struct {
+	VolumeId string
+}
+
+type GetVolumeSerialNumberResponse struct {
+	// Serial number of the file system e.g. 1A2B-3C4D, empty if not formatted
+	SerialNumber string
+}
+
+type SetVolumeLabelRequest struct {
+	VolumeId string
+	Label    string
+}
+
+type SetVolumeLabelResponse struct {
+}
+
+type IsVolumeDirtyRequest struct {
+	VolumeId string
+}
+
+type IsVolumeDirtyResponse struct {
+	Dirty bool
+}
+
+type GetVolumeStatsRequest struct {
+	VolumeId  string
+	SizesOnly bool
 }
 
 type GetVolumeStatsResponse struct {
-	TotalBytes int64
-	UsedBytes  int64
+	TotalBytes        int64
+	UsedBytes         int64
+	FileSystemType    string
+	FileSystemLabel   string
+	HealthStatus      string
+	OperationalStatus string
+	SerialNumber      string
 }
 
 type GetDiskNumberFromVolumeIDRequest struct {
@@ -93,6 +415,69 @@ type GetClosestVolumeIDFromTargetPathResponse struct {
 	VolumeId string
 }
 
+type GetVolumeAccessPathsRequest struct {
+	VolumeId string
+}
+
+type GetVolumeAccessPathsResponse struct {
+	AccessPaths  []string
+	DriveLetters []string
+}
+
+type CleanupVolumeRequest struct {
+	VolumeId              string
+	UsageThresholdPercent uint32
+	RecycleBin            bool
+	TempDirs              []string
+}
+
+type CleanupVolumeResponse struct {
+	CleanedUp       bool
+	UsedBytesBefore int64
+	UsedBytesAfter  int64
+	Warnings        []string
+}
+
+type DeduplicationUsageType uint32
+
+const (
+	DEDUPLICATION_USAGE_TYPE_DEFAULT = 0
+	DEDUPLICATION_USAGE_TYPE_HYPER_V = 1
+	DEDUPLICATION_USAGE_TYPE_BACKUP  = 2
+)
+
+type EnableDeduplicationRequest struct {
+	VolumeId  string
+	UsageType DeduplicationUsageType
+}
+
+type EnableDeduplicationResponse struct {
+}
+
+type GetDeduplicationStatusRequest struct {
+	VolumeId string
+}
+
+type GetDeduplicationStatusResponse struct {
+	Enabled             bool
+	UsageType           string
+	SavedBytes          int64
+	SavingsRate         uint32
+	OptimizedFilesCount uint64
+	InPolicyFilesCount  uint64
+	// Time of the last optimization in seconds since the Unix epoch, 0 if never optimized
+	LastOptimizationTime   int64
+	LastOptimizationResult string
+}
+
+type SetVolumeCompressionRequest struct {
+	VolumeId string
+	Enabled  bool
+}
+
+type SetVolumeCompressionResponse struct {
+}
+
 // These structs are used in APIs less than v1beta3 and rerouted internally
 
 type DismountVolumeRequest struct {
@@ -15,19 +15,46 @@ type VersionedAPI interface {
 
 // All the functions this group's server needs to define.
 type ServerInterface interface {
+	CleanupVolume(context.Context, *CleanupVolumeRequest, apiversion.Version) (*CleanupVolumeResponse, error)
+	CreateBoundedDirectory(context.Context, *CreateBoundedDirectoryRequest, apiversion.Version) (*CreateBoundedDirectoryResponse, error)
+	DiscoverLocalVolumes(context.Context, *DiscoverLocalVolumesRequest, apiversion.Version) (*DiscoverLocalVolumesResponse, error)
 	DismountVolume(context.Context, *DismountVolumeRequest, apiversion.Version) (*DismountVolumeResponse, error)
+	EnableDeduplication(context.Context, *EnableDeduplicationRequest, apiversion.Version) (*EnableDeduplicationResponse, error)
 	FormatVolume(context.Context, *FormatVolumeRequest, apiversion.Version) (*FormatVolumeResponse, error)
+	FormatVolumeAsync(context.Context, *FormatVolumeAsyncRequest, apiversion.Version) (*FormatVolumeAsyncResponse, error)
 	GetClosestVolumeIDFromTargetPath(context.Context, *GetClosestVolumeIDFromTargetPathRequest, apiversion.Version) (*GetClosestVolumeIDFromTargetPathResponse, error)
+	GetDeduplicationStatus(context.Context, *GetDeduplicationStatusRequest, apiversion.Version) (*GetDeduplicationStatusResponse, error)
 	GetDiskNumberFromVolumeID(context.Context, *GetDiskNumberFromVolumeIDRequest, apiversion.Version) (*GetDiskNumberFromVolumeIDResponse, error)
+	GetFormatVolumeStatus(context.Context, *GetFormatVolumeStatusRequest, apiversion.Version) (*GetFormatVolumeStatusResponse, error)
+	GetOperation(context.Context, *GetOperationRequest, apiversion.Version) (*GetOperationResponse, error)
+	GetVolumeAccessPaths(context.Context, *GetVolumeAccessPathsRequest, apiversion.Version) (*GetVolumeAccessPathsResponse, error)
 	GetVolumeDiskNumber(context.Context, *VolumeDiskNumberRequest, apiversion.Version) (*VolumeDiskNumberResponse, error)
+	GetVolumeHealth(context.Context, *GetVolumeHealthRequest, apiversion.Version) (*GetVolumeHealthResponse, error)
 	GetVolumeIDFromMount(context.Context, *VolumeIDFromMountRequest, apiversion.Version) (*VolumeIDFromMountResponse, error)
 	GetVolumeIDFromTargetPath(context.Context, *GetVolumeIDFromTargetPathRequest, apiversion.Version) (*GetVolumeIDFromTargetPathResponse, error)
+	GetVolumeLabel(context.Context, *GetVolumeLabelRequest, apiversion.Version) (*GetVolumeLabelResponse, error)
+	GetVolumeSerialNumber(context.Context, *GetVolumeSerialNumberRequest, apiversion.Version) (*GetVolumeSerialNumberResponse, error)
 	GetVolumeStats(context.Context, *GetVolumeStatsRequest, apiversion.Version) (*GetVolumeStatsResponse, error)
+	GetVolumeWriteCache(context.Context, *GetVolumeWriteCacheRequest, apiversion.Version) (*GetVolumeWriteCacheResponse, error)
+	IsVolumeDirty(context.Context, *IsVolumeDirtyRequest, apiversion.Version) (*IsVolumeDirtyResponse, error)
 	IsVolumeFormatted(context.Context, *IsVolumeFormattedRequest, apiversion.Version) (*IsVolumeFormattedResponse, error)
+	ListVolumes(context.Context, *ListVolumesRequest, apiversion.Version) (*ListVolumesResponse, error)
 	ListVolumesOnDisk(context.Context, *ListVolumesOnDiskRequest, apiversion.Version) (*ListVolumesOnDiskResponse, error)
 	MountVolume(context.Context, *MountVolumeRequest, apiversion.Version) (*MountVolumeResponse, error)
+	OptimizeVolume(context.Context, *OptimizeVolumeRequest, apiversion.Version) (*OptimizeVolumeResponse, error)
+	OptimizeVolumeAsync(context.Context, *OptimizeVolumeAsyncRequest, apiversion.Version) (*OptimizeVolumeAsyncResponse, error)
+	PrepareStagingPath(context.Context, *PrepareStagingPathRequest, apiversion.Version) (*PrepareStagingPathResponse, error)
+	RemoveBoundedDirectory(context.Context, *RemoveBoundedDirectoryRequest, apiversion.Version) (*RemoveBoundedDirectoryResponse, error)
+	RepairVolume(context.Context, *RepairVolumeRequest, apiversion.Version) (*RepairVolumeResponse, error)
+	RepairVolumeAsync(context.Context, *RepairVolumeAsyncRequest, apiversion.Version) (*RepairVolumeAsyncResponse, error)
 	ResizeVolume(context.Context, *ResizeVolumeRequest, apiversion.Version) (*ResizeVolumeResponse, error)
+	ResizeVolumeAsync(context.Context, *ResizeVolumeAsyncRequest, apiversion.Version) (*ResizeVolumeAsyncResponse, error)
+	SetVolumeCompression(context.Context, *SetVolumeCompressionRequest, apiversion.Version) (*SetVolumeCompressionResponse, error)
+	SetVolumeLabel(context.Context, *SetVolumeLabelRequest, apiversion.Version) (*SetVolumeLabelResponse, error)
+	SyncVolume(context.Context, *SyncVolumeRequest, apiversion.Version) (*SyncVolumeResponse, error)
 	UnmountVolume(context.Context, *UnmountVolumeRequest, apiversion.Version) (*UnmountVolumeResponse, error)
 	VolumeStats(context.Context, *VolumeStatsRequest, apiversion.Version) (*VolumeStatsResponse, error)
+	WatchVolumes(context.Context, *WatchVolumesRequest, func(*VolumeEvent) error, apiversion.Version) error
 	WriteVolumeCache(context.Context, *WriteVolumeCacheRequest, apiversion.Version) (*WriteVolumeCacheResponse, error)
+	WriteVolumeCaches(context.Context, *WriteVolumeCachesRequest, apiversion.Version) (*WriteVolumeCachesResponse, error)
 }
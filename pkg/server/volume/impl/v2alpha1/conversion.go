@@ -1,3 +1,369 @@
 package v2alpha1
 
+import (
+	"github.com/kubernetes-csi/csi-proxy/client/api/volume/v2alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+)
+
 // Add manual conversion functions here to override automatic conversion functions
+
+func Convert_impl_ListVolumesResponse_To_v2alpha1_ListVolumesResponse(in *impl.ListVolumesResponse, out *v2alpha1.ListVolumesResponse) error {
+	if in.Volumes != nil {
+		out.Volumes = make([]*v2alpha1.VolumeInfo, len(in.Volumes))
+		for i, val := range in.Volumes {
+			newVal := new(v2alpha1.VolumeInfo)
+			if err := Convert_impl_VolumeInfo_To_v2alpha1_VolumeInfo(val, newVal); err != nil {
+				return err
+			}
+			out.Volumes[i] = newVal
+		}
+	} else {
+		out.Volumes = nil
+	}
+	return nil
+}
+
+func Convert_v2alpha1_ListVolumesResponse_To_impl_ListVolumesResponse(in *v2alpha1.ListVolumesResponse, out *impl.ListVolumesResponse) error {
+	if in.Volumes != nil {
+		out.Volumes = make([]*impl.VolumeInfo, len(in.Volumes))
+		for i, val := range in.Volumes {
+			newVal := new(impl.VolumeInfo)
+			if err := Convert_v2alpha1_VolumeInfo_To_impl_VolumeInfo(val, newVal); err != nil {
+				return err
+			}
+			out.Volumes[i] = newVal
+		}
+	} else {
+		out.Volumes = nil
+	}
+	return nil
+}
+
+func Convert_impl_ListVolumesOnDiskResponse_To_v2alpha1_ListVolumesOnDiskResponse(in *impl.ListVolumesOnDiskResponse, out *v2alpha1.ListVolumesOnDiskResponse) error {
+	out.VolumeIds = in.VolumeIds
+	if in.Volumes != nil {
+		out.Volumes = make([]*v2alpha1.VolumeInfo, len(in.Volumes))
+		for i, val := range in.Volumes {
+			newVal := new(v2alpha1.VolumeInfo)
+			if err := Convert_impl_VolumeInfo_To_v2alpha1_VolumeInfo(val, newVal); err != nil {
+				return err
+			}
+			out.Volumes[i] = newVal
+		}
+	} else {
+		out.Volumes = nil
+	}
+	return nil
+}
+
+func Convert_v2alpha1_ListVolumesOnDiskResponse_To_impl_ListVolumesOnDiskResponse(in *v2alpha1.ListVolumesOnDiskResponse, out *impl.ListVolumesOnDiskResponse) error {
+	out.VolumeIds = in.VolumeIds
+	if in.Volumes != nil {
+		out.Volumes = make([]*impl.VolumeInfo, len(in.Volumes))
+		for i, val := range in.Volumes {
+			newVal := new(impl.VolumeInfo)
+			if err := Convert_v2alpha1_VolumeInfo_To_impl_VolumeInfo(val, newVal); err != nil {
+				return err
+			}
+			out.Volumes[i] = newVal
+		}
+	} else {
+		out.Volumes = nil
+	}
+	return nil
+}
+
+func Convert_impl_UnmountVolumeResponse_To_v2alpha1_UnmountVolumeResponse(in *impl.UnmountVolumeResponse, out *v2alpha1.UnmountVolumeResponse) error {
+	if in.OpenHandles != nil {
+		out.OpenHandles = make([]*v2alpha1.OpenHandle, len(in.OpenHandles))
+		for i, val := range in.OpenHandles {
+			newVal := new(v2alpha1.OpenHandle)
+			if err := Convert_impl_OpenHandle_To_v2alpha1_OpenHandle(val, newVal); err != nil {
+				return err
+			}
+			out.OpenHandles[i] = newVal
+		}
+	} else {
+		out.OpenHandles = nil
+	}
+	return nil
+}
+
+func Convert_v2alpha1_UnmountVolumeResponse_To_impl_UnmountVolumeResponse(in *v2alpha1.UnmountVolumeResponse, out *impl.UnmountVolumeResponse) error {
+	if in.OpenHandles != nil {
+		out.OpenHandles = make([]*impl.OpenHandle, len(in.OpenHandles))
+		for i, val := range in.OpenHandles {
+			newVal := new(impl.OpenHandle)
+			if err := Convert_v2alpha1_OpenHandle_To_impl_OpenHandle(val, newVal); err != nil {
+				return err
+			}
+			out.OpenHandles[i] = newVal
+		}
+	} else {
+		out.OpenHandles = nil
+	}
+	return nil
+}
+
+func Convert_impl_WriteVolumeCachesResponse_To_v2alpha1_WriteVolumeCachesResponse(in *impl.WriteVolumeCachesResponse, out *v2alpha1.WriteVolumeCachesResponse) error {
+	if in.Errors != nil {
+		out.Errors = make([]*v2alpha1.VolumeCacheError, len(in.Errors))
+		for i, val := range in.Errors {
+			newVal := new(v2alpha1.VolumeCacheError)
+			if err := Convert_impl_VolumeCacheError_To_v2alpha1_VolumeCacheError(val, newVal); err != nil {
+				return err
+			}
+			out.Errors[i] = newVal
+		}
+	} else {
+		out.Errors = nil
+	}
+	return nil
+}
+
+func Convert_v2alpha1_WriteVolumeCachesResponse_To_impl_WriteVolumeCachesResponse(in *v2alpha1.WriteVolumeCachesResponse, out *impl.WriteVolumeCachesResponse) error {
+	if in.Errors != nil {
+		out.Errors = make([]*impl.VolumeCacheError, len(in.Errors))
+		for i, val := range in.Errors {
+			newVal := new(impl.VolumeCacheError)
+			if err := Convert_v2alpha1_VolumeCacheError_To_impl_VolumeCacheError(val, newVal); err != nil {
+				return err
+			}
+			out.Errors[i] = newVal
+		}
+	} else {
+		out.Errors = nil
+	}
+	return nil
+}
+
+func Convert_impl_DiscoverLocalVolumesResponse_To_v2alpha1_DiscoverLocalVolumesResponse(in *impl.DiscoverLocalVolumesResponse, out *v2alpha1.DiscoverLocalVolumesResponse) error {
+	if in.Volumes != nil {
+		out.Volumes = make([]*v2alpha1.VolumeInfo, len(in.Volumes))
+		for i, val := range in.Volumes {
+			newVal := new(v2alpha1.VolumeInfo)
+			if err := Convert_impl_VolumeInfo_To_v2alpha1_VolumeInfo(val, newVal); err != nil {
+				return err
+			}
+			out.Volumes[i] = newVal
+		}
+	} else {
+		out.Volumes = nil
+	}
+	out.RuleNames = in.RuleNames
+	return nil
+}
+
+func Convert_v2alpha1_DiscoverLocalVolumesResponse_To_impl_DiscoverLocalVolumesResponse(in *v2alpha1.DiscoverLocalVolumesResponse, out *impl.DiscoverLocalVolumesResponse) error {
+	if in.Volumes != nil {
+		out.Volumes = make([]*impl.VolumeInfo, len(in.Volumes))
+		for i, val := range in.Volumes {
+			newVal := new(impl.VolumeInfo)
+			if err := Convert_v2alpha1_VolumeInfo_To_impl_VolumeInfo(val, newVal); err != nil {
+				return err
+			}
+			out.Volumes[i] = newVal
+		}
+	} else {
+		out.Volumes = nil
+	}
+	out.RuleNames = in.RuleNames
+	return nil
+}
+
+// The sizes in bytes of v2alpha1 are unsigned, the conversions below reject the
+// sizes that don't fit in the int64 sizes of the internal types instead of
+// wrapping them around.
+
+func Convert_v2alpha1_VolumeInfo_To_impl_VolumeInfo(in *v2alpha1.VolumeInfo, out *impl.VolumeInfo) error {
+	var err error
+	out.VolumeId = in.VolumeId
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	if out.SizeBytes, err = utils.ByteSizeToInt64("VolumeInfo.SizeBytes", in.SizeBytes); err != nil {
+		return err
+	}
+	out.AccessPaths = in.AccessPaths
+	out.SerialNumber = in.SerialNumber
+	out.DiskSerialNumber = in.DiskSerialNumber
+	out.FileSystem = in.FileSystem
+	out.Label = in.Label
+	out.GptName = in.GptName
+	return nil
+}
+
+func Convert_impl_VolumeInfo_To_v2alpha1_VolumeInfo(in *impl.VolumeInfo, out *v2alpha1.VolumeInfo) error {
+	var err error
+	out.VolumeId = in.VolumeId
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	if out.SizeBytes, err = utils.ByteSizeFromInt64("VolumeInfo.SizeBytes", in.SizeBytes); err != nil {
+		return err
+	}
+	out.AccessPaths = in.AccessPaths
+	out.SerialNumber = in.SerialNumber
+	out.DiskSerialNumber = in.DiskSerialNumber
+	out.FileSystem = in.FileSystem
+	out.Label = in.Label
+	out.GptName = in.GptName
+	return nil
+}
+
+func Convert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(in *v2alpha1.ResizeVolumeRequest, out *impl.ResizeVolumeRequest) error {
+	var err error
+	out.VolumeId = in.VolumeId
+	if out.SizeBytes, err = utils.ByteSizeToInt64("ResizeVolumeRequest.SizeBytes", in.SizeBytes); err != nil {
+		return err
+	}
+	out.AllowShrink = in.AllowShrink
+	return nil
+}
+
+func Convert_impl_ResizeVolumeRequest_To_v2alpha1_ResizeVolumeRequest(in *impl.ResizeVolumeRequest, out *v2alpha1.ResizeVolumeRequest) error {
+	var err error
+	out.VolumeId = in.VolumeId
+	if out.SizeBytes, err = utils.ByteSizeFromInt64("ResizeVolumeRequest.SizeBytes", in.SizeBytes); err != nil {
+		return err
+	}
+	out.AllowShrink = in.AllowShrink
+	return nil
+}
+
+func Convert_v2alpha1_GetVolumeStatsResponse_To_impl_GetVolumeStatsResponse(in *v2alpha1.GetVolumeStatsResponse, out *impl.GetVolumeStatsResponse) error {
+	var err error
+	if out.TotalBytes, err = utils.ByteSizeToInt64("GetVolumeStatsResponse.TotalBytes", in.TotalBytes); err != nil {
+		return err
+	}
+	if out.UsedBytes, err = utils.ByteSizeToInt64("GetVolumeStatsResponse.UsedBytes", in.UsedBytes); err != nil {
+		return err
+	}
+	out.FileSystemType = in.FileSystemType
+	out.FileSystemLabel = in.FileSystemLabel
+	out.HealthStatus = in.HealthStatus
+	out.OperationalStatus = in.OperationalStatus
+	out.SerialNumber = in.SerialNumber
+	return nil
+}
+
+func Convert_impl_GetVolumeStatsResponse_To_v2alpha1_GetVolumeStatsResponse(in *impl.GetVolumeStatsResponse, out *v2alpha1.GetVolumeStatsResponse) error {
+	var err error
+	if out.TotalBytes, err = utils.ByteSizeFromInt64("GetVolumeStatsResponse.TotalBytes", in.TotalBytes); err != nil {
+		return err
+	}
+	if out.UsedBytes, err = utils.ByteSizeFromInt64("GetVolumeStatsResponse.UsedBytes", in.UsedBytes); err != nil {
+		return err
+	}
+	out.FileSystemType = in.FileSystemType
+	out.FileSystemLabel = in.FileSystemLabel
+	out.HealthStatus = in.HealthStatus
+	out.OperationalStatus = in.OperationalStatus
+	out.SerialNumber = in.SerialNumber
+	return nil
+}
+
+func Convert_v2alpha1_CleanupVolumeResponse_To_impl_CleanupVolumeResponse(in *v2alpha1.CleanupVolumeResponse, out *impl.CleanupVolumeResponse) error {
+	var err error
+	out.CleanedUp = in.CleanedUp
+	if out.UsedBytesBefore, err = utils.ByteSizeToInt64("CleanupVolumeResponse.UsedBytesBefore", in.UsedBytesBefore); err != nil {
+		return err
+	}
+	if out.UsedBytesAfter, err = utils.ByteSizeToInt64("CleanupVolumeResponse.UsedBytesAfter", in.UsedBytesAfter); err != nil {
+		return err
+	}
+	out.Warnings = in.Warnings
+	return nil
+}
+
+func Convert_impl_CleanupVolumeResponse_To_v2alpha1_CleanupVolumeResponse(in *impl.CleanupVolumeResponse, out *v2alpha1.CleanupVolumeResponse) error {
+	var err error
+	out.CleanedUp = in.CleanedUp
+	if out.UsedBytesBefore, err = utils.ByteSizeFromInt64("CleanupVolumeResponse.UsedBytesBefore", in.UsedBytesBefore); err != nil {
+		return err
+	}
+	if out.UsedBytesAfter, err = utils.ByteSizeFromInt64("CleanupVolumeResponse.UsedBytesAfter", in.UsedBytesAfter); err != nil {
+		return err
+	}
+	out.Warnings = in.Warnings
+	return nil
+}
+
+func Convert_v2alpha1_GetDeduplicationStatusResponse_To_impl_GetDeduplicationStatusResponse(in *v2alpha1.GetDeduplicationStatusResponse, out *impl.GetDeduplicationStatusResponse) error {
+	var err error
+	out.Enabled = in.Enabled
+	out.UsageType = in.UsageType
+	if out.SavedBytes, err = utils.ByteSizeToInt64("GetDeduplicationStatusResponse.SavedBytes", in.SavedBytes); err != nil {
+		return err
+	}
+	out.SavingsRate = in.SavingsRate
+	out.OptimizedFilesCount = in.OptimizedFilesCount
+	out.InPolicyFilesCount = in.InPolicyFilesCount
+	out.LastOptimizationTime = in.LastOptimizationTime
+	out.LastOptimizationResult = in.LastOptimizationResult
+	return nil
+}
+
+func Convert_impl_GetDeduplicationStatusResponse_To_v2alpha1_GetDeduplicationStatusResponse(in *impl.GetDeduplicationStatusResponse, out *v2alpha1.GetDeduplicationStatusResponse) error {
+	var err error
+	out.Enabled = in.Enabled
+	out.UsageType = in.UsageType
+	if out.SavedBytes, err = utils.ByteSizeFromInt64("GetDeduplicationStatusResponse.SavedBytes", in.SavedBytes); err != nil {
+		return err
+	}
+	out.SavingsRate = in.SavingsRate
+	out.OptimizedFilesCount = in.OptimizedFilesCount
+	out.InPolicyFilesCount = in.InPolicyFilesCount
+	out.LastOptimizationTime = in.LastOptimizationTime
+	out.LastOptimizationResult = in.LastOptimizationResult
+	return nil
+}
+
+func Convert_v2alpha1_VolumeEvent_To_impl_VolumeEvent(in *v2alpha1.VolumeEvent, out *impl.VolumeEvent) error {
+	var err error
+	out.Type = impl.VolumeEventType(in.Type)
+	out.VolumeId = in.VolumeId
+	if out.SizeBytes, err = utils.ByteSizeToInt64("VolumeEvent.SizeBytes", in.SizeBytes); err != nil {
+		return err
+	}
+	out.DriveLetter = in.DriveLetter
+	out.FileSystemType = in.FileSystemType
+	out.HealthStatus = in.HealthStatus
+	out.OperationalStatus = in.OperationalStatus
+	return nil
+}
+
+func Convert_impl_VolumeEvent_To_v2alpha1_VolumeEvent(in *impl.VolumeEvent, out *v2alpha1.VolumeEvent) error {
+	var err error
+	out.Type = v2alpha1.VolumeEventType(in.Type)
+	out.VolumeId = in.VolumeId
+	if out.SizeBytes, err = utils.ByteSizeFromInt64("VolumeEvent.SizeBytes", in.SizeBytes); err != nil {
+		return err
+	}
+	out.DriveLetter = in.DriveLetter
+	out.FileSystemType = in.FileSystemType
+	out.HealthStatus = in.HealthStatus
+	out.OperationalStatus = in.OperationalStatus
+	return nil
+}
+
+func Convert_v2alpha1_VolumeFilter_To_impl_VolumeFilter(in *v2alpha1.VolumeFilter, out *impl.VolumeFilter) error {
+	var err error
+	out.FormattedOnly = in.FormattedOnly
+	out.FileSystem = in.FileSystem
+	if out.MinSizeBytes, err = utils.ByteSizeToInt64("VolumeFilter.MinSizeBytes", in.MinSizeBytes); err != nil {
+		return err
+	}
+	out.WithAccessPathOnly = in.WithAccessPathOnly
+	return nil
+}
+
+func Convert_impl_VolumeFilter_To_v2alpha1_VolumeFilter(in *impl.VolumeFilter, out *v2alpha1.VolumeFilter) error {
+	var err error
+	out.FormattedOnly = in.FormattedOnly
+	out.FileSystem = in.FileSystem
+	if out.MinSizeBytes, err = utils.ByteSizeFromInt64("VolumeFilter.MinSizeBytes", in.MinSizeBytes); err != nil {
+		return err
+	}
+	out.WithAccessPathOnly = in.WithAccessPathOnly
+	return nil
+}
@@ -9,8 +9,234 @@ import (
 	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl"
 )
 
+func autoConvert_v2alpha1_CleanupVolumeRequest_To_impl_CleanupVolumeRequest(in *v2alpha1.CleanupVolumeRequest, out *impl.CleanupVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.UsageThresholdPercent = in.UsageThresholdPercent
+	out.RecycleBin = in.RecycleBin
+	out.TempDirs = *(*[]string)(unsafe.Pointer(&in.TempDirs))
+	return nil
+}
+
+// Convert_v2alpha1_CleanupVolumeRequest_To_impl_CleanupVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha1_CleanupVolumeRequest_To_impl_CleanupVolumeRequest(in *v2alpha1.CleanupVolumeRequest, out *impl.CleanupVolumeRequest) error {
+	return autoConvert_v2alpha1_CleanupVolumeRequest_To_impl_CleanupVolumeRequest(in, out)
+}
+
+func autoConvert_impl_CleanupVolumeRequest_To_v2alpha1_CleanupVolumeRequest(in *impl.CleanupVolumeRequest, out *v2alpha1.CleanupVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.UsageThresholdPercent = in.UsageThresholdPercent
+	out.RecycleBin = in.RecycleBin
+	out.TempDirs = *(*[]string)(unsafe.Pointer(&in.TempDirs))
+	return nil
+}
+
+// Convert_impl_CleanupVolumeRequest_To_v2alpha1_CleanupVolumeRequest is an autogenerated conversion function.
+func Convert_impl_CleanupVolumeRequest_To_v2alpha1_CleanupVolumeRequest(in *impl.CleanupVolumeRequest, out *v2alpha1.CleanupVolumeRequest) error {
+	return autoConvert_impl_CleanupVolumeRequest_To_v2alpha1_CleanupVolumeRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_CleanupVolumeResponse_To_impl_CleanupVolumeResponse(in *v2alpha1.CleanupVolumeResponse, out *impl.CleanupVolumeResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_CleanupVolumeResponse_To_v2alpha1_CleanupVolumeResponse(in *impl.CleanupVolumeResponse, out *v2alpha1.CleanupVolumeResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_CreateBoundedDirectoryRequest_To_impl_CreateBoundedDirectoryRequest(in *v2alpha1.CreateBoundedDirectoryRequest, out *impl.CreateBoundedDirectoryRequest) error {
+	out.Path = in.Path
+	out.SizeBytes = in.SizeBytes
+	return nil
+}
+
+// Convert_v2alpha1_CreateBoundedDirectoryRequest_To_impl_CreateBoundedDirectoryRequest is an autogenerated conversion function.
+func Convert_v2alpha1_CreateBoundedDirectoryRequest_To_impl_CreateBoundedDirectoryRequest(in *v2alpha1.CreateBoundedDirectoryRequest, out *impl.CreateBoundedDirectoryRequest) error {
+	return autoConvert_v2alpha1_CreateBoundedDirectoryRequest_To_impl_CreateBoundedDirectoryRequest(in, out)
+}
+
+func autoConvert_impl_CreateBoundedDirectoryRequest_To_v2alpha1_CreateBoundedDirectoryRequest(in *impl.CreateBoundedDirectoryRequest, out *v2alpha1.CreateBoundedDirectoryRequest) error {
+	out.Path = in.Path
+	out.SizeBytes = in.SizeBytes
+	return nil
+}
+
+// Convert_impl_CreateBoundedDirectoryRequest_To_v2alpha1_CreateBoundedDirectoryRequest is an autogenerated conversion function.
+func Convert_impl_CreateBoundedDirectoryRequest_To_v2alpha1_CreateBoundedDirectoryRequest(in *impl.CreateBoundedDirectoryRequest, out *v2alpha1.CreateBoundedDirectoryRequest) error {
+	return autoConvert_impl_CreateBoundedDirectoryRequest_To_v2alpha1_CreateBoundedDirectoryRequest(in, out)
+}
+
+func autoConvert_v2alpha1_CreateBoundedDirectoryResponse_To_impl_CreateBoundedDirectoryResponse(in *v2alpha1.CreateBoundedDirectoryResponse, out *impl.CreateBoundedDirectoryResponse) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha1_CreateBoundedDirectoryResponse_To_impl_CreateBoundedDirectoryResponse is an autogenerated conversion function.
+func Convert_v2alpha1_CreateBoundedDirectoryResponse_To_impl_CreateBoundedDirectoryResponse(in *v2alpha1.CreateBoundedDirectoryResponse, out *impl.CreateBoundedDirectoryResponse) error {
+	return autoConvert_v2alpha1_CreateBoundedDirectoryResponse_To_impl_CreateBoundedDirectoryResponse(in, out)
+}
+
+func autoConvert_impl_CreateBoundedDirectoryResponse_To_v2alpha1_CreateBoundedDirectoryResponse(in *impl.CreateBoundedDirectoryResponse, out *v2alpha1.CreateBoundedDirectoryResponse) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_CreateBoundedDirectoryResponse_To_v2alpha1_CreateBoundedDirectoryResponse is an autogenerated conversion function.
+func Convert_impl_CreateBoundedDirectoryResponse_To_v2alpha1_CreateBoundedDirectoryResponse(in *impl.CreateBoundedDirectoryResponse, out *v2alpha1.CreateBoundedDirectoryResponse) error {
+	return autoConvert_impl_CreateBoundedDirectoryResponse_To_v2alpha1_CreateBoundedDirectoryResponse(in, out)
+}
+
+func autoConvert_v2alpha1_DiscoverLocalVolumesRequest_To_impl_DiscoverLocalVolumesRequest(in *v2alpha1.DiscoverLocalVolumesRequest, out *impl.DiscoverLocalVolumesRequest) error {
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]*impl.VolumeDiscoveryRule, len(*in))
+		for i := range *in {
+			if err := Convert_v2alpha1_VolumeDiscoveryRule_To_impl_VolumeDiscoveryRule(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_DiscoverLocalVolumesRequest_To_impl_DiscoverLocalVolumesRequest is an autogenerated conversion function.
+func Convert_v2alpha1_DiscoverLocalVolumesRequest_To_impl_DiscoverLocalVolumesRequest(in *v2alpha1.DiscoverLocalVolumesRequest, out *impl.DiscoverLocalVolumesRequest) error {
+	return autoConvert_v2alpha1_DiscoverLocalVolumesRequest_To_impl_DiscoverLocalVolumesRequest(in, out)
+}
+
+func autoConvert_impl_DiscoverLocalVolumesRequest_To_v2alpha1_DiscoverLocalVolumesRequest(in *impl.DiscoverLocalVolumesRequest, out *v2alpha1.DiscoverLocalVolumesRequest) error {
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]*v2alpha1.VolumeDiscoveryRule, len(*in))
+		for i := range *in {
+			if err := Convert_impl_VolumeDiscoveryRule_To_v2alpha1_VolumeDiscoveryRule(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Rules = nil
+	}
+	return nil
+}
+
+// Convert_impl_DiscoverLocalVolumesRequest_To_v2alpha1_DiscoverLocalVolumesRequest is an autogenerated conversion function.
+func Convert_impl_DiscoverLocalVolumesRequest_To_v2alpha1_DiscoverLocalVolumesRequest(in *impl.DiscoverLocalVolumesRequest, out *v2alpha1.DiscoverLocalVolumesRequest) error {
+	return autoConvert_impl_DiscoverLocalVolumesRequest_To_v2alpha1_DiscoverLocalVolumesRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_DiscoverLocalVolumesResponse_To_impl_DiscoverLocalVolumesResponse(in *v2alpha1.DiscoverLocalVolumesResponse, out *impl.DiscoverLocalVolumesResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_DiscoverLocalVolumesResponse_To_v2alpha1_DiscoverLocalVolumesResponse(in *impl.DiscoverLocalVolumesResponse, out *v2alpha1.DiscoverLocalVolumesResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_EnableDeduplicationRequest_To_impl_EnableDeduplicationRequest(in *v2alpha1.EnableDeduplicationRequest, out *impl.EnableDeduplicationRequest) error {
+	out.VolumeId = in.VolumeId
+	out.UsageType = impl.DeduplicationUsageType(in.UsageType)
+	return nil
+}
+
+// Convert_v2alpha1_EnableDeduplicationRequest_To_impl_EnableDeduplicationRequest is an autogenerated conversion function.
+func Convert_v2alpha1_EnableDeduplicationRequest_To_impl_EnableDeduplicationRequest(in *v2alpha1.EnableDeduplicationRequest, out *impl.EnableDeduplicationRequest) error {
+	return autoConvert_v2alpha1_EnableDeduplicationRequest_To_impl_EnableDeduplicationRequest(in, out)
+}
+
+func autoConvert_impl_EnableDeduplicationRequest_To_v2alpha1_EnableDeduplicationRequest(in *impl.EnableDeduplicationRequest, out *v2alpha1.EnableDeduplicationRequest) error {
+	out.VolumeId = in.VolumeId
+	out.UsageType = v2alpha1.DeduplicationUsageType(in.UsageType)
+	return nil
+}
+
+// Convert_impl_EnableDeduplicationRequest_To_v2alpha1_EnableDeduplicationRequest is an autogenerated conversion function.
+func Convert_impl_EnableDeduplicationRequest_To_v2alpha1_EnableDeduplicationRequest(in *impl.EnableDeduplicationRequest, out *v2alpha1.EnableDeduplicationRequest) error {
+	return autoConvert_impl_EnableDeduplicationRequest_To_v2alpha1_EnableDeduplicationRequest(in, out)
+}
+
+func autoConvert_v2alpha1_EnableDeduplicationResponse_To_impl_EnableDeduplicationResponse(in *v2alpha1.EnableDeduplicationResponse, out *impl.EnableDeduplicationResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_EnableDeduplicationResponse_To_impl_EnableDeduplicationResponse is an autogenerated conversion function.
+func Convert_v2alpha1_EnableDeduplicationResponse_To_impl_EnableDeduplicationResponse(in *v2alpha1.EnableDeduplicationResponse, out *impl.EnableDeduplicationResponse) error {
+	return autoConvert_v2alpha1_EnableDeduplicationResponse_To_impl_EnableDeduplicationResponse(in, out)
+}
+
+func autoConvert_impl_EnableDeduplicationResponse_To_v2alpha1_EnableDeduplicationResponse(in *impl.EnableDeduplicationResponse, out *v2alpha1.EnableDeduplicationResponse) error {
+	return nil
+}
+
+// Convert_impl_EnableDeduplicationResponse_To_v2alpha1_EnableDeduplicationResponse is an autogenerated conversion function.
+func Convert_impl_EnableDeduplicationResponse_To_v2alpha1_EnableDeduplicationResponse(in *impl.EnableDeduplicationResponse, out *v2alpha1.EnableDeduplicationResponse) error {
+	return autoConvert_impl_EnableDeduplicationResponse_To_v2alpha1_EnableDeduplicationResponse(in, out)
+}
+
+func autoConvert_v2alpha1_FormatVolumeAsyncRequest_To_impl_FormatVolumeAsyncRequest(in *v2alpha1.FormatVolumeAsyncRequest, out *impl.FormatVolumeAsyncRequest) error {
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(impl.FormatVolumeRequest)
+		if err := Convert_v2alpha1_FormatVolumeRequest_To_impl_FormatVolumeRequest(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Request = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_FormatVolumeAsyncRequest_To_impl_FormatVolumeAsyncRequest is an autogenerated conversion function.
+func Convert_v2alpha1_FormatVolumeAsyncRequest_To_impl_FormatVolumeAsyncRequest(in *v2alpha1.FormatVolumeAsyncRequest, out *impl.FormatVolumeAsyncRequest) error {
+	return autoConvert_v2alpha1_FormatVolumeAsyncRequest_To_impl_FormatVolumeAsyncRequest(in, out)
+}
+
+func autoConvert_impl_FormatVolumeAsyncRequest_To_v2alpha1_FormatVolumeAsyncRequest(in *impl.FormatVolumeAsyncRequest, out *v2alpha1.FormatVolumeAsyncRequest) error {
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(v2alpha1.FormatVolumeRequest)
+		if err := Convert_impl_FormatVolumeRequest_To_v2alpha1_FormatVolumeRequest(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Request = nil
+	}
+	return nil
+}
+
+// Convert_impl_FormatVolumeAsyncRequest_To_v2alpha1_FormatVolumeAsyncRequest is an autogenerated conversion function.
+func Convert_impl_FormatVolumeAsyncRequest_To_v2alpha1_FormatVolumeAsyncRequest(in *impl.FormatVolumeAsyncRequest, out *v2alpha1.FormatVolumeAsyncRequest) error {
+	return autoConvert_impl_FormatVolumeAsyncRequest_To_v2alpha1_FormatVolumeAsyncRequest(in, out)
+}
+
+func autoConvert_v2alpha1_FormatVolumeAsyncResponse_To_impl_FormatVolumeAsyncResponse(in *v2alpha1.FormatVolumeAsyncResponse, out *impl.FormatVolumeAsyncResponse) error {
+	out.OperationId = in.OperationId
+	return nil
+}
+
+// Convert_v2alpha1_FormatVolumeAsyncResponse_To_impl_FormatVolumeAsyncResponse is an autogenerated conversion function.
+func Convert_v2alpha1_FormatVolumeAsyncResponse_To_impl_FormatVolumeAsyncResponse(in *v2alpha1.FormatVolumeAsyncResponse, out *impl.FormatVolumeAsyncResponse) error {
+	return autoConvert_v2alpha1_FormatVolumeAsyncResponse_To_impl_FormatVolumeAsyncResponse(in, out)
+}
+
+func autoConvert_impl_FormatVolumeAsyncResponse_To_v2alpha1_FormatVolumeAsyncResponse(in *impl.FormatVolumeAsyncResponse, out *v2alpha1.FormatVolumeAsyncResponse) error {
+	out.OperationId = in.OperationId
+	return nil
+}
+
+// Convert_impl_FormatVolumeAsyncResponse_To_v2alpha1_FormatVolumeAsyncResponse is an autogenerated conversion function.
+func Convert_impl_FormatVolumeAsyncResponse_To_v2alpha1_FormatVolumeAsyncResponse(in *impl.FormatVolumeAsyncResponse, out *v2alpha1.FormatVolumeAsyncResponse) error {
+	return autoConvert_impl_FormatVolumeAsyncResponse_To_v2alpha1_FormatVolumeAsyncResponse(in, out)
+}
+
 func autoConvert_v2alpha1_FormatVolumeRequest_To_impl_FormatVolumeRequest(in *v2alpha1.FormatVolumeRequest, out *impl.FormatVolumeRequest) error {
 	out.VolumeId = in.VolumeId
+	out.AllocationUnitSize = in.AllocationUnitSize
+	out.Label = in.Label
+	out.FullFormat = in.FullFormat
+	out.Compress = in.Compress
+	out.UseLargeFrs = in.UseLargeFrs
+	out.WriteThrough = in.WriteThrough
 	return nil
 }
 
@@ -21,6 +247,12 @@ func Convert_v2alpha1_FormatVolumeRequest_To_impl_FormatVolumeRequest(in *v2alph
 
 func autoConvert_impl_FormatVolumeRequest_To_v2alpha1_FormatVolumeRequest(in *impl.FormatVolumeRequest, out *v2alpha1.FormatVolumeRequest) error {
 	out.VolumeId = in.VolumeId
+	out.AllocationUnitSize = in.AllocationUnitSize
+	out.Label = in.Label
+	out.FullFormat = in.FullFormat
+	out.Compress = in.Compress
+	out.UseLargeFrs = in.UseLargeFrs
+	out.WriteThrough = in.WriteThrough
 	return nil
 }
 
@@ -87,6 +319,34 @@ func Convert_impl_GetClosestVolumeIDFromTargetPathResponse_To_v2alpha1_GetCloses
 	return autoConvert_impl_GetClosestVolumeIDFromTargetPathResponse_To_v2alpha1_GetClosestVolumeIDFromTargetPathResponse(in, out)
 }
 
+func autoConvert_v2alpha1_GetDeduplicationStatusRequest_To_impl_GetDeduplicationStatusRequest(in *v2alpha1.GetDeduplicationStatusRequest, out *impl.GetDeduplicationStatusRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha1_GetDeduplicationStatusRequest_To_impl_GetDeduplicationStatusRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetDeduplicationStatusRequest_To_impl_GetDeduplicationStatusRequest(in *v2alpha1.GetDeduplicationStatusRequest, out *impl.GetDeduplicationStatusRequest) error {
+	return autoConvert_v2alpha1_GetDeduplicationStatusRequest_To_impl_GetDeduplicationStatusRequest(in, out)
+}
+
+func autoConvert_impl_GetDeduplicationStatusRequest_To_v2alpha1_GetDeduplicationStatusRequest(in *impl.GetDeduplicationStatusRequest, out *v2alpha1.GetDeduplicationStatusRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_GetDeduplicationStatusRequest_To_v2alpha1_GetDeduplicationStatusRequest is an autogenerated conversion function.
+func Convert_impl_GetDeduplicationStatusRequest_To_v2alpha1_GetDeduplicationStatusRequest(in *impl.GetDeduplicationStatusRequest, out *v2alpha1.GetDeduplicationStatusRequest) error {
+	return autoConvert_impl_GetDeduplicationStatusRequest_To_v2alpha1_GetDeduplicationStatusRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_GetDeduplicationStatusResponse_To_impl_GetDeduplicationStatusResponse(in *v2alpha1.GetDeduplicationStatusResponse, out *impl.GetDeduplicationStatusResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_GetDeduplicationStatusResponse_To_v2alpha1_GetDeduplicationStatusResponse(in *impl.GetDeduplicationStatusResponse, out *v2alpha1.GetDeduplicationStatusResponse) error
+// skipping generation of the auto function
+
 func autoConvert_v2alpha1_GetDiskNumberFromVolumeIDRequest_To_impl_GetDiskNumberFromVolumeIDRequest(in *v2alpha1.GetDiskNumberFromVolumeIDRequest, out *impl.GetDiskNumberFromVolumeIDRequest) error {
 	out.VolumeId = in.VolumeId
 	return nil
@@ -127,324 +387,1332 @@ func Convert_impl_GetDiskNumberFromVolumeIDResponse_To_v2alpha1_GetDiskNumberFro
 	return autoConvert_impl_GetDiskNumberFromVolumeIDResponse_To_v2alpha1_GetDiskNumberFromVolumeIDResponse(in, out)
 }
 
-func autoConvert_v2alpha1_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(in *v2alpha1.GetVolumeIDFromTargetPathRequest, out *impl.GetVolumeIDFromTargetPathRequest) error {
-	out.TargetPath = in.TargetPath
+func autoConvert_v2alpha1_GetFormatVolumeStatusRequest_To_impl_GetFormatVolumeStatusRequest(in *v2alpha1.GetFormatVolumeStatusRequest, out *impl.GetFormatVolumeStatusRequest) error {
+	out.VolumeId = in.VolumeId
 	return nil
 }
 
-// Convert_v2alpha1_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest is an autogenerated conversion function.
-func Convert_v2alpha1_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(in *v2alpha1.GetVolumeIDFromTargetPathRequest, out *impl.GetVolumeIDFromTargetPathRequest) error {
-	return autoConvert_v2alpha1_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(in, out)
+// Convert_v2alpha1_GetFormatVolumeStatusRequest_To_impl_GetFormatVolumeStatusRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetFormatVolumeStatusRequest_To_impl_GetFormatVolumeStatusRequest(in *v2alpha1.GetFormatVolumeStatusRequest, out *impl.GetFormatVolumeStatusRequest) error {
+	return autoConvert_v2alpha1_GetFormatVolumeStatusRequest_To_impl_GetFormatVolumeStatusRequest(in, out)
 }
 
-func autoConvert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha1_GetVolumeIDFromTargetPathRequest(in *impl.GetVolumeIDFromTargetPathRequest, out *v2alpha1.GetVolumeIDFromTargetPathRequest) error {
-	out.TargetPath = in.TargetPath
+func autoConvert_impl_GetFormatVolumeStatusRequest_To_v2alpha1_GetFormatVolumeStatusRequest(in *impl.GetFormatVolumeStatusRequest, out *v2alpha1.GetFormatVolumeStatusRequest) error {
+	out.VolumeId = in.VolumeId
 	return nil
 }
 
-// Convert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha1_GetVolumeIDFromTargetPathRequest is an autogenerated conversion function.
-func Convert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha1_GetVolumeIDFromTargetPathRequest(in *impl.GetVolumeIDFromTargetPathRequest, out *v2alpha1.GetVolumeIDFromTargetPathRequest) error {
-	return autoConvert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha1_GetVolumeIDFromTargetPathRequest(in, out)
+// Convert_impl_GetFormatVolumeStatusRequest_To_v2alpha1_GetFormatVolumeStatusRequest is an autogenerated conversion function.
+func Convert_impl_GetFormatVolumeStatusRequest_To_v2alpha1_GetFormatVolumeStatusRequest(in *impl.GetFormatVolumeStatusRequest, out *v2alpha1.GetFormatVolumeStatusRequest) error {
+	return autoConvert_impl_GetFormatVolumeStatusRequest_To_v2alpha1_GetFormatVolumeStatusRequest(in, out)
 }
 
-func autoConvert_v2alpha1_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse(in *v2alpha1.GetVolumeIDFromTargetPathResponse, out *impl.GetVolumeIDFromTargetPathResponse) error {
-	out.VolumeId = in.VolumeId
+func autoConvert_v2alpha1_GetFormatVolumeStatusResponse_To_impl_GetFormatVolumeStatusResponse(in *v2alpha1.GetFormatVolumeStatusResponse, out *impl.GetFormatVolumeStatusResponse) error {
+	out.InProgress = in.InProgress
+	out.Error = in.Error
 	return nil
 }
 
-// Convert_v2alpha1_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse is an autogenerated conversion function.
-func Convert_v2alpha1_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse(in *v2alpha1.GetVolumeIDFromTargetPathResponse, out *impl.GetVolumeIDFromTargetPathResponse) error {
-	return autoConvert_v2alpha1_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse(in, out)
+// Convert_v2alpha1_GetFormatVolumeStatusResponse_To_impl_GetFormatVolumeStatusResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetFormatVolumeStatusResponse_To_impl_GetFormatVolumeStatusResponse(in *v2alpha1.GetFormatVolumeStatusResponse, out *impl.GetFormatVolumeStatusResponse) error {
+	return autoConvert_v2alpha1_GetFormatVolumeStatusResponse_To_impl_GetFormatVolumeStatusResponse(in, out)
 }
 
-func autoConvert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha1_GetVolumeIDFromTargetPathResponse(in *impl.GetVolumeIDFromTargetPathResponse, out *v2alpha1.GetVolumeIDFromTargetPathResponse) error {
-	out.VolumeId = in.VolumeId
+func autoConvert_impl_GetFormatVolumeStatusResponse_To_v2alpha1_GetFormatVolumeStatusResponse(in *impl.GetFormatVolumeStatusResponse, out *v2alpha1.GetFormatVolumeStatusResponse) error {
+	out.InProgress = in.InProgress
+	out.Error = in.Error
 	return nil
 }
 
-// Convert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha1_GetVolumeIDFromTargetPathResponse is an autogenerated conversion function.
-func Convert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha1_GetVolumeIDFromTargetPathResponse(in *impl.GetVolumeIDFromTargetPathResponse, out *v2alpha1.GetVolumeIDFromTargetPathResponse) error {
-	return autoConvert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha1_GetVolumeIDFromTargetPathResponse(in, out)
+// Convert_impl_GetFormatVolumeStatusResponse_To_v2alpha1_GetFormatVolumeStatusResponse is an autogenerated conversion function.
+func Convert_impl_GetFormatVolumeStatusResponse_To_v2alpha1_GetFormatVolumeStatusResponse(in *impl.GetFormatVolumeStatusResponse, out *v2alpha1.GetFormatVolumeStatusResponse) error {
+	return autoConvert_impl_GetFormatVolumeStatusResponse_To_v2alpha1_GetFormatVolumeStatusResponse(in, out)
 }
 
-func autoConvert_v2alpha1_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(in *v2alpha1.GetVolumeStatsRequest, out *impl.GetVolumeStatsRequest) error {
-	out.VolumeId = in.VolumeId
+func autoConvert_v2alpha1_GetOperationRequest_To_impl_GetOperationRequest(in *v2alpha1.GetOperationRequest, out *impl.GetOperationRequest) error {
+	out.OperationId = in.OperationId
 	return nil
 }
 
-// Convert_v2alpha1_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest is an autogenerated conversion function.
-func Convert_v2alpha1_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(in *v2alpha1.GetVolumeStatsRequest, out *impl.GetVolumeStatsRequest) error {
-	return autoConvert_v2alpha1_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(in, out)
+// Convert_v2alpha1_GetOperationRequest_To_impl_GetOperationRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetOperationRequest_To_impl_GetOperationRequest(in *v2alpha1.GetOperationRequest, out *impl.GetOperationRequest) error {
+	return autoConvert_v2alpha1_GetOperationRequest_To_impl_GetOperationRequest(in, out)
 }
 
-func autoConvert_impl_GetVolumeStatsRequest_To_v2alpha1_GetVolumeStatsRequest(in *impl.GetVolumeStatsRequest, out *v2alpha1.GetVolumeStatsRequest) error {
-	out.VolumeId = in.VolumeId
+func autoConvert_impl_GetOperationRequest_To_v2alpha1_GetOperationRequest(in *impl.GetOperationRequest, out *v2alpha1.GetOperationRequest) error {
+	out.OperationId = in.OperationId
 	return nil
 }
 
-// Convert_impl_GetVolumeStatsRequest_To_v2alpha1_GetVolumeStatsRequest is an autogenerated conversion function.
-func Convert_impl_GetVolumeStatsRequest_To_v2alpha1_GetVolumeStatsRequest(in *impl.GetVolumeStatsRequest, out *v2alpha1.GetVolumeStatsRequest) error {
-	return autoConvert_impl_GetVolumeStatsRequest_To_v2alpha1_GetVolumeStatsRequest(in, out)
+// Convert_impl_GetOperationRequest_To_v2alpha1_GetOperationRequest is an autogenerated conversion function.
+func Convert_impl_GetOperationRequest_To_v2alpha1_GetOperationRequest(in *impl.GetOperationRequest, out *v2alpha1.GetOperationRequest) error {
+	return autoConvert_impl_GetOperationRequest_To_v2alpha1_GetOperationRequest(in, out)
 }
 
-func autoConvert_v2alpha1_GetVolumeStatsResponse_To_impl_GetVolumeStatsResponse(in *v2alpha1.GetVolumeStatsResponse, out *impl.GetVolumeStatsResponse) error {
-	out.TotalBytes = in.TotalBytes
-	out.UsedBytes = in.UsedBytes
+func autoConvert_v2alpha1_GetOperationResponse_To_impl_GetOperationResponse(in *v2alpha1.GetOperationResponse, out *impl.GetOperationResponse) error {
+	out.Method = in.Method
+	out.VolumeId = in.VolumeId
+	out.Done = in.Done
+	out.Error = in.Error
+	out.ErrorCode = in.ErrorCode
+	if in.ResizeVolumeResult != nil {
+		in, out := &in.ResizeVolumeResult, &out.ResizeVolumeResult
+		*out = new(impl.ResizeVolumeResponse)
+		if err := Convert_v2alpha1_ResizeVolumeResponse_To_impl_ResizeVolumeResponse(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.ResizeVolumeResult = nil
+	}
+	if in.RepairVolumeResult != nil {
+		in, out := &in.RepairVolumeResult, &out.RepairVolumeResult
+		*out = new(impl.RepairVolumeResponse)
+		if err := Convert_v2alpha1_RepairVolumeResponse_To_impl_RepairVolumeResponse(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.RepairVolumeResult = nil
+	}
 	return nil
 }
 
-// Convert_v2alpha1_GetVolumeStatsResponse_To_impl_GetVolumeStatsResponse is an autogenerated conversion function.
-func Convert_v2alpha1_GetVolumeStatsResponse_To_impl_GetVolumeStatsResponse(in *v2alpha1.GetVolumeStatsResponse, out *impl.GetVolumeStatsResponse) error {
-	return autoConvert_v2alpha1_GetVolumeStatsResponse_To_impl_GetVolumeStatsResponse(in, out)
+// Convert_v2alpha1_GetOperationResponse_To_impl_GetOperationResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetOperationResponse_To_impl_GetOperationResponse(in *v2alpha1.GetOperationResponse, out *impl.GetOperationResponse) error {
+	return autoConvert_v2alpha1_GetOperationResponse_To_impl_GetOperationResponse(in, out)
 }
 
-func autoConvert_impl_GetVolumeStatsResponse_To_v2alpha1_GetVolumeStatsResponse(in *impl.GetVolumeStatsResponse, out *v2alpha1.GetVolumeStatsResponse) error {
-	out.TotalBytes = in.TotalBytes
-	out.UsedBytes = in.UsedBytes
+func autoConvert_impl_GetOperationResponse_To_v2alpha1_GetOperationResponse(in *impl.GetOperationResponse, out *v2alpha1.GetOperationResponse) error {
+	out.Method = in.Method
+	out.VolumeId = in.VolumeId
+	out.Done = in.Done
+	out.Error = in.Error
+	out.ErrorCode = in.ErrorCode
+	if in.ResizeVolumeResult != nil {
+		in, out := &in.ResizeVolumeResult, &out.ResizeVolumeResult
+		*out = new(v2alpha1.ResizeVolumeResponse)
+		if err := Convert_impl_ResizeVolumeResponse_To_v2alpha1_ResizeVolumeResponse(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.ResizeVolumeResult = nil
+	}
+	if in.RepairVolumeResult != nil {
+		in, out := &in.RepairVolumeResult, &out.RepairVolumeResult
+		*out = new(v2alpha1.RepairVolumeResponse)
+		if err := Convert_impl_RepairVolumeResponse_To_v2alpha1_RepairVolumeResponse(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.RepairVolumeResult = nil
+	}
 	return nil
 }
 
-// Convert_impl_GetVolumeStatsResponse_To_v2alpha1_GetVolumeStatsResponse is an autogenerated conversion function.
-func Convert_impl_GetVolumeStatsResponse_To_v2alpha1_GetVolumeStatsResponse(in *impl.GetVolumeStatsResponse, out *v2alpha1.GetVolumeStatsResponse) error {
-	return autoConvert_impl_GetVolumeStatsResponse_To_v2alpha1_GetVolumeStatsResponse(in, out)
+// Convert_impl_GetOperationResponse_To_v2alpha1_GetOperationResponse is an autogenerated conversion function.
+func Convert_impl_GetOperationResponse_To_v2alpha1_GetOperationResponse(in *impl.GetOperationResponse, out *v2alpha1.GetOperationResponse) error {
+	return autoConvert_impl_GetOperationResponse_To_v2alpha1_GetOperationResponse(in, out)
 }
 
-func autoConvert_v2alpha1_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(in *v2alpha1.IsVolumeFormattedRequest, out *impl.IsVolumeFormattedRequest) error {
+func autoConvert_v2alpha1_GetVolumeAccessPathsRequest_To_impl_GetVolumeAccessPathsRequest(in *v2alpha1.GetVolumeAccessPathsRequest, out *impl.GetVolumeAccessPathsRequest) error {
 	out.VolumeId = in.VolumeId
 	return nil
 }
 
-// Convert_v2alpha1_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest is an autogenerated conversion function.
-func Convert_v2alpha1_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(in *v2alpha1.IsVolumeFormattedRequest, out *impl.IsVolumeFormattedRequest) error {
-	return autoConvert_v2alpha1_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(in, out)
+// Convert_v2alpha1_GetVolumeAccessPathsRequest_To_impl_GetVolumeAccessPathsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeAccessPathsRequest_To_impl_GetVolumeAccessPathsRequest(in *v2alpha1.GetVolumeAccessPathsRequest, out *impl.GetVolumeAccessPathsRequest) error {
+	return autoConvert_v2alpha1_GetVolumeAccessPathsRequest_To_impl_GetVolumeAccessPathsRequest(in, out)
 }
 
-func autoConvert_impl_IsVolumeFormattedRequest_To_v2alpha1_IsVolumeFormattedRequest(in *impl.IsVolumeFormattedRequest, out *v2alpha1.IsVolumeFormattedRequest) error {
+func autoConvert_impl_GetVolumeAccessPathsRequest_To_v2alpha1_GetVolumeAccessPathsRequest(in *impl.GetVolumeAccessPathsRequest, out *v2alpha1.GetVolumeAccessPathsRequest) error {
 	out.VolumeId = in.VolumeId
 	return nil
 }
 
-// Convert_impl_IsVolumeFormattedRequest_To_v2alpha1_IsVolumeFormattedRequest is an autogenerated conversion function.
-func Convert_impl_IsVolumeFormattedRequest_To_v2alpha1_IsVolumeFormattedRequest(in *impl.IsVolumeFormattedRequest, out *v2alpha1.IsVolumeFormattedRequest) error {
-	return autoConvert_impl_IsVolumeFormattedRequest_To_v2alpha1_IsVolumeFormattedRequest(in, out)
+// Convert_impl_GetVolumeAccessPathsRequest_To_v2alpha1_GetVolumeAccessPathsRequest is an autogenerated conversion function.
+func Convert_impl_GetVolumeAccessPathsRequest_To_v2alpha1_GetVolumeAccessPathsRequest(in *impl.GetVolumeAccessPathsRequest, out *v2alpha1.GetVolumeAccessPathsRequest) error {
+	return autoConvert_impl_GetVolumeAccessPathsRequest_To_v2alpha1_GetVolumeAccessPathsRequest(in, out)
 }
 
-func autoConvert_v2alpha1_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse(in *v2alpha1.IsVolumeFormattedResponse, out *impl.IsVolumeFormattedResponse) error {
-	out.Formatted = in.Formatted
+func autoConvert_v2alpha1_GetVolumeAccessPathsResponse_To_impl_GetVolumeAccessPathsResponse(in *v2alpha1.GetVolumeAccessPathsResponse, out *impl.GetVolumeAccessPathsResponse) error {
+	out.AccessPaths = *(*[]string)(unsafe.Pointer(&in.AccessPaths))
+	out.DriveLetters = *(*[]string)(unsafe.Pointer(&in.DriveLetters))
 	return nil
 }
 
-// Convert_v2alpha1_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse is an autogenerated conversion function.
-func Convert_v2alpha1_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse(in *v2alpha1.IsVolumeFormattedResponse, out *impl.IsVolumeFormattedResponse) error {
-	return autoConvert_v2alpha1_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse(in, out)
+// Convert_v2alpha1_GetVolumeAccessPathsResponse_To_impl_GetVolumeAccessPathsResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeAccessPathsResponse_To_impl_GetVolumeAccessPathsResponse(in *v2alpha1.GetVolumeAccessPathsResponse, out *impl.GetVolumeAccessPathsResponse) error {
+	return autoConvert_v2alpha1_GetVolumeAccessPathsResponse_To_impl_GetVolumeAccessPathsResponse(in, out)
 }
 
-func autoConvert_impl_IsVolumeFormattedResponse_To_v2alpha1_IsVolumeFormattedResponse(in *impl.IsVolumeFormattedResponse, out *v2alpha1.IsVolumeFormattedResponse) error {
-	out.Formatted = in.Formatted
+func autoConvert_impl_GetVolumeAccessPathsResponse_To_v2alpha1_GetVolumeAccessPathsResponse(in *impl.GetVolumeAccessPathsResponse, out *v2alpha1.GetVolumeAccessPathsResponse) error {
+	out.AccessPaths = *(*[]string)(unsafe.Pointer(&in.AccessPaths))
+	out.DriveLetters = *(*[]string)(unsafe.Pointer(&in.DriveLetters))
 	return nil
 }
 
-// Convert_impl_IsVolumeFormattedResponse_To_v2alpha1_IsVolumeFormattedResponse is an autogenerated conversion function.
-func Convert_impl_IsVolumeFormattedResponse_To_v2alpha1_IsVolumeFormattedResponse(in *impl.IsVolumeFormattedResponse, out *v2alpha1.IsVolumeFormattedResponse) error {
-	return autoConvert_impl_IsVolumeFormattedResponse_To_v2alpha1_IsVolumeFormattedResponse(in, out)
+// Convert_impl_GetVolumeAccessPathsResponse_To_v2alpha1_GetVolumeAccessPathsResponse is an autogenerated conversion function.
+func Convert_impl_GetVolumeAccessPathsResponse_To_v2alpha1_GetVolumeAccessPathsResponse(in *impl.GetVolumeAccessPathsResponse, out *v2alpha1.GetVolumeAccessPathsResponse) error {
+	return autoConvert_impl_GetVolumeAccessPathsResponse_To_v2alpha1_GetVolumeAccessPathsResponse(in, out)
 }
 
-func autoConvert_v2alpha1_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(in *v2alpha1.ListVolumesOnDiskRequest, out *impl.ListVolumesOnDiskRequest) error {
-	out.DiskNumber = in.DiskNumber
-	out.PartitionNumber = in.PartitionNumber
+func autoConvert_v2alpha1_GetVolumeHealthRequest_To_impl_GetVolumeHealthRequest(in *v2alpha1.GetVolumeHealthRequest, out *impl.GetVolumeHealthRequest) error {
+	out.VolumeId = in.VolumeId
 	return nil
 }
 
-// Convert_v2alpha1_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest is an autogenerated conversion function.
-func Convert_v2alpha1_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(in *v2alpha1.ListVolumesOnDiskRequest, out *impl.ListVolumesOnDiskRequest) error {
-	return autoConvert_v2alpha1_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(in, out)
+// Convert_v2alpha1_GetVolumeHealthRequest_To_impl_GetVolumeHealthRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeHealthRequest_To_impl_GetVolumeHealthRequest(in *v2alpha1.GetVolumeHealthRequest, out *impl.GetVolumeHealthRequest) error {
+	return autoConvert_v2alpha1_GetVolumeHealthRequest_To_impl_GetVolumeHealthRequest(in, out)
 }
 
-func autoConvert_impl_ListVolumesOnDiskRequest_To_v2alpha1_ListVolumesOnDiskRequest(in *impl.ListVolumesOnDiskRequest, out *v2alpha1.ListVolumesOnDiskRequest) error {
-	out.DiskNumber = in.DiskNumber
-	out.PartitionNumber = in.PartitionNumber
+func autoConvert_impl_GetVolumeHealthRequest_To_v2alpha1_GetVolumeHealthRequest(in *impl.GetVolumeHealthRequest, out *v2alpha1.GetVolumeHealthRequest) error {
+	out.VolumeId = in.VolumeId
 	return nil
 }
 
-// Convert_impl_ListVolumesOnDiskRequest_To_v2alpha1_ListVolumesOnDiskRequest is an autogenerated conversion function.
-func Convert_impl_ListVolumesOnDiskRequest_To_v2alpha1_ListVolumesOnDiskRequest(in *impl.ListVolumesOnDiskRequest, out *v2alpha1.ListVolumesOnDiskRequest) error {
-	return autoConvert_impl_ListVolumesOnDiskRequest_To_v2alpha1_ListVolumesOnDiskRequest(in, out)
+// Convert_impl_GetVolumeHealthRequest_To_v2alpha1_GetVolumeHealthRequest is an autogenerated conversion function.
+func Convert_impl_GetVolumeHealthRequest_To_v2alpha1_GetVolumeHealthRequest(in *impl.GetVolumeHealthRequest, out *v2alpha1.GetVolumeHealthRequest) error {
+	return autoConvert_impl_GetVolumeHealthRequest_To_v2alpha1_GetVolumeHealthRequest(in, out)
 }
 
-func autoConvert_v2alpha1_ListVolumesOnDiskResponse_To_impl_ListVolumesOnDiskResponse(in *v2alpha1.ListVolumesOnDiskResponse, out *impl.ListVolumesOnDiskResponse) error {
-	out.VolumeIds = *(*[]string)(unsafe.Pointer(&in.VolumeIds))
+func autoConvert_v2alpha1_GetVolumeHealthResponse_To_impl_GetVolumeHealthResponse(in *v2alpha1.GetVolumeHealthResponse, out *impl.GetVolumeHealthResponse) error {
+	out.HealthStatus = in.HealthStatus
+	out.OperationalStatus = in.OperationalStatus
+	out.Abnormal = in.Abnormal
+	out.Message = in.Message
 	return nil
 }
 
-// Convert_v2alpha1_ListVolumesOnDiskResponse_To_impl_ListVolumesOnDiskResponse is an autogenerated conversion function.
-func Convert_v2alpha1_ListVolumesOnDiskResponse_To_impl_ListVolumesOnDiskResponse(in *v2alpha1.ListVolumesOnDiskResponse, out *impl.ListVolumesOnDiskResponse) error {
-	return autoConvert_v2alpha1_ListVolumesOnDiskResponse_To_impl_ListVolumesOnDiskResponse(in, out)
+// Convert_v2alpha1_GetVolumeHealthResponse_To_impl_GetVolumeHealthResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeHealthResponse_To_impl_GetVolumeHealthResponse(in *v2alpha1.GetVolumeHealthResponse, out *impl.GetVolumeHealthResponse) error {
+	return autoConvert_v2alpha1_GetVolumeHealthResponse_To_impl_GetVolumeHealthResponse(in, out)
 }
 
-func autoConvert_impl_ListVolumesOnDiskResponse_To_v2alpha1_ListVolumesOnDiskResponse(in *impl.ListVolumesOnDiskResponse, out *v2alpha1.ListVolumesOnDiskResponse) error {
-	out.VolumeIds = *(*[]string)(unsafe.Pointer(&in.VolumeIds))
+func autoConvert_impl_GetVolumeHealthResponse_To_v2alpha1_GetVolumeHealthResponse(in *impl.GetVolumeHealthResponse, out *v2alpha1.GetVolumeHealthResponse) error {
+	out.HealthStatus = in.HealthStatus
+	out.OperationalStatus = in.OperationalStatus
+	out.Abnormal = in.Abnormal
+	out.Message = in.Message
 	return nil
 }
 
-// Convert_impl_ListVolumesOnDiskResponse_To_v2alpha1_ListVolumesOnDiskResponse is an autogenerated conversion function.
-func Convert_impl_ListVolumesOnDiskResponse_To_v2alpha1_ListVolumesOnDiskResponse(in *impl.ListVolumesOnDiskResponse, out *v2alpha1.ListVolumesOnDiskResponse) error {
-	return autoConvert_impl_ListVolumesOnDiskResponse_To_v2alpha1_ListVolumesOnDiskResponse(in, out)
+// Convert_impl_GetVolumeHealthResponse_To_v2alpha1_GetVolumeHealthResponse is an autogenerated conversion function.
+func Convert_impl_GetVolumeHealthResponse_To_v2alpha1_GetVolumeHealthResponse(in *impl.GetVolumeHealthResponse, out *v2alpha1.GetVolumeHealthResponse) error {
+	return autoConvert_impl_GetVolumeHealthResponse_To_v2alpha1_GetVolumeHealthResponse(in, out)
 }
 
-func autoConvert_v2alpha1_MountVolumeRequest_To_impl_MountVolumeRequest(in *v2alpha1.MountVolumeRequest, out *impl.MountVolumeRequest) error {
-	out.VolumeId = in.VolumeId
+func autoConvert_v2alpha1_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(in *v2alpha1.GetVolumeIDFromTargetPathRequest, out *impl.GetVolumeIDFromTargetPathRequest) error {
 	out.TargetPath = in.TargetPath
 	return nil
 }
 
-// Convert_v2alpha1_MountVolumeRequest_To_impl_MountVolumeRequest is an autogenerated conversion function.
-func Convert_v2alpha1_MountVolumeRequest_To_impl_MountVolumeRequest(in *v2alpha1.MountVolumeRequest, out *impl.MountVolumeRequest) error {
-	return autoConvert_v2alpha1_MountVolumeRequest_To_impl_MountVolumeRequest(in, out)
+// Convert_v2alpha1_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(in *v2alpha1.GetVolumeIDFromTargetPathRequest, out *impl.GetVolumeIDFromTargetPathRequest) error {
+	return autoConvert_v2alpha1_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(in, out)
 }
 
-func autoConvert_impl_MountVolumeRequest_To_v2alpha1_MountVolumeRequest(in *impl.MountVolumeRequest, out *v2alpha1.MountVolumeRequest) error {
-	out.VolumeId = in.VolumeId
+func autoConvert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha1_GetVolumeIDFromTargetPathRequest(in *impl.GetVolumeIDFromTargetPathRequest, out *v2alpha1.GetVolumeIDFromTargetPathRequest) error {
 	out.TargetPath = in.TargetPath
 	return nil
 }
 
-// Convert_impl_MountVolumeRequest_To_v2alpha1_MountVolumeRequest is an autogenerated conversion function.
-func Convert_impl_MountVolumeRequest_To_v2alpha1_MountVolumeRequest(in *impl.MountVolumeRequest, out *v2alpha1.MountVolumeRequest) error {
-	return autoConvert_impl_MountVolumeRequest_To_v2alpha1_MountVolumeRequest(in, out)
+// Convert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha1_GetVolumeIDFromTargetPathRequest is an autogenerated conversion function.
+func Convert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha1_GetVolumeIDFromTargetPathRequest(in *impl.GetVolumeIDFromTargetPathRequest, out *v2alpha1.GetVolumeIDFromTargetPathRequest) error {
+	return autoConvert_impl_GetVolumeIDFromTargetPathRequest_To_v2alpha1_GetVolumeIDFromTargetPathRequest(in, out)
 }
 
-func autoConvert_v2alpha1_MountVolumeResponse_To_impl_MountVolumeResponse(in *v2alpha1.MountVolumeResponse, out *impl.MountVolumeResponse) error {
+func autoConvert_v2alpha1_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse(in *v2alpha1.GetVolumeIDFromTargetPathResponse, out *impl.GetVolumeIDFromTargetPathResponse) error {
+	out.VolumeId = in.VolumeId
 	return nil
 }
 
-// Convert_v2alpha1_MountVolumeResponse_To_impl_MountVolumeResponse is an autogenerated conversion function.
-func Convert_v2alpha1_MountVolumeResponse_To_impl_MountVolumeResponse(in *v2alpha1.MountVolumeResponse, out *impl.MountVolumeResponse) error {
-	return autoConvert_v2alpha1_MountVolumeResponse_To_impl_MountVolumeResponse(in, out)
+// Convert_v2alpha1_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse(in *v2alpha1.GetVolumeIDFromTargetPathResponse, out *impl.GetVolumeIDFromTargetPathResponse) error {
+	return autoConvert_v2alpha1_GetVolumeIDFromTargetPathResponse_To_impl_GetVolumeIDFromTargetPathResponse(in, out)
 }
 
-func autoConvert_impl_MountVolumeResponse_To_v2alpha1_MountVolumeResponse(in *impl.MountVolumeResponse, out *v2alpha1.MountVolumeResponse) error {
+func autoConvert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha1_GetVolumeIDFromTargetPathResponse(in *impl.GetVolumeIDFromTargetPathResponse, out *v2alpha1.GetVolumeIDFromTargetPathResponse) error {
+	out.VolumeId = in.VolumeId
 	return nil
 }
 
-// Convert_impl_MountVolumeResponse_To_v2alpha1_MountVolumeResponse is an autogenerated conversion function.
-func Convert_impl_MountVolumeResponse_To_v2alpha1_MountVolumeResponse(in *impl.MountVolumeResponse, out *v2alpha1.MountVolumeResponse) error {
-	return autoConvert_impl_MountVolumeResponse_To_v2alpha1_MountVolumeResponse(in, out)
+// Convert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha1_GetVolumeIDFromTargetPathResponse is an autogenerated conversion function.
+func Convert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha1_GetVolumeIDFromTargetPathResponse(in *impl.GetVolumeIDFromTargetPathResponse, out *v2alpha1.GetVolumeIDFromTargetPathResponse) error {
+	return autoConvert_impl_GetVolumeIDFromTargetPathResponse_To_v2alpha1_GetVolumeIDFromTargetPathResponse(in, out)
 }
 
-func autoConvert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(in *v2alpha1.ResizeVolumeRequest, out *impl.ResizeVolumeRequest) error {
+func autoConvert_v2alpha1_GetVolumeLabelRequest_To_impl_GetVolumeLabelRequest(in *v2alpha1.GetVolumeLabelRequest, out *impl.GetVolumeLabelRequest) error {
 	out.VolumeId = in.VolumeId
-	out.SizeBytes = in.SizeBytes
 	return nil
 }
 
-// Convert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest is an autogenerated conversion function.
-func Convert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(in *v2alpha1.ResizeVolumeRequest, out *impl.ResizeVolumeRequest) error {
-	return autoConvert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(in, out)
+// Convert_v2alpha1_GetVolumeLabelRequest_To_impl_GetVolumeLabelRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeLabelRequest_To_impl_GetVolumeLabelRequest(in *v2alpha1.GetVolumeLabelRequest, out *impl.GetVolumeLabelRequest) error {
+	return autoConvert_v2alpha1_GetVolumeLabelRequest_To_impl_GetVolumeLabelRequest(in, out)
 }
 
-func autoConvert_impl_ResizeVolumeRequest_To_v2alpha1_ResizeVolumeRequest(in *impl.ResizeVolumeRequest, out *v2alpha1.ResizeVolumeRequest) error {
+func autoConvert_impl_GetVolumeLabelRequest_To_v2alpha1_GetVolumeLabelRequest(in *impl.GetVolumeLabelRequest, out *v2alpha1.GetVolumeLabelRequest) error {
 	out.VolumeId = in.VolumeId
-	out.SizeBytes = in.SizeBytes
 	return nil
 }
 
-// Convert_impl_ResizeVolumeRequest_To_v2alpha1_ResizeVolumeRequest is an autogenerated conversion function.
-func Convert_impl_ResizeVolumeRequest_To_v2alpha1_ResizeVolumeRequest(in *impl.ResizeVolumeRequest, out *v2alpha1.ResizeVolumeRequest) error {
-	return autoConvert_impl_ResizeVolumeRequest_To_v2alpha1_ResizeVolumeRequest(in, out)
+// Convert_impl_GetVolumeLabelRequest_To_v2alpha1_GetVolumeLabelRequest is an autogenerated conversion function.
+func Convert_impl_GetVolumeLabelRequest_To_v2alpha1_GetVolumeLabelRequest(in *impl.GetVolumeLabelRequest, out *v2alpha1.GetVolumeLabelRequest) error {
+	return autoConvert_impl_GetVolumeLabelRequest_To_v2alpha1_GetVolumeLabelRequest(in, out)
 }
 
-func autoConvert_v2alpha1_ResizeVolumeResponse_To_impl_ResizeVolumeResponse(in *v2alpha1.ResizeVolumeResponse, out *impl.ResizeVolumeResponse) error {
+func autoConvert_v2alpha1_GetVolumeLabelResponse_To_impl_GetVolumeLabelResponse(in *v2alpha1.GetVolumeLabelResponse, out *impl.GetVolumeLabelResponse) error {
+	out.Label = in.Label
 	return nil
 }
 
-// Convert_v2alpha1_ResizeVolumeResponse_To_impl_ResizeVolumeResponse is an autogenerated conversion function.
-func Convert_v2alpha1_ResizeVolumeResponse_To_impl_ResizeVolumeResponse(in *v2alpha1.ResizeVolumeResponse, out *impl.ResizeVolumeResponse) error {
-	return autoConvert_v2alpha1_ResizeVolumeResponse_To_impl_ResizeVolumeResponse(in, out)
+// Convert_v2alpha1_GetVolumeLabelResponse_To_impl_GetVolumeLabelResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeLabelResponse_To_impl_GetVolumeLabelResponse(in *v2alpha1.GetVolumeLabelResponse, out *impl.GetVolumeLabelResponse) error {
+	return autoConvert_v2alpha1_GetVolumeLabelResponse_To_impl_GetVolumeLabelResponse(in, out)
 }
 
-func autoConvert_impl_ResizeVolumeResponse_To_v2alpha1_ResizeVolumeResponse(in *impl.ResizeVolumeResponse, out *v2alpha1.ResizeVolumeResponse) error {
+func autoConvert_impl_GetVolumeLabelResponse_To_v2alpha1_GetVolumeLabelResponse(in *impl.GetVolumeLabelResponse, out *v2alpha1.GetVolumeLabelResponse) error {
+	out.Label = in.Label
 	return nil
 }
 
-// Convert_impl_ResizeVolumeResponse_To_v2alpha1_ResizeVolumeResponse is an autogenerated conversion function.
-func Convert_impl_ResizeVolumeResponse_To_v2alpha1_ResizeVolumeResponse(in *impl.ResizeVolumeResponse, out *v2alpha1.ResizeVolumeResponse) error {
-	return autoConvert_impl_ResizeVolumeResponse_To_v2alpha1_ResizeVolumeResponse(in, out)
+// Convert_impl_GetVolumeLabelResponse_To_v2alpha1_GetVolumeLabelResponse is an autogenerated conversion function.
+func Convert_impl_GetVolumeLabelResponse_To_v2alpha1_GetVolumeLabelResponse(in *impl.GetVolumeLabelResponse, out *v2alpha1.GetVolumeLabelResponse) error {
+	return autoConvert_impl_GetVolumeLabelResponse_To_v2alpha1_GetVolumeLabelResponse(in, out)
 }
 
-func autoConvert_v2alpha1_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(in *v2alpha1.UnmountVolumeRequest, out *impl.UnmountVolumeRequest) error {
+func autoConvert_v2alpha1_GetVolumeSerialNumberRequest_To_impl_GetVolumeSerialNumberRequest(in *v2alpha1.GetVolumeSerialNumberRequest, out *impl.GetVolumeSerialNumberRequest) error {
 	out.VolumeId = in.VolumeId
-	out.TargetPath = in.TargetPath
 	return nil
 }
 
-// Convert_v2alpha1_UnmountVolumeRequest_To_impl_UnmountVolumeRequest is an autogenerated conversion function.
-func Convert_v2alpha1_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(in *v2alpha1.UnmountVolumeRequest, out *impl.UnmountVolumeRequest) error {
-	return autoConvert_v2alpha1_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(in, out)
+// Convert_v2alpha1_GetVolumeSerialNumberRequest_To_impl_GetVolumeSerialNumberRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeSerialNumberRequest_To_impl_GetVolumeSerialNumberRequest(in *v2alpha1.GetVolumeSerialNumberRequest, out *impl.GetVolumeSerialNumberRequest) error {
+	return autoConvert_v2alpha1_GetVolumeSerialNumberRequest_To_impl_GetVolumeSerialNumberRequest(in, out)
 }
 
-func autoConvert_impl_UnmountVolumeRequest_To_v2alpha1_UnmountVolumeRequest(in *impl.UnmountVolumeRequest, out *v2alpha1.UnmountVolumeRequest) error {
+func autoConvert_impl_GetVolumeSerialNumberRequest_To_v2alpha1_GetVolumeSerialNumberRequest(in *impl.GetVolumeSerialNumberRequest, out *v2alpha1.GetVolumeSerialNumberRequest) error {
 	out.VolumeId = in.VolumeId
-	out.TargetPath = in.TargetPath
 	return nil
 }
 
-// Convert_impl_UnmountVolumeRequest_To_v2alpha1_UnmountVolumeRequest is an autogenerated conversion function.
-func Convert_impl_UnmountVolumeRequest_To_v2alpha1_UnmountVolumeRequest(in *impl.UnmountVolumeRequest, out *v2alpha1.UnmountVolumeRequest) error {
-	return autoConvert_impl_UnmountVolumeRequest_To_v2alpha1_UnmountVolumeRequest(in, out)
+// Convert_impl_GetVolumeSerialNumberRequest_To_v2alpha1_GetVolumeSerialNumberRequest is an autogenerated conversion function.
+func Convert_impl_GetVolumeSerialNumberRequest_To_v2alpha1_GetVolumeSerialNumberRequest(in *impl.GetVolumeSerialNumberRequest, out *v2alpha1.GetVolumeSerialNumberRequest) error {
+	return autoConvert_impl_GetVolumeSerialNumberRequest_To_v2alpha1_GetVolumeSerialNumberRequest(in, out)
 }
 
-func autoConvert_v2alpha1_UnmountVolumeResponse_To_impl_UnmountVolumeResponse(in *v2alpha1.UnmountVolumeResponse, out *impl.UnmountVolumeResponse) error {
+func autoConvert_v2alpha1_GetVolumeSerialNumberResponse_To_impl_GetVolumeSerialNumberResponse(in *v2alpha1.GetVolumeSerialNumberResponse, out *impl.GetVolumeSerialNumberResponse) error {
+	out.SerialNumber = in.SerialNumber
 	return nil
 }
 
-// Convert_v2alpha1_UnmountVolumeResponse_To_impl_UnmountVolumeResponse is an autogenerated conversion function.
-func Convert_v2alpha1_UnmountVolumeResponse_To_impl_UnmountVolumeResponse(in *v2alpha1.UnmountVolumeResponse, out *impl.UnmountVolumeResponse) error {
-	return autoConvert_v2alpha1_UnmountVolumeResponse_To_impl_UnmountVolumeResponse(in, out)
+// Convert_v2alpha1_GetVolumeSerialNumberResponse_To_impl_GetVolumeSerialNumberResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeSerialNumberResponse_To_impl_GetVolumeSerialNumberResponse(in *v2alpha1.GetVolumeSerialNumberResponse, out *impl.GetVolumeSerialNumberResponse) error {
+	return autoConvert_v2alpha1_GetVolumeSerialNumberResponse_To_impl_GetVolumeSerialNumberResponse(in, out)
 }
 
-func autoConvert_impl_UnmountVolumeResponse_To_v2alpha1_UnmountVolumeResponse(in *impl.UnmountVolumeResponse, out *v2alpha1.UnmountVolumeResponse) error {
+func autoConvert_impl_GetVolumeSerialNumberResponse_To_v2alpha1_GetVolumeSerialNumberResponse(in *impl.GetVolumeSerialNumberResponse, out *v2alpha1.GetVolumeSerialNumberResponse) error {
+	out.SerialNumber = in.SerialNumber
 	return nil
 }
 
-// Convert_impl_UnmountVolumeResponse_To_v2alpha1_UnmountVolumeResponse is an autogenerated conversion function.
-func Convert_impl_UnmountVolumeResponse_To_v2alpha1_UnmountVolumeResponse(in *impl.UnmountVolumeResponse, out *v2alpha1.UnmountVolumeResponse) error {
-	return autoConvert_impl_UnmountVolumeResponse_To_v2alpha1_UnmountVolumeResponse(in, out)
+// Convert_impl_GetVolumeSerialNumberResponse_To_v2alpha1_GetVolumeSerialNumberResponse is an autogenerated conversion function.
+func Convert_impl_GetVolumeSerialNumberResponse_To_v2alpha1_GetVolumeSerialNumberResponse(in *impl.GetVolumeSerialNumberResponse, out *v2alpha1.GetVolumeSerialNumberResponse) error {
+	return autoConvert_impl_GetVolumeSerialNumberResponse_To_v2alpha1_GetVolumeSerialNumberResponse(in, out)
 }
 
-func autoConvert_v2alpha1_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(in *v2alpha1.WriteVolumeCacheRequest, out *impl.WriteVolumeCacheRequest) error {
+func autoConvert_v2alpha1_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(in *v2alpha1.GetVolumeStatsRequest, out *impl.GetVolumeStatsRequest) error {
 	out.VolumeId = in.VolumeId
+	out.SizesOnly = in.SizesOnly
 	return nil
 }
 
-// Convert_v2alpha1_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest is an autogenerated conversion function.
-func Convert_v2alpha1_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(in *v2alpha1.WriteVolumeCacheRequest, out *impl.WriteVolumeCacheRequest) error {
-	return autoConvert_v2alpha1_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(in, out)
+// Convert_v2alpha1_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(in *v2alpha1.GetVolumeStatsRequest, out *impl.GetVolumeStatsRequest) error {
+	return autoConvert_v2alpha1_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(in, out)
 }
 
-func autoConvert_impl_WriteVolumeCacheRequest_To_v2alpha1_WriteVolumeCacheRequest(in *impl.WriteVolumeCacheRequest, out *v2alpha1.WriteVolumeCacheRequest) error {
+func autoConvert_impl_GetVolumeStatsRequest_To_v2alpha1_GetVolumeStatsRequest(in *impl.GetVolumeStatsRequest, out *v2alpha1.GetVolumeStatsRequest) error {
 	out.VolumeId = in.VolumeId
+	out.SizesOnly = in.SizesOnly
 	return nil
 }
 
-// Convert_impl_WriteVolumeCacheRequest_To_v2alpha1_WriteVolumeCacheRequest is an autogenerated conversion function.
-func Convert_impl_WriteVolumeCacheRequest_To_v2alpha1_WriteVolumeCacheRequest(in *impl.WriteVolumeCacheRequest, out *v2alpha1.WriteVolumeCacheRequest) error {
-	return autoConvert_impl_WriteVolumeCacheRequest_To_v2alpha1_WriteVolumeCacheRequest(in, out)
+// Convert_impl_GetVolumeStatsRequest_To_v2alpha1_GetVolumeStatsRequest is an autogenerated conversion function.
+func Convert_impl_GetVolumeStatsRequest_To_v2alpha1_GetVolumeStatsRequest(in *impl.GetVolumeStatsRequest, out *v2alpha1.GetVolumeStatsRequest) error {
+	return autoConvert_impl_GetVolumeStatsRequest_To_v2alpha1_GetVolumeStatsRequest(in, out)
 }
 
-func autoConvert_v2alpha1_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse(in *v2alpha1.WriteVolumeCacheResponse, out *impl.WriteVolumeCacheResponse) error {
+// detected external conversion function
+// Convert_v2alpha1_GetVolumeStatsResponse_To_impl_GetVolumeStatsResponse(in *v2alpha1.GetVolumeStatsResponse, out *impl.GetVolumeStatsResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_GetVolumeStatsResponse_To_v2alpha1_GetVolumeStatsResponse(in *impl.GetVolumeStatsResponse, out *v2alpha1.GetVolumeStatsResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_GetVolumeWriteCacheRequest_To_impl_GetVolumeWriteCacheRequest(in *v2alpha1.GetVolumeWriteCacheRequest, out *impl.GetVolumeWriteCacheRequest) error {
+	out.VolumeId = in.VolumeId
 	return nil
 }
 
-// Convert_v2alpha1_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse is an autogenerated conversion function.
-func Convert_v2alpha1_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse(in *v2alpha1.WriteVolumeCacheResponse, out *impl.WriteVolumeCacheResponse) error {
-	return autoConvert_v2alpha1_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse(in, out)
+// Convert_v2alpha1_GetVolumeWriteCacheRequest_To_impl_GetVolumeWriteCacheRequest is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeWriteCacheRequest_To_impl_GetVolumeWriteCacheRequest(in *v2alpha1.GetVolumeWriteCacheRequest, out *impl.GetVolumeWriteCacheRequest) error {
+	return autoConvert_v2alpha1_GetVolumeWriteCacheRequest_To_impl_GetVolumeWriteCacheRequest(in, out)
 }
 
-func autoConvert_impl_WriteVolumeCacheResponse_To_v2alpha1_WriteVolumeCacheResponse(in *impl.WriteVolumeCacheResponse, out *v2alpha1.WriteVolumeCacheResponse) error {
+func autoConvert_impl_GetVolumeWriteCacheRequest_To_v2alpha1_GetVolumeWriteCacheRequest(in *impl.GetVolumeWriteCacheRequest, out *v2alpha1.GetVolumeWriteCacheRequest) error {
+	out.VolumeId = in.VolumeId
 	return nil
 }
 
-// Convert_impl_WriteVolumeCacheResponse_To_v2alpha1_WriteVolumeCacheResponse is an autogenerated conversion function.
+// Convert_impl_GetVolumeWriteCacheRequest_To_v2alpha1_GetVolumeWriteCacheRequest is an autogenerated conversion function.
+func Convert_impl_GetVolumeWriteCacheRequest_To_v2alpha1_GetVolumeWriteCacheRequest(in *impl.GetVolumeWriteCacheRequest, out *v2alpha1.GetVolumeWriteCacheRequest) error {
+	return autoConvert_impl_GetVolumeWriteCacheRequest_To_v2alpha1_GetVolumeWriteCacheRequest(in, out)
+}
+
+func autoConvert_v2alpha1_GetVolumeWriteCacheResponse_To_impl_GetVolumeWriteCacheResponse(in *v2alpha1.GetVolumeWriteCacheResponse, out *impl.GetVolumeWriteCacheResponse) error {
+	out.WriteCacheEnabled = in.WriteCacheEnabled
+	return nil
+}
+
+// Convert_v2alpha1_GetVolumeWriteCacheResponse_To_impl_GetVolumeWriteCacheResponse is an autogenerated conversion function.
+func Convert_v2alpha1_GetVolumeWriteCacheResponse_To_impl_GetVolumeWriteCacheResponse(in *v2alpha1.GetVolumeWriteCacheResponse, out *impl.GetVolumeWriteCacheResponse) error {
+	return autoConvert_v2alpha1_GetVolumeWriteCacheResponse_To_impl_GetVolumeWriteCacheResponse(in, out)
+}
+
+func autoConvert_impl_GetVolumeWriteCacheResponse_To_v2alpha1_GetVolumeWriteCacheResponse(in *impl.GetVolumeWriteCacheResponse, out *v2alpha1.GetVolumeWriteCacheResponse) error {
+	out.WriteCacheEnabled = in.WriteCacheEnabled
+	return nil
+}
+
+// Convert_impl_GetVolumeWriteCacheResponse_To_v2alpha1_GetVolumeWriteCacheResponse is an autogenerated conversion function.
+func Convert_impl_GetVolumeWriteCacheResponse_To_v2alpha1_GetVolumeWriteCacheResponse(in *impl.GetVolumeWriteCacheResponse, out *v2alpha1.GetVolumeWriteCacheResponse) error {
+	return autoConvert_impl_GetVolumeWriteCacheResponse_To_v2alpha1_GetVolumeWriteCacheResponse(in, out)
+}
+
+func autoConvert_v2alpha1_IsVolumeDirtyRequest_To_impl_IsVolumeDirtyRequest(in *v2alpha1.IsVolumeDirtyRequest, out *impl.IsVolumeDirtyRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha1_IsVolumeDirtyRequest_To_impl_IsVolumeDirtyRequest is an autogenerated conversion function.
+func Convert_v2alpha1_IsVolumeDirtyRequest_To_impl_IsVolumeDirtyRequest(in *v2alpha1.IsVolumeDirtyRequest, out *impl.IsVolumeDirtyRequest) error {
+	return autoConvert_v2alpha1_IsVolumeDirtyRequest_To_impl_IsVolumeDirtyRequest(in, out)
+}
+
+func autoConvert_impl_IsVolumeDirtyRequest_To_v2alpha1_IsVolumeDirtyRequest(in *impl.IsVolumeDirtyRequest, out *v2alpha1.IsVolumeDirtyRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_IsVolumeDirtyRequest_To_v2alpha1_IsVolumeDirtyRequest is an autogenerated conversion function.
+func Convert_impl_IsVolumeDirtyRequest_To_v2alpha1_IsVolumeDirtyRequest(in *impl.IsVolumeDirtyRequest, out *v2alpha1.IsVolumeDirtyRequest) error {
+	return autoConvert_impl_IsVolumeDirtyRequest_To_v2alpha1_IsVolumeDirtyRequest(in, out)
+}
+
+func autoConvert_v2alpha1_IsVolumeDirtyResponse_To_impl_IsVolumeDirtyResponse(in *v2alpha1.IsVolumeDirtyResponse, out *impl.IsVolumeDirtyResponse) error {
+	out.Dirty = in.Dirty
+	return nil
+}
+
+// Convert_v2alpha1_IsVolumeDirtyResponse_To_impl_IsVolumeDirtyResponse is an autogenerated conversion function.
+func Convert_v2alpha1_IsVolumeDirtyResponse_To_impl_IsVolumeDirtyResponse(in *v2alpha1.IsVolumeDirtyResponse, out *impl.IsVolumeDirtyResponse) error {
+	return autoConvert_v2alpha1_IsVolumeDirtyResponse_To_impl_IsVolumeDirtyResponse(in, out)
+}
+
+func autoConvert_impl_IsVolumeDirtyResponse_To_v2alpha1_IsVolumeDirtyResponse(in *impl.IsVolumeDirtyResponse, out *v2alpha1.IsVolumeDirtyResponse) error {
+	out.Dirty = in.Dirty
+	return nil
+}
+
+// Convert_impl_IsVolumeDirtyResponse_To_v2alpha1_IsVolumeDirtyResponse is an autogenerated conversion function.
+func Convert_impl_IsVolumeDirtyResponse_To_v2alpha1_IsVolumeDirtyResponse(in *impl.IsVolumeDirtyResponse, out *v2alpha1.IsVolumeDirtyResponse) error {
+	return autoConvert_impl_IsVolumeDirtyResponse_To_v2alpha1_IsVolumeDirtyResponse(in, out)
+}
+
+func autoConvert_v2alpha1_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(in *v2alpha1.IsVolumeFormattedRequest, out *impl.IsVolumeFormattedRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha1_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest is an autogenerated conversion function.
+func Convert_v2alpha1_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(in *v2alpha1.IsVolumeFormattedRequest, out *impl.IsVolumeFormattedRequest) error {
+	return autoConvert_v2alpha1_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(in, out)
+}
+
+func autoConvert_impl_IsVolumeFormattedRequest_To_v2alpha1_IsVolumeFormattedRequest(in *impl.IsVolumeFormattedRequest, out *v2alpha1.IsVolumeFormattedRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_IsVolumeFormattedRequest_To_v2alpha1_IsVolumeFormattedRequest is an autogenerated conversion function.
+func Convert_impl_IsVolumeFormattedRequest_To_v2alpha1_IsVolumeFormattedRequest(in *impl.IsVolumeFormattedRequest, out *v2alpha1.IsVolumeFormattedRequest) error {
+	return autoConvert_impl_IsVolumeFormattedRequest_To_v2alpha1_IsVolumeFormattedRequest(in, out)
+}
+
+func autoConvert_v2alpha1_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse(in *v2alpha1.IsVolumeFormattedResponse, out *impl.IsVolumeFormattedResponse) error {
+	out.Formatted = in.Formatted
+	out.FileSystemType = in.FileSystemType
+	out.Unrecognized = in.Unrecognized
+	return nil
+}
+
+// Convert_v2alpha1_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse is an autogenerated conversion function.
+func Convert_v2alpha1_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse(in *v2alpha1.IsVolumeFormattedResponse, out *impl.IsVolumeFormattedResponse) error {
+	return autoConvert_v2alpha1_IsVolumeFormattedResponse_To_impl_IsVolumeFormattedResponse(in, out)
+}
+
+func autoConvert_impl_IsVolumeFormattedResponse_To_v2alpha1_IsVolumeFormattedResponse(in *impl.IsVolumeFormattedResponse, out *v2alpha1.IsVolumeFormattedResponse) error {
+	out.Formatted = in.Formatted
+	out.FileSystemType = in.FileSystemType
+	out.Unrecognized = in.Unrecognized
+	return nil
+}
+
+// Convert_impl_IsVolumeFormattedResponse_To_v2alpha1_IsVolumeFormattedResponse is an autogenerated conversion function.
+func Convert_impl_IsVolumeFormattedResponse_To_v2alpha1_IsVolumeFormattedResponse(in *impl.IsVolumeFormattedResponse, out *v2alpha1.IsVolumeFormattedResponse) error {
+	return autoConvert_impl_IsVolumeFormattedResponse_To_v2alpha1_IsVolumeFormattedResponse(in, out)
+}
+
+func autoConvert_v2alpha1_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(in *v2alpha1.ListVolumesOnDiskRequest, out *impl.ListVolumesOnDiskRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(impl.VolumeFilter)
+		if err := Convert_v2alpha1_VolumeFilter_To_impl_VolumeFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(in *v2alpha1.ListVolumesOnDiskRequest, out *impl.ListVolumesOnDiskRequest) error {
+	return autoConvert_v2alpha1_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(in, out)
+}
+
+func autoConvert_impl_ListVolumesOnDiskRequest_To_v2alpha1_ListVolumesOnDiskRequest(in *impl.ListVolumesOnDiskRequest, out *v2alpha1.ListVolumesOnDiskRequest) error {
+	out.DiskNumber = in.DiskNumber
+	out.PartitionNumber = in.PartitionNumber
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(v2alpha1.VolumeFilter)
+		if err := Convert_impl_VolumeFilter_To_v2alpha1_VolumeFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	return nil
+}
+
+// Convert_impl_ListVolumesOnDiskRequest_To_v2alpha1_ListVolumesOnDiskRequest is an autogenerated conversion function.
+func Convert_impl_ListVolumesOnDiskRequest_To_v2alpha1_ListVolumesOnDiskRequest(in *impl.ListVolumesOnDiskRequest, out *v2alpha1.ListVolumesOnDiskRequest) error {
+	return autoConvert_impl_ListVolumesOnDiskRequest_To_v2alpha1_ListVolumesOnDiskRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_ListVolumesOnDiskResponse_To_impl_ListVolumesOnDiskResponse(in *v2alpha1.ListVolumesOnDiskResponse, out *impl.ListVolumesOnDiskResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_ListVolumesOnDiskResponse_To_v2alpha1_ListVolumesOnDiskResponse(in *impl.ListVolumesOnDiskResponse, out *v2alpha1.ListVolumesOnDiskResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_ListVolumesRequest_To_impl_ListVolumesRequest(in *v2alpha1.ListVolumesRequest, out *impl.ListVolumesRequest) error {
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(impl.VolumeFilter)
+		if err := Convert_v2alpha1_VolumeFilter_To_impl_VolumeFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_ListVolumesRequest_To_impl_ListVolumesRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ListVolumesRequest_To_impl_ListVolumesRequest(in *v2alpha1.ListVolumesRequest, out *impl.ListVolumesRequest) error {
+	return autoConvert_v2alpha1_ListVolumesRequest_To_impl_ListVolumesRequest(in, out)
+}
+
+func autoConvert_impl_ListVolumesRequest_To_v2alpha1_ListVolumesRequest(in *impl.ListVolumesRequest, out *v2alpha1.ListVolumesRequest) error {
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(v2alpha1.VolumeFilter)
+		if err := Convert_impl_VolumeFilter_To_v2alpha1_VolumeFilter(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Filter = nil
+	}
+	return nil
+}
+
+// Convert_impl_ListVolumesRequest_To_v2alpha1_ListVolumesRequest is an autogenerated conversion function.
+func Convert_impl_ListVolumesRequest_To_v2alpha1_ListVolumesRequest(in *impl.ListVolumesRequest, out *v2alpha1.ListVolumesRequest) error {
+	return autoConvert_impl_ListVolumesRequest_To_v2alpha1_ListVolumesRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_ListVolumesResponse_To_impl_ListVolumesResponse(in *v2alpha1.ListVolumesResponse, out *impl.ListVolumesResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_ListVolumesResponse_To_v2alpha1_ListVolumesResponse(in *impl.ListVolumesResponse, out *v2alpha1.ListVolumesResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_MountVolumeRequest_To_impl_MountVolumeRequest(in *v2alpha1.MountVolumeRequest, out *impl.MountVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.TargetPath = in.TargetPath
+	out.ReadOnly = in.ReadOnly
+	out.AssignDriveLetter = in.AssignDriveLetter
+	out.CreateTargetPath = in.CreateTargetPath
+	out.TargetPathSddl = in.TargetPathSddl
+	out.WriteThrough = in.WriteThrough
+	out.DisableIndexing = in.DisableIndexing
+	return nil
+}
+
+// Convert_v2alpha1_MountVolumeRequest_To_impl_MountVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha1_MountVolumeRequest_To_impl_MountVolumeRequest(in *v2alpha1.MountVolumeRequest, out *impl.MountVolumeRequest) error {
+	return autoConvert_v2alpha1_MountVolumeRequest_To_impl_MountVolumeRequest(in, out)
+}
+
+func autoConvert_impl_MountVolumeRequest_To_v2alpha1_MountVolumeRequest(in *impl.MountVolumeRequest, out *v2alpha1.MountVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.TargetPath = in.TargetPath
+	out.ReadOnly = in.ReadOnly
+	out.AssignDriveLetter = in.AssignDriveLetter
+	out.CreateTargetPath = in.CreateTargetPath
+	out.TargetPathSddl = in.TargetPathSddl
+	out.WriteThrough = in.WriteThrough
+	out.DisableIndexing = in.DisableIndexing
+	return nil
+}
+
+// Convert_impl_MountVolumeRequest_To_v2alpha1_MountVolumeRequest is an autogenerated conversion function.
+func Convert_impl_MountVolumeRequest_To_v2alpha1_MountVolumeRequest(in *impl.MountVolumeRequest, out *v2alpha1.MountVolumeRequest) error {
+	return autoConvert_impl_MountVolumeRequest_To_v2alpha1_MountVolumeRequest(in, out)
+}
+
+func autoConvert_v2alpha1_MountVolumeResponse_To_impl_MountVolumeResponse(in *v2alpha1.MountVolumeResponse, out *impl.MountVolumeResponse) error {
+	out.DriveLetter = in.DriveLetter
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_v2alpha1_MountVolumeResponse_To_impl_MountVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha1_MountVolumeResponse_To_impl_MountVolumeResponse(in *v2alpha1.MountVolumeResponse, out *impl.MountVolumeResponse) error {
+	return autoConvert_v2alpha1_MountVolumeResponse_To_impl_MountVolumeResponse(in, out)
+}
+
+func autoConvert_impl_MountVolumeResponse_To_v2alpha1_MountVolumeResponse(in *impl.MountVolumeResponse, out *v2alpha1.MountVolumeResponse) error {
+	out.DriveLetter = in.DriveLetter
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_impl_MountVolumeResponse_To_v2alpha1_MountVolumeResponse is an autogenerated conversion function.
+func Convert_impl_MountVolumeResponse_To_v2alpha1_MountVolumeResponse(in *impl.MountVolumeResponse, out *v2alpha1.MountVolumeResponse) error {
+	return autoConvert_impl_MountVolumeResponse_To_v2alpha1_MountVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha1_OpenHandle_To_impl_OpenHandle(in *v2alpha1.OpenHandle, out *impl.OpenHandle) error {
+	out.ProcessId = in.ProcessId
+	out.ProcessName = in.ProcessName
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v2alpha1_OpenHandle_To_impl_OpenHandle is an autogenerated conversion function.
+func Convert_v2alpha1_OpenHandle_To_impl_OpenHandle(in *v2alpha1.OpenHandle, out *impl.OpenHandle) error {
+	return autoConvert_v2alpha1_OpenHandle_To_impl_OpenHandle(in, out)
+}
+
+func autoConvert_impl_OpenHandle_To_v2alpha1_OpenHandle(in *impl.OpenHandle, out *v2alpha1.OpenHandle) error {
+	out.ProcessId = in.ProcessId
+	out.ProcessName = in.ProcessName
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_impl_OpenHandle_To_v2alpha1_OpenHandle is an autogenerated conversion function.
+func Convert_impl_OpenHandle_To_v2alpha1_OpenHandle(in *impl.OpenHandle, out *v2alpha1.OpenHandle) error {
+	return autoConvert_impl_OpenHandle_To_v2alpha1_OpenHandle(in, out)
+}
+
+func autoConvert_v2alpha1_OptimizeVolumeAsyncRequest_To_impl_OptimizeVolumeAsyncRequest(in *v2alpha1.OptimizeVolumeAsyncRequest, out *impl.OptimizeVolumeAsyncRequest) error {
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(impl.OptimizeVolumeRequest)
+		if err := Convert_v2alpha1_OptimizeVolumeRequest_To_impl_OptimizeVolumeRequest(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Request = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_OptimizeVolumeAsyncRequest_To_impl_OptimizeVolumeAsyncRequest is an autogenerated conversion function.
+func Convert_v2alpha1_OptimizeVolumeAsyncRequest_To_impl_OptimizeVolumeAsyncRequest(in *v2alpha1.OptimizeVolumeAsyncRequest, out *impl.OptimizeVolumeAsyncRequest) error {
+	return autoConvert_v2alpha1_OptimizeVolumeAsyncRequest_To_impl_OptimizeVolumeAsyncRequest(in, out)
+}
+
+func autoConvert_impl_OptimizeVolumeAsyncRequest_To_v2alpha1_OptimizeVolumeAsyncRequest(in *impl.OptimizeVolumeAsyncRequest, out *v2alpha1.OptimizeVolumeAsyncRequest) error {
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(v2alpha1.OptimizeVolumeRequest)
+		if err := Convert_impl_OptimizeVolumeRequest_To_v2alpha1_OptimizeVolumeRequest(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Request = nil
+	}
+	return nil
+}
+
+// Convert_impl_OptimizeVolumeAsyncRequest_To_v2alpha1_OptimizeVolumeAsyncRequest is an autogenerated conversion function.
+func Convert_impl_OptimizeVolumeAsyncRequest_To_v2alpha1_OptimizeVolumeAsyncRequest(in *impl.OptimizeVolumeAsyncRequest, out *v2alpha1.OptimizeVolumeAsyncRequest) error {
+	return autoConvert_impl_OptimizeVolumeAsyncRequest_To_v2alpha1_OptimizeVolumeAsyncRequest(in, out)
+}
+
+func autoConvert_v2alpha1_OptimizeVolumeAsyncResponse_To_impl_OptimizeVolumeAsyncResponse(in *v2alpha1.OptimizeVolumeAsyncResponse, out *impl.OptimizeVolumeAsyncResponse) error {
+	out.OperationId = in.OperationId
+	return nil
+}
+
+// Convert_v2alpha1_OptimizeVolumeAsyncResponse_To_impl_OptimizeVolumeAsyncResponse is an autogenerated conversion function.
+func Convert_v2alpha1_OptimizeVolumeAsyncResponse_To_impl_OptimizeVolumeAsyncResponse(in *v2alpha1.OptimizeVolumeAsyncResponse, out *impl.OptimizeVolumeAsyncResponse) error {
+	return autoConvert_v2alpha1_OptimizeVolumeAsyncResponse_To_impl_OptimizeVolumeAsyncResponse(in, out)
+}
+
+func autoConvert_impl_OptimizeVolumeAsyncResponse_To_v2alpha1_OptimizeVolumeAsyncResponse(in *impl.OptimizeVolumeAsyncResponse, out *v2alpha1.OptimizeVolumeAsyncResponse) error {
+	out.OperationId = in.OperationId
+	return nil
+}
+
+// Convert_impl_OptimizeVolumeAsyncResponse_To_v2alpha1_OptimizeVolumeAsyncResponse is an autogenerated conversion function.
+func Convert_impl_OptimizeVolumeAsyncResponse_To_v2alpha1_OptimizeVolumeAsyncResponse(in *impl.OptimizeVolumeAsyncResponse, out *v2alpha1.OptimizeVolumeAsyncResponse) error {
+	return autoConvert_impl_OptimizeVolumeAsyncResponse_To_v2alpha1_OptimizeVolumeAsyncResponse(in, out)
+}
+
+func autoConvert_v2alpha1_OptimizeVolumeRequest_To_impl_OptimizeVolumeRequest(in *v2alpha1.OptimizeVolumeRequest, out *impl.OptimizeVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Retrim = in.Retrim
+	out.Defrag = in.Defrag
+	out.SlabConsolidate = in.SlabConsolidate
+	return nil
+}
+
+// Convert_v2alpha1_OptimizeVolumeRequest_To_impl_OptimizeVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha1_OptimizeVolumeRequest_To_impl_OptimizeVolumeRequest(in *v2alpha1.OptimizeVolumeRequest, out *impl.OptimizeVolumeRequest) error {
+	return autoConvert_v2alpha1_OptimizeVolumeRequest_To_impl_OptimizeVolumeRequest(in, out)
+}
+
+func autoConvert_impl_OptimizeVolumeRequest_To_v2alpha1_OptimizeVolumeRequest(in *impl.OptimizeVolumeRequest, out *v2alpha1.OptimizeVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Retrim = in.Retrim
+	out.Defrag = in.Defrag
+	out.SlabConsolidate = in.SlabConsolidate
+	return nil
+}
+
+// Convert_impl_OptimizeVolumeRequest_To_v2alpha1_OptimizeVolumeRequest is an autogenerated conversion function.
+func Convert_impl_OptimizeVolumeRequest_To_v2alpha1_OptimizeVolumeRequest(in *impl.OptimizeVolumeRequest, out *v2alpha1.OptimizeVolumeRequest) error {
+	return autoConvert_impl_OptimizeVolumeRequest_To_v2alpha1_OptimizeVolumeRequest(in, out)
+}
+
+func autoConvert_v2alpha1_OptimizeVolumeResponse_To_impl_OptimizeVolumeResponse(in *v2alpha1.OptimizeVolumeResponse, out *impl.OptimizeVolumeResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_OptimizeVolumeResponse_To_impl_OptimizeVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha1_OptimizeVolumeResponse_To_impl_OptimizeVolumeResponse(in *v2alpha1.OptimizeVolumeResponse, out *impl.OptimizeVolumeResponse) error {
+	return autoConvert_v2alpha1_OptimizeVolumeResponse_To_impl_OptimizeVolumeResponse(in, out)
+}
+
+func autoConvert_impl_OptimizeVolumeResponse_To_v2alpha1_OptimizeVolumeResponse(in *impl.OptimizeVolumeResponse, out *v2alpha1.OptimizeVolumeResponse) error {
+	return nil
+}
+
+// Convert_impl_OptimizeVolumeResponse_To_v2alpha1_OptimizeVolumeResponse is an autogenerated conversion function.
+func Convert_impl_OptimizeVolumeResponse_To_v2alpha1_OptimizeVolumeResponse(in *impl.OptimizeVolumeResponse, out *v2alpha1.OptimizeVolumeResponse) error {
+	return autoConvert_impl_OptimizeVolumeResponse_To_v2alpha1_OptimizeVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha1_PrepareStagingPathRequest_To_impl_PrepareStagingPathRequest(in *v2alpha1.PrepareStagingPathRequest, out *impl.PrepareStagingPathRequest) error {
+	out.StagingPath = in.StagingPath
+	out.Sddl = in.Sddl
+	return nil
+}
+
+// Convert_v2alpha1_PrepareStagingPathRequest_To_impl_PrepareStagingPathRequest is an autogenerated conversion function.
+func Convert_v2alpha1_PrepareStagingPathRequest_To_impl_PrepareStagingPathRequest(in *v2alpha1.PrepareStagingPathRequest, out *impl.PrepareStagingPathRequest) error {
+	return autoConvert_v2alpha1_PrepareStagingPathRequest_To_impl_PrepareStagingPathRequest(in, out)
+}
+
+func autoConvert_impl_PrepareStagingPathRequest_To_v2alpha1_PrepareStagingPathRequest(in *impl.PrepareStagingPathRequest, out *v2alpha1.PrepareStagingPathRequest) error {
+	out.StagingPath = in.StagingPath
+	out.Sddl = in.Sddl
+	return nil
+}
+
+// Convert_impl_PrepareStagingPathRequest_To_v2alpha1_PrepareStagingPathRequest is an autogenerated conversion function.
+func Convert_impl_PrepareStagingPathRequest_To_v2alpha1_PrepareStagingPathRequest(in *impl.PrepareStagingPathRequest, out *v2alpha1.PrepareStagingPathRequest) error {
+	return autoConvert_impl_PrepareStagingPathRequest_To_v2alpha1_PrepareStagingPathRequest(in, out)
+}
+
+func autoConvert_v2alpha1_PrepareStagingPathResponse_To_impl_PrepareStagingPathResponse(in *v2alpha1.PrepareStagingPathResponse, out *impl.PrepareStagingPathResponse) error {
+	out.Created = in.Created
+	return nil
+}
+
+// Convert_v2alpha1_PrepareStagingPathResponse_To_impl_PrepareStagingPathResponse is an autogenerated conversion function.
+func Convert_v2alpha1_PrepareStagingPathResponse_To_impl_PrepareStagingPathResponse(in *v2alpha1.PrepareStagingPathResponse, out *impl.PrepareStagingPathResponse) error {
+	return autoConvert_v2alpha1_PrepareStagingPathResponse_To_impl_PrepareStagingPathResponse(in, out)
+}
+
+func autoConvert_impl_PrepareStagingPathResponse_To_v2alpha1_PrepareStagingPathResponse(in *impl.PrepareStagingPathResponse, out *v2alpha1.PrepareStagingPathResponse) error {
+	out.Created = in.Created
+	return nil
+}
+
+// Convert_impl_PrepareStagingPathResponse_To_v2alpha1_PrepareStagingPathResponse is an autogenerated conversion function.
+func Convert_impl_PrepareStagingPathResponse_To_v2alpha1_PrepareStagingPathResponse(in *impl.PrepareStagingPathResponse, out *v2alpha1.PrepareStagingPathResponse) error {
+	return autoConvert_impl_PrepareStagingPathResponse_To_v2alpha1_PrepareStagingPathResponse(in, out)
+}
+
+func autoConvert_v2alpha1_RemoveBoundedDirectoryRequest_To_impl_RemoveBoundedDirectoryRequest(in *v2alpha1.RemoveBoundedDirectoryRequest, out *impl.RemoveBoundedDirectoryRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v2alpha1_RemoveBoundedDirectoryRequest_To_impl_RemoveBoundedDirectoryRequest is an autogenerated conversion function.
+func Convert_v2alpha1_RemoveBoundedDirectoryRequest_To_impl_RemoveBoundedDirectoryRequest(in *v2alpha1.RemoveBoundedDirectoryRequest, out *impl.RemoveBoundedDirectoryRequest) error {
+	return autoConvert_v2alpha1_RemoveBoundedDirectoryRequest_To_impl_RemoveBoundedDirectoryRequest(in, out)
+}
+
+func autoConvert_impl_RemoveBoundedDirectoryRequest_To_v2alpha1_RemoveBoundedDirectoryRequest(in *impl.RemoveBoundedDirectoryRequest, out *v2alpha1.RemoveBoundedDirectoryRequest) error {
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_impl_RemoveBoundedDirectoryRequest_To_v2alpha1_RemoveBoundedDirectoryRequest is an autogenerated conversion function.
+func Convert_impl_RemoveBoundedDirectoryRequest_To_v2alpha1_RemoveBoundedDirectoryRequest(in *impl.RemoveBoundedDirectoryRequest, out *v2alpha1.RemoveBoundedDirectoryRequest) error {
+	return autoConvert_impl_RemoveBoundedDirectoryRequest_To_v2alpha1_RemoveBoundedDirectoryRequest(in, out)
+}
+
+func autoConvert_v2alpha1_RemoveBoundedDirectoryResponse_To_impl_RemoveBoundedDirectoryResponse(in *v2alpha1.RemoveBoundedDirectoryResponse, out *impl.RemoveBoundedDirectoryResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_RemoveBoundedDirectoryResponse_To_impl_RemoveBoundedDirectoryResponse is an autogenerated conversion function.
+func Convert_v2alpha1_RemoveBoundedDirectoryResponse_To_impl_RemoveBoundedDirectoryResponse(in *v2alpha1.RemoveBoundedDirectoryResponse, out *impl.RemoveBoundedDirectoryResponse) error {
+	return autoConvert_v2alpha1_RemoveBoundedDirectoryResponse_To_impl_RemoveBoundedDirectoryResponse(in, out)
+}
+
+func autoConvert_impl_RemoveBoundedDirectoryResponse_To_v2alpha1_RemoveBoundedDirectoryResponse(in *impl.RemoveBoundedDirectoryResponse, out *v2alpha1.RemoveBoundedDirectoryResponse) error {
+	return nil
+}
+
+// Convert_impl_RemoveBoundedDirectoryResponse_To_v2alpha1_RemoveBoundedDirectoryResponse is an autogenerated conversion function.
+func Convert_impl_RemoveBoundedDirectoryResponse_To_v2alpha1_RemoveBoundedDirectoryResponse(in *impl.RemoveBoundedDirectoryResponse, out *v2alpha1.RemoveBoundedDirectoryResponse) error {
+	return autoConvert_impl_RemoveBoundedDirectoryResponse_To_v2alpha1_RemoveBoundedDirectoryResponse(in, out)
+}
+
+func autoConvert_v2alpha1_RepairVolumeAsyncRequest_To_impl_RepairVolumeAsyncRequest(in *v2alpha1.RepairVolumeAsyncRequest, out *impl.RepairVolumeAsyncRequest) error {
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(impl.RepairVolumeRequest)
+		if err := Convert_v2alpha1_RepairVolumeRequest_To_impl_RepairVolumeRequest(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Request = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_RepairVolumeAsyncRequest_To_impl_RepairVolumeAsyncRequest is an autogenerated conversion function.
+func Convert_v2alpha1_RepairVolumeAsyncRequest_To_impl_RepairVolumeAsyncRequest(in *v2alpha1.RepairVolumeAsyncRequest, out *impl.RepairVolumeAsyncRequest) error {
+	return autoConvert_v2alpha1_RepairVolumeAsyncRequest_To_impl_RepairVolumeAsyncRequest(in, out)
+}
+
+func autoConvert_impl_RepairVolumeAsyncRequest_To_v2alpha1_RepairVolumeAsyncRequest(in *impl.RepairVolumeAsyncRequest, out *v2alpha1.RepairVolumeAsyncRequest) error {
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(v2alpha1.RepairVolumeRequest)
+		if err := Convert_impl_RepairVolumeRequest_To_v2alpha1_RepairVolumeRequest(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Request = nil
+	}
+	return nil
+}
+
+// Convert_impl_RepairVolumeAsyncRequest_To_v2alpha1_RepairVolumeAsyncRequest is an autogenerated conversion function.
+func Convert_impl_RepairVolumeAsyncRequest_To_v2alpha1_RepairVolumeAsyncRequest(in *impl.RepairVolumeAsyncRequest, out *v2alpha1.RepairVolumeAsyncRequest) error {
+	return autoConvert_impl_RepairVolumeAsyncRequest_To_v2alpha1_RepairVolumeAsyncRequest(in, out)
+}
+
+func autoConvert_v2alpha1_RepairVolumeAsyncResponse_To_impl_RepairVolumeAsyncResponse(in *v2alpha1.RepairVolumeAsyncResponse, out *impl.RepairVolumeAsyncResponse) error {
+	out.OperationId = in.OperationId
+	return nil
+}
+
+// Convert_v2alpha1_RepairVolumeAsyncResponse_To_impl_RepairVolumeAsyncResponse is an autogenerated conversion function.
+func Convert_v2alpha1_RepairVolumeAsyncResponse_To_impl_RepairVolumeAsyncResponse(in *v2alpha1.RepairVolumeAsyncResponse, out *impl.RepairVolumeAsyncResponse) error {
+	return autoConvert_v2alpha1_RepairVolumeAsyncResponse_To_impl_RepairVolumeAsyncResponse(in, out)
+}
+
+func autoConvert_impl_RepairVolumeAsyncResponse_To_v2alpha1_RepairVolumeAsyncResponse(in *impl.RepairVolumeAsyncResponse, out *v2alpha1.RepairVolumeAsyncResponse) error {
+	out.OperationId = in.OperationId
+	return nil
+}
+
+// Convert_impl_RepairVolumeAsyncResponse_To_v2alpha1_RepairVolumeAsyncResponse is an autogenerated conversion function.
+func Convert_impl_RepairVolumeAsyncResponse_To_v2alpha1_RepairVolumeAsyncResponse(in *impl.RepairVolumeAsyncResponse, out *v2alpha1.RepairVolumeAsyncResponse) error {
+	return autoConvert_impl_RepairVolumeAsyncResponse_To_v2alpha1_RepairVolumeAsyncResponse(in, out)
+}
+
+func autoConvert_v2alpha1_RepairVolumeRequest_To_impl_RepairVolumeRequest(in *v2alpha1.RepairVolumeRequest, out *impl.RepairVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Mode = impl.RepairMode(in.Mode)
+	return nil
+}
+
+// Convert_v2alpha1_RepairVolumeRequest_To_impl_RepairVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha1_RepairVolumeRequest_To_impl_RepairVolumeRequest(in *v2alpha1.RepairVolumeRequest, out *impl.RepairVolumeRequest) error {
+	return autoConvert_v2alpha1_RepairVolumeRequest_To_impl_RepairVolumeRequest(in, out)
+}
+
+func autoConvert_impl_RepairVolumeRequest_To_v2alpha1_RepairVolumeRequest(in *impl.RepairVolumeRequest, out *v2alpha1.RepairVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Mode = v2alpha1.RepairMode(in.Mode)
+	return nil
+}
+
+// Convert_impl_RepairVolumeRequest_To_v2alpha1_RepairVolumeRequest is an autogenerated conversion function.
+func Convert_impl_RepairVolumeRequest_To_v2alpha1_RepairVolumeRequest(in *impl.RepairVolumeRequest, out *v2alpha1.RepairVolumeRequest) error {
+	return autoConvert_impl_RepairVolumeRequest_To_v2alpha1_RepairVolumeRequest(in, out)
+}
+
+func autoConvert_v2alpha1_RepairVolumeResponse_To_impl_RepairVolumeResponse(in *v2alpha1.RepairVolumeResponse, out *impl.RepairVolumeResponse) error {
+	out.Result = in.Result
+	return nil
+}
+
+// Convert_v2alpha1_RepairVolumeResponse_To_impl_RepairVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha1_RepairVolumeResponse_To_impl_RepairVolumeResponse(in *v2alpha1.RepairVolumeResponse, out *impl.RepairVolumeResponse) error {
+	return autoConvert_v2alpha1_RepairVolumeResponse_To_impl_RepairVolumeResponse(in, out)
+}
+
+func autoConvert_impl_RepairVolumeResponse_To_v2alpha1_RepairVolumeResponse(in *impl.RepairVolumeResponse, out *v2alpha1.RepairVolumeResponse) error {
+	out.Result = in.Result
+	return nil
+}
+
+// Convert_impl_RepairVolumeResponse_To_v2alpha1_RepairVolumeResponse is an autogenerated conversion function.
+func Convert_impl_RepairVolumeResponse_To_v2alpha1_RepairVolumeResponse(in *impl.RepairVolumeResponse, out *v2alpha1.RepairVolumeResponse) error {
+	return autoConvert_impl_RepairVolumeResponse_To_v2alpha1_RepairVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha1_ResizeVolumeAsyncRequest_To_impl_ResizeVolumeAsyncRequest(in *v2alpha1.ResizeVolumeAsyncRequest, out *impl.ResizeVolumeAsyncRequest) error {
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(impl.ResizeVolumeRequest)
+		if err := Convert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Request = nil
+	}
+	return nil
+}
+
+// Convert_v2alpha1_ResizeVolumeAsyncRequest_To_impl_ResizeVolumeAsyncRequest is an autogenerated conversion function.
+func Convert_v2alpha1_ResizeVolumeAsyncRequest_To_impl_ResizeVolumeAsyncRequest(in *v2alpha1.ResizeVolumeAsyncRequest, out *impl.ResizeVolumeAsyncRequest) error {
+	return autoConvert_v2alpha1_ResizeVolumeAsyncRequest_To_impl_ResizeVolumeAsyncRequest(in, out)
+}
+
+func autoConvert_impl_ResizeVolumeAsyncRequest_To_v2alpha1_ResizeVolumeAsyncRequest(in *impl.ResizeVolumeAsyncRequest, out *v2alpha1.ResizeVolumeAsyncRequest) error {
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(v2alpha1.ResizeVolumeRequest)
+		if err := Convert_impl_ResizeVolumeRequest_To_v2alpha1_ResizeVolumeRequest(*in, *out); err != nil {
+			return err
+		}
+	} else {
+		out.Request = nil
+	}
+	return nil
+}
+
+// Convert_impl_ResizeVolumeAsyncRequest_To_v2alpha1_ResizeVolumeAsyncRequest is an autogenerated conversion function.
+func Convert_impl_ResizeVolumeAsyncRequest_To_v2alpha1_ResizeVolumeAsyncRequest(in *impl.ResizeVolumeAsyncRequest, out *v2alpha1.ResizeVolumeAsyncRequest) error {
+	return autoConvert_impl_ResizeVolumeAsyncRequest_To_v2alpha1_ResizeVolumeAsyncRequest(in, out)
+}
+
+func autoConvert_v2alpha1_ResizeVolumeAsyncResponse_To_impl_ResizeVolumeAsyncResponse(in *v2alpha1.ResizeVolumeAsyncResponse, out *impl.ResizeVolumeAsyncResponse) error {
+	out.OperationId = in.OperationId
+	return nil
+}
+
+// Convert_v2alpha1_ResizeVolumeAsyncResponse_To_impl_ResizeVolumeAsyncResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ResizeVolumeAsyncResponse_To_impl_ResizeVolumeAsyncResponse(in *v2alpha1.ResizeVolumeAsyncResponse, out *impl.ResizeVolumeAsyncResponse) error {
+	return autoConvert_v2alpha1_ResizeVolumeAsyncResponse_To_impl_ResizeVolumeAsyncResponse(in, out)
+}
+
+func autoConvert_impl_ResizeVolumeAsyncResponse_To_v2alpha1_ResizeVolumeAsyncResponse(in *impl.ResizeVolumeAsyncResponse, out *v2alpha1.ResizeVolumeAsyncResponse) error {
+	out.OperationId = in.OperationId
+	return nil
+}
+
+// Convert_impl_ResizeVolumeAsyncResponse_To_v2alpha1_ResizeVolumeAsyncResponse is an autogenerated conversion function.
+func Convert_impl_ResizeVolumeAsyncResponse_To_v2alpha1_ResizeVolumeAsyncResponse(in *impl.ResizeVolumeAsyncResponse, out *v2alpha1.ResizeVolumeAsyncResponse) error {
+	return autoConvert_impl_ResizeVolumeAsyncResponse_To_v2alpha1_ResizeVolumeAsyncResponse(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(in *v2alpha1.ResizeVolumeRequest, out *impl.ResizeVolumeRequest) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_ResizeVolumeRequest_To_v2alpha1_ResizeVolumeRequest(in *impl.ResizeVolumeRequest, out *v2alpha1.ResizeVolumeRequest) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_ResizeVolumeResponse_To_impl_ResizeVolumeResponse(in *v2alpha1.ResizeVolumeResponse, out *impl.ResizeVolumeResponse) error {
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_v2alpha1_ResizeVolumeResponse_To_impl_ResizeVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha1_ResizeVolumeResponse_To_impl_ResizeVolumeResponse(in *v2alpha1.ResizeVolumeResponse, out *impl.ResizeVolumeResponse) error {
+	return autoConvert_v2alpha1_ResizeVolumeResponse_To_impl_ResizeVolumeResponse(in, out)
+}
+
+func autoConvert_impl_ResizeVolumeResponse_To_v2alpha1_ResizeVolumeResponse(in *impl.ResizeVolumeResponse, out *v2alpha1.ResizeVolumeResponse) error {
+	out.Warnings = *(*[]string)(unsafe.Pointer(&in.Warnings))
+	return nil
+}
+
+// Convert_impl_ResizeVolumeResponse_To_v2alpha1_ResizeVolumeResponse is an autogenerated conversion function.
+func Convert_impl_ResizeVolumeResponse_To_v2alpha1_ResizeVolumeResponse(in *impl.ResizeVolumeResponse, out *v2alpha1.ResizeVolumeResponse) error {
+	return autoConvert_impl_ResizeVolumeResponse_To_v2alpha1_ResizeVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha1_SetVolumeCompressionRequest_To_impl_SetVolumeCompressionRequest(in *v2alpha1.SetVolumeCompressionRequest, out *impl.SetVolumeCompressionRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v2alpha1_SetVolumeCompressionRequest_To_impl_SetVolumeCompressionRequest is an autogenerated conversion function.
+func Convert_v2alpha1_SetVolumeCompressionRequest_To_impl_SetVolumeCompressionRequest(in *v2alpha1.SetVolumeCompressionRequest, out *impl.SetVolumeCompressionRequest) error {
+	return autoConvert_v2alpha1_SetVolumeCompressionRequest_To_impl_SetVolumeCompressionRequest(in, out)
+}
+
+func autoConvert_impl_SetVolumeCompressionRequest_To_v2alpha1_SetVolumeCompressionRequest(in *impl.SetVolumeCompressionRequest, out *v2alpha1.SetVolumeCompressionRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_impl_SetVolumeCompressionRequest_To_v2alpha1_SetVolumeCompressionRequest is an autogenerated conversion function.
+func Convert_impl_SetVolumeCompressionRequest_To_v2alpha1_SetVolumeCompressionRequest(in *impl.SetVolumeCompressionRequest, out *v2alpha1.SetVolumeCompressionRequest) error {
+	return autoConvert_impl_SetVolumeCompressionRequest_To_v2alpha1_SetVolumeCompressionRequest(in, out)
+}
+
+func autoConvert_v2alpha1_SetVolumeCompressionResponse_To_impl_SetVolumeCompressionResponse(in *v2alpha1.SetVolumeCompressionResponse, out *impl.SetVolumeCompressionResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_SetVolumeCompressionResponse_To_impl_SetVolumeCompressionResponse is an autogenerated conversion function.
+func Convert_v2alpha1_SetVolumeCompressionResponse_To_impl_SetVolumeCompressionResponse(in *v2alpha1.SetVolumeCompressionResponse, out *impl.SetVolumeCompressionResponse) error {
+	return autoConvert_v2alpha1_SetVolumeCompressionResponse_To_impl_SetVolumeCompressionResponse(in, out)
+}
+
+func autoConvert_impl_SetVolumeCompressionResponse_To_v2alpha1_SetVolumeCompressionResponse(in *impl.SetVolumeCompressionResponse, out *v2alpha1.SetVolumeCompressionResponse) error {
+	return nil
+}
+
+// Convert_impl_SetVolumeCompressionResponse_To_v2alpha1_SetVolumeCompressionResponse is an autogenerated conversion function.
+func Convert_impl_SetVolumeCompressionResponse_To_v2alpha1_SetVolumeCompressionResponse(in *impl.SetVolumeCompressionResponse, out *v2alpha1.SetVolumeCompressionResponse) error {
+	return autoConvert_impl_SetVolumeCompressionResponse_To_v2alpha1_SetVolumeCompressionResponse(in, out)
+}
+
+func autoConvert_v2alpha1_SetVolumeLabelRequest_To_impl_SetVolumeLabelRequest(in *v2alpha1.SetVolumeLabelRequest, out *impl.SetVolumeLabelRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Label = in.Label
+	return nil
+}
+
+// Convert_v2alpha1_SetVolumeLabelRequest_To_impl_SetVolumeLabelRequest is an autogenerated conversion function.
+func Convert_v2alpha1_SetVolumeLabelRequest_To_impl_SetVolumeLabelRequest(in *v2alpha1.SetVolumeLabelRequest, out *impl.SetVolumeLabelRequest) error {
+	return autoConvert_v2alpha1_SetVolumeLabelRequest_To_impl_SetVolumeLabelRequest(in, out)
+}
+
+func autoConvert_impl_SetVolumeLabelRequest_To_v2alpha1_SetVolumeLabelRequest(in *impl.SetVolumeLabelRequest, out *v2alpha1.SetVolumeLabelRequest) error {
+	out.VolumeId = in.VolumeId
+	out.Label = in.Label
+	return nil
+}
+
+// Convert_impl_SetVolumeLabelRequest_To_v2alpha1_SetVolumeLabelRequest is an autogenerated conversion function.
+func Convert_impl_SetVolumeLabelRequest_To_v2alpha1_SetVolumeLabelRequest(in *impl.SetVolumeLabelRequest, out *v2alpha1.SetVolumeLabelRequest) error {
+	return autoConvert_impl_SetVolumeLabelRequest_To_v2alpha1_SetVolumeLabelRequest(in, out)
+}
+
+func autoConvert_v2alpha1_SetVolumeLabelResponse_To_impl_SetVolumeLabelResponse(in *v2alpha1.SetVolumeLabelResponse, out *impl.SetVolumeLabelResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_SetVolumeLabelResponse_To_impl_SetVolumeLabelResponse is an autogenerated conversion function.
+func Convert_v2alpha1_SetVolumeLabelResponse_To_impl_SetVolumeLabelResponse(in *v2alpha1.SetVolumeLabelResponse, out *impl.SetVolumeLabelResponse) error {
+	return autoConvert_v2alpha1_SetVolumeLabelResponse_To_impl_SetVolumeLabelResponse(in, out)
+}
+
+func autoConvert_impl_SetVolumeLabelResponse_To_v2alpha1_SetVolumeLabelResponse(in *impl.SetVolumeLabelResponse, out *v2alpha1.SetVolumeLabelResponse) error {
+	return nil
+}
+
+// Convert_impl_SetVolumeLabelResponse_To_v2alpha1_SetVolumeLabelResponse is an autogenerated conversion function.
+func Convert_impl_SetVolumeLabelResponse_To_v2alpha1_SetVolumeLabelResponse(in *impl.SetVolumeLabelResponse, out *v2alpha1.SetVolumeLabelResponse) error {
+	return autoConvert_impl_SetVolumeLabelResponse_To_v2alpha1_SetVolumeLabelResponse(in, out)
+}
+
+func autoConvert_v2alpha1_SyncVolumeRequest_To_impl_SyncVolumeRequest(in *v2alpha1.SyncVolumeRequest, out *impl.SyncVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha1_SyncVolumeRequest_To_impl_SyncVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha1_SyncVolumeRequest_To_impl_SyncVolumeRequest(in *v2alpha1.SyncVolumeRequest, out *impl.SyncVolumeRequest) error {
+	return autoConvert_v2alpha1_SyncVolumeRequest_To_impl_SyncVolumeRequest(in, out)
+}
+
+func autoConvert_impl_SyncVolumeRequest_To_v2alpha1_SyncVolumeRequest(in *impl.SyncVolumeRequest, out *v2alpha1.SyncVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_SyncVolumeRequest_To_v2alpha1_SyncVolumeRequest is an autogenerated conversion function.
+func Convert_impl_SyncVolumeRequest_To_v2alpha1_SyncVolumeRequest(in *impl.SyncVolumeRequest, out *v2alpha1.SyncVolumeRequest) error {
+	return autoConvert_impl_SyncVolumeRequest_To_v2alpha1_SyncVolumeRequest(in, out)
+}
+
+func autoConvert_v2alpha1_SyncVolumeResponse_To_impl_SyncVolumeResponse(in *v2alpha1.SyncVolumeResponse, out *impl.SyncVolumeResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_SyncVolumeResponse_To_impl_SyncVolumeResponse is an autogenerated conversion function.
+func Convert_v2alpha1_SyncVolumeResponse_To_impl_SyncVolumeResponse(in *v2alpha1.SyncVolumeResponse, out *impl.SyncVolumeResponse) error {
+	return autoConvert_v2alpha1_SyncVolumeResponse_To_impl_SyncVolumeResponse(in, out)
+}
+
+func autoConvert_impl_SyncVolumeResponse_To_v2alpha1_SyncVolumeResponse(in *impl.SyncVolumeResponse, out *v2alpha1.SyncVolumeResponse) error {
+	return nil
+}
+
+// Convert_impl_SyncVolumeResponse_To_v2alpha1_SyncVolumeResponse is an autogenerated conversion function.
+func Convert_impl_SyncVolumeResponse_To_v2alpha1_SyncVolumeResponse(in *impl.SyncVolumeResponse, out *v2alpha1.SyncVolumeResponse) error {
+	return autoConvert_impl_SyncVolumeResponse_To_v2alpha1_SyncVolumeResponse(in, out)
+}
+
+func autoConvert_v2alpha1_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(in *v2alpha1.UnmountVolumeRequest, out *impl.UnmountVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.TargetPath = in.TargetPath
+	out.Force = in.Force
+	return nil
+}
+
+// Convert_v2alpha1_UnmountVolumeRequest_To_impl_UnmountVolumeRequest is an autogenerated conversion function.
+func Convert_v2alpha1_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(in *v2alpha1.UnmountVolumeRequest, out *impl.UnmountVolumeRequest) error {
+	return autoConvert_v2alpha1_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(in, out)
+}
+
+func autoConvert_impl_UnmountVolumeRequest_To_v2alpha1_UnmountVolumeRequest(in *impl.UnmountVolumeRequest, out *v2alpha1.UnmountVolumeRequest) error {
+	out.VolumeId = in.VolumeId
+	out.TargetPath = in.TargetPath
+	out.Force = in.Force
+	return nil
+}
+
+// Convert_impl_UnmountVolumeRequest_To_v2alpha1_UnmountVolumeRequest is an autogenerated conversion function.
+func Convert_impl_UnmountVolumeRequest_To_v2alpha1_UnmountVolumeRequest(in *impl.UnmountVolumeRequest, out *v2alpha1.UnmountVolumeRequest) error {
+	return autoConvert_impl_UnmountVolumeRequest_To_v2alpha1_UnmountVolumeRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_UnmountVolumeResponse_To_impl_UnmountVolumeResponse(in *v2alpha1.UnmountVolumeResponse, out *impl.UnmountVolumeResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_UnmountVolumeResponse_To_v2alpha1_UnmountVolumeResponse(in *impl.UnmountVolumeResponse, out *v2alpha1.UnmountVolumeResponse) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_VolumeCacheError_To_impl_VolumeCacheError(in *v2alpha1.VolumeCacheError, out *impl.VolumeCacheError) error {
+	out.VolumeId = in.VolumeId
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_v2alpha1_VolumeCacheError_To_impl_VolumeCacheError is an autogenerated conversion function.
+func Convert_v2alpha1_VolumeCacheError_To_impl_VolumeCacheError(in *v2alpha1.VolumeCacheError, out *impl.VolumeCacheError) error {
+	return autoConvert_v2alpha1_VolumeCacheError_To_impl_VolumeCacheError(in, out)
+}
+
+func autoConvert_impl_VolumeCacheError_To_v2alpha1_VolumeCacheError(in *impl.VolumeCacheError, out *v2alpha1.VolumeCacheError) error {
+	out.VolumeId = in.VolumeId
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_impl_VolumeCacheError_To_v2alpha1_VolumeCacheError is an autogenerated conversion function.
+func Convert_impl_VolumeCacheError_To_v2alpha1_VolumeCacheError(in *impl.VolumeCacheError, out *v2alpha1.VolumeCacheError) error {
+	return autoConvert_impl_VolumeCacheError_To_v2alpha1_VolumeCacheError(in, out)
+}
+
+func autoConvert_v2alpha1_VolumeDiscoveryRule_To_impl_VolumeDiscoveryRule(in *v2alpha1.VolumeDiscoveryRule, out *impl.VolumeDiscoveryRule) error {
+	out.Name = in.Name
+	out.PathPrefixes = *(*[]string)(unsafe.Pointer(&in.PathPrefixes))
+	out.GptNames = *(*[]string)(unsafe.Pointer(&in.GptNames))
+	out.Labels = *(*[]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_v2alpha1_VolumeDiscoveryRule_To_impl_VolumeDiscoveryRule is an autogenerated conversion function.
+func Convert_v2alpha1_VolumeDiscoveryRule_To_impl_VolumeDiscoveryRule(in *v2alpha1.VolumeDiscoveryRule, out *impl.VolumeDiscoveryRule) error {
+	return autoConvert_v2alpha1_VolumeDiscoveryRule_To_impl_VolumeDiscoveryRule(in, out)
+}
+
+func autoConvert_impl_VolumeDiscoveryRule_To_v2alpha1_VolumeDiscoveryRule(in *impl.VolumeDiscoveryRule, out *v2alpha1.VolumeDiscoveryRule) error {
+	out.Name = in.Name
+	out.PathPrefixes = *(*[]string)(unsafe.Pointer(&in.PathPrefixes))
+	out.GptNames = *(*[]string)(unsafe.Pointer(&in.GptNames))
+	out.Labels = *(*[]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_impl_VolumeDiscoveryRule_To_v2alpha1_VolumeDiscoveryRule is an autogenerated conversion function.
+func Convert_impl_VolumeDiscoveryRule_To_v2alpha1_VolumeDiscoveryRule(in *impl.VolumeDiscoveryRule, out *v2alpha1.VolumeDiscoveryRule) error {
+	return autoConvert_impl_VolumeDiscoveryRule_To_v2alpha1_VolumeDiscoveryRule(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_VolumeEvent_To_impl_VolumeEvent(in *v2alpha1.VolumeEvent, out *impl.VolumeEvent) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_VolumeEvent_To_v2alpha1_VolumeEvent(in *impl.VolumeEvent, out *v2alpha1.VolumeEvent) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_v2alpha1_VolumeFilter_To_impl_VolumeFilter(in *v2alpha1.VolumeFilter, out *impl.VolumeFilter) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_VolumeFilter_To_v2alpha1_VolumeFilter(in *impl.VolumeFilter, out *v2alpha1.VolumeFilter) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_v2alpha1_VolumeInfo_To_impl_VolumeInfo(in *v2alpha1.VolumeInfo, out *impl.VolumeInfo) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_VolumeInfo_To_v2alpha1_VolumeInfo(in *impl.VolumeInfo, out *v2alpha1.VolumeInfo) error
+// skipping generation of the auto function
+
+func autoConvert_v2alpha1_WatchVolumesRequest_To_impl_WatchVolumesRequest(in *v2alpha1.WatchVolumesRequest, out *impl.WatchVolumesRequest) error {
+	out.VolumeIds = *(*[]string)(unsafe.Pointer(&in.VolumeIds))
+	return nil
+}
+
+// Convert_v2alpha1_WatchVolumesRequest_To_impl_WatchVolumesRequest is an autogenerated conversion function.
+func Convert_v2alpha1_WatchVolumesRequest_To_impl_WatchVolumesRequest(in *v2alpha1.WatchVolumesRequest, out *impl.WatchVolumesRequest) error {
+	return autoConvert_v2alpha1_WatchVolumesRequest_To_impl_WatchVolumesRequest(in, out)
+}
+
+func autoConvert_impl_WatchVolumesRequest_To_v2alpha1_WatchVolumesRequest(in *impl.WatchVolumesRequest, out *v2alpha1.WatchVolumesRequest) error {
+	out.VolumeIds = *(*[]string)(unsafe.Pointer(&in.VolumeIds))
+	return nil
+}
+
+// Convert_impl_WatchVolumesRequest_To_v2alpha1_WatchVolumesRequest is an autogenerated conversion function.
+func Convert_impl_WatchVolumesRequest_To_v2alpha1_WatchVolumesRequest(in *impl.WatchVolumesRequest, out *v2alpha1.WatchVolumesRequest) error {
+	return autoConvert_impl_WatchVolumesRequest_To_v2alpha1_WatchVolumesRequest(in, out)
+}
+
+func autoConvert_v2alpha1_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(in *v2alpha1.WriteVolumeCacheRequest, out *impl.WriteVolumeCacheRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_v2alpha1_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest is an autogenerated conversion function.
+func Convert_v2alpha1_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(in *v2alpha1.WriteVolumeCacheRequest, out *impl.WriteVolumeCacheRequest) error {
+	return autoConvert_v2alpha1_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(in, out)
+}
+
+func autoConvert_impl_WriteVolumeCacheRequest_To_v2alpha1_WriteVolumeCacheRequest(in *impl.WriteVolumeCacheRequest, out *v2alpha1.WriteVolumeCacheRequest) error {
+	out.VolumeId = in.VolumeId
+	return nil
+}
+
+// Convert_impl_WriteVolumeCacheRequest_To_v2alpha1_WriteVolumeCacheRequest is an autogenerated conversion function.
+func Convert_impl_WriteVolumeCacheRequest_To_v2alpha1_WriteVolumeCacheRequest(in *impl.WriteVolumeCacheRequest, out *v2alpha1.WriteVolumeCacheRequest) error {
+	return autoConvert_impl_WriteVolumeCacheRequest_To_v2alpha1_WriteVolumeCacheRequest(in, out)
+}
+
+func autoConvert_v2alpha1_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse(in *v2alpha1.WriteVolumeCacheResponse, out *impl.WriteVolumeCacheResponse) error {
+	return nil
+}
+
+// Convert_v2alpha1_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse is an autogenerated conversion function.
+func Convert_v2alpha1_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse(in *v2alpha1.WriteVolumeCacheResponse, out *impl.WriteVolumeCacheResponse) error {
+	return autoConvert_v2alpha1_WriteVolumeCacheResponse_To_impl_WriteVolumeCacheResponse(in, out)
+}
+
+func autoConvert_impl_WriteVolumeCacheResponse_To_v2alpha1_WriteVolumeCacheResponse(in *impl.WriteVolumeCacheResponse, out *v2alpha1.WriteVolumeCacheResponse) error {
+	return nil
+}
+
+// Convert_impl_WriteVolumeCacheResponse_To_v2alpha1_WriteVolumeCacheResponse is an autogenerated conversion function.
 func Convert_impl_WriteVolumeCacheResponse_To_v2alpha1_WriteVolumeCacheResponse(in *impl.WriteVolumeCacheResponse, out *v2alpha1.WriteVolumeCacheResponse) error {
 	return autoConvert_impl_WriteVolumeCacheResponse_To_v2alpha1_WriteVolumeCacheResponse(in, out)
 }
+
+func autoConvert_v2alpha1_WriteVolumeCachesRequest_To_impl_WriteVolumeCachesRequest(in *v2alpha1.WriteVolumeCachesRequest, out *impl.WriteVolumeCachesRequest) error {
+	out.VolumeIds = *(*[]string)(unsafe.Pointer(&in.VolumeIds))
+	return nil
+}
+
+// Convert_v2alpha1_WriteVolumeCachesRequest_To_impl_WriteVolumeCachesRequest is an autogenerated conversion function.
+func Convert_v2alpha1_WriteVolumeCachesRequest_To_impl_WriteVolumeCachesRequest(in *v2alpha1.WriteVolumeCachesRequest, out *impl.WriteVolumeCachesRequest) error {
+	return autoConvert_v2alpha1_WriteVolumeCachesRequest_To_impl_WriteVolumeCachesRequest(in, out)
+}
+
+func autoConvert_impl_WriteVolumeCachesRequest_To_v2alpha1_WriteVolumeCachesRequest(in *impl.WriteVolumeCachesRequest, out *v2alpha1.WriteVolumeCachesRequest) error {
+	out.VolumeIds = *(*[]string)(unsafe.Pointer(&in.VolumeIds))
+	return nil
+}
+
+// Convert_impl_WriteVolumeCachesRequest_To_v2alpha1_WriteVolumeCachesRequest is an autogenerated conversion function.
+func Convert_impl_WriteVolumeCachesRequest_To_v2alpha1_WriteVolumeCachesRequest(in *impl.WriteVolumeCachesRequest, out *v2alpha1.WriteVolumeCachesRequest) error {
+	return autoConvert_impl_WriteVolumeCachesRequest_To_v2alpha1_WriteVolumeCachesRequest(in, out)
+}
+
+// detected external conversion function
+// Convert_v2alpha1_WriteVolumeCachesResponse_To_impl_WriteVolumeCachesResponse(in *v2alpha1.WriteVolumeCachesResponse, out *impl.WriteVolumeCachesResponse) error
+// skipping generation of the auto function
+
+// detected external conversion function
+// Convert_impl_WriteVolumeCachesResponse_To_v2alpha1_WriteVolumeCachesResponse(in *impl.WriteVolumeCachesResponse, out *v2alpha1.WriteVolumeCachesResponse) error
+// skipping generation of the auto function
@@ -27,6 +27,82 @@ func (s *versionedAPI) Register(grpcServer *grpc.Server) {
 	v2alpha1.RegisterVolumeServer(grpcServer, s)
 }
 
+func (s *versionedAPI) CleanupVolume(context context.Context, versionedRequest *v2alpha1.CleanupVolumeRequest) (*v2alpha1.CleanupVolumeResponse, error) {
+	request := &impl.CleanupVolumeRequest{}
+	if err := Convert_v2alpha1_CleanupVolumeRequest_To_impl_CleanupVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.CleanupVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.CleanupVolumeResponse{}
+	if err := Convert_impl_CleanupVolumeResponse_To_v2alpha1_CleanupVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) CreateBoundedDirectory(context context.Context, versionedRequest *v2alpha1.CreateBoundedDirectoryRequest) (*v2alpha1.CreateBoundedDirectoryResponse, error) {
+	request := &impl.CreateBoundedDirectoryRequest{}
+	if err := Convert_v2alpha1_CreateBoundedDirectoryRequest_To_impl_CreateBoundedDirectoryRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.CreateBoundedDirectory(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.CreateBoundedDirectoryResponse{}
+	if err := Convert_impl_CreateBoundedDirectoryResponse_To_v2alpha1_CreateBoundedDirectoryResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) DiscoverLocalVolumes(context context.Context, versionedRequest *v2alpha1.DiscoverLocalVolumesRequest) (*v2alpha1.DiscoverLocalVolumesResponse, error) {
+	request := &impl.DiscoverLocalVolumesRequest{}
+	if err := Convert_v2alpha1_DiscoverLocalVolumesRequest_To_impl_DiscoverLocalVolumesRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.DiscoverLocalVolumes(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.DiscoverLocalVolumesResponse{}
+	if err := Convert_impl_DiscoverLocalVolumesResponse_To_v2alpha1_DiscoverLocalVolumesResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) EnableDeduplication(context context.Context, versionedRequest *v2alpha1.EnableDeduplicationRequest) (*v2alpha1.EnableDeduplicationResponse, error) {
+	request := &impl.EnableDeduplicationRequest{}
+	if err := Convert_v2alpha1_EnableDeduplicationRequest_To_impl_EnableDeduplicationRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.EnableDeduplication(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.EnableDeduplicationResponse{}
+	if err := Convert_impl_EnableDeduplicationResponse_To_v2alpha1_EnableDeduplicationResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) FormatVolume(context context.Context, versionedRequest *v2alpha1.FormatVolumeRequest) (*v2alpha1.FormatVolumeResponse, error) {
 	request := &impl.FormatVolumeRequest{}
 	if err := Convert_v2alpha1_FormatVolumeRequest_To_impl_FormatVolumeRequest(versionedRequest, request); err != nil {
@@ -46,6 +122,25 @@ func (s *versionedAPI) FormatVolume(context context.Context, versionedRequest *v
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) FormatVolumeAsync(context context.Context, versionedRequest *v2alpha1.FormatVolumeAsyncRequest) (*v2alpha1.FormatVolumeAsyncResponse, error) {
+	request := &impl.FormatVolumeAsyncRequest{}
+	if err := Convert_v2alpha1_FormatVolumeAsyncRequest_To_impl_FormatVolumeAsyncRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.FormatVolumeAsync(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.FormatVolumeAsyncResponse{}
+	if err := Convert_impl_FormatVolumeAsyncResponse_To_v2alpha1_FormatVolumeAsyncResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) GetClosestVolumeIDFromTargetPath(context context.Context, versionedRequest *v2alpha1.GetClosestVolumeIDFromTargetPathRequest) (*v2alpha1.GetClosestVolumeIDFromTargetPathResponse, error) {
 	request := &impl.GetClosestVolumeIDFromTargetPathRequest{}
 	if err := Convert_v2alpha1_GetClosestVolumeIDFromTargetPathRequest_To_impl_GetClosestVolumeIDFromTargetPathRequest(versionedRequest, request); err != nil {
@@ -65,6 +160,25 @@ func (s *versionedAPI) GetClosestVolumeIDFromTargetPath(context context.Context,
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) GetDeduplicationStatus(context context.Context, versionedRequest *v2alpha1.GetDeduplicationStatusRequest) (*v2alpha1.GetDeduplicationStatusResponse, error) {
+	request := &impl.GetDeduplicationStatusRequest{}
+	if err := Convert_v2alpha1_GetDeduplicationStatusRequest_To_impl_GetDeduplicationStatusRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetDeduplicationStatus(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetDeduplicationStatusResponse{}
+	if err := Convert_impl_GetDeduplicationStatusResponse_To_v2alpha1_GetDeduplicationStatusResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) GetDiskNumberFromVolumeID(context context.Context, versionedRequest *v2alpha1.GetDiskNumberFromVolumeIDRequest) (*v2alpha1.GetDiskNumberFromVolumeIDResponse, error) {
 	request := &impl.GetDiskNumberFromVolumeIDRequest{}
 	if err := Convert_v2alpha1_GetDiskNumberFromVolumeIDRequest_To_impl_GetDiskNumberFromVolumeIDRequest(versionedRequest, request); err != nil {
@@ -84,6 +198,82 @@ func (s *versionedAPI) GetDiskNumberFromVolumeID(context context.Context, versio
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) GetFormatVolumeStatus(context context.Context, versionedRequest *v2alpha1.GetFormatVolumeStatusRequest) (*v2alpha1.GetFormatVolumeStatusResponse, error) {
+	request := &impl.GetFormatVolumeStatusRequest{}
+	if err := Convert_v2alpha1_GetFormatVolumeStatusRequest_To_impl_GetFormatVolumeStatusRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetFormatVolumeStatus(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetFormatVolumeStatusResponse{}
+	if err := Convert_impl_GetFormatVolumeStatusResponse_To_v2alpha1_GetFormatVolumeStatusResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetOperation(context context.Context, versionedRequest *v2alpha1.GetOperationRequest) (*v2alpha1.GetOperationResponse, error) {
+	request := &impl.GetOperationRequest{}
+	if err := Convert_v2alpha1_GetOperationRequest_To_impl_GetOperationRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetOperation(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetOperationResponse{}
+	if err := Convert_impl_GetOperationResponse_To_v2alpha1_GetOperationResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetVolumeAccessPaths(context context.Context, versionedRequest *v2alpha1.GetVolumeAccessPathsRequest) (*v2alpha1.GetVolumeAccessPathsResponse, error) {
+	request := &impl.GetVolumeAccessPathsRequest{}
+	if err := Convert_v2alpha1_GetVolumeAccessPathsRequest_To_impl_GetVolumeAccessPathsRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetVolumeAccessPaths(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetVolumeAccessPathsResponse{}
+	if err := Convert_impl_GetVolumeAccessPathsResponse_To_v2alpha1_GetVolumeAccessPathsResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetVolumeHealth(context context.Context, versionedRequest *v2alpha1.GetVolumeHealthRequest) (*v2alpha1.GetVolumeHealthResponse, error) {
+	request := &impl.GetVolumeHealthRequest{}
+	if err := Convert_v2alpha1_GetVolumeHealthRequest_To_impl_GetVolumeHealthRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetVolumeHealth(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetVolumeHealthResponse{}
+	if err := Convert_impl_GetVolumeHealthResponse_To_v2alpha1_GetVolumeHealthResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) GetVolumeIDFromTargetPath(context context.Context, versionedRequest *v2alpha1.GetVolumeIDFromTargetPathRequest) (*v2alpha1.GetVolumeIDFromTargetPathResponse, error) {
 	request := &impl.GetVolumeIDFromTargetPathRequest{}
 	if err := Convert_v2alpha1_GetVolumeIDFromTargetPathRequest_To_impl_GetVolumeIDFromTargetPathRequest(versionedRequest, request); err != nil {
@@ -103,6 +293,44 @@ func (s *versionedAPI) GetVolumeIDFromTargetPath(context context.Context, versio
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) GetVolumeLabel(context context.Context, versionedRequest *v2alpha1.GetVolumeLabelRequest) (*v2alpha1.GetVolumeLabelResponse, error) {
+	request := &impl.GetVolumeLabelRequest{}
+	if err := Convert_v2alpha1_GetVolumeLabelRequest_To_impl_GetVolumeLabelRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetVolumeLabel(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetVolumeLabelResponse{}
+	if err := Convert_impl_GetVolumeLabelResponse_To_v2alpha1_GetVolumeLabelResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) GetVolumeSerialNumber(context context.Context, versionedRequest *v2alpha1.GetVolumeSerialNumberRequest) (*v2alpha1.GetVolumeSerialNumberResponse, error) {
+	request := &impl.GetVolumeSerialNumberRequest{}
+	if err := Convert_v2alpha1_GetVolumeSerialNumberRequest_To_impl_GetVolumeSerialNumberRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetVolumeSerialNumber(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetVolumeSerialNumberResponse{}
+	if err := Convert_impl_GetVolumeSerialNumberResponse_To_v2alpha1_GetVolumeSerialNumberResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) GetVolumeStats(context context.Context, versionedRequest *v2alpha1.GetVolumeStatsRequest) (*v2alpha1.GetVolumeStatsResponse, error) {
 	request := &impl.GetVolumeStatsRequest{}
 	if err := Convert_v2alpha1_GetVolumeStatsRequest_To_impl_GetVolumeStatsRequest(versionedRequest, request); err != nil {
@@ -122,6 +350,44 @@ func (s *versionedAPI) GetVolumeStats(context context.Context, versionedRequest
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) GetVolumeWriteCache(context context.Context, versionedRequest *v2alpha1.GetVolumeWriteCacheRequest) (*v2alpha1.GetVolumeWriteCacheResponse, error) {
+	request := &impl.GetVolumeWriteCacheRequest{}
+	if err := Convert_v2alpha1_GetVolumeWriteCacheRequest_To_impl_GetVolumeWriteCacheRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.GetVolumeWriteCache(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.GetVolumeWriteCacheResponse{}
+	if err := Convert_impl_GetVolumeWriteCacheResponse_To_v2alpha1_GetVolumeWriteCacheResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) IsVolumeDirty(context context.Context, versionedRequest *v2alpha1.IsVolumeDirtyRequest) (*v2alpha1.IsVolumeDirtyResponse, error) {
+	request := &impl.IsVolumeDirtyRequest{}
+	if err := Convert_v2alpha1_IsVolumeDirtyRequest_To_impl_IsVolumeDirtyRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.IsVolumeDirty(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.IsVolumeDirtyResponse{}
+	if err := Convert_impl_IsVolumeDirtyResponse_To_v2alpha1_IsVolumeDirtyResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) IsVolumeFormatted(context context.Context, versionedRequest *v2alpha1.IsVolumeFormattedRequest) (*v2alpha1.IsVolumeFormattedResponse, error) {
 	request := &impl.IsVolumeFormattedRequest{}
 	if err := Convert_v2alpha1_IsVolumeFormattedRequest_To_impl_IsVolumeFormattedRequest(versionedRequest, request); err != nil {
@@ -141,6 +407,25 @@ func (s *versionedAPI) IsVolumeFormatted(context context.Context, versionedReque
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) ListVolumes(context context.Context, versionedRequest *v2alpha1.ListVolumesRequest) (*v2alpha1.ListVolumesResponse, error) {
+	request := &impl.ListVolumesRequest{}
+	if err := Convert_v2alpha1_ListVolumesRequest_To_impl_ListVolumesRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ListVolumes(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ListVolumesResponse{}
+	if err := Convert_impl_ListVolumesResponse_To_v2alpha1_ListVolumesResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) ListVolumesOnDisk(context context.Context, versionedRequest *v2alpha1.ListVolumesOnDiskRequest) (*v2alpha1.ListVolumesOnDiskResponse, error) {
 	request := &impl.ListVolumesOnDiskRequest{}
 	if err := Convert_v2alpha1_ListVolumesOnDiskRequest_To_impl_ListVolumesOnDiskRequest(versionedRequest, request); err != nil {
@@ -179,6 +464,120 @@ func (s *versionedAPI) MountVolume(context context.Context, versionedRequest *v2
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) OptimizeVolume(context context.Context, versionedRequest *v2alpha1.OptimizeVolumeRequest) (*v2alpha1.OptimizeVolumeResponse, error) {
+	request := &impl.OptimizeVolumeRequest{}
+	if err := Convert_v2alpha1_OptimizeVolumeRequest_To_impl_OptimizeVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.OptimizeVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.OptimizeVolumeResponse{}
+	if err := Convert_impl_OptimizeVolumeResponse_To_v2alpha1_OptimizeVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) OptimizeVolumeAsync(context context.Context, versionedRequest *v2alpha1.OptimizeVolumeAsyncRequest) (*v2alpha1.OptimizeVolumeAsyncResponse, error) {
+	request := &impl.OptimizeVolumeAsyncRequest{}
+	if err := Convert_v2alpha1_OptimizeVolumeAsyncRequest_To_impl_OptimizeVolumeAsyncRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.OptimizeVolumeAsync(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.OptimizeVolumeAsyncResponse{}
+	if err := Convert_impl_OptimizeVolumeAsyncResponse_To_v2alpha1_OptimizeVolumeAsyncResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) PrepareStagingPath(context context.Context, versionedRequest *v2alpha1.PrepareStagingPathRequest) (*v2alpha1.PrepareStagingPathResponse, error) {
+	request := &impl.PrepareStagingPathRequest{}
+	if err := Convert_v2alpha1_PrepareStagingPathRequest_To_impl_PrepareStagingPathRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.PrepareStagingPath(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.PrepareStagingPathResponse{}
+	if err := Convert_impl_PrepareStagingPathResponse_To_v2alpha1_PrepareStagingPathResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) RemoveBoundedDirectory(context context.Context, versionedRequest *v2alpha1.RemoveBoundedDirectoryRequest) (*v2alpha1.RemoveBoundedDirectoryResponse, error) {
+	request := &impl.RemoveBoundedDirectoryRequest{}
+	if err := Convert_v2alpha1_RemoveBoundedDirectoryRequest_To_impl_RemoveBoundedDirectoryRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.RemoveBoundedDirectory(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.RemoveBoundedDirectoryResponse{}
+	if err := Convert_impl_RemoveBoundedDirectoryResponse_To_v2alpha1_RemoveBoundedDirectoryResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) RepairVolume(context context.Context, versionedRequest *v2alpha1.RepairVolumeRequest) (*v2alpha1.RepairVolumeResponse, error) {
+	request := &impl.RepairVolumeRequest{}
+	if err := Convert_v2alpha1_RepairVolumeRequest_To_impl_RepairVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.RepairVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.RepairVolumeResponse{}
+	if err := Convert_impl_RepairVolumeResponse_To_v2alpha1_RepairVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) RepairVolumeAsync(context context.Context, versionedRequest *v2alpha1.RepairVolumeAsyncRequest) (*v2alpha1.RepairVolumeAsyncResponse, error) {
+	request := &impl.RepairVolumeAsyncRequest{}
+	if err := Convert_v2alpha1_RepairVolumeAsyncRequest_To_impl_RepairVolumeAsyncRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.RepairVolumeAsync(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.RepairVolumeAsyncResponse{}
+	if err := Convert_impl_RepairVolumeAsyncResponse_To_v2alpha1_RepairVolumeAsyncResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) ResizeVolume(context context.Context, versionedRequest *v2alpha1.ResizeVolumeRequest) (*v2alpha1.ResizeVolumeResponse, error) {
 	request := &impl.ResizeVolumeRequest{}
 	if err := Convert_v2alpha1_ResizeVolumeRequest_To_impl_ResizeVolumeRequest(versionedRequest, request); err != nil {
@@ -198,6 +597,82 @@ func (s *versionedAPI) ResizeVolume(context context.Context, versionedRequest *v
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) ResizeVolumeAsync(context context.Context, versionedRequest *v2alpha1.ResizeVolumeAsyncRequest) (*v2alpha1.ResizeVolumeAsyncResponse, error) {
+	request := &impl.ResizeVolumeAsyncRequest{}
+	if err := Convert_v2alpha1_ResizeVolumeAsyncRequest_To_impl_ResizeVolumeAsyncRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.ResizeVolumeAsync(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.ResizeVolumeAsyncResponse{}
+	if err := Convert_impl_ResizeVolumeAsyncResponse_To_v2alpha1_ResizeVolumeAsyncResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) SetVolumeCompression(context context.Context, versionedRequest *v2alpha1.SetVolumeCompressionRequest) (*v2alpha1.SetVolumeCompressionResponse, error) {
+	request := &impl.SetVolumeCompressionRequest{}
+	if err := Convert_v2alpha1_SetVolumeCompressionRequest_To_impl_SetVolumeCompressionRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.SetVolumeCompression(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.SetVolumeCompressionResponse{}
+	if err := Convert_impl_SetVolumeCompressionResponse_To_v2alpha1_SetVolumeCompressionResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) SetVolumeLabel(context context.Context, versionedRequest *v2alpha1.SetVolumeLabelRequest) (*v2alpha1.SetVolumeLabelResponse, error) {
+	request := &impl.SetVolumeLabelRequest{}
+	if err := Convert_v2alpha1_SetVolumeLabelRequest_To_impl_SetVolumeLabelRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.SetVolumeLabel(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.SetVolumeLabelResponse{}
+	if err := Convert_impl_SetVolumeLabelResponse_To_v2alpha1_SetVolumeLabelResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
+func (s *versionedAPI) SyncVolume(context context.Context, versionedRequest *v2alpha1.SyncVolumeRequest) (*v2alpha1.SyncVolumeResponse, error) {
+	request := &impl.SyncVolumeRequest{}
+	if err := Convert_v2alpha1_SyncVolumeRequest_To_impl_SyncVolumeRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.SyncVolume(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.SyncVolumeResponse{}
+	if err := Convert_impl_SyncVolumeResponse_To_v2alpha1_SyncVolumeResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
+
 func (s *versionedAPI) UnmountVolume(context context.Context, versionedRequest *v2alpha1.UnmountVolumeRequest) (*v2alpha1.UnmountVolumeResponse, error) {
 	request := &impl.UnmountVolumeRequest{}
 	if err := Convert_v2alpha1_UnmountVolumeRequest_To_impl_UnmountVolumeRequest(versionedRequest, request); err != nil {
@@ -217,6 +692,21 @@ func (s *versionedAPI) UnmountVolume(context context.Context, versionedRequest *
 	return versionedResponse, err
 }
 
+func (s *versionedAPI) WatchVolumes(versionedRequest *v2alpha1.WatchVolumesRequest, stream v2alpha1.Volume_WatchVolumesServer) error {
+	request := &impl.WatchVolumesRequest{}
+	if err := Convert_v2alpha1_WatchVolumesRequest_To_impl_WatchVolumesRequest(versionedRequest, request); err != nil {
+		return err
+	}
+
+	return s.apiGroupServer.WatchVolumes(stream.Context(), request, func(event *impl.VolumeEvent) error {
+		versionedEvent := &v2alpha1.VolumeEvent{}
+		if err := Convert_impl_VolumeEvent_To_v2alpha1_VolumeEvent(event, versionedEvent); err != nil {
+			return err
+		}
+		return stream.Send(versionedEvent)
+	}, version)
+}
+
 func (s *versionedAPI) WriteVolumeCache(context context.Context, versionedRequest *v2alpha1.WriteVolumeCacheRequest) (*v2alpha1.WriteVolumeCacheResponse, error) {
 	request := &impl.WriteVolumeCacheRequest{}
 	if err := Convert_v2alpha1_WriteVolumeCacheRequest_To_impl_WriteVolumeCacheRequest(versionedRequest, request); err != nil {
@@ -235,3 +725,22 @@ func (s *versionedAPI) WriteVolumeCache(context context.Context, versionedReques
 
 	return versionedResponse, err
 }
+
+func (s *versionedAPI) WriteVolumeCaches(context context.Context, versionedRequest *v2alpha1.WriteVolumeCachesRequest) (*v2alpha1.WriteVolumeCachesResponse, error) {
+	request := &impl.WriteVolumeCachesRequest{}
+	if err := Convert_v2alpha1_WriteVolumeCachesRequest_To_impl_WriteVolumeCachesRequest(versionedRequest, request); err != nil {
+		return nil, err
+	}
+
+	response, err := s.apiGroupServer.WriteVolumeCaches(context, request, version)
+	if err != nil {
+		return nil, err
+	}
+
+	versionedResponse := &v2alpha1.WriteVolumeCachesResponse{}
+	if err := Convert_impl_WriteVolumeCachesResponse_To_v2alpha1_WriteVolumeCachesResponse(response, versionedResponse); err != nil {
+		return nil, err
+	}
+
+	return versionedResponse, err
+}
@@ -0,0 +1,82 @@
+package volume
+
+import (
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/volume"
+	"k8s.io/klog/v2"
+)
+
+// journaledStatsMaxAge is how long the stats of a volume are kept when its USN
+// journal has no changes, the changes that don't go through the journal e.g. an
+// extension of the volume by another tool show up after it.
+const journaledStatsMaxAge = 10 * time.Minute
+
+// journaledStats are the stats of a volume at a position of its USN journal.
+type journaledStats struct {
+	stats     volume.VolumeStats
+	position  volume.JournalPosition
+	queriedAt time.Time
+}
+
+// SetJournaledStats enables the journaled stats mode, the stats of a volume are
+// queried once and then only when its USN change journal has records of files
+// created, deleted, extended or truncated since, reading the records since the
+// last query is cheaper than querying the file system of a large volume.
+func (s *Server) SetJournaledStats(enabled bool) {
+	s.journaledStatsLock.Lock()
+	defer s.journaledStatsLock.Unlock()
+	s.journaledStatsEnabled = enabled
+	s.journaledStats = make(map[string]*journaledStats)
+}
+
+// volumeStats returns the stats of the volume `volumeID`, they're queried with
+// the host API unless the journaled stats mode is enabled and the volume didn't
+// change since the last query. The volumes without a journal e.g. FAT volumes
+// are always queried.
+func (s *Server) volumeStats(volumeID string) (*volume.VolumeStats, error) {
+	s.journaledStatsLock.Lock()
+	enabled := s.journaledStatsEnabled
+	cached := s.journaledStats[volumeID]
+	s.journaledStatsLock.Unlock()
+	if !enabled {
+		return s.hostAPI.GetVolumeStats(volumeID)
+	}
+
+	var since *volume.JournalPosition
+	if cached != nil && time.Since(cached.queriedAt) < journaledStatsMaxAge {
+		since = &cached.position
+	}
+	changed, position, err := s.hostAPI.HasVolumeSpaceChanged(volumeID, since)
+	if err != nil {
+		klog.V(4).Infof("USN journal of volume %s unavailable, querying its stats: %v", volumeID, err)
+		s.forgetStats(volumeID)
+		return s.hostAPI.GetVolumeStats(volumeID)
+	}
+	if !changed {
+		stats := cached.stats
+		return &stats, nil
+	}
+
+	// the position is read before the query so that the changes made meanwhile
+	// are seen by the next call
+	stats, err := s.hostAPI.GetVolumeStats(volumeID)
+	if err != nil {
+		s.forgetStats(volumeID)
+		return nil, err
+	}
+	s.journaledStatsLock.Lock()
+	if s.journaledStatsEnabled {
+		s.journaledStats[volumeID] = &journaledStats{stats: *stats, position: *position, queriedAt: time.Now()}
+	}
+	s.journaledStatsLock.Unlock()
+	return stats, nil
+}
+
+// forgetStats drops the journaled stats of the volume `volumeID`, the changes of
+// its size, its label or its file system aren't recorded in the journal.
+func (s *Server) forgetStats(volumeID string) {
+	s.journaledStatsLock.Lock()
+	defer s.journaledStatsLock.Unlock()
+	delete(s.journaledStats, volumeID)
+}
@@ -0,0 +1,263 @@
+package volume
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// volumeMounts are the paths where a volume is mounted.
+type volumeMounts struct {
+	TargetPaths []string `json:"targetPaths"`
+	ReadOnly    bool     `json:"readOnly"`
+}
+
+// mountStore is the mount metadata store, it keeps the paths where each volume is
+// mounted so that a volume can be mounted at multiple paths (reference counted by
+// its paths), and the staging paths prepared with PrepareStagingPath. The store is
+// persisted to a JSON file so that it survives restarts of csi-proxy, it's kept in
+// memory only if the file path is empty. The staging paths are persisted to a
+// file next to it so that the format of the mount metadata file doesn't change.
+type mountStore struct {
+	file        string
+	stagingFile string
+
+	lock         sync.Mutex
+	mounts       map[string]*volumeMounts
+	stagingPaths []string
+}
+
+func newMountStore(file string) (*mountStore, error) {
+	s := &mountStore{
+		file:         file,
+		mounts:       make(map[string]*volumeMounts),
+		stagingPaths: []string{},
+	}
+	if file == "" {
+		return s, nil
+	}
+	// e.g. C:\var\lib\csi-proxy\mounts-staging.json
+	s.stagingFile = strings.TrimSuffix(file, filepath.Ext(file)) + "-staging.json"
+	if err := readJSONFile(file, &s.mounts); err != nil {
+		return nil, fmt.Errorf("error reading the mount metadata file %s: %v", file, err)
+	}
+	if err := readJSONFile(s.stagingFile, &s.stagingPaths); err != nil {
+		return nil, fmt.Errorf("error reading the staging paths file %s: %v", s.stagingFile, err)
+	}
+	return s, nil
+}
+
+// readJSONFile parses the JSON file `file` into `v`, `v` is left as is if the
+// file doesn't exist.
+func readJSONFile(file string, v interface{}) error {
+	data, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Get returns the mounts of the volume `volumeID`, nil if it isn't mounted.
+func (s *mountStore) Get(volumeID string) *volumeMounts {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	m, ok := s.mounts[volumeID]
+	if !ok {
+		return nil
+	}
+	return &volumeMounts{
+		TargetPaths: append([]string{}, m.TargetPaths...),
+		ReadOnly:    m.ReadOnly,
+	}
+}
+
+// VolumeIDs returns the IDs of the mounted volumes.
+func (s *mountStore) VolumeIDs() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	volumeIDs := make([]string, 0, len(s.mounts))
+	for volumeID := range s.mounts {
+		volumeIDs = append(volumeIDs, volumeID)
+	}
+	return volumeIDs
+}
+
+// AddMount records that the volume `volumeID` is mounted at `targetPath`.
+func (s *mountStore) AddMount(volumeID, targetPath string, readOnly bool) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	m, ok := s.mounts[volumeID]
+	if !ok {
+		m = &volumeMounts{ReadOnly: readOnly}
+		s.mounts[volumeID] = m
+	}
+	if !containsPath(m.TargetPaths, targetPath) {
+		m.TargetPaths = append(m.TargetPaths, targetPath)
+	}
+	return s.save()
+}
+
+// RemoveMount records that the volume `volumeID` isn't mounted at `targetPath`
+// anymore and returns the number of paths where it's still mounted.
+func (s *mountStore) RemoveMount(volumeID, targetPath string) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	m, ok := s.mounts[volumeID]
+	if !ok {
+		return 0, nil
+	}
+	paths := []string{}
+	for _, p := range m.TargetPaths {
+		if !samePath(p, targetPath) {
+			paths = append(paths, p)
+		}
+	}
+	m.TargetPaths = paths
+	if len(paths) == 0 {
+		delete(s.mounts, volumeID)
+	}
+	return len(paths), s.save()
+}
+
+// MountedVolumeAt returns the ID of the volume mounted at `path` or at one of its
+// parent directories and the path where it's mounted, empty if `path` isn't
+// inside a mounted volume.
+func (s *mountStore) MountedVolumeAt(path string) (volumeID string, targetPath string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for id, m := range s.mounts {
+		for _, p := range m.TargetPaths {
+			if isWithinPath(path, p) {
+				return id, p
+			}
+		}
+	}
+	return "", ""
+}
+
+// AddStagingPath records that the staging path `path` was prepared.
+func (s *mountStore) AddStagingPath(path string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if containsPath(s.stagingPaths, path) {
+		return nil
+	}
+	s.stagingPaths = append(s.stagingPaths, path)
+	return s.saveStagingPaths()
+}
+
+// RemoveStagingPath forgets the staging path `path`, it returns false if it
+// wasn't prepared.
+func (s *mountStore) RemoveStagingPath(path string) (bool, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	paths := []string{}
+	for _, p := range s.stagingPaths {
+		if !samePath(p, path) {
+			paths = append(paths, p)
+		}
+	}
+	if len(paths) == len(s.stagingPaths) {
+		return false, nil
+	}
+	s.stagingPaths = paths
+	return true, s.saveStagingPaths()
+}
+
+// IsStagingPath returns true if `path` is a prepared staging path.
+func (s *mountStore) IsStagingPath(path string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return containsPath(s.stagingPaths, path)
+}
+
+// Export returns the mounts of all the volumes as JSON.
+func (s *mountStore) Export() (json.RawMessage, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return json.Marshal(s.mounts)
+}
+
+// Import replaces the mounts of all the volumes with `data`, mounts exported
+// by Export.
+func (s *mountStore) Import(data json.RawMessage) error {
+	mounts := make(map[string]*volumeMounts)
+	if err := json.Unmarshal(data, &mounts); err != nil {
+		return fmt.Errorf("error parsing the mount metadata: %v", err)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.mounts = mounts
+	return s.save()
+}
+
+// save persists the mounts, it must be called with the lock held.
+func (s *mountStore) save() error {
+	if s.file == "" {
+		return nil
+	}
+	return writeJSONFile(s.file, "mount metadata file", s.mounts)
+}
+
+// saveStagingPaths persists the staging paths, it must be called with the lock
+// held.
+func (s *mountStore) saveStagingPaths() error {
+	if s.stagingFile == "" {
+		return nil
+	}
+	return writeJSONFile(s.stagingFile, "staging paths file", s.stagingPaths)
+}
+
+// writeJSONFile writes `v` as JSON to the file `file` described by `name` in
+// the errors.
+func writeJSONFile(file, name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return fmt.Errorf("error creating the directory of the %s %s: %v", name, file, err)
+	}
+	// write to a temporary file and rename it so that the file is never left half written
+	tmpFile := file + ".tmp"
+	if err := ioutil.WriteFile(tmpFile, data, 0600); err != nil {
+		return fmt.Errorf("error writing the %s %s: %v", name, tmpFile, err)
+	}
+	if err := os.Rename(tmpFile, file); err != nil {
+		return fmt.Errorf("error replacing the %s %s: %v", name, file, err)
+	}
+	return nil
+}
+
+func containsPath(paths []string, path string) bool {
+	for _, p := range paths {
+		if samePath(p, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWithinPath returns true if `path` is `parent` or one of its descendants,
+// compared the way Windows does.
+func isWithinPath(path, parent string) bool {
+	path = strings.TrimRight(path, `\/`)
+	parent = strings.TrimRight(parent, `\/`)
+	if strings.EqualFold(path, parent) {
+		return true
+	}
+	return len(path) > len(parent) && strings.EqualFold(path[:len(parent)], parent) && (path[len(parent)] == '\\' || path[len(parent)] == '/')
+}
+
+// samePath compares paths the way Windows does i.e. case insensitive and
+// ignoring trailing separators.
+func samePath(a, b string) bool {
+	return strings.EqualFold(strings.TrimRight(a, `\/`), strings.TrimRight(b, `\/`))
+}
@@ -0,0 +1,233 @@
+package volume
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/klog/v2"
+)
+
+// operationRetention is how long a completed operation can be polled with GetOperation.
+const operationRetention = time.Hour
+
+// operation is an operation started in the background by an asynchronous method.
+type operation struct {
+	id       string
+	method   string
+	volumeID string
+	done     bool
+	err      error
+	// result is the response of the method once it succeeded
+	result    interface{}
+	completed time.Time
+}
+
+// SetVolumeLock sets the function that serializes the operations running in the
+// background with the calls of the serialized methods, see srvtypes.VolumeLocker.
+func (s *Server) SetVolumeLock(lock func(ctx context.Context, volumeID string) (func(), error)) {
+	s.volumeLock = lock
+}
+
+// startOperation runs `run` in the background as the operation `method` of the
+// volume `volumeID` and returns its ID. A client that retries after its deadline
+// gets the ID of the same operation if it's still running, the other methods fail
+// while an operation of the volume is running.
+func (s *Server) startOperation(method, volumeID string, run func(ctx context.Context) (interface{}, error)) (string, error) {
+	s.operationsLock.Lock()
+	defer s.operationsLock.Unlock()
+	now := time.Now()
+	for id, op := range s.operations {
+		if op.done {
+			if now.Sub(op.completed) > operationRetention {
+				delete(s.operations, id)
+			}
+			continue
+		}
+		// volume IDs are GUID paths, which are case insensitive
+		if strings.EqualFold(op.volumeID, volumeID) {
+			if op.method == method {
+				return op.id, nil
+			}
+			return "", fmt.Errorf("%s of volume %s is in progress in operation %s", op.method, volumeID, op.id)
+		}
+	}
+
+	id, err := newOperationID()
+	if err != nil {
+		return "", err
+	}
+	op := &operation{
+		id:       id,
+		method:   method,
+		volumeID: volumeID,
+	}
+	s.operations[id] = op
+
+	go func() {
+		klog.V(2).Infof("Starting %s of volume %s in operation %s", method, volumeID, id)
+		result, err := s.runOperation(volumeID, run)
+		if err != nil {
+			klog.Errorf("failed %s of volume %s in operation %s: %v", method, volumeID, id, err)
+		} else {
+			klog.V(2).Infof("%s of volume %s in operation %s completed", method, volumeID, id)
+		}
+
+		s.operationsLock.Lock()
+		defer s.operationsLock.Unlock()
+		op.done = true
+		op.err = err
+		if err == nil {
+			op.result = result
+		}
+		op.completed = time.Now()
+	}()
+	return id, nil
+}
+
+// runOperation runs `run` holding the lock of the volume `volumeID`.
+func (s *Server) runOperation(volumeID string, run func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	ctx := context.Background()
+	if s.volumeLock != nil {
+		unlock, err := s.volumeLock(ctx, volumeID)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+	return run(ctx)
+}
+
+// newOperationID returns a random operation ID, the IDs of the operations started
+// before a restart of csi-proxy aren't reused.
+func newOperationID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("error generating an operation ID: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func (s *Server) FormatVolumeAsync(ctx context.Context, request *internal.FormatVolumeAsyncRequest, version apiversion.Version) (*internal.FormatVolumeAsyncResponse, error) {
+	klog.V(2).Infof("FormatVolumeAsync: Request: %+v", request.Request)
+	response := &internal.FormatVolumeAsyncResponse{}
+
+	if request.Request == nil || request.Request.VolumeId == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+	if request.Request.FullFormat {
+		klog.Errorf("full format of volume %s requested asynchronously", request.Request.VolumeId)
+		return response, fmt.Errorf("full formats run in the background already, use FormatVolume and GetFormatVolumeStatus")
+	}
+
+	id, err := s.startOperation("FormatVolume", request.Request.VolumeId, func(ctx context.Context) (interface{}, error) {
+		return s.FormatVolume(ctx, request.Request, version)
+	})
+	if err != nil {
+		klog.Errorf("failed FormatVolumeAsync %v", err)
+		return response, err
+	}
+	response.OperationId = id
+	return response, nil
+}
+
+func (s *Server) ResizeVolumeAsync(ctx context.Context, request *internal.ResizeVolumeAsyncRequest, version apiversion.Version) (*internal.ResizeVolumeAsyncResponse, error) {
+	klog.V(2).Infof("ResizeVolumeAsync: Request: %+v", request.Request)
+	response := &internal.ResizeVolumeAsyncResponse{}
+
+	if request.Request == nil || request.Request.VolumeId == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	id, err := s.startOperation("ResizeVolume", request.Request.VolumeId, func(ctx context.Context) (interface{}, error) {
+		return s.ResizeVolume(ctx, request.Request, version)
+	})
+	if err != nil {
+		klog.Errorf("failed ResizeVolumeAsync %v", err)
+		return response, err
+	}
+	response.OperationId = id
+	return response, nil
+}
+
+func (s *Server) RepairVolumeAsync(ctx context.Context, request *internal.RepairVolumeAsyncRequest, version apiversion.Version) (*internal.RepairVolumeAsyncResponse, error) {
+	klog.V(2).Infof("RepairVolumeAsync: Request: %+v", request.Request)
+	response := &internal.RepairVolumeAsyncResponse{}
+
+	if request.Request == nil || request.Request.VolumeId == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	id, err := s.startOperation("RepairVolume", request.Request.VolumeId, func(ctx context.Context) (interface{}, error) {
+		return s.RepairVolume(ctx, request.Request, version)
+	})
+	if err != nil {
+		klog.Errorf("failed RepairVolumeAsync %v", err)
+		return response, err
+	}
+	response.OperationId = id
+	return response, nil
+}
+
+func (s *Server) OptimizeVolumeAsync(ctx context.Context, request *internal.OptimizeVolumeAsyncRequest, version apiversion.Version) (*internal.OptimizeVolumeAsyncResponse, error) {
+	klog.V(2).Infof("OptimizeVolumeAsync: Request: %+v", request.Request)
+	response := &internal.OptimizeVolumeAsyncResponse{}
+
+	if request.Request == nil || request.Request.VolumeId == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	id, err := s.startOperation("OptimizeVolume", request.Request.VolumeId, func(ctx context.Context) (interface{}, error) {
+		return s.OptimizeVolume(ctx, request.Request, version)
+	})
+	if err != nil {
+		klog.Errorf("failed OptimizeVolumeAsync %v", err)
+		return response, err
+	}
+	response.OperationId = id
+	return response, nil
+}
+
+func (s *Server) GetOperation(ctx context.Context, request *internal.GetOperationRequest, version apiversion.Version) (*internal.GetOperationResponse, error) {
+	klog.V(4).Infof("GetOperation: Request: %+v", request)
+
+	if request.OperationId == "" {
+		klog.Errorf("operation id empty")
+		return nil, fmt.Errorf("operation id empty")
+	}
+
+	s.operationsLock.Lock()
+	defer s.operationsLock.Unlock()
+	op, ok := s.operations[request.OperationId]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "operation %s not found", request.OperationId)
+	}
+	response := &internal.GetOperationResponse{
+		Method:   op.method,
+		VolumeId: op.volumeID,
+		Done:     op.done,
+	}
+	if op.err != nil {
+		response.Error = utils.Redact(op.err.Error())
+		response.ErrorCode, _ = utils.StableErrorCode(op.err)
+	}
+	switch result := op.result.(type) {
+	case *internal.ResizeVolumeResponse:
+		response.ResizeVolumeResult = result
+	case *internal.RepairVolumeResponse:
+		response.RepairVolumeResult = result
+	}
+	return response, nil
+}
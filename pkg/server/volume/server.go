@@ -2,39 +2,373 @@ package volume
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/volume"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
 	"k8s.io/klog/v2"
 )
 
 // Server wraps the host API and implements the autogenerated server interface
 type Server struct {
 	hostAPI volume.API
+	mounts  *mountStore
+
+	// driveLetters are the drive letters that can be assigned to volumes,
+	// driveLettersLock serializes the assignment of drive letters.
+	driveLetters     string
+	driveLettersLock sync.Mutex
+
+	// fullFormats are the full formats by volume ID, a full format stays
+	// here after it completes so that its status can be queried.
+	fullFormats     map[string]*fullFormat
+	fullFormatsLock sync.Mutex
+
+	// operations are the operations started by the asynchronous methods by
+	// operation ID, see startOperation. volumeLock serializes them with the
+	// serialized methods, see SetVolumeLock.
+	operations     map[string]*operation
+	operationsLock sync.Mutex
+	volumeLock     func(ctx context.Context, volumeID string) (func(), error)
+
+	// diskPolicy restricts the disks whose volumes can be formatted.
+	diskPolicy shared.DiskPolicy
+
+	// nodeName is the name of this node in the mount markers and fencingHook
+	// fences the volumes mounted on other nodes, see SetFencingHook.
+	nodeName    string
+	fencingHook FencingHook
+
+	// hooks run before and after the volumes are mounted, formatted and
+	// unmounted, see AddVolumeHook.
+	hooks []VolumeHook
+
+	// journaledStats are the stats of the volumes by volume ID when the
+	// journaled stats mode is enabled, see SetJournaledStats.
+	journaledStatsEnabled bool
+	journaledStats        map[string]*journaledStats
+	journaledStatsLock    sync.Mutex
+
+	// boundedDirectoriesDir is the directory of the VHDX of the bounded
+	// directories, empty if the mount metadata is in memory only.
+	// boundedDirectoriesLock serializes their creation and removal.
+	boundedDirectoriesDir  string
+	boundedDirectoriesLock sync.Mutex
+
+	// pathValidator checks that the directories created by the volume server
+	// are within the working directories, see SetPathValidator.
+	pathValidator PathValidator
 }
 
-func NewServer(hostAPI volume.API) (*Server, error) {
+// PathValidator validates the paths of the directories that the volume server
+// creates, e.g. the filesystem server checks them against the working directories.
+type PathValidator interface {
+	// ValidatePluginPath returns an error if `path` can't be used.
+	ValidatePluginPath(path string) error
+}
+
+// fullFormat is the status of a full format running in the background.
+type fullFormat struct {
+	done bool
+	err  error
+}
+
+// defaultDriveLetters are the drive letters assigned to volumes if no drive letters are configured.
+const defaultDriveLetters = "DEFGHIJKLMNOPQRSTUVWXYZ"
+
+const (
+	// minBoundedDirectorySize is the minimum size of a bounded directory, the
+	// GPT partition table and NTFS take several MiB of its VHDX.
+	minBoundedDirectorySize = 64 * 1024 * 1024
+	// boundedDirectorySizeUnit is the unit the sizes of the bounded directories
+	// are rounded up to.
+	boundedDirectorySizeUnit = 1024 * 1024
+)
+
+// NewServer creates a volume server, the paths where volumes are mounted are kept
+// in `mountMetadataFile` (in memory only if it's empty), volumes mounted at a
+// drive letter get one of `driveLetters` (any free letter if it's empty) and only
+// the volumes of the disks allowed by `diskPolicy` are formatted.
+func NewServer(mountMetadataFile string, driveLetters string, diskPolicy shared.DiskPolicy, hostAPI volume.API) (*Server, error) {
+	mounts, err := newMountStore(mountMetadataFile)
+	if err != nil {
+		return nil, err
+	}
+	if driveLetters == "" {
+		driveLetters = defaultDriveLetters
+	}
+	driveLetters = strings.ToUpper(driveLetters)
+	for _, l := range driveLetters {
+		if l < 'A' || l > 'Z' {
+			return nil, fmt.Errorf("invalid drive letter %q in %q", l, driveLetters)
+		}
+	}
+	var boundedDirectoriesDir string
+	if mountMetadataFile != "" {
+		boundedDirectoriesDir = filepath.Join(filepath.Dir(mountMetadataFile), "bounded-directories")
+	}
 	return &Server{
-		hostAPI: hostAPI,
+		hostAPI:               hostAPI,
+		mounts:                mounts,
+		driveLetters:          driveLetters,
+		fullFormats:           make(map[string]*fullFormat),
+		operations:            make(map[string]*operation),
+		diskPolicy:            diskPolicy,
+		boundedDirectoriesDir: boundedDirectoriesDir,
 	}, nil
 }
 
+// SetPathValidator sets the validator of the staging paths and of the bounded
+// directories, any absolute path is accepted if it isn't set.
+func (s *Server) SetPathValidator(validator PathValidator) {
+	s.pathValidator = validator
+}
+
+// validatePath validates `path` with the path validator if it's set.
+func (s *Server) validatePath(path string) error {
+	if s.pathValidator == nil {
+		return nil
+	}
+	return s.pathValidator.ValidatePluginPath(path)
+}
+
+// WarmUp lists the volumes, it loads the Storage module and the storage WMI provider.
+func (s *Server) WarmUp() error {
+	_, err := s.hostAPI.ListVolumes()
+	return err
+}
+
+// ExportState exports the mount metadata store, it's the mounts section of the
+// state exported by the system API group.
+func (s *Server) ExportState() (json.RawMessage, error) {
+	return s.mounts.Export()
+}
+
+// FlushMountedVolumes flushes the caches of the volumes mounted by csi-proxy in
+// parallel, waiting at most `timeout`. It's called when the node shuts down so
+// that the volumes aren't left dirty if Windows doesn't dismount them cleanly,
+// e.g. when the VM is stopped right after the shutdown.
+func (s *Server) FlushMountedVolumes(timeout time.Duration) {
+	volumeIDs := s.mounts.VolumeIDs()
+	klog.Infof("Flushing %d mounted volumes", len(volumeIDs))
+
+	done := make(chan struct{})
+	go func() {
+		for volumeID, err := range s.writeVolumeCaches(volumeIDs) {
+			klog.Errorf("failed to flush volume %s: %v", volumeID, err)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+		klog.Infof("Flushed the mounted volumes")
+	case <-time.After(timeout):
+		klog.Warningf("Timed out after %v flushing the mounted volumes", timeout)
+	}
+}
+
+// ImportState replaces the mount metadata store with a store exported by
+// ExportState.
+func (s *Server) ImportState(state json.RawMessage) error {
+	return s.mounts.Import(state)
+}
+
 func (s *Server) ListVolumesOnDisk(context context.Context, request *internal.ListVolumesOnDiskRequest, version apiversion.Version) (*internal.ListVolumesOnDiskResponse, error) {
 	klog.V(2).Infof("ListVolumesOnDisk: Request: %+v", request)
 	response := &internal.ListVolumesOnDiskResponse{}
 
-	volumeIDs, err := s.hostAPI.ListVolumesOnDisk(request.DiskNumber, request.PartitionNumber)
+	if err := validateVolumeFilter(request.Filter); err != nil {
+		klog.Errorf("failed ListVolumesOnDisk %v", err)
+		return response, err
+	}
+	volumes, err := s.hostAPI.ListVolumesOnDisk(request.DiskNumber, request.PartitionNumber)
 	if err != nil {
 		klog.Errorf("failed ListVolumeOnDisk %v", err)
 		return response, err
 	}
 
-	response.VolumeIds = volumeIDs
+	response.VolumeIds = make([]string, 0, len(volumes))
+	response.Volumes = make([]*internal.VolumeInfo, 0, len(volumes))
+	for _, v := range volumes {
+		if !matchesVolumeFilter(v, request.Filter) {
+			continue
+		}
+		response.VolumeIds = append(response.VolumeIds, v.VolumeID)
+		response.Volumes = append(response.Volumes, volumeInfo(v))
+	}
+	return response, nil
+}
+
+func (s *Server) ListVolumes(context context.Context, request *internal.ListVolumesRequest, version apiversion.Version) (*internal.ListVolumesResponse, error) {
+	klog.V(2).Infof("ListVolumes: Request: %+v", request)
+	response := &internal.ListVolumesResponse{}
+
+	if err := validateVolumeFilter(request.Filter); err != nil {
+		klog.Errorf("failed ListVolumes %v", err)
+		return response, err
+	}
+	volumes, err := s.hostAPI.ListVolumes()
+	if err != nil {
+		klog.Errorf("failed ListVolumes %v", err)
+		return response, err
+	}
+
+	response.Volumes = make([]*internal.VolumeInfo, 0, len(volumes))
+	for _, v := range volumes {
+		if !matchesVolumeFilter(v, request.Filter) {
+			continue
+		}
+		info := volumeInfo(v)
+		klog.V(4).Infof("ListVolumes: volume %s serial number %q disk %d serial number %q",
+			info.VolumeId, info.SerialNumber, info.DiskNumber, info.DiskSerialNumber)
+		response.Volumes = append(response.Volumes, info)
+	}
+	return response, nil
+}
+
+func (s *Server) DiscoverLocalVolumes(context context.Context, request *internal.DiscoverLocalVolumesRequest, version apiversion.Version) (*internal.DiscoverLocalVolumesResponse, error) {
+	klog.V(2).Infof("DiscoverLocalVolumes: Request: %+v", request)
+	response := &internal.DiscoverLocalVolumesResponse{}
+
+	if err := validateVolumeDiscoveryRules(request.Rules); err != nil {
+		klog.Errorf("failed DiscoverLocalVolumes %v", err)
+		return response, err
+	}
+	volumes, err := s.hostAPI.ListVolumes()
+	if err != nil {
+		klog.Errorf("failed DiscoverLocalVolumes %v", err)
+		return response, err
+	}
+
+	for _, v := range volumes {
+		for _, rule := range request.Rules {
+			if !matchesVolumeDiscoveryRule(v, rule) {
+				continue
+			}
+			klog.V(4).Infof("DiscoverLocalVolumes: volume %s matches rule %q", v.VolumeID, rule.Name)
+			response.Volumes = append(response.Volumes, volumeInfo(v))
+			response.RuleNames = append(response.RuleNames, rule.Name)
+			break
+		}
+	}
 	return response, nil
 }
 
+func validateVolumeDiscoveryRules(rules []*internal.VolumeDiscoveryRule) error {
+	if len(rules) == 0 {
+		return fmt.Errorf("DiscoverLocalVolumesRequest.Rules is empty")
+	}
+	for i, rule := range rules {
+		if rule == nil || len(rule.PathPrefixes)+len(rule.GptNames)+len(rule.Labels) == 0 {
+			return fmt.Errorf("DiscoverLocalVolumesRequest.Rules[%d] has no condition", i)
+		}
+	}
+	return nil
+}
+
+// matchesVolumeDiscoveryRule returns true if the volume `v` matches all the
+// conditions of `rule` that are set.
+func matchesVolumeDiscoveryRule(v volume.VolumeInfo, rule *internal.VolumeDiscoveryRule) bool {
+	if len(rule.PathPrefixes) > 0 {
+		matches := false
+		for _, path := range v.AccessPaths {
+			for _, prefix := range rule.PathPrefixes {
+				matches = matches || strings.HasPrefix(strings.ToLower(path), strings.ToLower(prefix))
+			}
+		}
+		if !matches {
+			return false
+		}
+	}
+	if len(rule.GptNames) > 0 && (v.GptName == "" || !containsFold(rule.GptNames, v.GptName)) {
+		return false
+	}
+	if len(rule.Labels) > 0 && (v.Label == "" || !containsFold(rule.Labels, v.Label)) {
+		return false
+	}
+	return true
+}
+
+// containsFold returns true if `values` has `s`, case insensitively.
+func containsFold(values []string, s string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateVolumeFilter(filter *internal.VolumeFilter) error {
+	if filter == nil {
+		return nil
+	}
+	return utils.ValidateByteSize("VolumeFilter.MinSizeBytes", filter.MinSizeBytes)
+}
+
+// matchesVolumeFilter returns true if the volume `v` matches all the conditions
+// of `filter`, every volume matches a nil filter.
+func matchesVolumeFilter(v volume.VolumeInfo, filter *internal.VolumeFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.FormattedOnly && v.FileSystem == "" {
+		return false
+	}
+	if filter.FileSystem != "" && !strings.EqualFold(filter.FileSystem, v.FileSystem) {
+		return false
+	}
+	if filter.MinSizeBytes > 0 && v.SizeBytes < filter.MinSizeBytes {
+		return false
+	}
+	if filter.WithAccessPathOnly {
+		hasAccessPath := false
+		for _, path := range v.AccessPaths {
+			// the volume device ID path e.g. \\?\Volume{GUID}\ is always there
+			hasAccessPath = hasAccessPath || !strings.HasPrefix(strings.ToLower(path), `\\?\volume{`)
+		}
+		if !hasAccessPath {
+			return false
+		}
+	}
+	return true
+}
+
+// volumeInfo converts a volume of the host API, the disk number and the partition
+// number are 0 if the volume isn't on a partition of a disk.
+func volumeInfo(v volume.VolumeInfo) *internal.VolumeInfo {
+	info := &internal.VolumeInfo{
+		VolumeId:     v.VolumeID,
+		SizeBytes:    v.SizeBytes,
+		AccessPaths:  v.AccessPaths,
+		SerialNumber: v.SerialNumber,
+		FileSystem:   v.FileSystem,
+		Label:        v.Label,
+		GptName:      v.GptName,
+	}
+	if v.DiskNumber != nil && v.PartitionNumber != nil {
+		info.DiskNumber = *v.DiskNumber
+		info.PartitionNumber = *v.PartitionNumber
+	}
+	if v.DiskSerialNumber != nil {
+		info.DiskSerialNumber = *v.DiskSerialNumber
+	}
+	return info
+}
+
 func (s *Server) MountVolume(context context.Context, request *internal.MountVolumeRequest, version apiversion.Version) (*internal.MountVolumeResponse, error) {
 	klog.V(2).Infof("MountVolume: Request: %+v", request)
 	response := &internal.MountVolumeResponse{}
@@ -45,19 +379,147 @@ func (s *Server) MountVolume(context context.Context, request *internal.MountVol
 		return response, fmt.Errorf("MountVolumeRequest.VolumeId is empty")
 	}
 	targetPath := request.TargetPath
+	if request.TargetPathSddl != "" && !request.CreateTargetPath {
+		return response, fmt.Errorf("MountVolumeRequest.TargetPathSddl is only used with CreateTargetPath")
+	}
+	if request.AssignDriveLetter {
+		if request.CreateTargetPath {
+			return response, fmt.Errorf("MountVolumeRequest.CreateTargetPath can't be used to assign a drive letter")
+		}
+		if targetPath != "" {
+			return response, fmt.Errorf("MountVolumeRequest.TargetPath must be empty to assign a drive letter")
+		}
+		// hold the lock until the letter is assigned so that concurrent mounts don't pick the same letter
+		s.driveLettersLock.Lock()
+		defer s.driveLettersLock.Unlock()
+		letter, hasLetter, err := s.driveLetterFor(volumeID)
+		if err != nil {
+			klog.Errorf("failed to get a drive letter for volume %s: %v", volumeID, err)
+			return response, err
+		}
+		response.DriveLetter = letter
+		targetPath = letter + `:\`
+		if hasLetter {
+			// the volume is already mounted at the drive letter, make sure that it's tracked
+			if mounts := s.mounts.Get(volumeID); mounts != nil && mounts.ReadOnly != request.ReadOnly {
+				return response, fmt.Errorf("volume %s is mounted with readOnly=%v at %v, it can't be mounted with readOnly=%v", volumeID, mounts.ReadOnly, mounts.TargetPaths, request.ReadOnly)
+			}
+			klog.V(4).Infof("Volume %s already has the drive letter %s", volumeID, letter)
+			return response, s.mounts.AddMount(volumeID, targetPath, request.ReadOnly)
+		}
+	}
 	if targetPath == "" {
 		klog.Errorf("targetPath empty")
 		return response, fmt.Errorf("MountVolumeRequest.TargetPath is empty")
 	}
 
-	err := s.hostAPI.MountVolume(volumeID, targetPath)
+	// a volume can be mounted at multiple paths, as long as all of them are
+	// read-only or read-write because read-only applies to the whole volume
+	if mounts := s.mounts.Get(volumeID); mounts != nil {
+		if containsPath(mounts.TargetPaths, targetPath) {
+			klog.V(4).Infof("Volume %s is already mounted at %s", volumeID, targetPath)
+			return response, nil
+		}
+		if mounts.ReadOnly != request.ReadOnly {
+			return response, fmt.Errorf("volume %s is mounted with readOnly=%v at %v, it can't be mounted with readOnly=%v", volumeID, mounts.ReadOnly, mounts.TargetPaths, request.ReadOnly)
+		}
+		klog.V(4).Infof("Adding mount path %s to volume %s mounted at %v", targetPath, volumeID, mounts.TargetPaths)
+	}
+
+	// the volume may still be mounted on another node e.g. after a network partition
+	if err := s.fence(volumeID); err != nil {
+		klog.Errorf("failed MountVolume %v", err)
+		return response, err
+	}
+	hookContext := HookContext{Operation: HookMount, VolumeID: volumeID, Path: targetPath}
+	if err := s.runBeforeHooks(hookContext); err != nil {
+		klog.Errorf("failed MountVolume %v", err)
+		return response, err
+	}
+
+	var createdDirs []string
+	if request.CreateTargetPath {
+		var err error
+		createdDirs, err = s.hostAPI.CreateDirectories(targetPath, request.TargetPathSddl)
+		if err != nil {
+			klog.Errorf("failed to create the target path %s: %v", targetPath, err)
+			return response, err
+		}
+		klog.V(4).Infof("Created the directories %v of target path %s", createdDirs, targetPath)
+	}
+
+	// the write cache policy of the disk is only changed once the mount was
+	// validated and the volume fenced
+	var err error
+	if request.WriteThrough {
+		err = s.disableWriteCache(volumeID)
+	}
+	if err == nil {
+		err = s.hostAPI.MountVolume(volumeID, targetPath, request.ReadOnly)
+	}
 	if err != nil {
 		klog.Errorf("failed MountVolume %v", err)
+		// don't leave the directories created for the mount behind
+		if err := s.hostAPI.RemoveDirectories(createdDirs); err != nil {
+			klog.Warningf("failed to remove the directories %v created for the mount of volume %s: %v", createdDirs, volumeID, err)
+		}
 		return response, err
 	}
+	if request.DisableIndexing && !request.ReadOnly {
+		if err := s.hostAPI.SetVolumeIndexing(volumeID, false); err != nil {
+			warning := fmt.Sprintf("failed to exclude volume %s from the indexing: %v", volumeID, err)
+			klog.Warningf("MountVolume: %s", warning)
+			response.Warnings = append(response.Warnings, warning)
+		}
+	}
+	if err := s.mounts.AddMount(volumeID, targetPath, request.ReadOnly); err != nil {
+		klog.Errorf("failed to record the mount of volume %s at %s: %v", volumeID, targetPath, err)
+		return response, err
+	}
+	if !request.ReadOnly {
+		s.markMounted(volumeID)
+	}
+	response.Warnings = append(response.Warnings, s.runAfterHooks(hookContext)...)
+
+	// a dirty volume works but it may be corrupted, the caller decides whether to repair it.
+	// The dirty bit is a single FSCTL on the volume, failing to check it doesn't fail the mount
+	if dirty, err := s.hostAPI.IsVolumeDirty(volumeID); err != nil {
+		klog.Warningf("failed to check the dirty bit of volume %s: %v", volumeID, err)
+	} else if dirty {
+		warning := fmt.Sprintf("volume %s is mounted but its dirty bit is set, it may need to be repaired", volumeID)
+		klog.Warningf("MountVolume: %s", warning)
+		response.Warnings = append(response.Warnings, warning)
+	}
 	return response, nil
 }
 
+// driveLetterFor returns the drive letter of the volume `volumeID` if it has one,
+// otherwise it returns the first free drive letter that can be assigned to it.
+func (s *Server) driveLetterFor(volumeID string) (letter string, hasLetter bool, err error) {
+	letter, err = s.hostAPI.GetVolumeDriveLetter(volumeID)
+	if err != nil {
+		return "", false, err
+	}
+	if letter != "" {
+		return letter, true, nil
+	}
+
+	used, err := s.hostAPI.ListDriveLetters()
+	if err != nil {
+		return "", false, err
+	}
+	inUse := make(map[string]bool)
+	for _, l := range used {
+		inUse[strings.ToUpper(l)] = true
+	}
+	for _, l := range s.driveLetters {
+		if !inUse[string(l)] {
+			return string(l), false, nil
+		}
+	}
+	return "", false, fmt.Errorf("all the drive letters %q are in use", s.driveLetters)
+}
+
 func (s *Server) DismountVolume(context context.Context, request *internal.DismountVolumeRequest, version apiversion.Version) (*internal.DismountVolumeResponse, error) {
 	unmountVolumeRequest := &internal.UnmountVolumeRequest{
 		VolumeId:   request.VolumeId,
@@ -85,14 +547,143 @@ func (s *Server) UnmountVolume(context context.Context, request *internal.Unmoun
 		klog.Errorf("target path empty")
 		return response, fmt.Errorf("target path empty")
 	}
+	hookContext := HookContext{Operation: HookUnmount, VolumeID: volumeID, Path: targetPath}
+	if err := s.runBeforeHooks(hookContext); err != nil {
+		klog.Errorf("failed UnmountVolume %v", err)
+		return response, err
+	}
+	if request.Force {
+		response.OpenHandles = s.dismountBusyVolume(volumeID, targetPath)
+	}
 	err := s.hostAPI.UnmountVolume(volumeID, targetPath)
 	if err != nil {
 		klog.Errorf("failed UnmountVolume %v", err)
+		return response, s.explainUnmountFailure(volumeID, err)
+	}
+	remaining, err := s.mounts.RemoveMount(volumeID, targetPath)
+	if err != nil {
+		klog.Errorf("failed to record the unmount of volume %s from %s: %v", volumeID, targetPath, err)
 		return response, err
 	}
+	klog.V(4).Infof("Volume %s is still mounted at %d paths", volumeID, remaining)
+	if remaining == 0 {
+		s.markUnmounted(volumeID)
+	}
+	// the staging path is prepared again before the volume is staged again
+	if unstaged, err := s.mounts.RemoveStagingPath(targetPath); err != nil {
+		klog.Warningf("failed to forget the staging path %s: %v", targetPath, err)
+	} else if unstaged {
+		klog.V(4).Infof("Volume %s was unstaged from %s", volumeID, targetPath)
+	}
+	s.runAfterHooks(hookContext)
 	return response, nil
 }
 
+// dismountBusyVolume logs the open handles of the processes to the files of the volume
+// `volumeID` and dismounts its file system forcibly if it has any, so that unmounting it
+// from `targetPath` doesn't leave it busy. The file system isn't dismounted if the volume
+// is mounted at other paths. Failures are logged, the volume is unmounted anyway.
+func (s *Server) dismountBusyVolume(volumeID, targetPath string) []*internal.OpenHandle {
+	handles, err := s.hostAPI.ListOpenHandles(volumeID)
+	if err != nil {
+		klog.Warningf("failed to list the open handles of volume %s: %v", volumeID, err)
+		return nil
+	}
+	openHandles := make([]*internal.OpenHandle, 0, len(handles))
+	for _, h := range handles {
+		klog.Warningf("Volume %s has an open handle of process %d (%s) to %s", volumeID, h.ProcessID, h.ProcessName, h.Path)
+		openHandles = append(openHandles, &internal.OpenHandle{
+			ProcessId:   h.ProcessID,
+			ProcessName: h.ProcessName,
+			Path:        h.Path,
+		})
+	}
+	if len(handles) == 0 {
+		return openHandles
+	}
+
+	if mounts := s.mounts.Get(volumeID); mounts != nil {
+		for _, path := range mounts.TargetPaths {
+			if !samePath(path, targetPath) {
+				klog.Warningf("Not dismounting volume %s with %d open handles, it's still mounted at %s", volumeID, len(handles), path)
+				return openHandles
+			}
+		}
+	}
+	klog.Warningf("Dismounting volume %s forcibly, its %d open handles become invalid", volumeID, len(handles))
+	if err := s.hostAPI.DismountVolume(volumeID); err != nil {
+		klog.Errorf("failed to dismount volume %s: %v", volumeID, err)
+	}
+	return openHandles
+}
+
+// backgroundServices maps the executables of the Windows services that open the files
+// of volumes in the background, and can make their unmount fail, to the services.
+var backgroundServices = map[string]string{
+	"searchindexer.exe":         "Windows Search",
+	"searchprotocolhost.exe":    "Windows Search",
+	"searchfilterhost.exe":      "Windows Search",
+	"msmpeng.exe":               "Microsoft Defender",
+	"mpdefendercoreservice.exe": "Microsoft Defender",
+	"nissrv.exe":                "Microsoft Defender",
+}
+
+// volumeInUseError is returned when a volume can't be unmounted because processes
+// have open handles to its files.
+type volumeInUseError struct {
+	volumeID string
+	err      error
+	// processes are the processes with open handles e.g. "pause.exe (42)"
+	processes []string
+	// services are the background services among the processes
+	services []string
+}
+
+func (e *volumeInUseError) Error() string {
+	msg := fmt.Sprintf("%v: volume %s has open handles of %s", e.err, e.volumeID, strings.Join(e.processes, ", "))
+	if len(e.services) > 0 {
+		msg += fmt.Sprintf(", including the background scans of %s, exclude the volume from the scans or retry once they complete", strings.Join(e.services, " and "))
+	}
+	return msg
+}
+
+func (e *volumeInUseError) Unwrap() error {
+	return e.err
+}
+
+// ErrorCode returns the stable code of the error.
+func (e *volumeInUseError) ErrorCode() string {
+	return utils.ErrorCodeInUse
+}
+
+// explainUnmountFailure returns a volumeInUseError with the processes that hold open
+// handles to the files of the volume `volumeID` whose unmount failed with `err`, or
+// `err` if there are none.
+func (s *Server) explainUnmountFailure(volumeID string, err error) error {
+	handles, listErr := s.hostAPI.ListOpenHandles(volumeID)
+	if listErr != nil {
+		klog.Warningf("failed to list the open handles of volume %s: %v", volumeID, listErr)
+		return err
+	}
+	if len(handles) == 0 {
+		return err
+	}
+	inUse := &volumeInUseError{volumeID: volumeID, err: err}
+	seen := map[string]bool{}
+	for _, h := range handles {
+		process := fmt.Sprintf("%s (%d)", h.ProcessName, h.ProcessID)
+		if !seen[process] {
+			seen[process] = true
+			inUse.processes = append(inUse.processes, process)
+		}
+		if service, ok := backgroundServices[strings.ToLower(h.ProcessName)]; ok && !seen[service] {
+			seen[service] = true
+			inUse.services = append(inUse.services, service)
+		}
+	}
+	return inUse
+}
+
 func (s *Server) IsVolumeFormatted(context context.Context, request *internal.IsVolumeFormattedRequest, version apiversion.Version) (*internal.IsVolumeFormattedResponse, error) {
 	klog.V(2).Infof("IsVolumeFormatted: Request: %+v", request)
 	response := &internal.IsVolumeFormattedResponse{}
@@ -102,19 +693,343 @@ func (s *Server) IsVolumeFormatted(context context.Context, request *internal.Is
 		klog.Errorf("volume id empty")
 		return response, fmt.Errorf("volume id empty")
 	}
-	isFormatted, err := s.hostAPI.IsVolumeFormatted(volumeID)
+	isFormatted, fileSystemType, err := s.hostAPI.IsVolumeFormatted(volumeID)
+	if err != nil {
+		klog.Errorf("failed IsVolumeFormatted %v", err)
+		return response, err
+	}
+	klog.V(5).Infof("IsVolumeFormatted: return: %v, file system: %s", isFormatted, fileSystemType)
+	response.Formatted = isFormatted
+	response.FileSystemType = fileSystemType
+	if !isFormatted {
+		// Windows doesn't recognize the file system of a volume never formatted nor of a
+		// volume whose file system is damaged, only the first must be formatted
+		raw, err := s.hostAPI.IsVolumeRaw(volumeID)
+		if err != nil {
+			klog.Warningf("failed to check if volume %s is raw: %v", volumeID, err)
+		} else if !raw {
+			klog.Warningf("IsVolumeFormatted: the file system of volume %s isn't recognized but the volume has data", volumeID)
+			response.Unrecognized = true
+		}
+	}
+	return response, nil
+}
+
+func (s *Server) FormatVolume(context context.Context, request *internal.FormatVolumeRequest, version apiversion.Version) (*internal.FormatVolumeResponse, error) {
+	klog.V(2).Infof("FormatVolume: Request: %+v", request)
+	response := &internal.FormatVolumeResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	if err := validateAllocationUnitSize(request.AllocationUnitSize); err != nil {
+		klog.Errorf("invalid allocation unit size: %v", err)
+		return response, err
+	}
+
+	if utf8.RuneCountInString(request.Label) > maxVolumeLabelLength {
+		klog.Errorf("volume label %q too long", request.Label)
+		return response, fmt.Errorf("volume label %q is longer than %d characters", request.Label, maxVolumeLabelLength)
+	}
+
+	if err := s.checkDiskPolicy(volumeID); err != nil {
+		klog.Errorf("failed FormatVolume %v", err)
+		return response, err
+	}
+
+	options := volume.FormatOptions{
+		AllocationUnitSize: request.AllocationUnitSize,
+		Label:              request.Label,
+		Full:               request.FullFormat,
+		Compress:           request.Compress,
+		UseLargeFRS:        request.UseLargeFrs,
+	}
+	if request.FullFormat {
+		if err := s.runBeforeHooks(HookContext{Operation: HookFormat, VolumeID: volumeID}); err != nil {
+			klog.Errorf("failed FormatVolume %v", err)
+			return response, err
+		}
+		if err := s.startFullFormat(volumeID, options, request.WriteThrough); err != nil {
+			klog.Errorf("failed FormatVolume %v", err)
+			return response, err
+		}
+		return response, nil
+	}
+
+	s.fullFormatsLock.Lock()
+	ff, ok := s.fullFormats[volumeID]
+	s.fullFormatsLock.Unlock()
+	if ok && !ff.done {
+		return response, fmt.Errorf("a full format of volume %s is in progress", volumeID)
+	}
+
+	hookContext := HookContext{Operation: HookFormat, VolumeID: volumeID}
+	if err := s.runBeforeHooks(hookContext); err != nil {
+		klog.Errorf("failed FormatVolume %v", err)
+		return response, err
+	}
+	// the write cache policy of the disk is only changed once the format was
+	// validated and the before hooks ran
+	if request.WriteThrough {
+		if err := s.disableWriteCache(volumeID); err != nil {
+			klog.Errorf("failed FormatVolume %v", err)
+			return response, err
+		}
+	}
+	err := s.hostAPI.FormatVolume(volumeID, options)
+	s.forgetStats(volumeID)
+	if err != nil {
+		klog.Errorf("failed FormatVolume %v", err)
+		return response, err
+	}
+	s.runAfterHooks(hookContext)
+	return response, nil
+}
+
+// checkDiskPolicy returns an error if the disk policy doesn't allow writing to
+// the disk of the volume `volumeID`.
+func (s *Server) checkDiskPolicy(volumeID string) error {
+	if !s.diskPolicy.Restricted() {
+		return nil
+	}
+	d, err := s.hostAPI.GetVolumeDisk(volumeID)
+	if err != nil {
+		return err
+	}
+	if err := s.diskPolicy.Check(*d); err != nil {
+		return fmt.Errorf("the disk policy doesn't allow formatting volume %s: %v", volumeID, err)
+	}
+	return nil
+}
+
+// startFullFormat starts a full format of the volume `volumeID` in the background,
+// full formats can take longer than the timeout of the gRPC calls. The write cache
+// of the disk is disabled first if `writeThrough` is set.
+func (s *Server) startFullFormat(volumeID string, options volume.FormatOptions, writeThrough bool) error {
+	s.fullFormatsLock.Lock()
+	defer s.fullFormatsLock.Unlock()
+	if ff, ok := s.fullFormats[volumeID]; ok && !ff.done {
+		return fmt.Errorf("a full format of volume %s is already in progress", volumeID)
+	}
+	if writeThrough {
+		if err := s.disableWriteCache(volumeID); err != nil {
+			return err
+		}
+	}
+	ff := &fullFormat{}
+	s.fullFormats[volumeID] = ff
+
+	go func() {
+		klog.V(2).Infof("Starting full format of volume %s", volumeID)
+		err := s.hostAPI.FormatVolume(volumeID, options)
+		s.forgetStats(volumeID)
+		if err != nil {
+			klog.Errorf("failed full format of volume %s: %v", volumeID, err)
+		} else {
+			klog.V(2).Infof("Full format of volume %s completed", volumeID)
+			s.runAfterHooks(HookContext{Operation: HookFormat, VolumeID: volumeID})
+		}
+
+		s.fullFormatsLock.Lock()
+		defer s.fullFormatsLock.Unlock()
+		ff.done = true
+		ff.err = err
+	}()
+	return nil
+}
+
+func (s *Server) GetFormatVolumeStatus(context context.Context, request *internal.GetFormatVolumeStatusRequest, version apiversion.Version) (*internal.GetFormatVolumeStatusResponse, error) {
+	klog.V(4).Infof("GetFormatVolumeStatus: Request: %+v", request)
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return nil, fmt.Errorf("volume id empty")
+	}
+
+	s.fullFormatsLock.Lock()
+	defer s.fullFormatsLock.Unlock()
+	ff, ok := s.fullFormats[volumeID]
+	if !ok {
+		return nil, fmt.Errorf("no full format of volume %s was started", volumeID)
+	}
+	response := &internal.GetFormatVolumeStatusResponse{
+		InProgress: !ff.done,
+	}
+	if ff.err != nil {
+		response.Error = ff.err.Error()
+	}
+	return response, nil
+}
+
+func (s *Server) WriteVolumeCache(context context.Context, request *internal.WriteVolumeCacheRequest, version apiversion.Version) (*internal.WriteVolumeCacheResponse, error) {
+	klog.V(2).Infof("WriteVolumeCache: Request: %+v", request)
+	response := &internal.WriteVolumeCacheResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	err := s.hostAPI.WriteVolumeCache(volumeID)
+	if err != nil {
+		klog.Errorf("failed WriteVolumeCache %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) WriteVolumeCaches(context context.Context, request *internal.WriteVolumeCachesRequest, version apiversion.Version) (*internal.WriteVolumeCachesResponse, error) {
+	klog.V(2).Infof("WriteVolumeCaches: Request: %+v", request)
+	response := &internal.WriteVolumeCachesResponse{}
+
+	volumeIDs := []string{}
+	seen := make(map[string]bool)
+	for _, volumeID := range request.VolumeIds {
+		if volumeID == "" {
+			klog.Errorf("volume id empty")
+			return response, fmt.Errorf("volume id empty")
+		}
+		if !seen[volumeID] {
+			seen[volumeID] = true
+			volumeIDs = append(volumeIDs, volumeID)
+		}
+	}
+	if len(volumeIDs) == 0 {
+		volumeIDs = s.mounts.VolumeIDs()
+	}
+
+	errs := s.writeVolumeCaches(volumeIDs)
+	for _, volumeID := range volumeIDs {
+		if err, ok := errs[volumeID]; ok {
+			klog.Errorf("failed WriteVolumeCache %v", err)
+			response.Errors = append(response.Errors, &internal.VolumeCacheError{
+				VolumeId: volumeID,
+				Error:    err.Error(),
+			})
+		}
+	}
+	return response, nil
+}
+
+// writeVolumeCaches flushes the volumes `volumeIDs` in parallel and returns the
+// errors of the volumes that failed to flush.
+func (s *Server) writeVolumeCaches(volumeIDs []string) map[string]error {
+	var lock sync.Mutex
+	errs := make(map[string]error)
+	var wg sync.WaitGroup
+	for _, volumeID := range volumeIDs {
+		wg.Add(1)
+		go func(volumeID string) {
+			defer wg.Done()
+			if err := s.hostAPI.WriteVolumeCache(volumeID); err != nil {
+				lock.Lock()
+				defer lock.Unlock()
+				errs[volumeID] = err
+			}
+		}(volumeID)
+	}
+	wg.Wait()
+	return errs
+}
+
+func (s *Server) SyncVolume(context context.Context, request *internal.SyncVolumeRequest, version apiversion.Version) (*internal.SyncVolumeResponse, error) {
+	klog.V(2).Infof("SyncVolume: Request: %+v", request)
+	response := &internal.SyncVolumeResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	err := s.hostAPI.SyncVolume(volumeID)
+	if err != nil {
+		klog.Errorf("failed SyncVolume %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) GetVolumeWriteCache(context context.Context, request *internal.GetVolumeWriteCacheRequest, version apiversion.Version) (*internal.GetVolumeWriteCacheResponse, error) {
+	klog.V(2).Infof("GetVolumeWriteCache: Request: %+v", request)
+	response := &internal.GetVolumeWriteCacheResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	enabled, err := s.hostAPI.GetVolumeWriteCache(volumeID)
+	if err != nil {
+		klog.Errorf("failed GetVolumeWriteCache %v", err)
+		return response, err
+	}
+	response.WriteCacheEnabled = enabled
+	return response, nil
+}
+
+// disableWriteCache disables the write cache of the disk of the volume `volumeID`
+// if the disk policy allows writing to it.
+func (s *Server) disableWriteCache(volumeID string) error {
+	if err := s.checkDiskPolicy(volumeID); err != nil {
+		return err
+	}
+	if err := s.hostAPI.SetVolumeWriteCache(volumeID, false); err != nil {
+		return fmt.Errorf("failed to disable the write cache of the disk of volume %s: %v", volumeID, err)
+	}
+	klog.V(4).Infof("Disabled the write cache of the disk of volume %s", volumeID)
+	return nil
+}
+
+// repairModes maps the repair modes of the API to the switches of Repair-Volume.
+var repairModes = map[internal.RepairMode]volume.RepairMode{
+	internal.REPAIR_MODE_SCAN:                 volume.RepairModeScan,
+	internal.REPAIR_MODE_SPOT_FIX:             volume.RepairModeSpotFix,
+	internal.REPAIR_MODE_OFFLINE_SCAN_AND_FIX: volume.RepairModeOfflineScanAndFix,
+}
+
+func (s *Server) RepairVolume(context context.Context, request *internal.RepairVolumeRequest, version apiversion.Version) (*internal.RepairVolumeResponse, error) {
+	klog.V(2).Infof("RepairVolume: Request: %+v", request)
+	response := &internal.RepairVolumeResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+	mode, ok := repairModes[request.Mode]
+	if !ok {
+		klog.Errorf("invalid repair mode %d", request.Mode)
+		return response, fmt.Errorf("invalid repair mode %d", request.Mode)
+	}
+	// an offline repair dismounts the volume, it would fail the IO of the
+	// workloads using it
+	if mode == volume.RepairModeOfflineScanAndFix {
+		if mounts := s.mounts.Get(volumeID); mounts != nil {
+			klog.Errorf("volume %s is mounted at %v", volumeID, mounts.TargetPaths)
+			return response, fmt.Errorf("volume %s is mounted at %v, it must be unmounted before an offline repair", volumeID, mounts.TargetPaths)
+		}
+	}
+
+	result, err := s.hostAPI.RepairVolume(volumeID, mode)
 	if err != nil {
-		klog.Errorf("failed IsVolumeFormatted %v", err)
+		klog.Errorf("failed RepairVolume %v", err)
 		return response, err
 	}
-	klog.V(5).Infof("IsVolumeFormatted: return: %v", isFormatted)
-	response.Formatted = isFormatted
+	klog.V(2).Infof("RepairVolume: volume %s repaired with mode %s, result: %s", volumeID, mode, result)
+	response.Result = result
 	return response, nil
 }
 
-func (s *Server) FormatVolume(context context.Context, request *internal.FormatVolumeRequest, version apiversion.Version) (*internal.FormatVolumeResponse, error) {
-	klog.V(2).Infof("FormatVolume: Request: %+v", request)
-	response := &internal.FormatVolumeResponse{}
+func (s *Server) IsVolumeDirty(context context.Context, request *internal.IsVolumeDirtyRequest, version apiversion.Version) (*internal.IsVolumeDirtyResponse, error) {
+	klog.V(2).Infof("IsVolumeDirty: Request: %+v", request)
+	response := &internal.IsVolumeDirtyResponse{}
 
 	volumeID := request.VolumeId
 	if volumeID == "" {
@@ -122,17 +1037,18 @@ func (s *Server) FormatVolume(context context.Context, request *internal.FormatV
 		return response, fmt.Errorf("volume id empty")
 	}
 
-	err := s.hostAPI.FormatVolume(volumeID)
+	dirty, err := s.hostAPI.IsVolumeDirty(volumeID)
 	if err != nil {
-		klog.Errorf("failed FormatVolume %v", err)
+		klog.Errorf("failed IsVolumeDirty %v", err)
 		return response, err
 	}
+	response.Dirty = dirty
 	return response, nil
 }
 
-func (s *Server) WriteVolumeCache(context context.Context, request *internal.WriteVolumeCacheRequest, version apiversion.Version) (*internal.WriteVolumeCacheResponse, error) {
-	klog.V(2).Infof("WriteVolumeCache: Request: %+v", request)
-	response := &internal.WriteVolumeCacheResponse{}
+func (s *Server) OptimizeVolume(context context.Context, request *internal.OptimizeVolumeRequest, version apiversion.Version) (*internal.OptimizeVolumeResponse, error) {
+	klog.V(2).Infof("OptimizeVolume: Request: %+v", request)
+	response := &internal.OptimizeVolumeResponse{}
 
 	volumeID := request.VolumeId
 	if volumeID == "" {
@@ -140,9 +1056,13 @@ func (s *Server) WriteVolumeCache(context context.Context, request *internal.Wri
 		return response, fmt.Errorf("volume id empty")
 	}
 
-	err := s.hostAPI.WriteVolumeCache(volumeID)
-	if err != nil {
-		klog.Errorf("failed WriteVolumeCache %v", err)
+	options := volume.OptimizeOptions{
+		ReTrim:          request.Retrim,
+		Defrag:          request.Defrag,
+		SlabConsolidate: request.SlabConsolidate,
+	}
+	if err := s.hostAPI.OptimizeVolume(volumeID, options); err != nil {
+		klog.Errorf("failed OptimizeVolume %v", err)
 		return response, err
 	}
 	return response, nil
@@ -158,13 +1078,25 @@ func (s *Server) ResizeVolume(context context.Context, request *internal.ResizeV
 		return response, fmt.Errorf("volume id empty")
 	}
 	sizeBytes := request.SizeBytes
-	// TODO : Validate size param
+	if err := utils.ValidateByteSize("ResizeVolumeRequest.SizeBytes", sizeBytes); err != nil {
+		klog.Errorf("failed ResizeVolume %v", err)
+		return response, err
+	}
+	if request.AllowShrink && sizeBytes == 0 {
+		klog.Errorf("size is required to shrink volume %s", volumeID)
+		return response, fmt.Errorf("size is required to shrink volume %s", volumeID)
+	}
 
-	err := s.hostAPI.ResizeVolume(volumeID, sizeBytes)
+	warnings, err := s.hostAPI.ResizeVolume(volumeID, sizeBytes, request.AllowShrink)
+	s.forgetStats(volumeID)
 	if err != nil {
 		klog.Errorf("failed ResizeVolume %v", err)
 		return response, err
 	}
+	for _, warning := range warnings {
+		klog.Warningf("ResizeVolume: %s", warning)
+	}
+	response.Warnings = warnings
 	return response, nil
 }
 
@@ -188,6 +1120,142 @@ func (s *Server) VolumeStats(context context.Context, request *internal.VolumeSt
 	return volumeStatsResponse, nil
 }
 
+func (s *Server) GetVolumeLabel(context context.Context, request *internal.GetVolumeLabelRequest, version apiversion.Version) (*internal.GetVolumeLabelResponse, error) {
+	klog.V(2).Infof("GetVolumeLabel: Request: %+v", request)
+	response := &internal.GetVolumeLabelResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	label, err := s.hostAPI.GetVolumeLabel(volumeID)
+	if err != nil {
+		klog.Errorf("failed GetVolumeLabel %v", err)
+		return response, err
+	}
+	response.Label = label
+	return response, nil
+}
+
+// repairModesNeeded are the repair modes that fix the volumes whose file system
+// needs a repair by operational status.
+var repairModesNeeded = map[string]string{
+	"Scan Needed":        "SCAN",
+	"Spot Fix Needed":    "SPOT_FIX",
+	"Full Repair Needed": "OFFLINE_SCAN_AND_FIX",
+}
+
+func (s *Server) GetVolumeHealth(context context.Context, request *internal.GetVolumeHealthRequest, version apiversion.Version) (*internal.GetVolumeHealthResponse, error) {
+	klog.V(4).Infof("GetVolumeHealth: Request: %+v", request)
+	response := &internal.GetVolumeHealthResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	healthStatus, operationalStatus, err := s.hostAPI.GetVolumeHealth(volumeID)
+	if err != nil {
+		klog.Errorf("failed GetVolumeHealth %v", err)
+		return response, err
+	}
+	response.HealthStatus = healthStatus
+	response.OperationalStatus = operationalStatus
+	response.Abnormal = healthStatus != "Healthy" || (operationalStatus != "" && operationalStatus != "OK")
+	response.Message = fmt.Sprintf("volume health is %s, operational status is %s", healthStatus, operationalStatus)
+	if mode, ok := repairModesNeeded[operationalStatus]; ok {
+		response.Message = fmt.Sprintf("%s, the file system needs a RepairVolume with mode %s", response.Message, mode)
+	}
+	if response.Abnormal {
+		klog.Warningf("GetVolumeHealth: volume %s is abnormal: %s", volumeID, response.Message)
+	}
+	return response, nil
+}
+
+// volumeEventTypes are the internal types of the volume events of the host.
+var volumeEventTypes = map[volume.VolumeEventType]internal.VolumeEventType{
+	volume.VolumeAdded:   internal.VOLUME_EVENT_TYPE_ADDED,
+	volume.VolumeRemoved: internal.VOLUME_EVENT_TYPE_REMOVED,
+	volume.VolumeChanged: internal.VOLUME_EVENT_TYPE_CHANGED,
+}
+
+func (s *Server) WatchVolumes(context context.Context, request *internal.WatchVolumesRequest, send func(*internal.VolumeEvent) error, version apiversion.Version) error {
+	klog.V(2).Infof("WatchVolumes: Request: %+v", request)
+
+	watched := map[string]bool{}
+	for _, volumeID := range request.VolumeIds {
+		watched[strings.ToLower(volumeID)] = true
+	}
+	err := s.hostAPI.WatchVolumes(context, func(event volume.VolumeEvent) error {
+		if len(watched) > 0 && !watched[strings.ToLower(event.VolumeID)] {
+			return nil
+		}
+		eventType, ok := volumeEventTypes[event.Type]
+		if !ok {
+			klog.Warningf("WatchVolumes: ignoring the event %q of volume %s", event.Type, event.VolumeID)
+			return nil
+		}
+		klog.V(4).Infof("WatchVolumes: volume %s %s", event.VolumeID, event.Type)
+		return send(&internal.VolumeEvent{
+			Type:              eventType,
+			VolumeId:          event.VolumeID,
+			SizeBytes:         event.SizeBytes,
+			DriveLetter:       event.DriveLetter,
+			FileSystemType:    event.FileSystemType,
+			HealthStatus:      event.HealthStatus,
+			OperationalStatus: event.OperationalStatus,
+		})
+	})
+	if err != nil && context.Err() == nil {
+		klog.Errorf("failed WatchVolumes %v", err)
+	}
+	return err
+}
+
+func (s *Server) GetVolumeSerialNumber(context context.Context, request *internal.GetVolumeSerialNumberRequest, version apiversion.Version) (*internal.GetVolumeSerialNumberResponse, error) {
+	klog.V(2).Infof("GetVolumeSerialNumber: Request: %+v", request)
+	response := &internal.GetVolumeSerialNumberResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	serialNumber, err := s.hostAPI.GetVolumeSerialNumber(volumeID)
+	if err != nil {
+		klog.Errorf("failed GetVolumeSerialNumber %v", err)
+		return response, err
+	}
+	response.SerialNumber = serialNumber
+	return response, nil
+}
+
+func (s *Server) SetVolumeLabel(context context.Context, request *internal.SetVolumeLabelRequest, version apiversion.Version) (*internal.SetVolumeLabelResponse, error) {
+	klog.V(2).Infof("SetVolumeLabel: Request: %+v", request)
+	response := &internal.SetVolumeLabelResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+	if utf8.RuneCountInString(request.Label) > maxVolumeLabelLength {
+		klog.Errorf("volume label %q too long", request.Label)
+		return response, fmt.Errorf("volume label %q is longer than %d characters", request.Label, maxVolumeLabelLength)
+	}
+
+	if err := s.hostAPI.SetVolumeLabel(volumeID, request.Label); err != nil {
+		klog.Errorf("failed SetVolumeLabel %v", err)
+		return response, err
+	}
+	s.forgetStats(volumeID)
+	return response, nil
+}
+
 func (s *Server) GetVolumeStats(context context.Context, request *internal.GetVolumeStatsRequest, version apiversion.Version) (*internal.GetVolumeStatsResponse, error) {
 	klog.V(2).Infof("GetVolumeStats: Request: %+v", request)
 	volumeID := request.VolumeId
@@ -195,17 +1263,32 @@ func (s *Server) GetVolumeStats(context context.Context, request *internal.GetVo
 		return nil, fmt.Errorf("volume id empty")
 	}
 
-	totalBytes, usedBytes, err := s.hostAPI.GetVolumeStats(volumeID)
+	stats, err := s.volumeStats(volumeID)
 	if err != nil {
 		klog.Errorf("failed GetVolumeStats %v", err)
 		return nil, err
 	}
 
-	klog.V(2).Infof("VolumeStats: returned: Capacity %v Used %v", totalBytes, usedBytes)
+	klog.V(2).Infof("VolumeStats: returned: volume %s serial number %q Capacity %v Used %v", volumeID, stats.SerialNumber, stats.TotalBytes, stats.UsedBytes)
 
 	response := &internal.GetVolumeStatsResponse{
-		TotalBytes: totalBytes,
-		UsedBytes:  usedBytes,
+		TotalBytes:      stats.TotalBytes,
+		UsedBytes:       stats.UsedBytes,
+		FileSystemType:  stats.FileSystemType,
+		FileSystemLabel: stats.FileSystemLabel,
+		SerialNumber:    stats.SerialNumber,
+	}
+
+	// the health isn't part of the responses before v2alpha1, skip the slow query
+	// of the health if it would be dropped
+	minimumHealthVersion := apiversion.NewVersionOrPanic("v2alpha1")
+	if request.SizesOnly || version.Compare(minimumHealthVersion) < 0 {
+		return response, nil
+	}
+	response.HealthStatus, response.OperationalStatus, err = s.hostAPI.GetVolumeHealth(volumeID)
+	if err != nil {
+		klog.Errorf("failed GetVolumeHealth %v", err)
+		return nil, err
 	}
 
 	return response, nil
@@ -311,3 +1394,415 @@ func (s *Server) GetClosestVolumeIDFromTargetPath(context context.Context, reque
 
 	return response, nil
 }
+
+// PrepareStagingPath creates the staging directory of a volume, applies its
+// security descriptor and checks that it's on the OS volume, outside of the
+// volumes mounted by csi-proxy, before recording it in the mount metadata.
+func (s *Server) PrepareStagingPath(context context.Context, request *internal.PrepareStagingPathRequest, version apiversion.Version) (*internal.PrepareStagingPathResponse, error) {
+	klog.V(2).Infof("PrepareStagingPath: Request: %+v", request)
+	response := &internal.PrepareStagingPathResponse{}
+
+	stagingPath := request.StagingPath
+	if !isAbsoluteLocalPath(stagingPath) {
+		klog.Errorf("invalid staging path %q", stagingPath)
+		return response, fmt.Errorf("PrepareStagingPathRequest.StagingPath %q must be an absolute path with a drive letter e.g. C:\\dir", stagingPath)
+	}
+	if err := s.validatePath(stagingPath); err != nil {
+		klog.Errorf("failed PrepareStagingPath: %v", err)
+		return response, err
+	}
+	// a staging path inside another volume would be hidden or removed with it
+	if volumeID, targetPath := s.mounts.MountedVolumeAt(stagingPath); volumeID != "" {
+		klog.Errorf("failed PrepareStagingPath: %s is inside volume %s mounted at %s", stagingPath, volumeID, targetPath)
+		return response, fmt.Errorf("staging path %s is inside volume %s mounted at %s", stagingPath, volumeID, targetPath)
+	}
+	osVolumeID, err := s.hostAPI.GetClosestVolumeIDFromTargetPath(systemDrive())
+	if err != nil {
+		klog.Errorf("failed to get the OS volume: %v", err)
+		return response, err
+	}
+
+	created, err := s.hostAPI.CreateDirectories(stagingPath, request.Sddl)
+	if err != nil {
+		klog.Errorf("failed to create the staging path %s: %v", stagingPath, err)
+		return response, err
+	}
+	// don't leave the directories created behind if the staging path can't be used
+	fail := func(err error) (*internal.PrepareStagingPathResponse, error) {
+		klog.Errorf("failed PrepareStagingPath %v", err)
+		if err := s.hostAPI.RemoveDirectories(created); err != nil {
+			klog.Warningf("failed to remove the directories %v created for the staging path %s: %v", created, stagingPath, err)
+		}
+		return response, err
+	}
+	if request.Sddl != "" && len(created) == 0 && !s.mounts.IsStagingPath(stagingPath) {
+		// the security descriptor is only applied to the directories created, a
+		// staging path prepared before is left as it is so that calls can be retried
+		klog.Errorf("failed PrepareStagingPath: %s already exists", stagingPath)
+		return response, fmt.Errorf("staging path %s already exists, the security descriptor of an existing directory isn't changed", stagingPath)
+	}
+	volumeID, err := s.hostAPI.GetClosestVolumeIDFromTargetPath(stagingPath)
+	if err != nil {
+		return fail(err)
+	}
+	if !sameVolumeID(volumeID, osVolumeID) {
+		return fail(fmt.Errorf("staging path %s is on volume %s, not on the OS volume %s", stagingPath, volumeID, osVolumeID))
+	}
+	if err := s.mounts.AddStagingPath(stagingPath); err != nil {
+		return fail(fmt.Errorf("failed to record the staging path %s: %v", stagingPath, err))
+	}
+	response.Created = len(created) > 0
+	klog.Infof("Prepared staging path %s (created: %t, security descriptor: %q)", stagingPath, response.Created, request.Sddl)
+	return response, nil
+}
+
+// CreateBoundedDirectory mounts a volume on a dynamic VHDX of the requested size
+// at a directory, the VHDX is created, attached and formatted if it isn't yet so
+// that the directory is created again e.g. after the node restarts.
+func (s *Server) CreateBoundedDirectory(context context.Context, request *internal.CreateBoundedDirectoryRequest, version apiversion.Version) (*internal.CreateBoundedDirectoryResponse, error) {
+	klog.V(2).Infof("CreateBoundedDirectory: Request: %+v", request)
+	response := &internal.CreateBoundedDirectoryResponse{}
+
+	path := request.Path
+	if !isAbsoluteLocalPath(path) {
+		klog.Errorf("invalid bounded directory %q", path)
+		return response, fmt.Errorf("CreateBoundedDirectoryRequest.Path %q must be an absolute path with a drive letter e.g. C:\\dir", path)
+	}
+	if err := s.validatePath(path); err != nil {
+		klog.Errorf("failed CreateBoundedDirectory: %v", err)
+		return response, err
+	}
+	if request.SizeBytes < minBoundedDirectorySize {
+		return response, fmt.Errorf("CreateBoundedDirectoryRequest.SizeBytes %d is smaller than the minimum %d", request.SizeBytes, minBoundedDirectorySize)
+	}
+	vhdPath, err := s.boundedDirectoryVHDX(path)
+	if err != nil {
+		klog.Errorf("failed CreateBoundedDirectory %v", err)
+		return response, err
+	}
+	// a bounded directory inside another volume would be removed with it
+	if volumeID, targetPath := s.mounts.MountedVolumeAt(path); volumeID != "" && !samePath(targetPath, path) {
+		klog.Errorf("failed CreateBoundedDirectory: %s is inside volume %s mounted at %s", path, volumeID, targetPath)
+		return response, fmt.Errorf("bounded directory %s is inside volume %s mounted at %s", path, volumeID, targetPath)
+	}
+
+	s.boundedDirectoriesLock.Lock()
+	defer s.boundedDirectoriesLock.Unlock()
+	if err := os.MkdirAll(s.boundedDirectoriesDir, 0755); err != nil {
+		klog.Errorf("failed CreateBoundedDirectory %v", err)
+		return response, err
+	}
+	sizeBytes := (request.SizeBytes + boundedDirectorySizeUnit - 1) / boundedDirectorySizeUnit * boundedDirectorySizeUnit
+	volumeID, err := s.hostAPI.CreateBoundedVolume(vhdPath, int64(sizeBytes))
+	if err != nil {
+		klog.Errorf("failed to create the bounded volume %s: %v", vhdPath, err)
+		return response, err
+	}
+	created, err := s.hostAPI.CreateDirectories(path, "")
+	if err != nil {
+		klog.Errorf("failed to create the bounded directory %s: %v", path, err)
+		return response, err
+	}
+	if err := s.hostAPI.MountVolume(volumeID, path, false); err != nil {
+		klog.Errorf("failed to mount the bounded volume %s at %s: %v", volumeID, path, err)
+		if err := s.hostAPI.RemoveDirectories(created); err != nil {
+			klog.Warningf("failed to remove the directories %v created for the bounded directory %s: %v", created, path, err)
+		}
+		return response, err
+	}
+	if err := s.mounts.AddMount(volumeID, path, false); err != nil {
+		klog.Errorf("failed to record the mount of volume %s at %s: %v", volumeID, path, err)
+		return response, err
+	}
+	response.VolumeId = volumeID
+	klog.Infof("Created bounded directory %s of %d bytes on %s", path, sizeBytes, vhdPath)
+	return response, nil
+}
+
+// RemoveBoundedDirectory unmounts the volume of a bounded directory and deletes
+// its VHDX, it also cleans up a bounded directory whose creation failed.
+func (s *Server) RemoveBoundedDirectory(context context.Context, request *internal.RemoveBoundedDirectoryRequest, version apiversion.Version) (*internal.RemoveBoundedDirectoryResponse, error) {
+	klog.V(2).Infof("RemoveBoundedDirectory: Request: %+v", request)
+	response := &internal.RemoveBoundedDirectoryResponse{}
+
+	path := request.Path
+	if !isAbsoluteLocalPath(path) {
+		klog.Errorf("invalid bounded directory %q", path)
+		return response, fmt.Errorf("RemoveBoundedDirectoryRequest.Path %q must be an absolute path with a drive letter e.g. C:\\dir", path)
+	}
+	vhdPath, err := s.boundedDirectoryVHDX(path)
+	if err != nil {
+		klog.Errorf("failed RemoveBoundedDirectory %v", err)
+		return response, err
+	}
+
+	s.boundedDirectoriesLock.Lock()
+	defer s.boundedDirectoriesLock.Unlock()
+	if volumeID, targetPath := s.mounts.MountedVolumeAt(path); volumeID != "" && samePath(targetPath, path) {
+		if err := s.hostAPI.UnmountVolume(volumeID, path); err != nil {
+			klog.Errorf("failed RemoveBoundedDirectory %v", err)
+			return response, s.explainUnmountFailure(volumeID, err)
+		}
+		if _, err := s.mounts.RemoveMount(volumeID, path); err != nil {
+			klog.Errorf("failed to record the unmount of volume %s from %s: %v", volumeID, path, err)
+			return response, err
+		}
+	}
+	if err := s.hostAPI.DeleteBoundedVolume(vhdPath); err != nil {
+		klog.Errorf("failed to delete the bounded volume %s: %v", vhdPath, err)
+		return response, err
+	}
+	klog.Infof("Removed bounded directory %s", path)
+	return response, nil
+}
+
+// boundedDirectoryVHDX returns the path of the VHDX of the bounded directory
+// `path`, named after the hash of the path so that it's found again without
+// metadata.
+func (s *Server) boundedDirectoryVHDX(path string) (string, error) {
+	if s.boundedDirectoriesDir == "" {
+		return "", fmt.Errorf("bounded directories require a mount metadata file, their VHDX are stored in its directory")
+	}
+	// paths are case insensitive
+	hash := sha256.Sum256([]byte(strings.ToLower(strings.TrimRight(filepath.Clean(path), `\/`))))
+	return filepath.Join(s.boundedDirectoriesDir, hex.EncodeToString(hash[:16])+".vhdx"), nil
+}
+
+// isAbsoluteLocalPath returns true if `path` is an absolute path with a drive
+// letter e.g. C:\dir that doesn't have . or .. elements.
+func isAbsoluteLocalPath(path string) bool {
+	if len(path) < 3 || !unicode.IsLetter(rune(path[0])) || path[1] != ':' || (path[2] != '\\' && path[2] != '/') {
+		return false
+	}
+	for _, element := range strings.FieldsFunc(path[3:], func(r rune) bool { return r == '\\' || r == '/' }) {
+		if element == ".." || element == "." {
+			return false
+		}
+	}
+	return true
+}
+
+// systemDrive returns the root directory of the drive of the OS e.g. C:\.
+func systemDrive() string {
+	if drive := os.Getenv("SystemDrive"); drive != "" {
+		return drive + `\`
+	}
+	return `C:\`
+}
+
+// sameVolumeID compares volume IDs ignoring the case and the trailing separator,
+// e.g. \\?\Volume{...}\ and \\?\volume{...}
+func sameVolumeID(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, `\`), strings.TrimSuffix(b, `\`))
+}
+
+func (s *Server) GetVolumeAccessPaths(context context.Context, request *internal.GetVolumeAccessPathsRequest, version apiversion.Version) (*internal.GetVolumeAccessPathsResponse, error) {
+	klog.V(2).Infof("GetVolumeAccessPaths: Request: %+v", request)
+	response := &internal.GetVolumeAccessPathsResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	accessPaths, driveLetters, err := s.hostAPI.GetVolumeAccessPaths(volumeID)
+	if err != nil {
+		klog.Errorf("failed GetVolumeAccessPaths %v", err)
+		return response, err
+	}
+	response.AccessPaths = accessPaths
+	response.DriveLetters = driveLetters
+	return response, nil
+}
+
+func (s *Server) CleanupVolume(context context.Context, request *internal.CleanupVolumeRequest, version apiversion.Version) (*internal.CleanupVolumeResponse, error) {
+	klog.V(2).Infof("CleanupVolume: Request: %+v", request)
+	response := &internal.CleanupVolumeResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+	if request.UsageThresholdPercent > 100 {
+		return response, fmt.Errorf("usage threshold %d%% is greater than 100%%", request.UsageThresholdPercent)
+	}
+	for _, dir := range request.TempDirs {
+		if !isVolumeRelativePath(dir) {
+			return response, fmt.Errorf("temporary directory %q isn't relative to the root of the volume", dir)
+		}
+	}
+	// only the volumes of the workloads are cleaned up, never e.g. the OS volume
+	if s.mounts.Get(volumeID) == nil {
+		return response, fmt.Errorf("volume %s isn't mounted by csi-proxy", volumeID)
+	}
+
+	stats, err := s.volumeStats(volumeID)
+	if err != nil {
+		klog.Errorf("failed CleanupVolume %v", err)
+		return response, err
+	}
+	response.UsedBytesBefore = stats.UsedBytes
+	response.UsedBytesAfter = stats.UsedBytes
+	threshold := stats.TotalBytes * int64(request.UsageThresholdPercent) / 100
+	if stats.UsedBytes < threshold {
+		klog.V(4).Infof("CleanupVolume: volume %s uses %d bytes out of %d, below the threshold", volumeID, stats.UsedBytes, stats.TotalBytes)
+		return response, nil
+	}
+
+	options := volume.CleanupOptions{
+		RecycleBin: request.RecycleBin,
+		TempDirs:   request.TempDirs,
+	}
+	warnings, err := s.hostAPI.CleanupVolume(volumeID, options)
+	if err != nil {
+		klog.Errorf("failed CleanupVolume %v", err)
+		return response, err
+	}
+	for _, warning := range warnings {
+		klog.Warningf("CleanupVolume: %s", warning)
+	}
+	response.CleanedUp = true
+	response.Warnings = warnings
+
+	stats, err = s.volumeStats(volumeID)
+	if err != nil {
+		klog.Errorf("failed CleanupVolume %v", err)
+		return response, err
+	}
+	response.UsedBytesAfter = stats.UsedBytes
+	klog.V(2).Infof("CleanupVolume: freed %d bytes of volume %s", response.UsedBytesBefore-response.UsedBytesAfter, volumeID)
+	return response, nil
+}
+
+// isVolumeRelativePath returns true if `path` is a path relative to the root of a
+// volume that doesn't escape it nor designate the root itself.
+func isVolumeRelativePath(path string) bool {
+	if path == "" || strings.HasPrefix(path, `\`) || strings.HasPrefix(path, "/") || strings.Contains(path, ":") {
+		return false
+	}
+	for _, element := range strings.FieldsFunc(path, func(r rune) bool { return r == '\\' || r == '/' }) {
+		if element == ".." || element == "." {
+			return false
+		}
+	}
+	return true
+}
+
+// dedupUsageTypes maps the deduplication usage types of the API to the usage types of Enable-DedupVolume.
+var dedupUsageTypes = map[internal.DeduplicationUsageType]volume.DedupUsageType{
+	internal.DEDUPLICATION_USAGE_TYPE_DEFAULT: volume.DedupUsageTypeDefault,
+	internal.DEDUPLICATION_USAGE_TYPE_HYPER_V: volume.DedupUsageTypeHyperV,
+	internal.DEDUPLICATION_USAGE_TYPE_BACKUP:  volume.DedupUsageTypeBackup,
+}
+
+func (s *Server) EnableDeduplication(context context.Context, request *internal.EnableDeduplicationRequest, version apiversion.Version) (*internal.EnableDeduplicationResponse, error) {
+	klog.V(2).Infof("EnableDeduplication: Request: %+v", request)
+	response := &internal.EnableDeduplicationResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+	usageType, ok := dedupUsageTypes[request.UsageType]
+	if !ok {
+		klog.Errorf("invalid deduplication usage type %d", request.UsageType)
+		return response, fmt.Errorf("invalid deduplication usage type %d", request.UsageType)
+	}
+
+	// the deduplication of ReFS volumes is newer than the deduplication of NTFS volumes
+	_, fileSystemType, err := s.hostAPI.IsVolumeFormatted(volumeID)
+	if err != nil {
+		klog.Errorf("failed EnableDeduplication %v", err)
+		return response, err
+	}
+	if strings.EqualFold(fileSystemType, "ReFS") {
+		if err := utils.CheckFeature(utils.FeatureReFSDeduplication); err != nil {
+			klog.Errorf("failed EnableDeduplication %v", err)
+			return response, err
+		}
+	}
+
+	if err := s.hostAPI.EnableDeduplication(volumeID, usageType); err != nil {
+		klog.Errorf("failed EnableDeduplication %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) GetDeduplicationStatus(context context.Context, request *internal.GetDeduplicationStatusRequest, version apiversion.Version) (*internal.GetDeduplicationStatusResponse, error) {
+	klog.V(4).Infof("GetDeduplicationStatus: Request: %+v", request)
+	response := &internal.GetDeduplicationStatusResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	status, err := s.hostAPI.GetDeduplicationStatus(volumeID)
+	if err != nil {
+		klog.Errorf("failed GetDeduplicationStatus %v", err)
+		return response, err
+	}
+	response.Enabled = status.Enabled
+	response.UsageType = status.UsageType
+	response.SavedBytes = status.SavedSpace
+	response.SavingsRate = status.SavingsRate
+	response.OptimizedFilesCount = status.OptimizedFilesCount
+	response.InPolicyFilesCount = status.InPolicyFilesCount
+	if !status.LastOptimizationTime.IsZero() {
+		response.LastOptimizationTime = status.LastOptimizationTime.Unix()
+	}
+	response.LastOptimizationResult = status.LastOptimizationResultMessage
+	return response, nil
+}
+
+func (s *Server) SetVolumeCompression(context context.Context, request *internal.SetVolumeCompressionRequest, version apiversion.Version) (*internal.SetVolumeCompressionResponse, error) {
+	klog.V(2).Infof("SetVolumeCompression: Request: %+v", request)
+	response := &internal.SetVolumeCompressionResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	if err := s.hostAPI.SetVolumeCompression(volumeID, request.Enabled); err != nil {
+		klog.Errorf("failed SetVolumeCompression %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+// maxVolumeLabelLength is the maximum length of an NTFS file system label.
+const maxVolumeLabelLength = 32
+
+// ntfsAllocationUnitSizes are the cluster sizes (in bytes) supported by NTFS,
+// cluster sizes bigger than 64K are supported since Windows Server 2019.
+var ntfsAllocationUnitSizes = map[uint32]bool{
+	512:             true,
+	1024:            true,
+	2 * 1024:        true,
+	4 * 1024:        true,
+	8 * 1024:        true,
+	16 * 1024:       true,
+	32 * 1024:       true,
+	64 * 1024:       true,
+	128 * 1024:      true,
+	256 * 1024:      true,
+	512 * 1024:      true,
+	1024 * 1024:     true,
+	2 * 1024 * 1024: true,
+}
+
+// validateAllocationUnitSize checks that `allocationUnitSize` is a cluster size supported
+// by the file system volumes are formatted with (NTFS), 0 means the default cluster size.
+func validateAllocationUnitSize(allocationUnitSize uint32) error {
+	if allocationUnitSize == 0 || ntfsAllocationUnitSizes[allocationUnitSize] {
+		return nil
+	}
+	return fmt.Errorf("allocation unit size %d is not supported by NTFS, it must be a power of 2 between 512 and %d bytes", allocationUnitSize, 2*1024*1024)
+}
@@ -2,16 +2,129 @@ package volume
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
 	"github.com/kubernetes-csi/csi-proxy/pkg/os/volume"
 	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/volume/impl"
+	shared "github.com/kubernetes-csi/csi-proxy/pkg/shared/disk"
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type fakeVolumeAPI struct {
 	diskVolMap map[uint32][]string
+
+	// volumes are the volumes of the host
+	volumes []volume.VolumeInfo
+
+	// resizeAllowShrink is the allowShrink argument of the last resize
+	resizeAllowShrink bool
+	// volumeSize is the size of the volumes, smaller sizes are shrinks
+	volumeSize int64
+
+	// dirty is the dirty bit of the volumes
+	dirty bool
+	// dirtyErr is returned when the dirty bit is checked
+	dirtyErr error
+
+	// journalUSN is the position of the USN journal of the volumes, journalErr
+	// fails the reads of the journal
+	journalUSN int64
+	journalErr error
+	statsCalls int
+
+	// fileSystemType is the file system of the volumes, empty if they aren't formatted
+	fileSystemType string
+	// unrecognized is set if the volumes that aren't formatted have data
+	unrecognized bool
+
+	// repairMode is the mode of the last repair
+	repairMode volume.RepairMode
+
+	// optimizeOptions are the options of the last optimization
+	optimizeOptions *volume.OptimizeOptions
+
+	// fullFormatDone blocks full formats until it's closed
+	fullFormatDone chan struct{}
+	fullFormatErr  error
+
+	mountCalls int
+	// mountErr fails the mounts
+	mountErr error
+	// markers are the mount markers of the volumes
+	markers map[string]*volume.MountMarker
+	// dirs are the directories created by CreateDirectories
+	dirs map[string]bool
+	// dirsSDDL is the security descriptor of the last directories created
+	dirsSDDL string
+	// closestVolumeIDs are the volumes of the paths, the paths are on the volume
+	// "id" by default
+	closestVolumeIDs map[string]string
+
+	volumeDriveLetters map[string]string
+	usedDriveLetters   []string
+
+	// volumeAccessPaths are the access paths of the volumes without their drive letter
+	volumeAccessPaths map[string][]string
+
+	volumeLabels map[string]string
+
+	// flushed are the volumes whose cache was written
+	flushed     []string
+	flushedLock sync.Mutex
+	// flushErrs are the errors of the volumes that fail to flush
+	flushErrs map[string]error
+
+	// volumeDisk is the disk of the volumes
+	volumeDisk shared.DiskInfo
+	// formatCalls is the number of formats
+	formatCalls int
+	// formatOptions are the options of the last format
+	formatOptions volume.FormatOptions
+	// compressed are the volumes whose compression is enabled
+	compressed map[string]bool
+
+	// dedupUsageType is the usage type of the volumes whose deduplication is enabled
+	dedupUsageType map[string]volume.DedupUsageType
+
+	// volumeStats are the stats of the volumes, unknown sizes if nil
+	volumeStats *volume.VolumeStats
+	// cleanupOptions are the options of the last cleanup
+	cleanupOptions *volume.CleanupOptions
+	// usedBytesAfterCleanup are the used bytes of the volumes after a cleanup
+	usedBytesAfterCleanup int64
+	// healthCalls is the number of queries of the volume health
+	healthCalls int
+	// healthStatus and operationalStatus are the health of the volumes, Healthy and OK if empty
+	healthStatus      string
+	operationalStatus string
+
+	// openHandles are the open handles of the volumes
+	openHandles []volume.OpenHandle
+	// dismountCalls is the number of forced dismounts
+	dismountCalls int
+
+	// events are the volume events reported by WatchVolumes before it waits for the context
+	events []volume.VolumeEvent
+
+	// writeCacheDisabled are the volumes whose disk write cache is disabled
+	writeCacheDisabled map[string]bool
+	// indexingDisabled are the volumes excluded from the indexing
+	indexingDisabled map[string]bool
+	// unmountErr fails the unmounts
+	unmountErr error
+	// boundedVolumes are the sizes of the VHDX of the bounded volumes by path
+	boundedVolumes map[string]int64
 }
 
 var _ volume.API = &fakeVolumeAPI{}
@@ -22,31 +135,174 @@ func (volumeAPI *fakeVolumeAPI) Fill(diskToVolMapIn map[uint32][]string) {
 	}
 }
 
-func (volumeAPI *fakeVolumeAPI) ListVolumesOnDisk(diskNumber uint32, partitionNumber uint32) (volumeIDs []string, err error) {
+func (volumeAPI *fakeVolumeAPI) ListVolumesOnDisk(diskNumber uint32, partitionNumber uint32) ([]volume.VolumeInfo, error) {
 	v := volumeAPI.diskVolMap[diskNumber]
 	if v == nil {
 		return nil, fmt.Errorf("returning error for %d list", diskNumber)
 	}
-	return v, nil
+	// the volumes are on the partitions of the disk in order
+	volumes := []volume.VolumeInfo{}
+	for i, volumeID := range v {
+		diskNumber, partitionNumber := diskNumber, uint32(i+1)
+		volumes = append(volumes, volume.VolumeInfo{
+			VolumeID:        volumeID,
+			SizeBytes:       1024,
+			DiskNumber:      &diskNumber,
+			PartitionNumber: &partitionNumber,
+			AccessPaths:     []string{volumeID},
+			FileSystem:      "NTFS",
+		})
+	}
+	return volumes, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) ListVolumes() ([]volume.VolumeInfo, error) {
+	return volumeAPI.volumes, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) MountVolume(volumeID, path string, readOnly bool) error {
+	volumeAPI.mountCalls++
+	return volumeAPI.mountErr
+}
+
+func (volumeAPI *fakeVolumeAPI) GetMountMarker(volumeID string) (*volume.MountMarker, error) {
+	return volumeAPI.markers[volumeID], nil
+}
+
+func (volumeAPI *fakeVolumeAPI) SetMountMarker(volumeID string, marker *volume.MountMarker) error {
+	if volumeAPI.markers == nil {
+		volumeAPI.markers = make(map[string]*volume.MountMarker)
+	}
+	if marker == nil {
+		delete(volumeAPI.markers, volumeID)
+		return nil
+	}
+	volumeAPI.markers[volumeID] = marker
+	return nil
+}
+
+func (volumeAPI *fakeVolumeAPI) CreateDirectories(path string, sddl string) ([]string, error) {
+	if volumeAPI.dirs == nil {
+		volumeAPI.dirs = make(map[string]bool)
+	}
+	volumeAPI.dirsSDDL = sddl
+	created := []string{}
+	for dir := filepath.Clean(path); !volumeAPI.dirs[dir] && filepath.Dir(dir) != dir; dir = filepath.Dir(dir) {
+		volumeAPI.dirs[dir] = true
+		created = append([]string{dir}, created...)
+	}
+	return created, nil
 }
 
-func (volumeAPI *fakeVolumeAPI) MountVolume(volumeID, path string) error {
+func (volumeAPI *fakeVolumeAPI) RemoveDirectories(dirs []string) error {
+	for _, dir := range dirs {
+		delete(volumeAPI.dirs, dir)
+	}
 	return nil
 }
 
 func (volumeAPI *fakeVolumeAPI) UnmountVolume(volumeID, path string) error {
+	return volumeAPI.unmountErr
+}
+
+func (volumeAPI *fakeVolumeAPI) ListOpenHandles(volumeID string) ([]volume.OpenHandle, error) {
+	return volumeAPI.openHandles, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) DismountVolume(volumeID string) error {
+	volumeAPI.dismountCalls++
+	return nil
+}
+
+func (volumeAPI *fakeVolumeAPI) IsVolumeFormatted(volumeID string) (bool, string, error) {
+	return volumeAPI.fileSystemType != "", volumeAPI.fileSystemType, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) IsVolumeRaw(volumeID string) (bool, error) {
+	return !volumeAPI.unrecognized, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) FormatVolume(volumeID string, options volume.FormatOptions) error {
+	volumeAPI.formatCalls++
+	volumeAPI.formatOptions = options
+	if options.Full && volumeAPI.fullFormatDone != nil {
+		<-volumeAPI.fullFormatDone
+		return volumeAPI.fullFormatErr
+	}
 	return nil
 }
 
-func (volumeAPI *fakeVolumeAPI) IsVolumeFormatted(volumeID string) (bool, error) {
-	return true, nil
+func (volumeAPI *fakeVolumeAPI) SetVolumeCompression(volumeID string, enabled bool) error {
+	if volumeAPI.compressed == nil {
+		volumeAPI.compressed = make(map[string]bool)
+	}
+	volumeAPI.compressed[volumeID] = enabled
+	return nil
+}
+
+func (volumeAPI *fakeVolumeAPI) EnableDeduplication(volumeID string, usageType volume.DedupUsageType) error {
+	if volumeAPI.dedupUsageType == nil {
+		volumeAPI.dedupUsageType = make(map[string]volume.DedupUsageType)
+	}
+	volumeAPI.dedupUsageType[volumeID] = usageType
+	return nil
+}
+
+func (volumeAPI *fakeVolumeAPI) GetDeduplicationStatus(volumeID string) (*volume.DedupStatus, error) {
+	usageType, ok := volumeAPI.dedupUsageType[volumeID]
+	if !ok {
+		return &volume.DedupStatus{}, nil
+	}
+	return &volume.DedupStatus{
+		Enabled:                       true,
+		UsageType:                     string(usageType),
+		SavedSpace:                    1 << 30,
+		SavingsRate:                   42,
+		OptimizedFilesCount:           10,
+		InPolicyFilesCount:            12,
+		LastOptimizationTime:          time.Unix(1700000000, 0),
+		LastOptimizationResultMessage: "The operation completed successfully.",
+	}, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) ResizeVolume(volumeID string, size int64, allowShrink bool) ([]string, error) {
+	volumeAPI.resizeAllowShrink = allowShrink
+	if size < volumeAPI.volumeSize && !allowShrink {
+		return []string{"not shrunk"}, nil
+	}
+	return nil, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) RepairVolume(volumeID string, mode volume.RepairMode) (string, error) {
+	volumeAPI.repairMode = mode
+	return "NoErrorsFound", nil
+}
+
+func (volumeAPI *fakeVolumeAPI) IsVolumeDirty(volumeID string) (bool, error) {
+	return volumeAPI.dirty, volumeAPI.dirtyErr
 }
 
-func (volumeAPI *fakeVolumeAPI) FormatVolume(volumeID string) error {
+func (volumeAPI *fakeVolumeAPI) GetVolumeLabel(volumeID string) (string, error) {
+	return volumeAPI.volumeLabels[volumeID], nil
+}
+
+func (volumeAPI *fakeVolumeAPI) GetVolumeSerialNumber(volumeID string) (string, error) {
+	if volumeAPI.fileSystemType == "" {
+		return "", nil
+	}
+	return "1A2B-3C4D", nil
+}
+
+func (volumeAPI *fakeVolumeAPI) SetVolumeLabel(volumeID string, label string) error {
+	if volumeAPI.volumeLabels == nil {
+		volumeAPI.volumeLabels = make(map[string]string)
+	}
+	volumeAPI.volumeLabels[volumeID] = label
 	return nil
 }
 
-func (volumeAPI *fakeVolumeAPI) ResizeVolume(volumeID string, size int64) error {
+func (volumeAPI *fakeVolumeAPI) OptimizeVolume(volumeID string, options volume.OptimizeOptions) error {
+	volumeAPI.optimizeOptions = &options
 	return nil
 }
 
@@ -54,22 +310,133 @@ func (volumeAPI *fakeVolumeAPI) GetDiskNumberFromVolumeID(volumeID string) (uint
 	return 0, nil
 }
 
+func (volumeAPI *fakeVolumeAPI) GetVolumeDisk(volumeID string) (*shared.DiskInfo, error) {
+	d := volumeAPI.volumeDisk
+	return &d, nil
+}
+
 func (volumeAPI *fakeVolumeAPI) GetVolumeIDFromTargetPath(mount string) (string, error) {
 	return "id", nil
 }
 
 func (volumeAPI *fakeVolumeAPI) GetClosestVolumeIDFromTargetPath(mount string) (string, error) {
+	if volumeID, ok := volumeAPI.closestVolumeIDs[mount]; ok {
+		return volumeID, nil
+	}
 	return "id", nil
 }
 
-func (volumeAPI *fakeVolumeAPI) GetVolumeStats(volumeID string) (int64, int64, error) {
-	return -1, -1, nil
+func (volumeAPI *fakeVolumeAPI) GetVolumeStats(volumeID string) (*volume.VolumeStats, error) {
+	volumeAPI.statsCalls++
+	if volumeAPI.volumeStats != nil {
+		stats := *volumeAPI.volumeStats
+		return &stats, nil
+	}
+	return &volume.VolumeStats{TotalBytes: -1, UsedBytes: -1, SerialNumber: "1A2B-3C4D"}, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) HasVolumeSpaceChanged(volumeID string, since *volume.JournalPosition) (bool, *volume.JournalPosition, error) {
+	if volumeAPI.journalErr != nil {
+		return false, nil, volumeAPI.journalErr
+	}
+	position := &volume.JournalPosition{JournalID: 1, USN: volumeAPI.journalUSN}
+	return since == nil || *since != *position, position, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) CleanupVolume(volumeID string, options volume.CleanupOptions) ([]string, error) {
+	volumeAPI.cleanupOptions = &options
+	volumeAPI.volumeStats.UsedBytes = volumeAPI.usedBytesAfterCleanup
+	return []string{`D:\tmp\in-use.log wasn't deleted`}, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) GetVolumeHealth(volumeID string) (string, string, error) {
+	volumeAPI.healthCalls++
+	if volumeAPI.healthStatus != "" {
+		return volumeAPI.healthStatus, volumeAPI.operationalStatus, nil
+	}
+	return "Healthy", "OK", nil
 }
 
 func (volumeAPI *fakeVolumeAPI) WriteVolumeCache(volumeID string) error {
+	volumeAPI.flushedLock.Lock()
+	defer volumeAPI.flushedLock.Unlock()
+	if err, ok := volumeAPI.flushErrs[volumeID]; ok {
+		return err
+	}
+	volumeAPI.flushed = append(volumeAPI.flushed, volumeID)
+	return nil
+}
+
+func (volumeAPI *fakeVolumeAPI) SyncVolume(volumeID string) error {
+	return nil
+}
+
+func (volumeAPI *fakeVolumeAPI) SetVolumeIndexing(volumeID string, enabled bool) error {
+	if volumeAPI.indexingDisabled == nil {
+		volumeAPI.indexingDisabled = map[string]bool{}
+	}
+	volumeAPI.indexingDisabled[volumeID] = !enabled
+	return nil
+}
+
+func (volumeAPI *fakeVolumeAPI) SetVolumeWriteCache(volumeID string, enabled bool) error {
+	if volumeAPI.writeCacheDisabled == nil {
+		volumeAPI.writeCacheDisabled = map[string]bool{}
+	}
+	volumeAPI.writeCacheDisabled[volumeID] = !enabled
+	return nil
+}
+
+func (volumeAPI *fakeVolumeAPI) GetVolumeWriteCache(volumeID string) (bool, error) {
+	return !volumeAPI.writeCacheDisabled[volumeID], nil
+}
+
+func (volumeAPI *fakeVolumeAPI) GetVolumeDriveLetter(volumeID string) (string, error) {
+	return volumeAPI.volumeDriveLetters[volumeID], nil
+}
+
+func (volumeAPI *fakeVolumeAPI) ListDriveLetters() ([]string, error) {
+	return volumeAPI.usedDriveLetters, nil
+}
+
+func (volumeAPI *fakeVolumeAPI) WatchVolumes(ctx context.Context, handler func(volume.VolumeEvent) error) error {
+	for _, event := range volumeAPI.events {
+		if err := handler(event); err != nil {
+			return err
+		}
+	}
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (volumeAPI *fakeVolumeAPI) CreateBoundedVolume(vhdPath string, sizeBytes int64) (string, error) {
+	if volumeAPI.boundedVolumes == nil {
+		volumeAPI.boundedVolumes = make(map[string]int64)
+	}
+	if _, ok := volumeAPI.boundedVolumes[vhdPath]; !ok {
+		volumeAPI.boundedVolumes[vhdPath] = sizeBytes
+	}
+	return "bounded-" + filepath.Base(vhdPath), nil
+}
+
+func (volumeAPI *fakeVolumeAPI) DeleteBoundedVolume(vhdPath string) error {
+	delete(volumeAPI.boundedVolumes, vhdPath)
 	return nil
 }
 
+func (volumeAPI *fakeVolumeAPI) GetVolumeAccessPaths(volumeID string) ([]string, []string, error) {
+	accessPaths, ok := volumeAPI.volumeAccessPaths[volumeID]
+	if !ok {
+		return nil, nil, fmt.Errorf("volume %s not found", volumeID)
+	}
+	driveLetters := []string{}
+	if letter := volumeAPI.volumeDriveLetters[volumeID]; letter != "" {
+		accessPaths = append([]string{letter + `:\`}, accessPaths...)
+		driveLetters = append(driveLetters, letter)
+	}
+	return accessPaths, driveLetters, nil
+}
+
 func TestListVolumesOnDisk(t *testing.T) {
 	v1, err := apiversion.NewVersion("v1")
 	if err != nil {
@@ -115,7 +482,7 @@ func TestListVolumesOnDisk(t *testing.T) {
 	}
 	volAPI.Fill(diskToVolMap)
 
-	volumeSrv, err := NewServer(volAPI)
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
 	if err != nil {
 		t.Fatalf("Volume server could not be initialized: %v", err)
 	}
@@ -151,6 +518,2277 @@ func TestListVolumesOnDisk(t *testing.T) {
 					t.Fatalf("Volume: %s count: %d", k, v)
 				}
 			}
+
+			if len(volumeListResponse.Volumes) != len(volumeListResponse.VolumeIds) {
+				t.Fatalf("Expected %d volumes, got %d", len(volumeListResponse.VolumeIds), len(volumeListResponse.Volumes))
+			}
+			for i, v := range volumeListResponse.Volumes {
+				if v.VolumeId != volumeListResponse.VolumeIds[i] || v.DiskNumber != tc.inputDiskNumber ||
+					v.PartitionNumber != uint32(i+1) || v.FileSystem != "NTFS" {
+					t.Errorf("Unexpected volume %d: %+v", i, v)
+				}
+			}
+		}
+	}
+}
+
+func TestListVolumes(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	diskNumber, partitionNumber, diskSerialNumber := uint32(0), uint32(2), "disk-serial"
+	volAPI := &fakeVolumeAPI{
+		volumes: []volume.VolumeInfo{
+			{
+				VolumeID:         `\\?\Volume{1}\`,
+				SizeBytes:        1024,
+				DiskNumber:       &diskNumber,
+				PartitionNumber:  &partitionNumber,
+				AccessPaths:      []string{`C:\`, `\\?\Volume{1}\`},
+				SerialNumber:     "1A2B-3C4D",
+				DiskSerialNumber: &diskSerialNumber,
+			},
+			{
+				VolumeID:  `\\?\Volume{2}\`,
+				SizeBytes: 2048,
+			},
+		},
+	}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	response, err := volumeSrv.ListVolumes(context.TODO(), &internal.ListVolumesRequest{}, v2alpha1)
+	if err != nil {
+		t.Fatalf("ListVolumes failed: %v", err)
+	}
+	expected := []*internal.VolumeInfo{
+		{
+			VolumeId:         `\\?\Volume{1}\`,
+			DiskNumber:       0,
+			PartitionNumber:  2,
+			SizeBytes:        1024,
+			AccessPaths:      []string{`C:\`, `\\?\Volume{1}\`},
+			SerialNumber:     "1A2B-3C4D",
+			DiskSerialNumber: "disk-serial",
+		},
+		{
+			VolumeId:  `\\?\Volume{2}\`,
+			SizeBytes: 2048,
+		},
+	}
+	if !reflect.DeepEqual(response.Volumes, expected) {
+		t.Errorf("expected volumes %+v, got %+v", expected, response.Volumes)
+	}
+
+	volAPI.volumes[0].FileSystem = "NTFS"
+	volAPI.volumes = append(volAPI.volumes, volume.VolumeInfo{
+		VolumeID:    `\\?\Volume{3}\`,
+		SizeBytes:   4096,
+		AccessPaths: []string{`\\?\Volume{3}\`},
+		FileSystem:  "ReFS",
+	})
+	filterCases := []struct {
+		name            string
+		filter          *internal.VolumeFilter
+		expectedVolumes []string
+	}{
+		{
+			name:            "formatted only",
+			filter:          &internal.VolumeFilter{FormattedOnly: true},
+			expectedVolumes: []string{`\\?\Volume{1}\`, `\\?\Volume{3}\`},
+		},
+		{
+			name:            "file system",
+			filter:          &internal.VolumeFilter{FileSystem: "refs"},
+			expectedVolumes: []string{`\\?\Volume{3}\`},
+		},
+		{
+			name:            "minimum size",
+			filter:          &internal.VolumeFilter{MinSizeBytes: 2048},
+			expectedVolumes: []string{`\\?\Volume{2}\`, `\\?\Volume{3}\`},
+		},
+		{
+			name:            "with access path only",
+			filter:          &internal.VolumeFilter{WithAccessPathOnly: true},
+			expectedVolumes: []string{`\\?\Volume{1}\`},
+		},
+	}
+	for _, tc := range filterCases {
+		response, err := volumeSrv.ListVolumes(context.TODO(), &internal.ListVolumesRequest{Filter: tc.filter}, v2alpha1)
+		if err != nil {
+			t.Fatalf("%s: ListVolumes failed: %v", tc.name, err)
+		}
+		volumeIDs := []string{}
+		for _, v := range response.Volumes {
+			volumeIDs = append(volumeIDs, v.VolumeId)
+		}
+		if !reflect.DeepEqual(volumeIDs, tc.expectedVolumes) {
+			t.Errorf("%s: expected volumes %v, got %v", tc.name, tc.expectedVolumes, volumeIDs)
+		}
+	}
+	if _, err := volumeSrv.ListVolumes(context.TODO(), &internal.ListVolumesRequest{Filter: &internal.VolumeFilter{MinSizeBytes: -1}}, v2alpha1); err == nil {
+		t.Errorf("Expected error for a negative minimum size")
+	}
+}
+
+func TestDiscoverLocalVolumes(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	volAPI := &fakeVolumeAPI{
+		volumes: []volume.VolumeInfo{
+			{
+				VolumeID:    `\\?\Volume{1}\`,
+				AccessPaths: []string{`C:\`, `\\?\Volume{1}\`},
+				Label:       "Windows",
+				GptName:     "Basic data partition",
+			},
+			{
+				VolumeID:    `\\?\Volume{2}\`,
+				AccessPaths: []string{`C:\local-volumes\vol2\`, `\\?\Volume{2}\`},
+				GptName:     "local-ssd",
+			},
+			{
+				VolumeID:    `\\?\Volume{3}\`,
+				AccessPaths: []string{`\\?\Volume{3}\`},
+				Label:       "SCRATCH",
+				GptName:     "local-ssd",
+			},
+		},
+	}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	testCases := []struct {
+		name              string
+		rules             []*internal.VolumeDiscoveryRule
+		expectedVolumes   []string
+		expectedRuleNames []string
+		expectError       bool
+	}{
+		{
+			name:              "path prefix",
+			rules:             []*internal.VolumeDiscoveryRule{{Name: "local", PathPrefixes: []string{`c:\LOCAL-VOLUMES\`}}},
+			expectedVolumes:   []string{`\\?\Volume{2}\`},
+			expectedRuleNames: []string{"local"},
+		},
+		{
+			name:              "GPT name and label",
+			rules:             []*internal.VolumeDiscoveryRule{{Name: "scratch", GptNames: []string{"local-ssd"}, Labels: []string{"scratch"}}},
+			expectedVolumes:   []string{`\\?\Volume{3}\`},
+			expectedRuleNames: []string{"scratch"},
+		},
+		{
+			name: "first matching rule",
+			rules: []*internal.VolumeDiscoveryRule{
+				{Name: "scratch", Labels: []string{"scratch"}},
+				{Name: "ssd", GptNames: []string{"local-ssd"}},
+			},
+			expectedVolumes:   []string{`\\?\Volume{2}\`, `\\?\Volume{3}\`},
+			expectedRuleNames: []string{"ssd", "scratch"},
+		},
+		{
+			name:        "no rules",
+			expectError: true,
+		},
+		{
+			name:        "rule without conditions",
+			rules:       []*internal.VolumeDiscoveryRule{{Name: "all"}},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		response, err := volumeSrv.DiscoverLocalVolumes(context.TODO(), &internal.DiscoverLocalVolumesRequest{Rules: tc.rules}, v2alpha1)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("%s: expected an error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: DiscoverLocalVolumes failed: %v", tc.name, err)
+		}
+		volumeIDs := []string{}
+		for _, v := range response.Volumes {
+			volumeIDs = append(volumeIDs, v.VolumeId)
+		}
+		if !reflect.DeepEqual(volumeIDs, tc.expectedVolumes) || !reflect.DeepEqual(response.RuleNames, tc.expectedRuleNames) {
+			t.Errorf("%s: expected volumes %v with rules %v, got %v with rules %v", tc.name, tc.expectedVolumes, tc.expectedRuleNames, volumeIDs, response.RuleNames)
+		}
+	}
+}
+
+func TestIsVolumeFormatted(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	for _, fileSystemType := range []string{"", "NTFS", "ReFS"} {
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{fileSystemType: fileSystemType})
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		response, err := volumeSrv.IsVolumeFormatted(context.TODO(), &internal.IsVolumeFormattedRequest{VolumeId: "volumeID1"}, v2alpha1)
+		if err != nil {
+			t.Fatalf("Error %v not expected", err)
+		}
+		if response.Formatted != (fileSystemType != "") || response.FileSystemType != fileSystemType {
+			t.Errorf("Expected file system %q, got formatted=%v file system %q", fileSystemType, response.Formatted, response.FileSystemType)
+		}
+		if response.Unrecognized {
+			t.Errorf("Expected the volume with file system %q not to be unrecognized", fileSystemType)
+		}
+	}
+
+	// a volume with data whose file system isn't recognized
+	for _, fileSystemType := range []string{"", "NTFS"} {
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{fileSystemType: fileSystemType, unrecognized: true})
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		response, err := volumeSrv.IsVolumeFormatted(context.TODO(), &internal.IsVolumeFormattedRequest{VolumeId: "volumeID1"}, v2alpha1)
+		if err != nil {
+			t.Fatalf("Error %v not expected", err)
+		}
+		if response.Formatted != (fileSystemType != "") || response.Unrecognized != (fileSystemType == "") {
+			t.Errorf("Expected formatted=%v unrecognized=%v, got %+v", fileSystemType != "", fileSystemType == "", response)
+		}
+	}
+}
+
+func TestGetVolumeStatsHealth(t *testing.T) {
+	testCases := []struct {
+		name           string
+		version        string
+		sizesOnly      bool
+		expectedHealth string
+	}{
+		{
+			name:           "v2alpha1",
+			version:        "v2alpha1",
+			expectedHealth: "Healthy",
+		},
+		{
+			name:      "v2alpha1 sizes only",
+			version:   "v2alpha1",
+			sizesOnly: true,
+		},
+		{
+			name:    "v1 without health",
+			version: "v1",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := apiversion.NewVersion(tc.version)
+			if err != nil {
+				t.Fatalf("New version error: %v", err)
+			}
+			volAPI := &fakeVolumeAPI{}
+			volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+			if err != nil {
+				t.Fatalf("Volume server could not be initialized: %v", err)
+			}
+			request := &internal.GetVolumeStatsRequest{VolumeId: "volumeID1", SizesOnly: tc.sizesOnly}
+			response, err := volumeSrv.GetVolumeStats(context.TODO(), request, version)
+			if err != nil {
+				t.Fatalf("Error %v not expected", err)
+			}
+			if response.TotalBytes != -1 || response.UsedBytes != -1 || response.SerialNumber != "1A2B-3C4D" {
+				t.Errorf("Unexpected stats %+v", response)
+			}
+			if response.HealthStatus != tc.expectedHealth {
+				t.Errorf("Expected health %q, got %q", tc.expectedHealth, response.HealthStatus)
+			}
+			expectedCalls := 0
+			if tc.expectedHealth != "" {
+				expectedCalls = 1
+			}
+			if volAPI.healthCalls != expectedCalls {
+				t.Errorf("Expected %d queries of the health, got %d", expectedCalls, volAPI.healthCalls)
+			}
+		})
+	}
+}
+
+func TestGetVolumeStatsJournaled(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volAPI := &fakeVolumeAPI{volumeStats: &volume.VolumeStats{TotalBytes: 100, UsedBytes: 10}}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	volumeSrv.SetJournaledStats(true)
+
+	getUsedBytes := func() int64 {
+		request := &internal.GetVolumeStatsRequest{VolumeId: "volumeID1", SizesOnly: true}
+		response, err := volumeSrv.GetVolumeStats(context.TODO(), request, v2alpha1)
+		if err != nil {
+			t.Fatalf("Error %v not expected", err)
 		}
+		return response.UsedBytes
+	}
+	if used := getUsedBytes(); used != 10 || volAPI.statsCalls != 1 {
+		t.Errorf("Expected 10 used bytes after 1 query, got %d after %d", used, volAPI.statsCalls)
+	}
+
+	// the stats are kept while the journal has no changes
+	volAPI.volumeStats.UsedBytes = 20
+	if used := getUsedBytes(); used != 10 || volAPI.statsCalls != 1 {
+		t.Errorf("Expected the kept 10 used bytes, got %d after %d queries", used, volAPI.statsCalls)
+	}
+
+	volAPI.journalUSN = 42
+	if used := getUsedBytes(); used != 20 || volAPI.statsCalls != 2 {
+		t.Errorf("Expected 20 used bytes after 2 queries, got %d after %d", used, volAPI.statsCalls)
+	}
+
+	// a new label isn't recorded in the journal
+	volAPI.volumeStats.FileSystemLabel = "data"
+	if _, err := volumeSrv.SetVolumeLabel(context.TODO(), &internal.SetVolumeLabelRequest{VolumeId: "volumeID1", Label: "data"}, v2alpha1); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	getUsedBytes()
+	if volAPI.statsCalls != 3 {
+		t.Errorf("Expected 3 queries after the label change, got %d", volAPI.statsCalls)
+	}
+
+	// the volumes without a journal are always queried
+	volAPI.journalErr = fmt.Errorf("journal not active")
+	getUsedBytes()
+	getUsedBytes()
+	if volAPI.statsCalls != 5 {
+		t.Errorf("Expected 5 queries without a journal, got %d", volAPI.statsCalls)
+	}
+}
+
+func TestFormatVolumeAllocationUnitSize(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		name               string
+		allocationUnitSize uint32
+		isErrorExpected    bool
+	}{
+		{
+			name:               "default allocation unit size",
+			allocationUnitSize: 0,
+		},
+		{
+			name:               "64K allocation unit size",
+			allocationUnitSize: 64 * 1024,
+		},
+		{
+			name:               "2M allocation unit size",
+			allocationUnitSize: 2 * 1024 * 1024,
+		},
+		{
+			name:               "allocation unit size not a power of 2",
+			allocationUnitSize: 3000,
+			isErrorExpected:    true,
+		},
+		{
+			name:               "allocation unit size too big",
+			allocationUnitSize: 4 * 1024 * 1024,
+			isErrorExpected:    true,
+		},
+	}
+
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{})
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		request := &internal.FormatVolumeRequest{
+			VolumeId:           "volumeID1",
+			AllocationUnitSize: tc.allocationUnitSize,
+		}
+		_, err := volumeSrv.FormatVolume(context.TODO(), request, v2alpha1)
+		if tc.isErrorExpected && err == nil {
+			t.Errorf("Expected error for allocation unit size %d", tc.allocationUnitSize)
+		}
+		if !tc.isErrorExpected && err != nil {
+			t.Errorf("Error %v not expected", err)
+		}
+	}
+}
+
+func TestFormatVolumeDiskPolicy(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	policy := shared.DiskPolicy{
+		AllowedBusTypes: []string{"SAS"},
+		MaxSizeBytes:    100,
+		ProtectOSDisks:  true,
+	}
+	testCases := []struct {
+		name            string
+		disk            shared.DiskInfo
+		policy          shared.DiskPolicy
+		isErrorExpected bool
+	}{
+		{
+			name:   "allowed disk",
+			disk:   shared.DiskInfo{Number: 1, BusType: "SAS", Size: 100},
+			policy: policy,
+		},
+		{
+			name:            "OS disk",
+			disk:            shared.DiskInfo{Number: 0, BusType: "SAS", Size: 100, IsOSDisk: true},
+			policy:          policy,
+			isErrorExpected: true,
+		},
+		{
+			name:            "bus type not allowed",
+			disk:            shared.DiskInfo{Number: 1, BusType: "USB", Size: 100},
+			policy:          policy,
+			isErrorExpected: true,
+		},
+		{
+			name:            "disk too large",
+			disk:            shared.DiskInfo{Number: 1, BusType: "SAS", Size: 200},
+			policy:          policy,
+			isErrorExpected: true,
+		},
+		{
+			name: "no policy",
+			disk: shared.DiskInfo{Number: 0, BusType: "SAS", Size: 100, IsOSDisk: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		volumeAPI := &fakeVolumeAPI{volumeDisk: tc.disk}
+		volumeSrv, err := NewServer("", "", tc.policy, volumeAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		_, err = volumeSrv.FormatVolume(context.TODO(), &internal.FormatVolumeRequest{VolumeId: "volumeID1"}, v2alpha1)
+		if tc.isErrorExpected && (err == nil || volumeAPI.formatCalls != 0) {
+			t.Errorf("Expected the format to be rejected, err=%v, formatCalls=%d", err, volumeAPI.formatCalls)
+		}
+		if !tc.isErrorExpected && err != nil {
+			t.Errorf("Error %v not expected", err)
+		}
+	}
+}
+
+func TestFormatVolumeLabel(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		name            string
+		label           string
+		isErrorExpected bool
+	}{
+		{
+			name:  "no label",
+			label: "",
+		},
+		{
+			name:  "32 characters label",
+			label: "pvc-0123456789abcdef0123456789ab",
+		},
+		{
+			name:            "label longer than 32 characters",
+			label:           "pvc-0123456789abcdef0123456789abc",
+			isErrorExpected: true,
+		},
+	}
+
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{})
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		request := &internal.FormatVolumeRequest{
+			VolumeId: "volumeID1",
+			Label:    tc.label,
+		}
+		_, err := volumeSrv.FormatVolume(context.TODO(), request, v2alpha1)
+		if tc.isErrorExpected && err == nil {
+			t.Errorf("Expected error for label %q", tc.label)
+		}
+		if !tc.isErrorExpected && err != nil {
+			t.Errorf("Error %v not expected", err)
+		}
+	}
+}
+
+func TestFormatVolumeCompression(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volAPI := &fakeVolumeAPI{}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	request := &internal.FormatVolumeRequest{
+		VolumeId:           "volumeID1",
+		AllocationUnitSize: 4096,
+		Label:              "logs",
+		Compress:           true,
+		UseLargeFrs:        true,
+	}
+	if _, err := volumeSrv.FormatVolume(context.TODO(), request, v2alpha1); err != nil {
+		t.Fatalf("FormatVolume failed: %v", err)
+	}
+	expected := volume.FormatOptions{AllocationUnitSize: 4096, Label: "logs", Compress: true, UseLargeFRS: true}
+	if volAPI.formatOptions != expected {
+		t.Errorf("Expected the format options %+v, got %+v", expected, volAPI.formatOptions)
+	}
+
+	for _, enabled := range []bool{true, false} {
+		compressionRequest := &internal.SetVolumeCompressionRequest{VolumeId: "volumeID1", Enabled: enabled}
+		if _, err := volumeSrv.SetVolumeCompression(context.TODO(), compressionRequest, v2alpha1); err != nil {
+			t.Fatalf("SetVolumeCompression failed: %v", err)
+		}
+		if volAPI.compressed["volumeID1"] != enabled {
+			t.Errorf("Expected the compression of volumeID1 to be %t", enabled)
+		}
+	}
+	if _, err := volumeSrv.SetVolumeCompression(context.TODO(), &internal.SetVolumeCompressionRequest{}, v2alpha1); err == nil {
+		t.Errorf("Expected an error for an empty volume id")
+	}
+}
+
+func TestMountVolumeCreateTargetPath(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	sddl := "D:P(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)"
+
+	testCases := []struct {
+		name        string
+		request     *internal.MountVolumeRequest
+		mountErr    error
+		existing    []string
+		expectDirs  []string
+		expectError bool
+	}{
+		{
+			name:       "missing directories",
+			request:    &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: "/var/lib/kubelet/pv/globalmount", CreateTargetPath: true, TargetPathSddl: sddl},
+			existing:   []string{"/var/lib"},
+			expectDirs: []string{"/var", "/var/lib", "/var/lib/kubelet", "/var/lib/kubelet/pv", "/var/lib/kubelet/pv/globalmount"},
+		},
+		{
+			name:        "failed mount",
+			request:     &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: "/var/lib/kubelet/pv/globalmount", CreateTargetPath: true},
+			existing:    []string{"/var/lib"},
+			mountErr:    fmt.Errorf("mount failed"),
+			expectDirs:  []string{"/var", "/var/lib"},
+			expectError: true,
+		},
+		{
+			name:        "security descriptor without creating the target path",
+			request:     &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: "/var/lib/kubelet/pv/globalmount", TargetPathSddl: sddl},
+			expectError: true,
+		},
+		{
+			name:        "drive letter",
+			request:     &internal.MountVolumeRequest{VolumeId: "volumeID1", AssignDriveLetter: true, CreateTargetPath: true},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		volAPI := &fakeVolumeAPI{mountErr: tc.mountErr}
+		for _, dir := range tc.existing {
+			volAPI.CreateDirectories(dir, "")
+		}
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+
+		_, err = volumeSrv.MountVolume(context.TODO(), tc.request, v2alpha1)
+		if tc.expectError != (err != nil) {
+			t.Errorf("Expected error: %t, got %v", tc.expectError, err)
+		}
+		dirs := []string{}
+		for dir := range volAPI.dirs {
+			dirs = append(dirs, filepath.ToSlash(dir))
+		}
+		sort.Strings(dirs)
+		if len(tc.expectDirs) > 0 && !reflect.DeepEqual(dirs, tc.expectDirs) {
+			t.Errorf("Expected the directories %v, got %v", tc.expectDirs, dirs)
+		}
+		if tc.request.TargetPathSddl != "" && !tc.expectError && volAPI.dirsSDDL != sddl {
+			t.Errorf("Expected the directories to be created with the security descriptor %q, got %q", sddl, volAPI.dirsSDDL)
+		}
+	}
+}
+
+// fakeFencingHook records the fenced volumes.
+type fakeFencingHook struct {
+	fenced []string
+	err    error
+}
+
+func (h *fakeFencingHook) Fence(volumeID string, marker volume.MountMarker) error {
+	h.fenced = append(h.fenced, volumeID+"@"+marker.Node)
+	return h.err
+}
+
+func TestMountVolumeFencing(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		name         string
+		markerNode   string
+		fencingErr   error
+		readOnly     bool
+		expectFenced []string
+		expectMarker string
+		expectError  bool
+	}{
+		{
+			name:         "no marker",
+			expectMarker: "node1",
+		},
+		{
+			name:         "mounted on this node",
+			markerNode:   "NODE1",
+			expectMarker: "node1",
+		},
+		{
+			name:         "mounted on another node",
+			markerNode:   "node2",
+			expectFenced: []string{"volumeID1@node2"},
+			expectMarker: "node1",
+		},
+		{
+			name:         "fencing failed",
+			markerNode:   "node2",
+			fencingErr:   fmt.Errorf("disk still attached to node2"),
+			expectFenced: []string{"volumeID1@node2"},
+			expectMarker: "node2",
+			expectError:  true,
+		},
+		{
+			name:     "read-only mount",
+			readOnly: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		volAPI := &fakeVolumeAPI{}
+		if tc.markerNode != "" {
+			volAPI.SetMountMarker("volumeID1", &volume.MountMarker{Node: tc.markerNode})
+		}
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		hook := &fakeFencingHook{err: tc.fencingErr}
+		volumeSrv.SetFencingHook("node1", hook)
+
+		request := &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`, ReadOnly: tc.readOnly}
+		_, err = volumeSrv.MountVolume(context.TODO(), request, v2alpha1)
+		if tc.expectError != (err != nil) {
+			t.Errorf("Expected error: %t, got %v", tc.expectError, err)
+		}
+		if tc.expectError && volAPI.mountCalls != 0 {
+			t.Errorf("Expected the volume not to be mounted")
+		}
+		if !reflect.DeepEqual(hook.fenced, tc.expectFenced) {
+			t.Errorf("Expected the fenced volumes %v, got %v", tc.expectFenced, hook.fenced)
+		}
+		marker := ""
+		if m := volAPI.markers["volumeID1"]; m != nil {
+			marker = m.Node
+		}
+		if marker != tc.expectMarker {
+			t.Errorf("Expected the mount marker %q, got %q", tc.expectMarker, marker)
+		}
+		if tc.expectError {
+			continue
+		}
+
+		// the marker is kept while the volume is still mounted on this node
+		unmountRequest := &internal.UnmountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`}
+		volAPI.unmountErr = fmt.Errorf("volume busy")
+		if _, err := volumeSrv.UnmountVolume(context.TODO(), unmountRequest, v2alpha1); err == nil {
+			t.Fatalf("Expected UnmountVolume to fail")
+		}
+		marker = ""
+		if m := volAPI.markers["volumeID1"]; m != nil {
+			marker = m.Node
+		}
+		if marker != tc.expectMarker {
+			t.Errorf("Expected the mount marker %q after a failed unmount, got %q", tc.expectMarker, marker)
+		}
+		volAPI.unmountErr = nil
+
+		// the marker is removed when the volume is unmounted from its last path
+		if _, err := volumeSrv.UnmountVolume(context.TODO(), unmountRequest, v2alpha1); err != nil {
+			t.Fatalf("UnmountVolume failed: %v", err)
+		}
+		if volAPI.markers["volumeID1"] != nil {
+			t.Errorf("Expected the mount marker to be removed, got %+v", volAPI.markers["volumeID1"])
+		}
+	}
+}
+
+// fakeVolumeHook records the calls of the hook as "<phase> <operation> <volume ID> <path>".
+type fakeVolumeHook struct {
+	calls []string
+	// beforeErr and afterErr are returned by Before and After for the operation failOperation
+	failOperation HookOperation
+	beforeErr     error
+	afterErr      error
+}
+
+func (h *fakeVolumeHook) Before(op HookContext) error {
+	h.calls = append(h.calls, fmt.Sprintf("before %s %s %s", op.Operation, op.VolumeID, op.Path))
+	if op.Operation == h.failOperation {
+		return h.beforeErr
+	}
+	return nil
+}
+
+func (h *fakeVolumeHook) After(op HookContext) error {
+	h.calls = append(h.calls, fmt.Sprintf("after %s %s %s", op.Operation, op.VolumeID, op.Path))
+	if op.Operation == h.failOperation {
+		return h.afterErr
+	}
+	return nil
+}
+
+func TestVolumeHooks(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	mountRequest := &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`}
+	unmountRequest := &internal.UnmountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`}
+	formatRequest := &internal.FormatVolumeRequest{VolumeId: "volumeID1"}
+
+	t.Run("operations", func(t *testing.T) {
+		volAPI := &fakeVolumeAPI{}
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		first, second := &fakeVolumeHook{}, &fakeVolumeHook{}
+		volumeSrv.AddVolumeHook(first)
+		volumeSrv.AddVolumeHook(second)
+
+		if _, err := volumeSrv.FormatVolume(context.TODO(), formatRequest, v2alpha1); err != nil {
+			t.Fatalf("FormatVolume failed: %v", err)
+		}
+		if _, err := volumeSrv.MountVolume(context.TODO(), mountRequest, v2alpha1); err != nil {
+			t.Fatalf("MountVolume failed: %v", err)
+		}
+		// mounting again at the same path is a no-op without hooks
+		if _, err := volumeSrv.MountVolume(context.TODO(), mountRequest, v2alpha1); err != nil {
+			t.Fatalf("MountVolume failed: %v", err)
+		}
+		if _, err := volumeSrv.UnmountVolume(context.TODO(), unmountRequest, v2alpha1); err != nil {
+			t.Fatalf("UnmountVolume failed: %v", err)
+		}
+		expected := []string{
+			"before format volumeID1 ",
+			"after format volumeID1 ",
+			`before mount volumeID1 C:\mnt\a`,
+			`after mount volumeID1 C:\mnt\a`,
+			`before unmount volumeID1 C:\mnt\a`,
+			`after unmount volumeID1 C:\mnt\a`,
+		}
+		for _, hook := range []*fakeVolumeHook{first, second} {
+			if !reflect.DeepEqual(hook.calls, expected) {
+				t.Errorf("Expected the hook calls %v, got %v", expected, hook.calls)
+			}
+		}
+	})
+
+	t.Run("before hook failed", func(t *testing.T) {
+		volAPI := &fakeVolumeAPI{}
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		failing := &fakeVolumeHook{failOperation: HookMount, beforeErr: fmt.Errorf("agent unavailable")}
+		next := &fakeVolumeHook{}
+		volumeSrv.AddVolumeHook(failing)
+		volumeSrv.AddVolumeHook(next)
+
+		if _, err := volumeSrv.MountVolume(context.TODO(), mountRequest, v2alpha1); err == nil {
+			t.Fatalf("Expected MountVolume to fail")
+		}
+		if volAPI.mountCalls != 0 {
+			t.Errorf("Expected the volume not to be mounted")
+		}
+		if len(next.calls) != 0 {
+			t.Errorf("Expected the hooks after the failed one not to run, got %v", next.calls)
+		}
+	})
+
+	t.Run("after hook failed", func(t *testing.T) {
+		volAPI := &fakeVolumeAPI{}
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		volumeSrv.AddVolumeHook(&fakeVolumeHook{failOperation: HookMount, afterErr: fmt.Errorf("cache warm failed")})
+
+		response, err := volumeSrv.MountVolume(context.TODO(), mountRequest, v2alpha1)
+		if err != nil {
+			t.Fatalf("MountVolume failed: %v", err)
+		}
+		if len(response.Warnings) != 1 || !strings.Contains(response.Warnings[0], "cache warm failed") {
+			t.Errorf("Expected a warning about the hook, got %v", response.Warnings)
+		}
+	})
+}
+
+func TestRegisterVolumeHook(t *testing.T) {
+	hook := &fakeVolumeHook{}
+	RegisterVolumeHook("test-plugin", hook)
+	if h, ok := VolumeHookPlugin("test-plugin"); !ok || h != hook {
+		t.Errorf("Expected the registered hook, got %v", h)
+	}
+	if _, ok := VolumeHookPlugin("missing"); ok {
+		t.Errorf("Expected no hook registered as missing")
+	}
+}
+
+func TestCleanupVolume(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		name            string
+		request         *internal.CleanupVolumeRequest
+		notMounted      bool
+		expectCleanedUp bool
+		expectError     bool
+	}{
+		{
+			name:            "usage above the threshold",
+			request:         &internal.CleanupVolumeRequest{VolumeId: "volumeID1", UsageThresholdPercent: 80, RecycleBin: true, TempDirs: []string{"tmp", `logs\old`}},
+			expectCleanedUp: true,
+		},
+		{
+			name:    "usage below the threshold",
+			request: &internal.CleanupVolumeRequest{VolumeId: "volumeID1", UsageThresholdPercent: 95, RecycleBin: true},
+		},
+		{
+			name:            "no threshold",
+			request:         &internal.CleanupVolumeRequest{VolumeId: "volumeID1", RecycleBin: true},
+			expectCleanedUp: true,
+		},
+		{
+			name:        "volume not mounted by csi-proxy",
+			request:     &internal.CleanupVolumeRequest{VolumeId: "volumeID1", RecycleBin: true},
+			notMounted:  true,
+			expectError: true,
+		},
+		{
+			name:        "threshold greater than 100%",
+			request:     &internal.CleanupVolumeRequest{VolumeId: "volumeID1", UsageThresholdPercent: 101},
+			expectError: true,
+		},
+		{
+			name:        "directory outside of the volume",
+			request:     &internal.CleanupVolumeRequest{VolumeId: "volumeID1", TempDirs: []string{`tmp\..\..`}},
+			expectError: true,
+		},
+		{
+			name:        "root directory",
+			request:     &internal.CleanupVolumeRequest{VolumeId: "volumeID1", TempDirs: []string{`.`}},
+			expectError: true,
+		},
+		{
+			name:        "absolute directory",
+			request:     &internal.CleanupVolumeRequest{VolumeId: "volumeID1", TempDirs: []string{`C:\Windows\Temp`}},
+			expectError: true,
+		},
+		{
+			name:        "empty volume id",
+			request:     &internal.CleanupVolumeRequest{RecycleBin: true},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		volAPI := &fakeVolumeAPI{
+			volumeStats:           &volume.VolumeStats{TotalBytes: 1000, UsedBytes: 900},
+			usedBytesAfterCleanup: 400,
+		}
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		if !tc.notMounted {
+			if _, err := volumeSrv.MountVolume(context.TODO(), &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`}, v2alpha1); err != nil {
+				t.Fatalf("MountVolume failed: %v", err)
+			}
+		}
+
+		response, err := volumeSrv.CleanupVolume(context.TODO(), tc.request, v2alpha1)
+		if tc.expectError {
+			if err == nil || volAPI.cleanupOptions != nil {
+				t.Errorf("Expected the cleanup to be rejected, err=%v, cleanupOptions=%v", err, volAPI.cleanupOptions)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Error %v not expected", err)
+			continue
+		}
+		if response.CleanedUp != tc.expectCleanedUp || (volAPI.cleanupOptions != nil) != tc.expectCleanedUp {
+			t.Errorf("Expected the volume to be cleaned up: %t, got %+v", tc.expectCleanedUp, response)
+			continue
+		}
+		if !tc.expectCleanedUp {
+			if response.UsedBytesBefore != 900 || response.UsedBytesAfter != 900 {
+				t.Errorf("Unexpected used bytes %+v", response)
+			}
+			continue
+		}
+		if response.UsedBytesBefore != 900 || response.UsedBytesAfter != 400 || len(response.Warnings) != 1 {
+			t.Errorf("Unexpected response %+v", response)
+		}
+		if volAPI.cleanupOptions.RecycleBin != tc.request.RecycleBin || !reflect.DeepEqual(volAPI.cleanupOptions.TempDirs, tc.request.TempDirs) {
+			t.Errorf("Unexpected cleanup options %+v", volAPI.cleanupOptions)
+		}
+	}
+}
+
+func TestDeduplication(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volAPI := &fakeVolumeAPI{}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	statusRequest := &internal.GetDeduplicationStatusRequest{VolumeId: "volumeID1"}
+	status, err := volumeSrv.GetDeduplicationStatus(context.TODO(), statusRequest, v2alpha1)
+	if err != nil {
+		t.Fatalf("GetDeduplicationStatus failed: %v", err)
+	}
+	if status.Enabled || status.LastOptimizationTime != 0 {
+		t.Errorf("Expected the deduplication to be disabled, got %+v", status)
+	}
+
+	request := &internal.EnableDeduplicationRequest{VolumeId: "volumeID1", UsageType: internal.DEDUPLICATION_USAGE_TYPE_HYPER_V}
+	if _, err := volumeSrv.EnableDeduplication(context.TODO(), request, v2alpha1); err != nil {
+		t.Fatalf("EnableDeduplication failed: %v", err)
+	}
+	if volAPI.dedupUsageType["volumeID1"] != volume.DedupUsageTypeHyperV {
+		t.Errorf("Expected the deduplication to be enabled with usage type HyperV, got %v", volAPI.dedupUsageType)
+	}
+	status, err = volumeSrv.GetDeduplicationStatus(context.TODO(), statusRequest, v2alpha1)
+	if err != nil {
+		t.Fatalf("GetDeduplicationStatus failed: %v", err)
+	}
+	expected := &internal.GetDeduplicationStatusResponse{
+		Enabled:                true,
+		UsageType:              "HyperV",
+		SavedBytes:             1 << 30,
+		SavingsRate:            42,
+		OptimizedFilesCount:    10,
+		InPolicyFilesCount:     12,
+		LastOptimizationTime:   1700000000,
+		LastOptimizationResult: "The operation completed successfully.",
+	}
+	if !reflect.DeepEqual(status, expected) {
+		t.Errorf("Expected the status %+v, got %+v", expected, status)
+	}
+
+	request = &internal.EnableDeduplicationRequest{VolumeId: "volumeID2", UsageType: 3}
+	if _, err := volumeSrv.EnableDeduplication(context.TODO(), request, v2alpha1); err == nil {
+		t.Errorf("Expected an error for an invalid usage type")
+	}
+	if _, err := volumeSrv.EnableDeduplication(context.TODO(), &internal.EnableDeduplicationRequest{}, v2alpha1); err == nil {
+		t.Errorf("Expected an error for an empty volume id")
+	}
+}
+
+func TestDeduplicationReFS(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	defer utils.SetCapabilities(utils.Capabilities{Modules: utils.PowerShellModules})
+
+	testCases := []struct {
+		fileSystemType string
+		build          uint32
+		expectError    bool
+	}{
+		{fileSystemType: "NTFS", build: utils.BuildWindowsServer2022},
+		{fileSystemType: "ReFS", build: utils.BuildWindowsServer2022, expectError: true},
+		{fileSystemType: "ReFS", build: utils.BuildWindowsServer2025},
+	}
+	for _, tc := range testCases {
+		utils.SetCapabilities(utils.Capabilities{Build: tc.build, Modules: utils.PowerShellModules})
+		volAPI := &fakeVolumeAPI{fileSystemType: tc.fileSystemType}
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		request := &internal.EnableDeduplicationRequest{VolumeId: "volumeID1", UsageType: internal.DEDUPLICATION_USAGE_TYPE_DEFAULT}
+		_, err = volumeSrv.EnableDeduplication(context.TODO(), request, v2alpha1)
+		if tc.expectError != (err != nil) {
+			t.Errorf("%s on build %d: expected error=%v, got %v", tc.fileSystemType, tc.build, tc.expectError, err)
+		}
+		if _, enabled := volAPI.dedupUsageType["volumeID1"]; enabled == tc.expectError {
+			t.Errorf("%s on build %d: expected the deduplication to be enabled=%v", tc.fileSystemType, tc.build, !tc.expectError)
+		}
+	}
+}
+
+func TestFullFormatVolume(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	volAPI := &fakeVolumeAPI{
+		fullFormatDone: make(chan struct{}),
+		fullFormatErr:  fmt.Errorf("disk failure"),
+	}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	statusRequest := &internal.GetFormatVolumeStatusRequest{VolumeId: "volumeID1"}
+	if _, err := volumeSrv.GetFormatVolumeStatus(context.TODO(), statusRequest, v2alpha1); err == nil {
+		t.Fatalf("Expected error getting the status of a volume that wasn't formatted")
+	}
+
+	request := &internal.FormatVolumeRequest{VolumeId: "volumeID1", FullFormat: true}
+	if _, err := volumeSrv.FormatVolume(context.TODO(), request, v2alpha1); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+
+	status, err := volumeSrv.GetFormatVolumeStatus(context.TODO(), statusRequest, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if !status.InProgress {
+		t.Errorf("Expected the full format to be in progress")
+	}
+
+	// formats of the volume are rejected while the full format is running
+	if _, err := volumeSrv.FormatVolume(context.TODO(), request, v2alpha1); err == nil {
+		t.Errorf("Expected error starting a full format while another one is in progress")
+	}
+	quickRequest := &internal.FormatVolumeRequest{VolumeId: "volumeID1"}
+	if _, err := volumeSrv.FormatVolume(context.TODO(), quickRequest, v2alpha1); err == nil {
+		t.Errorf("Expected error starting a quick format while a full format is in progress")
+	}
+
+	close(volAPI.fullFormatDone)
+	for deadline := time.Now().Add(5 * time.Second); status.InProgress; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Full format didn't complete")
+		}
+		status, err = volumeSrv.GetFormatVolumeStatus(context.TODO(), statusRequest, v2alpha1)
+		if err != nil {
+			t.Fatalf("Error %v not expected", err)
+		}
+	}
+	if status.Error != volAPI.fullFormatErr.Error() {
+		t.Errorf("Expected full format error %q, got %q", volAPI.fullFormatErr.Error(), status.Error)
+	}
+}
+
+func TestAsyncOperations(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{})
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	// the operations wait for the lock of the volume until it's released
+	released := make(chan struct{})
+	volumeSrv.SetVolumeLock(func(ctx context.Context, volumeID string) (func(), error) {
+		<-released
+		return func() {}, nil
+	})
+
+	resizeRequest := &internal.ResizeVolumeAsyncRequest{
+		Request: &internal.ResizeVolumeRequest{VolumeId: "volumeID1", SizeBytes: 1024},
+	}
+	started, err := volumeSrv.ResizeVolumeAsync(context.TODO(), resizeRequest, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	// a retry gets the operation in progress
+	retried, err := volumeSrv.ResizeVolumeAsync(context.TODO(), resizeRequest, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if retried.OperationId != started.OperationId {
+		t.Errorf("Expected operation %s, got %s", started.OperationId, retried.OperationId)
+	}
+	repairRequest := &internal.RepairVolumeAsyncRequest{Request: &internal.RepairVolumeRequest{VolumeId: "VOLUMEID1"}}
+	if _, err := volumeSrv.RepairVolumeAsync(context.TODO(), repairRequest, v2alpha1); err == nil {
+		t.Errorf("Expected error starting a repair while a resize of the volume is in progress")
+	}
+	formatRequest := &internal.FormatVolumeAsyncRequest{Request: &internal.FormatVolumeRequest{VolumeId: "volumeID2", FullFormat: true}}
+	if _, err := volumeSrv.FormatVolumeAsync(context.TODO(), formatRequest, v2alpha1); err == nil {
+		t.Errorf("Expected error starting a full format asynchronously")
+	}
+
+	getRequest := &internal.GetOperationRequest{OperationId: started.OperationId}
+	operation, err := volumeSrv.GetOperation(context.TODO(), getRequest, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if operation.Done || operation.Method != "ResizeVolume" || operation.VolumeId != "volumeID1" {
+		t.Errorf("Expected the resize of volumeID1 to be in progress, got %+v", operation)
+	}
+
+	close(released)
+	for deadline := time.Now().Add(5 * time.Second); !operation.Done; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Operation didn't complete")
+		}
+		operation, err = volumeSrv.GetOperation(context.TODO(), getRequest, v2alpha1)
+		if err != nil {
+			t.Fatalf("Error %v not expected", err)
+		}
+	}
+	if operation.Error != "" || operation.ResizeVolumeResult == nil {
+		t.Errorf("Expected the resize to succeed with a result, got %+v", operation)
+	}
+
+	// the volume accepts other operations once the resize is done
+	if _, err := volumeSrv.RepairVolumeAsync(context.TODO(), repairRequest, v2alpha1); err != nil {
+		t.Errorf("Error %v not expected", err)
+	}
+
+	_, err = volumeSrv.GetOperation(context.TODO(), &internal.GetOperationRequest{OperationId: "unknown"}, v2alpha1)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for an unknown operation, got %v", err)
+	}
+}
+
+func TestUnmountVolumeForce(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	openHandles := []volume.OpenHandle{
+		{ProcessID: 42, ProcessName: "pause.exe", Path: `\\?\Volume{1}\log.txt`},
+	}
+
+	testCases := []struct {
+		name            string
+		force           bool
+		openHandles     []volume.OpenHandle
+		otherTargetPath string
+		expectHandles   int
+		expectDismount  bool
+	}{
+		{
+			name:        "not forced",
+			openHandles: openHandles,
+		},
+		{
+			name:  "forced without open handles",
+			force: true,
+		},
+		{
+			name:           "forced with open handles",
+			force:          true,
+			openHandles:    openHandles,
+			expectHandles:  1,
+			expectDismount: true,
+		},
+		{
+			name:            "forced with open handles mounted at another path",
+			force:           true,
+			openHandles:     openHandles,
+			otherTargetPath: `C:\mnt\b`,
+			expectHandles:   1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			volAPI := &fakeVolumeAPI{openHandles: tc.openHandles}
+			volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+			if err != nil {
+				t.Fatalf("Volume server could not be initialized: %v", err)
+			}
+			for _, targetPath := range []string{`C:\mnt\a`, tc.otherTargetPath} {
+				if targetPath == "" {
+					continue
+				}
+				request := &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: targetPath}
+				if _, err := volumeSrv.MountVolume(context.TODO(), request, v2alpha1); err != nil {
+					t.Fatalf("Error %v not expected", err)
+				}
+			}
+
+			request := &internal.UnmountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`, Force: tc.force}
+			response, err := volumeSrv.UnmountVolume(context.TODO(), request, v2alpha1)
+			if err != nil {
+				t.Fatalf("Error %v not expected", err)
+			}
+			if len(response.OpenHandles) != tc.expectHandles {
+				t.Errorf("Expected %d open handles, got %d", tc.expectHandles, len(response.OpenHandles))
+			}
+			if dismounted := volAPI.dismountCalls > 0; dismounted != tc.expectDismount {
+				t.Errorf("Expected dismount=%v, got %v", tc.expectDismount, dismounted)
+			}
+		})
+	}
+}
+
+func TestMountVolumeMultipleTargetPaths(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	mountMetadataFile := filepath.Join(t.TempDir(), "mounts.json")
+	volAPI := &fakeVolumeAPI{}
+	volumeSrv, err := NewServer(mountMetadataFile, "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	mount := func(targetPath string, readOnly bool) error {
+		request := &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: targetPath, ReadOnly: readOnly}
+		_, err := volumeSrv.MountVolume(context.TODO(), request, v2alpha1)
+		return err
+	}
+	unmount := func(targetPath string) error {
+		request := &internal.UnmountVolumeRequest{VolumeId: "volumeID1", TargetPath: targetPath}
+		_, err := volumeSrv.UnmountVolume(context.TODO(), request, v2alpha1)
+		return err
+	}
+
+	if err := mount(`C:\mnt\a`, true); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if err := mount(`C:\mnt\b`, true); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	// mounting again at the same path is a no-op
+	if err := mount(`c:\MNT\a\`, true); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if volAPI.mountCalls != 2 {
+		t.Errorf("Expected 2 mounts, got %d", volAPI.mountCalls)
+	}
+	// the volume is read-only so it can't be mounted read-write
+	if err := mount(`C:\mnt\c`, false); err == nil {
+		t.Errorf("Expected error mounting a read-only volume read-write")
+	}
+
+	// the mounts are persisted
+	restartedSrv, err := NewServer(mountMetadataFile, "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	mounts := restartedSrv.mounts.Get("volumeID1")
+	if mounts == nil || !reflect.DeepEqual(mounts.TargetPaths, []string{`C:\mnt\a`, `C:\mnt\b`}) || !mounts.ReadOnly {
+		t.Errorf("Unexpected mounts after a restart: %+v", mounts)
+	}
+
+	if err := unmount(`C:\mnt\a`); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if err := unmount(`C:\mnt\b`); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	// once the volume isn't mounted anywhere it can be mounted read-write
+	if err := mount(`C:\mnt\c`, false); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+}
+
+func TestMountVolumeAssignDriveLetter(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		name               string
+		driveLetters       string
+		volumeDriveLetters map[string]string
+		usedDriveLetters   []string
+		expectDriveLetter  string
+		expectMount        bool
+		isErrorExpected    bool
+	}{
+		{
+			name:              "first free drive letter",
+			usedDriveLetters:  []string{"C", "D"},
+			expectDriveLetter: "E",
+			expectMount:       true,
+		},
+		{
+			name:              "first free drive letter of the configured drive letters",
+			driveLetters:      "xyz",
+			usedDriveLetters:  []string{"C", "X"},
+			expectDriveLetter: "Y",
+			expectMount:       true,
+		},
+		{
+			name:               "volume with a drive letter",
+			volumeDriveLetters: map[string]string{"volumeID1": "F"},
+			usedDriveLetters:   []string{"C", "F"},
+			expectDriveLetter:  "F",
+		},
+		{
+			name:             "no free drive letters",
+			driveLetters:     "XY",
+			usedDriveLetters: []string{"C", "X", "Y"},
+			isErrorExpected:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		volAPI := &fakeVolumeAPI{
+			volumeDriveLetters: tc.volumeDriveLetters,
+			usedDriveLetters:   tc.usedDriveLetters,
+		}
+		volumeSrv, err := NewServer("", tc.driveLetters, shared.DiskPolicy{}, volAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		request := &internal.MountVolumeRequest{VolumeId: "volumeID1", AssignDriveLetter: true}
+		response, err := volumeSrv.MountVolume(context.TODO(), request, v2alpha1)
+		if tc.isErrorExpected {
+			if err == nil {
+				t.Errorf("Expected error assigning a drive letter")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Error %v not expected", err)
+		}
+		if response.DriveLetter != tc.expectDriveLetter {
+			t.Errorf("Expected drive letter %s, got %s", tc.expectDriveLetter, response.DriveLetter)
+		}
+		if (volAPI.mountCalls == 1) != tc.expectMount {
+			t.Errorf("Expected mount=%v, got %d mounts", tc.expectMount, volAPI.mountCalls)
+		}
+		mounts := volumeSrv.mounts.Get("volumeID1")
+		if mounts == nil || !containsPath(mounts.TargetPaths, tc.expectDriveLetter+`:\`) {
+			t.Errorf("Expected the drive letter to be recorded as a mount, got %+v", mounts)
+		}
+	}
+}
+
+func TestResizeVolumeAllowShrink(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		name            string
+		sizeBytes       int64
+		allowShrink     bool
+		expectWarnings  bool
+		isErrorExpected bool
+	}{
+		{
+			name:      "grow",
+			sizeBytes: 2 * 1024 * 1024 * 1024,
+		},
+		{
+			name:        "shrink",
+			sizeBytes:   512 * 1024 * 1024,
+			allowShrink: true,
+		},
+		{
+			name:           "shrink not allowed",
+			sizeBytes:      512 * 1024 * 1024,
+			expectWarnings: true,
+		},
+		{
+			name:            "shrink without size",
+			sizeBytes:       0,
+			allowShrink:     true,
+			isErrorExpected: true,
+		},
+		{
+			name:            "negative size",
+			sizeBytes:       -1,
+			isErrorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		volumeAPI := &fakeVolumeAPI{volumeSize: 1024 * 1024 * 1024}
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volumeAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		request := &internal.ResizeVolumeRequest{
+			VolumeId:    "volumeID1",
+			SizeBytes:   tc.sizeBytes,
+			AllowShrink: tc.allowShrink,
+		}
+		response, err := volumeSrv.ResizeVolume(context.TODO(), request, v2alpha1)
+		if tc.isErrorExpected {
+			if err == nil {
+				t.Errorf("Expected error")
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Error %v not expected", err)
+		}
+		if volumeAPI.resizeAllowShrink != tc.allowShrink {
+			t.Errorf("Expected allowShrink=%t, got %t", tc.allowShrink, volumeAPI.resizeAllowShrink)
+		}
+		if (len(response.Warnings) > 0) != tc.expectWarnings {
+			t.Errorf("Unexpected warnings %v", response.Warnings)
+		}
+	}
+}
+
+func TestExportImportMountState(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	source, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{})
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	request := &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`, ReadOnly: true}
+	if _, err := source.MountVolume(context.TODO(), request, v2alpha1); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	state, err := source.ExportState()
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+
+	mountMetadataFile := filepath.Join(t.TempDir(), "mounts.json")
+	target, err := NewServer(mountMetadataFile, "", shared.DiskPolicy{}, &fakeVolumeAPI{})
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	if err := target.ImportState(state); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+
+	// the imported mounts are persisted
+	restarted, err := NewServer(mountMetadataFile, "", shared.DiskPolicy{}, &fakeVolumeAPI{})
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	mounts := restarted.mounts.Get("volumeID1")
+	if mounts == nil || !mounts.ReadOnly || len(mounts.TargetPaths) != 1 || mounts.TargetPaths[0] != `C:\mnt\a` {
+		t.Errorf("Unexpected mounts after import: %+v", mounts)
+	}
+}
+
+func TestRepairVolume(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		name            string
+		mode            internal.RepairMode
+		mounted         bool
+		expectMode      volume.RepairMode
+		isErrorExpected bool
+	}{
+		{
+			name:       "scan",
+			mode:       internal.REPAIR_MODE_SCAN,
+			mounted:    true,
+			expectMode: volume.RepairModeScan,
+		},
+		{
+			name:       "spot fix",
+			mode:       internal.REPAIR_MODE_SPOT_FIX,
+			mounted:    true,
+			expectMode: volume.RepairModeSpotFix,
+		},
+		{
+			name:       "offline scan and fix",
+			mode:       internal.REPAIR_MODE_OFFLINE_SCAN_AND_FIX,
+			expectMode: volume.RepairModeOfflineScanAndFix,
+		},
+		{
+			name:            "offline scan and fix of a mounted volume",
+			mode:            internal.REPAIR_MODE_OFFLINE_SCAN_AND_FIX,
+			mounted:         true,
+			isErrorExpected: true,
+		},
+		{
+			name:            "invalid mode",
+			mode:            internal.RepairMode(10),
+			isErrorExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("test case: %s", tc.name)
+		volumeAPI := &fakeVolumeAPI{}
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volumeAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		if tc.mounted {
+			request := &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`}
+			if _, err := volumeSrv.MountVolume(context.TODO(), request, v2alpha1); err != nil {
+				t.Fatalf("Error %v not expected", err)
+			}
+		}
+
+		request := &internal.RepairVolumeRequest{VolumeId: "volumeID1", Mode: tc.mode}
+		response, err := volumeSrv.RepairVolume(context.TODO(), request, v2alpha1)
+		if tc.isErrorExpected {
+			if err == nil {
+				t.Errorf("Expected error")
+			}
+			if volumeAPI.repairMode != "" {
+				t.Errorf("Expected the volume not to be repaired")
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Error %v not expected", err)
+			continue
+		}
+		if volumeAPI.repairMode != tc.expectMode {
+			t.Errorf("Expected repair mode %s, got %s", tc.expectMode, volumeAPI.repairMode)
+		}
+		if response.Result != "NoErrorsFound" {
+			t.Errorf("Unexpected result %q", response.Result)
+		}
+	}
+}
+
+func TestOptimizeVolume(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volumeAPI := &fakeVolumeAPI{}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volumeAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	if _, err := volumeSrv.OptimizeVolume(context.TODO(), &internal.OptimizeVolumeRequest{Retrim: true}, v2alpha1); err == nil {
+		t.Errorf("Expected error for an empty volume id")
+	}
+	if volumeAPI.optimizeOptions != nil {
+		t.Errorf("Expected the volume not to be optimized")
+	}
+
+	request := &internal.OptimizeVolumeRequest{VolumeId: "volumeID1", Retrim: true, SlabConsolidate: true}
+	if _, err := volumeSrv.OptimizeVolume(context.TODO(), request, v2alpha1); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	expected := volume.OptimizeOptions{ReTrim: true, SlabConsolidate: true}
+	if volumeAPI.optimizeOptions == nil || *volumeAPI.optimizeOptions != expected {
+		t.Errorf("Expected options %+v, got %+v", expected, volumeAPI.optimizeOptions)
+	}
+}
+
+func TestSetVolumeLabel(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volumeAPI := &fakeVolumeAPI{volumeLabels: map[string]string{"volumeID1": "old"}}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volumeAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	request := &internal.SetVolumeLabelRequest{VolumeId: "volumeID1", Label: "pvc-0123456789abcdef0123456789abc"}
+	if _, err := volumeSrv.SetVolumeLabel(context.TODO(), request, v2alpha1); err == nil {
+		t.Errorf("Expected error for a label longer than 32 characters")
+	}
+
+	request = &internal.SetVolumeLabelRequest{VolumeId: "volumeID1", Label: "restored"}
+	if _, err := volumeSrv.SetVolumeLabel(context.TODO(), request, v2alpha1); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	response, err := volumeSrv.GetVolumeLabel(context.TODO(), &internal.GetVolumeLabelRequest{VolumeId: "volumeID1"}, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if response.Label != "restored" {
+		t.Errorf("Expected label %q, got %q", "restored", response.Label)
+	}
+}
+
+func TestGetVolumeHealth(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	testCases := []struct {
+		healthStatus      string
+		operationalStatus string
+		expectAbnormal    bool
+		expectRepairMode  string
+	}{
+		{
+			healthStatus:      "Healthy",
+			operationalStatus: "OK",
+		},
+		{
+			healthStatus:      "Warning",
+			operationalStatus: "Scan Needed",
+			expectAbnormal:    true,
+			expectRepairMode:  "SCAN",
+		},
+		{
+			healthStatus:      "Unhealthy",
+			operationalStatus: "Full Repair Needed",
+			expectAbnormal:    true,
+			expectRepairMode:  "OFFLINE_SCAN_AND_FIX",
+		},
+		{
+			healthStatus:      "Healthy",
+			operationalStatus: "Degraded",
+			expectAbnormal:    true,
+		},
+	}
+	for _, tc := range testCases {
+		volAPI := &fakeVolumeAPI{healthStatus: tc.healthStatus, operationalStatus: tc.operationalStatus}
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		response, err := volumeSrv.GetVolumeHealth(context.TODO(), &internal.GetVolumeHealthRequest{VolumeId: "volumeID1"}, v2alpha1)
+		if err != nil {
+			t.Fatalf("Error %v not expected", err)
+		}
+		if response.HealthStatus != tc.healthStatus || response.OperationalStatus != tc.operationalStatus || response.Abnormal != tc.expectAbnormal {
+			t.Errorf("Unexpected health %+v of a volume %s/%s", response, tc.healthStatus, tc.operationalStatus)
+		}
+		if tc.expectRepairMode != "" && !strings.Contains(response.Message, tc.expectRepairMode) {
+			t.Errorf("Expected the message %q to name the repair mode %s", response.Message, tc.expectRepairMode)
+		}
+	}
+}
+
+func TestGetVolumeSerialNumber(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	for _, fileSystemType := range []string{"", "NTFS"} {
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{fileSystemType: fileSystemType})
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		response, err := volumeSrv.GetVolumeSerialNumber(context.TODO(), &internal.GetVolumeSerialNumberRequest{VolumeId: "volumeID1"}, v2alpha1)
+		if err != nil {
+			t.Fatalf("Error %v not expected", err)
+		}
+		expected := ""
+		if fileSystemType != "" {
+			expected = "1A2B-3C4D"
+		}
+		if response.SerialNumber != expected {
+			t.Errorf("Expected serial number %q of a volume with file system %q, got %q", expected, fileSystemType, response.SerialNumber)
+		}
+	}
+}
+
+func TestMountVolumeDirtyWarning(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	for _, dirty := range []bool{false, true} {
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{dirty: dirty})
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		request := &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`}
+		response, err := volumeSrv.MountVolume(context.TODO(), request, v2alpha1)
+		if err != nil {
+			t.Fatalf("Error %v not expected", err)
+		}
+		if (len(response.Warnings) > 0) != dirty {
+			t.Errorf("Unexpected warnings %v for dirty=%t", response.Warnings, dirty)
+		}
+	}
+
+	// the dirty bit is only a caveat, failing to check it doesn't fail the mount
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{dirtyErr: fmt.Errorf("access denied")})
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	request := &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`}
+	if _, err := volumeSrv.MountVolume(context.TODO(), request, v2alpha1); err != nil {
+		t.Errorf("Expected the mount to succeed when the dirty bit can't be checked, got %v", err)
+	}
+}
+
+func TestFlushMountedVolumes(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volAPI := &fakeVolumeAPI{}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	for _, request := range []*internal.MountVolumeRequest{
+		{VolumeId: "volumeID1", TargetPath: filepath.Join(t.TempDir(), "a")},
+		{VolumeId: "volumeID1", TargetPath: filepath.Join(t.TempDir(), "b")},
+		{VolumeId: "volumeID2", TargetPath: filepath.Join(t.TempDir(), "c")},
+	} {
+		if _, err := volumeSrv.MountVolume(context.TODO(), request, v2alpha1); err != nil {
+			t.Fatalf("MountVolume failed: %v", err)
+		}
+	}
+
+	volumeSrv.FlushMountedVolumes(time.Minute)
+	sort.Strings(volAPI.flushed)
+	if expected := []string{"volumeID1", "volumeID2"}; !reflect.DeepEqual(volAPI.flushed, expected) {
+		t.Errorf("Expected the volumes %v to be flushed, got %v", expected, volAPI.flushed)
+	}
+}
+
+func TestWriteVolumeCaches(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volAPI := &fakeVolumeAPI{
+		flushErrs: map[string]error{"volumeID3": errors.New("access denied")},
+	}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	request := &internal.WriteVolumeCachesRequest{VolumeIds: []string{"volumeID1", "volumeID3", "volumeID2", "volumeID1"}}
+	response, err := volumeSrv.WriteVolumeCaches(context.TODO(), request, v2alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	sort.Strings(volAPI.flushed)
+	if expected := []string{"volumeID1", "volumeID2"}; !reflect.DeepEqual(volAPI.flushed, expected) {
+		t.Errorf("Expected the volumes %v to be flushed, got %v", expected, volAPI.flushed)
+	}
+	expectedErrors := []*internal.VolumeCacheError{{VolumeId: "volumeID3", Error: "access denied"}}
+	if !reflect.DeepEqual(response.Errors, expectedErrors) {
+		t.Errorf("Expected errors %+v, got %+v", expectedErrors, response.Errors)
+	}
+
+	// the mounted volumes are flushed by default
+	volAPI.flushed = nil
+	mountRequest := &internal.MountVolumeRequest{VolumeId: "volumeID4", TargetPath: filepath.Join(t.TempDir(), "a")}
+	if _, err := volumeSrv.MountVolume(context.TODO(), mountRequest, v2alpha1); err != nil {
+		t.Fatalf("MountVolume failed: %v", err)
+	}
+	if _, err := volumeSrv.WriteVolumeCaches(context.TODO(), &internal.WriteVolumeCachesRequest{}, v2alpha1); err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if expected := []string{"volumeID4"}; !reflect.DeepEqual(volAPI.flushed, expected) {
+		t.Errorf("Expected the volumes %v to be flushed, got %v", expected, volAPI.flushed)
+	}
+
+	request = &internal.WriteVolumeCachesRequest{VolumeIds: []string{"volumeID1", ""}}
+	if _, err := volumeSrv.WriteVolumeCaches(context.TODO(), request, v2alpha1); err == nil {
+		t.Errorf("Expected an error for an empty volume id")
+	}
+}
+
+func TestWatchVolumes(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+
+	events := []volume.VolumeEvent{
+		{Type: volume.VolumeAdded, VolumeID: "volumeID1", SizeBytes: 1 << 30, FileSystemType: "Unknown", HealthStatus: "Healthy", OperationalStatus: "OK"},
+		{Type: volume.VolumeAdded, VolumeID: "volumeID2"},
+		{Type: volume.VolumeChanged, VolumeID: "VOLUMEID1", SizeBytes: 1 << 30, DriveLetter: "E", FileSystemType: "NTFS", HealthStatus: "Healthy", OperationalStatus: "OK"},
+		{Type: volume.VolumeRemoved, VolumeID: "volumeID1", SizeBytes: 1 << 30},
+	}
+	testCases := []struct {
+		volumeIDs     []string
+		expectVolumes []string
+	}{
+		{volumeIDs: nil, expectVolumes: []string{"volumeID1", "volumeID2", "VOLUMEID1", "volumeID1"}},
+		{volumeIDs: []string{"volumeID1"}, expectVolumes: []string{"volumeID1", "VOLUMEID1", "volumeID1"}},
+		{volumeIDs: []string{"volumeID3"}, expectVolumes: nil},
+	}
+	for _, tc := range testCases {
+		volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{events: events})
+		if err != nil {
+			t.Fatalf("Volume server could not be initialized: %v", err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		var received []*internal.VolumeEvent
+		err = volumeSrv.WatchVolumes(ctx, &internal.WatchVolumesRequest{VolumeIds: tc.volumeIDs}, func(event *internal.VolumeEvent) error {
+			received = append(received, event)
+			return nil
+		}, v2alpha1)
+		cancel()
+		if err != context.DeadlineExceeded {
+			t.Errorf("Expected the watch to end with the context, got %v", err)
+		}
+		var volumes []string
+		for _, event := range received {
+			volumes = append(volumes, event.VolumeId)
+		}
+		if !reflect.DeepEqual(volumes, tc.expectVolumes) {
+			t.Errorf("Expected the events of volumes %v watching %v, got %v", tc.expectVolumes, tc.volumeIDs, volumes)
+		}
+		if len(received) == 4 {
+			if received[0].Type != internal.VOLUME_EVENT_TYPE_ADDED || received[2].Type != internal.VOLUME_EVENT_TYPE_CHANGED || received[3].Type != internal.VOLUME_EVENT_TYPE_REMOVED {
+				t.Errorf("Unexpected event types %+v", received)
+			}
+			if received[2].DriveLetter != "E" || received[2].FileSystemType != "NTFS" || received[2].SizeBytes != 1<<30 {
+				t.Errorf("Unexpected changed volume %+v", received[2])
+			}
+		}
+	}
+
+	// the watch ends when an event can't be sent
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, &fakeVolumeAPI{events: events})
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	sendErr := errors.New("stream closed")
+	err = volumeSrv.WatchVolumes(context.TODO(), &internal.WatchVolumesRequest{}, func(event *internal.VolumeEvent) error {
+		return sendErr
+	}, v2alpha1)
+	if err != sendErr {
+		t.Errorf("Expected the send error, got %v", err)
+	}
+}
+
+func TestGetVolumeAccessPaths(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volAPI := &fakeVolumeAPI{
+		volumeDriveLetters: map[string]string{`\\?\Volume{1}\`: "D"},
+		volumeAccessPaths: map[string][]string{
+			`\\?\Volume{1}\`: {`C:\mnt\a\`, `\\?\Volume{1}\`},
+			`\\?\Volume{2}\`: {`\\?\Volume{2}\`},
+		},
+	}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	testCases := []struct {
+		volumeID           string
+		expectAccessPaths  []string
+		expectDriveLetters []string
+		expectError        bool
+	}{
+		{
+			volumeID:           `\\?\Volume{1}\`,
+			expectAccessPaths:  []string{`D:\`, `C:\mnt\a\`, `\\?\Volume{1}\`},
+			expectDriveLetters: []string{"D"},
+		},
+		{
+			volumeID:           `\\?\Volume{2}\`,
+			expectAccessPaths:  []string{`\\?\Volume{2}\`},
+			expectDriveLetters: []string{},
+		},
+		{
+			volumeID:    `\\?\Volume{3}\`,
+			expectError: true,
+		},
+		{
+			volumeID:    "",
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		response, err := volumeSrv.GetVolumeAccessPaths(context.TODO(), &internal.GetVolumeAccessPathsRequest{VolumeId: tc.volumeID}, v2alpha1)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("Expected an error for volume %q", tc.volumeID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("GetVolumeAccessPaths of volume %s failed: %v", tc.volumeID, err)
+			continue
+		}
+		if !reflect.DeepEqual(response.AccessPaths, tc.expectAccessPaths) || !reflect.DeepEqual(response.DriveLetters, tc.expectDriveLetters) {
+			t.Errorf("Volume %s: expected access paths %v and drive letters %v, got %v and %v",
+				tc.volumeID, tc.expectAccessPaths, tc.expectDriveLetters, response.AccessPaths, response.DriveLetters)
+		}
+	}
+}
+
+func TestWriteThrough(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volAPI := &fakeVolumeAPI{}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	if _, err := volumeSrv.FormatVolume(context.TODO(), &internal.FormatVolumeRequest{VolumeId: "volumeID1", WriteThrough: true}, v2alpha1); err != nil {
+		t.Fatalf("FormatVolume failed: %v", err)
+	}
+	if _, err := volumeSrv.MountVolume(context.TODO(), &internal.MountVolumeRequest{VolumeId: "volumeID2", TargetPath: `C:\mnt\b`, WriteThrough: true}, v2alpha1); err != nil {
+		t.Fatalf("MountVolume failed: %v", err)
+	}
+	if _, err := volumeSrv.MountVolume(context.TODO(), &internal.MountVolumeRequest{VolumeId: "volumeID3", TargetPath: `C:\mnt\c`}, v2alpha1); err != nil {
+		t.Fatalf("MountVolume failed: %v", err)
+	}
+	for volumeID, expectEnabled := range map[string]bool{"volumeID1": false, "volumeID2": false, "volumeID3": true} {
+		response, err := volumeSrv.GetVolumeWriteCache(context.TODO(), &internal.GetVolumeWriteCacheRequest{VolumeId: volumeID}, v2alpha1)
+		if err != nil {
+			t.Fatalf("GetVolumeWriteCache failed: %v", err)
+		}
+		if response.WriteCacheEnabled != expectEnabled {
+			t.Errorf("Expected the write cache of the disk of %s to be enabled=%t", volumeID, expectEnabled)
+		}
+	}
+
+	// a rejected mount doesn't change the write cache of the disk
+	_, err = volumeSrv.MountVolume(context.TODO(), &internal.MountVolumeRequest{VolumeId: "volumeID3", TargetPath: `C:\mnt\d`, ReadOnly: true, WriteThrough: true}, v2alpha1)
+	if err == nil || volAPI.writeCacheDisabled["volumeID3"] {
+		t.Errorf("Expected the read-only mount of read-write volumeID3 to be rejected without disabling its write cache, err=%v", err)
+	}
+
+	// a rejected or aborted format doesn't change the write cache of the disk
+	volumeSrv.fullFormats["volumeID4"] = &fullFormat{}
+	for _, fullFormat := range []bool{false, true} {
+		request := &internal.FormatVolumeRequest{VolumeId: "volumeID4", FullFormat: fullFormat, WriteThrough: true}
+		_, err = volumeSrv.FormatVolume(context.TODO(), request, v2alpha1)
+		if err == nil || volAPI.writeCacheDisabled["volumeID4"] {
+			t.Errorf("Expected the format (full: %t) of volumeID4 to be rejected during a full format without disabling its write cache, err=%v", fullFormat, err)
+		}
+	}
+	volumeSrv.AddVolumeHook(&fakeVolumeHook{failOperation: HookFormat, beforeErr: fmt.Errorf("agent unavailable")})
+	_, err = volumeSrv.FormatVolume(context.TODO(), &internal.FormatVolumeRequest{VolumeId: "volumeID5", WriteThrough: true}, v2alpha1)
+	if err == nil || volAPI.writeCacheDisabled["volumeID5"] {
+		t.Errorf("Expected the format of volumeID5 to be aborted by the hook without disabling its write cache, err=%v", err)
+	}
+
+	// the write cache of the OS disk isn't changed when the disk policy protects it
+	volAPI = &fakeVolumeAPI{volumeDisk: shared.DiskInfo{Number: 0, IsOSDisk: true}}
+	volumeSrv, err = NewServer("", "", shared.DiskPolicy{ProtectOSDisks: true}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	_, err = volumeSrv.MountVolume(context.TODO(), &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`, WriteThrough: true}, v2alpha1)
+	if err == nil || volAPI.mountCalls != 0 || volAPI.writeCacheDisabled["volumeID1"] {
+		t.Errorf("Expected the write-through mount to be rejected, err=%v, mountCalls=%d", err, volAPI.mountCalls)
+	}
+}
+
+func TestUnmountVolumeInUse(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	unmountErr := errors.New("The process cannot access the file because it is being used by another process.")
+
+	testCases := []struct {
+		name          string
+		openHandles   []volume.OpenHandle
+		expectCode    string
+		expectMessage string
+	}{
+		{
+			name:        "no open handles",
+			openHandles: nil,
+		},
+		{
+			name: "pod process",
+			openHandles: []volume.OpenHandle{
+				{ProcessID: 42, ProcessName: "pause.exe", Path: `\\?\Volume{1}\log.txt`},
+			},
+			expectCode:    utils.ErrorCodeInUse,
+			expectMessage: "pause.exe (42)",
+		},
+		{
+			name: "indexing and Defender scan",
+			openHandles: []volume.OpenHandle{
+				{ProcessID: 7, ProcessName: "SearchProtocolHost.exe", Path: `\\?\Volume{1}\a.txt`},
+				{ProcessID: 7, ProcessName: "SearchProtocolHost.exe", Path: `\\?\Volume{1}\b.txt`},
+				{ProcessID: 9, ProcessName: "MsMpEng.exe", Path: `\\?\Volume{1}\a.txt`},
+			},
+			expectCode:    utils.ErrorCodeInUse,
+			expectMessage: "SearchProtocolHost.exe (7), MsMpEng.exe (9), including the background scans of Windows Search and Microsoft Defender",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			volAPI := &fakeVolumeAPI{openHandles: tc.openHandles, unmountErr: unmountErr}
+			volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+			if err != nil {
+				t.Fatalf("Volume server could not be initialized: %v", err)
+			}
+			request := &internal.UnmountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`}
+			_, err = volumeSrv.UnmountVolume(context.TODO(), request, v2alpha1)
+			if !errors.Is(err, unmountErr) {
+				t.Fatalf("Expected the unmount error, got %v", err)
+			}
+			if code, _ := utils.StableErrorCode(err); tc.expectCode != "" && code != tc.expectCode {
+				t.Errorf("Expected code %s, got %s", tc.expectCode, code)
+			}
+			if !strings.Contains(err.Error(), tc.expectMessage) {
+				t.Errorf("Expected %q in the error, got %v", tc.expectMessage, err)
+			}
+		})
+	}
+}
+
+func TestMountVolumeDisableIndexing(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volAPI := &fakeVolumeAPI{}
+	volumeSrv, err := NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	request := &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: `C:\mnt\a`, DisableIndexing: true}
+	if _, err := volumeSrv.MountVolume(context.TODO(), request, v2alpha1); err != nil {
+		t.Fatalf("MountVolume failed: %v", err)
+	}
+	request = &internal.MountVolumeRequest{VolumeId: "volumeID2", TargetPath: `C:\mnt\b`}
+	if _, err := volumeSrv.MountVolume(context.TODO(), request, v2alpha1); err != nil {
+		t.Fatalf("MountVolume failed: %v", err)
+	}
+	if !volAPI.indexingDisabled["volumeID1"] || volAPI.indexingDisabled["volumeID2"] {
+		t.Errorf("Expected only volumeID1 to be excluded from the indexing, got %v", volAPI.indexingDisabled)
+	}
+}
+
+// fakePathValidator accepts the paths within its working directories.
+type fakePathValidator struct {
+	workingDirs []string
+}
+
+func (v fakePathValidator) ValidatePluginPath(path string) error {
+	path = strings.ToLower(path)
+	for _, dir := range v.workingDirs {
+		dir = strings.ToLower(dir)
+		if path == dir || strings.HasPrefix(path, dir+`\`) {
+			return nil
+		}
+	}
+	return fmt.Errorf("path %s is not within the working directories %v", path, v.workingDirs)
+}
+
+func TestPrepareStagingPath(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	sddl := "D:P(A;OICI;FA;;;SY)(A;OICI;FA;;;BA)"
+	metadataFile := filepath.Join(t.TempDir(), "mounts.json")
+	volAPI := &fakeVolumeAPI{closestVolumeIDs: map[string]string{`D:\staging`: "data"}}
+	volumeSrv, err := NewServer(metadataFile, "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+
+	validator := fakePathValidator{workingDirs: []string{`C:\var\lib\kubelet`, `D:\staging`}}
+	volumeSrv.SetPathValidator(validator)
+
+	stagingPath := `C:\var\lib\kubelet\pv\globalmount`
+	request := &internal.PrepareStagingPathRequest{StagingPath: stagingPath, Sddl: sddl}
+	response, err := volumeSrv.PrepareStagingPath(context.TODO(), request, v2alpha1)
+	if err != nil {
+		t.Fatalf("PrepareStagingPath failed: %v", err)
+	}
+	if !response.Created || volAPI.dirsSDDL != sddl {
+		t.Errorf("Expected the staging path to be created with %q, got created=%t with %q", sddl, response.Created, volAPI.dirsSDDL)
+	}
+	// the staging path was prepared, the call can be retried
+	response, err = volumeSrv.PrepareStagingPath(context.TODO(), request, v2alpha1)
+	if err != nil {
+		t.Fatalf("PrepareStagingPath failed: %v", err)
+	}
+	if response.Created {
+		t.Errorf("Expected the existing staging path not to be created again")
+	}
+	// the security descriptor of a directory that exists isn't changed
+	existing := `C:\var\lib\kubelet\pv\existing`
+	volAPI.CreateDirectories(existing, "")
+	request = &internal.PrepareStagingPathRequest{StagingPath: existing, Sddl: sddl}
+	if _, err := volumeSrv.PrepareStagingPath(context.TODO(), request, v2alpha1); err == nil {
+		t.Errorf("Expected PrepareStagingPath of the existing directory %s with a security descriptor to fail", existing)
+	}
+	if volumeSrv.mounts.IsStagingPath(existing) {
+		t.Errorf("Expected %s not to be a staging path", existing)
+	}
+
+	// the staging paths survive restarts
+	volumeSrv, err = NewServer(metadataFile, "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	volumeSrv.SetPathValidator(validator)
+	if !volumeSrv.mounts.IsStagingPath(stagingPath) {
+		t.Errorf("Expected %s to be a staging path after a restart", stagingPath)
+	}
+	mountRequest := &internal.MountVolumeRequest{VolumeId: "volumeID1", TargetPath: stagingPath}
+	if _, err := volumeSrv.MountVolume(context.TODO(), mountRequest, v2alpha1); err != nil {
+		t.Fatalf("MountVolume failed: %v", err)
+	}
+
+	for _, path := range []string{"", `kubelet\pv`, `C:\var\..\pv`, stagingPath + `\nested`, `D:\staging`, `C:\Windows`} {
+		request := &internal.PrepareStagingPathRequest{StagingPath: path}
+		if _, err := volumeSrv.PrepareStagingPath(context.TODO(), request, v2alpha1); err == nil {
+			t.Errorf("Expected PrepareStagingPath of %q to fail", path)
+		}
+	}
+	if volAPI.dirs[`D:\staging`] {
+		t.Errorf("Expected the staging path on another volume to be removed")
+	}
+
+	unmountRequest := &internal.UnmountVolumeRequest{VolumeId: "volumeID1", TargetPath: stagingPath}
+	if _, err := volumeSrv.UnmountVolume(context.TODO(), unmountRequest, v2alpha1); err != nil {
+		t.Fatalf("UnmountVolume failed: %v", err)
+	}
+	if volumeSrv.mounts.IsStagingPath(stagingPath) {
+		t.Errorf("Expected %s not to be a staging path after the volume is unstaged", stagingPath)
+	}
+}
+
+func TestBoundedDirectory(t *testing.T) {
+	v2alpha1, err := apiversion.NewVersion("v2alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	volAPI := &fakeVolumeAPI{}
+	volumeSrv, err := NewServer(filepath.Join(t.TempDir(), "mounts.json"), "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	volumeSrv.SetPathValidator(fakePathValidator{workingDirs: []string{`C:\var\lib\kubelet`}})
+
+	path := `C:\var\lib\kubelet\pods\uid\volumes\kubernetes.io~empty-dir\cache`
+	request := &internal.CreateBoundedDirectoryRequest{Path: path, SizeBytes: 100*1024*1024 + 1}
+	response, err := volumeSrv.CreateBoundedDirectory(context.TODO(), request, v2alpha1)
+	if err != nil {
+		t.Fatalf("CreateBoundedDirectory failed: %v", err)
+	}
+	if len(volAPI.boundedVolumes) != 1 || !volAPI.dirs[path] {
+		t.Fatalf("Expected a bounded volume and the directory %s, got %v and %v", path, volAPI.boundedVolumes, volAPI.dirs)
+	}
+	for vhdPath, size := range volAPI.boundedVolumes {
+		if size != 101*1024*1024 {
+			t.Errorf("Expected the size of %s to be rounded up to 101MiB, got %d", vhdPath, size)
+		}
+	}
+	if volumeID, targetPath := volumeSrv.mounts.MountedVolumeAt(path); volumeID != response.VolumeId || targetPath != path {
+		t.Errorf("Expected volume %s to be mounted at %s, got %s at %s", response.VolumeId, path, volumeID, targetPath)
+	}
+	// the bounded directory is created again with the same volume, e.g. after a restart
+	again, err := volumeSrv.CreateBoundedDirectory(context.TODO(), request, v2alpha1)
+	if err != nil {
+		t.Fatalf("CreateBoundedDirectory failed: %v", err)
+	}
+	if again.VolumeId != response.VolumeId || len(volAPI.boundedVolumes) != 1 {
+		t.Errorf("Expected the bounded volume %s to be reused, got %s and %v", response.VolumeId, again.VolumeId, volAPI.boundedVolumes)
+	}
+
+	invalid := []*internal.CreateBoundedDirectoryRequest{
+		{Path: `kubelet\cache`, SizeBytes: minBoundedDirectorySize},
+		{Path: `C:\cache`, SizeBytes: 1024},
+		{Path: path + `\nested`, SizeBytes: minBoundedDirectorySize},
+		{Path: `C:\Windows\cache`, SizeBytes: minBoundedDirectorySize},
+	}
+	for _, request := range invalid {
+		if _, err := volumeSrv.CreateBoundedDirectory(context.TODO(), request, v2alpha1); err == nil {
+			t.Errorf("Expected CreateBoundedDirectory of %+v to fail", request)
+		}
+	}
+	if volAPI.dirs[`C:\Windows\cache`] || len(volAPI.boundedVolumes) != 1 {
+		t.Errorf("Expected nothing to be created outside of the working directories, got %v and %v", volAPI.dirs, volAPI.boundedVolumes)
+	}
+
+	removeRequest := &internal.RemoveBoundedDirectoryRequest{Path: path}
+	if _, err := volumeSrv.RemoveBoundedDirectory(context.TODO(), removeRequest, v2alpha1); err != nil {
+		t.Fatalf("RemoveBoundedDirectory failed: %v", err)
+	}
+	if volumeID, _ := volumeSrv.mounts.MountedVolumeAt(path); volumeID != "" || len(volAPI.boundedVolumes) != 0 {
+		t.Errorf("Expected the bounded volume to be unmounted and deleted, got %s mounted and %v", volumeID, volAPI.boundedVolumes)
+	}
+	if _, err := volumeSrv.RemoveBoundedDirectory(context.TODO(), removeRequest, v2alpha1); err != nil {
+		t.Errorf("Expected RemoveBoundedDirectory of a removed directory to succeed, got %v", err)
+	}
+
+	// the VHDX are stored next to the mount metadata
+	volumeSrv, err = NewServer("", "", shared.DiskPolicy{}, volAPI)
+	if err != nil {
+		t.Fatalf("Volume server could not be initialized: %v", err)
+	}
+	if _, err := volumeSrv.CreateBoundedDirectory(context.TODO(), request, v2alpha1); err == nil {
+		t.Errorf("Expected CreateBoundedDirectory to fail without a mount metadata file")
 	}
 }
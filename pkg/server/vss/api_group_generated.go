@@ -0,0 +1,27 @@
+// Code generated by csi-proxy-api-gen. DO NOT EDIT.
+
+package vss
+
+import (
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/vss/impl"
+	"github.com/kubernetes-csi/csi-proxy/pkg/server/vss/impl/v1alpha1"
+)
+
+const name = "vss"
+
+// ensure the server defines all the required methods
+var _ impl.ServerInterface = &Server{}
+
+func (s *Server) VersionedAPIs() []*srvtypes.VersionedAPI {
+	v1alpha1Server := v1alpha1.NewVersionedServer(s)
+
+	return []*srvtypes.VersionedAPI{
+		{
+			Group:      name,
+			Version:    apiversion.NewVersionOrPanic("v1alpha1"),
+			Registrant: v1alpha1Server.Register,
+		},
+	}
+}
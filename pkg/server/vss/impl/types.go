@@ -0,0 +1,43 @@
+package impl
+
+type ShadowCopy struct {
+	Id           string
+	VolumeId     string
+	DeviceObject string
+	// Creation time in seconds since the Unix epoch
+	CreationTime int64
+}
+
+type CreateShadowCopyRequest struct {
+	VolumeId string
+}
+
+type CreateShadowCopyResponse struct {
+	ShadowCopy *ShadowCopy
+}
+
+type ListShadowCopiesRequest struct {
+	VolumeId string
+}
+
+type ListShadowCopiesResponse struct {
+	ShadowCopies []*ShadowCopy
+}
+
+type DeleteShadowCopyRequest struct {
+	ShadowCopyId string
+}
+
+type DeleteShadowCopyResponse struct {
+	// Intentionally empty.
+}
+
+type RevertToShadowCopyRequest struct {
+	VolumeId      string
+	ShadowCopyId  string
+	ForceDismount bool
+}
+
+type RevertToShadowCopyResponse struct {
+	// Intentionally empty.
+}
@@ -0,0 +1,40 @@
+package v1alpha1
+
+import (
+	"github.com/kubernetes-csi/csi-proxy/client/api/vss/v1alpha1"
+	impl "github.com/kubernetes-csi/csi-proxy/pkg/server/vss/impl"
+)
+
+// Add manual conversion functions here to override automatic conversion functions
+
+func Convert_impl_ListShadowCopiesResponse_To_v1alpha1_ListShadowCopiesResponse(in *impl.ListShadowCopiesResponse, out *v1alpha1.ListShadowCopiesResponse) error {
+	if in.ShadowCopies != nil {
+		in, out := &in.ShadowCopies, &out.ShadowCopies
+		*out = make([]*v1alpha1.ShadowCopy, len(*in))
+		for i := range *in {
+			(*out)[i] = new(v1alpha1.ShadowCopy)
+			if err := Convert_impl_ShadowCopy_To_v1alpha1_ShadowCopy(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ShadowCopies = nil
+	}
+	return nil
+}
+
+func Convert_v1alpha1_ListShadowCopiesResponse_To_impl_ListShadowCopiesResponse(in *v1alpha1.ListShadowCopiesResponse, out *impl.ListShadowCopiesResponse) error {
+	if in.ShadowCopies != nil {
+		in, out := &in.ShadowCopies, &out.ShadowCopies
+		*out = make([]*impl.ShadowCopy, len(*in))
+		for i := range *in {
+			(*out)[i] = new(impl.ShadowCopy)
+			if err := Convert_v1alpha1_ShadowCopy_To_impl_ShadowCopy(*&(*in)[i], *&(*out)[i]); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ShadowCopies = nil
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package vss
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/vss"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/vss/impl"
+	"k8s.io/klog/v2"
+)
+
+type Server struct {
+	hostAPI vss.API
+}
+
+// check that Server implements the ServerInterface
+var _ internal.ServerInterface = &Server{}
+
+// shadowCopyIDRegexp matches the IDs of shadow copies e.g. {d5c8a3e6-3b8b-4d0f-9a2e-6b1c1f0a7e21}
+var shadowCopyIDRegexp = regexp.MustCompile(`^\{[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}\}$`)
+
+func NewServer(hostAPI vss.API) (*Server, error) {
+	return &Server{
+		hostAPI: hostAPI,
+	}, nil
+}
+
+// WarmUp lists the shadow copies of no volume, it loads the VSS WMI provider.
+func (s *Server) WarmUp() error {
+	_, err := s.hostAPI.ListShadowCopies("")
+	return err
+}
+
+func toInternalShadowCopy(shadowCopy *vss.ShadowCopy) *internal.ShadowCopy {
+	return &internal.ShadowCopy{
+		Id:           shadowCopy.ID,
+		VolumeId:     shadowCopy.VolumeID,
+		DeviceObject: shadowCopy.DeviceObject,
+		CreationTime: shadowCopy.CreationTime.Unix(),
+	}
+}
+
+func (s *Server) CreateShadowCopy(context context.Context, request *internal.CreateShadowCopyRequest, version apiversion.Version) (*internal.CreateShadowCopyResponse, error) {
+	klog.V(2).Infof("CreateShadowCopy: Request: %+v", request)
+	response := &internal.CreateShadowCopyResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	shadowCopy, err := s.hostAPI.CreateShadowCopy(volumeID)
+	if err != nil {
+		klog.Errorf("failed CreateShadowCopy %v", err)
+		return response, err
+	}
+	klog.V(2).Infof("CreateShadowCopy: created shadow copy %s of volume %s", shadowCopy.ID, volumeID)
+	response.ShadowCopy = toInternalShadowCopy(shadowCopy)
+	return response, nil
+}
+
+func (s *Server) ListShadowCopies(context context.Context, request *internal.ListShadowCopiesRequest, version apiversion.Version) (*internal.ListShadowCopiesResponse, error) {
+	klog.V(2).Infof("ListShadowCopies: Request: %+v", request)
+	response := &internal.ListShadowCopiesResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+
+	shadowCopies, err := s.hostAPI.ListShadowCopies(volumeID)
+	if err != nil {
+		klog.Errorf("failed ListShadowCopies %v", err)
+		return response, err
+	}
+	response.ShadowCopies = []*internal.ShadowCopy{}
+	for i := range shadowCopies {
+		response.ShadowCopies = append(response.ShadowCopies, toInternalShadowCopy(&shadowCopies[i]))
+	}
+	return response, nil
+}
+
+func (s *Server) DeleteShadowCopy(context context.Context, request *internal.DeleteShadowCopyRequest, version apiversion.Version) (*internal.DeleteShadowCopyResponse, error) {
+	klog.V(2).Infof("DeleteShadowCopy: Request: %+v", request)
+	response := &internal.DeleteShadowCopyResponse{}
+
+	shadowCopyID := request.ShadowCopyId
+	if !shadowCopyIDRegexp.MatchString(shadowCopyID) {
+		klog.Errorf("invalid shadow copy id %q", shadowCopyID)
+		return response, fmt.Errorf("invalid shadow copy id %q", shadowCopyID)
+	}
+
+	if err := s.hostAPI.DeleteShadowCopy(shadowCopyID); err != nil {
+		klog.Errorf("failed DeleteShadowCopy %v", err)
+		return response, err
+	}
+	return response, nil
+}
+
+func (s *Server) RevertToShadowCopy(context context.Context, request *internal.RevertToShadowCopyRequest, version apiversion.Version) (*internal.RevertToShadowCopyResponse, error) {
+	klog.V(2).Infof("RevertToShadowCopy: Request: %+v", request)
+	response := &internal.RevertToShadowCopyResponse{}
+
+	volumeID := request.VolumeId
+	if volumeID == "" {
+		klog.Errorf("volume id empty")
+		return response, fmt.Errorf("volume id empty")
+	}
+	shadowCopyID := request.ShadowCopyId
+	if !shadowCopyIDRegexp.MatchString(shadowCopyID) {
+		klog.Errorf("invalid shadow copy id %q", shadowCopyID)
+		return response, fmt.Errorf("invalid shadow copy id %q", shadowCopyID)
+	}
+
+	// the shadow copy must be a shadow copy of the volume so that a wrong ID doesn't revert
+	// another volume
+	shadowCopies, err := s.hostAPI.ListShadowCopies(volumeID)
+	if err != nil {
+		klog.Errorf("failed RevertToShadowCopy %v", err)
+		return response, err
+	}
+	found := false
+	for _, shadowCopy := range shadowCopies {
+		if strings.EqualFold(shadowCopy.ID, shadowCopyID) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		klog.Errorf("shadow copy %s of volume %s not found", shadowCopyID, volumeID)
+		return response, fmt.Errorf("shadow copy %s of volume %s not found", shadowCopyID, volumeID)
+	}
+
+	if err := s.hostAPI.RevertToShadowCopy(shadowCopyID, request.ForceDismount); err != nil {
+		klog.Errorf("failed RevertToShadowCopy %v", err)
+		return response, err
+	}
+	klog.V(2).Infof("RevertToShadowCopy: reverted volume %s to shadow copy %s", volumeID, shadowCopyID)
+	return response, nil
+}
@@ -0,0 +1,156 @@
+package vss
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	"github.com/kubernetes-csi/csi-proxy/pkg/os/vss"
+	internal "github.com/kubernetes-csi/csi-proxy/pkg/server/vss/impl"
+)
+
+const testShadowCopyID = "{d5c8a3e6-3b8b-4d0f-9a2e-6b1c1f0a7e21}"
+
+type fakeVssAPI struct {
+	shadowCopies []vss.ShadowCopy
+	deleted      []string
+	// reverted is the shadow copy of the last revert
+	reverted string
+}
+
+var _ vss.API = &fakeVssAPI{}
+
+func (vssAPI *fakeVssAPI) CreateShadowCopy(volumeID string) (*vss.ShadowCopy, error) {
+	shadowCopy := vss.ShadowCopy{
+		ID:           testShadowCopyID,
+		VolumeID:     volumeID,
+		DeviceObject: `\\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy1`,
+		CreationTime: time.Unix(1634295807, 0),
+	}
+	vssAPI.shadowCopies = append(vssAPI.shadowCopies, shadowCopy)
+	return &shadowCopy, nil
+}
+
+func (vssAPI *fakeVssAPI) ListShadowCopies(volumeID string) ([]vss.ShadowCopy, error) {
+	shadowCopies := []vss.ShadowCopy{}
+	for _, shadowCopy := range vssAPI.shadowCopies {
+		if shadowCopy.VolumeID == volumeID {
+			shadowCopies = append(shadowCopies, shadowCopy)
+		}
+	}
+	return shadowCopies, nil
+}
+
+func (vssAPI *fakeVssAPI) DeleteShadowCopy(shadowCopyID string) error {
+	vssAPI.deleted = append(vssAPI.deleted, shadowCopyID)
+	return nil
+}
+
+func (vssAPI *fakeVssAPI) RevertToShadowCopy(shadowCopyID string, forceDismount bool) error {
+	vssAPI.reverted = shadowCopyID
+	return nil
+}
+
+func TestShadowCopies(t *testing.T) {
+	v1alpha1, err := apiversion.NewVersion("v1alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	vssAPI := &fakeVssAPI{}
+	srv, err := NewServer(vssAPI)
+	if err != nil {
+		t.Fatalf("VSS server could not be initialized: %v", err)
+	}
+
+	createResponse, err := srv.CreateShadowCopy(context.TODO(), &internal.CreateShadowCopyRequest{VolumeId: "volumeID1"}, v1alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	expected := &internal.ShadowCopy{
+		Id:           testShadowCopyID,
+		VolumeId:     "volumeID1",
+		DeviceObject: `\\?\GLOBALROOT\Device\HarddiskVolumeShadowCopy1`,
+		CreationTime: 1634295807,
+	}
+	if *createResponse.ShadowCopy != *expected {
+		t.Errorf("Expected shadow copy %+v, got %+v", expected, createResponse.ShadowCopy)
+	}
+
+	listResponse, err := srv.ListShadowCopies(context.TODO(), &internal.ListShadowCopiesRequest{VolumeId: "volumeID2"}, v1alpha1)
+	if err != nil {
+		t.Fatalf("Error %v not expected", err)
+	}
+	if listResponse.ShadowCopies == nil || len(listResponse.ShadowCopies) != 0 {
+		t.Errorf("Expected an empty list of shadow copies, got %v", listResponse.ShadowCopies)
+	}
+
+	testCases := []struct {
+		shadowCopyID    string
+		isErrorExpected bool
+	}{
+		{shadowCopyID: testShadowCopyID},
+		{shadowCopyID: "", isErrorExpected: true},
+		{shadowCopyID: "d5c8a3e6-3b8b-4d0f-9a2e-6b1c1f0a7e21", isErrorExpected: true},
+		{shadowCopyID: "{d5c8a3e6-3b8b-4d0f-9a2e-6b1c1f0a7e21}' | Remove-Item", isErrorExpected: true},
+	}
+	for _, tc := range testCases {
+		vssAPI.deleted = nil
+		_, err := srv.DeleteShadowCopy(context.TODO(), &internal.DeleteShadowCopyRequest{ShadowCopyId: tc.shadowCopyID}, v1alpha1)
+		if tc.isErrorExpected {
+			if err == nil || len(vssAPI.deleted) != 0 {
+				t.Errorf("Expected error for shadow copy id %q", tc.shadowCopyID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Error %v not expected", err)
+		}
+	}
+}
+
+func TestRevertToShadowCopy(t *testing.T) {
+	v1alpha1, err := apiversion.NewVersion("v1alpha1")
+	if err != nil {
+		t.Fatalf("New version error: %v", err)
+	}
+	vssAPI := &fakeVssAPI{
+		shadowCopies: []vss.ShadowCopy{{ID: testShadowCopyID, VolumeID: "volumeID1"}},
+	}
+	srv, err := NewServer(vssAPI)
+	if err != nil {
+		t.Fatalf("VSS server could not be initialized: %v", err)
+	}
+
+	testCases := []struct {
+		volumeID        string
+		shadowCopyID    string
+		isErrorExpected bool
+	}{
+		{volumeID: "volumeID1", shadowCopyID: testShadowCopyID},
+		{volumeID: "volumeID1", shadowCopyID: strings.ToUpper(testShadowCopyID)},
+		{volumeID: "", shadowCopyID: testShadowCopyID, isErrorExpected: true},
+		{volumeID: "volumeID1", shadowCopyID: "", isErrorExpected: true},
+		// the shadow copy of another volume
+		{volumeID: "volumeID2", shadowCopyID: testShadowCopyID, isErrorExpected: true},
+		{volumeID: "volumeID1", shadowCopyID: "{00000000-0000-0000-0000-000000000000}", isErrorExpected: true},
+	}
+	for _, tc := range testCases {
+		vssAPI.reverted = ""
+		request := &internal.RevertToShadowCopyRequest{VolumeId: tc.volumeID, ShadowCopyId: tc.shadowCopyID}
+		_, err := srv.RevertToShadowCopy(context.TODO(), request, v1alpha1)
+		if tc.isErrorExpected {
+			if err == nil || vssAPI.reverted != "" {
+				t.Errorf("Expected error reverting volume %q to shadow copy %q", tc.volumeID, tc.shadowCopyID)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Error %v not expected", err)
+		}
+		if vssAPI.reverted != tc.shadowCopyID {
+			t.Errorf("Expected volume %s to be reverted to shadow copy %s, got %q", tc.volumeID, tc.shadowCopyID, vssAPI.reverted)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package server
+
+import (
+	"expvar"
+	"sync"
+	"time"
+
+	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
+	"k8s.io/klog/v2"
+)
+
+var (
+	// startupMetrics are the durations of the warm-up of the API groups in
+	// milliseconds and `ready`, published as `startup` in the metrics server.
+	startupMetrics = expvar.NewMap("startup")
+	// ready is set to 1 once the named pipes are created.
+	ready = new(expvar.Int)
+)
+
+func init() {
+	startupMetrics.Set("ready", ready)
+}
+
+// WarmUp runs the warm-up of the API groups that implement srvtypes.WarmUpper
+// concurrently, it returns once they're done or after `timeout`. Failures are
+// only logged, the operations that depend on the failed queries fail the same
+// way later.
+func WarmUp(timeout time.Duration, apiGroups ...srvtypes.APIGroup) {
+	start := time.Now()
+	var wg sync.WaitGroup
+	for _, apiGroup := range apiGroups {
+		warmUpper, ok := apiGroup.(srvtypes.WarmUpper)
+		if !ok {
+			continue
+		}
+		name := apiGroupName(apiGroup)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			groupStart := time.Now()
+			if err := warmUpper.WarmUp(); err != nil {
+				klog.Warningf("Warm-up of the %s API group failed: %v", name, err)
+			}
+			duration := time.Since(groupStart)
+			startupMetrics.Add("warm_up_ms_"+name, duration.Milliseconds())
+			klog.V(2).Infof("Warm-up of the %s API group took %v", name, duration)
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		klog.Infof("Warm-up completed in %v", time.Since(start))
+	case <-time.After(timeout):
+		klog.Warningf("Warm-up didn't complete in %v, starting anyway", timeout)
+	}
+}
+
+// apiGroupName returns the name of the API group `apiGroup` e.g. volume.
+func apiGroupName(apiGroup srvtypes.APIGroup) string {
+	for _, versionedAPI := range apiGroup.VersionedAPIs() {
+		return versionedAPI.Group
+	}
+	return "unknown"
+}
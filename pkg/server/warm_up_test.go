@@ -0,0 +1,54 @@
+package server
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/client/apiversion"
+	srvtypes "github.com/kubernetes-csi/csi-proxy/pkg/server/types"
+)
+
+type fakeAPIGroup struct {
+	name string
+}
+
+func (g *fakeAPIGroup) VersionedAPIs() []*srvtypes.VersionedAPI {
+	return []*srvtypes.VersionedAPI{{Group: g.name, Version: apiversion.NewVersionOrPanic("v1")}}
+}
+
+// fakeWarmUpper is an API group whose warm-up blocks until `unblock` is closed.
+type fakeWarmUpper struct {
+	fakeAPIGroup
+	unblock chan struct{}
+	err     error
+	calls   int32
+}
+
+func (g *fakeWarmUpper) WarmUp() error {
+	atomic.AddInt32(&g.calls, 1)
+	if g.unblock != nil {
+		<-g.unblock
+	}
+	return g.err
+}
+
+func TestWarmUp(t *testing.T) {
+	failing := &fakeWarmUpper{fakeAPIGroup: fakeAPIGroup{name: "disk"}, err: errors.New("no Storage module")}
+	working := &fakeWarmUpper{fakeAPIGroup: fakeAPIGroup{name: "volume"}}
+	WarmUp(time.Minute, &fakeAPIGroup{name: "filesystem"}, failing, working)
+	if failing.calls != 1 || working.calls != 1 {
+		t.Errorf("Expected 1 warm-up of each group, got %d and %d", failing.calls, working.calls)
+	}
+
+	// a warm-up that hangs doesn't delay the startup past the timeout
+	unblock := make(chan struct{})
+	defer close(unblock)
+	hanging := &fakeWarmUpper{fakeAPIGroup: fakeAPIGroup{name: "iscsi"}, unblock: unblock}
+	start := time.Now()
+	WarmUp(50*time.Millisecond, hanging)
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("Expected the warm-up to time out, it took %v", elapsed)
+	}
+}
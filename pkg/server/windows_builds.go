@@ -0,0 +1,19 @@
+package server
+
+import (
+	"context"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/utils"
+	"google.golang.org/grpc"
+)
+
+// rejectUnsupportedMethods fails the methods that require a feature of Windows
+// that the node doesn't have according to utils.OperationFeatures before they
+// run, with an error that names the feature and the Windows release required
+// instead of the error of a missing cmdlet.
+func rejectUnsupportedMethods(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := utils.CheckOperation(serviceMethod(info.FullMethod)); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
@@ -0,0 +1,55 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiskPolicy restricts the disks that csi-proxy initializes, partitions and
+// formats, it's a safety net against wiping a disk that isn't managed by a CSI
+// driver e.g. the OS disk. The zero value allows every disk.
+type DiskPolicy struct {
+	// AllowedBusTypes are the bus types (e.g. SAS, iSCSI) of the disks that can
+	// be written, all the bus types are allowed if it's empty.
+	AllowedBusTypes []string
+
+	// MinSizeBytes and MaxSizeBytes are the range of sizes of the disks that
+	// can be written, 0 means no limit.
+	MinSizeBytes int64
+	MaxSizeBytes int64
+
+	// ProtectOSDisks rejects the disks of the boot and system partitions.
+	ProtectOSDisks bool
+}
+
+// Restricted returns true if the policy rejects some disks.
+func (p DiskPolicy) Restricted() bool {
+	return len(p.AllowedBusTypes) > 0 || p.MinSizeBytes > 0 || p.MaxSizeBytes > 0 || p.ProtectOSDisks
+}
+
+// Check returns an error describing why the policy rejects the disk `d`, nil if
+// the disk is allowed.
+func (p DiskPolicy) Check(d DiskInfo) error {
+	if p.ProtectOSDisks && d.IsOSDisk {
+		return fmt.Errorf("disk %d is an OS disk", d.Number)
+	}
+	if len(p.AllowedBusTypes) > 0 {
+		allowed := false
+		for _, busType := range p.AllowedBusTypes {
+			if strings.EqualFold(busType, d.BusType) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("bus type %s of disk %d isn't one of %s", d.BusType, d.Number, strings.Join(p.AllowedBusTypes, ","))
+		}
+	}
+	if p.MinSizeBytes > 0 && d.Size < p.MinSizeBytes {
+		return fmt.Errorf("disk %d is smaller than %d bytes", d.Number, p.MinSizeBytes)
+	}
+	if p.MaxSizeBytes > 0 && d.Size > p.MaxSizeBytes {
+		return fmt.Errorf("disk %d is larger than %d bytes", d.Number, p.MaxSizeBytes)
+	}
+	return nil
+}
@@ -9,6 +9,9 @@ type DiskLocation struct {
 	Bus     string
 	Target  string
 	LUNID   string
+	// SerialNumber is the serial number of the disk, it's empty for some
+	// virtual disks
+	SerialNumber string
 }
 
 // DiskIDs definition
@@ -16,3 +19,60 @@ type DiskIDs struct {
 	Page83       string
 	SerialNumber string
 }
+
+// Page83ID is an identifier of the device identification VPD page (0x83) of a
+// disk.
+type Page83ID struct {
+	// Type of the identifier e.g. NAA, EUI64 or T10
+	Type string
+	// Association of the identifier: Device (the logical unit), Port or Target
+	Association string
+	// Value of the identifier, the binary identifiers are hex encoded
+	Value string
+}
+
+// DiskInfo definition
+type DiskInfo struct {
+	Number         uint32
+	BusType        string
+	Size           int64
+	PartitionStyle string
+	// IsOSDisk is set for the disks of the boot and system partitions
+	IsOSDisk bool
+	// FriendlyName is the model of the disk e.g. Msft Virtual Disk
+	FriendlyName string
+	// SerialNumber is empty for some virtual disks
+	SerialNumber string
+	IsOffline    bool
+	IsReadOnly   bool
+	// Signature is the signature of an MBR disk, GUID the GUID of a GPT disk,
+	// they're empty for the other partition styles
+	Signature uint32
+	GUID      string
+}
+
+// CounterNotReported is the value of the reliability counters that the disk doesn't report.
+const CounterNotReported = -1
+
+// ReliabilityCounters are the reliability (SMART) counters of a disk, each of them is
+// CounterNotReported if the disk doesn't report it.
+type ReliabilityCounters struct {
+	// TemperatureCelsius is the current temperature, MaxTemperatureCelsius the highest one
+	// the disk can operate at
+	TemperatureCelsius    int64
+	MaxTemperatureCelsius int64
+	// WearPercentage is the percentage of the rated endurance of an SSD used
+	WearPercentage         int64
+	ReadErrorsTotal        int64
+	ReadErrorsCorrected    int64
+	ReadErrorsUncorrected  int64
+	WriteErrorsTotal       int64
+	WriteErrorsCorrected   int64
+	WriteErrorsUncorrected int64
+	PowerOnHours           int64
+	// ReadLatencyMaxMs, WriteLatencyMaxMs and FlushLatencyMaxMs are the highest latencies
+	// in milliseconds
+	ReadLatencyMaxMs  int64
+	WriteLatencyMaxMs int64
+	FlushLatencyMaxMs int64
+}
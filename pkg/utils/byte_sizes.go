@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"fmt"
+	"math"
+)
+
+// InvalidByteSizeError is returned for the sizes in bytes that are negative or
+// that don't fit in the int64 sizes used by Windows.
+type InvalidByteSizeError struct {
+	Field string
+	Value string
+}
+
+func (e *InvalidByteSizeError) Error() string {
+	return fmt.Sprintf("invalid %s %s, it must be between 0 and %d bytes", e.Field, e.Value, int64(math.MaxInt64))
+}
+
+// ErrorCode returns the stable code of the error.
+func (e *InvalidByteSizeError) ErrorCode() string {
+	return ErrorCodeInvalidParameter
+}
+
+// ValidateByteSize returns an InvalidByteSizeError if the size `size` of the
+// field `field` is negative.
+func ValidateByteSize(field string, size int64) error {
+	if size < 0 {
+		return &InvalidByteSizeError{Field: field, Value: fmt.Sprint(size)}
+	}
+	return nil
+}
+
+// ByteSizeToInt64 converts the unsigned size `size` of the field `field` of an
+// API to the int64 sizes used by Windows, sizes above math.MaxInt64 (e.g.
+// sentinels like math.MaxUint64) return an InvalidByteSizeError instead of
+// wrapping around to negative sizes.
+func ByteSizeToInt64(field string, size uint64) (int64, error) {
+	if size > math.MaxInt64 {
+		return 0, &InvalidByteSizeError{Field: field, Value: fmt.Sprint(size)}
+	}
+	return int64(size), nil
+}
+
+// ByteSizeFromInt64 converts the int64 size `size` of the field `field` to the
+// unsigned size of an API, negative sizes return an InvalidByteSizeError.
+func ByteSizeFromInt64(field string, size int64) (uint64, error) {
+	if err := ValidateByteSize(field, size); err != nil {
+		return 0, err
+	}
+	return uint64(size), nil
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestByteSizeConversions(t *testing.T) {
+	if size, err := ByteSizeToInt64("SizeBytes", 1<<40); err != nil || size != 1<<40 {
+		t.Errorf("expected 1TiB, got %d, %v", size, err)
+	}
+	if size, err := ByteSizeToInt64("SizeBytes", math.MaxInt64); err != nil || size != math.MaxInt64 {
+		t.Errorf("expected math.MaxInt64, got %d, %v", size, err)
+	}
+	if size, err := ByteSizeFromInt64("SizeBytes", 0); err != nil || size != 0 {
+		t.Errorf("expected 0, got %d, %v", size, err)
+	}
+
+	for _, err := range []error{
+		func() error { _, err := ByteSizeToInt64("SizeBytes", math.MaxInt64+1); return err }(),
+		func() error { _, err := ByteSizeToInt64("SizeBytes", math.MaxUint64); return err }(),
+		func() error { _, err := ByteSizeFromInt64("SizeBytes", -1); return err }(),
+		ValidateByteSize("SizeBytes", math.MinInt64),
+	} {
+		var invalid *InvalidByteSizeError
+		if !errors.As(err, &invalid) || invalid.ErrorCode() != ErrorCodeInvalidParameter {
+			t.Errorf("expected an InvalidByteSizeError, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"k8s.io/klog/v2"
+)
+
+// PowerShell modules of the cmdlets run by the OS APIs, minimal SKUs of Windows
+// like Server Core and Nano Server don't have all of them.
+const (
+	ModuleStorage    = "Storage"
+	ModuleSmbShare   = "SmbShare"
+	ModuleISCSI      = "iSCSI"
+	ModuleBitLocker  = "BitLocker"
+	ModuleCimCmdlets = "CimCmdlets"
+	// ModuleDeduplication is installed with the Data Deduplication feature of Windows Server
+	ModuleDeduplication = "Deduplication"
+)
+
+// PowerShellModules are the PowerShell modules looked up by DetectCapabilities.
+var PowerShellModules = []string{ModuleStorage, ModuleSmbShare, ModuleISCSI, ModuleBitLocker, ModuleCimCmdlets, ModuleDeduplication}
+
+// Capabilities are the features of the host the OS APIs depend on.
+type Capabilities struct {
+	// InstallationType is the installation type of Windows e.g. Server, Server Core
+	// or Nano Server, it's empty if it couldn't be read.
+	InstallationType string
+	// Build is the build number of Windows e.g. 20348 for Windows Server 2022, it's
+	// 0 if it couldn't be read.
+	Build uint32
+	// PowerShell is false if powershell.exe isn't available or if it's disabled
+	// in strict mode.
+	PowerShell bool
+	// Modules are the available modules of PowerShellModules.
+	Modules []string
+}
+
+var (
+	capabilitiesLock sync.RWMutex
+	// capabilities are the detected capabilities, nil until SetCapabilities is
+	// called in which case every module is assumed to be available.
+	capabilities *Capabilities
+)
+
+// DetectCapabilities detects the capabilities of the host, the PowerShell modules
+// are detected with a single PowerShell call. All the modules are assumed to be
+// available if they can't be listed.
+func DetectCapabilities() Capabilities {
+	c := Capabilities{InstallationType: installationType(), Build: windowsBuild()}
+	if PowerShellDisabled() {
+		return c
+	}
+	if _, err := exec.LookPath("powershell"); err != nil {
+		klog.Warningf("PowerShell isn't available: %v", err)
+		return c
+	}
+	c.PowerShell = true
+
+	cmd := fmt.Sprintf("ConvertTo-Json @(Get-Module -ListAvailable -Name %s | Select-Object -ExpandProperty Name -Unique)",
+		strings.Join(PowerShellModules, ","))
+	out, err := RunPowershellCmd(cmd)
+	if err != nil {
+		klog.Warningf("error listing the PowerShell modules, assuming they're all available. cmd: %s, output: %s, error: %v", cmd, string(out), err)
+		c.Modules = append([]string{}, PowerShellModules...)
+		return c
+	}
+	if err := json.Unmarshal(out, &c.Modules); err != nil {
+		klog.Warningf("error parsing the PowerShell modules %s, assuming they're all available: %v", string(out), err)
+		c.Modules = append([]string{}, PowerShellModules...)
+	}
+	return c
+}
+
+// SetCapabilities sets the capabilities of the host used to choose how the OS
+// APIs run operations, it's called once at startup.
+func SetCapabilities(c Capabilities) {
+	capabilitiesLock.Lock()
+	defer capabilitiesLock.Unlock()
+	capabilities = &c
+}
+
+// GetCapabilities returns the capabilities set by SetCapabilities, ok is false
+// if they weren't set.
+func GetCapabilities() (c Capabilities, ok bool) {
+	capabilitiesLock.RLock()
+	defer capabilitiesLock.RUnlock()
+	if capabilities == nil {
+		return Capabilities{}, false
+	}
+	return *capabilities, true
+}
+
+// HasPowerShellModule returns true if the PowerShell module `name` is available,
+// the OS APIs fall back to CIM queries or system calls for the cmdlets of the
+// modules that aren't. It's true for every module if the capabilities weren't set.
+func HasPowerShellModule(name string) bool {
+	c, ok := GetCapabilities()
+	if !ok {
+		return true
+	}
+	for _, module := range c.Modules {
+		if strings.EqualFold(module, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestHasPowerShellModule(t *testing.T) {
+	defer func() { capabilities = nil }()
+
+	if !HasPowerShellModule(ModuleStorage) {
+		t.Errorf("expected every module to be available before the capabilities are set")
+	}
+
+	SetCapabilities(Capabilities{PowerShell: true, Modules: []string{"storage", ModuleCimCmdlets}})
+	for module, expected := range map[string]bool{
+		ModuleStorage:    true,
+		ModuleCimCmdlets: true,
+		ModuleSmbShare:   false,
+		ModuleBitLocker:  false,
+	} {
+		if available := HasPowerShellModule(module); available != expected {
+			t.Errorf("expected module %s available=%v, got %v", module, expected, available)
+		}
+	}
+}
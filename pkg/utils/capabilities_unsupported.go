@@ -0,0 +1,12 @@
+//go:build !windows
+// +build !windows
+
+package utils
+
+func installationType() string {
+	return ""
+}
+
+func windowsBuild() uint32 {
+	return 0
+}
@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// installationType returns the installation type of Windows e.g. Server Core,
+// it's read from the registry because Nano Server may not have PowerShell.
+func installationType() string {
+	subkey, err := windows.UTF16PtrFromString(`SOFTWARE\Microsoft\Windows NT\CurrentVersion`)
+	if err != nil {
+		return ""
+	}
+	var key windows.Handle
+	if err := windows.RegOpenKeyEx(windows.HKEY_LOCAL_MACHINE, subkey, 0, windows.KEY_READ, &key); err != nil {
+		return ""
+	}
+	defer windows.RegCloseKey(key)
+
+	name, err := windows.UTF16PtrFromString("InstallationType")
+	if err != nil {
+		return ""
+	}
+	buf := make([]uint16, 64)
+	size := uint32(len(buf) * 2)
+	if err := windows.RegQueryValueEx(key, name, nil, nil, (*byte)(unsafe.Pointer(&buf[0])), &size); err != nil {
+		return ""
+	}
+	return windows.UTF16ToString(buf)
+}
+
+// windowsBuild returns the build number of Windows, RtlGetVersion isn't subject
+// to the compatibility shims of GetVersionEx.
+func windowsBuild() uint32 {
+	return windows.RtlGetVersion().BuildNumber
+}
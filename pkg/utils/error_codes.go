@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// Stable codes of common Windows errors, unlike the messages of the errors they
+// don't depend on the display language of Windows.
+const (
+	ErrorCodeNotFound             = "NOT_FOUND"
+	ErrorCodeAccessDenied         = "ACCESS_DENIED"
+	ErrorCodeAlreadyExists        = "ALREADY_EXISTS"
+	ErrorCodeInUse                = "IN_USE"
+	ErrorCodeInvalidParameter     = "INVALID_PARAMETER"
+	ErrorCodeNotSupported         = "NOT_SUPPORTED"
+	ErrorCodeDeviceNotReady       = "DEVICE_NOT_READY"
+	ErrorCodeWriteProtected       = "WRITE_PROTECTED"
+	ErrorCodeDiskFull             = "DISK_FULL"
+	ErrorCodeTimeout              = "TIMEOUT"
+	ErrorCodeNetworkPathNotFound  = "NETWORK_PATH_NOT_FOUND"
+	ErrorCodeAuthenticationFailed = "AUTHENTICATION_FAILED"
+	ErrorCodeCredentialConflict   = "CREDENTIAL_CONFLICT"
+	ErrorCodeMountConflict        = "MOUNT_CONFLICT"
+)
+
+// CodedError is implemented by the errors of csi-proxy that have a stable code
+// of their own i.e. that aren't Windows errors.
+type CodedError interface {
+	error
+	ErrorCode() string
+}
+
+// systemErrorCodes maps Windows system error codes to stable codes.
+var systemErrorCodes = map[uint32]string{
+	1:    ErrorCodeNotSupported,         // ERROR_INVALID_FUNCTION
+	2:    ErrorCodeNotFound,             // ERROR_FILE_NOT_FOUND
+	3:    ErrorCodeNotFound,             // ERROR_PATH_NOT_FOUND
+	5:    ErrorCodeAccessDenied,         // ERROR_ACCESS_DENIED
+	19:   ErrorCodeWriteProtected,       // ERROR_WRITE_PROTECT
+	21:   ErrorCodeDeviceNotReady,       // ERROR_NOT_READY
+	32:   ErrorCodeInUse,                // ERROR_SHARING_VIOLATION
+	33:   ErrorCodeInUse,                // ERROR_LOCK_VIOLATION
+	50:   ErrorCodeNotSupported,         // ERROR_NOT_SUPPORTED
+	53:   ErrorCodeNetworkPathNotFound,  // ERROR_BAD_NETPATH
+	67:   ErrorCodeNetworkPathNotFound,  // ERROR_BAD_NET_NAME
+	80:   ErrorCodeAlreadyExists,        // ERROR_FILE_EXISTS
+	87:   ErrorCodeInvalidParameter,     // ERROR_INVALID_PARAMETER
+	112:  ErrorCodeDiskFull,             // ERROR_DISK_FULL
+	121:  ErrorCodeTimeout,              // ERROR_SEM_TIMEOUT
+	183:  ErrorCodeAlreadyExists,        // ERROR_ALREADY_EXISTS
+	1219: ErrorCodeCredentialConflict,   // ERROR_SESSION_CREDENTIAL_CONFLICT
+	1224: ErrorCodeInUse,                // ERROR_USER_MAPPED_FILE
+	1326: ErrorCodeAuthenticationFailed, // ERROR_LOGON_FAILURE
+	1460: ErrorCodeTimeout,              // ERROR_TIMEOUT
+}
+
+// hresultErrorCodes maps the HRESULTs that don't wrap a system error code to
+// stable codes.
+var hresultErrorCodes = map[uint32]string{
+	0x80041002: ErrorCodeNotFound,     // WBEM_E_NOT_FOUND
+	0x80041003: ErrorCodeAccessDenied, // WBEM_E_ACCESS_DENIED
+}
+
+// storageWMIErrorCodes maps the MSFT_StorageWMI errors of the Storage cmdlets to
+// stable codes.
+var storageWMIErrorCodes = map[uint32]string{
+	1:     ErrorCodeNotSupported,     // Not Supported
+	3:     ErrorCodeTimeout,          // Timeout
+	5:     ErrorCodeInvalidParameter, // Invalid Parameter
+	40001: ErrorCodeAccessDenied,     // Access denied
+}
+
+// storageWMIRegexp matches the error IDs of the Storage cmdlets in their output
+// e.g. "FullyQualifiedErrorId : StorageWMI 40001,Initialize-Disk"
+var storageWMIRegexp = regexp.MustCompile(`StorageWMI (\d+)`)
+
+// cmdletNotFoundErrorID is the error ID of the CIM based cmdlets (e.g. Get-Volume)
+// when the object they look for doesn't exist.
+const cmdletNotFoundErrorID = "CmdletizationQuery_NotFound"
+
+// StableErrorCode returns the stable code of the Windows error of `err` and the
+// Windows error code it was mapped from. Both are empty if the error isn't known,
+// the Windows error code is empty for a CodedError.
+func StableErrorCode(err error) (code string, windowsCode string) {
+	if err == nil {
+		return "", ""
+	}
+	var coded CodedError
+	if errors.As(err, &coded) {
+		return coded.ErrorCode(), ""
+	}
+	var errno syscall.Errno
+	if errors.As(err, &errno) {
+		if code, ok := systemErrorCodes[uint32(errno)]; ok {
+			return code, strconv.FormatUint(uint64(errno), 10)
+		}
+	}
+	msg := err.Error()
+	if hresult := hresultRegexp.FindString(msg); hresult != "" {
+		if code := hresultErrorCode(hresult); code != "" {
+			return code, hresult
+		}
+	}
+	if m := storageWMIRegexp.FindStringSubmatch(msg); m != nil {
+		if id, err := strconv.ParseUint(m[1], 10, 32); err == nil {
+			if code, ok := storageWMIErrorCodes[uint32(id)]; ok {
+				return code, m[0]
+			}
+		}
+	}
+	if strings.Contains(msg, cmdletNotFoundErrorID) {
+		return ErrorCodeNotFound, cmdletNotFoundErrorID
+	}
+	return "", ""
+}
+
+// hresultErrorCode returns the stable code of the HRESULT `hresult` e.g.
+// "0x80070005", HRESULTs of the Win32 facility wrap a system error code.
+func hresultErrorCode(hresult string) string {
+	var hr uint32
+	if _, err := fmt.Sscanf(hresult, "0x%x", &hr); err != nil {
+		return ""
+	}
+	if hr&0xFFFF0000 == 0x80070000 {
+		return systemErrorCodes[hr&0xFFFF]
+	}
+	return hresultErrorCodes[hr]
+}
@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/metrics"
+	"k8s.io/klog/v2"
+)
+
+// ErrPowerShellDisabled is returned instead of running PowerShell in strict mode.
+var ErrPowerShellDisabled = errors.New("PowerShell is disabled in strict mode")
+
+// powerShellDisabled is set in strict mode.
+var powerShellDisabled int32
+
+// DisablePowerShell makes every later attempt to run PowerShell fail with
+// ErrPowerShellDisabled, it's called once at startup in strict mode.
+func DisablePowerShell() {
+	atomic.StoreInt32(&powerShellDisabled, 1)
+}
+
+// PowerShellDisabled returns true in strict mode.
+func PowerShellDisabled() bool {
+	return atomic.LoadInt32(&powerShellDisabled) == 1
+}
+
+// PowerShellCommand returns the command that runs the PowerShell script `script`
+// with the environment variables `envs` (e.g. "name=value") added to the
+// environment of csi-proxy. ErrPowerShellDisabled is returned in strict mode.
+func PowerShellCommand(script string, envs ...string) (*exec.Cmd, error) {
+	if PowerShellDisabled() {
+		return nil, ErrPowerShellDisabled
+	}
+	cmd := exec.Command("powershell", "/c", script)
+	if len(envs) > 0 {
+		cmd.Env = append(os.Environ(), envs...)
+	}
+	return cmd, nil
+}
+
+// RunPowershellCmd runs the PowerShell script `script` with the environment
+// variables `envs` and returns its combined stdout and stderr. The script is
+// recorded in the command log, the timeline of the slow operations, with its
+// secrets redacted. Only the cmdlets of the script are logged below
+// metrics.CommandTextLogLevel, its full text may contain host paths. The error
+// is a PowerShellError if the script wrote error records, the secrets of the
+// records are redacted too.
+func RunPowershellCmd(script string, envs ...string) ([]byte, error) {
+	cmd, err := PowerShellCommand(script, envs...)
+	if err != nil {
+		return nil, err
+	}
+	summary := CommandSummary(script)
+	klog.V(metrics.CommandTextLogLevel).Infof("Executing command: %q", Redact(script))
+	start := time.Now()
+	out, err := cmd.CombinedOutput()
+	metrics.Commands.Record(Redact(script), summary, start, RedactError(err))
+	klog.V(2).InfoS("Ran PowerShell command", "cmdlets", summary, "parameters", commandParameters(script),
+		"duration", time.Since(start), "failed", err != nil)
+	if err != nil {
+		if records, _ := ParsePowerShellErrors(string(out)); len(records) > 0 {
+			for i := range records {
+				records[i].Message = Redact(records[i].Message)
+				records[i].TargetObject = Redact(records[i].TargetObject)
+				records[i].Activity = Redact(records[i].Activity)
+			}
+			err = &PowerShellError{Err: err, Records: records}
+		}
+	}
+	return out, err
+}
+
+// cmdletRegexp matches the cmdlets of a script, e.g. Get-Disk, by the approved
+// PowerShell verbs so that the hyphenated words of the paths and the names aren't
+// taken for cmdlets, a cmdlet starts a statement, a pipeline element or an expression.
+var cmdletRegexp = regexp.MustCompile(`(?:^|[\s(|;{=])((?:Add|Clear|Connect|ConvertFrom|ConvertTo|Disable|Disconnect|Dismount|Enable|` +
+	`ForEach|Format|Get|Initialize|Install|Invoke|Measure|Mount|New|Optimize|Out|Register|Remove|Repair|` +
+	`Resize|Select|Set|Sort|Start|Stop|Test|Unlock|Unregister|Update|Wait|Where|Write)-[A-Z][A-Za-z]*)\b`)
+
+// CommandSummary returns the cmdlets run by the script `script` in order of first
+// use e.g. "Get-Disk,ConvertTo-Json", it's logged instead of the script at the
+// default verbosity because it doesn't contain the parameters (e.g. host paths)
+// and it's stable for the log analytics.
+func CommandSummary(script string) string {
+	cmdlets := []string{}
+	seen := map[string]bool{}
+	for _, match := range cmdletRegexp.FindAllStringSubmatch(script, -1) {
+		if cmdlet := match[1]; !seen[cmdlet] {
+			seen[cmdlet] = true
+			cmdlets = append(cmdlets, cmdlet)
+		}
+	}
+	if len(cmdlets) == 0 {
+		return "script"
+	}
+	return strings.Join(cmdlets, ",")
+}
+
+// parameterRegexp matches the names of the parameters of the cmdlets e.g. -ErrorAction.
+var parameterRegexp = regexp.MustCompile(`(?:^|\s)-([A-Z][A-Za-z]*)\b`)
+
+// commandParameters returns the names of the parameters passed to the cmdlets of
+// the script `script`, without their values, in order of first use.
+func commandParameters(script string) string {
+	parameters := []string{}
+	seen := map[string]bool{}
+	for _, match := range parameterRegexp.FindAllStringSubmatch(script, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			parameters = append(parameters, match[1])
+		}
+	}
+	return strings.Join(parameters, ",")
+}
+
+// CommandText returns the text of the script `script` to put in the errors, the
+// redacted script at metrics.CommandTextLogLevel and above, its summary otherwise.
+// The full text of the recent scripts stays in metrics.Commands.
+func CommandText(script string) string {
+	if klog.V(metrics.CommandTextLogLevel).Enabled() {
+		return Redact(script)
+	}
+	return CommandSummary(script)
+}
@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// PowerShellErrorRecord is an error record of the error stream of PowerShell.
+type PowerShellErrorRecord struct {
+	// Message of the error, in the display language of the host.
+	Message string
+
+	// Category of the error e.g. ObjectNotFound.
+	Category string
+
+	// ExceptionType is the type name of the exception e.g. CimJobException.
+	ExceptionType string
+
+	// TargetObject is the object the cmdlet was processing, can be empty.
+	TargetObject string
+
+	// Activity is the cmdlet or the script that wrote the error, can be empty.
+	Activity string
+
+	// ErrorID is the FullyQualifiedErrorId of the error e.g.
+	// CmdletizationQuery_NotFound_UniqueId,Get-Volume.
+	ErrorID string
+}
+
+// PowerShellError is the error of a PowerShell script that failed, with the error
+// records it wrote.
+type PowerShellError struct {
+	Err     error
+	Records []PowerShellErrorRecord
+}
+
+func (e *PowerShellError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PowerShellError) Unwrap() error {
+	return e.Err
+}
+
+// The error records are written in the default view of PowerShell 5.1 e.g.
+//
+//	Get-Volume : No MSFT_Volume objects found with property 'UniqueId' equal to 'x'.
+//	At line:1 char:1
+//	+ Get-Volume -UniqueId x
+//	+ ~~~~~~~~~~~~~~~~~~~~~~
+//	    + CategoryInfo          : ObjectNotFound: (x:String) [Get-Volume], CimJobException
+//	    + FullyQualifiedErrorId : CmdletizationQuery_NotFound_UniqueId,Get-Volume
+//
+// the message and the position are localized, the names of the fields aren't.
+var (
+	categoryInfoRegexp = regexp.MustCompile(`^\s*\+ CategoryInfo\s*: (\w+): \((.*)\) \[(.*)\], (\S+)\s*$`)
+	errorIDRegexp      = regexp.MustCompile(`^\s*\+ FullyQualifiedErrorId\s*: (.*?)\s*$`)
+	errorFieldRegexp   = regexp.MustCompile(`^\s+\+ \w+\s*: `)
+)
+
+// ParsePowerShellErrors parses the error records in the output `output` of a
+// PowerShell script. It returns the records and the output where every record is
+// replaced by its message.
+func ParsePowerShellErrors(output string) ([]PowerShellErrorRecord, string) {
+	lines := strings.Split(strings.ReplaceAll(output, "\r\n", "\n"), "\n")
+	records := []PowerShellErrorRecord{}
+	text := []string{}
+	// start is the first line that isn't part of the records parsed so far
+	start := 0
+	for i := 0; i < len(lines); i++ {
+		m := categoryInfoRegexp.FindStringSubmatch(lines[i])
+		if m == nil {
+			continue
+		}
+		record := PowerShellErrorRecord{
+			Category:      m[1],
+			TargetObject:  m[2],
+			Activity:      m[3],
+			ExceptionType: m[4],
+		}
+		// the target is written as <object>:<type name>
+		if j := strings.LastIndex(record.TargetObject, ":"); j >= 0 {
+			record.TargetObject = record.TargetObject[:j]
+		}
+
+		// the message is followed by the position of the error and the line of the
+		// script where it occurred, underlined
+		end := i
+		for end > start && strings.HasPrefix(lines[end-1], "+ ") {
+			end--
+		}
+		if end < i && end > start {
+			end--
+		}
+		// the message starts after the output written before the error, i.e. after
+		// the last blank line
+		begin := end
+		for begin > start && strings.TrimSpace(lines[begin-1]) != "" {
+			begin--
+		}
+		text = append(text, lines[start:begin]...)
+		message := strings.TrimSpace(strings.Join(lines[begin:end], "\n"))
+		// the message starts with the activity, possibly after other output on the
+		// same line
+		prefix := ""
+		if j := strings.Index(message, record.Activity+" : "); record.Activity != "" && j >= 0 {
+			prefix, message = message[:j], message[j+len(record.Activity)+3:]
+		}
+		record.Message = message
+		text = append(text, prefix+message)
+
+		// the fields of the record follow CategoryInfo, then blank lines
+		for i+1 < len(lines) && errorFieldRegexp.MatchString(lines[i+1]) {
+			i++
+			if m := errorIDRegexp.FindStringSubmatch(lines[i]); m != nil {
+				record.ErrorID = m[1]
+			}
+		}
+		for i+1 < len(lines) && strings.TrimSpace(lines[i+1]) == "" {
+			i++
+		}
+		start = i + 1
+		records = append(records, record)
+	}
+	if len(records) == 0 {
+		return nil, output
+	}
+	text = append(text, lines[start:]...)
+	return records, strings.TrimSpace(strings.Join(text, "\n"))
+}
+
+// PowerShellErrors returns the PowerShell error records of the error `err` and
+// its message where every record is replaced by its message. The records are
+// parsed from the message if `err` doesn't wrap a PowerShellError, e.g. if the
+// output of the script was formatted into the message.
+func PowerShellErrors(err error) ([]PowerShellErrorRecord, string) {
+	msg := err.Error()
+	records, text := ParsePowerShellErrors(msg)
+	var psErr *PowerShellError
+	if errors.As(err, &psErr) && len(psErr.Records) > 0 && len(records) == 0 {
+		return psErr.Records, msg
+	}
+	return records, text
+}
@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+const getVolumeNotFoundOutput = "Get-Volume : No MSFT_Volume objects found with property 'UniqueId' equal to 'x'.  Verify the value of the property and retry.\r\n" +
+	"At line:1 char:1\r\n" +
+	"+ Get-Volume -UniqueId x\r\n" +
+	"+ ~~~~~~~~~~~~~~~~~~~~~~\r\n" +
+	"    + CategoryInfo          : ObjectNotFound: (x:String) [Get-Volume], CimJobException\r\n" +
+	"    + FullyQualifiedErrorId : CmdletizationQuery_NotFound_UniqueId,Get-Volume\r\n" +
+	" \r\n"
+
+func TestParsePowerShellErrors(t *testing.T) {
+	testCases := []struct {
+		name          string
+		output        string
+		expectRecords []PowerShellErrorRecord
+		expectText    string
+	}{
+		{
+			name:       "no error",
+			output:     "4096\r\n",
+			expectText: "4096\r\n",
+		},
+		{
+			name:   "cmdlet error",
+			output: getVolumeNotFoundOutput,
+			expectRecords: []PowerShellErrorRecord{
+				{
+					Message:       "No MSFT_Volume objects found with property 'UniqueId' equal to 'x'.  Verify the value of the property and retry.",
+					Category:      "ObjectNotFound",
+					ExceptionType: "CimJobException",
+					TargetObject:  "x",
+					Activity:      "Get-Volume",
+					ErrorID:       "CmdletizationQuery_NotFound_UniqueId,Get-Volume",
+				},
+			},
+			expectText: "No MSFT_Volume objects found with property 'UniqueId' equal to 'x'.  Verify the value of the property and retry.",
+		},
+		{
+			name: "output and localized errors",
+			output: "Début\r\n" +
+				"\r\n" +
+				"Format-Volume : Accès refusé\r\n" +
+				"Au caractère Ligne:1 : 1\r\n" +
+				"+ Format-Volume -UniqueId x\r\n" +
+				"+ ~~~~~~~~~~~~~~~~~~~~~~~~~\r\n" +
+				"    + CategoryInfo          : PermissionDenied: (StorageWMI:ROOT/Microsoft/Windows/Storage/MSFT_Volume) [Format-Volume], CimException\r\n" +
+				"    + FullyQualifiedErrorId : StorageWMI 40001,Format-Volume\r\n" +
+				"\r\n" +
+				"échec\r\n" +
+				"    + CategoryInfo          : OperationStopped: (échec:String) [], RuntimeException\r\n" +
+				"    + FullyQualifiedErrorId : échec\r\n",
+			expectRecords: []PowerShellErrorRecord{
+				{
+					Message:       "Accès refusé",
+					Category:      "PermissionDenied",
+					ExceptionType: "CimException",
+					TargetObject:  "StorageWMI",
+					Activity:      "Format-Volume",
+					ErrorID:       "StorageWMI 40001,Format-Volume",
+				},
+				{
+					Message:       "échec",
+					Category:      "OperationStopped",
+					ExceptionType: "RuntimeException",
+					TargetObject:  "échec",
+					ErrorID:       "échec",
+				},
+			},
+			expectText: "Début\n\nAccès refusé\néchec",
+		},
+	}
+	for _, tc := range testCases {
+		records, text := ParsePowerShellErrors(tc.output)
+		if !reflect.DeepEqual(records, tc.expectRecords) {
+			t.Errorf("%s: expected records %+v, got %+v", tc.name, tc.expectRecords, records)
+		}
+		if text != tc.expectText {
+			t.Errorf("%s: expected text %q, got %q", tc.name, tc.expectText, text)
+		}
+	}
+}
+
+func TestPowerShellErrors(t *testing.T) {
+	// the output formatted into the message
+	err := fmt.Errorf("error getting volume. output: %s, error: %v", getVolumeNotFoundOutput, errors.New("exit status 1"))
+	records, msg := PowerShellErrors(err)
+	if len(records) != 1 || records[0].Category != "ObjectNotFound" || records[0].Message != "No MSFT_Volume objects found with property 'UniqueId' equal to 'x'.  Verify the value of the property and retry." {
+		t.Errorf("expected the ObjectNotFound record, got %+v", records)
+	}
+	expectMsg := "error getting volume. output: No MSFT_Volume objects found with property 'UniqueId' equal to 'x'.  Verify the value of the property and retry.\n, error: exit status 1"
+	if msg != expectMsg {
+		t.Errorf("expected message %q, got %q", expectMsg, msg)
+	}
+
+	// the PowerShellError wrapped
+	psErr := &PowerShellError{Err: errors.New("exit status 1"), Records: []PowerShellErrorRecord{{Category: "InvalidArgument"}}}
+	err = fmt.Errorf("error resizing volume: %w", psErr)
+	records, msg = PowerShellErrors(err)
+	if len(records) != 1 || records[0].Category != "InvalidArgument" {
+		t.Errorf("expected the InvalidArgument record, got %+v", records)
+	}
+	if msg != err.Error() {
+		t.Errorf("expected message %q, got %q", err.Error(), msg)
+	}
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPowerShellDisabled(t *testing.T) {
+	if _, err := PowerShellCommand("Get-Disk"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	DisablePowerShell()
+	defer func() { powerShellDisabled = 0 }()
+	if _, err := PowerShellCommand("Get-Disk"); !errors.Is(err, ErrPowerShellDisabled) {
+		t.Errorf("expected ErrPowerShellDisabled, got %v", err)
+	}
+	if _, err := RunPowershellCmd("Get-Disk"); !errors.Is(err, ErrPowerShellDisabled) {
+		t.Errorf("expected ErrPowerShellDisabled, got %v", err)
+	}
+}
+
+func TestCommandSummary(t *testing.T) {
+	testCases := []struct {
+		script           string
+		expectSummary    string
+		expectParameters string
+	}{
+		{
+			script:           `Get-Disk -Number 1 | Get-Partition | ConvertTo-Json`,
+			expectSummary:    "Get-Disk,Get-Partition,ConvertTo-Json",
+			expectParameters: "Number",
+		},
+		{
+			script:           `Get-Item -Path C:\var\lib\New-Volumes\pvc-1 -ErrorAction Stop | Get-Item -Path $Env:path`,
+			expectSummary:    "Get-Item",
+			expectParameters: "Path,ErrorAction",
+		},
+		{
+			script:        `$disk = 1; Write-Output $disk`,
+			expectSummary: "Write-Output",
+		},
+		{
+			script:        `fsutil dirty query C:`,
+			expectSummary: "script",
+		},
+	}
+	for _, tc := range testCases {
+		if summary := CommandSummary(tc.script); summary != tc.expectSummary {
+			t.Errorf("CommandSummary(%q): expected %q, got %q", tc.script, tc.expectSummary, summary)
+		}
+		if parameters := commandParameters(tc.script); parameters != tc.expectParameters {
+			t.Errorf("commandParameters(%q): expected %q, got %q", tc.script, tc.expectParameters, parameters)
+		}
+		// the text is summarized at the default verbosity
+		if text := CommandText(tc.script); text != tc.expectSummary {
+			t.Errorf("CommandText(%q): expected %q, got %q", tc.script, tc.expectSummary, text)
+		}
+	}
+}
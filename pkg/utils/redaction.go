@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces the secrets in the logs, the command log and the errors.
+const Redacted = "[REDACTED]"
+
+// maxRedactedSecrets is the number of secrets that are redacted, the secrets of
+// the older requests are forgotten first.
+const maxRedactedSecrets = 256
+
+// minRedactedSecretLength is the length of the shortest secret that's redacted,
+// replacing every occurrence of shorter secrets would garble the logs. The
+// secrets passed as parameters of commands are redacted whatever their length.
+const minRedactedSecretLength = 4
+
+// secretParameterRegexp matches the credential parameters of command lines e.g.
+// "-ChapSecret abc" and "smbpassword=abc", references to environment variables
+// (e.g. "-ChapSecret ${Env:iscsi_chap_secret}") aren't secrets.
+var secretParameterRegexp = regexp.MustCompile(`(?i)((?:-\w*(?:password|secret)\s+)|(?:\b\w*(?:password|secret)=))([^\s$]\S*)`)
+
+// redaction holds the secrets that are redacted, they're kept in a ring buffer.
+var redaction = struct {
+	lock    sync.RWMutex
+	enabled bool
+	secrets []string
+	next    int
+}{enabled: true}
+
+// SetRedaction enables or disables the redaction of the secrets, it's enabled
+// by default.
+func SetRedaction(enabled bool) {
+	redaction.lock.Lock()
+	defer redaction.lock.Unlock()
+	redaction.enabled = enabled
+}
+
+// RegisterSecret makes `secret` (e.g. an SMB password or a CHAP secret of a
+// request) redacted from then on.
+func RegisterSecret(secret string) {
+	if len(secret) < minRedactedSecretLength {
+		return
+	}
+	redaction.lock.Lock()
+	defer redaction.lock.Unlock()
+	for _, s := range redaction.secrets {
+		if s == secret {
+			return
+		}
+	}
+	if len(redaction.secrets) < maxRedactedSecrets {
+		redaction.secrets = append(redaction.secrets, secret)
+		return
+	}
+	redaction.secrets[redaction.next] = secret
+	redaction.next = (redaction.next + 1) % len(redaction.secrets)
+}
+
+// Redact replaces the registered secrets and the credential parameters of
+// command lines in `s` with Redacted.
+func Redact(s string) string {
+	redaction.lock.RLock()
+	defer redaction.lock.RUnlock()
+	if !redaction.enabled {
+		return s
+	}
+	for _, secret := range redaction.secrets {
+		s = strings.ReplaceAll(s, secret, Redacted)
+	}
+	return secretParameterRegexp.ReplaceAllString(s, "${1}"+Redacted)
+}
+
+// redactedError is an error whose message was redacted, the error it wraps is
+// still available to errors.As.
+type redactedError struct {
+	err error
+	msg string
+}
+
+func (e *redactedError) Error() string {
+	return e.msg
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// RedactError returns `err` with the secrets of its message redacted, `err` is
+// returned as is if its message has no secrets.
+func RedactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	if redacted := Redact(msg); redacted != msg {
+		return &redactedError{err: err, msg: redacted}
+	}
+	return err
+}
+
+// RedactionLogFilter is a klog.LogFilter that redacts the secrets of every log
+// line.
+type RedactionLogFilter struct{}
+
+func (RedactionLogFilter) Filter(args []interface{}) []interface{} {
+	return []interface{}{Redact(fmt.Sprint(args...))}
+}
+
+func (RedactionLogFilter) FilterF(format string, args []interface{}) (string, []interface{}) {
+	return "%s", []interface{}{Redact(fmt.Sprintf(format, args...))}
+}
+
+func (RedactionLogFilter) FilterS(msg string, keysAndValues []interface{}) (string, []interface{}) {
+	redacted := make([]interface{}, len(keysAndValues))
+	for i, v := range keysAndValues {
+		if i%2 == 1 {
+			v = Redact(fmt.Sprint(v))
+		}
+		redacted[i] = v
+	}
+	return Redact(msg), redacted
+}
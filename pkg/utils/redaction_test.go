@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	defer func() {
+		redaction.secrets, redaction.next = nil, 0
+		SetRedaction(true)
+	}()
+	RegisterSecret("p4ssw0rd")
+	RegisterSecret("abc")
+
+	testCases := []struct {
+		in       string
+		expected string
+	}{
+		{in: "mapping failed with p4ssw0rd", expected: "mapping failed with [REDACTED]"},
+		{in: "New-SmbGlobalMapping -Password hunter2 -Persistent", expected: "New-SmbGlobalMapping -Password [REDACTED] -Persistent"},
+		{in: "smbpassword=hunter2", expected: "smbpassword=[REDACTED]"},
+		{in: "Connect-IscsiTarget -ChapSecret ${Env:iscsi_chap_secret}", expected: "Connect-IscsiTarget -ChapSecret ${Env:iscsi_chap_secret}"},
+		// secrets too short to be redacted everywhere
+		{in: "abcdef", expected: "abcdef"},
+	}
+	for _, tc := range testCases {
+		if got := Redact(tc.in); got != tc.expected {
+			t.Errorf("Redact(%q): expected %q, got %q", tc.in, tc.expected, got)
+		}
+	}
+
+	coded := &InvalidByteSizeError{Field: "SizeBytes", Value: "-1"}
+	err := RedactError(fmt.Errorf("p4ssw0rd rejected: %w", coded))
+	if code, _ := StableErrorCode(err); err.Error() != "[REDACTED] rejected: "+coded.Error() || code != ErrorCodeInvalidParameter {
+		t.Errorf("expected a redacted error that wraps the original error, got %v", err)
+	}
+
+	SetRedaction(false)
+	if got := Redact("p4ssw0rd"); got != "p4ssw0rd" {
+		t.Errorf("expected no redaction when it's disabled, got %q", got)
+	}
+}
+
+func TestRegisterSecretBounded(t *testing.T) {
+	defer func() { redaction.secrets, redaction.next = nil, 0 }()
+	for i := 0; i < maxRedactedSecrets+1; i++ {
+		RegisterSecret(fmt.Sprintf("secret-%d", i))
+	}
+	if len(redaction.secrets) != maxRedactedSecrets {
+		t.Errorf("expected %d secrets, got %d", maxRedactedSecrets, len(redaction.secrets))
+	}
+	if got := Redact("secret-0 secret-1"); got != "secret-0 [REDACTED]" {
+		t.Errorf("expected the oldest secret to be forgotten, got %q", got)
+	}
+}
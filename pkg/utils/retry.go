@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/kubernetes-csi/csi-proxy/pkg/metrics"
+	"k8s.io/klog/v2"
+)
+
+// hresultRegexp matches the HRESULTs included in the output of failed cmdlets
+// e.g. "... (Exception from HRESULT: 0x80070020)"
+var hresultRegexp = regexp.MustCompile(`0x[0-9A-Fa-f]{8}`)
+
+// RetryOnError calls `fn` until it succeeds, it fails with an error that
+// `isRetriable` rejects or it was called `maxAttempts` times, waiting `interval`
+// between attempts. The number of attempts and the error codes that caused
+// retries are recorded in the metrics of `operation`.
+func RetryOnError(operation string, maxAttempts int, interval time.Duration, isRetriable func(error) bool, fn func() error) error {
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err = fn(); err == nil {
+			metrics.ObserveOperationAttempts(operation, attempt, true)
+			return nil
+		}
+		if attempt == maxAttempts || !isRetriable(err) {
+			break
+		}
+		code := ErrorCode(err)
+		klog.V(4).Infof("%s failed with error code %s (attempt %d/%d), retrying: %v", operation, code, attempt, maxAttempts, err)
+		metrics.ObserveRetriedError(operation, code)
+		time.Sleep(interval)
+	}
+	metrics.ObserveOperationAttempts(operation, 0, false)
+	return err
+}
+
+// ErrorCode returns the Windows error code of `err`, it's either the system
+// error code of a failed syscall or the HRESULT of a failed cmdlet. "unknown"
+// is returned if `err` doesn't have an error code.
+func ErrorCode(err error) string {
+	var errno syscall.Errno
+	if errors.As(err, &errno) {
+		return strconv.FormatUint(uint64(errno), 10)
+	}
+	if code := hresultRegexp.FindString(err.Error()); code != "" {
+		return code
+	}
+	return "unknown"
+}
@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"syscall"
+	"testing"
+)
+
+func TestRetryOnError(t *testing.T) {
+	transientErr := fmt.Errorf("open failed: %w", syscall.Errno(32))
+	permanentErr := errors.New("access denied")
+
+	testCases := []struct {
+		name           string
+		errs           []error
+		expectAttempts int
+		expectError    bool
+	}{
+		{
+			name:           "succeeds in the first attempt",
+			errs:           []error{nil},
+			expectAttempts: 1,
+		},
+		{
+			name:           "succeeds after retries",
+			errs:           []error{transientErr, transientErr, nil},
+			expectAttempts: 3,
+		},
+		{
+			name:           "fails with a non retriable error",
+			errs:           []error{permanentErr},
+			expectAttempts: 1,
+			expectError:    true,
+		},
+		{
+			name:           "fails after exhausting the attempts",
+			errs:           []error{transientErr, transientErr, transientErr, nil},
+			expectAttempts: 3,
+			expectError:    true,
+		},
+	}
+
+	isRetriable := func(err error) bool {
+		return err == transientErr
+	}
+	for _, tc := range testCases {
+		attempts := 0
+		err := RetryOnError("Test", 3, 0, isRetriable, func() error {
+			err := tc.errs[attempts]
+			attempts++
+			return err
+		})
+		if attempts != tc.expectAttempts {
+			t.Errorf("%s: expected %d attempts, got %d", tc.name, tc.expectAttempts, attempts)
+		}
+		if tc.expectError != (err != nil) {
+			t.Errorf("%s: expected error=%v, got %v", tc.name, tc.expectError, err)
+		}
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	testCases := []struct {
+		err        error
+		expectCode string
+	}{
+		{
+			err:        fmt.Errorf("open failed: %w", syscall.Errno(32)),
+			expectCode: "32",
+		},
+		{
+			err:        errors.New("Format-Volume : Access denied (Exception from HRESULT: 0x80070005)"),
+			expectCode: "0x80070005",
+		},
+		{
+			err:        errors.New("exit status 1"),
+			expectCode: "unknown",
+		},
+	}
+	for _, tc := range testCases {
+		if code := ErrorCode(tc.err); code != tc.expectCode {
+			t.Errorf("expected error code %q for %v, got %q", tc.expectCode, tc.err, code)
+		}
+	}
+}
+
+type codedError struct{}
+
+func (codedError) Error() string     { return "coded error" }
+func (codedError) ErrorCode() string { return ErrorCodeMountConflict }
+
+func TestStableErrorCode(t *testing.T) {
+	testCases := []struct {
+		err               error
+		expectCode        string
+		expectWindowsCode string
+	}{
+		{
+			err:               fmt.Errorf("open failed: %w", syscall.Errno(32)),
+			expectCode:        ErrorCodeInUse,
+			expectWindowsCode: "32",
+		},
+		{
+			err:               errors.New("Format-Volume : Zugriff verweigert (Ausnahme von HRESULT: 0x80070005)"),
+			expectCode:        ErrorCodeAccessDenied,
+			expectWindowsCode: "0x80070005",
+		},
+		{
+			err:               errors.New("Get-CimInstance : Introuvable (HRESULT 0x80041002)"),
+			expectCode:        ErrorCodeNotFound,
+			expectWindowsCode: "0x80041002",
+		},
+		{
+			err:               errors.New("Initialize-Disk : Acceso denegado\r\n    + FullyQualifiedErrorId : StorageWMI 40001,Initialize-Disk"),
+			expectCode:        ErrorCodeAccessDenied,
+			expectWindowsCode: "StorageWMI 40001",
+		},
+		{
+			err:               errors.New("Get-Volume : ...\r\n    + FullyQualifiedErrorId : CmdletizationQuery_NotFound_UniqueId,Get-Volume"),
+			expectCode:        ErrorCodeNotFound,
+			expectWindowsCode: "CmdletizationQuery_NotFound",
+		},
+		{
+			err:               errors.New("Format-Volume : failed (Exception from HRESULT: 0x80070539)"),
+			expectCode:        "",
+			expectWindowsCode: "",
+		},
+		{
+			err:               fmt.Errorf("mount failed: %w", codedError{}),
+			expectCode:        ErrorCodeMountConflict,
+			expectWindowsCode: "",
+		},
+		{
+			err:               errors.New("exit status 1"),
+			expectCode:        "",
+			expectWindowsCode: "",
+		},
+	}
+	for _, tc := range testCases {
+		code, windowsCode := StableErrorCode(tc.err)
+		if code != tc.expectCode || windowsCode != tc.expectWindowsCode {
+			t.Errorf("expected (%q, %q) for %v, got (%q, %q)", tc.expectCode, tc.expectWindowsCode, tc.err, code, windowsCode)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package utils
+
+import "fmt"
+
+// Builds of the Windows Server releases.
+const (
+	BuildWindowsServer2016 = 14393
+	BuildWindowsServer1709 = 16299
+	BuildWindowsServer2019 = 17763
+	BuildWindowsServer2022 = 20348
+	BuildWindowsServer2025 = 26100
+)
+
+// Feature is a feature of Windows used by csi-proxy that older builds of Windows
+// don't have.
+type Feature struct {
+	// Name describes the feature in the errors e.g. "ReFS deduplication".
+	Name string
+	// MinimumBuild is the first build of Windows with the feature.
+	MinimumBuild uint32
+	// Release is the first release of Windows with the feature.
+	Release string
+}
+
+var (
+	FeatureSmbGlobalMapping   = Feature{Name: "SMB global mappings", MinimumBuild: BuildWindowsServer1709, Release: "Windows Server 1709"}
+	FeatureSmbAlternativePort = Feature{Name: "SMB over alternative ports", MinimumBuild: BuildWindowsServer2025, Release: "Windows Server 2025"}
+	FeatureReFSDeduplication  = Feature{Name: "ReFS deduplication", MinimumBuild: BuildWindowsServer2025, Release: "Windows Server 2025"}
+)
+
+// OperationFeatures is the capability matrix of the operations, the features of
+// Windows that every call of an operation requires by service and method name
+// without the API version. The features that depend on the arguments of a call
+// are checked by the operations themselves.
+var OperationFeatures = map[string][]Feature{
+	"Smb/NewSmbGlobalMapping":    {FeatureSmbGlobalMapping},
+	"Smb/RemoveSmbGlobalMapping": {FeatureSmbGlobalMapping},
+}
+
+// UnsupportedFeatureError is returned by the operations that require a feature
+// that the build of Windows of the node doesn't have.
+type UnsupportedFeatureError struct {
+	Feature Feature
+	Build   uint32
+}
+
+func (e *UnsupportedFeatureError) Error() string {
+	return fmt.Sprintf("%s requires %s (build %d) or later, the node runs build %d",
+		e.Feature.Name, e.Feature.Release, e.Feature.MinimumBuild, e.Build)
+}
+
+// ErrorCode returns the stable code of the error.
+func (e *UnsupportedFeatureError) ErrorCode() string {
+	return ErrorCodeNotSupported
+}
+
+// CheckFeature returns an UnsupportedFeatureError if the build of Windows of the
+// node doesn't have the feature `feature`. Features are assumed to be available
+// if the build is unknown.
+func CheckFeature(feature Feature) error {
+	c, ok := GetCapabilities()
+	if !ok || c.Build == 0 || c.Build >= feature.MinimumBuild {
+		return nil
+	}
+	return &UnsupportedFeatureError{Feature: feature, Build: c.Build}
+}
+
+// CheckOperation checks the features of OperationFeatures required by the
+// operation `method` e.g. "Smb/NewSmbGlobalMapping".
+func CheckOperation(method string) error {
+	for _, feature := range OperationFeatures[method] {
+		if err := CheckFeature(feature); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckOperation(t *testing.T) {
+	defer func() { capabilities = nil }()
+
+	if err := CheckOperation("Smb/NewSmbGlobalMapping"); err != nil {
+		t.Errorf("expected every feature to be available before the capabilities are set, got %v", err)
+	}
+
+	testCases := []struct {
+		build       uint32
+		method      string
+		expectError bool
+	}{
+		{build: BuildWindowsServer2016, method: "Smb/NewSmbGlobalMapping", expectError: true},
+		{build: BuildWindowsServer2019, method: "Smb/NewSmbGlobalMapping"},
+		{build: BuildWindowsServer2016, method: "Volume/FormatVolume"},
+		// the build couldn't be read
+		{build: 0, method: "Smb/NewSmbGlobalMapping"},
+	}
+	for _, tc := range testCases {
+		SetCapabilities(Capabilities{Build: tc.build})
+		err := CheckOperation(tc.method)
+		if tc.expectError != (err != nil) {
+			t.Errorf("%s on build %d: expected error=%v, got %v", tc.method, tc.build, tc.expectError, err)
+		}
+		var unsupported *UnsupportedFeatureError
+		if err != nil && (!errors.As(err, &unsupported) || unsupported.ErrorCode() != ErrorCodeNotSupported) {
+			t.Errorf("%s on build %d: expected an UnsupportedFeatureError, got %v", tc.method, tc.build, err)
+		}
+	}
+}
@@ -62,6 +62,10 @@ run_csi_proxy_integration_tests() {
     Run-CSIProxyIntegrationTests -test_args \"--test.v --test.run TestDiskAPIGroup\";
     Run-CSIProxyIntegrationTests -test_args \"--test.v --test.run TestVolumeAPIs\";
     Run-CSIProxyIntegrationTests -test_args \"--test.v --test.run TestSmbAPIGroup\";
+    Run-CSIProxyIntegrationTests -test_args \"--test.v --test.run TestBitLockerAPIGroup\";
+    Run-CSIProxyIntegrationTests -test_args \"--test.v --test.run TestVssAPIGroup\";
+    Run-CSIProxyIntegrationTests -test_args \"--test.v --test.run TestVhdAPIGroup\";
+    Run-CSIProxyIntegrationTests -test_args \"--test.v --test.run TestStorageSpacesAPIGroup\";
   }"
 EOF
 );